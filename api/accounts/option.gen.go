@@ -1,6 +1,8 @@
 package accounts
 
 import (
+	"strings"
+
 	"github.com/hashicorp/boundary/api"
 )
 
@@ -17,6 +19,8 @@ type options struct {
 	postMap                 map[string]interface{}
 	queryMap                map[string]string
 	withAutomaticVersioning bool
+	withFilter              string
+	withPageSize            uint32
 }
 
 func getDefaultOptions() options {
@@ -45,6 +49,25 @@ func WithAutomaticVersioning(enable bool) Option {
 	}
 }
 
+// WithFilter tells the API to filter the items returned using the provided
+// filter term. The filter should be in a format supported by
+// hashicorp/go-bexpr, with fields of items selected with JSON pointers
+// under "/item", e.g. "/item/name" == "foo".
+func WithFilter(filter string) Option {
+	return func(o *options) {
+		o.withFilter = strings.TrimSpace(filter)
+	}
+}
+
+// WithPageSize tells the API how many items to return in each page of a
+// list. The controller uses its default page size if this is unset. List and
+// ListIterator request the following pages as they are needed.
+func WithPageSize(size uint32) Option {
+	return func(o *options) {
+		o.withPageSize = size
+	}
+}
+
 func WithAttributes(inAttributes map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["attributes"] = inAttributes
@@ -69,6 +92,30 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithLdapAccountLoginName(inLoginName string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["login_name"] = inLoginName
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAccountLoginName() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["login_name"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithPasswordAccountLoginName(inLoginName string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -128,3 +175,27 @@ func DefaultPasswordAccountPassword() Option {
 		o.postMap["attributes"] = val
 	}
 }
+
+func WithOidcAccountSubject(inSubject string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["subject"] = inSubject
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultOidcAccountSubject() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["subject"] = nil
+		o.postMap["attributes"] = val
+	}
+}
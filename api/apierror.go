@@ -1,10 +1,14 @@
 package api
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"net/http"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -15,15 +19,91 @@ var (
 )
 
 // AsServerError returns an api *Error from the provided error.  If the provided error
-// is not an api Error nil is returned instead.
+// is not an api Error nil is returned instead. Status errors returned by the
+// gRPC listener of Boundary are converted to the Error the same request would
+// have returned over HTTP.
 func AsServerError(in error) *Error {
 	var serverErr *Error
 	if !errors.As(in, &serverErr) {
-		return nil
+		return errorFromGrpcStatus(in)
 	}
 	return serverErr
 }
 
+// errorFromGrpcStatus converts a gRPC status error to an api *Error, or
+// returns nil if in is not a status error
+func errorFromGrpcStatus(in error) *Error {
+	st, ok := status.FromError(in)
+	if !ok || st.Code() == codes.OK {
+		return nil
+	}
+	ret := &Error{
+		Status:  int32(httpStatusFromGrpcCode(st.Code())),
+		Code:    st.Code().String(),
+		Message: st.Message(),
+	}
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.BadRequest:
+			if ret.Details == nil {
+				ret.Details = new(ErrorDetails)
+			}
+			for _, v := range d.GetFieldViolations() {
+				ret.Details.RequestFields = append(ret.Details.RequestFields, &FieldError{
+					Name:        v.GetField(),
+					Description: v.GetDescription(),
+				})
+			}
+		case *errdetails.ErrorInfo:
+			if errId := d.GetMetadata()["error_id"]; errId != "" {
+				if ret.Details == nil {
+					ret.Details = new(ErrorDetails)
+				}
+				ret.Details.ErrorId = errId
+			}
+		}
+	}
+	// Keep the error printable the same as errors read from HTTP responses
+	body, err := json.Marshal(ret)
+	if err != nil {
+		body = []byte(st.Message())
+	}
+	ret.responseBody = bytes.NewBuffer(body)
+	return ret
+}
+
+// httpStatusFromGrpcCode returns the HTTP status the controller responds with
+// for a gRPC code
+func httpStatusFromGrpcCode(c codes.Code) int {
+	switch c {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return http.StatusRequestTimeout
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.Unimplemented:
+		return http.StatusMethodNotAllowed
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
 // Error satisfies the error interface.
 func (e *Error) Error() string {
 	return e.responseBody.String()
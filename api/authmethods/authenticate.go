@@ -40,3 +40,46 @@ func (c *Client) Authenticate(ctx context.Context, authMethodId string, credenti
 
 	return target, nil
 }
+
+// AuthenticateStartResult is the result of starting an authentication with
+// an oidc auth method. The user authenticates with the provider at AuthUrl
+// which then redirects the user to the redirect URL with State and an
+// authorization code. Both are passed as the "state" and "code" credentials
+// to Authenticate.
+type AuthenticateStartResult struct {
+	AuthUrl string `json:"auth_url,omitempty"`
+	State   string `json:"state,omitempty"`
+}
+
+func (c *Client) AuthenticateStart(ctx context.Context, authMethodId, redirectUrl string, opt ...Option) (*AuthenticateStartResult, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in AuthenticateStart request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	reqBody := map[string]interface{}{
+		"redirect_url": redirectUrl,
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("auth-methods/%s:authenticate-start", authMethodId), reqBody, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating AuthenticateStart request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during AuthenticateStart call: %w", err)
+	}
+
+	target := new(AuthenticateStartResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding AuthenticateStart response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	return target, nil
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/boundary/api"
@@ -72,9 +73,10 @@ func (n AuthMethodDeleteResult) GetResponseMap() map[string]interface{} {
 }
 
 type AuthMethodListResult struct {
-	Items        []*AuthMethod
-	responseBody *bytes.Buffer
-	responseMap  map[string]interface{}
+	Items         []*AuthMethod
+	NextPageToken string `json:"next_page_token,omitempty"`
+	responseBody  *bytes.Buffer
+	responseMap   map[string]interface{}
 }
 
 func (n AuthMethodListResult) GetItems() interface{} {
@@ -306,7 +308,98 @@ func (c *Client) Delete(ctx context.Context, authMethodId string, opt ...Option)
 	return target, nil
 }
 
+// List returns every item of the list, requesting its pages from the
+// controller one after the other. The response body and map of the result are
+// those of the last page. Use ListIterator to handle the items of large lists
+// as they arrive.
 func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*AuthMethodListResult, error) {
+	it, err := c.ListIterator(ctx, scopeId, opt...)
+	if err != nil {
+		return nil, err
+	}
+	var items []*AuthMethod
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	target := *it.Page()
+	target.Items = items
+	return &target, nil
+}
+
+// AuthMethodListIterator iterates over the items of a list, requesting the
+// pages of the list from the controller as they are needed.
+type AuthMethodListIterator struct {
+	client  *Client
+	ctx     context.Context
+	scopeId string
+	opts    []Option
+	page    *AuthMethodListResult
+	index   int
+	err     error
+}
+
+// ListIterator returns an iterator over the items of the list, having
+// requested its first page.
+func (c *Client) ListIterator(ctx context.Context, scopeId string, opt ...Option) (*AuthMethodListIterator, error) {
+	page, err := c.listPage(ctx, scopeId, "", opt...)
+	if err != nil {
+		return nil, err
+	}
+	return &AuthMethodListIterator{
+		client:  c,
+		ctx:     ctx,
+		scopeId: scopeId,
+		opts:    opt,
+		page:    page,
+		index:   -1,
+	}, nil
+}
+
+// Next advances the iterator to the next item, requesting the next page of
+// the list once the items of the current one are used up. It returns false
+// when there are no more items or requesting a page failed, in which case Err
+// returns the error.
+func (it *AuthMethodListIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	it.index++
+	for it.index >= len(it.page.Items) {
+		if it.page.NextPageToken == "" {
+			return false
+		}
+		page, err := it.client.listPage(it.ctx, it.scopeId, it.page.NextPageToken, it.opts...)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.index = page, 0
+	}
+	return true
+}
+
+// Item returns the current item. It must only be called after Next returned
+// true.
+func (it *AuthMethodListIterator) Item() *AuthMethod {
+	return it.page.Items[it.index]
+}
+
+// Page returns the page of the list holding the current item.
+func (it *AuthMethodListIterator) Page() *AuthMethodListResult {
+	return it.page
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *AuthMethodListIterator) Err() error {
+	return it.err
+}
+
+// listPage requests the page of the list following pageToken, or the first
+// page if pageToken is empty
+func (c *Client) listPage(ctx context.Context, scopeId string, pageToken string, opt ...Option) (*AuthMethodListResult, error) {
 	if scopeId == "" {
 		return nil, fmt.Errorf("empty scopeId value passed into List request")
 	}
@@ -316,6 +409,15 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Auth
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
+	if opts.withFilter != "" {
+		opts.queryMap["filter"] = opts.withFilter
+	}
+	if opts.withPageSize != 0 {
+		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
+	}
+	if pageToken != "" {
+		opts.queryMap["page_token"] = pageToken
+	}
 
 	req, err := c.client.NewRequest(ctx, "GET", "auth-methods", nil, apiOpts...)
 	if err != nil {
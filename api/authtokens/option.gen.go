@@ -1,6 +1,9 @@
 package authtokens
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/boundary/api"
 )
 
@@ -17,6 +20,8 @@ type options struct {
 	postMap                 map[string]interface{}
 	queryMap                map[string]string
 	withAutomaticVersioning bool
+	withFilter              string
+	withPageSize            uint32
 }
 
 func getDefaultOptions() options {
@@ -44,3 +49,28 @@ func WithAutomaticVersioning(enable bool) Option {
 		o.withAutomaticVersioning = enable
 	}
 }
+
+// WithFilter tells the API to filter the items returned using the provided
+// filter term. The filter should be in a format supported by
+// hashicorp/go-bexpr, with fields of items selected with JSON pointers
+// under "/item", e.g. "/item/name" == "foo".
+func WithFilter(filter string) Option {
+	return func(o *options) {
+		o.withFilter = strings.TrimSpace(filter)
+	}
+}
+
+// WithPageSize tells the API how many items to return in each page of a
+// list. The controller uses its default page size if this is unset. List and
+// ListIterator request the following pages as they are needed.
+func WithPageSize(size uint32) Option {
+	return func(o *options) {
+		o.withPageSize = size
+	}
+}
+
+func WithRecursive(inRecursive bool) Option {
+	return func(o *options) {
+		o.queryMap["recursive"] = fmt.Sprintf("%v", inRecursive)
+	}
+}
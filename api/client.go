@@ -29,6 +29,7 @@ import (
 )
 
 const EnvBoundaryAddr = "BOUNDARY_ADDR"
+const EnvBoundaryGrpcAddr = "BOUNDARY_GRPC_ADDR"
 const EnvBoundaryCACert = "BOUNDARY_CACERT"
 const EnvBoundaryCAPath = "BOUNDARY_CAPATH"
 const EnvBoundaryClientCert = "BOUNDARY_CLIENT_CERT"
@@ -49,6 +50,12 @@ type Config struct {
 	// HttpClient.
 	Addr string
 
+	// GrpcAddr is the address of a listener of the Boundary controller with
+	// the "grpc" purpose, serving the API over gRPC. This should be a URL
+	// such as "https://boundary.example.com:9204"; its scheme selects whether
+	// TLS is used. It is only used by GrpcDial.
+	GrpcAddr string
+
 	// Token is the client token that reuslts from authentication and can be
 	// used to make calls into Boundary
 	Token string
@@ -266,6 +273,10 @@ func (c *Config) ReadEnvironment() error {
 		c.Addr = v
 	}
 
+	if v := os.Getenv(EnvBoundaryGrpcAddr); v != "" {
+		c.GrpcAddr = v
+	}
+
 	if v := os.Getenv(EnvBoundaryToken); v != "" {
 		c.Token = v
 	}
@@ -550,6 +561,7 @@ func (c *Client) Clone() *Client {
 
 	newConfig := &Config{
 		Addr:               config.Addr,
+		GrpcAddr:           config.GrpcAddr,
 		Token:              config.Token,
 		RecoveryKmsWrapper: config.RecoveryKmsWrapper,
 		HttpClient:         config.HttpClient,
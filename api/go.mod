@@ -13,5 +13,6 @@ require (
 	github.com/hashicorp/go-rootcerts v1.0.2
 	github.com/stretchr/testify v1.6.1
 	golang.org/x/time v0.0.0-20200630173020-3af7569d3a1e
+	google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013
 	google.golang.org/grpc v1.32.0
 )
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/boundary/sdk/recovery"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// GrpcAddr returns the address of the gRPC listener of Boundary
+func (c *Client) GrpcAddr() string {
+	c.modifyLock.RLock()
+	defer c.modifyLock.RUnlock()
+
+	return c.config.GrpcAddr
+}
+
+// SetGrpcAddr sets the address of the gRPC listener of Boundary used by
+// GrpcDial. The format of address should be "<Scheme>://<Host>:<Port>", where
+// the scheme is "https", or "http" for listeners with TLS disabled. Setting
+// this on a client will override the value of the BOUNDARY_GRPC_ADDR
+// environment variable.
+func (c *Client) SetGrpcAddr(addr string) error {
+	if _, _, err := parseGrpcAddr(addr); err != nil {
+		return err
+	}
+
+	c.modifyLock.Lock()
+	defer c.modifyLock.Unlock()
+
+	c.config.GrpcAddr = addr
+	return nil
+}
+
+// GrpcDial connects to the gRPC listener of Boundary at the address set by
+// SetGrpcAddr. Requests made over the connection are authenticated with the
+// token of the client, or a recovery KMS token if a wrapper is set, read at
+// the time of each request. Connections to "https" addresses use the TLS
+// configuration of the client.
+//
+// The services of the connection are those of the Boundary API, and can be
+// called with clients generated from the protobuf definitions of the
+// controller API services.
+func (c *Client) GrpcDial(ctx context.Context, opt ...grpc.DialOption) (*grpc.ClientConn, error) {
+	c.modifyLock.RLock()
+	addr := c.config.GrpcAddr
+	httpClient := c.config.HttpClient
+	c.modifyLock.RUnlock()
+
+	if addr == "" {
+		return nil, fmt.Errorf("no grpc address set")
+	}
+	target, secure, err := parseGrpcAddr(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithPerRPCCredentials(&grpcTokenCredentials{client: c, secure: secure}),
+	}
+	switch secure {
+	case true:
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if httpClient != nil {
+			if transport, ok := httpClient.Transport.(*http.Transport); ok && transport.TLSClientConfig != nil {
+				tlsConfig = transport.TLSClientConfig.Clone()
+			}
+		}
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	default:
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+	}
+
+	conn, err := grpc.DialContext(ctx, target, append(dialOpts, opt...)...)
+	if err != nil {
+		return nil, fmt.Errorf("error dialing grpc address %q: %w", addr, err)
+	}
+	return conn, nil
+}
+
+// parseGrpcAddr returns the host and port to dial for a gRPC address and
+// whether TLS is used
+func parseGrpcAddr(addr string) (string, bool, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return "", false, fmt.Errorf("error parsing grpc address: %w", err)
+	}
+	if u.Host == "" {
+		return "", false, fmt.Errorf("grpc address %q has no host", addr)
+	}
+	switch u.Scheme {
+	case "https":
+		return u.Host, true, nil
+	case "http":
+		return u.Host, false, nil
+	default:
+		return "", false, fmt.Errorf("unsupported scheme %q in grpc address", u.Scheme)
+	}
+}
+
+// grpcTokenCredentials adds the token of a client to each gRPC request
+type grpcTokenCredentials struct {
+	client *Client
+	secure bool
+}
+
+func (t *grpcTokenCredentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	t.client.modifyLock.RLock()
+	token := t.client.config.Token
+	recoveryKmsWrapper := t.client.config.RecoveryKmsWrapper
+	t.client.modifyLock.RUnlock()
+
+	if recoveryKmsWrapper != nil {
+		var err error
+		token, err = recovery.GenerateRecoveryToken(ctx, recoveryKmsWrapper)
+		if err != nil {
+			return nil, fmt.Errorf("error generating recovery KMS workflow token: %w", err)
+		}
+	}
+	if token == "" {
+		return nil, nil
+	}
+	return map[string]string{"authorization": "Bearer " + token}, nil
+}
+
+func (t *grpcTokenCredentials) RequireTransportSecurity() bool {
+	return t.secure
+}
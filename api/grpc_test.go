@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClientSetGrpcAddr(t *testing.T) {
+	tests := []struct {
+		name   string
+		addr   string
+		target string
+		secure bool
+		err    string
+	}{
+		{"https", "https://boundary.example.com:9204", "boundary.example.com:9204", true, ""},
+		{"http", "http://127.0.0.1:9204", "127.0.0.1:9204", false, ""},
+		{"no scheme", "127.0.0.1:9204", "", false, "error parsing grpc address"},
+		{"no host", "https://", "", false, "has no host"},
+		{"unix socket", "unix:///tmp/boundary.sock", "", false, "has no host"},
+		{"other scheme", "tcp://127.0.0.1:9204", "", false, `unsupported scheme "tcp"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			client, err := NewClient(nil)
+			require.NoError(err)
+			err = client.SetGrpcAddr(tt.addr)
+			if tt.err != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.addr, client.GrpcAddr())
+			assert.Equal(tt.addr, client.Clone().GrpcAddr())
+
+			target, secure, err := parseGrpcAddr(client.GrpcAddr())
+			require.NoError(err)
+			assert.Equal(tt.target, target)
+			assert.Equal(tt.secure, secure)
+		})
+	}
+}
+
+func TestGrpcTokenCredentials(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	client, err := NewClient(nil)
+	require.NoError(err)
+	client.SetToken("")
+	creds := &grpcTokenCredentials{client: client}
+
+	md, err := creds.GetRequestMetadata(context.Background())
+	require.NoError(err)
+	assert.Empty(md)
+
+	// The token is read at the time of each request
+	client.SetToken("at_1234567890_token")
+	md, err = creds.GetRequestMetadata(context.Background())
+	require.NoError(err)
+	assert.Equal(map[string]string{"authorization": "Bearer at_1234567890_token"}, md)
+}
+
+func TestAsServerError_Grpc(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	assert.Nil(AsServerError(errors.New("not a server error")))
+
+	apiErr := AsServerError(status.Error(codes.NotFound, "Resource not found."))
+	require.NotNil(apiErr)
+	assert.True(errors.Is(apiErr, ErrNotFound))
+	assert.Equal("Resource not found.", apiErr.Message)
+	assert.Contains(apiErr.Error(), "Resource not found.")
+
+	st, err := status.New(codes.InvalidArgument, "Invalid request.").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "page_size", Description: "Must be at most 10000."},
+		},
+	})
+	require.NoError(err)
+	apiErr = AsServerError(st.Err())
+	require.NotNil(apiErr)
+	assert.True(errors.Is(apiErr, ErrInvalidArgument))
+	assert.EqualValues(http.StatusBadRequest, apiErr.Status)
+	require.NotNil(apiErr.Details)
+	assert.Equal([]*FieldError{{Name: "page_size", Description: "Must be at most 10000."}}, apiErr.Details.RequestFields)
+
+	st, err = status.New(codes.Internal, "").WithDetails(&errdetails.ErrorInfo{
+		Reason:   "Internal",
+		Domain:   "boundary",
+		Metadata: map[string]string{"error_id": "abcdef1234"},
+	})
+	require.NoError(err)
+	apiErr = AsServerError(st.Err())
+	require.NotNil(apiErr)
+	assert.EqualValues(http.StatusInternalServerError, apiErr.Status)
+	require.NotNil(apiErr.Details)
+	assert.Equal("abcdef1234", apiErr.Details.ErrorId)
+}
@@ -1,6 +1,9 @@
 package hostcatalogs
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/boundary/api"
 )
 
@@ -17,6 +20,8 @@ type options struct {
 	postMap                 map[string]interface{}
 	queryMap                map[string]string
 	withAutomaticVersioning bool
+	withFilter              string
+	withPageSize            uint32
 }
 
 func getDefaultOptions() options {
@@ -45,6 +50,25 @@ func WithAutomaticVersioning(enable bool) Option {
 	}
 }
 
+// WithFilter tells the API to filter the items returned using the provided
+// filter term. The filter should be in a format supported by
+// hashicorp/go-bexpr, with fields of items selected with JSON pointers
+// under "/item", e.g. "/item/name" == "foo".
+func WithFilter(filter string) Option {
+	return func(o *options) {
+		o.withFilter = strings.TrimSpace(filter)
+	}
+}
+
+// WithPageSize tells the API how many items to return in each page of a
+// list. The controller uses its default page size if this is unset. List and
+// ListIterator request the following pages as they are needed.
+func WithPageSize(size uint32) Option {
+	return func(o *options) {
+		o.withPageSize = size
+	}
+}
+
 func WithAttributes(inAttributes map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["attributes"] = inAttributes
@@ -80,3 +104,57 @@ func DefaultName() Option {
 		o.postMap["name"] = nil
 	}
 }
+
+func WithRecursive(inRecursive bool) Option {
+	return func(o *options) {
+		o.queryMap["recursive"] = fmt.Sprintf("%v", inRecursive)
+	}
+}
+
+func WithDynamicHostCatalogRefreshIntervalSeconds(inRefreshIntervalSeconds uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["refresh_interval_seconds"] = inRefreshIntervalSeconds
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultDynamicHostCatalogRefreshIntervalSeconds() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["refresh_interval_seconds"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithDynamicHostCatalogSource(inSource string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["source"] = inSource
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultDynamicHostCatalogSource() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["source"] = nil
+		o.postMap["attributes"] = val
+	}
+}
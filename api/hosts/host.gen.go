@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/boundary/api"
@@ -73,9 +74,10 @@ func (n HostDeleteResult) GetResponseMap() map[string]interface{} {
 }
 
 type HostListResult struct {
-	Items        []*Host
-	responseBody *bytes.Buffer
-	responseMap  map[string]interface{}
+	Items         []*Host
+	NextPageToken string `json:"next_page_token,omitempty"`
+	responseBody  *bytes.Buffer
+	responseMap   map[string]interface{}
 }
 
 func (n HostListResult) GetItems() interface{} {
@@ -302,7 +304,98 @@ func (c *Client) Delete(ctx context.Context, hostId string, opt ...Option) (*Hos
 	return target, nil
 }
 
+// List returns every item of the list, requesting its pages from the
+// controller one after the other. The response body and map of the result are
+// those of the last page. Use ListIterator to handle the items of large lists
+// as they arrive.
 func (c *Client) List(ctx context.Context, hostCatalogId string, opt ...Option) (*HostListResult, error) {
+	it, err := c.ListIterator(ctx, hostCatalogId, opt...)
+	if err != nil {
+		return nil, err
+	}
+	var items []*Host
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	target := *it.Page()
+	target.Items = items
+	return &target, nil
+}
+
+// HostListIterator iterates over the items of a list, requesting the
+// pages of the list from the controller as they are needed.
+type HostListIterator struct {
+	client        *Client
+	ctx           context.Context
+	hostCatalogId string
+	opts          []Option
+	page          *HostListResult
+	index         int
+	err           error
+}
+
+// ListIterator returns an iterator over the items of the list, having
+// requested its first page.
+func (c *Client) ListIterator(ctx context.Context, hostCatalogId string, opt ...Option) (*HostListIterator, error) {
+	page, err := c.listPage(ctx, hostCatalogId, "", opt...)
+	if err != nil {
+		return nil, err
+	}
+	return &HostListIterator{
+		client:        c,
+		ctx:           ctx,
+		hostCatalogId: hostCatalogId,
+		opts:          opt,
+		page:          page,
+		index:         -1,
+	}, nil
+}
+
+// Next advances the iterator to the next item, requesting the next page of
+// the list once the items of the current one are used up. It returns false
+// when there are no more items or requesting a page failed, in which case Err
+// returns the error.
+func (it *HostListIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	it.index++
+	for it.index >= len(it.page.Items) {
+		if it.page.NextPageToken == "" {
+			return false
+		}
+		page, err := it.client.listPage(it.ctx, it.hostCatalogId, it.page.NextPageToken, it.opts...)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.index = page, 0
+	}
+	return true
+}
+
+// Item returns the current item. It must only be called after Next returned
+// true.
+func (it *HostListIterator) Item() *Host {
+	return it.page.Items[it.index]
+}
+
+// Page returns the page of the list holding the current item.
+func (it *HostListIterator) Page() *HostListResult {
+	return it.page
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *HostListIterator) Err() error {
+	return it.err
+}
+
+// listPage requests the page of the list following pageToken, or the first
+// page if pageToken is empty
+func (c *Client) listPage(ctx context.Context, hostCatalogId string, pageToken string, opt ...Option) (*HostListResult, error) {
 	if hostCatalogId == "" {
 		return nil, fmt.Errorf("empty hostCatalogId value passed into List request")
 	}
@@ -312,6 +405,15 @@ func (c *Client) List(ctx context.Context, hostCatalogId string, opt ...Option)
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["host_catalog_id"] = hostCatalogId
+	if opts.withFilter != "" {
+		opts.queryMap["filter"] = opts.withFilter
+	}
+	if opts.withPageSize != 0 {
+		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
+	}
+	if pageToken != "" {
+		opts.queryMap["page_token"] = pageToken
+	}
 
 	req, err := c.client.NewRequest(ctx, "GET", "hosts", nil, apiOpts...)
 	if err != nil {
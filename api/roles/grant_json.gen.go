@@ -2,7 +2,11 @@
 package roles
 
 type GrantJson struct {
-	Id      string   `json:"id,omitempty"`
-	Type    string   `json:"type,omitempty"`
-	Actions []string `json:"actions,omitempty"`
+	Id            string   `json:"id,omitempty"`
+	Type          string   `json:"type,omitempty"`
+	Actions       []string `json:"actions,omitempty"`
+	Deny          bool     `json:"deny,omitempty"`
+	Cidrs         []string `json:"cidrs,omitempty"`
+	AuthMethodIds []string `json:"auth_method_ids,omitempty"`
+	Time          string   `json:"time,omitempty"`
 }
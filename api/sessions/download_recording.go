@@ -0,0 +1,137 @@
+package sessions
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+type SessionRecordingListResult struct {
+	Items        []*SessionRecording
+	responseBody *bytes.Buffer
+	responseMap  map[string]interface{}
+}
+
+func (n SessionRecordingListResult) GetItems() interface{} {
+	return n.Items
+}
+
+func (n SessionRecordingListResult) GetResponseBody() *bytes.Buffer {
+	return n.responseBody
+}
+
+func (n SessionRecordingListResult) GetResponseMap() map[string]interface{} {
+	return n.responseMap
+}
+
+// RecordingErrorTrailer is the trailer of recording downloads holding why
+// the recording could not be read completely, if it could not
+const RecordingErrorTrailer = "Boundary-Recording-Error"
+
+// ErrIncompleteRecording is wrapped by the error returned by
+// DownloadRecording when the recording could not be read completely
+var ErrIncompleteRecording = errors.New("recording is incomplete")
+
+// ListRecordings returns the recordings of the connections of a session,
+// without their data. Use DownloadRecording to fetch each of them.
+func (c *Client) ListRecordings(ctx context.Context, sessionId string, opt ...Option) (*SessionRecordingListResult, error) {
+	if sessionId == "" {
+		return nil, fmt.Errorf("empty sessionId value passed into ListRecordings request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("sessions/%s:list-recordings", sessionId), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListRecordings request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListRecordings call: %w", err)
+	}
+
+	target := new(SessionRecordingListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListRecordings response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.responseBody = resp.Body
+	target.responseMap = resp.Map
+	return target, nil
+}
+
+// DownloadRecording writes the decrypted recording of a connection of a
+// session to w as it is downloaded. The recording can be replayed with
+// "boundary recording play". If the recording could not be read completely,
+// what could be read of it is written to w and the returned error wraps
+// ErrIncompleteRecording.
+func (c *Client) DownloadRecording(ctx context.Context, sessionId, connectionId string, w io.Writer, opt ...Option) error {
+	if sessionId == "" {
+		return fmt.Errorf("empty sessionId value passed into DownloadRecording request")
+	}
+	if connectionId == "" {
+		return fmt.Errorf("empty connectionId value passed into DownloadRecording request")
+	}
+	if c.client == nil {
+		return errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("sessions/%s:download-recording", sessionId), nil, apiOpts...)
+	if err != nil {
+		return fmt.Errorf("error creating DownloadRecording request: %w", err)
+	}
+
+	q := url.Values{}
+	for k, v := range opts.queryMap {
+		q.Add(k, v)
+	}
+	q.Set("connection_id", connectionId)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error performing client request during DownloadRecording call: %w", err)
+	}
+	httpResp := resp.HttpResponse()
+	if httpResp.StatusCode != http.StatusOK {
+		apiErr, err := resp.Decode(nil)
+		if err != nil {
+			return fmt.Errorf("error decoding DownloadRecording response: %w", err)
+		}
+		if apiErr != nil {
+			return apiErr
+		}
+		return fmt.Errorf("unexpected status %d in DownloadRecording response", httpResp.StatusCode)
+	}
+	defer httpResp.Body.Close()
+
+	if _, err := io.Copy(w, httpResp.Body); err != nil {
+		return fmt.Errorf("error reading DownloadRecording response: %w", err)
+	}
+	// The trailer is only known once the body has been read
+	if reason := httpResp.Trailer.Get(RecordingErrorTrailer); reason != "" {
+		return fmt.Errorf("%w: %s", ErrIncompleteRecording, reason)
+	}
+	return nil
+}
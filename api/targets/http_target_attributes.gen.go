@@ -0,0 +1,12 @@
+// Code generated by "make api"; DO NOT EDIT.
+package targets
+
+type HttpTargetAttributes struct {
+	DefaultPort                  uint32   `json:"default_port,omitempty"`
+	SessionMaxBytesPerSecond     uint32   `json:"session_max_bytes_per_second,omitempty"`
+	ConnectionIdleTimeoutSeconds uint32   `json:"connection_idle_timeout_seconds,omitempty"`
+	UseTls                       bool     `json:"use_tls,omitempty"`
+	AllowedMethods               []string `json:"allowed_methods,omitempty"`
+	AllowedPathPrefixes          []string `json:"allowed_path_prefixes,omitempty"`
+	InjectedHeaders              []string `json:"injected_headers,omitempty"`
+}
@@ -1,6 +1,9 @@
 package targets
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/boundary/api"
 )
 
@@ -17,6 +20,8 @@ type options struct {
 	postMap                 map[string]interface{}
 	queryMap                map[string]string
 	withAutomaticVersioning bool
+	withFilter              string
+	withPageSize            uint32
 }
 
 func getDefaultOptions() options {
@@ -45,6 +50,73 @@ func WithAutomaticVersioning(enable bool) Option {
 	}
 }
 
+// WithFilter tells the API to filter the items returned using the provided
+// filter term. The filter should be in a format supported by
+// hashicorp/go-bexpr, with fields of items selected with JSON pointers
+// under "/item", e.g. "/item/name" == "foo".
+func WithFilter(filter string) Option {
+	return func(o *options) {
+		o.withFilter = strings.TrimSpace(filter)
+	}
+}
+
+// WithPageSize tells the API how many items to return in each page of a
+// list. The controller uses its default page size if this is unset. List and
+// ListIterator request the following pages as they are needed.
+func WithPageSize(size uint32) Option {
+	return func(o *options) {
+		o.withPageSize = size
+	}
+}
+
+func WithHttpTargetAllowedMethods(inAllowedMethods []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["allowed_methods"] = inAllowedMethods
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetAllowedMethods() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["allowed_methods"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithHttpTargetAllowedPathPrefixes(inAllowedPathPrefixes []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["allowed_path_prefixes"] = inAllowedPathPrefixes
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetAllowedPathPrefixes() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["allowed_path_prefixes"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithAttributes(inAttributes map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["attributes"] = inAttributes
@@ -57,6 +129,126 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithHttpTargetConnectionIdleTimeoutSeconds(inConnectionIdleTimeoutSeconds uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["connection_idle_timeout_seconds"] = inConnectionIdleTimeoutSeconds
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetConnectionIdleTimeoutSeconds() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["connection_idle_timeout_seconds"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithTcpTargetConnectionIdleTimeoutSeconds(inConnectionIdleTimeoutSeconds uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["connection_idle_timeout_seconds"] = inConnectionIdleTimeoutSeconds
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTcpTargetConnectionIdleTimeoutSeconds() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["connection_idle_timeout_seconds"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithUdpTargetConnectionIdleTimeoutSeconds(inConnectionIdleTimeoutSeconds uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["connection_idle_timeout_seconds"] = inConnectionIdleTimeoutSeconds
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultUdpTargetConnectionIdleTimeoutSeconds() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["connection_idle_timeout_seconds"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithTcpTargetCredentialInjection(inCredentialInjection string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["credential_injection"] = inCredentialInjection
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTcpTargetCredentialInjection() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["credential_injection"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithHttpTargetDefaultPort(inDefaultPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = inDefaultPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetDefaultPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithTcpTargetDefaultPort(inDefaultPort uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -81,6 +273,30 @@ func DefaultTcpTargetDefaultPort() Option {
 	}
 }
 
+func WithUdpTargetDefaultPort(inDefaultPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = inDefaultPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultUdpTargetDefaultPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithDescription(inDescription string) Option {
 	return func(o *options) {
 		o.postMap["description"] = inDescription
@@ -99,6 +315,30 @@ func WithHostId(inHostId string) Option {
 	}
 }
 
+func WithHttpTargetInjectedHeaders(inInjectedHeaders []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["injected_headers"] = inInjectedHeaders
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetInjectedHeaders() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["injected_headers"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -111,6 +351,36 @@ func DefaultName() Option {
 	}
 }
 
+func WithTcpTargetRecordSessions(inRecordSessions bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["record_sessions"] = inRecordSessions
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTcpTargetRecordSessions() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["record_sessions"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithRecursive(inRecursive bool) Option {
+	return func(o *options) {
+		o.queryMap["recursive"] = fmt.Sprintf("%v", inRecursive)
+	}
+}
+
 func WithSessionConnectionLimit(inSessionConnectionLimit int32) Option {
 	return func(o *options) {
 		o.postMap["session_connection_limit"] = inSessionConnectionLimit
@@ -123,6 +393,78 @@ func DefaultSessionConnectionLimit() Option {
 	}
 }
 
+func WithHttpTargetSessionMaxBytesPerSecond(inSessionMaxBytesPerSecond uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["session_max_bytes_per_second"] = inSessionMaxBytesPerSecond
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetSessionMaxBytesPerSecond() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["session_max_bytes_per_second"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithTcpTargetSessionMaxBytesPerSecond(inSessionMaxBytesPerSecond uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["session_max_bytes_per_second"] = inSessionMaxBytesPerSecond
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTcpTargetSessionMaxBytesPerSecond() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["session_max_bytes_per_second"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithUdpTargetSessionMaxBytesPerSecond(inSessionMaxBytesPerSecond uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["session_max_bytes_per_second"] = inSessionMaxBytesPerSecond
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultUdpTargetSessionMaxBytesPerSecond() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["session_max_bytes_per_second"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSessionMaxSeconds(inSessionMaxSeconds uint32) Option {
 	return func(o *options) {
 		o.postMap["session_max_seconds"] = inSessionMaxSeconds
@@ -134,3 +476,45 @@ func DefaultSessionMaxSeconds() Option {
 		o.postMap["session_max_seconds"] = nil
 	}
 }
+
+func WithHttpTargetUseTls(inUseTls bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["use_tls"] = inUseTls
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetUseTls() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["use_tls"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithWorkerFilter(inWorkerFilter string) Option {
+	return func(o *options) {
+		o.postMap["worker_filter"] = inWorkerFilter
+	}
+}
+
+func DefaultWorkerFilter() Option {
+	return func(o *options) {
+		o.postMap["worker_filter"] = nil
+	}
+}
+
+func WithWorkerRegion(inWorkerRegion string) Option {
+	return func(o *options) {
+		o.postMap["worker_region"] = inWorkerRegion
+	}
+}
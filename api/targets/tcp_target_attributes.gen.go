@@ -2,5 +2,9 @@
 package targets
 
 type TcpTargetAttributes struct {
-	DefaultPort uint32 `json:"default_port,omitempty"`
+	DefaultPort                  uint32 `json:"default_port,omitempty"`
+	RecordSessions               bool   `json:"record_sessions,omitempty"`
+	CredentialInjection          string `json:"credential_injection,omitempty"`
+	SessionMaxBytesPerSecond     uint32 `json:"session_max_bytes_per_second,omitempty"`
+	ConnectionIdleTimeoutSeconds uint32 `json:"connection_idle_timeout_seconds,omitempty"`
 }
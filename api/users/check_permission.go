@@ -0,0 +1,81 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// PermissionCheckRequest describes the action and resource to check the
+// permissions of a user for. The resource is given by ResourceId or, for
+// actions on a collection such as list and create, by ScopeId and Type. Type
+// can also be set along with ResourceId to check an action on a collection
+// under that resource, such as listing the hosts of a host catalog.
+type PermissionCheckRequest struct {
+	ResourceId string `json:"resource_id,omitempty"`
+	ScopeId    string `json:"scope_id,omitempty"`
+	Type       string `json:"type,omitempty"`
+	Action     string `json:"action,omitempty"`
+
+	// ClientIp and AuthMethodId are checked against the conditions of grants.
+	// The account of the user in the auth method also fills in {{account.id}}
+	// templates.
+	ClientIp     string `json:"client_ip,omitempty"`
+	AuthMethodId string `json:"auth_method_id,omitempty"`
+}
+
+type PermissionCheckResult struct {
+	Item         *PermissionCheck
+	responseBody *bytes.Buffer
+	responseMap  map[string]interface{}
+}
+
+func (n PermissionCheckResult) GetItem() interface{} {
+	return n.Item
+}
+
+func (n PermissionCheckResult) GetResponseBody() *bytes.Buffer {
+	return n.responseBody
+}
+
+func (n PermissionCheckResult) GetResponseMap() map[string]interface{} {
+	return n.responseMap
+}
+
+// CheckPermission evaluates the grants of a user for an action on a resource
+// and returns whether the action is allowed and which grant allowed or denied
+// it.
+func (c *Client) CheckPermission(ctx context.Context, userId string, check PermissionCheckRequest, opt ...Option) (*PermissionCheckResult, error) {
+	if userId == "" {
+		return nil, fmt.Errorf("empty userId value passed into CheckPermission request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("users/%s:check-permission", userId), check, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CheckPermission request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during CheckPermission call: %w", err)
+	}
+
+	target := new(PermissionCheckResult)
+	target.Item = new(PermissionCheck)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding CheckPermission response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.responseBody = resp.Body
+	target.responseMap = resp.Map
+	return target, nil
+}
@@ -0,0 +1,73 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+type EffectiveGrantsResult struct {
+	Item         *EffectiveGrants
+	responseBody *bytes.Buffer
+	responseMap  map[string]interface{}
+}
+
+func (n EffectiveGrantsResult) GetItem() interface{} {
+	return n.Item
+}
+
+func (n EffectiveGrantsResult) GetResponseBody() *bytes.Buffer {
+	return n.responseBody
+}
+
+func (n EffectiveGrantsResult) GetResponseMap() map[string]interface{} {
+	return n.responseMap
+}
+
+// EffectiveGrants returns every grant held by a user, including those held
+// through groups and through the u_anon and u_auth users, grouped by the scope
+// they apply to. WithAuthMethodId fills in {{account.id}} templates with the
+// user's account in that auth method.
+func (c *Client) EffectiveGrants(ctx context.Context, userId string, opt ...Option) (*EffectiveGrantsResult, error) {
+	if userId == "" {
+		return nil, fmt.Errorf("empty userId value passed into EffectiveGrants request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("users/%s:effective-grants", userId), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating EffectiveGrants request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during EffectiveGrants call: %w", err)
+	}
+
+	target := new(EffectiveGrantsResult)
+	target.Item = new(EffectiveGrants)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding EffectiveGrants response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.responseBody = resp.Body
+	target.responseMap = resp.Map
+	return target, nil
+}
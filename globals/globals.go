@@ -12,6 +12,14 @@ import "time"
 const (
 	TcpProxyV1     = "boundary-tcp-proxy-v1"
 	ServiceTokenV1 = "s1"
+
+	// UdpProxyV1 is the websocket subprotocol used to proxy a flow of
+	// datagrams, each binary message carrying exactly one datagram
+	UdpProxyV1 = "boundary-udp-proxy-v1"
+
+	// WorkerTunnelV1 is the ALPN proto of the tunnel a downstream worker
+	// opens to its upstream worker
+	WorkerTunnelV1 = "boundary-worker-tunnel-v1"
 )
 
 type ContextMaxRequestSizeType int
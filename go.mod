@@ -9,9 +9,13 @@ replace github.com/hashicorp/boundary/sdk => ./sdk
 require (
 	github.com/armon/go-metrics v0.3.4
 	github.com/bufbuild/buf v0.24.0
+	github.com/coreos/go-oidc v2.2.1+incompatible
 	github.com/fatih/color v1.9.0
 	github.com/favadi/protoc-go-inject-tag v1.1.0
+	github.com/ghodss/yaml v1.0.0
+	github.com/go-asn1-ber/asn1-ber v1.5.1
 	github.com/go-bindata/go-bindata/v3 v3.1.3
+	github.com/go-ldap/ldap/v3 v3.3.0
 	github.com/go-swagger/go-swagger v0.25.0
 	github.com/golang-migrate/migrate/v4 v4.13.0
 	github.com/golang-sql/civil v0.0.0-20190719163853-cb61b32ac6fe
@@ -22,15 +26,18 @@ require (
 	github.com/hashicorp/boundary/sdk v0.0.1
 	github.com/hashicorp/dbassert v0.0.0-20200930125617-6218396928df
 	github.com/hashicorp/errwrap v1.1.0
+	github.com/hashicorp/go-bexpr v0.1.10
 	github.com/hashicorp/go-cleanhttp v0.5.1
 	github.com/hashicorp/go-hclog v0.14.1
 	github.com/hashicorp/go-kms-wrapping v0.5.16
 	github.com/hashicorp/go-multierror v1.1.0
 	github.com/hashicorp/go-retryablehttp v0.6.7
+	github.com/hashicorp/go-sockaddr v1.0.2
 	github.com/hashicorp/go-uuid v1.0.2
 	github.com/hashicorp/hcl v1.0.0
 	github.com/hashicorp/shared-secure-libs v0.0.2
 	github.com/hashicorp/vault/sdk v0.1.14-0.20200916184745-5576096032f8
+	github.com/hashicorp/yamux v0.0.0-20181012175058-2f1d1f20f75d
 	github.com/iancoleman/strcase v0.1.2
 	github.com/jackc/pgx/v4 v4.9.0
 	github.com/jinzhu/gorm v1.9.16
@@ -46,16 +53,21 @@ require (
 	github.com/ory/dockertest/v3 v3.6.0
 	github.com/patrickmn/go-cache v2.1.0+incompatible
 	github.com/pires/go-proxyproto v0.2.0
+	github.com/pkg/browser v0.0.0-20180916011732-0a3d74bf9ce4
 	github.com/pkg/errors v0.9.1
 	github.com/posener/complete v1.2.3
-	github.com/stretchr/testify v1.6.1
+	github.com/prometheus/client_golang v1.7.1
+	github.com/stretchr/testify v1.7.0
 	github.com/zalando/go-keyring v0.1.0
 	go.uber.org/atomic v1.7.0
 	golang.org/x/crypto v0.0.0-20201002170205-7f63de1d35b0
+	golang.org/x/oauth2 v0.0.0-20200902213428-5d25da1a8d43
+	golang.org/x/time v0.0.0-20200630173020-3af7569d3a1e
 	golang.org/x/tools v0.0.0-20201009032223-96877f285f7e
 	google.golang.org/genproto v0.0.0-20201009135657-4d944d34d83c
 	google.golang.org/grpc v1.32.0
 	google.golang.org/grpc/cmd/protoc-gen-go-grpc v0.0.0-20200527211525-6c9e30c09db2
 	google.golang.org/protobuf v1.25.0
+	gopkg.in/square/go-jose.v2 v2.5.1
 	nhooyr.io/websocket v1.8.6
 )
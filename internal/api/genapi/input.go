@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/accounts"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/authmethods"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/authtokens"
+	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/credentials"
+	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/credentialstores"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/groups"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/hostcatalogs"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/hosts"
@@ -91,6 +93,27 @@ type structInfo struct {
 	fieldFilter []string
 }
 
+// recursiveListOption is the option of the lists that can include the items of
+// the scopes below the scope they are requested in
+var recursiveListOption = fieldInfo{
+	Name:        "Recursive",
+	ProtoName:   "recursive",
+	FieldType:   "bool",
+	Query:       true,
+	SkipDefault: true,
+}
+
+// effectiveGrantsAuthMethodOption is the option of the effective grants of a
+// user which fills in account templates with the user's account in an auth
+// method
+var effectiveGrantsAuthMethodOption = fieldInfo{
+	Name:        "AuthMethodId",
+	ProtoName:   "auth_method_id",
+	FieldType:   "string",
+	Query:       true,
+	SkipDefault: true,
+}
+
 var inputStructs = []*structInfo{
 	{
 		inProto:    &api.Error{},
@@ -126,6 +149,7 @@ var inputStructs = []*structInfo{
 		},
 		pathArgs: []string{"scope"},
 		extraOptions: []fieldInfo{
+			recursiveListOption,
 			{
 				Name:      "SkipAdminRoleCreation",
 				ProtoName: "skip_admin_role_creation",
@@ -162,10 +186,39 @@ var inputStructs = []*structInfo{
 		sliceSubTypes: map[string]string{
 			"Accounts": "accountIds",
 		},
-		pathArgs:            []string{"user"},
+		pathArgs: []string{"user"},
+		extraOptions: []fieldInfo{
+			recursiveListOption,
+			effectiveGrantsAuthMethodOption,
+		},
 		versionEnabled:      true,
 		createResponseTypes: true,
 	},
+	{
+		inProto:    &users.PermissionGrant{},
+		outFile:    "users/permission_grant.gen.go",
+		outputOnly: true,
+	},
+	{
+		inProto:    &users.PermissionCheck{},
+		outFile:    "users/permission_check.gen.go",
+		outputOnly: true,
+	},
+	{
+		inProto:    &users.EffectiveGrant{},
+		outFile:    "users/effective_grant.gen.go",
+		outputOnly: true,
+	},
+	{
+		inProto:    &users.EffectiveGrantScope{},
+		outFile:    "users/effective_grant_scope.gen.go",
+		outputOnly: true,
+	},
+	{
+		inProto:    &users.EffectiveGrants{},
+		outFile:    "users/effective_grants.gen.go",
+		outputOnly: true,
+	},
 	// Group related resources
 	{
 		inProto:    &groups.Member{},
@@ -186,7 +239,10 @@ var inputStructs = []*structInfo{
 		sliceSubTypes: map[string]string{
 			"Members": "memberIds",
 		},
-		pathArgs:            []string{"group"},
+		pathArgs: []string{"group"},
+		extraOptions: []fieldInfo{
+			recursiveListOption,
+		},
 		versionEnabled:      true,
 		createResponseTypes: true,
 	},
@@ -221,7 +277,10 @@ var inputStructs = []*structInfo{
 			"Principals": "principalIds",
 			"Grants":     "grantStrings",
 		},
-		pathArgs:            []string{"role"},
+		pathArgs: []string{"role"},
+		extraOptions: []fieldInfo{
+			recursiveListOption,
+		},
 		versionEnabled:      true,
 		createResponseTypes: true,
 	},
@@ -237,7 +296,10 @@ var inputStructs = []*structInfo{
 			deleteTemplate,
 			listTemplate,
 		},
-		pathArgs:            []string{"auth-method"},
+		pathArgs: []string{"auth-method"},
+		extraOptions: []fieldInfo{
+			recursiveListOption,
+		},
 		typeOnCreate:        true,
 		versionEnabled:      true,
 		createResponseTypes: true,
@@ -247,6 +309,20 @@ var inputStructs = []*structInfo{
 		outFile:     "authmethods/password_auth_method_attributes.gen.go",
 		subtypeName: "PasswordAuthMethod",
 	},
+	{
+		inProto:     &authmethods.OidcAuthMethodAttributes{},
+		outFile:     "authmethods/oidc_auth_method_attributes.gen.go",
+		subtypeName: "OidcAuthMethod",
+	},
+	{
+		inProto:     &authmethods.LdapAuthMethodAttributes{},
+		outFile:     "authmethods/ldap_auth_method_attributes.gen.go",
+		subtypeName: "LdapAuthMethod",
+	},
+	{
+		inProto: &authmethods.LdapGroupMapping{},
+		outFile: "authmethods/ldap_group_mapping.gen.go",
+	},
 	// Accounts
 	{
 		inProto: &accounts.Account{},
@@ -269,6 +345,16 @@ var inputStructs = []*structInfo{
 		outFile:     "accounts/password_account_attributes.gen.go",
 		subtypeName: "PasswordAccount",
 	},
+	{
+		inProto:     &accounts.OidcAccountAttributes{},
+		outFile:     "accounts/oidc_account_attributes.gen.go",
+		subtypeName: "OidcAccount",
+	},
+	{
+		inProto:     &accounts.LdapAccountAttributes{},
+		outFile:     "accounts/ldap_account_attributes.gen.go",
+		subtypeName: "LdapAccount",
+	},
 	// Auth Tokens
 	{
 		inProto: &authtokens.AuthToken{},
@@ -279,7 +365,10 @@ var inputStructs = []*structInfo{
 			deleteTemplate,
 			listTemplate,
 		},
-		pathArgs:            []string{"auth-token"},
+		pathArgs: []string{"auth-token"},
+		extraOptions: []fieldInfo{
+			recursiveListOption,
+		},
 		createResponseTypes: true,
 	},
 	// Host related resources
@@ -294,11 +383,19 @@ var inputStructs = []*structInfo{
 			deleteTemplate,
 			listTemplate,
 		},
-		pathArgs:            []string{"host-catalog"},
+		pathArgs: []string{"host-catalog"},
+		extraOptions: []fieldInfo{
+			recursiveListOption,
+		},
 		typeOnCreate:        true,
 		versionEnabled:      true,
 		createResponseTypes: true,
 	},
+	{
+		inProto:     &hostcatalogs.DynamicHostCatalogAttributes{},
+		outFile:     "hostcatalogs/dynamic_host_catalog_attributes.gen.go",
+		subtypeName: "DynamicHostCatalog",
+	},
 	{
 		inProto: &hosts.Host{},
 		outFile: "hosts/host.gen.go",
@@ -320,6 +417,10 @@ var inputStructs = []*structInfo{
 		outFile:     "hosts/static_host_attributes.gen.go",
 		subtypeName: "StaticHost",
 	},
+	{
+		inProto: &hosts.DynamicHostAttributes{},
+		outFile: "hosts/dynamic_host_attributes.gen.go",
+	},
 	{
 		inProto: &hostsets.HostSet{},
 		outFile: "hostsets/host_set.gen.go",
@@ -339,6 +440,46 @@ var inputStructs = []*structInfo{
 		versionEnabled:      true,
 		createResponseTypes: true,
 	},
+	{
+		inProto:     &hostsets.DynamicHostSetAttributes{},
+		outFile:     "hostsets/dynamic_host_set_attributes.gen.go",
+		subtypeName: "DynamicHostSet",
+	},
+	// Credential related resources
+	{
+		inProto: &credentialstores.CredentialStore{},
+		outFile: "credentialstores/credential_store.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+			createTemplate,
+			readTemplate,
+			updateTemplate,
+			deleteTemplate,
+			listTemplate,
+		},
+		pathArgs: []string{"credential-store"},
+		extraOptions: []fieldInfo{
+			recursiveListOption,
+		},
+		versionEnabled:      true,
+		createResponseTypes: true,
+	},
+	{
+		inProto: &credentials.Credential{},
+		outFile: "credentials/credential.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+			createTemplate,
+			readTemplate,
+			updateTemplate,
+			deleteTemplate,
+			listTemplate,
+		},
+		pathArgs:            []string{"credential"},
+		parentTypeName:      "credential-store",
+		versionEnabled:      true,
+		createResponseTypes: true,
+	},
 	{
 		inProto: &targets.HostSet{},
 		outFile: "targets/host_set.gen.go",
@@ -356,15 +497,23 @@ var inputStructs = []*structInfo{
 		},
 		pathArgs: []string{"target"},
 		sliceSubTypes: map[string]string{
-			"HostSets": "hostSetIds",
+			"HostSets":    "hostSetIds",
+			"Credentials": "credentialIds",
 		},
 		extraOptions: []fieldInfo{
+			recursiveListOption,
 			{
 				Name:        "HostId",
 				ProtoName:   "host_id",
 				FieldType:   "string",
 				SkipDefault: true,
 			},
+			{
+				Name:        "WorkerRegion",
+				ProtoName:   "worker_region",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
 		},
 		versionEnabled:      true,
 		typeOnCreate:        true,
@@ -375,6 +524,16 @@ var inputStructs = []*structInfo{
 		outFile:     "targets/tcp_target_attributes.gen.go",
 		subtypeName: "TcpTarget",
 	},
+	{
+		inProto:     &targets.UdpTargetAttributes{},
+		outFile:     "targets/udp_target_attributes.gen.go",
+		subtypeName: "UdpTarget",
+	},
+	{
+		inProto:     &targets.HttpTargetAttributes{},
+		outFile:     "targets/http_target_attributes.gen.go",
+		subtypeName: "HttpTarget",
+	},
 	{
 		inProto: &sessions.Session{},
 		outFile: "sessions/session.gen.go",
@@ -383,7 +542,10 @@ var inputStructs = []*structInfo{
 			readTemplate,
 			listTemplate,
 		},
-		pathArgs:            []string{"session"},
+		pathArgs: []string{"session"},
+		extraOptions: []fieldInfo{
+			recursiveListOption,
+		},
 		createResponseTypes: true,
 		fieldFilter:         []string{"private_key"},
 	},
@@ -395,6 +557,10 @@ var inputStructs = []*structInfo{
 		inProto: &sessions.WorkerInfo{},
 		outFile: "sessions/workers.gen.go",
 	},
+	{
+		inProto: &sessions.SessionRecording{},
+		outFile: "sessions/recording.gen.go",
+	},
 	{
 		inProto:     &targets.SessionAuthorization{},
 		outFile:     "targets/session_authorization.gen.go",
@@ -405,4 +571,8 @@ var inputStructs = []*structInfo{
 		outFile:     "targets/worker_info.gen.go",
 		subtypeName: "WorkerInfo",
 	},
+	{
+		inProto: &targets.SessionCredential{},
+		outFile: "targets/session_credential.gen.go",
+	},
 }
@@ -96,7 +96,9 @@ func fillTemplates() {
 			for _, val := range input.Fields {
 				if val.GenerateSdkOption {
 					val.SubtypeName = in.subtypeName
-					pkgOptionMap[val.Name] = val
+					// Key on the subtype too so that subtypes sharing an
+					// attribute name (e.g. LoginName) each get an option.
+					pkgOptionMap[val.SubtypeName+val.Name] = val
 				}
 			}
 			optionMap := optionsMap[input.Package]
@@ -139,16 +141,16 @@ func fillTemplates() {
 	for pkg, options := range optionsMap {
 		outBuf := new(bytes.Buffer)
 
-		var fieldNames []string
-		for _, v := range options {
-			fieldNames = append(fieldNames, v.Name)
-		}
-		sort.Strings(fieldNames)
-
 		var fields []fieldInfo
-		for _, v := range fieldNames {
-			fields = append(fields, options[v])
+		for _, v := range options {
+			fields = append(fields, v)
 		}
+		sort.Slice(fields, func(i, j int) bool {
+			if fields[i].Name != fields[j].Name {
+				return fields[i].Name < fields[j].Name
+			}
+			return fields[i].SubtypeName < fields[j].SubtypeName
+		})
 
 		input := templateInput{
 			Package: pkg,
@@ -181,7 +183,98 @@ var listTemplate = template.Must(template.New("").Funcs(
 		"snakeCase": snakeCase,
 	},
 ).Parse(`
+// List returns every item of the list, requesting its pages from the
+// controller one after the other. The response body and map of the result are
+// those of the last page. Use ListIterator to handle the items of large lists
+// as they arrive.
 func (c *Client) List(ctx context.Context, {{ .CollectionFunctionArg }} string, opt... Option) (*{{ .Name }}ListResult, error) {
+	it, err := c.ListIterator(ctx, {{ .CollectionFunctionArg }}, opt...)
+	if err != nil {
+		return nil, err
+	}
+	var items []*{{ .Name }}
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	target := *it.Page()
+	target.Items = items
+	return &target, nil
+}
+
+// {{ .Name }}ListIterator iterates over the items of a list, requesting the
+// pages of the list from the controller as they are needed.
+type {{ .Name }}ListIterator struct {
+	client *Client
+	ctx context.Context
+	{{ .CollectionFunctionArg }} string
+	opts []Option
+	page *{{ .Name }}ListResult
+	index int
+	err error
+}
+
+// ListIterator returns an iterator over the items of the list, having
+// requested its first page.
+func (c *Client) ListIterator(ctx context.Context, {{ .CollectionFunctionArg }} string, opt... Option) (*{{ .Name }}ListIterator, error) {
+	page, err := c.listPage(ctx, {{ .CollectionFunctionArg }}, "", opt...)
+	if err != nil {
+		return nil, err
+	}
+	return &{{ .Name }}ListIterator{
+		client: c,
+		ctx: ctx,
+		{{ .CollectionFunctionArg }}: {{ .CollectionFunctionArg }},
+		opts: opt,
+		page: page,
+		index: -1,
+	}, nil
+}
+
+// Next advances the iterator to the next item, requesting the next page of
+// the list once the items of the current one are used up. It returns false
+// when there are no more items or requesting a page failed, in which case Err
+// returns the error.
+func (it *{{ .Name }}ListIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	it.index++
+	for it.index >= len(it.page.Items) {
+		if it.page.NextPageToken == "" {
+			return false
+		}
+		page, err := it.client.listPage(it.ctx, it.{{ .CollectionFunctionArg }}, it.page.NextPageToken, it.opts...)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.index = page, 0
+	}
+	return true
+}
+
+// Item returns the current item. It must only be called after Next returned
+// true.
+func (it *{{ .Name }}ListIterator) Item() *{{ .Name }} {
+	return it.page.Items[it.index]
+}
+
+// Page returns the page of the list holding the current item.
+func (it *{{ .Name }}ListIterator) Page() *{{ .Name }}ListResult {
+	return it.page
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *{{ .Name }}ListIterator) Err() error {
+	return it.err
+}
+
+// listPage requests the page of the list following pageToken, or the first
+// page if pageToken is empty
+func (c *Client) listPage(ctx context.Context, {{ .CollectionFunctionArg }} string, pageToken string, opt... Option) (*{{ .Name }}ListResult, error) {
 	if {{ .CollectionFunctionArg }} == "" {
 		return nil, fmt.Errorf("empty {{ .CollectionFunctionArg }} value passed into List request")
 	}
@@ -191,6 +284,15 @@ func (c *Client) List(ctx context.Context, {{ .CollectionFunctionArg }} string,
 
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["{{ snakeCase .CollectionFunctionArg }}"] = {{ .CollectionFunctionArg }}
+	if opts.withFilter != "" {
+		opts.queryMap["filter"] = opts.withFilter
+	}
+	if opts.withPageSize != 0 {
+		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
+	}
+	if pageToken != "" {
+		opts.queryMap["page_token"] = pageToken
+	}
 
 	req, err := c.client.NewRequest(ctx, "GET", "{{ .CollectionPath }}", nil, apiOpts...)
 	if err != nil {
@@ -597,6 +699,7 @@ func (n {{ .Name }}DeleteResult) GetResponseMap() map[string]interface{} {
 
 type {{ .Name }}ListResult struct {
 	Items []*{{ .Name }}
+	NextPageToken string `, "`json:\"next_page_token,omitempty\"`", `
 	responseBody *bytes.Buffer
 	responseMap map[string]interface{}
 }
@@ -641,6 +744,7 @@ package {{ .Package }}
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/boundary/api"
@@ -659,6 +763,8 @@ type options struct {
 	postMap map[string]interface{}
 	queryMap map[string]string
 	withAutomaticVersioning bool
+	withFilter string
+	withPageSize uint32
 }
 
 func getDefaultOptions() options {
@@ -686,6 +792,25 @@ func WithAutomaticVersioning(enable bool) Option {
 		o.withAutomaticVersioning = enable
 	}
 }
+
+// WithFilter tells the API to filter the items returned using the provided
+// filter term. The filter should be in a format supported by
+// hashicorp/go-bexpr, with fields of items selected with JSON pointers
+// under "/item", e.g. "/item/name" == "foo".
+func WithFilter(filter string) Option {
+	return func(o *options) {
+		o.withFilter = strings.TrimSpace(filter)
+	}
+}
+
+// WithPageSize tells the API how many items to return in each page of a
+// list. The controller uses its default page size if this is unset. List and
+// ListIterator request the following pages as they are needed.
+func WithPageSize(size uint32) Option {
+	return func(o *options) {
+		o.withPageSize = size
+	}
+}
 {{ range .Fields }}
 func With{{ .SubtypeName }}{{ .Name }}(in{{ .Name }} {{ .FieldType }}) Option {
 	return func(o *options) {		{{ if ( not ( eq .SubtypeName "" ) ) }}
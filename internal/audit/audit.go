@@ -0,0 +1,401 @@
+// Package audit emits audit events, recording the access decisions and
+// changes made by a controller, to the sinks configured for it: files,
+// syslog and webhooks. Events are versioned JSON objects describing who made
+// a request, the resource and action, and the result.
+package audit
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	ua "go.uber.org/atomic"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// queueSize is the number of events waiting to be encoded, and the number
+	// waiting to be written to each sink, above which events are dropped
+	// rather than holding up the requests emitting them
+	queueSize = 1024
+
+	// writeTimeout bounds the time spent writing an event to a sink, for
+	// sinks without a timeout of their own
+	writeTimeout = 30 * time.Second
+
+	// hmacKeyInfo is mixed into the derivation of the HMAC key so that it
+	// can never collide with other keys derived from the same DEK
+	hmacKeyInfo = "boundary-audit-hmac"
+)
+
+// droppedKey is the key of the number of events dropped because they could
+// not be queued, labeled with the sink they were not written to
+var droppedKey = []string{"controller", "audit", "dropped"}
+
+// ErrUnavailable is returned by CheckAvailable when an auditor failing closed
+// can't take more events
+var ErrUnavailable = errors.New("audit: events can't be written")
+
+// Sink is a destination of events
+type Sink interface {
+	// Write writes a single event, encoded as JSON without a trailing newline
+	Write(ctx context.Context, event []byte) error
+	io.Closer
+}
+
+// Auditor writes the events emitted with Emit to its sinks. Events are
+// encoded by a single goroutine and queued for each sink, which has its own
+// goroutine writing them in the order they were emitted, so that requests are
+// not held up by slow sinks and a slow sink doesn't hold up the others.
+type Auditor struct {
+	logger     hclog.Logger
+	sinks      []*sinkQueue
+	hmacFields []string
+	failClosed bool
+
+	// hmacKeyFn returns the key of the HMACs of the fields in hmacFields
+	hmacKeyFn func(context.Context) ([]byte, error)
+	keyLock   sync.Mutex
+	hmacKey   []byte
+
+	// l guards closed, which is set by Close once events is closed, so that
+	// no events are sent to it after
+	l      sync.RWMutex
+	closed bool
+	events chan *Event
+	done   chan struct{}
+}
+
+// sinkQueue holds the encoded events waiting to be written to a sink
+type sinkQueue struct {
+	name    string
+	sink    Sink
+	events  chan []byte
+	done    chan struct{}
+	dropped ua.Uint64
+}
+
+// New creates an Auditor writing to the sinks of conf. The HMACs of the
+// fields of events listed by conf are keyed with a key derived from the
+// database key of the global scope in kmsCache.
+func New(logger hclog.Logger, kmsCache *kms.Kms, conf *config.Audit) (*Auditor, error) {
+	if conf == nil {
+		return nil, errors.New("no audit configuration provided")
+	}
+	sinks := make(map[string]Sink, len(conf.Sinks))
+	for i, sc := range conf.Sinks {
+		var sink Sink
+		var err error
+		switch sc.Type {
+		case "file":
+			sink, err = NewFileSink(sc.Path)
+		case "syslog":
+			sink, err = NewSyslogSink(sc.Facility, sc.Tag)
+		case "webhook":
+			sink, err = NewWebhookSink(sc.Url, sc.Headers, sc.Timeout)
+		default:
+			err = fmt.Errorf("unknown sink type %q", sc.Type)
+		}
+		if err != nil {
+			for _, s := range sinks {
+				s.Close()
+			}
+			return nil, fmt.Errorf("error creating %s audit sink: %w", sc.Type, err)
+		}
+		sinks[fmt.Sprintf("%s-%d", sc.Type, i)] = sink
+	}
+	a := newAuditor(logger, sinks, conf.HmacFields, func(ctx context.Context) ([]byte, error) {
+		return deriveHmacKey(ctx, kmsCache)
+	})
+	a.failClosed = conf.FailClosed
+	return a, nil
+}
+
+func newAuditor(logger hclog.Logger, sinks map[string]Sink, hmacFields []string, hmacKeyFn func(context.Context) ([]byte, error)) *Auditor {
+	a := &Auditor{
+		logger:     logger,
+		hmacFields: hmacFields,
+		hmacKeyFn:  hmacKeyFn,
+		events:     make(chan *Event, queueSize),
+		done:       make(chan struct{}),
+	}
+	for name, s := range sinks {
+		q := &sinkQueue{
+			name:   name,
+			sink:   s,
+			events: make(chan []byte, queueSize),
+			done:   make(chan struct{}),
+		}
+		a.sinks = append(a.sinks, q)
+		go a.write(q)
+	}
+	go a.run()
+	return a
+}
+
+// Close stops accepting events, waits for the emitted events to be written
+// and closes the sinks.
+func (a *Auditor) Close() error {
+	if a == nil {
+		return nil
+	}
+	a.l.Lock()
+	if a.closed {
+		a.l.Unlock()
+		return nil
+	}
+	a.closed = true
+	close(a.events)
+	a.l.Unlock()
+	<-a.done
+
+	var retErr *multierror.Error
+	for _, q := range a.sinks {
+		<-q.done
+		if err := q.sink.Close(); err != nil {
+			retErr = multierror.Append(retErr, fmt.Errorf("error closing audit sink %s: %w", q.name, err))
+		}
+	}
+	return retErr.ErrorOrNil()
+}
+
+// emit queues e to be written, dropping it if the queue is full
+func (a *Auditor) emit(e *Event) {
+	a.l.RLock()
+	defer a.l.RUnlock()
+	if a.closed {
+		// Emitting after Close is a bug but shouldn't take down the
+		// controller while it shuts down
+		a.logger.Error("audit event emitted after close", "event_id", e.Id)
+		return
+	}
+	select {
+	case a.events <- e:
+	default:
+		a.logger.Error("audit event queue full, dropping event", "event_id", e.Id, "type", e.Type, "action", e.Action)
+		for _, q := range a.sinks {
+			q.drop()
+		}
+	}
+}
+
+// available reports whether the events emitted now can be queued for every
+// sink
+func (a *Auditor) available() bool {
+	a.l.RLock()
+	defer a.l.RUnlock()
+	if a.closed || len(a.events) == cap(a.events) {
+		return false
+	}
+	for _, q := range a.sinks {
+		if len(q.events) == cap(q.events) {
+			return false
+		}
+	}
+	return true
+}
+
+// run encodes the emitted events and queues them for each sink, dropping
+// them for the sinks whose queue is full. The queues of the sinks are closed
+// once the events are.
+func (a *Auditor) run() {
+	defer close(a.done)
+	defer func() {
+		for _, q := range a.sinks {
+			close(q.events)
+		}
+	}()
+	for e := range a.events {
+		ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+		err := a.hmacFieldsOf(ctx, e)
+		cancel()
+		if err != nil {
+			a.logger.Error("error computing hmac of audit event fields, dropping event", "event_id", e.Id, "error", err)
+			continue
+		}
+		b, err := json.Marshal(e)
+		if err != nil {
+			a.logger.Error("error encoding audit event", "event_id", e.Id, "error", err)
+			continue
+		}
+		for _, q := range a.sinks {
+			select {
+			case q.events <- b:
+			default:
+				a.logger.Error("audit sink queue full, dropping event", "sink", q.name, "event_id", e.Id, "type", e.Type, "action", e.Action)
+				q.drop()
+			}
+		}
+	}
+}
+
+// write writes the events queued for q to its sink until the queue is closed
+func (a *Auditor) write(q *sinkQueue) {
+	defer close(q.done)
+	for b := range q.events {
+		ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+		if err := q.sink.Write(ctx, b); err != nil {
+			a.logger.Error("error writing audit event", "sink", q.name, "error", err)
+		}
+		cancel()
+	}
+}
+
+// drop counts an event which was not written to the sink of q
+func (q *sinkQueue) drop() {
+	q.dropped.Inc()
+	metrics.IncrCounterWithLabels(droppedKey, 1, []metrics.Label{{Name: "sink", Value: q.name}})
+}
+
+// hmacFieldsOf replaces the fields of e listed in the hmac fields of the
+// auditor by their HMAC
+func (a *Auditor) hmacFieldsOf(ctx context.Context, e *Event) error {
+	if len(a.hmacFields) == 0 || e.Auth == nil {
+		return nil
+	}
+	key, err := a.key(ctx)
+	if err != nil {
+		return err
+	}
+	for _, f := range a.hmacFields {
+		var v *string
+		switch f {
+		case "auth_token_id":
+			v = &e.Auth.AuthTokenId
+		case "client_ip":
+			v = &e.Auth.ClientIp
+		case "user_id":
+			v = &e.Auth.UserId
+		default:
+			continue
+		}
+		if *v != "" {
+			*v = hmacValue(key, *v)
+		}
+	}
+	return nil
+}
+
+// key returns the HMAC key of the auditor, deriving it on first use
+func (a *Auditor) key(ctx context.Context) ([]byte, error) {
+	a.keyLock.Lock()
+	defer a.keyLock.Unlock()
+	if a.hmacKey != nil {
+		return a.hmacKey, nil
+	}
+	key, err := a.hmacKeyFn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a.hmacKey = key
+	return key, nil
+}
+
+// hmacValue returns the HMAC-SHA256 of v keyed with key, prefixed so that it
+// can't be mistaken for the value itself
+func hmacValue(key []byte, v string) string {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(v))
+	return "hmac-sha256:" + hex.EncodeToString(h.Sum(nil))
+}
+
+// deriveHmacKey derives a 32 byte key from the database DEK of the global
+// scope. Deriving it rather than storing it keeps the HMACs of a value stable
+// across controllers and restarts.
+func deriveHmacKey(ctx context.Context, kmsCache *kms.Kms) ([]byte, error) {
+	wrapper, err := kmsCache.GetWrapper(ctx, scope.Global.String(), kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, fmt.Errorf("error getting database wrapper: %w", err)
+	}
+	keyBytes, err := kms.BaseKeyBytes(wrapper)
+	if err != nil {
+		return nil, err
+	}
+	reader := hkdf.New(sha256.New, keyBytes, nil, []byte(hmacKeyInfo))
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(reader, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+type auditorKey struct{}
+
+type requestKey struct{}
+
+// Request is the state shared by the events emitted while serving an API
+// request. The auth information is filled in once the request is
+// authenticated, and the resource once it is authorized.
+type Request struct {
+	Id       string
+	Auth     Auth
+	Resource *Resource
+}
+
+// NewContext returns a context carrying a, to which Emit sends events
+func NewContext(ctx context.Context, a *Auditor) context.Context {
+	return context.WithValue(ctx, auditorKey{}, a)
+}
+
+// NewRequestContext returns a context carrying the state of an API request
+func NewRequestContext(ctx context.Context, r *Request) context.Context {
+	return context.WithValue(ctx, requestKey{}, r)
+}
+
+// RequestFromContext returns the state of the API request carried by ctx, or
+// nil if there is none
+func RequestFromContext(ctx context.Context) *Request {
+	r, _ := ctx.Value(requestKey{}).(*Request)
+	return r
+}
+
+// Emit sends e to the auditor carried by ctx, filling in its version, ID and
+// timestamp. Events emitted while serving an API request get its ID and, if
+// they have none, its auth information. Emit does nothing if ctx carries no
+// auditor, as is the case when audit events are not configured.
+func Emit(ctx context.Context, e *Event) {
+	a, _ := ctx.Value(auditorKey{}).(*Auditor)
+	if a == nil {
+		return
+	}
+	e.Version = EventVersion
+	e.Timestamp = time.Now().UTC()
+	var err error
+	if e.Id, err = uuid.GenerateUUID(); err != nil {
+		a.logger.Error("error generating audit event id", "error", err)
+	}
+	if r := RequestFromContext(ctx); r != nil {
+		e.RequestId = r.Id
+		if e.Auth == nil && r.Auth != (Auth{}) {
+			auth := r.Auth
+			e.Auth = &auth
+		}
+	}
+	a.emit(e)
+}
+
+// CheckAvailable returns ErrUnavailable if the auditor carried by ctx fails
+// closed and the events emitted now might be dropped, as a sink has fallen
+// too far behind. Requests whose events must not be lost check it before
+// they are served. It returns nil if ctx carries no auditor.
+func CheckAvailable(ctx context.Context) error {
+	a, _ := ctx.Value(auditorKey{}).(*Auditor)
+	if a == nil || !a.failClosed || a.available() {
+		return nil
+	}
+	return ErrUnavailable
+}
@@ -0,0 +1,190 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSink records the events written to it
+type testSink struct {
+	l      sync.Mutex
+	events []*Event
+	closed bool
+}
+
+func (s *testSink) Write(_ context.Context, event []byte) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	e := new(Event)
+	if err := json.Unmarshal(event, e); err != nil {
+		return err
+	}
+	s.events = append(s.events, e)
+	return nil
+}
+
+func (s *testSink) Close() error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.closed = true
+	return nil
+}
+
+func testKey(context.Context) ([]byte, error) {
+	return []byte("test-key"), nil
+}
+
+func TestEmit(t *testing.T) {
+	sink := new(testSink)
+	a := newAuditor(hclog.NewNullLogger(), map[string]Sink{"test": sink}, nil, testKey)
+
+	ctx := NewContext(context.Background(), a)
+	ctx = NewRequestContext(ctx, &Request{
+		Id:   "req-1",
+		Auth: Auth{UserId: "u_1234567890", AuthTokenId: "at_1234567890", ClientIp: "127.0.0.1"},
+	})
+	Emit(ctx, &Event{
+		Type:     AuthorizationEvent,
+		Resource: &Resource{Id: "ttcp_1234567890", Type: "target", ScopeId: "p_1234567890"},
+		Action:   "read",
+		Result:   &Result{Status: StatusAllowed},
+	})
+	Emit(ctx, &Event{
+		Type:   SessionEvent,
+		Auth:   &Auth{UserId: "u_other"},
+		Action: "activate",
+		Result: &Result{Status: StatusSuccess},
+	})
+	require.NoError(t, a.Close())
+	assert.True(t, sink.closed)
+
+	require.Len(t, sink.events, 2)
+	got := sink.events[0]
+	assert.Equal(t, EventVersion, got.Version)
+	assert.NotEmpty(t, got.Id)
+	assert.False(t, got.Timestamp.IsZero())
+	assert.Equal(t, "req-1", got.RequestId)
+	assert.Equal(t, &Auth{UserId: "u_1234567890", AuthTokenId: "at_1234567890", ClientIp: "127.0.0.1"}, got.Auth)
+	assert.Equal(t, "ttcp_1234567890", got.Resource.Id)
+	assert.Equal(t, StatusAllowed, got.Result.Status)
+
+	// Events with their own auth information keep it
+	assert.Equal(t, &Auth{UserId: "u_other"}, sink.events[1].Auth)
+	assert.NotEqual(t, got.Id, sink.events[1].Id)
+}
+
+func TestEmit_NoAuditor(t *testing.T) {
+	// Must not panic
+	Emit(context.Background(), &Event{Type: MutationEvent})
+	var a *Auditor
+	assert.NoError(t, a.Close())
+}
+
+func TestEmit_Hmac(t *testing.T) {
+	sink := new(testSink)
+	a := newAuditor(hclog.NewNullLogger(), map[string]Sink{"test": sink}, []string{"client_ip", "user_id"}, testKey)
+
+	ctx := NewContext(context.Background(), a)
+	Emit(ctx, &Event{
+		Type:   AuthorizationEvent,
+		Auth:   &Auth{UserId: "u_1234567890", AuthTokenId: "at_1234567890", ClientIp: "127.0.0.1"},
+		Result: &Result{Status: StatusDenied},
+	})
+	Emit(ctx, &Event{
+		Type:   AuthorizationEvent,
+		Auth:   &Auth{UserId: "u_1234567890"},
+		Result: &Result{Status: StatusDenied},
+	})
+	require.NoError(t, a.Close())
+
+	require.Len(t, sink.events, 2)
+	got := sink.events[0].Auth
+	assert.Equal(t, hmacValue([]byte("test-key"), "u_1234567890"), got.UserId)
+	assert.Equal(t, hmacValue([]byte("test-key"), "127.0.0.1"), got.ClientIp)
+	assert.Equal(t, "at_1234567890", got.AuthTokenId)
+	// HMACs are stable, so that events of a user can be correlated
+	assert.Equal(t, got.UserId, sink.events[1].Auth.UserId)
+	// Empty fields stay empty
+	assert.Empty(t, sink.events[1].Auth.ClientIp)
+}
+
+func TestHmacValue(t *testing.T) {
+	v := hmacValue([]byte("key"), "value")
+	assert.Equal(t, "hmac-sha256:90fbfcf15e74a36b89dbdb2a721d9aecffdfdddc5c83e27f7592594f71932481", v)
+	assert.NotEqual(t, v, hmacValue([]byte("other key"), "value"))
+}
+
+// blockingSink blocks the writes until unblock is closed
+type blockingSink struct {
+	testSink
+	unblock chan struct{}
+}
+
+func (s *blockingSink) Write(ctx context.Context, event []byte) error {
+	<-s.unblock
+	return s.testSink.Write(ctx, event)
+}
+
+func TestEmit_SlowSink(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	fast := new(testSink)
+	slow := &blockingSink{unblock: make(chan struct{})}
+	a := newAuditor(hclog.NewNullLogger(), map[string]Sink{"fast": fast, "slow": slow}, nil, testKey)
+	a.failClosed = true
+	ctx := NewContext(context.Background(), a)
+
+	var fastQueue, slowQueue *sinkQueue
+	for _, q := range a.sinks {
+		if q.name == "slow" {
+			slowQueue = q
+		} else {
+			fastQueue = q
+		}
+	}
+
+	// The slow sink holds up one event and queues queueSize more, after
+	// which its events are dropped while the fast sink keeps up
+	assert.NoError(CheckAvailable(ctx))
+	Emit(ctx, &Event{Type: MutationEvent})
+	require.Eventually(func() bool { return len(a.events) == 0 && len(slowQueue.events) == 0 }, 5*time.Second, time.Millisecond)
+	const emitted = 2*queueSize + 10
+	for i := 1; i < emitted; i++ {
+		Emit(ctx, &Event{Type: MutationEvent})
+		if i%100 == 0 {
+			// Let the events be queued for the sinks, so that those
+			// dropped are the ones of the slow sink
+			require.Eventually(func() bool { return len(a.events) == 0 }, 5*time.Second, time.Millisecond)
+		}
+	}
+	require.Eventually(func() bool {
+		fast.l.Lock()
+		defer fast.l.Unlock()
+		return len(fast.events) == emitted
+	}, 5*time.Second, time.Millisecond)
+	assert.Zero(fastQueue.dropped.Load())
+	assert.Equal(uint64(emitted-queueSize-1), slowQueue.dropped.Load())
+
+	// An auditor failing closed refuses the requests while events are dropped
+	assert.Equal(ErrUnavailable, CheckAvailable(ctx))
+	a.failClosed = false
+	assert.NoError(CheckAvailable(ctx))
+
+	close(slow.unblock)
+	require.NoError(a.Close())
+	assert.Len(slow.events, queueSize+1)
+	assert.True(slow.closed)
+	assert.True(fast.closed)
+
+	// Events emitted after close are dropped, and closing again does nothing
+	Emit(ctx, &Event{Type: MutationEvent})
+	assert.NoError(a.Close())
+	a.failClosed = true
+	assert.Equal(ErrUnavailable, CheckAvailable(ctx))
+}
@@ -0,0 +1,76 @@
+package audit
+
+import "time"
+
+// EventVersion is the version of the format of events. It changes whenever
+// a field is removed or its meaning changes, not when fields are added.
+const EventVersion = "v1"
+
+// EventType is the kind of decision or change an event records
+type EventType string
+
+const (
+	// AuthorizationEvent records the authn/authz decision made for a
+	// request on a resource
+	AuthorizationEvent EventType = "authorization"
+
+	// MutationEvent records the result of a request changing resources
+	MutationEvent EventType = "mutation"
+
+	// SessionEvent records a change of the lifecycle of a session: its
+	// authorization, activation by a worker or cancelation
+	SessionEvent EventType = "session"
+)
+
+// Result statuses of events
+const (
+	StatusAllowed = "allowed"
+	StatusDenied  = "denied"
+	StatusSuccess = "success"
+	StatusFailure = "failure"
+	StatusError   = "error"
+)
+
+// Event is an audit event, written to sinks as a single line of JSON
+type Event struct {
+	Version   string    `json:"version"`
+	Id        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	Type      EventType `json:"type"`
+
+	// RequestId identifies the API request the event was emitted for, so
+	// that the events of a request can be correlated. It is empty for
+	// events emitted for workers.
+	RequestId string `json:"request_id,omitempty"`
+
+	Auth     *Auth     `json:"auth,omitempty"`
+	Resource *Resource `json:"resource,omitempty"`
+	Action   string    `json:"action"`
+	Result   *Result   `json:"result"`
+
+	// Attributes hold details specific to the type and action of the
+	// event, such as the target of an authorized session
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Auth describes who made a request
+type Auth struct {
+	UserId      string `json:"user_id,omitempty"`
+	AuthTokenId string `json:"auth_token_id,omitempty"`
+	ClientIp    string `json:"client_ip,omitempty"`
+}
+
+// Resource describes the resource a request acted on
+type Resource struct {
+	Id      string `json:"id,omitempty"`
+	Type    string `json:"type,omitempty"`
+	ScopeId string `json:"scope_id,omitempty"`
+}
+
+// Result describes the outcome of a request. Status is one of the Status
+// constants, and Code the HTTP status of API requests.
+type Result struct {
+	Status string `json:"status"`
+	Code   int    `json:"code,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
@@ -0,0 +1,110 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// DefaultWebhookTimeout is the timeout of the requests of webhook sinks with
+// no configured timeout
+const DefaultWebhookTimeout = 10 * time.Second
+
+// FileSink appends events to a file, one per line
+type FileSink struct {
+	l    sync.Mutex
+	file *os.File
+}
+
+var _ Sink = (*FileSink)(nil)
+
+// NewFileSink opens the file at path for appending events, creating it if it
+// doesn't exist. Created files are only readable by the current user.
+func NewFileSink(path string) (*FileSink, error) {
+	if path == "" {
+		return nil, errors.New("no path provided")
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("error opening audit file: %w", err)
+	}
+	return &FileSink{file: f}, nil
+}
+
+// Write appends event and a newline to the file
+func (s *FileSink) Write(_ context.Context, event []byte) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	_, err := s.file.Write(append(event, '\n'))
+	return err
+}
+
+// Close closes the file
+func (s *FileSink) Close() error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	return s.file.Close()
+}
+
+// WebhookSink posts events to a URL, one per request
+type WebhookSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+var _ Sink = (*WebhookSink)(nil)
+
+// NewWebhookSink creates a sink posting events to url with the given headers.
+// Requests taking longer than timeout fail; a zero timeout means
+// DefaultWebhookTimeout.
+func NewWebhookSink(url string, headers map[string]string, timeout time.Duration) (*WebhookSink, error) {
+	if url == "" {
+		return nil, errors.New("no url provided")
+	}
+	if timeout == 0 {
+		timeout = DefaultWebhookTimeout
+	}
+	return &WebhookSink{
+		url:     url,
+		headers: headers,
+		client:  &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Write posts event as the JSON body of a request, failing unless the
+// response has a 2xx status
+func (s *WebhookSink) Write(ctx context.Context, event []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(event))
+	if err != nil {
+		return fmt.Errorf("error creating webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error posting to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	// Drain the body so the connection can be reused
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Close closes the idle connections of the sink
+func (s *WebhookSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
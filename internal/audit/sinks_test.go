@@ -0,0 +1,69 @@
+package audit
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+	s, err := NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, s.Write(context.Background(), []byte(`{"id":"1"}`)))
+	require.NoError(t, s.Close())
+
+	// Reopening appends
+	s, err = NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, s.Write(context.Background(), []byte(`{"id":"2"}`)))
+	require.NoError(t, s.Close())
+
+	b, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "{\"id\":\"1\"}\n{\"id\":\"2\"}\n", string(b))
+
+	_, err = NewFileSink("")
+	assert.Error(t, err)
+}
+
+func TestWebhookSink(t *testing.T) {
+	var gotBody, gotType, gotHeader string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		gotBody = string(b)
+		gotType = r.Header.Get("Content-Type")
+		gotHeader = r.Header.Get("Authorization")
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	s, err := NewWebhookSink(srv.URL, map[string]string{"Authorization": "Bearer secret"}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultWebhookTimeout, s.client.Timeout)
+	require.NoError(t, s.Write(context.Background(), []byte(`{"id":"1"}`)))
+	assert.Equal(t, `{"id":"1"}`, gotBody)
+	assert.Equal(t, "application/json", gotType)
+	assert.Equal(t, "Bearer secret", gotHeader)
+	require.NoError(t, s.Close())
+
+	s, err = NewWebhookSink(srv.URL+"/fail", nil, time.Second)
+	require.NoError(t, err)
+	assert.Error(t, s.Write(context.Background(), []byte(`{"id":"2"}`)))
+
+	_, err = NewWebhookSink("", nil, 0)
+	assert.Error(t, err)
+}
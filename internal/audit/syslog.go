@@ -0,0 +1,74 @@
+// +build !windows,!plan9
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"log/syslog"
+	"strings"
+)
+
+// defaultSyslogTag is the tag of the messages of syslog sinks with no
+// configured tag
+const defaultSyslogTag = "boundary"
+
+var syslogFacilities = map[string]syslog.Priority{
+	"kern":     syslog.LOG_KERN,
+	"user":     syslog.LOG_USER,
+	"mail":     syslog.LOG_MAIL,
+	"daemon":   syslog.LOG_DAEMON,
+	"auth":     syslog.LOG_AUTH,
+	"syslog":   syslog.LOG_SYSLOG,
+	"lpr":      syslog.LOG_LPR,
+	"news":     syslog.LOG_NEWS,
+	"uucp":     syslog.LOG_UUCP,
+	"cron":     syslog.LOG_CRON,
+	"authpriv": syslog.LOG_AUTHPRIV,
+	"ftp":      syslog.LOG_FTP,
+	"local0":   syslog.LOG_LOCAL0,
+	"local1":   syslog.LOG_LOCAL1,
+	"local2":   syslog.LOG_LOCAL2,
+	"local3":   syslog.LOG_LOCAL3,
+	"local4":   syslog.LOG_LOCAL4,
+	"local5":   syslog.LOG_LOCAL5,
+	"local6":   syslog.LOG_LOCAL6,
+	"local7":   syslog.LOG_LOCAL7,
+}
+
+// SyslogSink writes events to the local syslog daemon at the info severity
+type SyslogSink struct {
+	writer *syslog.Writer
+}
+
+var _ Sink = (*SyslogSink)(nil)
+
+// NewSyslogSink connects to the local syslog daemon. The facility defaults to
+// auth and the tag to "boundary".
+func NewSyslogSink(facility, tag string) (*SyslogSink, error) {
+	priority := syslog.LOG_AUTH
+	if facility != "" {
+		var ok bool
+		if priority, ok = syslogFacilities[strings.ToLower(facility)]; !ok {
+			return nil, fmt.Errorf("unknown syslog facility %q", facility)
+		}
+	}
+	if tag == "" {
+		tag = defaultSyslogTag
+	}
+	w, err := syslog.New(priority|syslog.LOG_INFO, tag)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to syslog: %w", err)
+	}
+	return &SyslogSink{writer: w}, nil
+}
+
+// Write writes event as a single message
+func (s *SyslogSink) Write(_ context.Context, event []byte) error {
+	return s.writer.Info(string(event))
+}
+
+// Close closes the connection to the syslog daemon
+func (s *SyslogSink) Close() error {
+	return s.writer.Close()
+}
@@ -0,0 +1,26 @@
+// +build windows plan9
+
+package audit
+
+import (
+	"context"
+	"errors"
+)
+
+// SyslogSink is not supported on this platform
+type SyslogSink struct{}
+
+var _ Sink = (*SyslogSink)(nil)
+
+// NewSyslogSink always fails, as syslog is not supported on this platform
+func NewSyslogSink(facility, tag string) (*SyslogSink, error) {
+	return nil, errors.New("syslog is not supported on this platform")
+}
+
+func (s *SyslogSink) Write(context.Context, []byte) error {
+	return errors.New("syslog is not supported on this platform")
+}
+
+func (s *SyslogSink) Close() error {
+	return nil
+}
@@ -151,3 +151,50 @@ func TestAdditionalVerification(t *testing.T) {
 		})
 	}
 }
+
+func TestScopeAllowed(t *testing.T) {
+	tc := controller.NewTestController(t, nil)
+	defer tc.Shutdown()
+
+	conn := tc.DbConn()
+	token := tc.Token()
+	org, proj := iam.TestScopes(t, tc.IamRepo(), iam.WithUserId(token.UserId), iam.WithSkipAdminRoleCreation(true), iam.WithSkipDefaultRoleCreation(true))
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return tc.IamRepo(), nil
+	}
+	serversRepoFn := func() (*servers.Repository, error) {
+		return tc.ServersRepo(), nil
+	}
+	authTokenRepoFn := func() (*authtoken.Repository, error) {
+		return tc.AuthTokenRepo(), nil
+	}
+
+	projRole := iam.TestRole(t, conn, proj.GetPublicId())
+	iam.TestUserRole(t, conn, projRole.PublicId, token.UserId)
+	iam.TestRoleGrant(t, conn, projRole.PublicId, "type=target;actions=list")
+
+	req := require.New(t)
+	ctx := auth.NewVerifierContext(
+		context.Background(),
+		tc.Logger(),
+		iamRepoFn,
+		authTokenRepoFn,
+		serversRepoFn,
+		tc.Kms(),
+		auth.RequestInfo{
+			PublicId:       token.Id,
+			EncryptedToken: strings.Split(token.Token, "_")[2],
+			TokenFormat:    auth.AuthTokenTypeBearer,
+		})
+
+	// The user can't list targets in the org, but is authenticated
+	res := auth.Verify(ctx, auth.WithScopeId(org.PublicId), auth.WithType(resource.Target), auth.WithAction(action.List))
+	req.Error(res.Error)
+	req.True(res.Authenticated)
+
+	req.True(res.ScopeAllowed(ctx, proj.PublicId, resource.Target, action.List))
+	req.False(res.ScopeAllowed(ctx, proj.PublicId, resource.Target, action.Create))
+	req.False(res.ScopeAllowed(ctx, proj.PublicId, resource.Session, action.List))
+	req.False(res.ScopeAllowed(ctx, org.PublicId, resource.Target, action.List))
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/audit"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
 	"github.com/hashicorp/boundary/internal/gen/controller/tokens"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -23,6 +25,7 @@ import (
 	wrapping "github.com/hashicorp/go-kms-wrapping"
 	"github.com/kr/pretty"
 	"github.com/mr-tron/base58"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -55,6 +58,10 @@ type RequestInfo struct {
 	Token          string
 	TokenFormat    TokenFormat
 
+	// ClientIp is the address of the client making the request, used when
+	// checking grant conditions
+	ClientIp string
+
 	// The following are useful for tests
 	scopeIdOverride      string
 	userIdOverride       string
@@ -68,6 +75,10 @@ type VerifyResults struct {
 	Error       error
 	Scope       *scopes.ScopeInfo
 
+	// Authenticated is true if the request was made by an authenticated user,
+	// whether or not the user was authorized
+	Authenticated bool
+
 	// Used for additional verification
 	v *verifier
 }
@@ -83,12 +94,13 @@ type verifier struct {
 	act             action.Type
 	ctx             context.Context
 	acl             perms.ACL
+	authMethodId    string
 }
 
 // NewVerifierContext creates a context that carries a verifier object from the
-// HTTP handlers to the gRPC service handlers. It should only be created in the
-// HTTP handler and should exist for every request that reaches the service
-// handlers.
+// HTTP handlers, or the interceptors of the gRPC API listener, to the gRPC
+// service handlers. It should exist for every request that reaches the
+// service handlers.
 func NewVerifierContext(ctx context.Context,
 	logger hclog.Logger,
 	iamRepoFn common.IamRepoFactory,
@@ -106,6 +118,17 @@ func NewVerifierContext(ctx context.Context,
 	})
 }
 
+// KmsFromContext returns the kms of the verifier carried by ctx, used by the
+// service handlers to encrypt the values they hand to clients, such as page
+// tokens. It returns nil if ctx carries no verifier or the verifier no kms.
+func KmsFromContext(ctx context.Context) *kms.Kms {
+	v, ok := ctx.Value(verifierKey).(*verifier)
+	if !ok {
+		return nil
+	}
+	return v.kms
+}
+
 // Verify takes in a context that has expected parameters as values and runs an
 // authn/authz check. It returns a user ID, the scope ID for the request (which
 // may come from the URL and may come from the token) and whether or not to
@@ -163,6 +186,9 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 
 	var authResults perms.ACLResults
 	var err error
+	defer func() {
+		v.auditDecision(ctx, ret, *v.res, v.act, authResults.Allowed, err)
+	}()
 	authResults, ret.UserId, ret.Scope, v.acl, err = v.performAuthCheck()
 	if err != nil {
 		v.logger.Error("error performing authn/authz check", "error", err)
@@ -170,11 +196,12 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 	}
 
 	ret.AuthTokenId = v.requestInfo.PublicId
+	ret.Authenticated = ret.UserId != "u_anon"
 	if !authResults.Allowed {
 		if v.requestInfo.DisableAuthzFailures {
 			ret.Error = nil
 			// TODO: Decide whether to remove this
-			v.logger.Info("failed authz info for request", "resource", pretty.Sprint(v.res), "user_id", ret.UserId, "action", v.act.String())
+			v.logger.Info("failed authz info for request", "resource", pretty.Sprint(v.res), "user_id", ret.UserId, "action", v.act.String(), "reason", authResults.Reason.String())
 		} else {
 			// If the anon user was used (either no token, or invalid (perhaps
 			// expired) token), return a 401. That way if it's an authn'd user
@@ -217,11 +244,18 @@ func (r *VerifyResults) AdditionalVerification(ctx context.Context, opt ...Optio
 		res.ScopeId = scope.Global.String()
 	}
 
+	var allowed bool
+	var checkErr error
+	defer func() {
+		v.auditDecision(ctx, ret, res, act, allowed, checkErr)
+	}()
+
 	// Only perform lookup if it's actually different, otherwise use cached info
 	if res.ScopeId != r.Scope.Id {
 		iamRepo, err := v.iamRepoFn()
 		if err != nil {
 			v.logger.Error("additional verification: failed to get iam repo", "error", err)
+			checkErr = err
 			return
 		}
 
@@ -241,10 +275,12 @@ func (r *VerifyResults) AdditionalVerification(ctx context.Context, opt ...Optio
 			scp, err := iamRepo.LookupScope(v.ctx, v.res.ScopeId)
 			if err != nil {
 				v.logger.Error("additional verification: failed to get look up scope", "error", err)
+				checkErr = err
 				return
 			}
 			if scp == nil {
 				v.logger.Error("additional verification: non-existent scope", "error", err)
+				checkErr = fmt.Errorf("non-existent scope %q", v.res.ScopeId)
 				return
 			}
 			ret.Scope = &scopes.ScopeInfo{
@@ -259,11 +295,13 @@ func (r *VerifyResults) AdditionalVerification(ctx context.Context, opt ...Optio
 
 	// Always allowed
 	if v.requestInfo.TokenFormat == AuthTokenTypeRecoveryKms {
+		allowed = true
 		ret.Error = nil
 		return
 	}
 
-	aclResults := v.acl.Allowed(res, act)
+	aclResults := v.acl.Allowed(res, act, v.aclOptions()...)
+	allowed = aclResults.Allowed
 
 	if !aclResults.Allowed {
 		if v.requestInfo.DisableAuthzFailures {
@@ -285,7 +323,95 @@ func (r *VerifyResults) AdditionalVerification(ctx context.Context, opt ...Optio
 	return
 }
 
-func (v verifier) performAuthCheck() (aclResults perms.ACLResults, userId string, scopeInfo *scopes.ScopeInfo, retAcl perms.ACL, retErr error) {
+// ScopeAllowed reports whether act is allowed on the items of typ in the
+// scope with the given ID, checked against the grants the request was verified
+// with. It is used by requests covering several scopes, such as recursive
+// lists, to check each of them. Like Verify it audits the decision.
+func (r *VerifyResults) ScopeAllowed(ctx context.Context, scopeId string, typ resource.Type, act action.Type) bool {
+	v := r.v
+	if v == nil {
+		return false
+	}
+	if v.requestInfo.DisableAuthEntirely {
+		return true
+	}
+
+	res := perms.Resource{ScopeId: scopeId, Type: typ}
+	allowed := v.requestInfo.TokenFormat == AuthTokenTypeRecoveryKms || v.acl.Allowed(res, act, v.aclOptions()...).Allowed
+	v.auditDecision(ctx, *r, res, act, allowed, nil)
+	return allowed || v.requestInfo.DisableAuthzFailures
+}
+
+// ResourceAllowed reports whether act is allowed on the resource of typ with
+// the given ID in the scope with the given ID, checked against the grants the
+// request was last verified with. It is used by watches to check each event
+// they send. Unlike ScopeAllowed it does not audit the decision, as a watch
+// may check any number of events.
+func (r *VerifyResults) ResourceAllowed(scopeId, id string, typ resource.Type, act action.Type) bool {
+	v := r.v
+	if v == nil {
+		return false
+	}
+	if v.requestInfo.DisableAuthEntirely {
+		return true
+	}
+
+	res := perms.Resource{ScopeId: scopeId, Id: id, Type: typ}
+	allowed := v.requestInfo.TokenFormat == AuthTokenTypeRecoveryKms || v.acl.Allowed(res, act, v.aclOptions()...).Allowed
+	return allowed || v.requestInfo.DisableAuthzFailures
+}
+
+// ListItemAllowed reports whether an item of a list, the resource of typ with
+// the given ID and pin in the scope with the given ID, is returned. Lists are
+// authorized for the scope or parent whose items they list, so an item is
+// returned unless a grant denies reading or listing it, such as a deny grant
+// on its ID. Like ResourceAllowed it does not audit the decision.
+func (r *VerifyResults) ListItemAllowed(scopeId, pin, id string, typ resource.Type) bool {
+	v := r.v
+	if v == nil {
+		return false
+	}
+	if v.requestInfo.DisableAuthEntirely || v.requestInfo.TokenFormat == AuthTokenTypeRecoveryKms {
+		return true
+	}
+
+	res := perms.Resource{ScopeId: scopeId, Pin: pin, Id: id, Type: typ}
+	for _, act := range []action.Type{action.Read, action.List} {
+		if v.acl.Allowed(res, act, v.aclOptions()...).Reason == perms.ReasonDenied {
+			return v.requestInfo.DisableAuthzFailures
+		}
+	}
+	return true
+}
+
+// auditDecision emits an audit event recording the authn/authz decision for
+// act on res. It also records who made the request, and the first resource
+// checked for it, for the events emitted later while serving the request.
+func (v *verifier) auditDecision(ctx context.Context, ret VerifyResults, res perms.Resource, act action.Type, allowed bool, checkErr error) {
+	result := &audit.Result{Status: audit.StatusAllowed}
+	switch {
+	case checkErr != nil:
+		result = &audit.Result{Status: audit.StatusError, Error: checkErr.Error()}
+	case !allowed:
+		result = &audit.Result{Status: audit.StatusDenied}
+	}
+	auditRes := &audit.Resource{Id: res.Id, Type: res.Type.String(), ScopeId: res.ScopeId}
+	if r := audit.RequestFromContext(ctx); r != nil {
+		r.Auth.UserId = ret.UserId
+		r.Auth.AuthTokenId = ret.AuthTokenId
+		if r.Resource == nil {
+			r.Resource = auditRes
+		}
+	}
+	audit.Emit(ctx, &audit.Event{
+		Type:     audit.AuthorizationEvent,
+		Resource: auditRes,
+		Action:   act.String(),
+		Result:   result,
+	})
+}
+
+func (v *verifier) performAuthCheck() (aclResults perms.ACLResults, userId string, scopeInfo *scopes.ScopeInfo, retAcl perms.ACL, retErr error) {
 	// Ensure we return an error by default if we forget to set this somewhere
 	retErr = errors.New("unknown")
 	// Make the linter happy
@@ -324,10 +450,12 @@ func (v verifier) performAuthCheck() (aclResults perms.ACLResults, userId string
 		if at != nil {
 			accountId = at.GetAuthAccountId()
 			userId = at.GetIamUserId()
+			v.authMethodId = at.GetAuthMethodId()
 			if userId == "" {
 				v.logger.Warn("perform auth check: valid token did not map to a user, likely because no account is associated with the user any longer; continuing as u_anon", "token_id", at.GetPublicId())
 				userId = "u_anon"
 				accountId = ""
+				v.authMethodId = ""
 			}
 		}
 	}
@@ -393,6 +521,7 @@ func (v verifier) performAuthCheck() (aclResults perms.ACLResults, userId string
 			pair.Grant,
 			perms.WithUserId(userId),
 			perms.WithAccountId(accountId),
+			perms.WithRoleId(pair.RoleId),
 			perms.WithSkipFinalValidation(true))
 		if err != nil {
 			retErr = fmt.Errorf("perform auth check: failed to parse grant %#v: %w", pair.Grant, err)
@@ -402,7 +531,7 @@ func (v verifier) performAuthCheck() (aclResults perms.ACLResults, userId string
 	}
 
 	retAcl = perms.NewACL(parsedGrants...)
-	aclResults = retAcl.Allowed(*v.res, v.act)
+	aclResults = retAcl.Allowed(*v.res, v.act, v.aclOptions()...)
 	retErr = nil
 	return
 }
@@ -438,6 +567,28 @@ func GetTokenFromRequest(logger hclog.Logger, kmsCache *kms.Kms, req *http.Reque
 		}
 	}
 
+	return parseToken(logger, fullToken, receivedTokenType)
+}
+
+// GetTokenFromGrpcMetadata pulls the token from the authorization metadata of
+// a request to the gRPC API and parses it, the same way GetTokenFromRequest
+// does for the Authorization header. Cookies are not supported over gRPC.
+func GetTokenFromGrpcMetadata(logger hclog.Logger, md metadata.MD) (string, string, TokenFormat) {
+	var receivedTokenType TokenFormat
+	var fullToken string
+	if authHeaders := md.Get("authorization"); len(authHeaders) > 0 && authHeaders[0] != "" {
+		headerSplit := strings.SplitN(strings.TrimSpace(authHeaders[0]), " ", 2)
+		if len(headerSplit) == 2 && strings.EqualFold(strings.TrimSpace(headerSplit[0]), "bearer") {
+			receivedTokenType = AuthTokenTypeBearer
+			fullToken = strings.TrimSpace(headerSplit[1])
+		}
+	}
+	return parseToken(logger, fullToken, receivedTokenType)
+}
+
+// parseToken splits a token received in the given format into its public ID
+// and encrypted token
+func parseToken(logger hclog.Logger, fullToken string, receivedTokenType TokenFormat) (string, string, TokenFormat) {
 	if receivedTokenType == AuthTokenTypeUnknown || fullToken == "" {
 		// We didn't find auth info or a client screwed up and put in a blank
 		// header instead of nothing at all, so return blank which will indicate
@@ -461,6 +612,18 @@ func GetTokenFromRequest(logger hclog.Logger, kmsCache *kms.Kms, req *http.Reque
 	return publicId, encryptedToken, receivedTokenType
 }
 
+// aclOptions returns the details of the request that conditions in grants are
+// checked against
+func (v *verifier) aclOptions() []perms.Option {
+	opts := []perms.Option{
+		perms.WithAuthMethodId(v.authMethodId),
+	}
+	if ip := net.ParseIP(v.requestInfo.ClientIp); ip != nil {
+		opts = append(opts, perms.WithClientIp(ip))
+	}
+	return opts
+}
+
 func (v *verifier) decryptToken() {
 	switch v.requestInfo.TokenFormat {
 	case AuthTokenTypeUnknown:
@@ -0,0 +1,101 @@
+// Package external holds what is shared by the auth methods whose accounts
+// are those of users of an external identity provider, such as the oidc and
+// ldap auth methods. Their accounts are created when users first
+// authenticate and kept up to date with the attributes the identity provider
+// gives them.
+package external
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// Account is an account of an auth method whose attributes are given by an
+// identity provider. It is implemented by a pointer to the account type of
+// the auth method.
+type Account interface {
+	// Attributes returns the attributes of the account given by the
+	// identity provider, by the name of their field
+	Attributes() map[string]string
+
+	// WithAttributes returns a copy of the account with the attributes in
+	// attrs, by the name of their field
+	WithAttributes(attrs map[string]string) Account
+
+	// Oplog returns the oplog metadata of op on the account
+	Oplog(op oplog.OpType) oplog.Metadata
+}
+
+// UpsertAccount returns the account of a user authenticated by an identity
+// provider, which lookup returns or nil if it doesn't exist. An account which
+// doesn't exist is created by create. The attributes of an existing account
+// are updated to attrs, those given by the identity provider, if they have
+// changed; empty attributes are set to null.
+func UpsertAccount(ctx context.Context, w db.Writer, kmsCache *kms.Kms, scopeId string, attrs map[string]string, lookup, create func(context.Context) (Account, error)) (Account, error) {
+	acct, err := lookup(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if acct == nil {
+		acct, err = create(ctx)
+		switch {
+		case err == nil:
+			return acct, nil
+		case errors.Is(err, db.ErrNotUnique):
+			// The user authenticated concurrently with another request which
+			// created the account first.
+			acct, err = lookup(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if acct == nil {
+				return nil, fmt.Errorf("account created concurrently: %w", db.ErrRecordNotFound)
+			}
+		default:
+			return nil, err
+		}
+	}
+
+	var changed bool
+	var dbMask, nullFields []string
+	current := acct.Attributes()
+	for f, v := range attrs {
+		if current[f] != v {
+			changed = true
+		}
+		if v == "" {
+			nullFields = append(nullFields, f)
+			continue
+		}
+		dbMask = append(dbMask, f)
+	}
+	if !changed {
+		return acct, nil
+	}
+
+	oplogWrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get oplog wrapper: %w", err)
+	}
+	upAccount := acct.WithAttributes(attrs)
+	_, err = w.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedAccount := upAccount.WithAttributes(attrs)
+			rowsUpdated, err := w.Update(ctx, returnedAccount, dbMask, nullFields, db.WithOplog(oplogWrapper, upAccount.Oplog(oplog.OpType_OP_TYPE_UPDATE)))
+			if err == nil && rowsUpdated > 1 {
+				return db.ErrMultipleRecords
+			}
+			upAccount = returnedAccount
+			return err
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("update account attributes: %w", err)
+	}
+	return upAccount, nil
+}
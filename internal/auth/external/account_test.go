@@ -0,0 +1,93 @@
+package external
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testAccount struct {
+	email string
+}
+
+func (a *testAccount) Attributes() map[string]string {
+	return map[string]string{"Email": a.email}
+}
+
+func (a *testAccount) WithAttributes(attrs map[string]string) Account {
+	return &testAccount{email: attrs["Email"]}
+}
+
+func (a *testAccount) Oplog(op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{"op-type": []string{op.String()}}
+}
+
+func TestUpsertAccount(t *testing.T) {
+	ctx := context.Background()
+	attrs := map[string]string{"Email": "user@example.com"}
+	existing := &testAccount{email: "user@example.com"}
+	found := func(context.Context) (Account, error) { return existing, nil }
+	notFound := func(context.Context) (Account, error) { return nil, nil }
+
+	t.Run("created", func(t *testing.T) {
+		created := &testAccount{email: "user@example.com"}
+		got, err := UpsertAccount(ctx, nil, nil, "o_1234567890", attrs, notFound, func(context.Context) (Account, error) {
+			return created, nil
+		})
+		require.NoError(t, err)
+		assert.Same(t, created, got)
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		got, err := UpsertAccount(ctx, nil, nil, "o_1234567890", attrs, found, func(context.Context) (Account, error) {
+			return nil, errors.New("created an existing account")
+		})
+		require.NoError(t, err)
+		assert.Same(t, existing, got)
+	})
+
+	t.Run("created-concurrently", func(t *testing.T) {
+		lookups := 0
+		lookup := func(context.Context) (Account, error) {
+			lookups++
+			if lookups == 1 {
+				return nil, nil
+			}
+			return existing, nil
+		}
+		got, err := UpsertAccount(ctx, nil, nil, "o_1234567890", attrs, lookup, func(context.Context) (Account, error) {
+			return nil, db.ErrNotUnique
+		})
+		require.NoError(t, err)
+		assert.Same(t, existing, got)
+		assert.Equal(t, 2, lookups)
+	})
+
+	t.Run("created-concurrently-not-found", func(t *testing.T) {
+		_, err := UpsertAccount(ctx, nil, nil, "o_1234567890", attrs, notFound, func(context.Context) (Account, error) {
+			return nil, db.ErrNotUnique
+		})
+		assert.True(t, errors.Is(err, db.ErrRecordNotFound))
+	})
+
+	t.Run("create-error", func(t *testing.T) {
+		createErr := errors.New("create failed")
+		_, err := UpsertAccount(ctx, nil, nil, "o_1234567890", attrs, notFound, func(context.Context) (Account, error) {
+			return nil, createErr
+		})
+		assert.Equal(t, createErr, err)
+	})
+
+	t.Run("lookup-error", func(t *testing.T) {
+		lookupErr := errors.New("lookup failed")
+		_, err := UpsertAccount(ctx, nil, nil, "o_1234567890", attrs, func(context.Context) (Account, error) {
+			return nil, lookupErr
+		}, notFound)
+		assert.Equal(t, lookupErr, err)
+	})
+}
@@ -0,0 +1,101 @@
+package ldap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/external"
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// An Account is the identity of a user in the directory of an ldap auth
+// method. It is identified by the login name of the user and is owned by an
+// auth method.
+type Account struct {
+	*store.Account
+	tableName string
+}
+
+func allocAccount() *Account {
+	return &Account{
+		Account: &store.Account{},
+	}
+}
+
+// NewAccount creates a new in memory Account for loginName. The login name
+// is converted to lower case. Name, description, DN, email, and full name
+// are the only valid options. All other options are ignored.
+func NewAccount(authMethodId, loginName string, opt ...Option) (*Account, error) {
+	// The scopeId in the embedded *store.Account is populated by a trigger
+	// in the database.
+	if authMethodId == "" {
+		return nil, fmt.Errorf("new: ldap account: no auth method id: %w", db.ErrInvalidParameter)
+	}
+
+	opts := getOpts(opt...)
+	a := &Account{
+		Account: &store.Account{
+			AuthMethodId: authMethodId,
+			LoginName:    strings.ToLower(strings.TrimSpace(loginName)),
+			Name:         opts.withName,
+			Description:  opts.withDescription,
+			Dn:           opts.withDn,
+			Email:        opts.withEmail,
+			FullName:     opts.withFullName,
+		},
+	}
+	return a, nil
+}
+
+func (a *Account) clone() *Account {
+	cp := proto.Clone(a.Account)
+	return &Account{
+		Account: cp.(*store.Account),
+	}
+}
+
+// TableName returns the table name.
+func (a *Account) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return "auth_ldap_account"
+}
+
+// SetTableName sets the table name.
+func (a *Account) SetTableName(n string) {
+	a.tableName = n
+}
+
+func (a *Account) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{a.GetPublicId()},
+		"resource-type":      []string{"ldap account"},
+		"op-type":            []string{op.String()},
+	}
+	if a.AuthMethodId != "" {
+		metadata["auth-method-id"] = []string{a.AuthMethodId}
+	}
+	return metadata
+}
+
+// Attributes returns the attributes of the account given by the directory entry of its user,
+// by the name of their field.
+func (a *Account) Attributes() map[string]string {
+	return map[string]string{"Dn": a.Dn, "Email": a.Email, "FullName": a.FullName}
+}
+
+// WithAttributes returns a copy of the account with the attributes in attrs.
+func (a *Account) WithAttributes(attrs map[string]string) external.Account {
+	cp := a.clone()
+	cp.Dn, cp.Email, cp.FullName = attrs["Dn"], attrs["Email"], attrs["FullName"]
+	return cp
+}
+
+// Oplog returns the oplog metadata of op on the account.
+func (a *Account) Oplog(op oplog.OpType) oplog.Metadata {
+	return a.oplog(op)
+}
@@ -0,0 +1,268 @@
+package ldap
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"strings"
+	"text/template"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// Default attributes and group filter of an AuthMethod.
+const (
+	DefaultUserAttr    = "uid"
+	DefaultGroupAttr   = "cn"
+	DefaultGroupFilter = "(|(memberUid={{.Username}})(member={{.UserDN}})(uniqueMember={{.UserDN}}))"
+	DefaultEmailAttr   = "mail"
+	DefaultNameAttr    = "cn"
+)
+
+// An AuthMethod authenticates users by binding to an LDAP directory with
+// their login name and password. It contains accounts and is owned by a
+// scope.
+//
+// If a bind DN is set or DiscoverDn is true, the DN of a user is found by
+// searching below UserDn for the entry whose UserAttr matches the login
+// name. Otherwise the DN of a user is "<UserAttr>=<login name>,<UserDn>".
+//
+// If a GroupDn is set, the groups of a user are the entries below GroupDn
+// matching GroupFilter. The GroupMappings of the auth method map the groups
+// onto iam groups.
+type AuthMethod struct {
+	*store.AuthMethod
+	GroupMappings []*GroupMapping `gorm:"-"`
+	tableName     string
+}
+
+func allocAuthMethod() AuthMethod {
+	return AuthMethod{
+		AuthMethod: &store.AuthMethod{},
+	}
+}
+
+// NewAuthMethod creates a new in memory AuthMethod assigned to scopeId for
+// the directory servers at urls with user entries below userDn. Name,
+// description, start TLS, insecure TLS, certificate, bind credential,
+// discover DN, user attr, group DN, group attr, group filter, email attr,
+// name attr, and group mappings are the only valid options. All other
+// options are ignored.
+func NewAuthMethod(scopeId string, urls []string, userDn string, opt ...Option) (*AuthMethod, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("new: ldap auth method: no scope id: %w", db.ErrInvalidParameter)
+	}
+
+	opts := getOpts(opt...)
+	a := &AuthMethod{
+		AuthMethod: &store.AuthMethod{
+			ScopeId:     scopeId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			Urls:        strings.Join(urls, " "),
+			StartTls:    opts.withStartTls,
+			InsecureTls: opts.withInsecureTls,
+			Certificate: opts.withCertificate,
+			BindDn:      opts.withBindDn,
+			DiscoverDn:  opts.withDiscoverDn,
+			UserDn:      userDn,
+			UserAttr:    opts.withUserAttr,
+			GroupDn:     opts.withGroupDn,
+			GroupAttr:   opts.withGroupAttr,
+			GroupFilter: opts.withGroupFilter,
+			EmailAttr:   opts.withEmailAttr,
+			NameAttr:    opts.withNameAttr,
+		},
+		GroupMappings: opts.withGroupMappings,
+	}
+	if opts.withBindPassword != "" {
+		a.BindPassword = []byte(opts.withBindPassword)
+	}
+	return a, nil
+}
+
+// UrlList returns the URLs of the directory servers.
+func (a *AuthMethod) UrlList() []string {
+	return strings.Fields(a.GetUrls())
+}
+
+func (a *AuthMethod) clone() *AuthMethod {
+	cp := proto.Clone(a.AuthMethod)
+	n := &AuthMethod{
+		AuthMethod: cp.(*store.AuthMethod),
+	}
+	for _, m := range a.GroupMappings {
+		n.GroupMappings = append(n.GroupMappings, m.clone())
+	}
+	return n
+}
+
+// TableName returns the table name.
+func (a *AuthMethod) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return "auth_ldap_method"
+}
+
+// SetTableName sets the table name.
+func (a *AuthMethod) SetTableName(n string) {
+	a.tableName = n
+}
+
+func (a *AuthMethod) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	if err := structwrapping.WrapStruct(ctx, cipher, a.AuthMethod, nil); err != nil {
+		return fmt.Errorf("error encrypting ldap bind password: %w", err)
+	}
+	a.KeyId = cipher.KeyID()
+	return nil
+}
+
+func (a *AuthMethod) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	if err := structwrapping.UnwrapStruct(ctx, cipher, a.AuthMethod, nil); err != nil {
+		return fmt.Errorf("error decrypting ldap bind password: %w", err)
+	}
+	return nil
+}
+
+func (a *AuthMethod) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{a.GetPublicId()},
+		"resource-type":      []string{"ldap auth method"},
+		"op-type":            []string{op.String()},
+	}
+	if a.ScopeId != "" {
+		metadata["scope-id"] = []string{a.ScopeId}
+	}
+	return metadata
+}
+
+// A GroupMapping maps the directory group GroupName onto the iam group
+// GroupId. Users who authenticate with the auth method and are members of
+// the directory group are made members of the iam group.
+type GroupMapping struct {
+	*store.GroupMapping
+	tableName string
+}
+
+// NewGroupMapping creates a new in memory GroupMapping of the directory
+// group groupName onto the iam group groupId. The auth method and scope of
+// the mapping are set when it is stored with its auth method.
+func NewGroupMapping(groupName, groupId string) (*GroupMapping, error) {
+	if strings.TrimSpace(groupName) == "" {
+		return nil, fmt.Errorf("new: ldap group mapping: no group name: %w", db.ErrInvalidParameter)
+	}
+	if groupId == "" {
+		return nil, fmt.Errorf("new: ldap group mapping: no group id: %w", db.ErrInvalidParameter)
+	}
+	return &GroupMapping{
+		GroupMapping: &store.GroupMapping{
+			GroupName: groupName,
+			GroupId:   groupId,
+		},
+	}, nil
+}
+
+func (m *GroupMapping) clone() *GroupMapping {
+	cp := proto.Clone(m.GroupMapping)
+	return &GroupMapping{
+		GroupMapping: cp.(*store.GroupMapping),
+	}
+}
+
+// TableName returns the table name.
+func (m *GroupMapping) TableName() string {
+	if m.tableName != "" {
+		return m.tableName
+	}
+	return "auth_ldap_group_mapping"
+}
+
+// SetTableName sets the table name.
+func (m *GroupMapping) SetTableName(n string) {
+	m.tableName = n
+}
+
+// ValidateUrls returns an error wrapping ErrInvalidUrl if urls is empty or
+// any of urls is not an ldap:// or ldaps:// URL with a host.
+func ValidateUrls(urls []string) error {
+	if len(urls) == 0 {
+		return fmt.Errorf("no urls: %w", ErrInvalidUrl)
+	}
+	for _, u := range urls {
+		pu, err := url.Parse(u)
+		if err != nil {
+			return fmt.Errorf("%q: %v: %w", u, err, ErrInvalidUrl)
+		}
+		if pu.Scheme != "ldap" && pu.Scheme != "ldaps" {
+			return fmt.Errorf("%q: scheme must be ldap or ldaps: %w", u, ErrInvalidUrl)
+		}
+		if pu.Host == "" {
+			return fmt.Errorf("%q: no host: %w", u, ErrInvalidUrl)
+		}
+	}
+	return nil
+}
+
+// ValidateCertificate returns an error wrapping ErrInvalidCertificate if
+// cert is not empty and is not a PEM encoded x509 certificate.
+func ValidateCertificate(cert string) error {
+	if cert == "" {
+		return nil
+	}
+	block, _ := pem.Decode([]byte(cert))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return fmt.Errorf("no PEM encoded certificate: %w", ErrInvalidCertificate)
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return fmt.Errorf("%v: %w", err, ErrInvalidCertificate)
+	}
+	return nil
+}
+
+// ValidateGroupFilter returns an error wrapping ErrInvalidGroupFilter if
+// filter is not empty and is not a template which renders to a valid LDAP
+// search filter.
+func ValidateGroupFilter(filter string) error {
+	if filter == "" {
+		return nil
+	}
+	f, err := renderGroupFilter(filter, "cn=user,dc=example,dc=com", "user")
+	if err != nil {
+		return err
+	}
+	if _, err := goldap.CompileFilter(f); err != nil {
+		return fmt.Errorf("%v: %w", err, ErrInvalidGroupFilter)
+	}
+	return nil
+}
+
+// renderGroupFilter executes the group filter template filter for the user
+// with the DN userDn and the login name username. Both values are escaped.
+func renderGroupFilter(filter, userDn, username string) (string, error) {
+	t, err := template.New("group_filter").Option("missingkey=error").Parse(filter)
+	if err != nil {
+		return "", fmt.Errorf("%v: %w", err, ErrInvalidGroupFilter)
+	}
+	var buf bytes.Buffer
+	err = t.Execute(&buf, struct {
+		UserDN   string
+		Username string
+	}{
+		UserDN:   goldap.EscapeFilter(userDn),
+		Username: goldap.EscapeFilter(username),
+	})
+	if err != nil {
+		return "", fmt.Errorf("%v: %w", err, ErrInvalidGroupFilter)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,267 @@
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// dialTimeout bounds connecting to a directory server and each request to
+// it.
+const dialTimeout = 10 * time.Second
+
+// An entry is the result of authenticating a user with a directory.
+type entry struct {
+	dn       string
+	email    string
+	fullName string
+	groups   []string
+}
+
+// authenticate binds to the directory of am as the user with loginName and
+// password and returns the user's entry and the names of the user's groups.
+// am must have its bind password decrypted. The returned error wraps
+// ErrAuthenticationFailed if the directory rejects the credentials or the
+// login name does not match exactly one user entry.
+func authenticate(ctx context.Context, am *AuthMethod, loginName, password string) (*entry, error) {
+	if password == "" {
+		// An empty password would result in an unauthenticated bind which
+		// most directories accept for any DN.
+		return nil, fmt.Errorf("empty password: %w", ErrAuthenticationFailed)
+	}
+	conn, err := dial(ctx, am)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	search := am.GetBindDn() != "" || am.GetDiscoverDn()
+	if err := serviceBind(conn, am); err != nil {
+		return nil, err
+	}
+	var userDn string
+	if search {
+		userDn, err = searchUserDn(conn, am, loginName)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		userDn = fmt.Sprintf("%s=%s,%s", userAttr(am), escapeDn(loginName), am.GetUserDn())
+	}
+
+	if err := conn.Bind(userDn, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, fmt.Errorf("bind %q: %w", userDn, ErrAuthenticationFailed)
+		}
+		return nil, fmt.Errorf("bind %q: %w", userDn, err)
+	}
+	// Read the entry of the user and search for the groups as the bind DN
+	// if one is set, otherwise as the user.
+	if am.GetBindDn() != "" {
+		if err := serviceBind(conn, am); err != nil {
+			return nil, err
+		}
+	}
+
+	e := &entry{dn: userDn}
+	res, err := conn.Search(goldap.NewSearchRequest(
+		userDn, goldap.ScopeBaseObject, goldap.NeverDerefAliases, 1, int(dialTimeout.Seconds()), false,
+		"(objectClass=*)", []string{emailAttr(am), nameAttr(am)}, nil,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("read user entry %q: %w", userDn, err)
+	}
+	if len(res.Entries) == 1 {
+		e.email = res.Entries[0].GetAttributeValue(emailAttr(am))
+		e.fullName = res.Entries[0].GetAttributeValue(nameAttr(am))
+	}
+
+	if am.GetGroupDn() != "" {
+		e.groups, err = searchGroups(conn, am, userDn, loginName)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// dial connects to the first of the directory servers of am which can be
+// reached, upgrading the connection with StartTLS if am requires it.
+func dial(ctx context.Context, am *AuthMethod) (*goldap.Conn, error) {
+	urls := am.UrlList()
+	if err := ValidateUrls(urls); err != nil {
+		return nil, err
+	}
+	var errs []string
+	for _, u := range urls {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		conn, err := dialUrl(am, u)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		return conn, nil
+	}
+	return nil, fmt.Errorf("unable to connect to a directory server: %s", strings.Join(errs, "; "))
+}
+
+func dialUrl(am *AuthMethod, u string) (*goldap.Conn, error) {
+	pu, err := url.Parse(u)
+	if err != nil {
+		return nil, fmt.Errorf("%q: %w", u, ErrInvalidUrl)
+	}
+	tlsConfig, err := tlsConfig(am, pu.Hostname())
+	if err != nil {
+		return nil, err
+	}
+	conn, err := goldap.DialURL(u, goldap.DialWithDialer(&net.Dialer{Timeout: dialTimeout}), goldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, fmt.Errorf("%q: %w", u, err)
+	}
+	conn.SetTimeout(dialTimeout)
+	if pu.Scheme == "ldap" && am.GetStartTls() {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("%q: start tls: %w", u, err)
+		}
+	}
+	return conn, nil
+}
+
+func tlsConfig(am *AuthMethod, serverName string) (*tls.Config, error) {
+	c := &tls.Config{
+		ServerName:         serverName,
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: am.GetInsecureTls(),
+	}
+	if am.GetCertificate() != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(am.GetCertificate())) {
+			return nil, fmt.Errorf("no PEM encoded certificate: %w", ErrInvalidCertificate)
+		}
+		c.RootCAs = pool
+	}
+	return c, nil
+}
+
+// serviceBind binds as the bind DN of am if it is set. Otherwise the
+// connection remains anonymous.
+func serviceBind(conn *goldap.Conn, am *AuthMethod) error {
+	if am.GetBindDn() == "" {
+		return nil
+	}
+	if err := conn.Bind(am.GetBindDn(), string(am.GetBindPassword())); err != nil {
+		return fmt.Errorf("bind as bind dn %q: %w", am.GetBindDn(), err)
+	}
+	return nil
+}
+
+// searchUserDn returns the DN of the only entry below the user DN of am
+// whose user attribute matches loginName.
+func searchUserDn(conn *goldap.Conn, am *AuthMethod, loginName string) (string, error) {
+	filter := fmt.Sprintf("(%s=%s)", userAttr(am), goldap.EscapeFilter(loginName))
+	res, err := conn.Search(goldap.NewSearchRequest(
+		am.GetUserDn(), goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 2, int(dialTimeout.Seconds()), false,
+		filter, []string{"dn"}, nil,
+	))
+	if err != nil && !goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) {
+		return "", fmt.Errorf("search for user %q: %w", loginName, err)
+	}
+	if res == nil || len(res.Entries) != 1 {
+		return "", fmt.Errorf("search for user %q: no unique entry: %w", loginName, ErrAuthenticationFailed)
+	}
+	return res.Entries[0].DN, nil
+}
+
+// searchGroups returns the names of the groups below the group DN of am
+// which match the group filter of am for the user with userDn and
+// loginName.
+func searchGroups(conn *goldap.Conn, am *AuthMethod, userDn, loginName string) ([]string, error) {
+	filter, err := renderGroupFilter(groupFilter(am), userDn, loginName)
+	if err != nil {
+		return nil, err
+	}
+	res, err := conn.Search(goldap.NewSearchRequest(
+		am.GetGroupDn(), goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 0, int(dialTimeout.Seconds()), false,
+		filter, []string{groupAttr(am)}, nil,
+	))
+	if err != nil {
+		var lerr *goldap.Error
+		if errors.As(err, &lerr) && lerr.ResultCode == goldap.LDAPResultNoSuchObject {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("search for groups of %q: %w", userDn, err)
+	}
+	var groups []string
+	for _, e := range res.Entries {
+		if name := e.GetAttributeValue(groupAttr(am)); name != "" {
+			groups = append(groups, name)
+		}
+	}
+	return groups, nil
+}
+
+// escapeDn escapes the special characters of an attribute value in a DN as
+// described in RFC 4514.
+func escapeDn(v string) string {
+	var b strings.Builder
+	for i, c := range v {
+		switch {
+		case strings.ContainsRune(`,+"\<>;=`, c),
+			i == 0 && (c == ' ' || c == '#'),
+			i == len(v)-1 && c == ' ':
+			b.WriteRune('\\')
+			b.WriteRune(c)
+		case c == 0:
+			b.WriteString(`\00`)
+		default:
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+func userAttr(am *AuthMethod) string {
+	if am.GetUserAttr() == "" {
+		return DefaultUserAttr
+	}
+	return am.GetUserAttr()
+}
+
+func groupAttr(am *AuthMethod) string {
+	if am.GetGroupAttr() == "" {
+		return DefaultGroupAttr
+	}
+	return am.GetGroupAttr()
+}
+
+func groupFilter(am *AuthMethod) string {
+	if am.GetGroupFilter() == "" {
+		return DefaultGroupFilter
+	}
+	return am.GetGroupFilter()
+}
+
+func emailAttr(am *AuthMethod) string {
+	if am.GetEmailAttr() == "" {
+		return DefaultEmailAttr
+	}
+	return am.GetEmailAttr()
+}
+
+func nameAttr(am *AuthMethod) string {
+	if am.GetNameAttr() == "" {
+		return DefaultNameAttr
+	}
+	return am.GetNameAttr()
+}
@@ -0,0 +1,220 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_authenticate(t *testing.T) {
+	t.Parallel()
+	td := NewTestDirectory(t)
+	aliceDn := td.AddUser(t, "alice", "alice-pass", "Alice Doe", "alice@example.com")
+	bobDn := td.AddUser(t, "bob", "bob-pass", "", "")
+	td.AddUser(t, "svc", "svc-pass", "", "")
+	td.AddGroup(t, "admins", aliceDn)
+	td.AddGroup(t, "devs", aliceDn, bobDn)
+	svcDn := "uid=svc," + TestUserDn
+
+	tests := []struct {
+		name       string
+		am         func() *AuthMethod
+		loginName  string
+		password   string
+		wantDn     string
+		wantEmail  string
+		wantName   string
+		wantGroups []string
+		wantErr    error
+		wantAnyErr bool
+	}{
+		{
+			name: "direct-bind",
+			am: func() *AuthMethod {
+				am, _ := NewAuthMethod("o_1234567890", []string{td.Url()}, TestUserDn)
+				return am
+			},
+			loginName: "alice",
+			password:  "alice-pass",
+			wantDn:    aliceDn,
+			wantEmail: "alice@example.com",
+			wantName:  "Alice Doe",
+		},
+		{
+			name: "search-bind-with-groups",
+			am: func() *AuthMethod {
+				am, _ := NewAuthMethod("o_1234567890", []string{td.Url()}, TestUserDn,
+					WithBindCredential(svcDn, "svc-pass"), WithGroupDn(TestGroupDn))
+				return am
+			},
+			loginName:  "alice",
+			password:   "alice-pass",
+			wantDn:     aliceDn,
+			wantEmail:  "alice@example.com",
+			wantName:   "Alice Doe",
+			wantGroups: []string{"admins", "devs"},
+		},
+		{
+			name: "anonymous-search-bind",
+			am: func() *AuthMethod {
+				am, _ := NewAuthMethod("o_1234567890", []string{td.Url()}, TestUserDn,
+					WithDiscoverDn(true), WithGroupDn(TestGroupDn))
+				return am
+			},
+			loginName:  "bob",
+			password:   "bob-pass",
+			wantDn:     bobDn,
+			wantGroups: []string{"devs"},
+		},
+		{
+			name: "start-tls",
+			am: func() *AuthMethod {
+				am, _ := NewAuthMethod("o_1234567890", []string{td.Url()}, TestUserDn,
+					WithStartTls(true), WithCertificate(td.Certificate()))
+				return am
+			},
+			loginName: "bob",
+			password:  "bob-pass",
+			wantDn:    bobDn,
+		},
+		{
+			name: "ldaps",
+			am: func() *AuthMethod {
+				am, _ := NewAuthMethod("o_1234567890", []string{td.TlsUrl()}, TestUserDn,
+					WithCertificate(td.Certificate()))
+				return am
+			},
+			loginName: "bob",
+			password:  "bob-pass",
+			wantDn:    bobDn,
+		},
+		{
+			name: "ldaps-insecure",
+			am: func() *AuthMethod {
+				am, _ := NewAuthMethod("o_1234567890", []string{td.TlsUrl()}, TestUserDn,
+					WithInsecureTls(true))
+				return am
+			},
+			loginName: "bob",
+			password:  "bob-pass",
+			wantDn:    bobDn,
+		},
+		{
+			name: "ldaps-unknown-certificate",
+			am: func() *AuthMethod {
+				am, _ := NewAuthMethod("o_1234567890", []string{td.TlsUrl()}, TestUserDn)
+				return am
+			},
+			loginName:  "bob",
+			password:   "bob-pass",
+			wantAnyErr: true,
+		},
+		{
+			name: "first-reachable-url",
+			am: func() *AuthMethod {
+				am, _ := NewAuthMethod("o_1234567890", []string{"ldap://127.0.0.1:1", td.Url()}, TestUserDn)
+				return am
+			},
+			loginName: "bob",
+			password:  "bob-pass",
+			wantDn:    bobDn,
+		},
+		{
+			name: "bad-password",
+			am: func() *AuthMethod {
+				am, _ := NewAuthMethod("o_1234567890", []string{td.Url()}, TestUserDn)
+				return am
+			},
+			loginName: "alice",
+			password:  "bob-pass",
+			wantErr:   ErrAuthenticationFailed,
+		},
+		{
+			name: "empty-password",
+			am: func() *AuthMethod {
+				am, _ := NewAuthMethod("o_1234567890", []string{td.Url()}, TestUserDn)
+				return am
+			},
+			loginName: "alice",
+			wantErr:   ErrAuthenticationFailed,
+		},
+		{
+			name: "unknown-user",
+			am: func() *AuthMethod {
+				am, _ := NewAuthMethod("o_1234567890", []string{td.Url()}, TestUserDn,
+					WithBindCredential(svcDn, "svc-pass"))
+				return am
+			},
+			loginName: "carol",
+			password:  "carol-pass",
+			wantErr:   ErrAuthenticationFailed,
+		},
+		{
+			name: "bad-bind-password",
+			am: func() *AuthMethod {
+				am, _ := NewAuthMethod("o_1234567890", []string{td.Url()}, TestUserDn,
+					WithBindCredential(svcDn, "wrong"))
+				return am
+			},
+			loginName:  "alice",
+			password:   "alice-pass",
+			wantAnyErr: true,
+		},
+		{
+			name: "filter-injection",
+			am: func() *AuthMethod {
+				am, _ := NewAuthMethod("o_1234567890", []string{td.Url()}, TestUserDn,
+					WithDiscoverDn(true))
+				return am
+			},
+			loginName: "*",
+			password:  "alice-pass",
+			wantErr:   ErrAuthenticationFailed,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			e, err := authenticate(context.Background(), tt.am(), tt.loginName, tt.password)
+			if tt.wantErr != nil {
+				assert.Truef(errors.Is(err, tt.wantErr), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(e)
+				return
+			}
+			if tt.wantAnyErr {
+				assert.Error(err)
+				assert.False(errors.Is(err, ErrAuthenticationFailed))
+				assert.Nil(e)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantDn, e.dn)
+			assert.Equal(tt.wantEmail, e.email)
+			assert.Equal(tt.wantName, e.fullName)
+			assert.ElementsMatch(tt.wantGroups, e.groups)
+		})
+	}
+}
+
+func Test_escapeDn(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "alice", want: "alice"},
+		{in: "doe, jane", want: `doe\, jane`},
+		{in: " #lead", want: `\ #lead`},
+		{in: "#lead", want: `\#lead`},
+		{in: "trail ", want: `trail\ `},
+		{in: `a+b="c"<d>;\`, want: `a\+b\=\"c\"\<d\>\;\\`},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, escapeDn(tt.in), tt.in)
+	}
+}
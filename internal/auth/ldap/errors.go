@@ -0,0 +1,22 @@
+package ldap
+
+import "errors"
+
+var (
+	// ErrInvalidUrl results from attempting to set the URL of a directory
+	// server which is not an ldap:// or ldaps:// URL with a host.
+	ErrInvalidUrl = errors.New("invalid url")
+
+	// ErrInvalidCertificate results from attempting to set a CA certificate
+	// which is not a PEM encoded x509 certificate.
+	ErrInvalidCertificate = errors.New("invalid certificate")
+
+	// ErrInvalidGroupFilter results from attempting to set a group filter
+	// which is not a valid template of an LDAP search filter.
+	ErrInvalidGroupFilter = errors.New("invalid group filter")
+
+	// ErrAuthenticationFailed results from the directory rejecting the
+	// credentials of a user or from the login name not matching exactly one
+	// user entry.
+	ErrAuthenticationFailed = errors.New("authentication failed")
+)
@@ -0,0 +1,199 @@
+package ldap
+
+import "github.com/hashicorp/boundary/internal/db"
+
+// getOpts - iterate the inbound Options and return a struct.
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName          string
+	withDescription   string
+	withLimit         int
+	withPageAfter     *db.PageAfter
+	withPublicId      string
+	withStartTls      bool
+	withInsecureTls   bool
+	withCertificate   string
+	withBindDn        string
+	withBindPassword  string
+	withDiscoverDn    bool
+	withUserAttr      string
+	withGroupDn       string
+	withGroupAttr     string
+	withGroupFilter   string
+	withEmailAttr     string
+	withNameAttr      string
+	withGroupMappings []*GroupMapping
+	withDn            string
+	withEmail         string
+	withFullName      string
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithPublicId provides an optional public id
+func WithPublicId(id string) Option {
+	return func(o *options) {
+		o.withPublicId = id
+	}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit.  Intentionally allowing
+// negative integers.   If WithLimit < 0, then unlimited results are returned.
+// If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithPageAfter provides an option to list only the items after the given
+// position, ordered by create time and public id, so that a list can be paged
+// through with WithLimit.
+func WithPageAfter(after *db.PageAfter) Option {
+	return func(o *options) {
+		o.withPageAfter = after
+	}
+}
+
+// WithStartTls provides an option to upgrade connections to ldap:// URLs
+// with StartTLS.
+func WithStartTls(b bool) Option {
+	return func(o *options) {
+		o.withStartTls = b
+	}
+}
+
+// WithInsecureTls provides an option to skip the verification of the
+// certificates of the directory servers.
+func WithInsecureTls(b bool) Option {
+	return func(o *options) {
+		o.withInsecureTls = b
+	}
+}
+
+// WithCertificate provides an optional PEM encoded CA certificate.
+func WithCertificate(pem string) Option {
+	return func(o *options) {
+		o.withCertificate = pem
+	}
+}
+
+// WithBindCredential provides an optional DN and password to bind as when
+// searching for the DN of a user.
+func WithBindCredential(dn, password string) Option {
+	return func(o *options) {
+		o.withBindDn = dn
+		o.withBindPassword = password
+	}
+}
+
+// WithDiscoverDn provides an option to search for the DN of a user with an
+// anonymous bind.
+func WithDiscoverDn(b bool) Option {
+	return func(o *options) {
+		o.withDiscoverDn = b
+	}
+}
+
+// WithUserAttr provides an optional attribute of a user entry matched
+// against the login name.
+func WithUserAttr(attr string) Option {
+	return func(o *options) {
+		o.withUserAttr = attr
+	}
+}
+
+// WithGroupDn provides an optional base DN of the group entries.
+func WithGroupDn(dn string) Option {
+	return func(o *options) {
+		o.withGroupDn = dn
+	}
+}
+
+// WithGroupAttr provides an optional attribute of a group entry used as
+// the name of the group.
+func WithGroupAttr(attr string) Option {
+	return func(o *options) {
+		o.withGroupAttr = attr
+	}
+}
+
+// WithGroupFilter provides an optional template of the filter used to
+// search for the groups of a user.
+func WithGroupFilter(filter string) Option {
+	return func(o *options) {
+		o.withGroupFilter = filter
+	}
+}
+
+// WithEmailAttr provides an optional attribute of a user entry mapped to
+// the email of an account.
+func WithEmailAttr(attr string) Option {
+	return func(o *options) {
+		o.withEmailAttr = attr
+	}
+}
+
+// WithNameAttr provides an optional attribute of a user entry mapped to the
+// full name of an account.
+func WithNameAttr(attr string) Option {
+	return func(o *options) {
+		o.withNameAttr = attr
+	}
+}
+
+// WithGroupMappings provides optional mappings of directory groups onto iam
+// groups.
+func WithGroupMappings(m ...*GroupMapping) Option {
+	return func(o *options) {
+		o.withGroupMappings = m
+	}
+}
+
+// WithDn provides an optional DN.
+func WithDn(dn string) Option {
+	return func(o *options) {
+		o.withDn = dn
+	}
+}
+
+// WithEmail provides an optional email.
+func WithEmail(email string) Option {
+	return func(o *options) {
+		o.withEmail = email
+	}
+}
+
+// WithFullName provides an optional full name.
+func WithFullName(name string) Option {
+	return func(o *options) {
+		o.withFullName = name
+	}
+}
@@ -0,0 +1,135 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithPublicId", func(t *testing.T) {
+		opts := getOpts(WithPublicId("test id"))
+		testOpts := getDefaultOptions()
+		testOpts.withPublicId = "test id"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithName", func(t *testing.T) {
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		opts := getOpts(WithLimit(5))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithPageAfter", func(t *testing.T) {
+		after := &db.PageAfter{PublicId: "test"}
+		opts := getOpts(WithPageAfter(after))
+		testOpts := getDefaultOptions()
+		testOpts.withPageAfter = after
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithStartTls", func(t *testing.T) {
+		opts := getOpts(WithStartTls(true))
+		testOpts := getDefaultOptions()
+		testOpts.withStartTls = true
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithInsecureTls", func(t *testing.T) {
+		opts := getOpts(WithInsecureTls(true))
+		testOpts := getDefaultOptions()
+		testOpts.withInsecureTls = true
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithBindCredential", func(t *testing.T) {
+		opts := getOpts(WithBindCredential("cn=admin,dc=example,dc=com", "secret"))
+		testOpts := getDefaultOptions()
+		testOpts.withBindDn = "cn=admin,dc=example,dc=com"
+		testOpts.withBindPassword = "secret"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithCertificate", func(t *testing.T) {
+		opts := getOpts(WithCertificate("pem"))
+		testOpts := getDefaultOptions()
+		testOpts.withCertificate = "pem"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDiscoverDn", func(t *testing.T) {
+		opts := getOpts(WithDiscoverDn(true))
+		testOpts := getDefaultOptions()
+		testOpts.withDiscoverDn = true
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithUserAttr", func(t *testing.T) {
+		opts := getOpts(WithUserAttr("sAMAccountName"))
+		testOpts := getDefaultOptions()
+		testOpts.withUserAttr = "sAMAccountName"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithGroupDn", func(t *testing.T) {
+		opts := getOpts(WithGroupDn("ou=groups,dc=example,dc=com"))
+		testOpts := getDefaultOptions()
+		testOpts.withGroupDn = "ou=groups,dc=example,dc=com"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithGroupAttr", func(t *testing.T) {
+		opts := getOpts(WithGroupAttr("ou"))
+		testOpts := getDefaultOptions()
+		testOpts.withGroupAttr = "ou"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithGroupFilter", func(t *testing.T) {
+		opts := getOpts(WithGroupFilter("(member={{.UserDN}})"))
+		testOpts := getDefaultOptions()
+		testOpts.withGroupFilter = "(member={{.UserDN}})"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithEmailAttr", func(t *testing.T) {
+		opts := getOpts(WithEmailAttr("userPrincipalName"))
+		testOpts := getDefaultOptions()
+		testOpts.withEmailAttr = "userPrincipalName"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithNameAttr", func(t *testing.T) {
+		opts := getOpts(WithNameAttr("displayName"))
+		testOpts := getDefaultOptions()
+		testOpts.withNameAttr = "displayName"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithGroupMappings", func(t *testing.T) {
+		m := &GroupMapping{}
+		opts := getOpts(WithGroupMappings(m))
+		testOpts := getDefaultOptions()
+		testOpts.withGroupMappings = []*GroupMapping{m}
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDn", func(t *testing.T) {
+		opts := getOpts(WithDn("uid=alice,dc=example,dc=com"))
+		testOpts := getDefaultOptions()
+		testOpts.withDn = "uid=alice,dc=example,dc=com"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithEmail", func(t *testing.T) {
+		opts := getOpts(WithEmail("alice@example.com"))
+		testOpts := getDefaultOptions()
+		testOpts.withEmail = "alice@example.com"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithFullName", func(t *testing.T) {
+		opts := getOpts(WithFullName("Alice"))
+		testOpts := getDefaultOptions()
+		testOpts.withFullName = "Alice"
+		assert.Equal(t, opts, testOpts)
+	})
+}
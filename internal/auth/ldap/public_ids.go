@@ -0,0 +1,29 @@
+package ldap
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// PublicId prefixes for the resources in the ldap package.
+const (
+	AuthMethodPrefix = "amldap"
+	AccountPrefix    = "acctldap"
+)
+
+func newAuthMethodId() (string, error) {
+	id, err := db.NewPublicId(AuthMethodPrefix)
+	if err != nil {
+		return "", fmt.Errorf("new ldap auth method id: %w", err)
+	}
+	return id, err
+}
+
+func newAccountId() (string, error) {
+	id, err := db.NewPublicId(AccountPrefix)
+	if err != nil {
+		return "", fmt.Errorf("new ldap account id: %w", err)
+	}
+	return id, err
+}
@@ -0,0 +1,250 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	dbcommon "github.com/hashicorp/boundary/internal/db/common"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// CreateAccount inserts a into the repository and returns a new Account
+// containing the account's PublicId. a is not changed. a must contain a
+// valid AuthMethodId and LoginName. a must not contain a PublicId. The
+// PublicId is generated and assigned by this method.
+//
+// Accounts are created automatically the first time a user authenticates.
+// CreateAccount is used to create an account for a login name ahead of
+// time, for example to associate it with a user before the user first
+// authenticates.
+//
+// Both a.Name and a.Description are optional. If a.Name is set, it must be
+// unique within a.AuthMethodId. a.LoginName must be unique within
+// a.AuthMethodId. All options are ignored.
+func (r *Repository) CreateAccount(ctx context.Context, scopeId string, a *Account, opt ...Option) (*Account, error) {
+	if a == nil {
+		return nil, fmt.Errorf("create: ldap account: %w", db.ErrInvalidParameter)
+	}
+	if a.Account == nil {
+		return nil, fmt.Errorf("create: ldap account: embedded Account: %w", db.ErrInvalidParameter)
+	}
+	if a.AuthMethodId == "" {
+		return nil, fmt.Errorf("create: ldap account: no auth method id: %w", db.ErrInvalidParameter)
+	}
+	if a.PublicId != "" {
+		return nil, fmt.Errorf("create: ldap account: public id not empty: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return nil, fmt.Errorf("create: ldap account: scope id empty: %w", db.ErrInvalidParameter)
+	}
+	if strings.TrimSpace(a.LoginName) == "" {
+		return nil, fmt.Errorf("create: ldap account: no login name: %w", db.ErrInvalidParameter)
+	}
+
+	a = a.clone()
+	id, err := newAccountId()
+	if err != nil {
+		return nil, fmt.Errorf("create: ldap account: %w", err)
+	}
+	a.PublicId = id
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, fmt.Errorf("create: ldap account: unable to get oplog wrapper: %w", err)
+	}
+
+	var newAccount *Account
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newAccount = a.clone()
+			return w.Create(ctx, newAccount, db.WithOplog(oplogWrapper, a.oplog(oplog.OpType_OP_TYPE_CREATE)))
+		},
+	)
+
+	if err != nil {
+		if db.IsUniqueError(err) {
+			return nil, fmt.Errorf("create: ldap account: in auth method: %s: name %q or login name %q already exists: %w",
+				a.AuthMethodId, a.Name, a.LoginName, db.ErrNotUnique)
+		}
+		return nil, fmt.Errorf("create: ldap account: in auth method: %s: %w", a.AuthMethodId, err)
+	}
+	return newAccount, nil
+}
+
+// LookupAccount will look up an account in the repository.  If the account is not
+// found, it will return nil, nil.  All options are ignored.
+func (r *Repository) LookupAccount(ctx context.Context, withPublicId string, opt ...Option) (*Account, error) {
+	if withPublicId == "" {
+		return nil, fmt.Errorf("lookup: ldap account: missing public id %w", db.ErrInvalidParameter)
+	}
+	a := allocAccount()
+	a.PublicId = withPublicId
+	if err := r.reader.LookupByPublicId(ctx, a); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("lookup: ldap account: failed %w for %s", err, withPublicId)
+	}
+	return a, nil
+}
+
+// lookupAccountByLoginName returns the account for loginName in authMethodId.
+// If the account is not found, it will return nil, nil.
+func (r *Repository) lookupAccountByLoginName(ctx context.Context, authMethodId, loginName string) (*Account, error) {
+	a := allocAccount()
+	if err := r.reader.LookupWhere(ctx, a, "auth_method_id = ? and login_name = ?", authMethodId, loginName); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("lookup: ldap account: login name %q in %s: %w", loginName, authMethodId, err)
+	}
+	return a, nil
+}
+
+// ListAccounts in an auth method and supports the WithLimit and WithPageAfter
+// options.
+func (r *Repository) ListAccounts(ctx context.Context, withAuthMethodId string, opt ...Option) ([]*Account, error) {
+	if withAuthMethodId == "" {
+		return nil, fmt.Errorf("list: ldap account: missing auth method id %w", db.ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var accts []*Account
+	err := r.reader.SearchWhere(ctx, &accts, "auth_method_id = ?", []interface{}{withAuthMethodId}, db.WithLimit(limit), db.WithPageAfter(opts.withPageAfter))
+	if err != nil {
+		return nil, fmt.Errorf("list: ldap account: %w", err)
+	}
+	return accts, nil
+}
+
+// DeleteAccount deletes the account for the provided id from the repository returning a count of the
+// number of records deleted.  All options are ignored.
+func (r *Repository) DeleteAccount(ctx context.Context, scopeId, withPublicId string, opt ...Option) (int, error) {
+	if withPublicId == "" {
+		return db.NoRowsAffected, fmt.Errorf("delete: ldap account: missing public id: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return db.NoRowsAffected, fmt.Errorf("delete: ldap account: scope id empty: %w", db.ErrInvalidParameter)
+	}
+	ac := allocAccount()
+	ac.PublicId = withPublicId
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("delete: ldap account: unable to get oplog wrapper: %w", err)
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			metadata := ac.oplog(oplog.OpType_OP_TYPE_DELETE)
+			dAc := ac.clone()
+			rowsDeleted, err = w.Delete(ctx, dAc, db.WithOplog(oplogWrapper, metadata))
+			if err == nil && rowsDeleted > 1 {
+				return db.ErrMultipleRecords
+			}
+			return err
+		},
+	)
+
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("delete: ldap account: %s: %w", withPublicId, err)
+	}
+
+	return rowsDeleted, nil
+}
+
+// UpdateAccount updates the repository entry for a.PublicId with the
+// values in a for the fields listed in fieldMaskPaths. It returns a new
+// Account containing the updated values and a count of the number of
+// records updated. a is not changed.
+//
+// a must contain a valid PublicId. Only a.Name and a.Description can be
+// updated; the login name of an account cannot be changed and its DN,
+// email, and full name are set from the directory. If a.Name is set to a
+// non-empty string, it must be unique within a.AuthMethodId.
+//
+// An attribute of a will be set to NULL in the database if the attribute
+// in a is the zero value and it is included in fieldMaskPaths.
+func (r *Repository) UpdateAccount(ctx context.Context, scopeId string, a *Account, version uint32, fieldMaskPaths []string, opt ...Option) (*Account, int, error) {
+	if a == nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap account: %w", db.ErrInvalidParameter)
+	}
+	if a.Account == nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap account: embedded Account: %w", db.ErrInvalidParameter)
+	}
+	if a.PublicId == "" {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap account: missing public id: %w", db.ErrInvalidParameter)
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap account: no version supplied: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap account: scope id empty: %w", db.ErrInvalidParameter)
+	}
+
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold("Name", f):
+		case strings.EqualFold("Description", f):
+		default:
+			return nil, db.NoRowsAffected, fmt.Errorf("update: ldap account: field: %s: %w", f, db.ErrInvalidFieldMask)
+		}
+	}
+	var dbMask, nullFields []string
+	dbMask, nullFields = dbcommon.BuildUpdatePaths(
+		map[string]interface{}{
+			"Name":        a.Name,
+			"Description": a.Description,
+		},
+		fieldMaskPaths,
+		nil,
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap account: %w", db.ErrEmptyFieldMask)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap account: unable to get oplog wrapper: %w", err)
+	}
+
+	a = a.clone()
+
+	metadata := a.oplog(oplog.OpType_OP_TYPE_UPDATE)
+
+	var rowsUpdated int
+	var returnedAccount *Account
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedAccount = a.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedAccount, dbMask, nullFields, db.WithOplog(oplogWrapper, metadata), db.WithVersion(&version))
+			if err == nil && rowsUpdated > 1 {
+				return db.ErrMultipleRecords
+			}
+			return err
+		},
+	)
+
+	if err != nil {
+		if db.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, fmt.Errorf("update: ldap account: %s: name %s already exists: %w",
+				a.PublicId, a.Name, db.ErrNotUnique)
+		}
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap account: %s: %w", a.PublicId, err)
+	}
+
+	return returnedAccount, rowsUpdated, nil
+}
@@ -0,0 +1,121 @@
+package ldap
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/external"
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// Authenticate binds to the directory of the auth method authMethodId in
+// scopeId as the user with loginName and password.
+//
+// The Account for loginName is returned along with the names of the
+// directory groups the user is a member of. If loginName has not
+// authenticated with the auth method before, a new Account is created. The
+// DN, email, and full name of the Account are updated from the user's
+// entry in the directory.
+//
+// The returned error wraps ErrAuthenticationFailed if the directory rejects
+// the credentials or loginName does not match exactly one user entry. All
+// options are ignored.
+func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string, opt ...Option) (*Account, []string, error) {
+	if authMethodId == "" {
+		return nil, nil, fmt.Errorf("ldap authenticate: no authMethodId: %w", db.ErrInvalidParameter)
+	}
+	loginName = strings.ToLower(strings.TrimSpace(loginName))
+	if loginName == "" {
+		return nil, nil, fmt.Errorf("ldap authenticate: no login name: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return nil, nil, fmt.Errorf("ldap authenticate: no scopeId: %w", db.ErrInvalidParameter)
+	}
+
+	am, err := r.lookupAuthMethodWithSecret(ctx, scopeId, authMethodId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ldap authenticate: %w", err)
+	}
+	if am == nil {
+		return nil, nil, fmt.Errorf("ldap authenticate: auth method %s: %w", authMethodId, db.ErrRecordNotFound)
+	}
+	e, err := authenticate(ctx, am, loginName, password)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ldap authenticate: %w", err)
+	}
+
+	acct, err := r.upsertAccount(ctx, scopeId, am, loginName, e)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ldap authenticate: %w", err)
+	}
+	return acct, e.groups, nil
+}
+
+// MappedGroups returns the ids of the iam groups the group mappings of the
+// auth method authMethodId map the directory groups onto. memberOf
+// contains the iam groups mapped from any of groups and notMemberOf
+// contains the other mapped iam groups. Directory group names are compared
+// case insensitively. All options are ignored.
+func (r *Repository) MappedGroups(ctx context.Context, authMethodId string, groups []string, opt ...Option) (memberOf []string, notMemberOf []string, err error) {
+	if authMethodId == "" {
+		return nil, nil, fmt.Errorf("ldap mapped groups: no authMethodId: %w", db.ErrInvalidParameter)
+	}
+	mappings, err := r.listGroupMappings(ctx, "auth_method_id = ?", authMethodId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ldap mapped groups: %w", err)
+	}
+	inGroup := make(map[string]bool, len(groups))
+	for _, g := range groups {
+		inGroup[strings.ToLower(g)] = true
+	}
+	member := make(map[string]bool)
+	for _, m := range mappings[authMethodId] {
+		if inGroup[strings.ToLower(m.GroupName)] {
+			member[m.GroupId] = true
+		} else if !member[m.GroupId] {
+			member[m.GroupId] = false
+		}
+	}
+	for id, ok := range member {
+		if ok {
+			memberOf = append(memberOf, id)
+		} else {
+			notMemberOf = append(notMemberOf, id)
+		}
+	}
+	sort.Strings(memberOf)
+	sort.Strings(notMemberOf)
+	return memberOf, notMemberOf, nil
+}
+
+// upsertAccount returns the account for loginName, creating it if it does
+// not exist and updating its DN, email, and full name from e if they have
+// changed.
+func (r *Repository) upsertAccount(ctx context.Context, scopeId string, am *AuthMethod, loginName string, e *entry) (*Account, error) {
+	a, err := NewAccount(am.PublicId, loginName, WithDn(e.dn), WithEmail(e.email), WithFullName(e.fullName))
+	if err != nil {
+		return nil, err
+	}
+	acct, err := external.UpsertAccount(ctx, r.writer, r.kms, scopeId, a.Attributes(),
+		func(ctx context.Context) (external.Account, error) {
+			acct, err := r.lookupAccountByLoginName(ctx, am.PublicId, loginName)
+			if err != nil || acct == nil {
+				return nil, err
+			}
+			return acct, nil
+		},
+		func(ctx context.Context) (external.Account, error) {
+			acct, err := r.CreateAccount(ctx, scopeId, a)
+			if err != nil {
+				return nil, err
+			}
+			return acct, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return acct.(*Account), nil
+}
@@ -0,0 +1,86 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Authenticate(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	td := NewTestDirectory(t)
+	aliceDn := td.AddUser(t, "alice", "alice-pass", "Alice", "alice@example.com")
+	td.AddGroup(t, "admins", aliceDn)
+	td.AddGroup(t, "devs")
+
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+	in, err := NewAuthMethod(org.PublicId, []string{td.Url()}, TestUserDn, WithGroupDn(TestGroupDn))
+	require.NoError(err)
+	am, err := repo.CreateAuthMethod(ctx, in)
+	require.NoError(err)
+
+	acct, groups, err := repo.Authenticate(ctx, org.PublicId, am.PublicId, "Alice", "alice-pass")
+	require.NoError(err)
+	assert.Equal(am.PublicId, acct.AuthMethodId)
+	assert.Equal("alice", acct.LoginName)
+	assert.Equal(aliceDn, acct.Dn)
+	assert.Equal("alice@example.com", acct.Email)
+	assert.Equal("Alice", acct.FullName)
+	assert.Equal([]string{"admins"}, groups)
+
+	// Authenticating again returns the same account.
+	again, _, err := repo.Authenticate(ctx, org.PublicId, am.PublicId, "alice", "alice-pass")
+	require.NoError(err)
+	assert.Equal(acct.PublicId, again.PublicId)
+
+	_, _, err = repo.Authenticate(ctx, org.PublicId, am.PublicId, "alice", "wrong")
+	assert.Truef(errors.Is(err, ErrAuthenticationFailed), "want err: %q got: %q", ErrAuthenticationFailed, err)
+}
+
+func TestRepository_MappedGroups(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	admins := iam.TestGroup(t, conn, org.PublicId)
+	devs := iam.TestGroup(t, conn, org.PublicId)
+
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+	m1, err := NewGroupMapping("Admins", admins.PublicId)
+	require.NoError(err)
+	m2, err := NewGroupMapping("devs", devs.PublicId)
+	require.NoError(err)
+	in, err := NewAuthMethod(org.PublicId, []string{"ldaps://ldap.example.com"}, TestUserDn, WithGroupMappings(m1, m2))
+	require.NoError(err)
+	am, err := repo.CreateAuthMethod(ctx, in)
+	require.NoError(err)
+
+	memberOf, notMemberOf, err := repo.MappedGroups(ctx, am.PublicId, []string{"admins", "other"})
+	require.NoError(err)
+	assert.Equal([]string{admins.PublicId}, memberOf)
+	assert.Equal([]string{devs.PublicId}, notMemberOf)
+
+	memberOf, notMemberOf, err = repo.MappedGroups(ctx, am.PublicId, nil)
+	require.NoError(err)
+	assert.Empty(memberOf)
+	assert.ElementsMatch([]string{admins.PublicId, devs.PublicId}, notMemberOf)
+}
@@ -0,0 +1,490 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	dbcommon "github.com/hashicorp/boundary/internal/db/common"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// CreateAuthMethod inserts m into the repository and returns a new
+// AuthMethod containing the auth method's PublicId. m is not changed. m must
+// contain a valid ScopeId, Urls, and UserDn. m must not contain a PublicId.
+// The PublicId is generated and assigned by this method. If m contains a
+// BindPassword it must also contain a BindDn. The bind password is
+// encrypted before it is stored and it is not included in the returned
+// AuthMethod.
+//
+// The GroupMappings of m are created with the auth method. The iam groups
+// of the mappings must be in m.ScopeId.
+//
+// WithPublicId is the only valid option. All other options are ignored.
+//
+// Both m.Name and m.Description are optional. If m.Name is set, it must be
+// unique within m.ScopeId.
+func (r *Repository) CreateAuthMethod(ctx context.Context, m *AuthMethod, opt ...Option) (*AuthMethod, error) {
+	if m == nil {
+		return nil, fmt.Errorf("create: ldap auth method: %w", db.ErrInvalidParameter)
+	}
+	if m.AuthMethod == nil {
+		return nil, fmt.Errorf("create: ldap auth method: embedded AuthMethod: %w", db.ErrInvalidParameter)
+	}
+	if m.ScopeId == "" {
+		return nil, fmt.Errorf("create: ldap auth method: no scope id: %w", db.ErrInvalidParameter)
+	}
+	if m.PublicId != "" {
+		return nil, fmt.Errorf("create: ldap auth method: public id not empty: %w", db.ErrInvalidParameter)
+	}
+	if err := validateAuthMethod(m); err != nil {
+		return nil, fmt.Errorf("create: ldap auth method: %w", err)
+	}
+	m = m.clone()
+
+	opts := getOpts(opt...)
+
+	if opts.withPublicId != "" {
+		if !strings.HasPrefix(opts.withPublicId, AuthMethodPrefix+"_") {
+			return nil, fmt.Errorf("create: ldap auth method: passed-in public ID %q has wrong prefix, should be %q: %w", opts.withPublicId, AuthMethodPrefix, db.ErrInvalidPublicId)
+		}
+		m.PublicId = opts.withPublicId
+	} else {
+		id, err := newAuthMethodId()
+		if err != nil {
+			return nil, fmt.Errorf("create: ldap auth method: %w", err)
+		}
+		m.PublicId = id
+	}
+	mappings := m.mappingItems()
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, m.GetScopeId(), kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, fmt.Errorf("create: ldap auth method: unable to get oplog wrapper: %w", err)
+	}
+	if len(m.BindPassword) > 0 {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, m.GetScopeId(), kms.KeyPurposeDatabase)
+		if err != nil {
+			return nil, fmt.Errorf("create: ldap auth method: unable to get database wrapper: %w", err)
+		}
+		if err := m.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, fmt.Errorf("create: ldap auth method: %w", err)
+		}
+	}
+
+	var newAuthMethod *AuthMethod
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newAuthMethod = m.clone()
+			ticket, err := w.GetTicket(newAuthMethod)
+			if err != nil {
+				return fmt.Errorf("unable to get ticket: %w", err)
+			}
+			var amOplogMsg oplog.Message
+			if err := w.Create(ctx, newAuthMethod, db.NewOplogMsg(&amOplogMsg)); err != nil {
+				return err
+			}
+			msgs := []*oplog.Message{&amOplogMsg}
+			if len(mappings) > 0 {
+				if err := w.CreateItems(ctx, mappings, db.NewOplogMsgs(&msgs)); err != nil {
+					return fmt.Errorf("unable to create group mappings: %w", err)
+				}
+			}
+			return w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, m.oplog(oplog.OpType_OP_TYPE_CREATE), msgs)
+		},
+	)
+
+	if err != nil {
+		if db.IsUniqueError(err) {
+			return nil, fmt.Errorf("create: ldap auth method: in scope: %s: name %s already exists: %w",
+				m.ScopeId, m.Name, db.ErrNotUnique)
+		}
+		return nil, fmt.Errorf("create: ldap auth method: in scope: %s: %w", m.ScopeId, err)
+	}
+	newAuthMethod.BindPassword = nil
+	return newAuthMethod, nil
+}
+
+// LookupAuthMethod will look up an auth method and its group mappings in
+// the repository. If the auth method is not found, it will return nil,
+// nil. The bind password of the returned auth method is not decrypted. All
+// options are ignored.
+func (r *Repository) LookupAuthMethod(ctx context.Context, publicId string, opt ...Option) (*AuthMethod, error) {
+	if publicId == "" {
+		return nil, fmt.Errorf("lookup: ldap auth method: missing public id %w", db.ErrInvalidParameter)
+	}
+	a := allocAuthMethod()
+	a.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, &a); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("lookup: ldap auth method: failed %w for %s", err, publicId)
+	}
+	mappings, err := r.listGroupMappings(ctx, "auth_method_id = ?", publicId)
+	if err != nil {
+		return nil, fmt.Errorf("lookup: ldap auth method: %w", err)
+	}
+	a.GroupMappings = mappings[publicId]
+	return &a, nil
+}
+
+// lookupAuthMethodWithSecret looks up an auth method in scopeId and
+// decrypts its bind password. If the auth method is not found, it will
+// return nil, nil.
+func (r *Repository) lookupAuthMethodWithSecret(ctx context.Context, scopeId, publicId string) (*AuthMethod, error) {
+	am, err := r.LookupAuthMethod(ctx, publicId)
+	if err != nil || am == nil {
+		return nil, err
+	}
+	if am.ScopeId != scopeId {
+		return nil, nil
+	}
+	if len(am.CtBindPassword) == 0 {
+		return am, nil
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(am.GetKeyId()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get database wrapper: %w", err)
+	}
+	if err := am.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, err
+	}
+	return am, nil
+}
+
+// ListAuthMethods returns a slice of AuthMethods for the scopeId with their
+// group mappings. Supports the WithLimit and WithPageAfter options.
+func (r *Repository) ListAuthMethods(ctx context.Context, scopeId string, opt ...Option) ([]*AuthMethod, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("list: ldap auth method: missing scope id: %w", db.ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var authMethods []*AuthMethod
+	err := r.reader.SearchWhere(ctx, &authMethods, "scope_id = ?", []interface{}{scopeId}, db.WithLimit(limit), db.WithPageAfter(opts.withPageAfter))
+	if err != nil {
+		return nil, fmt.Errorf("list: ldap auth method: %w", err)
+	}
+	if len(authMethods) == 0 {
+		return authMethods, nil
+	}
+	mappings, err := r.listGroupMappings(ctx, "scope_id = ?", scopeId)
+	if err != nil {
+		return nil, fmt.Errorf("list: ldap auth method: %w", err)
+	}
+	for _, am := range authMethods {
+		am.GroupMappings = mappings[am.PublicId]
+	}
+	return authMethods, nil
+}
+
+// listGroupMappings returns the group mappings matching where keyed by the
+// id of their auth method.
+func (r *Repository) listGroupMappings(ctx context.Context, where string, args ...interface{}) (map[string][]*GroupMapping, error) {
+	var mappings []*GroupMapping
+	if err := r.reader.SearchWhere(ctx, &mappings, where, args); err != nil {
+		return nil, fmt.Errorf("unable to list group mappings: %w", err)
+	}
+	byAuthMethod := make(map[string][]*GroupMapping)
+	for _, m := range mappings {
+		byAuthMethod[m.AuthMethodId] = append(byAuthMethod[m.AuthMethodId], m)
+	}
+	return byAuthMethod, nil
+}
+
+// DeleteAuthMethod deletes the auth method for the provided id from the repository returning a count of the
+// number of records deleted.  All options are ignored.
+func (r *Repository) DeleteAuthMethod(ctx context.Context, scopeId, publicId string, opt ...Option) (int, error) {
+	if publicId == "" {
+		return db.NoRowsAffected, fmt.Errorf("delete: ldap auth method: missing public id: %w", db.ErrInvalidParameter)
+	}
+	am := allocAuthMethod()
+	am.PublicId = publicId
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("delete: ldap auth method: unable to get oplog wrapper: %w", err)
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			metadata := am.oplog(oplog.OpType_OP_TYPE_DELETE)
+			dAc := am.clone()
+			rowsDeleted, err = w.Delete(ctx, dAc, db.WithOplog(oplogWrapper, metadata))
+			if err == nil && rowsDeleted > 1 {
+				return db.ErrMultipleRecords
+			}
+			return err
+		},
+	)
+
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("delete: ldap auth method: %s: %w", publicId, err)
+	}
+
+	return rowsDeleted, nil
+}
+
+// UpdateAuthMethod will update an auth method in the repository and return
+// the written auth method. fieldMaskPaths provides field_mask.proto paths
+// for fields that should be updated.  Fields will be set to NULL if the
+// field is a zero value and included in fieldMask. Name, Description,
+// Urls, StartTls, InsecureTls, Certificate, BindDn, BindPassword,
+// DiscoverDn, UserDn, UserAttr, GroupDn, GroupAttr, GroupFilter, EmailAttr,
+// NameAttr, and GroupMappings are the only updatable fields. Urls and UserDn
+// cannot be set to NULL. Setting UserAttr, GroupAttr, GroupFilter,
+// EmailAttr, or NameAttr to NULL restores their default. Updating
+// GroupMappings replaces all group mappings of the auth method. If no
+// updatable fields are included in the fieldMaskPaths, then an error is
+// returned.
+func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMethod, version uint32, fieldMaskPaths []string, opt ...Option) (*AuthMethod, int, error) {
+	if authMethod == nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: missing authMethod: %w", db.ErrInvalidParameter)
+	}
+	if authMethod.PublicId == "" {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: missing authMethod public id: %w", db.ErrInvalidParameter)
+	}
+	if authMethod.ScopeId == "" {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: scope id empty: %w", db.ErrInvalidParameter)
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: no version supplied: %w", db.ErrInvalidParameter)
+	}
+	upAuthMethod := authMethod.clone()
+	var withSecret, withMappings bool
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold("name", f):
+		case strings.EqualFold("description", f):
+		case strings.EqualFold("StartTls", f):
+		case strings.EqualFold("InsecureTls", f):
+		case strings.EqualFold("DiscoverDn", f):
+		case strings.EqualFold("BindDn", f):
+		case strings.EqualFold("GroupDn", f):
+		case strings.EqualFold("Urls", f):
+			if err := ValidateUrls(upAuthMethod.UrlList()); err != nil {
+				return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: %w", err)
+			}
+		case strings.EqualFold("UserDn", f):
+			if strings.TrimSpace(upAuthMethod.UserDn) == "" {
+				return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: no user dn: %w", db.ErrInvalidParameter)
+			}
+		case strings.EqualFold("Certificate", f):
+			if err := ValidateCertificate(upAuthMethod.Certificate); err != nil {
+				return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: %w", err)
+			}
+		case strings.EqualFold("BindPassword", f):
+			withSecret = true
+		case strings.EqualFold("UserAttr", f):
+			if upAuthMethod.UserAttr == "" {
+				upAuthMethod.UserAttr = DefaultUserAttr
+			}
+		case strings.EqualFold("GroupAttr", f):
+			if upAuthMethod.GroupAttr == "" {
+				upAuthMethod.GroupAttr = DefaultGroupAttr
+			}
+		case strings.EqualFold("GroupFilter", f):
+			if upAuthMethod.GroupFilter == "" {
+				upAuthMethod.GroupFilter = DefaultGroupFilter
+			}
+			if err := ValidateGroupFilter(upAuthMethod.GroupFilter); err != nil {
+				return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: %w", err)
+			}
+		case strings.EqualFold("EmailAttr", f):
+			if upAuthMethod.EmailAttr == "" {
+				upAuthMethod.EmailAttr = DefaultEmailAttr
+			}
+		case strings.EqualFold("NameAttr", f):
+			if upAuthMethod.NameAttr == "" {
+				upAuthMethod.NameAttr = DefaultNameAttr
+			}
+		case strings.EqualFold("GroupMappings", f):
+			withMappings = true
+		default:
+			return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: field: %s: %w", f, db.ErrInvalidFieldMask)
+		}
+	}
+	var dbMask, nullFields []string
+	dbMask, nullFields = dbcommon.BuildUpdatePaths(
+		map[string]interface{}{
+			"Name":        upAuthMethod.Name,
+			"Description": upAuthMethod.Description,
+			"Urls":        upAuthMethod.Urls,
+			"StartTls":    upAuthMethod.StartTls,
+			"InsecureTls": upAuthMethod.InsecureTls,
+			"Certificate": upAuthMethod.Certificate,
+			"BindDn":      upAuthMethod.BindDn,
+			"DiscoverDn":  upAuthMethod.DiscoverDn,
+			"UserDn":      upAuthMethod.UserDn,
+			"UserAttr":    upAuthMethod.UserAttr,
+			"GroupDn":     upAuthMethod.GroupDn,
+			"GroupAttr":   upAuthMethod.GroupAttr,
+			"GroupFilter": upAuthMethod.GroupFilter,
+			"EmailAttr":   upAuthMethod.EmailAttr,
+			"NameAttr":    upAuthMethod.NameAttr,
+		},
+		fieldMaskPaths,
+		[]string{"StartTls", "InsecureTls", "DiscoverDn"},
+	)
+	if withSecret {
+		if len(upAuthMethod.BindPassword) > 0 {
+			dbMask = append(dbMask, "CtBindPassword", "KeyId")
+		} else {
+			nullFields = append(nullFields, "CtBindPassword", "KeyId")
+		}
+	}
+	if len(dbMask) == 0 && len(nullFields) == 0 && !withMappings {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: %w", db.ErrEmptyFieldMask)
+	}
+	var mappings []interface{}
+	if withMappings {
+		// Group mappings are written with the version of the auth method.
+		dbMask = append(dbMask, "Version")
+		upAuthMethod.Version = version + 1
+		for _, m := range upAuthMethod.GroupMappings {
+			m.AuthMethodId = upAuthMethod.PublicId
+			m.ScopeId = upAuthMethod.ScopeId
+		}
+		mappings = upAuthMethod.mappingItems()
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, authMethod.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: unable to get oplog wrapper: %w", err)
+	}
+	if withSecret && len(upAuthMethod.BindPassword) > 0 {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, authMethod.ScopeId, kms.KeyPurposeDatabase)
+		if err != nil {
+			return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: unable to get database wrapper: %w", err)
+		}
+		if err := upAuthMethod.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: %w", err)
+		}
+	}
+
+	var rowsUpdated int
+	var returnedAuthMethod *AuthMethod
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			returnedAuthMethod = upAuthMethod.clone()
+			ticket, err := w.GetTicket(returnedAuthMethod)
+			if err != nil {
+				return fmt.Errorf("unable to get ticket: %w", err)
+			}
+			var amOplogMsg oplog.Message
+			rowsUpdated, err = w.Update(
+				ctx,
+				returnedAuthMethod,
+				dbMask,
+				nullFields,
+				db.NewOplogMsg(&amOplogMsg),
+				db.WithVersion(&version),
+			)
+			if err != nil {
+				return err
+			}
+			if rowsUpdated > 1 {
+				return db.ErrMultipleRecords
+			}
+			if rowsUpdated == 0 {
+				return nil
+			}
+			msgs := []*oplog.Message{&amOplogMsg}
+			if withMappings {
+				var current []*GroupMapping
+				if err := reader.SearchWhere(ctx, &current, "auth_method_id = ?", []interface{}{upAuthMethod.PublicId}); err != nil {
+					return fmt.Errorf("unable to list group mappings: %w", err)
+				}
+				if len(current) > 0 {
+					deletes := make([]interface{}, 0, len(current))
+					for _, m := range current {
+						deletes = append(deletes, m)
+					}
+					if _, err := w.DeleteItems(ctx, deletes, db.NewOplogMsgs(&msgs)); err != nil {
+						return fmt.Errorf("unable to delete group mappings: %w", err)
+					}
+				}
+				if len(mappings) > 0 {
+					if err := w.CreateItems(ctx, mappings, db.NewOplogMsgs(&msgs)); err != nil {
+						return fmt.Errorf("unable to create group mappings: %w", err)
+					}
+				}
+			}
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, returnedAuthMethod.oplog(oplog.OpType_OP_TYPE_UPDATE), msgs); err != nil {
+				return fmt.Errorf("unable to write oplog: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if db.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: authMethod %s already exists in scope %s: %w", authMethod.Name, authMethod.ScopeId, db.ErrNotUnique)
+		}
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: %w for %s", err, authMethod.PublicId)
+	}
+	if rowsUpdated == 0 {
+		return nil, db.NoRowsAffected, nil
+	}
+	if !withMappings {
+		mappings, err := r.listGroupMappings(ctx, "auth_method_id = ?", authMethod.PublicId)
+		if err != nil {
+			return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: %w", err)
+		}
+		returnedAuthMethod.GroupMappings = mappings[authMethod.PublicId]
+	}
+	returnedAuthMethod.BindPassword = nil
+	return returnedAuthMethod, rowsUpdated, nil
+}
+
+// mappingItems sets the auth method and scope of the group mappings of a
+// and returns them for CreateItems.
+func (a *AuthMethod) mappingItems() []interface{} {
+	items := make([]interface{}, 0, len(a.GroupMappings))
+	for _, m := range a.GroupMappings {
+		m.AuthMethodId = a.PublicId
+		m.ScopeId = a.ScopeId
+		items = append(items, m)
+	}
+	return items
+}
+
+// validateAuthMethod checks the fields of m which must be set or have a
+// specific format when m is created.
+func validateAuthMethod(m *AuthMethod) error {
+	if err := ValidateUrls(m.UrlList()); err != nil {
+		return err
+	}
+	if strings.TrimSpace(m.UserDn) == "" {
+		return fmt.Errorf("no user dn: %w", db.ErrInvalidParameter)
+	}
+	if len(m.BindPassword) > 0 && m.BindDn == "" {
+		return fmt.Errorf("bind password without bind dn: %w", db.ErrInvalidParameter)
+	}
+	if err := ValidateCertificate(m.Certificate); err != nil {
+		return err
+	}
+	if err := ValidateGroupFilter(m.GroupFilter); err != nil {
+		return err
+	}
+	for _, gm := range m.GroupMappings {
+		if gm == nil || gm.GroupMapping == nil || strings.TrimSpace(gm.GroupName) == "" || gm.GroupId == "" {
+			return fmt.Errorf("invalid group mapping: %w", db.ErrInvalidParameter)
+		}
+	}
+	return nil
+}
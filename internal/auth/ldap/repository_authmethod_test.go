@@ -0,0 +1,177 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateAuthMethod(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	grp := iam.TestGroup(t, conn, org.PublicId)
+
+	ctx := context.Background()
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	mapping, err := NewGroupMapping("admins", grp.PublicId)
+	require.NoError(t, err)
+
+	var tests = []struct {
+		name      string
+		urls      []string
+		userDn    string
+		opts      []Option
+		wantIsErr error
+	}{
+		{
+			name:   "valid",
+			urls:   []string{"ldaps://ldap1.example.com", "ldaps://ldap2.example.com"},
+			userDn: TestUserDn,
+			opts: []Option{
+				WithName("corp"), WithBindCredential("cn=admin,dc=example,dc=com", "secret"),
+				WithGroupDn(TestGroupDn), WithGroupMappings(mapping),
+			},
+		},
+		{
+			name:      "no-urls",
+			userDn:    TestUserDn,
+			wantIsErr: ErrInvalidUrl,
+		},
+		{
+			name:      "invalid-url",
+			urls:      []string{"https://ldap.example.com"},
+			userDn:    TestUserDn,
+			wantIsErr: ErrInvalidUrl,
+		},
+		{
+			name:      "no-user-dn",
+			urls:      []string{"ldaps://ldap.example.com"},
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "invalid-certificate",
+			urls:      []string{"ldaps://ldap.example.com"},
+			userDn:    TestUserDn,
+			opts:      []Option{WithCertificate("not a certificate")},
+			wantIsErr: ErrInvalidCertificate,
+		},
+		{
+			name:      "invalid-group-filter",
+			urls:      []string{"ldaps://ldap.example.com"},
+			userDn:    TestUserDn,
+			opts:      []Option{WithGroupFilter("(member={{.Unknown}})")},
+			wantIsErr: ErrInvalidGroupFilter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			in, err := NewAuthMethod(org.PublicId, tt.urls, tt.userDn, tt.opts...)
+			require.NoError(err)
+			got, err := repo.CreateAuthMethod(ctx, in)
+			if tt.wantIsErr != nil {
+				assert.Truef(errors.Is(err, tt.wantIsErr), "want err: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.True(len(got.PublicId) > len(AuthMethodPrefix))
+			assert.Empty(got.BindPassword, "the bind password must not be returned")
+			assert.NotEmpty(got.CtBindPassword)
+
+			found, err := repo.lookupAuthMethodWithSecret(ctx, org.PublicId, got.PublicId)
+			require.NoError(err)
+			assert.Equal("secret", string(found.BindPassword))
+			assert.Equal(tt.urls, found.UrlList())
+			assert.Equal(DefaultUserAttr, found.UserAttr)
+			assert.Equal(DefaultGroupFilter, found.GroupFilter)
+			require.Len(found.GroupMappings, 1)
+			assert.Equal("admins", found.GroupMappings[0].GroupName)
+			assert.Equal(grp.PublicId, found.GroupMappings[0].GroupId)
+		})
+	}
+
+	t.Run("group-in-other-scope", func(t *testing.T) {
+		_, proj := iam.TestScopes(t, iamRepo)
+		other := iam.TestGroup(t, conn, proj.PublicId)
+		m, err := NewGroupMapping("admins", other.PublicId)
+		require.NoError(t, err)
+		in, err := NewAuthMethod(org.PublicId, []string{"ldaps://ldap.example.com"}, TestUserDn, WithGroupMappings(m))
+		require.NoError(t, err)
+		got, err := repo.CreateAuthMethod(ctx, in)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func TestRepository_UpdateAuthMethod(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	admins := iam.TestGroup(t, conn, org.PublicId)
+	devs := iam.TestGroup(t, conn, org.PublicId)
+
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+	am := TestAuthMethods(t, conn, org.PublicId, "ldaps://ldap.example.com", 1)[0]
+
+	upd := am.clone()
+	upd.Name = "corp"
+	upd.UserAttr = ""
+	upd.BindDn = "cn=admin,dc=example,dc=com"
+	upd.BindPassword = []byte("secret")
+	got, n, err := repo.UpdateAuthMethod(ctx, upd, am.Version, []string{"Name", "UserAttr", "BindDn", "BindPassword"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal("corp", got.Name)
+	assert.Equal(DefaultUserAttr, got.UserAttr)
+	assert.Empty(got.BindPassword)
+
+	found, err := repo.lookupAuthMethodWithSecret(ctx, org.PublicId, am.PublicId)
+	require.NoError(err)
+	assert.Equal("secret", string(found.BindPassword))
+
+	m1, err := NewGroupMapping("admins", admins.PublicId)
+	require.NoError(err)
+	m2, err := NewGroupMapping("devs", devs.PublicId)
+	require.NoError(err)
+	upd = got.clone()
+	upd.GroupMappings = []*GroupMapping{m1, m2}
+	got, n, err = repo.UpdateAuthMethod(ctx, upd, got.Version, []string{"GroupMappings"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Len(got.GroupMappings, 2)
+
+	upd = got.clone()
+	upd.GroupMappings = []*GroupMapping{m2}
+	got, n, err = repo.UpdateAuthMethod(ctx, upd, got.Version, []string{"GroupMappings"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	require.Len(got.GroupMappings, 1)
+	assert.Equal(devs.PublicId, got.GroupMappings[0].GroupId)
+
+	upd = got.clone()
+	upd.Urls = ""
+	_, _, err = repo.UpdateAuthMethod(ctx, upd, got.Version, []string{"Urls"})
+	assert.Truef(errors.Is(err, ErrInvalidUrl), "want err: %q got: %q", ErrInvalidUrl, err)
+
+	_, _, err = repo.UpdateAuthMethod(ctx, got, got.Version, []string{"ScopeId"})
+	assert.Truef(errors.Is(err, db.ErrInvalidFieldMask), "want err: %q got: %q", db.ErrInvalidFieldMask, err)
+}
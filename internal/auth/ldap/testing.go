@@ -0,0 +1,485 @@
+package ldap
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuthMethods creates count number of ldap auth methods to the provided
+// DB with the provided scope id for the directory server at url. The user
+// DN of the auth methods is TestUserDn. If any errors are encountered
+// during the creation of the auth methods, the test will fail.
+func TestAuthMethods(t *testing.T, conn *gorm.DB, scopeId, url string, count int) []*AuthMethod {
+	t.Helper()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	w := db.New(conn)
+	var auts []*AuthMethod
+	for i := 0; i < count; i++ {
+		cat, err := NewAuthMethod(scopeId, []string{url}, TestUserDn)
+		assert.NoError(err)
+		require.NotNil(cat)
+		id, err := newAuthMethodId()
+		assert.NoError(err)
+		require.NotEmpty(id)
+		cat.PublicId = id
+
+		_, err2 := w.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+			func(_ db.Reader, iw db.Writer) error {
+				return iw.Create(ctx, cat)
+			},
+		)
+
+		require.NoError(err2)
+		auts = append(auts, cat)
+	}
+	return auts
+}
+
+// TestAccounts creates count number of ldap accounts to the provided DB
+// with the provided auth method id. The login names of the accounts are
+// "name0" to "name<count-1>". The auth method must have been created
+// previously. If any errors are encountered during the creation of the
+// account, the test will fail.
+func TestAccounts(t *testing.T, conn *gorm.DB, authMethodId string, count int) []*Account {
+	t.Helper()
+	assert, require := assert.New(t), require.New(t)
+	w := db.New(conn)
+	var auts []*Account
+	for i := 0; i < count; i++ {
+		cat, err := NewAccount(authMethodId, fmt.Sprintf("name%d", i))
+		assert.NoError(err)
+		require.NotNil(cat)
+		id, err := newAccountId()
+		assert.NoError(err)
+		require.NotEmpty(id)
+		cat.PublicId = id
+
+		ctx := context.Background()
+		_, err2 := w.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+			func(_ db.Reader, iw db.Writer) error {
+				return iw.Create(ctx, cat)
+			},
+		)
+
+		require.NoError(err2)
+		auts = append(auts, cat)
+	}
+	return auts
+}
+
+// The base DNs of the user and group entries of a TestDirectory.
+const (
+	TestUserDn  = "ou=people,dc=example,dc=com"
+	TestGroupDn = "ou=groups,dc=example,dc=com"
+)
+
+// TestDirectory is an in-process LDAP directory server for tests. It
+// listens on a loopback address for ldap:// connections, which can be
+// upgraded with StartTLS, and for ldaps:// connections. Both use a
+// self-signed certificate for 127.0.0.1.
+//
+// The directory supports simple binds, searches with and, or, not,
+// equality, and presence filters, the StartTLS extended operation, and
+// unbinds. Any bound or anonymous connection can search the whole
+// directory.
+type TestDirectory struct {
+	listener    net.Listener
+	tlsListener net.Listener
+	tlsConfig   *tls.Config
+	certificate string
+
+	mu        sync.Mutex
+	entries   []*testEntry
+	passwords map[string]string
+	conns     map[net.Conn]struct{}
+	wg        sync.WaitGroup
+}
+
+type testEntry struct {
+	dn    *goldap.DN
+	rawDn string
+	attrs map[string][]string
+}
+
+// NewTestDirectory starts a TestDirectory without any entries. The
+// directory is stopped when the test completes.
+func NewTestDirectory(t *testing.T) *TestDirectory {
+	t.Helper()
+	require := require.New(t)
+	cert, certPem := testCertificate(t)
+	d := &TestDirectory{
+		tlsConfig:   &tls.Config{Certificates: []tls.Certificate{cert}},
+		certificate: certPem,
+		passwords:   make(map[string]string),
+		conns:       make(map[net.Conn]struct{}),
+	}
+	var err error
+	d.listener, err = net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	d.tlsListener = tls.NewListener(l, d.tlsConfig)
+	d.wg.Add(2)
+	go d.serve(d.listener)
+	go d.serve(d.tlsListener)
+	t.Cleanup(d.close)
+	return d
+}
+
+// Url returns the ldap:// URL of the directory.
+func (d *TestDirectory) Url() string {
+	return "ldap://" + d.listener.Addr().String()
+}
+
+// TlsUrl returns the ldaps:// URL of the directory.
+func (d *TestDirectory) TlsUrl() string {
+	return "ldaps://" + d.tlsListener.Addr().String()
+}
+
+// Certificate returns the PEM encoded self-signed certificate of the
+// directory.
+func (d *TestDirectory) Certificate() string {
+	return d.certificate
+}
+
+// AddUser adds a user entry with the uid loginName, the common name
+// fullName, and the mail email below TestUserDn and returns its DN. The
+// user can bind with password.
+func (d *TestDirectory) AddUser(t *testing.T, loginName, password, fullName, email string) string {
+	t.Helper()
+	dn := fmt.Sprintf("uid=%s,%s", escapeDn(loginName), TestUserDn)
+	attrs := map[string][]string{
+		"objectClass": {"inetOrgPerson"},
+		"uid":         {loginName},
+	}
+	if fullName != "" {
+		attrs["cn"] = []string{fullName}
+	}
+	if email != "" {
+		attrs["mail"] = []string{email}
+	}
+	d.addEntry(t, dn, attrs)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.passwords[strings.ToLower(dn)] = password
+	return dn
+}
+
+// AddGroup adds a group entry with the common name name and the members
+// memberDns below TestGroupDn and returns its DN.
+func (d *TestDirectory) AddGroup(t *testing.T, name string, memberDns ...string) string {
+	t.Helper()
+	dn := fmt.Sprintf("cn=%s,%s", escapeDn(name), TestGroupDn)
+	d.addEntry(t, dn, map[string][]string{
+		"objectClass": {"groupOfNames"},
+		"cn":          {name},
+		"member":      memberDns,
+	})
+	return dn
+}
+
+func (d *TestDirectory) addEntry(t *testing.T, dn string, attrs map[string][]string) {
+	t.Helper()
+	parsed, err := goldap.ParseDN(dn)
+	require.NoError(t, err)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.entries = append(d.entries, &testEntry{dn: parsed, rawDn: dn, attrs: attrs})
+}
+
+func (d *TestDirectory) close() {
+	d.listener.Close()
+	d.tlsListener.Close()
+	d.mu.Lock()
+	for c := range d.conns {
+		c.Close()
+	}
+	d.mu.Unlock()
+	d.wg.Wait()
+}
+
+func (d *TestDirectory) serve(l net.Listener) {
+	defer d.wg.Done()
+	for {
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		d.mu.Lock()
+		d.conns[c] = struct{}{}
+		d.mu.Unlock()
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			d.handle(c)
+		}()
+	}
+}
+
+// handle serves the requests of one connection until it is closed or the
+// client unbinds.
+func (d *TestDirectory) handle(c net.Conn) {
+	defer func() {
+		d.mu.Lock()
+		delete(d.conns, c)
+		d.mu.Unlock()
+		c.Close()
+	}()
+	r := bufio.NewReader(c)
+	for {
+		req, err := ber.ReadPacket(r)
+		if err != nil || len(req.Children) < 2 {
+			return
+		}
+		msgId, _ := req.Children[0].Value.(int64)
+		op := req.Children[1]
+		if op.ClassType != ber.ClassApplication {
+			return
+		}
+		switch op.Tag {
+		case goldap.ApplicationBindRequest:
+			c.Write(ldapResult(msgId, goldap.ApplicationBindResponse, d.bind(op)).Bytes())
+		case goldap.ApplicationUnbindRequest:
+			return
+		case goldap.ApplicationSearchRequest:
+			for _, p := range d.search(msgId, op) {
+				c.Write(p.Bytes())
+			}
+		case goldap.ApplicationExtendedRequest:
+			if len(op.Children) == 0 || op.Children[0].Data.String() != "1.3.6.1.4.1.1466.20037" {
+				c.Write(ldapResult(msgId, goldap.ApplicationExtendedResponse, goldap.LDAPResultProtocolError).Bytes())
+				continue
+			}
+			if _, ok := c.(*tls.Conn); ok {
+				c.Write(ldapResult(msgId, goldap.ApplicationExtendedResponse, goldap.LDAPResultOperationsError).Bytes())
+				continue
+			}
+			c.Write(ldapResult(msgId, goldap.ApplicationExtendedResponse, goldap.LDAPResultSuccess).Bytes())
+			tc := tls.Server(c, d.tlsConfig)
+			d.mu.Lock()
+			delete(d.conns, c)
+			d.conns[tc] = struct{}{}
+			d.mu.Unlock()
+			c, r = tc, bufio.NewReader(tc)
+		default:
+			c.Write(ldapResult(msgId, goldap.ApplicationExtendedResponse, goldap.LDAPResultUnwillingToPerform).Bytes())
+		}
+	}
+}
+
+// bind returns the result code of the simple bind request op. An empty
+// name and password is an anonymous bind.
+func (d *TestDirectory) bind(op *ber.Packet) uint16 {
+	if len(op.Children) < 3 || op.Children[2].ClassType != ber.ClassContext || op.Children[2].Tag != 0 {
+		return goldap.LDAPResultAuthMethodNotSupported
+	}
+	name, _ := op.Children[1].Value.(string)
+	password := op.Children[2].Data.String()
+	if name == "" && password == "" {
+		return goldap.LDAPResultSuccess
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if want, ok := d.passwords[strings.ToLower(name)]; ok && password != "" && password == want {
+		return goldap.LDAPResultSuccess
+	}
+	return goldap.LDAPResultInvalidCredentials
+}
+
+// search returns the entries matching the search request op followed by
+// the search result done response.
+func (d *TestDirectory) search(msgId int64, op *ber.Packet) []*ber.Packet {
+	if len(op.Children) < 8 {
+		return []*ber.Packet{ldapResult(msgId, goldap.ApplicationSearchResultDone, goldap.LDAPResultProtocolError)}
+	}
+	baseDn, _ := op.Children[0].Value.(string)
+	scope, _ := op.Children[1].Value.(int64)
+	sizeLimit, _ := op.Children[3].Value.(int64)
+	filter := op.Children[6]
+	var attrs []string
+	for _, a := range op.Children[7].Children {
+		if s, ok := a.Value.(string); ok {
+			attrs = append(attrs, s)
+		}
+	}
+	base, err := goldap.ParseDN(baseDn)
+	if err != nil {
+		return []*ber.Packet{ldapResult(msgId, goldap.ApplicationSearchResultDone, goldap.LDAPResultInvalidDNSyntax)}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var found bool
+	var resp []*ber.Packet
+	for _, e := range d.entries {
+		if base.Equal(e.dn) || base.AncestorOf(e.dn) {
+			found = true
+		}
+		switch scope {
+		case goldap.ScopeBaseObject:
+			if !base.Equal(e.dn) {
+				continue
+			}
+		case goldap.ScopeSingleLevel:
+			if !base.AncestorOf(e.dn) || len(e.dn.RDNs) != len(base.RDNs)+1 {
+				continue
+			}
+		default:
+			if !base.Equal(e.dn) && !base.AncestorOf(e.dn) {
+				continue
+			}
+		}
+		if !matchFilter(filter, e) {
+			continue
+		}
+		if sizeLimit > 0 && int64(len(resp)) == sizeLimit {
+			return append(resp, ldapResult(msgId, goldap.ApplicationSearchResultDone, goldap.LDAPResultSizeLimitExceeded))
+		}
+		resp = append(resp, searchEntry(msgId, e, attrs))
+	}
+	if !found {
+		return []*ber.Packet{ldapResult(msgId, goldap.ApplicationSearchResultDone, goldap.LDAPResultNoSuchObject)}
+	}
+	return append(resp, ldapResult(msgId, goldap.ApplicationSearchResultDone, goldap.LDAPResultSuccess))
+}
+
+// matchFilter reports whether e matches the search filter f. Substring,
+// ordering, approximate, and extensible filters never match.
+func matchFilter(f *ber.Packet, e *testEntry) bool {
+	switch f.Tag {
+	case goldap.FilterAnd:
+		for _, c := range f.Children {
+			if !matchFilter(c, e) {
+				return false
+			}
+		}
+		return true
+	case goldap.FilterOr:
+		for _, c := range f.Children {
+			if matchFilter(c, e) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterNot:
+		return len(f.Children) == 1 && !matchFilter(f.Children[0], e)
+	case goldap.FilterEqualityMatch:
+		if len(f.Children) != 2 {
+			return false
+		}
+		attr, _ := f.Children[0].Value.(string)
+		value, _ := f.Children[1].Value.(string)
+		for _, v := range e.values(attr) {
+			if strings.EqualFold(v, value) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterPresent:
+		attr := f.Data.String()
+		return strings.EqualFold(attr, "objectClass") || len(e.values(attr)) > 0
+	}
+	return false
+}
+
+func (e *testEntry) values(attr string) []string {
+	for k, v := range e.attrs {
+		if strings.EqualFold(k, attr) {
+			return v
+		}
+	}
+	return nil
+}
+
+func searchEntry(msgId int64, e *testEntry, attrs []string) *ber.Packet {
+	p := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, msgId, "MessageID"))
+	entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.rawDn, "DN"))
+	list := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for k, vs := range e.attrs {
+		if !wantAttr(attrs, k) {
+			continue
+		}
+		a := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		a.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, k, "Type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, v := range vs {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+		}
+		a.AppendChild(set)
+		list.AppendChild(a)
+	}
+	entry.AppendChild(list)
+	p.AppendChild(entry)
+	return p
+}
+
+func wantAttr(attrs []string, attr string) bool {
+	if len(attrs) == 0 {
+		return true
+	}
+	for _, a := range attrs {
+		if a == "*" || strings.EqualFold(a, attr) {
+			return true
+		}
+	}
+	return false
+}
+
+func ldapResult(msgId int64, tag ber.Tag, code uint16) *ber.Packet {
+	p := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, msgId, "MessageID"))
+	res := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Response")
+	res.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "resultCode"))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, goldap.LDAPResultCodeMap[code], "diagnosticMessage"))
+	p.AppendChild(res)
+	return p
+}
+
+// testCertificate returns a self-signed certificate for 127.0.0.1 and its
+// PEM encoding.
+func testCertificate(t *testing.T) (tls.Certificate, string) {
+	t.Helper()
+	require := require.New(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "127.0.0.1"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key},
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
@@ -0,0 +1,114 @@
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/oidc/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// Default names of the ID token claims mapped to the fields of an Account.
+const (
+	DefaultEmailClaim = "email"
+	DefaultNameClaim  = "name"
+)
+
+// An AuthMethod authenticates users with an OpenID Connect provider using
+// the authorization code flow with PKCE. It contains accounts and is owned
+// by a scope.
+type AuthMethod struct {
+	*store.AuthMethod
+	tableName string
+}
+
+func allocAuthMethod() AuthMethod {
+	return AuthMethod{
+		AuthMethod: &store.AuthMethod{},
+	}
+}
+
+// NewAuthMethod creates a new in memory AuthMethod assigned to scopeId for
+// the provider at issuer. Name, description, client secret, claims scopes,
+// email claim, and name claim are the only valid options. All other options
+// are ignored.
+func NewAuthMethod(scopeId, issuer, clientId string, opt ...Option) (*AuthMethod, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("new: oidc auth method: no scope id: %w", db.ErrInvalidParameter)
+	}
+
+	opts := getOpts(opt...)
+	a := &AuthMethod{
+		AuthMethod: &store.AuthMethod{
+			ScopeId:      scopeId,
+			Name:         opts.withName,
+			Description:  opts.withDescription,
+			Issuer:       issuer,
+			ClientId:     clientId,
+			ClaimsScopes: strings.Join(opts.withClaimsScopes, " "),
+			EmailClaim:   opts.withEmailClaim,
+			NameClaim:    opts.withNameClaim,
+		},
+	}
+	if opts.withClientSecret != "" {
+		a.ClientSecret = []byte(opts.withClientSecret)
+	}
+	return a, nil
+}
+
+// Scopes returns the scopes requested in addition to the openid scope.
+func (a *AuthMethod) Scopes() []string {
+	return strings.Fields(a.GetClaimsScopes())
+}
+
+func (a *AuthMethod) clone() *AuthMethod {
+	cp := proto.Clone(a.AuthMethod)
+	return &AuthMethod{
+		AuthMethod: cp.(*store.AuthMethod),
+	}
+}
+
+// TableName returns the table name.
+func (a *AuthMethod) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return "auth_oidc_method"
+}
+
+// SetTableName sets the table name.
+func (a *AuthMethod) SetTableName(n string) {
+	a.tableName = n
+}
+
+func (a *AuthMethod) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	if err := structwrapping.WrapStruct(ctx, cipher, a.AuthMethod, nil); err != nil {
+		return fmt.Errorf("error encrypting oidc client secret: %w", err)
+	}
+	a.KeyId = cipher.KeyID()
+	return nil
+}
+
+func (a *AuthMethod) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	if err := structwrapping.UnwrapStruct(ctx, cipher, a.AuthMethod, nil); err != nil {
+		return fmt.Errorf("error decrypting oidc client secret: %w", err)
+	}
+	return nil
+}
+
+func (a *AuthMethod) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{a.GetPublicId()},
+		"resource-type":      []string{"oidc auth method"},
+		"op-type":            []string{op.String()},
+	}
+	if a.ScopeId != "" {
+		metadata["scope-id"] = []string{a.ScopeId}
+	}
+	return metadata
+}
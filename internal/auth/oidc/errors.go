@@ -0,0 +1,23 @@
+package oidc
+
+import "errors"
+
+var (
+	// ErrInvalidIssuer results from attempting to set an issuer which is
+	// not an https URL, or an http URL on a loopback address.
+	ErrInvalidIssuer = errors.New("invalid issuer")
+
+	// ErrInvalidRedirectURL results from attempting to start an
+	// authentication request with a redirect URL which is not an http URL
+	// on a loopback address.
+	ErrInvalidRedirectURL = errors.New("invalid redirect url")
+
+	// ErrInvalidState results from attempting to complete an authentication
+	// request with a state which is unknown, has already been used, or has
+	// expired.
+	ErrInvalidState = errors.New("unknown or expired state")
+
+	// ErrAuthenticationFailed results from the provider rejecting the
+	// authorization code or returning an ID token which fails validation.
+	ErrAuthenticationFailed = errors.New("authentication failed")
+)
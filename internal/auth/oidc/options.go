@@ -0,0 +1,118 @@
+package oidc
+
+import "github.com/hashicorp/boundary/internal/db"
+
+// getOpts - iterate the inbound Options and return a struct.
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName         string
+	withDescription  string
+	withLimit        int
+	withPageAfter    *db.PageAfter
+	withPublicId     string
+	withClientSecret string
+	withClaimsScopes []string
+	withEmailClaim   string
+	withNameClaim    string
+	withEmail        string
+	withFullName     string
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithPublicId provides an optional public id
+func WithPublicId(id string) Option {
+	return func(o *options) {
+		o.withPublicId = id
+	}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit.  Intentionally allowing
+// negative integers.   If WithLimit < 0, then unlimited results are returned.
+// If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithPageAfter provides an option to list only the items after the given
+// position, ordered by create time and public id, so that a list can be paged
+// through with WithLimit.
+func WithPageAfter(after *db.PageAfter) Option {
+	return func(o *options) {
+		o.withPageAfter = after
+	}
+}
+
+// WithClientSecret provides an optional client secret.
+func WithClientSecret(secret string) Option {
+	return func(o *options) {
+		o.withClientSecret = secret
+	}
+}
+
+// WithClaimsScopes provides optional scopes to request in addition to the
+// openid scope.
+func WithClaimsScopes(scopes ...string) Option {
+	return func(o *options) {
+		o.withClaimsScopes = scopes
+	}
+}
+
+// WithEmailClaim provides an optional name of the claim mapped to the email
+// of an account.
+func WithEmailClaim(claim string) Option {
+	return func(o *options) {
+		o.withEmailClaim = claim
+	}
+}
+
+// WithNameClaim provides an optional name of the claim mapped to the full
+// name of an account.
+func WithNameClaim(claim string) Option {
+	return func(o *options) {
+		o.withNameClaim = claim
+	}
+}
+
+// WithEmail provides an optional email.
+func WithEmail(email string) Option {
+	return func(o *options) {
+		o.withEmail = email
+	}
+}
+
+// WithFullName provides an optional full name.
+func WithFullName(name string) Option {
+	return func(o *options) {
+		o.withFullName = name
+	}
+}
@@ -0,0 +1,79 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithPublicId", func(t *testing.T) {
+		opts := getOpts(WithPublicId("test id"))
+		testOpts := getDefaultOptions()
+		testOpts.withPublicId = "test id"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithName", func(t *testing.T) {
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		opts := getOpts(WithLimit(5))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithPageAfter", func(t *testing.T) {
+		after := &db.PageAfter{PublicId: "test"}
+		opts := getOpts(WithPageAfter(after))
+		testOpts := getDefaultOptions()
+		testOpts.withPageAfter = after
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithClientSecret", func(t *testing.T) {
+		opts := getOpts(WithClientSecret("secret"))
+		testOpts := getDefaultOptions()
+		testOpts.withClientSecret = "secret"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithClaimsScopes", func(t *testing.T) {
+		opts := getOpts(WithClaimsScopes("email", "profile"))
+		testOpts := getDefaultOptions()
+		testOpts.withClaimsScopes = []string{"email", "profile"}
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithEmailClaim", func(t *testing.T) {
+		opts := getOpts(WithEmailClaim("upn"))
+		testOpts := getDefaultOptions()
+		testOpts.withEmailClaim = "upn"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithNameClaim", func(t *testing.T) {
+		opts := getOpts(WithNameClaim("preferred_username"))
+		testOpts := getDefaultOptions()
+		testOpts.withNameClaim = "preferred_username"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithEmail", func(t *testing.T) {
+		opts := getOpts(WithEmail("alice@example.com"))
+		testOpts := getDefaultOptions()
+		testOpts.withEmail = "alice@example.com"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithFullName", func(t *testing.T) {
+		opts := getOpts(WithFullName("Alice Smith"))
+		testOpts := getDefaultOptions()
+		testOpts.withFullName = "Alice Smith"
+		assert.Equal(t, opts, testOpts)
+	})
+}
@@ -0,0 +1,155 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	gooidc "github.com/coreos/go-oidc"
+	"golang.org/x/oauth2"
+)
+
+// ValidateIssuer returns an error wrapping ErrInvalidIssuer unless issuer
+// is an https URL, or an http URL on a loopback address.
+func ValidateIssuer(issuer string) error {
+	u, err := url.Parse(strings.TrimSpace(issuer))
+	if err != nil {
+		return fmt.Errorf("%q: %v: %w", issuer, err, ErrInvalidIssuer)
+	}
+	switch {
+	case u.Host == "":
+		return fmt.Errorf("%q: missing host: %w", issuer, ErrInvalidIssuer)
+	case u.RawQuery != "" || u.Fragment != "":
+		return fmt.Errorf("%q: must not contain a query or fragment: %w", issuer, ErrInvalidIssuer)
+	case u.Scheme == "https":
+		return nil
+	case u.Scheme == "http" && isLoopback(u.Hostname()):
+		return nil
+	}
+	return fmt.Errorf("%q: must be an https URL or an http URL on a loopback address: %w", issuer, ErrInvalidIssuer)
+}
+
+// ValidateRedirectURL returns an error wrapping ErrInvalidRedirectURL
+// unless redirectURL is an http URL with a port on a loopback address. The
+// authorization code is only ever sent to a listener on the user's own
+// machine, such as the one started by the CLI.
+func ValidateRedirectURL(redirectURL string) error {
+	u, err := url.Parse(redirectURL)
+	if err != nil {
+		return fmt.Errorf("%q: %v: %w", redirectURL, err, ErrInvalidRedirectURL)
+	}
+	switch {
+	case u.Scheme != "http":
+		return fmt.Errorf("%q: scheme must be http: %w", redirectURL, ErrInvalidRedirectURL)
+	case !isLoopback(u.Hostname()):
+		return fmt.Errorf("%q: host must be a loopback address: %w", redirectURL, ErrInvalidRedirectURL)
+	case u.Port() == "":
+		return fmt.Errorf("%q: missing port: %w", redirectURL, ErrInvalidRedirectURL)
+	case u.Fragment != "":
+		return fmt.Errorf("%q: must not contain a fragment: %w", redirectURL, ErrInvalidRedirectURL)
+	}
+	return nil
+}
+
+func isLoopback(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// provider is the configuration of an oidc AuthMethod discovered from its
+// issuer.
+type provider struct {
+	config   oauth2.Config
+	verifier *gooidc.IDTokenVerifier
+}
+
+// discover retrieves the configuration of the provider of am. The client
+// secret of am must already be decrypted.
+func discover(ctx context.Context, am *AuthMethod, redirectURL string) (*provider, error) {
+	p, err := gooidc.NewProvider(ctx, am.GetIssuer())
+	if err != nil {
+		return nil, fmt.Errorf("discover provider %s: %w", am.GetIssuer(), err)
+	}
+	return &provider{
+		config: oauth2.Config{
+			ClientID:     am.GetClientId(),
+			ClientSecret: string(am.GetClientSecret()),
+			Endpoint:     p.Endpoint(),
+			RedirectURL:  redirectURL,
+			Scopes:       append([]string{gooidc.ScopeOpenID}, am.Scopes()...),
+		},
+		verifier: p.Verifier(&gooidc.Config{ClientID: am.GetClientId()}),
+	}, nil
+}
+
+// authURL returns the URL of the authorization endpoint the user is sent
+// to.
+func (p *provider) authURL(state, nonce, codeVerifier string) string {
+	return p.config.AuthCodeURL(state,
+		gooidc.Nonce(nonce),
+		oauth2.SetAuthURLParam("code_challenge", codeChallenge(codeVerifier)),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	)
+}
+
+// claims are the verified claims of an ID token.
+type claims struct {
+	subject string
+	all     map[string]interface{}
+}
+
+// exchange redeems code for an ID token and verifies the token was issued
+// for nonce. The returned error wraps ErrAuthenticationFailed if the
+// provider rejects the code or the token is not valid.
+func (p *provider) exchange(ctx context.Context, code, nonce, codeVerifier string) (*claims, error) {
+	tok, err := p.config.Exchange(ctx, code, oauth2.SetAuthURLParam("code_verifier", codeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("exchange code: %v: %w", err, ErrAuthenticationFailed)
+	}
+	raw, ok := tok.Extra("id_token").(string)
+	if !ok || raw == "" {
+		return nil, fmt.Errorf("token response has no id_token: %w", ErrAuthenticationFailed)
+	}
+	idToken, err := p.verifier.Verify(ctx, raw)
+	if err != nil {
+		return nil, fmt.Errorf("verify id token: %v: %w", err, ErrAuthenticationFailed)
+	}
+	if idToken.Nonce != nonce {
+		return nil, fmt.Errorf("verify id token: nonce does not match: %w", ErrAuthenticationFailed)
+	}
+	c := &claims{subject: idToken.Subject}
+	if err := idToken.Claims(&c.all); err != nil {
+		return nil, fmt.Errorf("verify id token: claims: %v: %w", err, ErrAuthenticationFailed)
+	}
+	return c, nil
+}
+
+// stringClaim returns the value of the named claim if it is a string.
+func (c *claims) stringClaim(name string) string {
+	s, _ := c.all[name].(string)
+	return s
+}
+
+// newRandom returns a random URL safe string encoding n bytes of entropy.
+// It is used for states, nonces, and PKCE code verifiers.
+func newRandom(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate random value: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// codeChallenge returns the S256 PKCE code challenge of verifier.
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
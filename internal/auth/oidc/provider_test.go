@@ -0,0 +1,122 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateIssuer(t *testing.T) {
+	t.Parallel()
+	var tests = []struct {
+		issuer  string
+		wantErr bool
+	}{
+		{issuer: "https://accounts.example.com"},
+		{issuer: "https://example.com/tenant/v2.0"},
+		{issuer: "http://127.0.0.1:5556/dex"},
+		{issuer: "http://localhost:8080"},
+		{issuer: "http://[::1]:8080"},
+		{issuer: "", wantErr: true},
+		{issuer: "https://", wantErr: true},
+		{issuer: "http://accounts.example.com", wantErr: true},
+		{issuer: "https://example.com?tenant=a", wantErr: true},
+		{issuer: "https://example.com#frag", wantErr: true},
+		{issuer: "ftp://example.com", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.issuer, func(t *testing.T) {
+			err := ValidateIssuer(tt.issuer)
+			if tt.wantErr {
+				assert.Truef(t, errors.Is(err, ErrInvalidIssuer), "want err: %q got: %q", ErrInvalidIssuer, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateRedirectURL(t *testing.T) {
+	t.Parallel()
+	var tests = []struct {
+		redirectURL string
+		wantErr     bool
+	}{
+		{redirectURL: "http://127.0.0.1:49152/callback"},
+		{redirectURL: "http://localhost:8080/callback"},
+		{redirectURL: "http://[::1]:8080/"},
+		{redirectURL: "", wantErr: true},
+		{redirectURL: "https://127.0.0.1:49152/callback", wantErr: true},
+		{redirectURL: "http://example.com:8080/callback", wantErr: true},
+		{redirectURL: "http://127.0.0.1/callback", wantErr: true},
+		{redirectURL: "http://127.0.0.1:8080/callback#frag", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.redirectURL, func(t *testing.T) {
+			err := ValidateRedirectURL(tt.redirectURL)
+			if tt.wantErr {
+				assert.Truef(t, errors.Is(err, ErrInvalidRedirectURL), "want err: %q got: %q", ErrInvalidRedirectURL, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestProvider_Exchange(t *testing.T) {
+	t.Parallel()
+	const redirectURL = "http://127.0.0.1:49152/callback"
+	ctx := context.Background()
+	tp := NewTestProvider(t)
+	am, err := NewAuthMethod("o_1234567890", tp.Issuer(), tp.ClientId(), WithClientSecret(tp.ClientSecret()))
+	require.NoError(t, err)
+
+	start := func(t *testing.T) (p *provider, nonce, verifier, code string) {
+		t.Helper()
+		require := require.New(t)
+		p, err := discover(ctx, am, redirectURL)
+		require.NoError(err)
+		rs, err := newRequestState("amoidc_1234567890", redirectURL)
+		require.NoError(err)
+		state, code := tp.Authorize(t, p.authURL(rs.State.State, rs.Nonce, rs.CodeVerifier))
+		require.Equal(rs.State.State, state)
+		require.NotEmpty(code)
+		return p, rs.Nonce, rs.CodeVerifier, code
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		p, nonce, verifier, code := start(t)
+		c, err := p.exchange(ctx, code, nonce, verifier)
+		require.NoError(err)
+		assert.Equal("alice", c.subject)
+		assert.Equal("alice@example.com", c.stringClaim("email"))
+		assert.Equal("Alice", c.stringClaim("name"))
+		assert.Empty(c.stringClaim("missing"))
+
+		// An authorization code can only be used once.
+		_, err = p.exchange(ctx, code, nonce, verifier)
+		assert.Truef(errors.Is(err, ErrAuthenticationFailed), "want err: %q got: %q", ErrAuthenticationFailed, err)
+	})
+	t.Run("wrong-nonce", func(t *testing.T) {
+		p, _, verifier, code := start(t)
+		_, err := p.exchange(ctx, code, "not-the-nonce", verifier)
+		assert.Truef(t, errors.Is(err, ErrAuthenticationFailed), "want err: %q got: %q", ErrAuthenticationFailed, err)
+	})
+	t.Run("wrong-verifier", func(t *testing.T) {
+		p, nonce, _, code := start(t)
+		_, err := p.exchange(ctx, code, nonce, "not-the-verifier")
+		assert.Truef(t, errors.Is(err, ErrAuthenticationFailed), "want err: %q got: %q", ErrAuthenticationFailed, err)
+	})
+	t.Run("wrong-secret", func(t *testing.T) {
+		p, nonce, verifier, code := start(t)
+		p.config.ClientSecret = "not-the-secret"
+		_, err := p.exchange(ctx, code, nonce, verifier)
+		assert.Truef(t, errors.Is(err, ErrAuthenticationFailed), "want err: %q got: %q", ErrAuthenticationFailed, err)
+	})
+}
@@ -0,0 +1,9 @@
+package oidc
+
+const (
+	deleteExpiredStatesQuery = `
+delete from auth_oidc_state
+ where auth_method_id = $1
+   and expiration_time <= current_timestamp;
+`
+)
@@ -0,0 +1,189 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/auth/external"
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// StartAuthentication starts an authorization code flow with the provider
+// of the auth method authMethodId in scopeId. It stores a new state, nonce,
+// and PKCE code verifier and returns the URL of the provider's
+// authorization endpoint the user must be sent to along with the state.
+// After the user authenticates, the provider sends the user to redirectURL
+// with the state and an authorization code which are passed to
+// Authenticate. redirectURL must be an http URL on a loopback address.
+//
+// Expired states of the auth method are deleted. All options are ignored.
+func (r *Repository) StartAuthentication(ctx context.Context, scopeId, authMethodId, redirectURL string, opt ...Option) (authURL string, state string, err error) {
+	if authMethodId == "" {
+		return "", "", fmt.Errorf("oidc start authentication: no authMethodId: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return "", "", fmt.Errorf("oidc start authentication: no scopeId: %w", db.ErrInvalidParameter)
+	}
+	if err := ValidateRedirectURL(redirectURL); err != nil {
+		return "", "", fmt.Errorf("oidc start authentication: %w", err)
+	}
+
+	am, err := r.lookupAuthMethodWithSecret(ctx, scopeId, authMethodId)
+	if err != nil {
+		return "", "", fmt.Errorf("oidc start authentication: %w", err)
+	}
+	if am == nil {
+		return "", "", fmt.Errorf("oidc start authentication: auth method %s: %w", authMethodId, db.ErrRecordNotFound)
+	}
+	p, err := discover(ctx, am, redirectURL)
+	if err != nil {
+		return "", "", fmt.Errorf("oidc start authentication: %w", err)
+	}
+
+	rs, err := newRequestState(authMethodId, redirectURL)
+	if err != nil {
+		return "", "", fmt.Errorf("oidc start authentication: %w", err)
+	}
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, deleteExpiredStatesQuery, []interface{}{authMethodId}); err != nil {
+				return err
+			}
+			return w.Create(ctx, rs)
+		},
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("oidc start authentication: %w", err)
+	}
+	return p.authURL(rs.State.State, rs.Nonce, rs.CodeVerifier), rs.State.State, nil
+}
+
+// Authenticate completes the authorization code flow started by
+// StartAuthentication with the state and code the provider sent to the
+// redirect URL. The state can only be used once. The code is exchanged for
+// an ID token which is verified with the keys of the provider.
+//
+// The Account for the subject of the ID token is returned. If the subject
+// has not authenticated with the auth method before, a new Account is
+// created. The email and full name of the Account are updated from the
+// claims of the ID token.
+//
+// The returned error wraps ErrInvalidState if state is unknown, has been
+// used, or has expired and ErrAuthenticationFailed if the provider rejects
+// the code or the ID token is not valid. All options are ignored.
+func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, state, code string, opt ...Option) (*Account, error) {
+	if authMethodId == "" {
+		return nil, fmt.Errorf("oidc authenticate: no authMethodId: %w", db.ErrInvalidParameter)
+	}
+	if state == "" {
+		return nil, fmt.Errorf("oidc authenticate: no state: %w", db.ErrInvalidParameter)
+	}
+	if code == "" {
+		return nil, fmt.Errorf("oidc authenticate: no code: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return nil, fmt.Errorf("oidc authenticate: no scopeId: %w", db.ErrInvalidParameter)
+	}
+
+	rs, err := r.consumeState(ctx, authMethodId, state)
+	if err != nil {
+		return nil, fmt.Errorf("oidc authenticate: %w", err)
+	}
+
+	am, err := r.lookupAuthMethodWithSecret(ctx, scopeId, authMethodId)
+	if err != nil {
+		return nil, fmt.Errorf("oidc authenticate: %w", err)
+	}
+	if am == nil {
+		return nil, fmt.Errorf("oidc authenticate: auth method %s: %w", authMethodId, db.ErrRecordNotFound)
+	}
+	p, err := discover(ctx, am, rs.RedirectUrl)
+	if err != nil {
+		return nil, fmt.Errorf("oidc authenticate: %w", err)
+	}
+	c, err := p.exchange(ctx, code, rs.Nonce, rs.CodeVerifier)
+	if err != nil {
+		return nil, fmt.Errorf("oidc authenticate: %w", err)
+	}
+
+	acct, err := r.upsertAccount(ctx, scopeId, am, c)
+	if err != nil {
+		return nil, fmt.Errorf("oidc authenticate: %w", err)
+	}
+	return acct, nil
+}
+
+// consumeState deletes and returns the unexpired state of authMethodId.
+func (r *Repository) consumeState(ctx context.Context, authMethodId, state string) (*requestState, error) {
+	rs := allocRequestState()
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			if err := reader.LookupWhere(ctx, rs, "state = ? and auth_method_id = ? and expiration_time > current_timestamp", state, authMethodId); err != nil {
+				if errors.Is(err, db.ErrRecordNotFound) {
+					return ErrInvalidState
+				}
+				return err
+			}
+			rowsDeleted, err := w.Delete(ctx, rs.clone())
+			switch {
+			case err != nil:
+				return err
+			case rowsDeleted == 0:
+				// Another request used the state first.
+				return ErrInvalidState
+			case rowsDeleted > 1:
+				return db.ErrMultipleRecords
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("consume state: %w", err)
+	}
+	return rs, nil
+}
+
+// upsertAccount returns the account for the subject of c, creating it if
+// it does not exist and updating its email and full name from the claims
+// of c if they have changed.
+func (r *Repository) upsertAccount(ctx context.Context, scopeId string, am *AuthMethod, c *claims) (*Account, error) {
+	a, err := NewAccount(am.PublicId, c.subject, WithEmail(c.stringClaim(emailClaim(am))), WithFullName(c.stringClaim(nameClaim(am))))
+	if err != nil {
+		return nil, err
+	}
+	acct, err := external.UpsertAccount(ctx, r.writer, r.kms, scopeId, a.Attributes(),
+		func(ctx context.Context) (external.Account, error) {
+			acct, err := r.lookupAccountBySubject(ctx, am.PublicId, c.subject)
+			if err != nil || acct == nil {
+				return nil, err
+			}
+			return acct, nil
+		},
+		func(ctx context.Context) (external.Account, error) {
+			acct, err := r.CreateAccount(ctx, scopeId, a)
+			if err != nil {
+				return nil, err
+			}
+			return acct, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return acct.(*Account), nil
+}
+
+func emailClaim(am *AuthMethod) string {
+	if am.GetEmailClaim() == "" {
+		return DefaultEmailClaim
+	}
+	return am.GetEmailClaim()
+}
+
+func nameClaim(am *AuthMethod) string {
+	if am.GetNameClaim() == "" {
+		return DefaultNameClaim
+	}
+	return am.GetNameClaim()
+}
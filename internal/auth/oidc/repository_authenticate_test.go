@@ -0,0 +1,69 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Authenticate(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	tp := NewTestProvider(t)
+	am := TestAuthMethods(t, conn, kms, org.PublicId, tp.Issuer(), 1)[0]
+
+	const redirectURL = "http://127.0.0.1:49152/callback"
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	authURL, state, err := repo.StartAuthentication(ctx, org.PublicId, am.PublicId, redirectURL)
+	require.NoError(err)
+	gotState, code := tp.Authorize(t, authURL)
+	require.Equal(state, gotState)
+
+	acct, err := repo.Authenticate(ctx, org.PublicId, am.PublicId, state, code)
+	require.NoError(err)
+	assert.Equal(am.PublicId, acct.AuthMethodId)
+	assert.Equal("alice", acct.Subject)
+	assert.Equal("alice@example.com", acct.Email)
+	assert.Equal("Alice", acct.FullName)
+
+	// The state can only be used once.
+	_, err = repo.Authenticate(ctx, org.PublicId, am.PublicId, state, code)
+	assert.Truef(errors.Is(err, ErrInvalidState), "want err: %q got: %q", ErrInvalidState, err)
+
+	// Authenticating again returns the same account with updated claims.
+	tp.SetSubject("alice", map[string]interface{}{"email": "alice@corp.example.com"})
+	authURL, state, err = repo.StartAuthentication(ctx, org.PublicId, am.PublicId, redirectURL)
+	require.NoError(err)
+	_, code = tp.Authorize(t, authURL)
+	again, err := repo.Authenticate(ctx, org.PublicId, am.PublicId, state, code)
+	require.NoError(err)
+	assert.Equal(acct.PublicId, again.PublicId)
+	assert.Equal("alice@corp.example.com", again.Email)
+	assert.Empty(again.FullName)
+
+	// A code issued for another state is rejected.
+	authURL, _, err = repo.StartAuthentication(ctx, org.PublicId, am.PublicId, redirectURL)
+	require.NoError(err)
+	_, code = tp.Authorize(t, authURL)
+	_, otherState, err := repo.StartAuthentication(ctx, org.PublicId, am.PublicId, redirectURL)
+	require.NoError(err)
+	_, err = repo.Authenticate(ctx, org.PublicId, am.PublicId, otherState, code)
+	assert.Truef(errors.Is(err, ErrAuthenticationFailed), "want err: %q got: %q", ErrAuthenticationFailed, err)
+
+	_, _, err = repo.StartAuthentication(ctx, org.PublicId, am.PublicId, "https://attacker.example.com/callback")
+	assert.Truef(errors.Is(err, ErrInvalidRedirectURL), "want err: %q got: %q", ErrInvalidRedirectURL, err)
+}
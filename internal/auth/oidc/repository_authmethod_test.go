@@ -0,0 +1,126 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateAuthMethod(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	var tests = []struct {
+		name      string
+		issuer    string
+		clientId  string
+		opts      []Option
+		wantIsErr error
+	}{
+		{
+			name:     "valid",
+			issuer:   "https://accounts.example.com",
+			clientId: "client",
+			opts:     []Option{WithClientSecret("secret"), WithName("corp"), WithClaimsScopes("email", "profile")},
+		},
+		{
+			name:      "invalid-issuer",
+			issuer:    "http://accounts.example.com",
+			clientId:  "client",
+			opts:      []Option{WithClientSecret("secret")},
+			wantIsErr: ErrInvalidIssuer,
+		},
+		{
+			name:      "no-client-id",
+			issuer:    "https://accounts.example.com",
+			opts:      []Option{WithClientSecret("secret")},
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "no-client-secret",
+			issuer:    "https://accounts.example.com",
+			clientId:  "client",
+			wantIsErr: db.ErrInvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			in, err := NewAuthMethod(org.PublicId, tt.issuer, tt.clientId, tt.opts...)
+			require.NoError(err)
+			got, err := repo.CreateAuthMethod(ctx, in)
+			if tt.wantIsErr != nil {
+				assert.Truef(errors.Is(err, tt.wantIsErr), "want err: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.True(len(got.PublicId) > len(AuthMethodPrefix))
+			assert.Equal("email profile", got.ClaimsScopes)
+			assert.Equal(DefaultEmailClaim, got.EmailClaim)
+			assert.Empty(got.ClientSecret, "the client secret must not be returned")
+			assert.NotEmpty(got.CtClientSecret)
+
+			found, err := repo.lookupAuthMethodWithSecret(ctx, org.PublicId, got.PublicId)
+			require.NoError(err)
+			assert.Equal("secret", string(found.ClientSecret))
+		})
+	}
+}
+
+func TestRepository_UpdateAuthMethod(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+	am := TestAuthMethods(t, conn, kms, org.PublicId, "https://accounts.example.com", 1)[0]
+
+	up := am.clone()
+	up.Name = "corp"
+	up.ClientSecret = []byte("rotated")
+	up.EmailClaim = "mail"
+	got, rows, err := repo.UpdateAuthMethod(ctx, up, am.Version, []string{"Name", "ClientSecret", "EmailClaim"})
+	require.NoError(err)
+	assert.Equal(1, rows)
+	assert.Equal("corp", got.Name)
+	assert.Equal("mail", got.EmailClaim)
+	assert.Empty(got.ClientSecret)
+
+	found, err := repo.lookupAuthMethodWithSecret(ctx, org.PublicId, am.PublicId)
+	require.NoError(err)
+	assert.Equal("rotated", string(found.ClientSecret))
+
+	// Clearing the email claim restores the default.
+	up = got.clone()
+	up.EmailClaim = ""
+	got, rows, err = repo.UpdateAuthMethod(ctx, up, got.Version, []string{"EmailClaim"})
+	require.NoError(err)
+	assert.Equal(1, rows)
+	assert.Equal(DefaultEmailClaim, got.EmailClaim)
+
+	up = got.clone()
+	up.Issuer = "http://accounts.example.com"
+	_, _, err = repo.UpdateAuthMethod(ctx, up, got.Version, []string{"Issuer"})
+	assert.Truef(errors.Is(err, ErrInvalidIssuer), "want err: %q got: %q", ErrInvalidIssuer, err)
+}
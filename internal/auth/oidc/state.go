@@ -0,0 +1,87 @@
+package oidc
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/boundary/internal/auth/oidc/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"google.golang.org/protobuf/proto"
+)
+
+// stateTTL is how long a user has to complete an authentication request
+// after it has been started.
+const stateTTL = 10 * time.Minute
+
+// Sizes in bytes of the random values of an authentication request. The
+// code verifier is encoded to 43 characters, the minimum length allowed by
+// RFC 7636.
+const (
+	stateSize        = 20
+	nonceSize        = 20
+	codeVerifierSize = 32
+)
+
+// A requestState holds the values of an authentication request which has
+// been started but not yet completed. It is never written to the oplog.
+type requestState struct {
+	*store.State
+	tableName string
+}
+
+func allocRequestState() *requestState {
+	return &requestState{
+		State: &store.State{},
+	}
+}
+
+// newRequestState creates a new in memory requestState for authMethodId
+// with a random state, nonce, and PKCE code verifier.
+func newRequestState(authMethodId, redirectURL string) (*requestState, error) {
+	state, err := newRandom(stateSize)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := newRandom(nonceSize)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := newRandom(codeVerifierSize)
+	if err != nil {
+		return nil, err
+	}
+	expiration, err := ptypes.TimestampProto(time.Now().Add(stateTTL).Truncate(time.Second))
+	if err != nil {
+		return nil, err
+	}
+	return &requestState{
+		State: &store.State{
+			State:          state,
+			AuthMethodId:   authMethodId,
+			Nonce:          nonce,
+			CodeVerifier:   verifier,
+			RedirectUrl:    redirectURL,
+			ExpirationTime: &timestamp.Timestamp{Timestamp: expiration},
+		},
+	}, nil
+}
+
+func (s *requestState) clone() *requestState {
+	cp := proto.Clone(s.State)
+	return &requestState{
+		State: cp.(*store.State),
+	}
+}
+
+// TableName returns the table name.
+func (s *requestState) TableName() string {
+	if s.tableName != "" {
+		return s.tableName
+	}
+	return "auth_oidc_state"
+}
+
+// SetTableName sets the table name.
+func (s *requestState) SetTableName(n string) {
+	s.tableName = n
+}
@@ -0,0 +1,353 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
+)
+
+// TestAuthMethods creates count number of oidc auth methods to the provided
+// DB with the provided scope id for the provider at issuer. The client id
+// and secret of the auth methods are "test-client" and "test-secret". If
+// any errors are encountered during the creation of the auth methods, the
+// test will fail.
+func TestAuthMethods(t *testing.T, conn *gorm.DB, kmsCache *kms.Kms, scopeId, issuer string, count int) []*AuthMethod {
+	t.Helper()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	w := db.New(conn)
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+	var auts []*AuthMethod
+	for i := 0; i < count; i++ {
+		cat, err := NewAuthMethod(scopeId, issuer, "test-client", WithClientSecret("test-secret"))
+		assert.NoError(err)
+		require.NotNil(cat)
+		id, err := newAuthMethodId()
+		assert.NoError(err)
+		require.NotEmpty(id)
+		cat.PublicId = id
+		require.NoError(cat.encrypt(ctx, databaseWrapper))
+
+		_, err2 := w.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+			func(_ db.Reader, iw db.Writer) error {
+				return iw.Create(ctx, cat)
+			},
+		)
+
+		require.NoError(err2)
+		auts = append(auts, cat)
+	}
+	return auts
+}
+
+// TestAccounts creates count number of oidc accounts to the provided DB
+// with the provided auth method id. The subjects of the accounts are
+// "subject0" to "subject<count-1>". The auth method must have been created
+// previously. If any errors are encountered during the creation of the
+// account, the test will fail.
+func TestAccounts(t *testing.T, conn *gorm.DB, authMethodId string, count int) []*Account {
+	t.Helper()
+	assert, require := assert.New(t), require.New(t)
+	w := db.New(conn)
+	var auts []*Account
+	for i := 0; i < count; i++ {
+		cat, err := NewAccount(authMethodId, fmt.Sprintf("subject%d", i))
+		assert.NoError(err)
+		require.NotNil(cat)
+		id, err := newAccountId()
+		assert.NoError(err)
+		require.NotEmpty(id)
+		cat.PublicId = id
+
+		ctx := context.Background()
+		_, err2 := w.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+			func(_ db.Reader, iw db.Writer) error {
+				return iw.Create(ctx, cat)
+			},
+		)
+
+		require.NoError(err2)
+		auts = append(auts, cat)
+	}
+	return auts
+}
+
+// TestProvider is an in-process stand-in for an OpenID Provider. It serves
+// the discovery document, a JWKS, an authorization endpoint, and a token
+// endpoint from an httptest.Server on a loopback address.
+//
+// The authorization endpoint never prompts the user: every request is
+// authenticated as the provider's current subject and immediately
+// redirected back to the redirect_uri with an authorization code. The token
+// endpoint enforces the client credentials, the redirect_uri, and the PKCE
+// code verifier and issues RS256 signed ID tokens.
+type TestProvider struct {
+	server       *httptest.Server
+	key          *rsa.PrivateKey
+	keyId        string
+	clientId     string
+	clientSecret string
+
+	mu       sync.Mutex
+	subject  string
+	claims   map[string]interface{}
+	requests map[string]*testAuthRequest
+}
+
+type testAuthRequest struct {
+	redirectURI   string
+	nonce         string
+	codeChallenge string
+	subject       string
+	claims        map[string]interface{}
+}
+
+// NewTestProvider starts a TestProvider for the client "test-client" with
+// the secret "test-secret" which authenticates users as the subject
+// "alice" with the email "alice@example.com" and the name "Alice". The
+// provider is stopped when the test completes.
+func NewTestProvider(t *testing.T) *TestProvider {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	p := &TestProvider{
+		key:          key,
+		keyId:        "test-key",
+		clientId:     "test-client",
+		clientSecret: "test-secret",
+		subject:      "alice",
+		claims: map[string]interface{}{
+			"email": "alice@example.com",
+			"name":  "Alice",
+		},
+		requests: make(map[string]*testAuthRequest),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.handleDiscovery)
+	mux.HandleFunc("/keys", p.handleKeys)
+	mux.HandleFunc("/authorize", p.handleAuthorize)
+	mux.HandleFunc("/token", p.handleToken)
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+// Issuer returns the issuer URL of the provider.
+func (p *TestProvider) Issuer() string {
+	return p.server.URL
+}
+
+// ClientId returns the client id the provider accepts.
+func (p *TestProvider) ClientId() string {
+	return p.clientId
+}
+
+// ClientSecret returns the client secret the provider accepts.
+func (p *TestProvider) ClientSecret() string {
+	return p.clientSecret
+}
+
+// SetSubject sets the subject and the additional claims of the user the
+// provider authenticates from now on.
+func (p *TestProvider) SetSubject(subject string, claims map[string]interface{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.subject = subject
+	p.claims = claims
+}
+
+// Authorize performs the browser's side of the authorization code flow:
+// it requests authURL and returns the state and code the provider
+// redirects to the redirect_uri with. The test fails if the provider does
+// not redirect.
+func (p *TestProvider) Authorize(t *testing.T, authURL string) (state, code string) {
+	t.Helper()
+	require := require.New(t)
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get(authURL)
+	require.NoError(err)
+	defer resp.Body.Close()
+	require.Equal(http.StatusFound, resp.StatusCode)
+	loc, err := resp.Location()
+	require.NoError(err)
+	q := loc.Query()
+	require.Empty(q.Get("error"), q.Get("error_description"))
+	return q.Get("state"), q.Get("code")
+}
+
+func (p *TestProvider) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	issuer := p.Issuer()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                issuer,
+		"authorization_endpoint":                issuer + "/authorize",
+		"token_endpoint":                        issuer + "/token",
+		"jwks_uri":                              issuer + "/keys",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (p *TestProvider) handleKeys(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{{
+			Key:       &p.key.PublicKey,
+			KeyID:     p.keyId,
+			Algorithm: string(jose.RS256),
+			Use:       "sig",
+		}},
+	})
+}
+
+func (p *TestProvider) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	redirectURI := q.Get("redirect_uri")
+	if redirectURI == "" || q.Get("client_id") != p.clientId {
+		http.Error(w, "unknown client or missing redirect_uri", http.StatusBadRequest)
+		return
+	}
+	redirect, err := url.Parse(redirectURI)
+	if err != nil {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	params := url.Values{"state": {q.Get("state")}}
+	switch {
+	case q.Get("response_type") != "code":
+		params.Set("error", "unsupported_response_type")
+	case !strings.Contains(" "+q.Get("scope")+" ", " openid "):
+		params.Set("error", "invalid_scope")
+	case q.Get("code_challenge") == "" || q.Get("code_challenge_method") != "S256":
+		params.Set("error", "invalid_request")
+		params.Set("error_description", "an S256 code_challenge is required")
+	default:
+		code, err := newRandom(20)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		p.mu.Lock()
+		p.requests[code] = &testAuthRequest{
+			redirectURI:   redirectURI,
+			nonce:         q.Get("nonce"),
+			codeChallenge: q.Get("code_challenge"),
+			subject:       p.subject,
+			claims:        p.claims,
+		}
+		p.mu.Unlock()
+		params.Set("code", code)
+	}
+	redirect.RawQuery = params.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (p *TestProvider) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	clientId, clientSecret, ok := r.BasicAuth()
+	if ok {
+		clientId, _ = url.QueryUnescape(clientId)
+		clientSecret, _ = url.QueryUnescape(clientSecret)
+	} else {
+		clientId, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientId != p.clientId || clientSecret != p.clientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	code := r.PostForm.Get("code")
+	p.mu.Lock()
+	req, ok := p.requests[code]
+	delete(p.requests, code)
+	p.mu.Unlock()
+	if !ok ||
+		req.redirectURI != r.PostForm.Get("redirect_uri") ||
+		req.codeChallenge != codeChallenge(r.PostForm.Get("code_verifier")) {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	claims := map[string]interface{}{}
+	for k, v := range req.claims {
+		claims[k] = v
+	}
+	claims["iss"] = p.Issuer()
+	claims["sub"] = req.subject
+	claims["aud"] = p.clientId
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(5 * time.Minute).Unix()
+	if req.nonce != "" {
+		claims["nonce"] = req.nonce
+	}
+	idToken, err := p.sign(claims)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+	accessToken, err := newRandom(20)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
+
+func (p *TestProvider) sign(claims map[string]interface{}) (string, error) {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: jose.JSONWebKey{Key: p.key, KeyID: p.keyId}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	jws, err := signer.Sign(payload)
+	if err != nil {
+		return "", err
+	}
+	return jws.CompactSerialize()
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
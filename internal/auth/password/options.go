@@ -1,5 +1,7 @@
 package password
 
+import "github.com/hashicorp/boundary/internal/db"
+
 // getOpts - iterate the inbound Options and return a struct.
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -18,6 +20,7 @@ type options struct {
 	withDescription string
 	withLoginName   string
 	withLimit       int
+	withPageAfter   *db.PageAfter
 	withConfig      Configuration
 	withPublicId    string
 	password        string
@@ -67,6 +70,15 @@ func WithLimit(l int) Option {
 	}
 }
 
+// WithPageAfter provides an option to list only the items after the given
+// position, ordered by create time and public id, so that a list can be paged
+// through with WithLimit.
+func WithPageAfter(after *db.PageAfter) Option {
+	return func(o *options) {
+		o.withPageAfter = after
+	}
+}
+
 // WithPassword provides an optional password.
 func WithPassword(password string) Option {
 	return func(o *options) {
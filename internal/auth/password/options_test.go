@@ -3,6 +3,7 @@ package password
 import (
 	"testing"
 
+	"github.com/hashicorp/boundary/internal/db"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -39,6 +40,13 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withLimit = 5
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithPageAfter", func(t *testing.T) {
+		after := &db.PageAfter{PublicId: "test"}
+		opts := getOpts(WithPageAfter(after))
+		testOpts := getDefaultOptions()
+		testOpts.withPageAfter = after
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithPassword", func(t *testing.T) {
 		opts := getOpts(WithPassword("test password"))
 		testOpts := getDefaultOptions()
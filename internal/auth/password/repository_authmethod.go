@@ -112,7 +112,7 @@ func (r *Repository) LookupAuthMethod(ctx context.Context, publicId string, opt
 	return &a, nil
 }
 
-// ListAuthMethods returns a slice of AuthMethods for the scopeId. WithLimit is the only option supported.
+// ListAuthMethods returns a slice of AuthMethods for the scopeId. Supports the WithLimit and WithPageAfter options.
 func (r *Repository) ListAuthMethods(ctx context.Context, scopeId string, opt ...Option) ([]*AuthMethod, error) {
 	if scopeId == "" {
 		return nil, fmt.Errorf("list: password auth method: missing scope id: %w", db.ErrInvalidParameter)
@@ -124,7 +124,7 @@ func (r *Repository) ListAuthMethods(ctx context.Context, scopeId string, opt ..
 		limit = opts.withLimit
 	}
 	var authMethods []*AuthMethod
-	err := r.reader.SearchWhere(ctx, &authMethods, "scope_id = ?", []interface{}{scopeId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &authMethods, "scope_id = ?", []interface{}{scopeId}, db.WithLimit(limit), db.WithPageAfter(opts.withPageAfter))
 	if err != nil {
 		return nil, fmt.Errorf("list: password auth method: %w", err)
 	}
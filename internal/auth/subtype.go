@@ -3,6 +3,8 @@ package auth
 import (
 	"strings"
 
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 )
 
@@ -11,12 +13,18 @@ type SubType int
 const (
 	UnknownSubtype SubType = iota
 	PasswordSubtype
+	OidcSubtype
+	LdapSubtype
 )
 
 func (t SubType) String() string {
 	switch t {
 	case PasswordSubtype:
 		return "password"
+	case OidcSubtype:
+		return "oidc"
+	case LdapSubtype:
+		return "ldap"
 	}
 	return "unknown"
 }
@@ -27,6 +35,10 @@ func SubtypeFromType(t string) SubType {
 	switch {
 	case strings.EqualFold(strings.TrimSpace(t), PasswordSubtype.String()):
 		return PasswordSubtype
+	case strings.EqualFold(strings.TrimSpace(t), OidcSubtype.String()):
+		return OidcSubtype
+	case strings.EqualFold(strings.TrimSpace(t), LdapSubtype.String()):
+		return LdapSubtype
 	}
 	return UnknownSubtype
 }
@@ -39,6 +51,12 @@ func SubtypeFromId(id string) SubType {
 	case strings.HasPrefix(strings.TrimSpace(id), password.AuthMethodPrefix),
 		strings.HasPrefix(strings.TrimSpace(id), password.AccountPrefix):
 		return PasswordSubtype
+	case strings.HasPrefix(strings.TrimSpace(id), oidc.AuthMethodPrefix),
+		strings.HasPrefix(strings.TrimSpace(id), oidc.AccountPrefix):
+		return OidcSubtype
+	case strings.HasPrefix(strings.TrimSpace(id), ldap.AuthMethodPrefix),
+		strings.HasPrefix(strings.TrimSpace(id), ldap.AccountPrefix):
+		return LdapSubtype
 	}
 	return UnknownSubtype
 }
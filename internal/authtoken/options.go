@@ -1,5 +1,7 @@
 package authtoken
 
+import "github.com/hashicorp/boundary/internal/db"
+
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -16,6 +18,7 @@ type Option func(*options)
 type options struct {
 	withTokenValue bool
 	withLimit      int
+	withPageAfter  *db.PageAfter
 }
 
 func getDefaultOptions() options {
@@ -38,3 +41,12 @@ func WithLimit(limit int) Option {
 		o.withLimit = limit
 	}
 }
+
+// WithPageAfter provides an option to list only the items after the given
+// position, ordered by create time and public id, so that a list can be paged
+// through with WithLimit.
+func WithPageAfter(after *db.PageAfter) Option {
+	return func(o *options) {
+		o.withPageAfter = after
+	}
+}
@@ -271,7 +271,7 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 	return retAT, nil
 }
 
-// ListAuthTokens in an org and supports the WithLimit option.
+// ListAuthTokens in an org and supports the WithLimit and WithPageAfter options.
 func (r *Repository) ListAuthTokens(ctx context.Context, withOrgId string, opt ...Option) ([]*AuthToken, error) {
 	if withOrgId == "" {
 		return nil, fmt.Errorf("list users: missing org id %w", db.ErrInvalidParameter)
@@ -283,7 +283,7 @@ func (r *Repository) ListAuthTokens(ctx context.Context, withOrgId string, opt .
 		limit = opts.withLimit
 	}
 	var authTokens []*AuthToken
-	if err := r.reader.SearchWhere(ctx, &authTokens, "auth_account_id in (select public_id from auth_account where scope_id = ?)", []interface{}{withOrgId}, db.WithLimit(limit)); err != nil {
+	if err := r.reader.SearchWhere(ctx, &authTokens, "auth_account_id in (select public_id from auth_account where scope_id = ?)", []interface{}{withOrgId}, db.WithLimit(limit), db.WithPageAfter(opts.withPageAfter)); err != nil {
 		return nil, fmt.Errorf("list users: %w", err)
 	}
 	for _, at := range authTokens {
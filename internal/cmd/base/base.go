@@ -71,6 +71,9 @@ type Command struct {
 	FlagAuthMethodId  string
 	FlagHostCatalogId string
 	FlagVersion       int
+	FlagFilter        string
+	FlagPageSize      uint
+	FlagRecursive     bool
 
 	client *api.Client
 }
@@ -107,6 +110,24 @@ func MakeShutdownCh() chan struct{} {
 	return resultCh
 }
 
+// MakeForceShutdownCh returns a channel that receives a message for every
+// SIGINT or SIGTERM after the first, which closes the channel of
+// MakeShutdownCh, so that a command can cut a graceful shutdown short.
+func MakeForceShutdownCh() chan struct{} {
+	resultCh := make(chan struct{})
+
+	shutdownCh := make(chan os.Signal, 4)
+	signal.Notify(shutdownCh, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-shutdownCh
+		for {
+			<-shutdownCh
+			resultCh <- struct{}{}
+		}
+	}()
+	return resultCh
+}
+
 // Client returns the HTTP API client. The client is cached on the command to
 // save performance on future calls.
 func (c *Command) Client(opt ...Option) (*api.Client, error) {
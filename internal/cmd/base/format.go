@@ -202,3 +202,92 @@ func Format(ui cli.Ui) string {
 
 	return format
 }
+
+// ListPrinter outputs the items of a list one at a time, as their pages
+// arrive from the controller, rather than once the whole list has been read.
+// Table output starts with a header; JSON output is an array holding the
+// items, or null if there are none.
+type ListPrinter struct {
+	ui        cli.Ui
+	format    string
+	header    string
+	noneFound string
+
+	count int
+	// pending is the JSON of the last item printed, which is output once it
+	// is known whether another item follows it
+	pending []byte
+	opened  bool
+	err     error
+}
+
+// NewListPrinter returns a ListPrinter outputting to ui in its format, with
+// the given table header, and noneFound as the table output of an empty list.
+func NewListPrinter(ui cli.Ui, header, noneFound string) *ListPrinter {
+	return &ListPrinter{
+		ui:        ui,
+		format:    Format(ui),
+		header:    header,
+		noneFound: noneFound,
+	}
+}
+
+// Print outputs item, using lines as its table output.
+func (p *ListPrinter) Print(item interface{}, lines []string) {
+	if p.err != nil {
+		return
+	}
+	p.count++
+	switch p.format {
+	case "json":
+		b, err := JsonFormatter{}.Format(item)
+		if err != nil {
+			p.err = err
+			return
+		}
+		if p.pending != nil {
+			p.outputPending(",")
+		}
+		p.pending = b
+
+	case "table":
+		output := []string{""}
+		if p.count == 1 {
+			output = append(output, p.header)
+		}
+		p.ui.Output(WrapForHelpText(append(output, lines...)))
+	}
+}
+
+// Finish completes the output of the list and returns the exit code of the
+// command.
+func (p *ListPrinter) Finish() int {
+	if p.err != nil {
+		p.ui.Error(fmt.Errorf("Error formatting as JSON: %w", p.err).Error())
+		return 1
+	}
+	switch p.format {
+	case "json":
+		if p.count == 0 {
+			p.ui.Output("null")
+			return 0
+		}
+		p.outputPending("]")
+	case "table":
+		if p.count == 0 {
+			p.ui.Output(p.noneFound)
+		}
+	}
+	return 0
+}
+
+// outputPending outputs the pending item followed by suffix, opening the
+// array if it is the first item
+func (p *ListPrinter) outputPending(suffix string) {
+	prefix := ""
+	if !p.opened {
+		prefix = "["
+		p.opened = true
+	}
+	p.ui.Output(prefix + string(p.pending) + suffix)
+}
@@ -70,6 +70,10 @@ func tcpListenerFactory(l *configutil.Listener, logger hclog.Logger, ui cli.Ui)
 			l.Address = "127.0.0.1:9201"
 		case "proxy":
 			l.Address = "127.0.0.1:9202"
+		case "ops":
+			l.Address = "127.0.0.1:9203"
+		case "grpc":
+			l.Address = "127.0.0.1:9204"
 		default:
 			l.Address = "127.0.0.1:9200"
 		}
@@ -83,6 +87,10 @@ func tcpListenerFactory(l *configutil.Listener, logger hclog.Logger, ui cli.Ui)
 				port = "9201"
 			case "proxy":
 				port = "9202"
+			case "ops":
+				port = "9203"
+			case "grpc":
+				port = "9204"
 			default:
 				port = "9200"
 			}
@@ -0,0 +1,79 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/libs/alpnmux"
+	"github.com/hashicorp/boundary/sdk/strutil"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// StartOpsServers serves the operational endpoints on the listeners with the
+// "ops" purpose. Currently that is /metrics, which exports the metrics of the
+// controller and worker running in this process in the Prometheus text
+// format. The servers are shut down by the shutdown funcs.
+func (b *Server) StartOpsServers() error {
+	for _, ln := range b.Listeners {
+		if !strutil.StrListContains(ln.Config.Purpose, "ops") {
+			continue
+		}
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", b.metricsHandler())
+
+		server := &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+			ReadTimeout:       30 * time.Second,
+			IdleTimeout:       5 * time.Minute,
+			ErrorLog:          b.Logger.StandardLogger(nil),
+		}
+
+		var listeners []net.Listener
+		switch ln.Config.TLSDisable {
+		case true:
+			l, err := ln.Mux.RegisterProto(alpnmux.NoProto, nil)
+			if err != nil {
+				return fmt.Errorf("error getting non-tls listener: %w", err)
+			}
+			if l == nil {
+				return errors.New("could not get non-tls listener")
+			}
+			listeners = append(listeners, l)
+
+		default:
+			for _, v := range []string{"", "http/1.1", "h2"} {
+				l := ln.Mux.GetListener(v)
+				if l == nil {
+					return fmt.Errorf("could not get tls proto %q listener", v)
+				}
+				listeners = append(listeners, l)
+			}
+		}
+		for _, l := range listeners {
+			go server.Serve(l)
+		}
+
+		maxRequestDuration := ln.Config.MaxRequestDuration
+		b.ShutdownFuncs = append(b.ShutdownFuncs, func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), maxRequestDuration)
+			defer cancel()
+			return server.Shutdown(ctx)
+		})
+	}
+	return nil
+}
+
+func (b *Server) metricsHandler() http.Handler {
+	if !b.PrometheusEnabled {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "Prometheus metrics are disabled, as telemetry.prometheus_retention_time is 0.", http.StatusNotFound)
+		})
+	}
+	return promhttp.Handler()
+}
@@ -192,6 +192,13 @@ func (b *Server) RemovePidFile(pidPath string) error {
 func (b *Server) SetupMetrics(ui cli.Ui, telemetry *configutil.Telemetry) error {
 	// TODO: Figure out a user-agent we want to use for the last param
 	// TODO: Do we want different names for different components?
+	if telemetry == nil {
+		// Parsing a telemetry block defaults the retention time, so without
+		// one Prometheus is enabled the same way for the ops listeners
+		telemetry = &configutil.Telemetry{
+			PrometheusRetentionTime: configutil.PrometheusDefaultRetentionTime,
+		}
+	}
 	var err error
 	b.InmemSink, _, b.PrometheusEnabled, err = configutil.SetupTelemetry(&configutil.SetupTelemetryOpts{
 		Config:      telemetry,
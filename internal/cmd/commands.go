@@ -12,12 +12,16 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/commands/authtokens"
 	"github.com/hashicorp/boundary/internal/cmd/commands/config"
 	"github.com/hashicorp/boundary/internal/cmd/commands/connect"
+	"github.com/hashicorp/boundary/internal/cmd/commands/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/commands/credentialstores"
 	"github.com/hashicorp/boundary/internal/cmd/commands/database"
 	"github.com/hashicorp/boundary/internal/cmd/commands/dev"
 	"github.com/hashicorp/boundary/internal/cmd/commands/groups"
 	"github.com/hashicorp/boundary/internal/cmd/commands/hostcatalogs"
 	"github.com/hashicorp/boundary/internal/cmd/commands/hosts"
 	"github.com/hashicorp/boundary/internal/cmd/commands/hostsets"
+	"github.com/hashicorp/boundary/internal/cmd/commands/perms"
+	"github.com/hashicorp/boundary/internal/cmd/commands/recording"
 	"github.com/hashicorp/boundary/internal/cmd/commands/roles"
 	"github.com/hashicorp/boundary/internal/cmd/commands/scopes"
 	"github.com/hashicorp/boundary/internal/cmd/commands/server"
@@ -40,8 +44,10 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 					UI:         serverCmdUi,
 					ShutdownCh: base.MakeShutdownCh(),
 				}),
-				SighupCh:  MakeSighupCh(),
-				SigUSR2Ch: MakeSigUSR2Ch(),
+				ForceShutdownCh: base.MakeForceShutdownCh(),
+				SighupCh:        MakeSighupCh(),
+				SigUSR2Ch:       MakeSigUSR2Ch(),
+				SigUSR1Ch:       MakeSigUSR1Ch(),
 			}, nil
 		},
 		"dev": func() (cli.Command, error) {
@@ -70,6 +76,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui),
 			}, nil
 		},
+		"authenticate oidc": func() (cli.Command, error) {
+			return &authenticate.OidcCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"authenticate ldap": func() (cli.Command, error) {
+			return &authenticate.LdapCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 
 		"accounts": func() (cli.Command, error) {
 			return &accounts.Command{
@@ -118,6 +134,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"accounts create oidc": func() (cli.Command, error) {
+			return &accounts.OidcCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"accounts create ldap": func() (cli.Command, error) {
+			return &accounts.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"accounts update": func() (cli.Command, error) {
 			return &accounts.Command{
 				Command: base.NewCommand(ui),
@@ -130,6 +158,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"accounts update oidc": func() (cli.Command, error) {
+			return &accounts.OidcCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"accounts update ldap": func() (cli.Command, error) {
+			return &accounts.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 
 		"auth-methods": func() (cli.Command, error) {
 			return &authmethods.Command{
@@ -166,6 +206,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"auth-methods create oidc": func() (cli.Command, error) {
+			return &authmethods.OidcCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"auth-methods create ldap": func() (cli.Command, error) {
+			return &authmethods.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"auth-methods update": func() (cli.Command, error) {
 			return &authmethods.Command{
 				Command: base.NewCommand(ui),
@@ -178,6 +230,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"auth-methods update oidc": func() (cli.Command, error) {
+			return &authmethods.OidcCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"auth-methods update ldap": func() (cli.Command, error) {
+			return &authmethods.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 
 		"auth-tokens": func() (cli.Command, error) {
 			return &authtokens.Command{
@@ -276,6 +340,78 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			}, nil
 		},
 
+		"credential-stores": func() (cli.Command, error) {
+			return &credentialstores.Command{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"credential-stores create": func() (cli.Command, error) {
+			return &credentialstores.Command{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credential-stores update": func() (cli.Command, error) {
+			return &credentialstores.Command{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"credential-stores read": func() (cli.Command, error) {
+			return &credentialstores.Command{
+				Command: base.NewCommand(ui),
+				Func:    "read",
+			}, nil
+		},
+		"credential-stores delete": func() (cli.Command, error) {
+			return &credentialstores.Command{
+				Command: base.NewCommand(ui),
+				Func:    "delete",
+			}, nil
+		},
+		"credential-stores list": func() (cli.Command, error) {
+			return &credentialstores.Command{
+				Command: base.NewCommand(ui),
+				Func:    "list",
+			}, nil
+		},
+
+		"credentials": func() (cli.Command, error) {
+			return &credentials.Command{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"credentials create": func() (cli.Command, error) {
+			return &credentials.Command{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credentials update": func() (cli.Command, error) {
+			return &credentials.Command{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"credentials read": func() (cli.Command, error) {
+			return &credentials.Command{
+				Command: base.NewCommand(ui),
+				Func:    "read",
+			}, nil
+		},
+		"credentials delete": func() (cli.Command, error) {
+			return &credentials.Command{
+				Command: base.NewCommand(ui),
+				Func:    "delete",
+			}, nil
+		},
+		"credentials list": func() (cli.Command, error) {
+			return &credentials.Command{
+				Command: base.NewCommand(ui),
+				Func:    "list",
+			}, nil
+		},
+
 		"database": func() (cli.Command, error) {
 			return &database.Command{
 				Command: base.NewCommand(ui),
@@ -376,6 +512,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"host-catalogs create dynamic": func() (cli.Command, error) {
+			return &hostcatalogs.DynamicCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"host-catalogs update": func() (cli.Command, error) {
 			return &hostcatalogs.Command{
 				Command: base.NewCommand(ui),
@@ -388,6 +530,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"host-catalogs update dynamic": func() (cli.Command, error) {
+			return &hostcatalogs.DynamicCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 
 		"host-sets": func() (cli.Command, error) {
 			return &hostsets.Command{
@@ -424,6 +572,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"host-sets create dynamic": func() (cli.Command, error) {
+			return &hostsets.DynamicCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"host-sets update": func() (cli.Command, error) {
 			return &hostsets.Command{
 				Command: base.NewCommand(ui),
@@ -436,6 +590,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"host-sets update dynamic": func() (cli.Command, error) {
+			return &hostsets.DynamicCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 		"host-sets add-hosts": func() (cli.Command, error) {
 			return &hostsets.Command{
 				Command: base.NewCommand(ui),
@@ -634,6 +794,36 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "cancel",
 			}, nil
 		},
+		"sessions download-recording": func() (cli.Command, error) {
+			return &sessions.Command{
+				Command: base.NewCommand(ui),
+				Func:    "download-recording",
+			}, nil
+		},
+
+		"perms": func() (cli.Command, error) {
+			return &perms.Command{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"perms check": func() (cli.Command, error) {
+			return &perms.Command{
+				Command: base.NewCommand(ui),
+				Func:    "check",
+			}, nil
+		},
+
+		"recording": func() (cli.Command, error) {
+			return &recording.Command{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"recording play": func() (cli.Command, error) {
+			return &recording.Command{
+				Command: base.NewCommand(ui),
+				Func:    "play",
+			}, nil
+		},
 
 		"targets": func() (cli.Command, error) {
 			return &targets.Command{
@@ -676,6 +866,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"targets create udp": func() (cli.Command, error) {
+			return &targets.UdpCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"targets create http": func() (cli.Command, error) {
+			return &targets.HttpCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"targets update": func() (cli.Command, error) {
 			return &targets.Command{
 				Command: base.NewCommand(ui),
@@ -688,6 +890,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"targets update udp": func() (cli.Command, error) {
+			return &targets.UdpCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"targets update http": func() (cli.Command, error) {
+			return &targets.HttpCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 		"targets add-host-sets": func() (cli.Command, error) {
 			return &targets.Command{
 				Command: base.NewCommand(ui),
@@ -706,6 +920,24 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "set-host-sets",
 			}, nil
 		},
+		"targets add-credentials": func() (cli.Command, error) {
+			return &targets.Command{
+				Command: base.NewCommand(ui),
+				Func:    "add-credentials",
+			}, nil
+		},
+		"targets remove-credentials": func() (cli.Command, error) {
+			return &targets.Command{
+				Command: base.NewCommand(ui),
+				Func:    "remove-credentials",
+			}, nil
+		},
+		"targets set-credentials": func() (cli.Command, error) {
+			return &targets.Command{
+				Command: base.NewCommand(ui),
+				Func:    "set-credentials",
+			}, nil
+		},
 
 		"users": func() (cli.Command, error) {
 			return &users.Command{
@@ -760,6 +992,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "remove-accounts",
 			}, nil
 		},
+		"users effective-grants": func() (cli.Command, error) {
+			return &users.Command{
+				Command: base.NewCommand(ui),
+				Func:    "effective-grants",
+			}, nil
+		},
 	}
 }
 
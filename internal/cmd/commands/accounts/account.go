@@ -44,7 +44,7 @@ func (c *Command) Synopsis() string {
 var flagsMap = map[string][]string{
 	"read":            {"id"},
 	"delete":          {"id"},
-	"list":            {"auth-method-id"},
+	"list":            {"auth-method-id", "filter", "page-size"},
 	"set-password":    {"id", "password", "version"},
 	"change-password": {"id", "current-password", "new-password", "version"},
 }
@@ -75,6 +75,14 @@ func (c *Command) Help() string {
 			"",
 			`      $ boundary accounts create password -name prodops -description "For ProdOps usage"`,
 			"",
+			"    Create an oidc-type account:",
+			"",
+			`      $ boundary accounts create oidc -auth-method-id amoidc_1234567890 -subject 248289761001`,
+			"",
+			"    Create an ldap-type account:",
+			"",
+			`      $ boundary accounts create ldap -auth-method-id amldap_1234567890 -login-name alice`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "update":
@@ -259,7 +267,14 @@ func (c *Command) Run(args []string) int {
 
 	existed := true
 	var result api.GenericResult
-	var listResult api.GenericListResult
+	var listIter *accounts.AccountListIterator
+
+	if c.FlagFilter != "" {
+		opts = append(opts, accounts.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, accounts.WithPageSize(uint32(c.FlagPageSize)))
+	}
 
 	switch c.Func {
 	case "read":
@@ -271,7 +286,7 @@ func (c *Command) Run(args []string) int {
 			err = nil
 		}
 	case "list":
-		listResult, err = accountClient.List(c.Context, c.FlagAuthMethodId, opts...)
+		listIter, err = accountClient.ListIterator(c.Context, c.FlagAuthMethodId, opts...)
 	case "set-password":
 		result, err = accountClient.SetPassword(c.Context, c.FlagId, c.flagPassword, version, opts...)
 	case "change-password":
@@ -309,55 +324,38 @@ func (c *Command) Run(args []string) int {
 		return 0
 
 	case "list":
-		accounts := listResult.GetItems().([]*accounts.Account)
-		switch base.Format(c.UI) {
-		case "json":
-			if len(accounts) == 0 {
-				c.UI.Output("null")
-				return 0
-			}
-			b, err := base.JsonFormatter{}.Format(accounts)
-			if err != nil {
-				c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
-				return 1
+		printer := base.NewListPrinter(c.UI, "Account information:", "No accounts found")
+		for listIter.Next() {
+			m := listIter.Item()
+			var output []string
+			if true {
+				output = append(output,
+					fmt.Sprintf("  ID:             %s", m.Id),
+					fmt.Sprintf("    Version:      %d", m.Version),
+					fmt.Sprintf("    Type:         %s", m.Type),
+				)
 			}
-			c.UI.Output(string(b))
-
-		case "table":
-			if len(accounts) == 0 {
-				c.UI.Output("No accounts found")
-				return 0
+			if m.Name != "" {
+				output = append(output,
+					fmt.Sprintf("    Name:         %s", m.Name),
+				)
 			}
-			var output []string
-			output = []string{
-				"",
-				"Account information:",
+			if m.Description != "" {
+				output = append(output,
+					fmt.Sprintf("    Description:  %s", m.Description),
+				)
 			}
-			for i, m := range accounts {
-				if i > 0 {
-					output = append(output, "")
-				}
-				if true {
-					output = append(output,
-						fmt.Sprintf("  ID:             %s", m.Id),
-						fmt.Sprintf("    Version:      %d", m.Version),
-						fmt.Sprintf("    Type:         %s", m.Type),
-					)
-				}
-				if m.Name != "" {
-					output = append(output,
-						fmt.Sprintf("    Name:         %s", m.Name),
-					)
-				}
-				if m.Description != "" {
-					output = append(output,
-						fmt.Sprintf("    Description:  %s", m.Description),
-					)
-				}
+			printer.Print(m, output)
+		}
+		if err := listIter.Err(); err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+				return 1
 			}
-			c.UI.Output(base.WrapForHelpText(output))
+			c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+			return 2
 		}
-		return 0
+		return printer.Finish()
 	}
 
 	account := result.GetItem().(*accounts.Account)
@@ -48,4 +48,7 @@ func generateAccountTableOutput(in *accounts.Account) string {
 
 var keySubstMap = map[string]string{
 	"login_name": "Login Name",
+	"subject":    "Subject",
+	"email":      "Email",
+	"full_name":  "Full Name",
 }
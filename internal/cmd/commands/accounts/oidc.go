@@ -0,0 +1,198 @@
+package accounts
+
+import (
+	"fmt"
+	"net/textproto"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/accounts"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/hashicorp/boundary/sdk/strutil"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*OidcCommand)(nil)
+var _ cli.CommandAutocomplete = (*OidcCommand)(nil)
+
+type OidcCommand struct {
+	*base.Command
+
+	Func string
+
+	flagSubject string
+}
+
+func (c *OidcCommand) Synopsis() string {
+	return fmt.Sprintf("%s an oidc-type account", textproto.CanonicalMIMEHeaderKey(c.Func))
+}
+
+var oidcFlagsMap = map[string][]string{
+	"create": {"auth-method-id", "name", "description", "subject"},
+	"update": {"id", "name", "description", "version"},
+}
+
+func (c *OidcCommand) Help() string {
+	var info string
+	switch c.Func {
+	case "create":
+		info = base.WrapForHelpText([]string{
+			"Usage: boundary accounts create oidc [options] [args]",
+			"",
+			"  Create an oidc-type account for the subject of the ID tokens the provider issues for a user. Accounts are also created automatically the first time a user authenticates. Creating one in advance allows it to be added to a user beforehand. Example:",
+			"",
+			`    $ boundary accounts create oidc -auth-method-id amoidc_1234567890 -subject 248289761001 -name alice`,
+			"",
+			"",
+		})
+
+	case "update":
+		info = base.WrapForHelpText([]string{
+			"Usage: boundary accounts update oidc [options] [args]",
+			"",
+			"  Update an oidc-type account given its ID. The subject cannot be changed. Example:",
+			"",
+			`    $ boundary accounts update oidc -id acctoidc_1234567890 -name "alice" -description "Alice's corporate account"`,
+			"",
+			"",
+		})
+	}
+	return info + c.Flags().Help()
+}
+
+func (c *OidcCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	if len(oidcFlagsMap[c.Func]) > 0 {
+		common.PopulateCommonFlags(c.Command, f, "oidc-type account", oidcFlagsMap[c.Func])
+	}
+
+	f = set.NewFlagSet("OIDC Account Options")
+
+	for _, name := range oidcFlagsMap[c.Func] {
+		switch name {
+		case "subject":
+			f.StringVar(&base.StringVar{
+				Name:   "subject",
+				Target: &c.flagSubject,
+				Usage:  "The subject of the ID tokens issued for the account by the provider",
+			})
+		}
+	}
+
+	return set
+}
+
+func (c *OidcCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *OidcCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *OidcCommand) Run(args []string) int {
+	if c.Func == "" {
+		return cli.RunResultHelp
+	}
+
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if strutil.StrListContains(oidcFlagsMap[c.Func], "id") && c.FlagId == "" {
+		c.UI.Error("ID is required but not passed in via -id")
+		return 1
+	}
+	if strutil.StrListContains(oidcFlagsMap[c.Func], "auth-method-id") && c.FlagAuthMethodId == "" {
+		c.UI.Error("Auth Method ID must be passed in via -auth-method-id")
+		return 1
+	}
+	if c.Func == "create" && c.flagSubject == "" {
+		c.UI.Error("Subject must be passed in via -subject")
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating API client: %s", err.Error()))
+		return 2
+	}
+
+	var opts []accounts.Option
+
+	switch c.FlagName {
+	case "":
+	case "null":
+		opts = append(opts, accounts.DefaultName())
+	default:
+		opts = append(opts, accounts.WithName(c.FlagName))
+	}
+
+	switch c.FlagDescription {
+	case "":
+	case "null":
+		opts = append(opts, accounts.DefaultDescription())
+	default:
+		opts = append(opts, accounts.WithDescription(c.FlagDescription))
+	}
+
+	if c.flagSubject != "" {
+		opts = append(opts, accounts.WithOidcAccountSubject(c.flagSubject))
+	}
+
+	accountClient := accounts.NewClient(client)
+
+	// Perform check-and-set when needed
+	var version uint32
+	switch c.Func {
+	case "create":
+		// These don't update so don't need the existing version
+	default:
+		switch c.FlagVersion {
+		case 0:
+			opts = append(opts, accounts.WithAutomaticVersioning(true))
+		default:
+			version = uint32(c.FlagVersion)
+		}
+	}
+
+	var result api.GenericResult
+
+	switch c.Func {
+	case "create":
+		result, err = accountClient.Create(c.Context, c.FlagAuthMethodId, opts...)
+	case "update":
+		result, err = accountClient.Update(c.Context, c.FlagId, version, opts...)
+	}
+
+	plural := "oidc-type account"
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+		return 2
+	}
+
+	account := result.GetItem().(*accounts.Account)
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(generateAccountTableOutput(account))
+	case "json":
+		b, err := base.JsonFormatter{}.Format(account)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(b))
+	}
+
+	return 0
+}
@@ -0,0 +1,221 @@
+package authenticate
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/api/authtokens"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/pkg/browser"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*OidcCommand)(nil)
+var _ cli.CommandAutocomplete = (*OidcCommand)(nil)
+
+// oidcCallbackTimeout is how long the command waits for the provider to
+// redirect the browser back to the callback listener.
+const oidcCallbackTimeout = 5 * time.Minute
+
+type OidcCommand struct {
+	*base.Command
+
+	flagCallbackPort int
+	flagNoBrowser    bool
+}
+
+func (c *OidcCommand) Synopsis() string {
+	return wordwrap.WrapString("Invoke the OIDC auth method to authenticate with Boundary", base.TermWidth)
+}
+
+func (c *OidcCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary authenticate oidc [options] [args]",
+		"",
+		"  Invoke the OIDC auth method to authenticate the Boundary CLI. A browser is opened to the login page of the OpenID Provider and the command waits for the provider to redirect back to a listener on the loopback interface:",
+		"",
+		`    $ boundary authenticate oidc -auth-method-id amoidc_1234567890`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *OidcCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "auth-method-id",
+		EnvVar: "BOUNDARY_AUTH_METHOD_ID",
+		Target: &c.FlagAuthMethodId,
+		Usage:  "The auth-method resource to use for the operation",
+	})
+
+	f.IntVar(&base.IntVar{
+		Name:   "callback-port",
+		Target: &c.flagCallbackPort,
+		Usage:  "The port on 127.0.0.1 the callback listener binds to. The redirect URL http://127.0.0.1:<port>/callback must be allowed by the provider. Defaults to a random free port.",
+	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "no-browser",
+		Target: &c.flagNoBrowser,
+		Usage:  "Do not open a browser. The URL of the login page is printed instead.",
+	})
+
+	return set
+}
+
+func (c *OidcCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *OidcCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *OidcCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	switch {
+	case c.FlagAuthMethodId == "":
+		c.UI.Error("Auth method ID must be provided via -auth-method-id")
+		return 1
+	case c.flagCallbackPort < 0 || c.flagCallbackPort > 65535:
+		c.UI.Error("Callback port must be between 0 and 65535")
+		return 1
+	}
+
+	client, err := c.Client(base.WithNoTokenScope(), base.WithNoTokenValue())
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating API client: %s", err.Error()))
+		return 2
+	}
+
+	ln, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", c.flagCallbackPort))
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error starting callback listener: %s", err.Error()))
+		return 2
+	}
+	callbacks := make(chan oidcCallback, 1)
+	srv := &http.Server{Handler: oidcCallbackHandler(callbacks)}
+	go srv.Serve(ln)
+	defer srv.Close()
+
+	amClient := authmethods.NewClient(client)
+	redirectUrl := fmt.Sprintf("http://%s/callback", ln.Addr().String())
+	start, err := amClient.AuthenticateStart(c.Context, c.FlagAuthMethodId, redirectUrl)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when starting authentication: %s", base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to start authentication: %s", err.Error()))
+		return 2
+	}
+
+	if !c.flagNoBrowser {
+		browser.Stdout, browser.Stderr = ioutil.Discard, ioutil.Discard
+		if err := browser.OpenURL(start.AuthUrl); err != nil {
+			c.UI.Warn(fmt.Sprintf("Unable to open a browser: %s", err.Error()))
+			c.flagNoBrowser = true
+		}
+	}
+	if c.flagNoBrowser {
+		c.UI.Output(fmt.Sprintf("Complete the authentication by visiting:\n\n  %s\n", start.AuthUrl))
+	} else {
+		c.UI.Output(fmt.Sprintf("Complete the authentication in your browser. If it did not open, visit:\n\n  %s\n", start.AuthUrl))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context, oidcCallbackTimeout)
+	defer cancel()
+	var cb oidcCallback
+	select {
+	case cb = <-callbacks:
+	case <-ctx.Done():
+		c.UI.Error("Timed out waiting for the provider to redirect back to the callback listener")
+		return 2
+	}
+	switch {
+	case cb.err != "":
+		c.UI.Error(fmt.Sprintf("Error from provider when performing authentication: %s", cb.err))
+		return 1
+	case cb.state != start.State:
+		c.UI.Error("The state returned by the provider does not match the state of this authentication")
+		return 1
+	}
+
+	// note: Authenticate() calls SetToken() under the hood to set the
+	// auth bearer on the client so we do not need to do anything with the
+	// returned token after this call, so we ignore it
+	result, err := amClient.Authenticate(c.Context, c.FlagAuthMethodId,
+		map[string]interface{}{
+			"state": cb.state,
+			"code":  cb.code,
+		})
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing authentication: %s", base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to perform authentication: %s", err.Error()))
+		return 2
+	}
+
+	return saveAndOutputToken(c.Command, result.GetItem().(*authtokens.AuthToken))
+}
+
+// oidcCallback holds the parameters the provider redirected the browser
+// back with.
+type oidcCallback struct {
+	state string
+	code  string
+	err   string
+}
+
+// oidcCallbackHandler returns a handler for the redirect URL which sends
+// the first callback it receives to callbacks.
+func oidcCallbackHandler(callbacks chan<- oidcCallback) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/callback" {
+			http.NotFound(w, r)
+			return
+		}
+		q := r.URL.Query()
+		cb := oidcCallback{state: q.Get("state"), code: q.Get("code")}
+		msg := "Authentication complete. You may close this window and return to the terminal."
+		switch {
+		case q.Get("error") != "":
+			cb.err = q.Get("error")
+			if desc := q.Get("error_description"); desc != "" {
+				cb.err = fmt.Sprintf("%s: %s", cb.err, desc)
+			}
+			msg = "Authentication failed: " + cb.err
+		case cb.code == "":
+			cb.err = "no authorization code in callback"
+			msg = "Authentication failed: " + cb.err
+		}
+		select {
+		case callbacks <- cb:
+		default:
+			// Only the first callback is used.
+			msg = "This authentication has already been completed."
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprintf(w, "<!DOCTYPE html><html><body><p>%s</p></body></html>", html.EscapeString(msg))
+	})
+}
@@ -1,12 +1,9 @@
 package authenticate
 
 import (
-	"encoding/base64"
-	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/authmethods"
@@ -16,7 +13,6 @@ import (
 	"github.com/mitchellh/cli"
 	"github.com/mitchellh/go-wordwrap"
 	"github.com/posener/complete"
-	"github.com/zalando/go-keyring"
 )
 
 var _ cli.Command = (*PasswordCommand)(nil)
@@ -136,44 +132,5 @@ func (c *PasswordCommand) Run(args []string) int {
 		return 2
 	}
 
-	token := result.GetItem().(*authtokens.AuthToken)
-	switch base.Format(c.UI) {
-	case "table":
-		c.UI.Output(base.WrapForHelpText([]string{
-			"",
-			"Authentication information:",
-			fmt.Sprintf("  Account ID:      %s", token.AccountId),
-			fmt.Sprintf("  Auth Method ID:  %s", token.AuthMethodId),
-			fmt.Sprintf("  Expiration Time: %s", token.ExpirationTime.Local().Format(time.RFC1123)),
-			fmt.Sprintf("  Token:           %s", token.Token),
-			fmt.Sprintf("  User ID:         %s", token.UserId),
-		}))
-
-	case "json":
-		jsonOut, err := base.JsonFormatter{}.Format(token)
-		if err != nil {
-			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
-			return 1
-		}
-		c.UI.Output(string(jsonOut))
-	}
-
-	tokenName := "default"
-	if c.Command.FlagTokenName != "" {
-		tokenName = c.Command.FlagTokenName
-	}
-	if tokenName != "none" {
-		marshaled, err := json.Marshal(token)
-		if err != nil {
-			c.UI.Error(fmt.Sprintf("Error marshaling auth token to save to system credential store: %s", err))
-			return 1
-		}
-		// TODO: potentially look for dbus-launch in advance and don't issue a warning at all
-		if err := keyring.Set("HashiCorp Boundary Auth Token", tokenName, base64.RawStdEncoding.EncodeToString(marshaled)); err != nil {
-			c.UI.Error(fmt.Sprintf("Error saving auth token to system credential store: %s", err))
-			c.UI.Warn("The token printed above must be manually passed in via the BOUNDARY_TOKEN env var or -token flag. Storing the token can also be disabled via -token-name=none.")
-		}
-	}
-
-	return 0
+	return saveAndOutputToken(c.Command, result.GetItem().(*authtokens.AuthToken))
 }
@@ -30,7 +30,7 @@ func (c *Command) Synopsis() string {
 var flagsMap = map[string][]string{
 	"read":   {"id"},
 	"delete": {"id"},
-	"list":   {"scope-id"},
+	"list":   {"scope-id", "filter", "page-size", "recursive"},
 }
 
 func (c *Command) Help() string {
@@ -59,6 +59,10 @@ func (c *Command) Help() string {
 			"",
 			`      $ boundary auth-methods create password -name prodops -description "For ProdOps usage"`,
 			"",
+			"    Create an oidc-type auth method:",
+			"",
+			`      $ boundary auth-methods create oidc -name corp -issuer https://accounts.example.com -client-id boundary`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "update":
@@ -149,7 +153,17 @@ func (c *Command) Run(args []string) int {
 
 	existed := true
 	var result api.GenericResult
-	var listResult api.GenericListResult
+	var listIter *authmethods.AuthMethodListIterator
+
+	if c.FlagFilter != "" {
+		opts = append(opts, authmethods.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, authmethods.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, authmethods.WithRecursive(true))
+	}
 
 	switch c.Func {
 	case "read":
@@ -161,7 +175,7 @@ func (c *Command) Run(args []string) int {
 			err = nil
 		}
 	case "list":
-		listResult, err = authmethodClient.List(c.Context, c.FlagScopeId, opts...)
+		listIter, err = authmethodClient.ListIterator(c.Context, c.FlagScopeId, opts...)
 	}
 
 	plural := "auth method"
@@ -195,59 +209,47 @@ func (c *Command) Run(args []string) int {
 		return 0
 
 	case "list":
-		listedMethods := listResult.GetItems().([]*authmethods.AuthMethod)
-		switch base.Format(c.UI) {
-		case "json":
-			if len(listedMethods) == 0 {
-				c.UI.Output("null")
-				return 0
+		printer := base.NewListPrinter(c.UI, "Auth Method information:", "No auth methods found")
+		for listIter.Next() {
+			m := listIter.Item()
+			var output []string
+			if true {
+				output = append(output,
+					fmt.Sprintf("  ID:             %s", m.Id),
+				)
 			}
-			b, err := base.JsonFormatter{}.Format(listedMethods)
-			if err != nil {
-				c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
-				return 1
+			if m.Description != "" {
+				output = append(output,
+					fmt.Sprintf("    Description:  %s", m.Description),
+				)
 			}
-			c.UI.Output(string(b))
-
-		case "table":
-			if len(listedMethods) == 0 {
-				c.UI.Output("No auth methods found")
-				return 0
+			if m.Name != "" {
+				output = append(output,
+					fmt.Sprintf("    Name:         %s", m.Name),
+				)
 			}
-			var output []string
-			output = []string{
-				"",
-				"Auth Method information:",
+			if true {
+				output = append(output,
+					fmt.Sprintf("    Type:         %s", m.Type),
+					fmt.Sprintf("    Version:      %d", m.Version),
+				)
 			}
-			for i, m := range listedMethods {
-				if i > 0 {
-					output = append(output, "")
-				}
-				if true {
-					output = append(output,
-						fmt.Sprintf("  ID:             %s", m.Id),
-					)
-				}
-				if m.Description != "" {
-					output = append(output,
-						fmt.Sprintf("    Description:  %s", m.Description),
-					)
-				}
-				if m.Name != "" {
-					output = append(output,
-						fmt.Sprintf("    Name:         %s", m.Name),
-					)
-				}
-				if true {
-					output = append(output,
-						fmt.Sprintf("    Type:         %s", m.Type),
-						fmt.Sprintf("    Version:      %d", m.Version),
-					)
-				}
+			if c.FlagRecursive {
+				output = append(output,
+					fmt.Sprintf("    Scope ID:     %s", m.Scope.Id),
+				)
 			}
-			c.UI.Output(base.WrapForHelpText(output))
+			printer.Print(m, output)
 		}
-		return 0
+		if err := listIter.Err(); err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+				return 1
+			}
+			c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+			return 2
+		}
+		return printer.Finish()
 	}
 
 	method := result.GetItem().(*authmethods.AuthMethod)
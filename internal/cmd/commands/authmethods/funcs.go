@@ -20,6 +20,117 @@ func addPasswordFlags(c *PasswordCommand, f *base.FlagSet) {
 	})
 }
 
+func addOidcFlags(c *OidcCommand, f *base.FlagSet) {
+	f.StringVar(&base.StringVar{
+		Name:   "issuer",
+		Target: &c.flagIssuer,
+		Usage:  "The issuer URL of the OpenID Provider. Must be an https URL, or an http URL on a loopback address.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "client-id",
+		Target: &c.flagClientId,
+		Usage:  "The OAuth 2.0 client ID issued to Boundary by the provider",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "client-secret",
+		Target: &c.flagClientSecret,
+		Usage:  "The OAuth 2.0 client secret issued to Boundary by the provider. When creating an auth method, the command prompts for the secret if it is not specified.",
+	})
+	f.StringSliceVar(&base.StringSliceVar{
+		Name:   "claims-scopes",
+		Target: &c.flagClaimsScopes,
+		Usage:  `The scopes requested in addition to "openid". Can be specified multiple times.`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "email-claim",
+		Target: &c.flagEmailClaim,
+		Usage:  `The ID token claim mapped to the email of an account. Defaults to "email".`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "name-claim",
+		Target: &c.flagNameClaim,
+		Usage:  `The ID token claim mapped to the full name of an account. Defaults to "name".`,
+	})
+}
+
+func addLdapFlags(c *LdapCommand, f *base.FlagSet) {
+	f.StringSliceVar(&base.StringSliceVar{
+		Name:   "urls",
+		Target: &c.flagUrls,
+		Usage:  "The ldap:// or ldaps:// URLs of the directory servers, tried in order. Can be specified multiple times.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "start-tls",
+		Target: &c.flagStartTls,
+		Usage:  "If true, connections to ldap:// URLs are upgraded with StartTLS before binding",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "insecure-tls",
+		Target: &c.flagInsecureTls,
+		Usage:  "If true, the certificates of the directory servers are not verified",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "certificate",
+		Target: &c.flagCertificate,
+		Usage:  "The path to a file containing a PEM encoded CA certificate used to verify the certificates of the directory servers",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "bind-dn",
+		Target: &c.flagBindDn,
+		Usage:  "The DN to bind as when searching for the DN of a user",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "bind-password",
+		Target: &c.flagBindPassword,
+		Usage:  "The password of the bind DN",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "discover-dn",
+		Target: &c.flagDiscoverDn,
+		Usage:  "If true, the DN of a user is searched for with an anonymous bind when no bind DN is set",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "user-dn",
+		Target: &c.flagUserDn,
+		Usage:  "The base DN of the user entries",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "user-attr",
+		Target: &c.flagUserAttr,
+		Usage:  `The attribute of a user entry matched against the login name. Defaults to "uid".`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "group-dn",
+		Target: &c.flagGroupDn,
+		Usage:  "The base DN of the group entries. If not set, the groups of a user are not looked up.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "group-attr",
+		Target: &c.flagGroupAttr,
+		Usage:  `The attribute of a group entry used as the name of the group. Defaults to "cn".`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "group-filter",
+		Target: &c.flagGroupFilter,
+		Usage:  "The filter used to search for the groups of a user. {{.UserDN}} and {{.Username}} are replaced with the DN and login name of the user.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "email-attr",
+		Target: &c.flagEmailAttr,
+		Usage:  `The attribute of a user entry mapped to the email of an account. Defaults to "mail".`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "name-attr",
+		Target: &c.flagNameAttr,
+		Usage:  `The attribute of a user entry mapped to the full name of an account. Defaults to "cn".`,
+	})
+	f.StringSliceVar(&base.StringSliceVar{
+		Name:   "group-mapping",
+		Target: &c.flagGroupMappings,
+		Usage:  "A mapping of a directory group onto a Boundary group in the form <directory group>=<group id>. Users are made members of the Boundary group when they authenticate. Can be specified multiple times.",
+	})
+}
+
 func generateAuthMethodTableOutput(in *authmethods.AuthMethod) string {
 	nonAttributeMap := map[string]interface{}{
 		"ID":           in.Id,
@@ -61,4 +172,23 @@ func generateAuthMethodTableOutput(in *authmethods.AuthMethod) string {
 var keySubstMap = map[string]string{
 	"min_login_name_length": "Minimum Login Name Length",
 	"min_password_length":   "Minimum Password Length",
+	"issuer":                "Issuer",
+	"client_id":             "Client ID",
+	"claims_scopes":         "Claims Scopes",
+	"email_claim":           "Email Claim",
+	"name_claim":            "Name Claim",
+	"urls":                  "URLs",
+	"start_tls":             "StartTLS",
+	"insecure_tls":          "Insecure TLS",
+	"certificate":           "Certificate",
+	"bind_dn":               "Bind DN",
+	"discover_dn":           "Discover DN",
+	"user_dn":               "User DN",
+	"user_attr":             "User Attribute",
+	"group_dn":              "Group DN",
+	"group_attr":            "Group Attribute",
+	"group_filter":          "Group Filter",
+	"email_attr":            "Email Attribute",
+	"name_attr":             "Name Attribute",
+	"group_mappings":        "Group Mappings",
 }
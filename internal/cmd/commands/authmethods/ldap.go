@@ -0,0 +1,289 @@
+package authmethods
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/textproto"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/hashicorp/boundary/sdk/strutil"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*LdapCommand)(nil)
+var _ cli.CommandAutocomplete = (*LdapCommand)(nil)
+
+type LdapCommand struct {
+	*base.Command
+
+	Func string
+
+	flagUrls          []string
+	flagStartTls      string
+	flagInsecureTls   string
+	flagCertificate   string
+	flagBindDn        string
+	flagBindPassword  string
+	flagDiscoverDn    string
+	flagUserDn        string
+	flagUserAttr      string
+	flagGroupDn       string
+	flagGroupAttr     string
+	flagGroupFilter   string
+	flagEmailAttr     string
+	flagNameAttr      string
+	flagGroupMappings []string
+}
+
+func (c *LdapCommand) Synopsis() string {
+	return fmt.Sprintf("%s an ldap-type auth-method", textproto.CanonicalMIMEHeaderKey(c.Func))
+}
+
+var ldapFlagsMap = map[string][]string{
+	"create": {"scope-id", "name", "description"},
+	"update": {"id", "name", "description", "version"},
+}
+
+func (c *LdapCommand) Help() string {
+	var info string
+	switch c.Func {
+	case "create":
+		info = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods create ldap [options] [args]",
+			"",
+			"  Create an ldap-type auth method. Users authenticate by binding to the directory with their login name and password. Directory groups can be mapped onto Boundary groups with -group-mapping. Example:",
+			"",
+			`    $ boundary auth-methods create ldap -name corp -urls ldaps://ldap.example.com -user-dn ou=people,dc=example,dc=com -group-dn ou=groups,dc=example,dc=com -group-mapping admins=g_1234567890`,
+			"",
+			"",
+		})
+
+	case "update":
+		info = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods update ldap [options] [args]",
+			"",
+			"  Update an ldap-type auth method given its ID. Specifying -group-mapping replaces all group mappings of the auth method. Example:",
+			"",
+			`    $ boundary auth-methods update ldap -id amldap_1234567890 -group-mapping admins=g_1234567890 -group-mapping devs=g_0987654321`,
+			"",
+			"",
+		})
+	}
+	return info + c.Flags().Help()
+}
+
+func (c *LdapCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+
+	f := set.NewFlagSet("Command Options")
+	common.PopulateCommonFlags(c.Command, f, "ldap-type auth method", ldapFlagsMap[c.Func])
+
+	f = set.NewFlagSet("LDAP Auth-Method Options")
+	addLdapFlags(c, f)
+
+	return set
+}
+
+func (c *LdapCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *LdapCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *LdapCommand) Run(args []string) int {
+	if c.Func == "" {
+		return cli.RunResultHelp
+	}
+
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if strutil.StrListContains(ldapFlagsMap[c.Func], "id") && c.FlagId == "" {
+		c.UI.Error("ID is required but not passed in via -id")
+		return 1
+	}
+	if strutil.StrListContains(ldapFlagsMap[c.Func], "scope-id") && c.FlagScopeId == "" {
+		c.UI.Error("Scope ID must be passed in via -scope-id")
+		return 1
+	}
+	if c.Func == "create" {
+		switch {
+		case len(c.flagUrls) == 0:
+			c.UI.Error("At least one URL must be passed in via -urls")
+			return 1
+		case c.flagUserDn == "":
+			c.UI.Error("User DN must be passed in via -user-dn")
+			return 1
+		}
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating API client: %s", err.Error()))
+		return 2
+	}
+
+	var opts []authmethods.Option
+
+	switch c.FlagName {
+	case "":
+	case "null":
+		opts = append(opts, authmethods.DefaultName())
+	default:
+		opts = append(opts, authmethods.WithName(c.FlagName))
+	}
+
+	switch c.FlagDescription {
+	case "":
+	case "null":
+		opts = append(opts, authmethods.DefaultDescription())
+	default:
+		opts = append(opts, authmethods.WithDescription(c.FlagDescription))
+	}
+
+	if len(c.flagUrls) > 0 {
+		opts = append(opts, authmethods.WithLdapAuthMethodUrls(c.flagUrls))
+	}
+	if c.flagUserDn != "" {
+		opts = append(opts, authmethods.WithLdapAuthMethodUserDn(c.flagUserDn))
+	}
+
+	for _, b := range []struct {
+		name  string
+		value string
+		with  func(bool) authmethods.Option
+		unset func() authmethods.Option
+	}{
+		{"start-tls", c.flagStartTls, authmethods.WithLdapAuthMethodStartTls, authmethods.DefaultLdapAuthMethodStartTls},
+		{"insecure-tls", c.flagInsecureTls, authmethods.WithLdapAuthMethodInsecureTls, authmethods.DefaultLdapAuthMethodInsecureTls},
+		{"discover-dn", c.flagDiscoverDn, authmethods.WithLdapAuthMethodDiscoverDn, authmethods.DefaultLdapAuthMethodDiscoverDn},
+	} {
+		switch b.value {
+		case "":
+		case "null":
+			opts = append(opts, b.unset())
+		default:
+			v, err := strconv.ParseBool(b.value)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing -%s %q: %s", b.name, b.value, err))
+				return 1
+			}
+			opts = append(opts, b.with(v))
+		}
+	}
+
+	switch c.flagCertificate {
+	case "":
+	case "null":
+		opts = append(opts, authmethods.DefaultLdapAuthMethodCertificate())
+	default:
+		pem, err := ioutil.ReadFile(c.flagCertificate)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error reading certificate file %q: %s", c.flagCertificate, err))
+			return 1
+		}
+		opts = append(opts, authmethods.WithLdapAuthMethodCertificate(string(pem)))
+	}
+
+	for _, s := range []struct {
+		value string
+		with  func(string) authmethods.Option
+		unset func() authmethods.Option
+	}{
+		{c.flagBindDn, authmethods.WithLdapAuthMethodBindDn, authmethods.DefaultLdapAuthMethodBindDn},
+		{c.flagBindPassword, authmethods.WithLdapAuthMethodBindPassword, authmethods.DefaultLdapAuthMethodBindPassword},
+		{c.flagUserAttr, authmethods.WithLdapAuthMethodUserAttr, authmethods.DefaultLdapAuthMethodUserAttr},
+		{c.flagGroupDn, authmethods.WithLdapAuthMethodGroupDn, authmethods.DefaultLdapAuthMethodGroupDn},
+		{c.flagGroupAttr, authmethods.WithLdapAuthMethodGroupAttr, authmethods.DefaultLdapAuthMethodGroupAttr},
+		{c.flagGroupFilter, authmethods.WithLdapAuthMethodGroupFilter, authmethods.DefaultLdapAuthMethodGroupFilter},
+		{c.flagEmailAttr, authmethods.WithLdapAuthMethodEmailAttr, authmethods.DefaultLdapAuthMethodEmailAttr},
+		{c.flagNameAttr, authmethods.WithLdapAuthMethodNameAttr, authmethods.DefaultLdapAuthMethodNameAttr},
+	} {
+		switch s.value {
+		case "":
+		case "null":
+			opts = append(opts, s.unset())
+		default:
+			opts = append(opts, s.with(s.value))
+		}
+	}
+
+	switch {
+	case len(c.flagGroupMappings) == 0:
+	case len(c.flagGroupMappings) == 1 && c.flagGroupMappings[0] == "null":
+		opts = append(opts, authmethods.DefaultLdapAuthMethodGroupMappings())
+	default:
+		var mappings []*authmethods.LdapGroupMapping
+		for _, m := range c.flagGroupMappings {
+			i := strings.LastIndex(m, "=")
+			if i <= 0 || i == len(m)-1 {
+				c.UI.Error(fmt.Sprintf("Group mapping %q must be of the form <directory group>=<group id>", m))
+				return 1
+			}
+			mappings = append(mappings, &authmethods.LdapGroupMapping{Group: m[:i], GroupId: m[i+1:]})
+		}
+		opts = append(opts, authmethods.WithLdapAuthMethodGroupMappings(mappings))
+	}
+
+	authmethodClient := authmethods.NewClient(client)
+
+	// Perform check-and-set when needed
+	var version uint32
+	switch c.Func {
+	case "create":
+		// These don't update so don't need the existing version
+	default:
+		switch c.FlagVersion {
+		case 0:
+			opts = append(opts, authmethods.WithAutomaticVersioning(true))
+		default:
+			version = uint32(c.FlagVersion)
+		}
+	}
+
+	var result api.GenericResult
+
+	switch c.Func {
+	case "create":
+		result, err = authmethodClient.Create(c.Context, "ldap", c.FlagScopeId, opts...)
+	case "update":
+		result, err = authmethodClient.Update(c.Context, c.FlagId, version, opts...)
+	}
+
+	plural := "ldap-type auth-method"
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+		return 2
+	}
+
+	method := result.GetItem().(*authmethods.AuthMethod)
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(generateAuthMethodTableOutput(method))
+	case "json":
+		b, err := base.JsonFormatter{}.Format(method)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(b))
+	}
+
+	return 0
+}
@@ -31,7 +31,7 @@ func (c *Command) Synopsis() string {
 var flagsMap = map[string][]string{
 	"read":   {"id"},
 	"delete": {"id"},
-	"list":   {"scope-id"},
+	"list":   {"scope-id", "filter", "page-size", "recursive"},
 }
 
 func (c *Command) Help() string {
@@ -92,7 +92,18 @@ func (c *Command) Run(args []string) int {
 
 	existed := true
 	var result api.GenericResult
-	var listResult api.GenericListResult
+	var listIter *authtokens.AuthTokenListIterator
+
+	var opts []authtokens.Option
+	if c.FlagFilter != "" {
+		opts = append(opts, authtokens.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, authtokens.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, authtokens.WithRecursive(true))
+	}
 
 	switch c.Func {
 	case "read":
@@ -104,7 +115,7 @@ func (c *Command) Run(args []string) int {
 			err = nil
 		}
 	case "list":
-		listResult, err = authtokenClient.List(c.Context, c.FlagScopeId)
+		listIter, err = authtokenClient.ListIterator(c.Context, c.FlagScopeId, opts...)
 	}
 
 	plural := "auth token"
@@ -138,47 +149,35 @@ func (c *Command) Run(args []string) int {
 		return 0
 
 	case "list":
-		listedTokens := listResult.GetItems().([]*authtokens.AuthToken)
-		switch base.Format(c.UI) {
-		case "json":
-			if len(listedTokens) == 0 {
-				c.UI.Output("null")
-				return 0
-			}
-			b, err := base.JsonFormatter{}.Format(listedTokens)
-			if err != nil {
-				c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
-				return 1
-			}
-			c.UI.Output(string(b))
-
-		case "table":
-			if len(listedTokens) == 0 {
-				c.UI.Output("No auth tokens found")
-				return 0
-			}
+		printer := base.NewListPrinter(c.UI, "Auth Token information:", "No auth tokens found")
+		for listIter.Next() {
+			t := listIter.Item()
 			var output []string
-			output = []string{
-				"",
-				"Auth Token information:",
-			}
-			for i, t := range listedTokens {
-				if i > 0 {
-					output = append(output, "")
-				}
+			output = append(output,
+				fmt.Sprintf("  ID:                            %s", t.Id),
+				fmt.Sprintf("    Approximate Last Used Time:  %s", t.ApproximateLastUsedTime.Local().Format(time.RFC1123)),
+				fmt.Sprintf("    Auth Method ID:              %s", t.AuthMethodId),
+				fmt.Sprintf("    Created Time:                %s", t.CreatedTime.Local().Format(time.RFC1123)),
+				fmt.Sprintf("    Expiration Time:             %s", t.ExpirationTime.Local().Format(time.RFC1123)),
+				fmt.Sprintf("    Updated Time:                %s", t.UpdatedTime.Local().Format(time.RFC1123)),
+				fmt.Sprintf("    User ID:                     %s", t.UserId),
+			)
+			if c.FlagRecursive {
 				output = append(output,
-					fmt.Sprintf("  ID:                            %s", t.Id),
-					fmt.Sprintf("    Approximate Last Used Time:  %s", t.ApproximateLastUsedTime.Local().Format(time.RFC1123)),
-					fmt.Sprintf("    Auth Method ID:              %s", t.AuthMethodId),
-					fmt.Sprintf("    Created Time:                %s", t.CreatedTime.Local().Format(time.RFC1123)),
-					fmt.Sprintf("    Expiration Time:             %s", t.ExpirationTime.Local().Format(time.RFC1123)),
-					fmt.Sprintf("    Updated Time:                %s", t.UpdatedTime.Local().Format(time.RFC1123)),
-					fmt.Sprintf("    User ID:                     %s", t.UserId),
+					fmt.Sprintf("    Scope ID:                    %s", t.Scope.Id),
 				)
 			}
-			c.UI.Output(base.WrapForHelpText(output))
+			printer.Print(t, output)
 		}
-		return 0
+		if err := listIter.Err(); err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+				return 1
+			}
+			c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+			return 2
+		}
+		return printer.Finish()
 	}
 
 	token := result.GetItem().(*authtokens.AuthToken)
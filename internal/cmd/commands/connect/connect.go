@@ -60,13 +60,14 @@ var _ cli.CommandAutocomplete = (*Command)(nil)
 type Command struct {
 	*base.Command
 
-	flagAuthzToken string
-	flagListenAddr string
-	flagListenPort int
-	flagTargetId   string
-	flagHostId     string
-	flagExec       string
-	flagUsername   string
+	flagAuthzToken   string
+	flagListenAddr   string
+	flagListenPort   int
+	flagTargetId     string
+	flagHostId       string
+	flagWorkerRegion string
+	flagExec         string
+	flagUsername     string
 
 	// HTTP
 	httpFlags
@@ -87,13 +88,26 @@ type Command struct {
 	connWg             *sync.WaitGroup
 	listenerCloseOnce  sync.Once
 	listener           *net.TCPListener
-	listenerAddr       *net.TCPAddr
+	udpListener        *net.UDPConn
+	listenerIP         net.IP
+	listenerPort       int
 	connsLeftCh        chan int32
 	connectionsLeft    atomic.Int32
 	expiration         time.Time
 	execCmdReturnValue *atomic.Int32
 	proxyCtx           context.Context
 	proxyCancel        context.CancelFunc
+
+	// workerAddrs are the addresses of the workers of the session in order of
+	// preference and workerIdx the index of the one last connected through
+	workerAddrs []string
+	workerIdx   atomic.Int32
+
+	// flows maps the client addresses sending datagrams to a udp target to
+	// the websocket proxying their flow
+	flowsLock            sync.Mutex
+	flows                map[string]*websocket.Conn
+	udpListenerCloseOnce sync.Once
 }
 
 func (c *Command) Synopsis() string {
@@ -167,6 +181,12 @@ func (c *Command) Flags() *base.FlagSets {
 		Usage:  "The ID of a specific host to connect to out of the hosts from the target's host sets. If not specified, one is chosen at random.",
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:   "worker-region",
+		Target: &c.flagWorkerRegion,
+		Usage:  `A region hint used with -target-id. Workers whose "region" tag contains it are tried first.`,
+	})
+
 	f.StringVar(&base.StringVar{
 		Name:       "exec",
 		Target:     &c.flagExec,
@@ -314,6 +334,9 @@ func (c *Command) Run(args []string) (retCode int) {
 		if len(c.flagHostId) != 0 {
 			opts = append(opts, targets.WithHostId(c.flagHostId))
 		}
+		if len(c.flagWorkerRegion) != 0 {
+			opts = append(opts, targets.WithWorkerRegion(c.flagWorkerRegion))
+		}
 
 		sar, err := targetClient.AuthorizeSession(c.Context, c.flagTargetId, opts...)
 		if err != nil {
@@ -348,8 +371,16 @@ func (c *Command) Run(args []string) (retCode int) {
 		return 1
 	}
 
+	udp := c.sessionAuthzData.GetType() == "udp"
+	if udp && c.Func != "connect" {
+		c.UI.Error(fmt.Sprintf("Target %s is a udp target and cannot be connected to with %q", c.sessionAuthzData.GetTargetId(), c.Func))
+		return 1
+	}
+
 	c.connectionsLeft.Store(c.sessionAuthzData.ConnectionLimit)
-	workerAddr := c.sessionAuthzData.GetWorkerInfo()[0].GetAddress()
+	for _, wi := range c.sessionAuthzData.GetWorkerInfo() {
+		c.workerAddrs = append(c.workerAddrs, wi.GetAddress())
+	}
 
 	parsedCert, err := x509.ParseCertificate(c.sessionAuthzData.Certificate)
 	if err != nil {
@@ -393,10 +424,20 @@ func (c *Command) Run(args []string) (retCode int) {
 	// hijacked, just setting for completeness
 	transport.IdleConnTimeout = 0
 
-	c.listener, err = net.ListenTCP("tcp", &net.TCPAddr{
-		IP:   listenAddr,
-		Port: c.flagListenPort,
-	})
+	protocol := "tcp"
+	if udp {
+		protocol = "udp"
+		c.udpListener, err = net.ListenUDP("udp", &net.UDPAddr{
+			IP:   listenAddr,
+			Port: c.flagListenPort,
+		})
+		c.flows = make(map[string]*websocket.Conn)
+	} else {
+		c.listener, err = net.ListenTCP("tcp", &net.TCPAddr{
+			IP:   listenAddr,
+			Port: c.flagListenPort,
+		})
+	}
 	if err != nil {
 		c.UI.Error(fmt.Errorf("Error starting listening port: %w", err).Error())
 		return 1
@@ -405,6 +446,12 @@ func (c *Command) Run(args []string) (retCode int) {
 	listenerCloseFunc := func() {
 		// Forces the for loop to exist instead of spinning on errors
 		c.connectionsLeft.Store(0)
+		if udp {
+			// The socket is also used to reply to the flows already
+			// proxied, so it is only closed once they have ended
+			c.closeIdleUdpListener()
+			return
+		}
 		if err := c.listener.Close(); err != nil {
 			c.UI.Error(fmt.Errorf("Error closing listener on shutdown: %w", err).Error())
 			retCode = 1
@@ -416,13 +463,19 @@ func (c *Command) Run(args []string) (retCode int) {
 		c.listenerCloseOnce.Do(listenerCloseFunc)
 	}()
 
-	c.listenerAddr = c.listener.Addr().(*net.TCPAddr)
+	if udp {
+		addr := c.udpListener.LocalAddr().(*net.UDPAddr)
+		c.listenerIP, c.listenerPort = addr.IP, addr.Port
+	} else {
+		addr := c.listener.Addr().(*net.TCPAddr)
+		c.listenerIP, c.listenerPort = addr.IP, addr.Port
+	}
 
 	if c.flagExec == "" {
 		sessInfo := SessionInfo{
-			Protocol:        "tcp",
-			Address:         c.listenerAddr.IP.String(),
-			Port:            c.listenerAddr.Port,
+			Protocol:        protocol,
+			Address:         c.listenerIP.String(),
+			Port:            c.listenerPort,
 			Expiration:      c.expiration,
 			ConnectionLimit: c.sessionAuthzData.GetConnectionLimit(),
 			SessionId:       c.sessionAuthzData.GetSessionId(),
@@ -446,6 +499,10 @@ func (c *Command) Run(args []string) (retCode int) {
 	c.connWg.Add(1)
 	go func() {
 		defer c.connWg.Done()
+		if udp {
+			c.handleDatagrams(tofuToken, transport)
+			return
+		}
 		for {
 			listeningConn, err := c.listener.AcceptTCP()
 			if err != nil {
@@ -469,7 +526,6 @@ func (c *Command) Run(args []string) (retCode int) {
 				defer listeningConn.Close()
 				if err := c.handleConnection(
 					listeningConn,
-					workerAddr,
 					tofuToken,
 					transport); err != nil {
 					c.UI.Error(err.Error())
@@ -551,12 +607,87 @@ func (c *Command) Run(args []string) (retCode int) {
 
 func (c *Command) handleConnection(
 	listeningConn *net.TCPConn,
-	workerAddr string,
 	tofuToken string,
 	transport *http.Transport) error {
 
 	defer c.connWg.Done()
 
+	conn, err := c.dialWorker(tofuToken, transport, globals.TcpProxyV1)
+	if err != nil {
+		return err
+	}
+
+	// Get a wrapped net.Conn so we can use io.Copy
+	netConn := websocket.NetConn(c.proxyCtx, conn, websocket.MessageBinary)
+
+	localWg := new(sync.WaitGroup)
+	localWg.Add(2)
+
+	go func() {
+		defer localWg.Done()
+		io.Copy(netConn, listeningConn)
+		netConn.Close()
+		listeningConn.Close()
+	}()
+	go func() {
+		defer localWg.Done()
+		io.Copy(listeningConn, netConn)
+		listeningConn.Close()
+		netConn.Close()
+	}()
+	localWg.Wait()
+
+	return nil
+}
+
+// dialWorker opens a proxy connection for the session using subprotocol.
+// Workers are tried in order starting with the last one a connection was
+// made through, moving on to the next when one can't be reached or isn't
+// accepting connections.
+func (c *Command) dialWorker(
+	tofuToken string,
+	transport *http.Transport,
+	subprotocol string) (*websocket.Conn, error) {
+
+	start := int(c.workerIdx.Load())
+	var conn *websocket.Conn
+	var handshakeResult *proxy.HandshakeResult
+	var err error
+	for i := range c.workerAddrs {
+		idx := (start + i) % len(c.workerAddrs)
+		var failover bool
+		conn, handshakeResult, failover, err = c.connectToWorker(c.workerAddrs[idx], tofuToken, transport, subprotocol)
+		if err == nil {
+			c.workerIdx.Store(int32(idx))
+			break
+		}
+		if !failover {
+			return nil, err
+		}
+		if i < len(c.workerAddrs)-1 {
+			c.UI.Warn(fmt.Sprintf("%s, trying the next worker", err))
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if handshakeResult.GetConnectionsLeft() != -1 {
+		c.connsLeftCh <- handshakeResult.GetConnectionsLeft()
+	}
+	return conn, nil
+}
+
+// connectToWorker opens a proxy connection for the session through the
+// worker at workerAddr and performs the handshake. If the returned error
+// means the worker can't be used, but another worker of the session might
+// be, failover is true.
+func (c *Command) connectToWorker(
+	workerAddr string,
+	tofuToken string,
+	transport *http.Transport,
+	subprotocol string) (_ *websocket.Conn, _ *proxy.HandshakeResult, failover bool, retErr error) {
+
 	conn, resp, err := websocket.Dial(
 		c.proxyCtx,
 		fmt.Sprintf("wss://%s/v1/proxy", workerAddr),
@@ -564,79 +695,62 @@ func (c *Command) handleConnection(
 			HTTPClient: &http.Client{
 				Transport: transport,
 			},
-			Subprotocols: []string{globals.TcpProxyV1},
+			Subprotocols: []string{subprotocol},
 		},
 	)
 	if err != nil {
 		switch {
 		case strings.Contains(err.Error(), "tls: internal error"):
-			return errors.New("Session is unauthorized")
+			return nil, nil, true, fmt.Errorf("Session is unauthorized at worker %s", workerAddr)
 		case strings.Contains(err.Error(), "connect: connection refused"):
-			return fmt.Errorf("Unable to connect to worker at %s", workerAddr)
+			return nil, nil, true, fmt.Errorf("Unable to connect to worker at %s", workerAddr)
 		default:
-			return fmt.Errorf("Error dialing the worker: %w", err)
+			return nil, nil, true, fmt.Errorf("Error dialing the worker at %s: %w", workerAddr, err)
 		}
 	}
+	// Don't leak the websocket if the handshake with the worker fails
+	defer func() {
+		if retErr != nil {
+			conn.Close(websocket.StatusInternalError, "handshake failed")
+		}
+	}()
 
 	if resp == nil {
-		return errors.New("Response from worker is nil")
+		return nil, nil, false, errors.New("Response from worker is nil")
 	}
 	if resp.Header == nil {
-		return errors.New("Response header is nil")
+		return nil, nil, false, errors.New("Response header is nil")
 	}
 	negProto := resp.Header.Get("Sec-WebSocket-Protocol")
-	if negProto != globals.TcpProxyV1 {
-		return fmt.Errorf("Unexpected negotiated protocol: %s", negProto)
+	if negProto != subprotocol {
+		return nil, nil, false, fmt.Errorf("Unexpected negotiated protocol: %s", negProto)
 	}
 
 	handshake := proxy.ClientHandshake{TofuToken: tofuToken}
 	if err := wspb.Write(c.proxyCtx, conn, &handshake); err != nil {
-		return fmt.Errorf("error sending handshake to worker: %w", err)
+		return nil, nil, false, fmt.Errorf("error sending handshake to worker: %w", err)
 	}
-	var handshakeResult proxy.HandshakeResult
-	if err := wspb.Read(c.proxyCtx, conn, &handshakeResult); err != nil {
+	handshakeResult := new(proxy.HandshakeResult)
+	if err := wspb.Read(c.proxyCtx, conn, handshakeResult); err != nil {
 		switch {
+		case websocket.CloseStatus(err) == websocket.StatusTryAgainLater:
+			return nil, nil, true, fmt.Errorf("Worker at %s is draining and not accepting new connections", workerAddr)
 		case strings.Contains(err.Error(), "unable to authorize connection"):
 			// There's no reason to think we'd be able to authorize any more
 			// connections after the first has failed
 			c.connsLeftCh <- 0
-			return errors.New("Unable to authorize connection")
+			return nil, nil, false, errors.New("Unable to authorize connection")
 		}
 		switch {
 		case strings.Contains(err.Error(), "tofu token not allowed"):
 			// Nothing will be able to be done here, so cancel the context too
 			c.proxyCancel()
-			return errors.New("Session is already in use")
+			return nil, nil, false, errors.New("Session is already in use")
 		default:
-			return fmt.Errorf("error reading handshake result: %w", err)
+			return nil, nil, false, fmt.Errorf("error reading handshake result: %w", err)
 		}
 	}
-
-	if handshakeResult.GetConnectionsLeft() != -1 {
-		c.connsLeftCh <- handshakeResult.GetConnectionsLeft()
-	}
-
-	// Get a wrapped net.Conn so we can use io.Copy
-	netConn := websocket.NetConn(c.proxyCtx, conn, websocket.MessageBinary)
-
-	localWg := new(sync.WaitGroup)
-	localWg.Add(2)
-
-	go func() {
-		defer localWg.Done()
-		io.Copy(netConn, listeningConn)
-		netConn.Close()
-		listeningConn.Close()
-	}()
-	go func() {
-		defer localWg.Done()
-		io.Copy(listeningConn, netConn)
-		listeningConn.Close()
-		netConn.Close()
-	}()
-	localWg.Wait()
-
-	return nil
+	return conn, handshakeResult, false, nil
 }
 
 func (c *Command) updateConnsLeft(connsLeft int32) {
@@ -664,11 +778,11 @@ func (c *Command) handleExec(passthroughArgs []string) {
 	defer c.connWg.Done()
 	defer c.proxyCancel()
 
-	port := strconv.Itoa(c.listenerAddr.Port)
-	ip := c.listenerAddr.IP.String()
-	addr := c.listenerAddr.String()
+	port := strconv.Itoa(c.listenerPort)
+	ip := c.listenerIP.String()
+	addr := net.JoinHostPort(ip, port)
 
-	var args []string
+	var args, envs []string
 
 	switch c.Func {
 	case "http":
@@ -676,6 +790,7 @@ func (c *Command) handleExec(passthroughArgs []string) {
 
 	case "postgres":
 		args = append(args, c.postgresFlags.buildArgs(c, port, ip, addr)...)
+		envs = append(envs, c.postgresFlags.buildEnv(c)...)
 
 	case "rdp":
 		args = append(args, c.rdpFlags.buildArgs(c, port, ip, addr)...)
@@ -713,6 +828,7 @@ func (c *Command) handleExec(passthroughArgs []string) {
 		fmt.Sprintf("BOUNDARY_PROXIED_IP=%s", ip),
 		fmt.Sprintf("BOUNDARY_PROXIED_ADDR=%s", addr),
 	)
+	cmd.Env = append(cmd.Env, envs...)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
@@ -51,10 +51,9 @@ func httpOptions(c *Command, set *base.FlagSets) {
 	f.StringVar(&base.StringVar{
 		Name:       "scheme",
 		Target:     &c.flagHttpScheme,
-		Default:    "https",
 		EnvVar:     "BOUNDARY_CONNECT_HTTP_SCHEME",
 		Completion: complete.PredictNothing,
-		Usage:      `Specifies the scheme to use.`,
+		Usage:      `Specifies the scheme to use. Defaults to "http" for http-type targets, whose workers connect to the endpoint with TLS themselves when configured to, and to "https" otherwise.`,
 	})
 }
 
@@ -74,6 +73,12 @@ func (h *httpFlags) buildArgs(c *Command, port, ip, addr string) []string {
 	var args []string
 	switch h.flagHttpStyle {
 	case "curl":
+		if h.flagHttpScheme == "" {
+			h.flagHttpScheme = "https"
+			if c.sessionAuthzData.GetType() == "http" {
+				h.flagHttpScheme = "http"
+			}
+		}
 		if h.flagHttpMethod != "" {
 			args = append(args, "-X", h.flagHttpMethod)
 		}
@@ -1,9 +1,11 @@
 package connect
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/targets"
 	"github.com/posener/complete"
 )
 
@@ -28,7 +30,7 @@ func postgresOptions(c *Command, set *base.FlagSets) {
 		Target:     &c.flagUsername,
 		EnvVar:     "BOUNDARY_CONNECT_USERNAME",
 		Completion: complete.PredictNothing,
-		Usage:      `Specifies the username to pass through to the client`,
+		Usage:      `Specifies the username to pass through to the client. If not set, the username of the first credential of the target is used, if any.`,
 	})
 }
 
@@ -45,9 +47,45 @@ func (p *postgresFlags) buildArgs(c *Command, port, ip, addr string) []string {
 	switch p.flagPostgresStyle {
 	case "psql":
 		args = append(args, "-p", port, "-h", ip)
-		if c.flagUsername != "" {
-			args = append(args, "-U", c.flagUsername)
+		if username := p.username(c); username != "" {
+			args = append(args, "-U", username)
 		}
 	}
 	return args
 }
+
+// buildEnv returns the environment variables to set for the client. If the
+// target brokers a credential to the user its password is passed in
+// PGPASSWORD so the client doesn't prompt for it. If the worker injects the
+// credential no password is needed.
+func (p *postgresFlags) buildEnv(c *Command) []string {
+	cred := p.credential(c)
+	if cred == nil || cred.GetPassword() == "" || c.sessionAuthzData.GetCredentialInjection() != "" {
+		return nil
+	}
+	if c.flagUsername != "" && c.flagUsername != cred.GetUsername() {
+		return nil
+	}
+	switch p.flagPostgresStyle {
+	case "psql":
+		return []string{fmt.Sprintf("PGPASSWORD=%s", cred.GetPassword())}
+	}
+	return nil
+}
+
+// username returns the username to connect as: the one given by flag or
+// else the one of the first credential of the target
+func (p *postgresFlags) username(c *Command) string {
+	if c.flagUsername != "" {
+		return c.flagUsername
+	}
+	return p.credential(c).GetUsername()
+}
+
+func (p *postgresFlags) credential(c *Command) *targets.SessionCredential {
+	creds := c.sessionAuthzData.GetCredentials()
+	if len(creds) == 0 {
+		return nil
+	}
+	return creds[0]
+}
@@ -0,0 +1,116 @@
+package connect
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/hashicorp/boundary/globals"
+	"nhooyr.io/websocket"
+)
+
+// maxDatagramSize is the size of the largest UDP payload
+const maxDatagramSize = 64 * 1024
+
+// handleDatagrams reads the datagrams sent to the local socket of a udp
+// target. Each client address is proxied as its own flow, and so its own
+// session connection, through a websocket opened for its first datagram.
+func (c *Command) handleDatagrams(tofuToken string, transport *http.Transport) {
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, clientAddr, err := c.udpListener.ReadFromUDP(buf)
+		if err != nil {
+			select {
+			case <-c.proxyCtx.Done():
+				return
+			case <-c.Context.Done():
+				return
+			default:
+				// The socket is closed once no connections are left
+				if c.connectionsLeft.Load() == 0 {
+					return
+				}
+				c.UI.Error(fmt.Errorf("Error reading datagram: %w", err).Error())
+				continue
+			}
+		}
+
+		key := clientAddr.String()
+		c.flowsLock.Lock()
+		conn, ok := c.flows[key]
+		if !ok && c.connectionsLeft.Load() != 0 {
+			// Reserve the flow so that the socket isn't closed while the
+			// worker is dialed
+			c.flows[key] = nil
+		}
+		c.flowsLock.Unlock()
+		if !ok {
+			if c.connectionsLeft.Load() == 0 {
+				continue
+			}
+			conn, err = c.dialWorker(tofuToken, transport, globals.UdpProxyV1)
+			if err != nil {
+				c.UI.Error(err.Error())
+				c.removeFlow(key)
+				continue
+			}
+			conn.SetReadLimit(maxDatagramSize)
+			c.flowsLock.Lock()
+			c.flows[key] = conn
+			c.flowsLock.Unlock()
+			c.connWg.Add(1)
+			go c.handleFlow(conn, key, clientAddr)
+		}
+		if err := conn.Write(c.proxyCtx, websocket.MessageBinary, buf[:n]); err != nil {
+			// Ends the flow, which is opened again by the next datagram
+			conn.Close(websocket.StatusInternalError, "datagram write failed")
+		}
+	}
+}
+
+// handleFlow sends the datagrams received from the worker for a flow back to
+// its client until the worker closes the flow.
+func (c *Command) handleFlow(conn *websocket.Conn, key string, clientAddr *net.UDPAddr) {
+	defer c.connWg.Done()
+	defer c.removeFlow(key)
+	defer conn.Close(websocket.StatusNormalClosure, "done")
+
+	for {
+		typ, msg, err := conn.Read(c.proxyCtx)
+		if err != nil {
+			return
+		}
+		if typ != websocket.MessageBinary {
+			continue
+		}
+		if _, err := c.udpListener.WriteToUDP(msg, clientAddr); err != nil {
+			return
+		}
+	}
+}
+
+// removeFlow forgets the flow of key, closing the socket if it was the last
+// flow and no connections are left.
+func (c *Command) removeFlow(key string) {
+	c.flowsLock.Lock()
+	delete(c.flows, key)
+	c.flowsLock.Unlock()
+	if c.connectionsLeft.Load() == 0 {
+		c.closeIdleUdpListener()
+	}
+}
+
+// closeIdleUdpListener closes the socket of a udp target unless flows are
+// still proxied through it.
+func (c *Command) closeIdleUdpListener() {
+	c.flowsLock.Lock()
+	defer c.flowsLock.Unlock()
+	if len(c.flows) > 0 {
+		return
+	}
+	c.udpListenerCloseOnce.Do(func() {
+		if err := c.udpListener.Close(); err != nil {
+			c.UI.Error(fmt.Errorf("Error closing listener on shutdown: %w", err).Error())
+		}
+	})
+}
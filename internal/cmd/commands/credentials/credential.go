@@ -0,0 +1,264 @@
+package credentials
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/hashicorp/boundary/sdk/strutil"
+	"github.com/hashicorp/vault/sdk/helper/password"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*Command)(nil)
+var _ cli.CommandAutocomplete = (*Command)(nil)
+
+type Command struct {
+	*base.Command
+
+	Func string
+
+	flagCredentialStoreId string
+	flagUsername          string
+	flagPassword          string
+}
+
+func (c *Command) Synopsis() string {
+	return common.SynopsisFunc(c.Func, "credential")
+}
+
+var helpMap = func() map[string]func() string {
+	return common.HelpMap("credential")
+}
+
+var flagsMap = map[string][]string{
+	"create": {"credential-store-id", "name", "description", "username", "password"},
+	"update": {"id", "name", "description", "username", "password", "version"},
+	"read":   {"id"},
+	"delete": {"id"},
+	"list":   {"credential-store-id", "filter", "page-size"},
+}
+
+func (c *Command) Help() string {
+	hm := helpMap()
+	if c.Func == "" {
+		return hm["base"]()
+	}
+	return hm[c.Func]() + c.Flags().Help()
+}
+
+func (c *Command) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	populateFlags(c, f, flagsMap[c.Func])
+
+	return set
+}
+
+func (c *Command) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *Command) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *Command) Run(args []string) int {
+	if c.Func == "" {
+		return cli.RunResultHelp
+	}
+
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if strutil.StrListContains(flagsMap[c.Func], "id") && c.FlagId == "" {
+		c.UI.Error("ID is required but not passed in via -id")
+		return 1
+	}
+	if strutil.StrListContains(flagsMap[c.Func], "credential-store-id") && c.flagCredentialStoreId == "" {
+		c.UI.Error("Credential Store ID must be passed in via -credential-store-id")
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating API client: %s", err.Error()))
+		return 2
+	}
+
+	var opts []credentials.Option
+
+	switch c.FlagName {
+	case "":
+	case "null":
+		opts = append(opts, credentials.DefaultName())
+	default:
+		opts = append(opts, credentials.WithName(c.FlagName))
+	}
+
+	switch c.FlagDescription {
+	case "":
+	case "null":
+		opts = append(opts, credentials.DefaultDescription())
+	default:
+		opts = append(opts, credentials.WithDescription(c.FlagDescription))
+	}
+
+	if c.flagUsername != "" {
+		opts = append(opts, credentials.WithUsername(c.flagUsername))
+	}
+
+	// The password is required on creation, on update it is only changed
+	// if given
+	if c.Func == "create" && c.flagPassword == "" {
+		fmt.Print("Password is not set as flag, please enter it now (will be hidden): ")
+		value, err := password.Read(os.Stdin)
+		fmt.Print("\n")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("An error occurred attempting to read the password. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). The raw error was:\n\n%s", err.Error()))
+			return 2
+		}
+		c.flagPassword = strings.TrimSpace(value)
+	}
+	if c.flagPassword != "" {
+		opts = append(opts, credentials.WithPassword(c.flagPassword))
+	}
+
+	credClient := credentials.NewClient(client)
+
+	// Perform check-and-set when needed
+	var version uint32
+	switch c.Func {
+	case "create", "read", "delete", "list":
+		// These don't update so don't need the existing version
+	default:
+		switch c.FlagVersion {
+		case 0:
+			opts = append(opts, credentials.WithAutomaticVersioning(true))
+		default:
+			version = uint32(c.FlagVersion)
+		}
+	}
+
+	existed := true
+	var result api.GenericResult
+	var listIter *credentials.CredentialListIterator
+
+	if c.FlagFilter != "" {
+		opts = append(opts, credentials.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, credentials.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	switch c.Func {
+	case "create":
+		result, err = credClient.Create(c.Context, c.flagCredentialStoreId, opts...)
+	case "update":
+		result, err = credClient.Update(c.Context, c.FlagId, version, opts...)
+	case "read":
+		result, err = credClient.Read(c.Context, c.FlagId, opts...)
+	case "delete":
+		_, err = credClient.Delete(c.Context, c.FlagId, opts...)
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Status == int32(http.StatusNotFound) {
+			existed = false
+			err = nil
+		}
+	case "list":
+		listIter, err = credClient.ListIterator(c.Context, c.flagCredentialStoreId, opts...)
+	}
+
+	plural := "credential"
+	if c.Func == "list" {
+		plural = "credentials"
+	}
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+		return 2
+	}
+
+	switch c.Func {
+	case "delete":
+		switch base.Format(c.UI) {
+		case "json":
+			c.UI.Output("null")
+		case "table":
+			output := "The delete operation completed successfully"
+			switch existed {
+			case true:
+				output += "."
+			default:
+				output += ", however the resource did not exist at the time."
+			}
+			c.UI.Output(output)
+		}
+		return 0
+
+	case "list":
+		printer := base.NewListPrinter(c.UI, "Credential information:", "No credentials found")
+		for listIter.Next() {
+			cred := listIter.Item()
+			var output []string
+			if true {
+				output = append(output,
+					fmt.Sprintf("  ID:            %s", cred.Id),
+					fmt.Sprintf("    Version:     %d", cred.Version),
+				)
+			}
+			if cred.Name != "" {
+				output = append(output,
+					fmt.Sprintf("    Name:        %s", cred.Name),
+				)
+			}
+			if cred.Description != "" {
+				output = append(output,
+					fmt.Sprintf("    Description: %s", cred.Description),
+				)
+			}
+			if true {
+				output = append(output,
+					fmt.Sprintf("    Username:    %s", cred.Username),
+				)
+			}
+			printer.Print(cred, output)
+		}
+		if err := listIter.Err(); err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+				return 1
+			}
+			c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+			return 2
+		}
+		return printer.Finish()
+	}
+
+	cred := result.GetItem().(*credentials.Credential)
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(generateCredentialTableOutput(cred))
+	case "json":
+		b, err := base.JsonFormatter{}.Format(cred)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(b))
+	}
+
+	return 0
+}
@@ -0,0 +1,69 @@
+package credentials
+
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+func populateFlags(c *Command, f *base.FlagSet, flagNames []string) {
+	common.PopulateCommonFlags(c.Command, f, resource.Credential.String(), flagNames)
+
+	for _, name := range flagNames {
+		switch name {
+		case "credential-store-id":
+			f.StringVar(&base.StringVar{
+				Name:   "credential-store-id",
+				EnvVar: "BOUNDARY_CREDENTIAL_STORE_ID",
+				Target: &c.flagCredentialStoreId,
+				Usage:  "The credential-store resource to use for the operation",
+			})
+		case "username":
+			f.StringVar(&base.StringVar{
+				Name:   "username",
+				Target: &c.flagUsername,
+				Usage:  "The username of the credential",
+			})
+		case "password":
+			f.StringVar(&base.StringVar{
+				Name:   "password",
+				Target: &c.flagPassword,
+				Usage:  "The password of the credential. On create, if not specified, the command will prompt for the password to be entered in a non-echoing way.",
+			})
+		}
+	}
+}
+
+func generateCredentialTableOutput(in *credentials.Credential) string {
+	nonAttributeMap := map[string]interface{}{
+		"ID":                  in.Id,
+		"Version":             in.Version,
+		"Created Time":        in.CreatedTime.Local().Format(time.RFC1123),
+		"Updated Time":        in.UpdatedTime.Local().Format(time.RFC1123),
+		"Credential Store ID": in.CredentialStoreId,
+		"Username":            in.Username,
+	}
+
+	if in.Name != "" {
+		nonAttributeMap["Name"] = in.Name
+	}
+	if in.Description != "" {
+		nonAttributeMap["Description"] = in.Description
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Credential information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+		"",
+		"  Scope:",
+		base.ScopeInfoForOutput(in.Scope, maxLength),
+	}
+
+	return base.WrapForHelpText(ret)
+}
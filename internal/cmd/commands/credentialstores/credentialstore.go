@@ -0,0 +1,241 @@
+package credentialstores
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentialstores"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/sdk/strutil"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*Command)(nil)
+var _ cli.CommandAutocomplete = (*Command)(nil)
+
+type Command struct {
+	*base.Command
+
+	Func string
+}
+
+func (c *Command) Synopsis() string {
+	return common.SynopsisFunc(c.Func, "credential-store")
+}
+
+var helpMap = func() map[string]func() string {
+	return common.HelpMap("credential-store")
+}
+
+var flagsMap = map[string][]string{
+	"create": {"scope-id", "name", "description"},
+	"update": {"id", "name", "description", "version"},
+	"read":   {"id"},
+	"delete": {"id"},
+	"list":   {"scope-id", "filter", "page-size", "recursive"},
+}
+
+func (c *Command) Help() string {
+	hm := helpMap()
+	if c.Func == "" {
+		return hm["base"]()
+	}
+	return hm[c.Func]() + c.Flags().Help()
+}
+
+func (c *Command) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	common.PopulateCommonFlags(c.Command, f, resource.CredentialStore.String(), flagsMap[c.Func])
+
+	return set
+}
+
+func (c *Command) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *Command) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *Command) Run(args []string) int {
+	if c.Func == "" {
+		return cli.RunResultHelp
+	}
+
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if strutil.StrListContains(flagsMap[c.Func], "id") && c.FlagId == "" {
+		c.UI.Error("ID is required but not passed in via -id")
+		return 1
+	}
+	if strutil.StrListContains(flagsMap[c.Func], "scope-id") && c.FlagScopeId == "" {
+		c.UI.Error("Scope ID must be passed in via -scope-id")
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating API client: %s", err.Error()))
+		return 2
+	}
+
+	var opts []credentialstores.Option
+
+	switch c.FlagName {
+	case "":
+	case "null":
+		opts = append(opts, credentialstores.DefaultName())
+	default:
+		opts = append(opts, credentialstores.WithName(c.FlagName))
+	}
+
+	switch c.FlagDescription {
+	case "":
+	case "null":
+		opts = append(opts, credentialstores.DefaultDescription())
+	default:
+		opts = append(opts, credentialstores.WithDescription(c.FlagDescription))
+	}
+
+	storeClient := credentialstores.NewClient(client)
+
+	// Perform check-and-set when needed
+	var version uint32
+	switch c.Func {
+	case "create", "read", "delete", "list":
+		// These don't update so don't need the existing version
+	default:
+		switch c.FlagVersion {
+		case 0:
+			opts = append(opts, credentialstores.WithAutomaticVersioning(true))
+		default:
+			version = uint32(c.FlagVersion)
+		}
+	}
+
+	existed := true
+	var result api.GenericResult
+	var listIter *credentialstores.CredentialStoreListIterator
+
+	if c.FlagFilter != "" {
+		opts = append(opts, credentialstores.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, credentialstores.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, credentialstores.WithRecursive(true))
+	}
+
+	switch c.Func {
+	case "create":
+		result, err = storeClient.Create(c.Context, c.FlagScopeId, opts...)
+	case "update":
+		result, err = storeClient.Update(c.Context, c.FlagId, version, opts...)
+	case "read":
+		result, err = storeClient.Read(c.Context, c.FlagId, opts...)
+	case "delete":
+		_, err = storeClient.Delete(c.Context, c.FlagId, opts...)
+		if apiErr := api.AsServerError(err); apiErr != nil && apiErr.Status == int32(http.StatusNotFound) {
+			existed = false
+			err = nil
+		}
+	case "list":
+		listIter, err = storeClient.ListIterator(c.Context, c.FlagScopeId, opts...)
+	}
+
+	plural := "credential store"
+	if c.Func == "list" {
+		plural = "credential stores"
+	}
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+		return 2
+	}
+
+	switch c.Func {
+	case "delete":
+		switch base.Format(c.UI) {
+		case "json":
+			c.UI.Output("null")
+		case "table":
+			output := "The delete operation completed successfully"
+			switch existed {
+			case true:
+				output += "."
+			default:
+				output += ", however the resource did not exist at the time."
+			}
+			c.UI.Output(output)
+		}
+		return 0
+
+	case "list":
+		printer := base.NewListPrinter(c.UI, "Credential Store information:", "No credential stores found")
+		for listIter.Next() {
+			s := listIter.Item()
+			var output []string
+			if true {
+				output = append(output,
+					fmt.Sprintf("  ID:            %s", s.Id),
+					fmt.Sprintf("    Version:     %d", s.Version),
+				)
+			}
+			if s.Name != "" {
+				output = append(output,
+					fmt.Sprintf("    Name:        %s", s.Name),
+				)
+			}
+			if s.Description != "" {
+				output = append(output,
+					fmt.Sprintf("    Description: %s", s.Description),
+				)
+			}
+			if c.FlagRecursive {
+				output = append(output,
+					fmt.Sprintf("    Scope ID:    %s", s.Scope.Id),
+				)
+			}
+			printer.Print(s, output)
+		}
+		if err := listIter.Err(); err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+				return 1
+			}
+			c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+			return 2
+		}
+		return printer.Finish()
+	}
+
+	store := result.GetItem().(*credentialstores.CredentialStore)
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(generateCredentialStoreTableOutput(store))
+	case "json":
+		b, err := base.JsonFormatter{}.Format(store)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(b))
+	}
+
+	return 0
+}
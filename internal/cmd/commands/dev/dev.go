@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/boundary/internal/servers/worker"
 	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/shared-secure-libs/configutil"
 	"github.com/mitchellh/cli"
 	"github.com/posener/complete"
 )
@@ -49,6 +50,8 @@ type Command struct {
 	flagControllerAPIListenAddr      string
 	flagControllerClusterListenAddr  string
 	flagWorkerProxyListenAddr        string
+	flagOpsListenAddr                string
+	flagGrpcListenAddr               string
 	flagWorkerPublicAddr             string
 	flagPassthroughDirectory         string
 	flagRecoveryKey                  string
@@ -170,6 +173,20 @@ func (c *Command) Flags() *base.FlagSets {
 		Usage:  "Address to bind to for worker \"proxy\" purpose.",
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:   "ops-listen-address",
+		Target: &c.flagOpsListenAddr,
+		EnvVar: "BOUNDARY_DEV_OPS_LISTEN_ADDRESS",
+		Usage:  "Address to bind to for \"ops\" purpose, serving /metrics. If unset, no ops listener is started.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "grpc-listen-address",
+		Target: &c.flagGrpcListenAddr,
+		EnvVar: "BOUNDARY_DEV_GRPC_LISTEN_ADDRESS",
+		Usage:  "Address to bind to for \"grpc\" purpose, serving the controller API over gRPC. If unset, no grpc listener is started.",
+	})
+
 	f.StringVar(&base.StringVar{
 		Name:   "worker-public-address",
 		Target: &c.flagWorkerPublicAddr,
@@ -308,6 +325,22 @@ func (c *Command) Run(args []string) int {
 			}
 		}
 	}
+	if c.flagOpsListenAddr != "" {
+		c.Config.Listeners = append(c.Config.Listeners, &configutil.Listener{
+			Type:       "tcp",
+			Purpose:    []string{"ops"},
+			Address:    c.flagOpsListenAddr,
+			TLSDisable: true,
+		})
+	}
+	if c.flagGrpcListenAddr != "" {
+		c.Config.Listeners = append(c.Config.Listeners, &configutil.Listener{
+			Type:       "tcp",
+			Purpose:    []string{"grpc"},
+			Address:    c.flagGrpcListenAddr,
+			TLSDisable: true,
+		})
+	}
 
 	if err := c.SetupLogging(c.flagLogLevel, c.flagLogFormat, "", ""); err != nil {
 		c.UI.Error(err.Error())
@@ -344,10 +377,14 @@ func (c *Command) Run(args []string) int {
 	c.Info["[Recovery] AEAD Key Bytes"] = c.Config.DevRecoveryKey
 
 	// Initialize the listeners
-	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy"}); err != nil {
+	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy", "ops", "grpc"}); err != nil {
 		c.UI.Error(err.Error())
 		return 1
 	}
+	if err := c.StartOpsServers(); err != nil {
+		c.UI.Error(fmt.Errorf("Error starting ops servers: %w", err).Error())
+		return 1
+	}
 
 	if err := c.SetupWorkerPublicAddress(c.Config, c.flagWorkerPublicAddr); err != nil {
 		c.UI.Error(err.Error())
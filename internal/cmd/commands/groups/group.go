@@ -46,7 +46,7 @@ var flagsMap = map[string][]string{
 	"update":         {"id", "name", "description", "version"},
 	"read":           {"id"},
 	"delete":         {"id"},
-	"list":           {"scope-id"},
+	"list":           {"scope-id", "filter", "page-size", "recursive"},
 	"add-members":    {"id", "member", "version"},
 	"set-members":    {"id", "member", "version"},
 	"remove-members": {"id", "member", "version"},
@@ -159,7 +159,17 @@ func (c *Command) Run(args []string) int {
 
 	existed := true
 	var result api.GenericResult
-	var listResult api.GenericListResult
+	var listIter *groups.GroupListIterator
+
+	if c.FlagFilter != "" {
+		opts = append(opts, groups.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, groups.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, groups.WithRecursive(true))
+	}
 
 	switch c.Func {
 	case "create":
@@ -175,7 +185,7 @@ func (c *Command) Run(args []string) int {
 			err = nil
 		}
 	case "list":
-		listResult, err = groupClient.List(c.Context, c.FlagScopeId, opts...)
+		listIter, err = groupClient.ListIterator(c.Context, c.FlagScopeId, opts...)
 	case "add-members":
 		result, err = groupClient.AddMembers(c.Context, c.FlagId, version, members, opts...)
 	case "set-members":
@@ -215,54 +225,42 @@ func (c *Command) Run(args []string) int {
 		return 0
 
 	case "list":
-		listedGroups := listResult.GetItems().([]*groups.Group)
-		switch base.Format(c.UI) {
-		case "json":
-			if len(listedGroups) == 0 {
-				c.UI.Output("null")
-				return 0
+		printer := base.NewListPrinter(c.UI, "Group information:", "No groups found")
+		for listIter.Next() {
+			g := listIter.Item()
+			var output []string
+			if true {
+				output = append(output,
+					fmt.Sprintf("  ID:            %s", g.Id),
+					fmt.Sprintf("    Version:     %d", g.Version),
+				)
 			}
-			b, err := base.JsonFormatter{}.Format(listedGroups)
-			if err != nil {
-				c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
-				return 1
+			if g.Name != "" {
+				output = append(output,
+					fmt.Sprintf("    Name:        %s", g.Name),
+				)
 			}
-			c.UI.Output(string(b))
-
-		case "table":
-			if len(listedGroups) == 0 {
-				c.UI.Output("No groups found")
-				return 0
+			if g.Description != "" {
+				output = append(output,
+					fmt.Sprintf("    Description: %s", g.Description),
+				)
 			}
-			var output []string
-			output = []string{
-				"",
-				"Group information:",
+			if c.FlagRecursive {
+				output = append(output,
+					fmt.Sprintf("    Scope ID:    %s", g.Scope.Id),
+				)
 			}
-			for i, g := range listedGroups {
-				if i > 0 {
-					output = append(output, "")
-				}
-				if true {
-					output = append(output,
-						fmt.Sprintf("  ID:            %s", g.Id),
-						fmt.Sprintf("    Version:     %d", g.Version),
-					)
-				}
-				if g.Name != "" {
-					output = append(output,
-						fmt.Sprintf("    Name:        %s", g.Name),
-					)
-				}
-				if g.Description != "" {
-					output = append(output,
-						fmt.Sprintf("    Description: %s", g.Description),
-					)
-				}
+			printer.Print(g, output)
+		}
+		if err := listIter.Err(); err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+				return 1
 			}
-			c.UI.Output(base.WrapForHelpText(output))
+			c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+			return 2
 		}
-		return 0
+		return printer.Finish()
 	}
 
 	group := result.GetItem().(*groups.Group)
@@ -0,0 +1,228 @@
+package hostcatalogs
+
+import (
+	"fmt"
+	"net/textproto"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hostcatalogs"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/hashicorp/boundary/sdk/strutil"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*DynamicCommand)(nil)
+var _ cli.CommandAutocomplete = (*DynamicCommand)(nil)
+
+type DynamicCommand struct {
+	*base.Command
+
+	Func string
+
+	flagSource                 string
+	flagRefreshIntervalSeconds string
+}
+
+func (c *DynamicCommand) Synopsis() string {
+	return fmt.Sprintf("%s a dynamic-type host catalog", textproto.CanonicalMIMEHeaderKey(c.Func))
+}
+
+var dynamicFlagsMap = map[string][]string{
+	"create": {"scope-id", "name", "description", "source", "refresh-interval-seconds"},
+	"update": {"id", "name", "description", "version", "source", "refresh-interval-seconds"},
+}
+
+func (c *DynamicCommand) Help() string {
+	var info string
+	switch c.Func {
+	case "create":
+		info = base.WrapForHelpText([]string{
+			"Usage: boundary host-catalogs create dynamic [options] [args]",
+			"",
+			"  Create a dynamic-type host catalog. The hosts of a dynamic host catalog are synced from a JSON or YAML inventory read from a file or fetched from an HTTP endpoint. Example:",
+			"",
+			`    $ boundary host-catalogs create dynamic -name prodops -source /etc/inventory/prod.yaml -refresh-interval-seconds 3600`,
+			"",
+			"",
+		})
+
+	case "update":
+		info = base.WrapForHelpText([]string{
+			"Usage: boundary host-catalogs update dynamic [options] [args]",
+			"",
+			"  Update a dynamic-type host catalog given its ID. Example:",
+			"",
+			`    $ boundary host-catalogs update dynamic -id hcdy_1234567890 -source http://127.0.0.1:8080/inventory.json`,
+			"",
+			"",
+		})
+	}
+	return info + c.Flags().Help()
+}
+
+func (c *DynamicCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	common.PopulateCommonFlags(c.Command, f, "dynamic-type host catalog", dynamicFlagsMap[c.Func])
+
+	f = set.NewFlagSet("Dynamic Host Catalog Options")
+
+	for _, name := range dynamicFlagsMap[c.Func] {
+		switch name {
+		case "source":
+			f.StringVar(&base.StringVar{
+				Name:   "source",
+				Target: &c.flagSource,
+				Usage:  "The inventory the hosts are synced from. Can be a file path, a file:// URL, or an http or https URL.",
+			})
+		case "refresh-interval-seconds":
+			f.StringVar(&base.StringVar{
+				Name:   "refresh-interval-seconds",
+				Target: &c.flagRefreshIntervalSeconds,
+				Usage:  `How often the hosts are synced from the source. Can be specified as an integer number of seconds or a duration string. File sources are also synced whenever the file changes.`,
+			})
+		}
+	}
+
+	return set
+}
+
+func (c *DynamicCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *DynamicCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *DynamicCommand) Run(args []string) int {
+	if c.Func == "" {
+		return cli.RunResultHelp
+	}
+
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if strutil.StrListContains(dynamicFlagsMap[c.Func], "id") && c.FlagId == "" {
+		c.UI.Error("ID is required but not passed in via -id")
+		return 1
+	}
+	if strutil.StrListContains(dynamicFlagsMap[c.Func], "scope-id") && c.FlagScopeId == "" {
+		c.UI.Error("Scope ID must be passed in via -scope-id")
+		return 1
+	}
+	if c.Func == "create" && c.flagSource == "" {
+		c.UI.Error("Source must be passed in via -source")
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating API client: %s", err.Error()))
+		return 2
+	}
+
+	var opts []hostcatalogs.Option
+
+	switch c.FlagName {
+	case "":
+	case "null":
+		opts = append(opts, hostcatalogs.DefaultName())
+	default:
+		opts = append(opts, hostcatalogs.WithName(c.FlagName))
+	}
+
+	switch c.FlagDescription {
+	case "":
+	case "null":
+		opts = append(opts, hostcatalogs.DefaultDescription())
+	default:
+		opts = append(opts, hostcatalogs.WithDescription(c.FlagDescription))
+	}
+
+	switch c.flagSource {
+	case "":
+	case "null":
+		opts = append(opts, hostcatalogs.DefaultDynamicHostCatalogSource())
+	default:
+		opts = append(opts, hostcatalogs.WithDynamicHostCatalogSource(c.flagSource))
+	}
+
+	switch c.flagRefreshIntervalSeconds {
+	case "":
+	case "null":
+		opts = append(opts, hostcatalogs.DefaultDynamicHostCatalogRefreshIntervalSeconds())
+	default:
+		var final uint32
+		secs, err := strconv.ParseUint(c.flagRefreshIntervalSeconds, 10, 32)
+		if err == nil {
+			final = uint32(secs)
+		} else {
+			dur, err := time.ParseDuration(c.flagRefreshIntervalSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagRefreshIntervalSeconds, err))
+				return 1
+			}
+			final = uint32(dur.Seconds())
+		}
+		opts = append(opts, hostcatalogs.WithDynamicHostCatalogRefreshIntervalSeconds(final))
+	}
+
+	hostcatalogClient := hostcatalogs.NewClient(client)
+
+	// Perform check-and-set when needed
+	var version uint32
+	switch c.Func {
+	case "create":
+		// These don't update so don't need the existing version
+	default:
+		switch c.FlagVersion {
+		case 0:
+			opts = append(opts, hostcatalogs.WithAutomaticVersioning(true))
+		default:
+			version = uint32(c.FlagVersion)
+		}
+	}
+
+	var result api.GenericResult
+
+	switch c.Func {
+	case "create":
+		result, err = hostcatalogClient.Create(c.Context, "dynamic", c.FlagScopeId, opts...)
+	case "update":
+		result, err = hostcatalogClient.Update(c.Context, c.FlagId, version, opts...)
+	}
+
+	plural := "dynamic-type host-catalog"
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+		return 2
+	}
+
+	catalog := result.GetItem().(*hostcatalogs.HostCatalog)
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(generateHostCatalogTableOutput(catalog))
+	case "json":
+		b, err := base.JsonFormatter{}.Format(catalog)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(b))
+	}
+
+	return 0
+}
@@ -46,5 +46,7 @@ func generateHostCatalogTableOutput(in *hostcatalogs.HostCatalog) string {
 }
 
 var keySubstMap = map[string]string{
-	"address": "Address",
+	"address":                  "Address",
+	"source":                   "Source",
+	"refresh_interval_seconds": "Refresh Interval Seconds",
 }
@@ -30,7 +30,7 @@ func (c *Command) Synopsis() string {
 var flagsMap = map[string][]string{
 	"read":   {"id"},
 	"delete": {"id"},
-	"list":   {"scope-id"},
+	"list":   {"scope-id", "filter", "page-size", "recursive"},
 }
 
 func (c *Command) Help() string {
@@ -59,6 +59,10 @@ func (c *Command) Help() string {
 			"",
 			`      $ boundary host-catalogs create static -name prodops -description "For ProdOps usage"`,
 			"",
+			"    Create a dynamic-type host catalog:",
+			"",
+			`      $ boundary host-catalogs create dynamic -name prodops -source /etc/inventory/prod.yaml`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "update":
@@ -71,6 +75,10 @@ func (c *Command) Help() string {
 			"",
 			`      $ boundary host-catalogs update static -id hcst_1234567890 -name devops -description "For DevOps usage"`,
 			"",
+			"    Update a dynamic-type host catalog:",
+			"",
+			`      $ boundary host-catalogs update dynamic -id hcdy_1234567890 -refresh-interval-seconds 600`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	default:
@@ -150,7 +158,17 @@ func (c *Command) Run(args []string) int {
 
 	existed := true
 	var result api.GenericResult
-	var listResult api.GenericListResult
+	var listIter *hostcatalogs.HostCatalogListIterator
+
+	if c.FlagFilter != "" {
+		opts = append(opts, hostcatalogs.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, hostcatalogs.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, hostcatalogs.WithRecursive(true))
+	}
 
 	switch c.Func {
 	case "read":
@@ -162,7 +180,7 @@ func (c *Command) Run(args []string) int {
 			err = nil
 		}
 	case "list":
-		listResult, err = hostcatalogClient.List(c.Context, c.FlagScopeId, opts...)
+		listIter, err = hostcatalogClient.ListIterator(c.Context, c.FlagScopeId, opts...)
 	}
 
 	plural := "host catalog"
@@ -196,55 +214,43 @@ func (c *Command) Run(args []string) int {
 		return 0
 
 	case "list":
-		listedCatalogs := listResult.GetItems().([]*hostcatalogs.HostCatalog)
-		switch base.Format(c.UI) {
-		case "json":
-			if len(listedCatalogs) == 0 {
-				c.UI.Output("null")
-				return 0
+		printer := base.NewListPrinter(c.UI, "Host Catalog information:", "No host catalogs found")
+		for listIter.Next() {
+			m := listIter.Item()
+			var output []string
+			if true {
+				output = append(output,
+					fmt.Sprintf("  ID:             %s", m.Id),
+					fmt.Sprintf("    Version:      %d", m.Version),
+					fmt.Sprintf("    Type:         %s", m.Type),
+				)
 			}
-			b, err := base.JsonFormatter{}.Format(listedCatalogs)
-			if err != nil {
-				c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
-				return 1
+			if m.Name != "" {
+				output = append(output,
+					fmt.Sprintf("    Name:         %s", m.Name),
+				)
 			}
-			c.UI.Output(string(b))
-
-		case "table":
-			if len(listedCatalogs) == 0 {
-				c.UI.Output("No host catalogs found")
-				return 0
+			if m.Description != "" {
+				output = append(output,
+					fmt.Sprintf("    Description:  %s", m.Description),
+				)
 			}
-			var output []string
-			output = []string{
-				"",
-				"Host Catalog information:",
+			if c.FlagRecursive {
+				output = append(output,
+					fmt.Sprintf("    Scope ID:     %s", m.Scope.Id),
+				)
 			}
-			for i, m := range listedCatalogs {
-				if i > 0 {
-					output = append(output, "")
-				}
-				if true {
-					output = append(output,
-						fmt.Sprintf("  ID:             %s", m.Id),
-						fmt.Sprintf("    Version:      %d", m.Version),
-						fmt.Sprintf("    Type:         %s", m.Type),
-					)
-				}
-				if m.Name != "" {
-					output = append(output,
-						fmt.Sprintf("    Name:         %s", m.Name),
-					)
-				}
-				if m.Description != "" {
-					output = append(output,
-						fmt.Sprintf("    Description:  %s", m.Description),
-					)
-				}
+			printer.Print(m, output)
+		}
+		if err := listIter.Err(); err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+				return 1
 			}
-			c.UI.Output(base.WrapForHelpText(output))
+			c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+			return 2
 		}
-		return 0
+		return printer.Finish()
 	}
 
 	catalog := result.GetItem().(*hostcatalogs.HostCatalog)
@@ -54,4 +54,7 @@ func generateHostTableOutput(in *hosts.Host) string {
 	return base.WrapForHelpText(ret)
 }
 
-var keySubstMap = map[string]string{}
+var keySubstMap = map[string]string{
+	"address":     "Address",
+	"external_id": "External ID",
+}
@@ -30,7 +30,7 @@ func (c *Command) Synopsis() string {
 var flagsMap = map[string][]string{
 	"read":   {"id"},
 	"delete": {"id"},
-	"list":   {"host-catalog-id"},
+	"list":   {"host-catalog-id", "filter", "page-size"},
 }
 
 func (c *Command) Help() string {
@@ -149,7 +149,14 @@ func (c *Command) Run(args []string) int {
 
 	existed := true
 	var result api.GenericResult
-	var listResult api.GenericListResult
+	var listIter *hosts.HostListIterator
+
+	if c.FlagFilter != "" {
+		opts = append(opts, hosts.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, hosts.WithPageSize(uint32(c.FlagPageSize)))
+	}
 
 	switch c.Func {
 	case "read":
@@ -161,7 +168,7 @@ func (c *Command) Run(args []string) int {
 			err = nil
 		}
 	case "list":
-		listResult, err = hostClient.List(c.Context, c.FlagHostCatalogId, opts...)
+		listIter, err = hostClient.ListIterator(c.Context, c.FlagHostCatalogId, opts...)
 	}
 
 	plural := "host"
@@ -195,55 +202,38 @@ func (c *Command) Run(args []string) int {
 		return 0
 
 	case "list":
-		listedHosts := listResult.GetItems().([]*hosts.Host)
-		switch base.Format(c.UI) {
-		case "json":
-			if len(listedHosts) == 0 {
-				c.UI.Output("null")
-				return 0
-			}
-			b, err := base.JsonFormatter{}.Format(listedHosts)
-			if err != nil {
-				c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
-				return 1
+		printer := base.NewListPrinter(c.UI, "Host information:", "No hosts found")
+		for listIter.Next() {
+			m := listIter.Item()
+			var output []string
+			if true {
+				output = append(output,
+					fmt.Sprintf("  ID:             %s", m.Id),
+					fmt.Sprintf("    Version:      %d", m.Version),
+					fmt.Sprintf("    Type:         %s", m.Type),
+				)
 			}
-			c.UI.Output(string(b))
-
-		case "table":
-			if len(listedHosts) == 0 {
-				c.UI.Output("No hosts found")
-				return 0
+			if m.Name != "" {
+				output = append(output,
+					fmt.Sprintf("    Name:         %s", m.Name),
+				)
 			}
-			var output []string
-			output = []string{
-				"",
-				"Host information:",
+			if m.Description != "" {
+				output = append(output,
+					fmt.Sprintf("    Description:  %s", m.Description),
+				)
 			}
-			for i, m := range listedHosts {
-				if i > 0 {
-					output = append(output, "")
-				}
-				if true {
-					output = append(output,
-						fmt.Sprintf("  ID:             %s", m.Id),
-						fmt.Sprintf("    Version:      %d", m.Version),
-						fmt.Sprintf("    Type:         %s", m.Type),
-					)
-				}
-				if m.Name != "" {
-					output = append(output,
-						fmt.Sprintf("    Name:         %s", m.Name),
-					)
-				}
-				if m.Description != "" {
-					output = append(output,
-						fmt.Sprintf("    Description:  %s", m.Description),
-					)
-				}
+			printer.Print(m, output)
+		}
+		if err := listIter.Err(); err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+				return 1
 			}
-			c.UI.Output(base.WrapForHelpText(output))
+			c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+			return 2
 		}
-		return 0
+		return printer.Finish()
 	}
 
 	host := result.GetItem().(*hosts.Host)
@@ -0,0 +1,199 @@
+package hostsets
+
+import (
+	"fmt"
+	"net/textproto"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/hashicorp/boundary/sdk/strutil"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*DynamicCommand)(nil)
+var _ cli.CommandAutocomplete = (*DynamicCommand)(nil)
+
+type DynamicCommand struct {
+	*base.Command
+
+	Func string
+
+	flagFilter string
+}
+
+func (c *DynamicCommand) Synopsis() string {
+	return fmt.Sprintf("%s a dynamic-type host set", textproto.CanonicalMIMEHeaderKey(c.Func))
+}
+
+var dynamicFlagsMap = map[string][]string{
+	"create": {"host-catalog-id", "name", "description", "filter"},
+	"update": {"id", "name", "description", "version", "filter"},
+}
+
+func (c *DynamicCommand) Help() string {
+	var info string
+	switch c.Func {
+	case "create":
+		info = base.WrapForHelpText([]string{
+			"Usage: boundary host-sets create dynamic [options] [args]",
+			"",
+			"  Create a dynamic-type host set. The members of the set are the hosts in the dynamic host catalog that match the filter. Example:",
+			"",
+			`    $ boundary host-sets create dynamic -host-catalog-id hcdy_1234567890 -name web -filter '"web" in attributes.roles and attributes.env == "prod"'`,
+			"",
+			"",
+		})
+
+	case "update":
+		info = base.WrapForHelpText([]string{
+			"Usage: boundary host-sets update dynamic [options] [args]",
+			"",
+			"  Update a dynamic-type host set given its ID. Example:",
+			"",
+			`    $ boundary host-sets update dynamic -id hsdy_1234567890 -filter 'attributes.env == "staging"'`,
+			"",
+			"",
+		})
+	}
+	return info + c.Flags().Help()
+}
+
+func (c *DynamicCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	common.PopulateCommonFlags(c.Command, f, "dynamic-type host set", dynamicFlagsMap[c.Func])
+
+	f = set.NewFlagSet("Dynamic Host Set Options")
+
+	for _, name := range dynamicFlagsMap[c.Func] {
+		switch name {
+		case "filter":
+			f.StringVar(&base.StringVar{
+				Name:   "filter",
+				Target: &c.flagFilter,
+				Usage:  "The boolean expression selecting the hosts in the host catalog that are members of the set. It can reference the id, name, address, and attributes of a host.",
+			})
+		}
+	}
+
+	return set
+}
+
+func (c *DynamicCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *DynamicCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *DynamicCommand) Run(args []string) int {
+	if c.Func == "" {
+		return cli.RunResultHelp
+	}
+
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if strutil.StrListContains(dynamicFlagsMap[c.Func], "id") && c.FlagId == "" {
+		c.UI.Error("ID is required but not passed in via -id")
+		return 1
+	}
+	if strutil.StrListContains(dynamicFlagsMap[c.Func], "host-catalog-id") && c.FlagHostCatalogId == "" {
+		c.UI.Error("Host Catalog ID must be passed in via -host-catalog-id")
+		return 1
+	}
+	if c.Func == "create" && c.flagFilter == "" {
+		c.UI.Error("Filter must be passed in via -filter")
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating API client: %s", err.Error()))
+		return 2
+	}
+
+	var opts []hostsets.Option
+
+	switch c.FlagName {
+	case "":
+	case "null":
+		opts = append(opts, hostsets.DefaultName())
+	default:
+		opts = append(opts, hostsets.WithName(c.FlagName))
+	}
+
+	switch c.FlagDescription {
+	case "":
+	case "null":
+		opts = append(opts, hostsets.DefaultDescription())
+	default:
+		opts = append(opts, hostsets.WithDescription(c.FlagDescription))
+	}
+
+	switch c.flagFilter {
+	case "":
+	case "null":
+		opts = append(opts, hostsets.DefaultDynamicHostSetFilter())
+	default:
+		opts = append(opts, hostsets.WithDynamicHostSetFilter(c.flagFilter))
+	}
+
+	hostsetClient := hostsets.NewClient(client)
+
+	// Perform check-and-set when needed
+	var version uint32
+	switch c.Func {
+	case "create":
+		// These don't update so don't need the existing version
+	default:
+		switch c.FlagVersion {
+		case 0:
+			opts = append(opts, hostsets.WithAutomaticVersioning(true))
+		default:
+			version = uint32(c.FlagVersion)
+		}
+	}
+
+	var result api.GenericResult
+
+	switch c.Func {
+	case "create":
+		result, err = hostsetClient.Create(c.Context, c.FlagHostCatalogId, opts...)
+	case "update":
+		result, err = hostsetClient.Update(c.Context, c.FlagId, version, opts...)
+	}
+
+	plural := "dynamic-type host-set"
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+		return 2
+	}
+
+	set := result.GetItem().(*hostsets.HostSet)
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(generateHostSetTableOutput(set))
+	case "json":
+		b, err := base.JsonFormatter{}.Format(set)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(b))
+	}
+
+	return 0
+}
@@ -41,7 +41,7 @@ func (c *Command) Synopsis() string {
 var flagsMap = map[string][]string{
 	"read":         {"id"},
 	"delete":       {"id"},
-	"list":         {"host-catalog-id"},
+	"list":         {"host-catalog-id", "filter", "page-size"},
 	"add-hosts":    {"id", "host", "version"},
 	"set-hosts":    {"id", "host", "version"},
 	"remove-hosts": {"id", "host", "version"},
@@ -73,6 +73,10 @@ func (c *Command) Help() string {
 			"",
 			`      $ boundary host-sets create static -name prodops -description "For ProdOps usage"`,
 			"",
+			"    Create a dynamic-type host set:",
+			"",
+			`      $ boundary host-sets create dynamic -host-catalog-id hcdy_1234567890 -name web -filter 'attributes.role == "web"'`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "update":
@@ -85,6 +89,10 @@ func (c *Command) Help() string {
 			"",
 			`      $ boundary host-sets update static -id hsst_1234567890 -name devops -description "For DevOps usage"`,
 			"",
+			"    Update a dynamic-type host set:",
+			"",
+			`      $ boundary host-sets update dynamic -id hsdy_1234567890 -filter 'attributes.role == "db"'`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "add-hosts":
@@ -238,7 +246,14 @@ func (c *Command) Run(args []string) int {
 
 	existed := true
 	var result api.GenericResult
-	var listResult api.GenericListResult
+	var listIter *hostsets.HostSetListIterator
+
+	if c.FlagFilter != "" {
+		opts = append(opts, hostsets.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, hostsets.WithPageSize(uint32(c.FlagPageSize)))
+	}
 
 	switch c.Func {
 	case "read":
@@ -250,7 +265,7 @@ func (c *Command) Run(args []string) int {
 			err = nil
 		}
 	case "list":
-		listResult, err = hostsetClient.List(c.Context, c.FlagHostCatalogId, opts...)
+		listIter, err = hostsetClient.ListIterator(c.Context, c.FlagHostCatalogId, opts...)
 	case "add-hosts":
 		result, err = hostsetClient.AddHosts(c.Context, c.FlagId, version, hosts, opts...)
 	case "remove-hosts":
@@ -290,55 +305,38 @@ func (c *Command) Run(args []string) int {
 		return 0
 
 	case "list":
-		listedSets := listResult.GetItems().([]*hostsets.HostSet)
-		switch base.Format(c.UI) {
-		case "json":
-			if len(listedSets) == 0 {
-				c.UI.Output("null")
-				return 0
+		printer := base.NewListPrinter(c.UI, "Host Set information:", "No host sets found")
+		for listIter.Next() {
+			m := listIter.Item()
+			var output []string
+			if true {
+				output = append(output,
+					fmt.Sprintf("  ID:             %s", m.Id),
+					fmt.Sprintf("    Version:      %d", m.Version),
+					fmt.Sprintf("    Type:         %s", m.Type),
+				)
 			}
-			b, err := base.JsonFormatter{}.Format(listedSets)
-			if err != nil {
-				c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
-				return 1
+			if m.Name != "" {
+				output = append(output,
+					fmt.Sprintf("    Name:         %s", m.Name),
+				)
 			}
-			c.UI.Output(string(b))
-
-		case "table":
-			if len(listedSets) == 0 {
-				c.UI.Output("No host sets found")
-				return 0
+			if m.Description != "" {
+				output = append(output,
+					fmt.Sprintf("    Description:  %s", m.Description),
+				)
 			}
-			var output []string
-			output = []string{
-				"",
-				"Host Set information:",
-			}
-			for i, m := range listedSets {
-				if i > 0 {
-					output = append(output, "")
-				}
-				if true {
-					output = append(output,
-						fmt.Sprintf("  ID:             %s", m.Id),
-						fmt.Sprintf("    Version:      %d", m.Version),
-						fmt.Sprintf("    Type:         %s", m.Type),
-					)
-				}
-				if m.Name != "" {
-					output = append(output,
-						fmt.Sprintf("    Name:         %s", m.Name),
-					)
-				}
-				if m.Description != "" {
-					output = append(output,
-						fmt.Sprintf("    Description:  %s", m.Description),
-					)
-				}
+			printer.Print(m, output)
+		}
+		if err := listIter.Err(); err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+				return 1
 			}
-			c.UI.Output(base.WrapForHelpText(output))
+			c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+			return 2
 		}
-		return 0
+		return printer.Finish()
 	}
 
 	set := result.GetItem().(*hostsets.HostSet)
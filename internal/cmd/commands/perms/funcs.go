@@ -0,0 +1,65 @@
+package perms
+
+import (
+	"github.com/hashicorp/boundary/api/users"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func generatePermissionCheckTableOutput(in *users.PermissionCheck) string {
+	nonAttributeMap := map[string]interface{}{
+		"User ID":       in.UserId,
+		"Resource Type": in.ResourceType,
+		"Scope ID":      in.ScopeId,
+		"Action":        in.Action,
+		"Allowed":       in.Allowed,
+		"Reason":        in.Reason,
+	}
+	if in.ResourceId != "" {
+		nonAttributeMap["Resource ID"] = in.ResourceId
+	}
+	if in.Pin != "" {
+		nonAttributeMap["Pinned To"] = in.Pin
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+	if l := len("Grant Scope ID"); l > maxLength {
+		maxLength = l
+	}
+
+	ret := []string{
+		"",
+		"Permission check:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if in.Grant != nil {
+		ret = append(ret,
+			"",
+			"  Deciding Grant:",
+			base.WrapMap(4, maxLength, grantMap(in.Grant)),
+		)
+	}
+
+	if len(in.ScopeGrants) > 0 {
+		ret = append(ret,
+			"",
+			"  Grants In Scope:",
+		)
+		for i, g := range in.ScopeGrants {
+			if i > 0 {
+				ret = append(ret, "")
+			}
+			ret = append(ret, base.WrapMap(4, maxLength, grantMap(g)))
+		}
+	}
+
+	return base.WrapForHelpText(ret)
+}
+
+func grantMap(in *users.PermissionGrant) map[string]interface{} {
+	return map[string]interface{}{
+		"Role ID":        in.RoleId,
+		"Grant Scope ID": in.GrantScopeId,
+		"Grant":          in.Canonical,
+	}
+}
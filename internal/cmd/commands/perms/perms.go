@@ -0,0 +1,190 @@
+package perms
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/users"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*Command)(nil)
+var _ cli.CommandAutocomplete = (*Command)(nil)
+
+type Command struct {
+	*base.Command
+
+	Func string
+
+	flagUserId       string
+	flagResourceId   string
+	flagType         string
+	flagAction       string
+	flagClientIp     string
+	flagAuthMethodId string
+}
+
+func (c *Command) Synopsis() string {
+	switch c.Func {
+	case "check":
+		return "Check whether a user is allowed to perform an action"
+	default:
+		return "Inspect the effective permissions of users"
+	}
+}
+
+func (c *Command) Help() string {
+	switch c.Func {
+	case "check":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary perms check [options]",
+			"",
+			"  Evaluate the grants of a user for an action on a resource, and show whether the action is allowed and which grant allowed or denied it. Example:",
+			"",
+			`    $ boundary perms check -user-id u_1234567890 -resource-id ttcp_1234567890 -action authorize-session`,
+			"",
+			"  Actions on a collection, such as list and create, are checked by passing the scope and the resource type instead:",
+			"",
+			`    $ boundary perms check -user-id u_1234567890 -scope-id p_1234567890 -type target -action list`,
+			"",
+			"",
+		}) + c.Flags().Help()
+	default:
+		return base.WrapForHelpText([]string{
+			"Usage: boundary perms [sub command] [options] [args]",
+			"",
+			"  This command allows inspecting the effective permissions of users.",
+			"",
+			"    Check whether a user can connect to a target:",
+			"",
+			`      $ boundary perms check -user-id u_1234567890 -resource-id ttcp_1234567890 -action authorize-session`,
+			"",
+			"  Please see the perms subcommand help for detailed usage information.",
+		})
+	}
+}
+
+func (c *Command) Flags() *base.FlagSets {
+	if c.Func == "" {
+		return c.FlagSet(base.FlagSetNone)
+	}
+
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "user-id",
+		Target: &c.flagUserId,
+		Usage:  "The ID of the user whose permissions are checked.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "resource-id",
+		Target: &c.flagResourceId,
+		Usage:  "The ID of the resource the action is performed on.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "scope-id",
+		Target: &c.FlagScopeId,
+		Usage:  "The ID of the scope containing the collection the action is performed on. Requires -type.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "type",
+		Target: &c.flagType,
+		Usage:  "The type of the collection the action is performed on, such as \"target\". If set along with -resource-id, the collection is the one under that resource, such as the hosts of a host catalog.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "action",
+		Target: &c.flagAction,
+		Usage:  "The action to check, such as \"read\" or \"authorize-session\".",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "client-ip",
+		Target: &c.flagClientIp,
+		Usage:  "The client IP address checked against the cidrs conditions of grants.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "auth-method-id",
+		Target: &c.flagAuthMethodId,
+		Usage:  "The auth method ID checked against the auth_method_ids conditions of grants.",
+	})
+
+	return set
+}
+
+func (c *Command) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *Command) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *Command) Run(args []string) int {
+	if c.Func == "" {
+		return cli.RunResultHelp
+	}
+
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	switch {
+	case c.flagUserId == "":
+		c.UI.Error("User ID must be passed in via -user-id")
+		return 1
+	case c.flagAction == "":
+		c.UI.Error("Action must be passed in via -action")
+		return 1
+	case c.flagResourceId == "" && c.FlagScopeId == "":
+		c.UI.Error("One of -resource-id or -scope-id must be provided")
+		return 1
+	case c.flagResourceId != "" && c.FlagScopeId != "":
+		c.UI.Error("Only one of -resource-id or -scope-id may be provided")
+		return 1
+	case c.FlagScopeId != "" && c.flagType == "":
+		c.UI.Error("Type must be passed in via -type when using -scope-id")
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating API client: %s", err.Error()))
+		return 2
+	}
+
+	result, err := users.NewClient(client).CheckPermission(c.Context, c.flagUserId, users.PermissionCheckRequest{
+		ResourceId:   c.flagResourceId,
+		ScopeId:      c.FlagScopeId,
+		Type:         c.flagType,
+		Action:       c.flagAction,
+		ClientIp:     c.flagClientIp,
+		AuthMethodId: c.flagAuthMethodId,
+	})
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing permission check: %s", base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to check permission: %s", err.Error()))
+		return 2
+	}
+
+	check := result.GetItem().(*users.PermissionCheck)
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(generatePermissionCheckTableOutput(check))
+	case "json":
+		b, err := base.JsonFormatter{}.Format(check)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(b))
+	}
+
+	return 0
+}
@@ -0,0 +1,175 @@
+package recording
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/recording"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*Command)(nil)
+var _ cli.CommandAutocomplete = (*Command)(nil)
+
+type Command struct {
+	*base.Command
+
+	Func string
+
+	flagFile      string
+	flagSpeed     float64
+	flagMaxIdle   time.Duration
+	flagShowInput bool
+}
+
+func (c *Command) Synopsis() string {
+	switch c.Func {
+	case "play":
+		return "Replay a downloaded session recording"
+	default:
+		return "Work with session recordings"
+	}
+}
+
+func (c *Command) Help() string {
+	switch c.Func {
+	case "play":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary recording play [options]",
+			"",
+			"  Replay a recording downloaded with \"boundary sessions download-recording\",",
+			"  writing the data sent by the endpoint to stdout with its original timing. Example:",
+			"",
+			`    $ boundary recording play -file s_1234567890_sc_1234567890.rec`,
+			"",
+			"",
+		}) + c.Flags().Help()
+	default:
+		return base.WrapForHelpText([]string{
+			"Usage: boundary recording [sub command] [options] [args]",
+			"",
+			"  This command allows working with session recordings offline.",
+			"",
+			"    Replay a recording:",
+			"",
+			`      $ boundary recording play -file s_1234567890_sc_1234567890.rec`,
+			"",
+			"  Please see the recording subcommand help for detailed usage information.",
+		})
+	}
+}
+
+func (c *Command) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetNone)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:       "file",
+		Target:     &c.flagFile,
+		Completion: complete.PredictFiles("*"),
+		Usage:      "The recording file to replay.",
+	})
+	f.Float64Var(&base.Float64Var{
+		Name:    "speed",
+		Target:  &c.flagSpeed,
+		Default: 1,
+		Usage:   "The playback speed multiplier. A value of 2 replays twice as fast.",
+	})
+	f.DurationVar(&base.DurationVar{
+		Name:   "max-idle",
+		Target: &c.flagMaxIdle,
+		Usage:  "If set, idle periods in the recording longer than this are shortened to this duration.",
+	})
+	f.BoolVar(&base.BoolVar{
+		Name:   "show-input",
+		Target: &c.flagShowInput,
+		Usage:  "Also write the data sent by the client to stdout. By default only data sent by the endpoint is replayed.",
+	})
+
+	return set
+}
+
+func (c *Command) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *Command) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *Command) Run(args []string) int {
+	if c.Func == "" {
+		return cli.RunResultHelp
+	}
+
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	if c.flagFile == "" {
+		c.UI.Error("A recording file must be passed in via -file")
+		return 1
+	}
+	if c.flagSpeed <= 0 {
+		c.UI.Error("Speed must be greater than zero")
+		return 1
+	}
+
+	file, err := os.Open(c.flagFile)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error opening recording: %s", err))
+		return 1
+	}
+	defer file.Close()
+
+	r, err := recording.NewReader(file)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error reading recording: %s", err))
+		return 1
+	}
+	hdr := r.Header()
+	c.UI.Info(fmt.Sprintf("Replaying connection %s of session %s to %s, recorded %s", hdr.ConnectionId, hdr.SessionId, hdr.Endpoint, hdr.StartTime.Local().Format(time.RFC1123)))
+
+	// elapsed is the playback time reached so far, which can drift from the
+	// recorded offsets when idle periods are shortened
+	var elapsed, lastOffset time.Duration
+	start := time.Now()
+	for {
+		frame, err := r.Next()
+		if err == io.EOF {
+			return 0
+		}
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error reading recording: %s", err))
+			return 1
+		}
+		if frame.Direction == recording.DirectionUp && !c.flagShowInput {
+			continue
+		}
+
+		gap := time.Duration(float64(frame.Offset-lastOffset) / c.flagSpeed)
+		if c.flagMaxIdle > 0 && gap > c.flagMaxIdle {
+			gap = c.flagMaxIdle
+		}
+		lastOffset = frame.Offset
+		elapsed += gap
+
+		timer := time.NewTimer(time.Until(start.Add(elapsed)))
+		select {
+		case <-c.Context.Done():
+			timer.Stop()
+			return 1
+		case <-timer.C:
+		}
+
+		if _, err := os.Stdout.Write(frame.Data); err != nil {
+			c.UI.Error(fmt.Sprintf("Error writing to stdout: %s", err))
+			return 1
+		}
+	}
+}
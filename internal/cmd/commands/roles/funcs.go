@@ -80,7 +80,7 @@ func populateFlags(c *Command, f *base.FlagSet, flagNames []string) {
 			f.StringSliceVar(&base.StringSliceVar{
 				Name:   "grant",
 				Target: &c.flagGrants,
-				Usage:  "The grants to add, remove, or set. May be specified multiple times. Can be in compact string format or JSON (be sure to escape JSON properly).",
+				Usage:  "The grants to add, remove, or set. May be specified multiple times. Can be in compact string format or JSON (be sure to escape JSON properly). Add deny=true to a grant to deny its actions instead, overriding any allow grants.",
 			})
 		}
 	}
@@ -56,7 +56,7 @@ var flagsMap = map[string][]string{
 	"update":            {"id", "name", "description", "grantscopeid", "version"},
 	"read":              {"id"},
 	"delete":            {"id"},
-	"list":              {"scope-id"},
+	"list":              {"scope-id", "filter", "page-size", "recursive"},
 	"add-principals":    {"id", "principal", "version"},
 	"set-principals":    {"id", "principal", "version"},
 	"remove-principals": {"id", "principal", "version"},
@@ -206,7 +206,17 @@ func (c *Command) Run(args []string) int {
 
 	existed := true
 	var result api.GenericResult
-	var listResult api.GenericListResult
+	var listIter *roles.RoleListIterator
+
+	if c.FlagFilter != "" {
+		opts = append(opts, roles.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, roles.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, roles.WithRecursive(true))
+	}
 
 	switch c.Func {
 	case "create":
@@ -222,7 +232,7 @@ func (c *Command) Run(args []string) int {
 			err = nil
 		}
 	case "list":
-		listResult, err = roleClient.List(c.Context, c.FlagScopeId, opts...)
+		listIter, err = roleClient.ListIterator(c.Context, c.FlagScopeId, opts...)
 	case "add-principals":
 		result, err = roleClient.AddPrincipals(c.Context, c.FlagId, version, principals, opts...)
 	case "set-principals":
@@ -268,54 +278,42 @@ func (c *Command) Run(args []string) int {
 		return 0
 
 	case "list":
-		listedRoles := listResult.GetItems().([]*roles.Role)
-		switch base.Format(c.UI) {
-		case "json":
-			if len(listedRoles) == 0 {
-				c.UI.Output("null")
-				return 0
+		printer := base.NewListPrinter(c.UI, "Role information:", "No roles found")
+		for listIter.Next() {
+			r := listIter.Item()
+			var output []string
+			if true {
+				output = append(output,
+					fmt.Sprintf("  ID:            %s", r.Id),
+					fmt.Sprintf("    Version:     %d", r.Version),
+				)
 			}
-			b, err := base.JsonFormatter{}.Format(listedRoles)
-			if err != nil {
-				c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
-				return 1
+			if r.Name != "" {
+				output = append(output,
+					fmt.Sprintf("    Name:        %s", r.Name),
+				)
 			}
-			c.UI.Output(string(b))
-
-		case "table":
-			if len(listedRoles) == 0 {
-				c.UI.Output("No roles found")
-				return 0
+			if r.Description != "" {
+				output = append(output,
+					fmt.Sprintf("    Description: %s", r.Description),
+				)
 			}
-			var output []string
-			output = []string{
-				"",
-				"Role information:",
+			if c.FlagRecursive {
+				output = append(output,
+					fmt.Sprintf("    Scope ID:    %s", r.Scope.Id),
+				)
 			}
-			for i, r := range listedRoles {
-				if i > 0 {
-					output = append(output, "")
-				}
-				if true {
-					output = append(output,
-						fmt.Sprintf("  ID:            %s", r.Id),
-						fmt.Sprintf("    Version:     %d", r.Version),
-					)
-				}
-				if r.Name != "" {
-					output = append(output,
-						fmt.Sprintf("    Name:        %s", r.Name),
-					)
-				}
-				if r.Description != "" {
-					output = append(output,
-						fmt.Sprintf("    Description: %s", r.Description),
-					)
-				}
+			printer.Print(r, output)
+		}
+		if err := listIter.Err(); err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+				return 1
 			}
-			c.UI.Output(base.WrapForHelpText(output))
+			c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+			return 2
 		}
-		return 0
+		return printer.Finish()
 	}
 
 	role := result.GetItem().(*roles.Role)
@@ -35,7 +35,7 @@ var flagsMap = map[string][]string{
 	"update": {"id", "name", "description", "version"},
 	"read":   {"id"},
 	"delete": {"id"},
-	"list":   {"scope-id"},
+	"list":   {"scope-id", "filter", "page-size", "recursive"},
 }
 
 func (c *Command) Help() string {
@@ -145,7 +145,17 @@ func (c *Command) Run(args []string) int {
 
 	existed := true
 	var result api.GenericResult
-	var listResult api.GenericListResult
+	var listIter *scopes.ScopeListIterator
+
+	if c.FlagFilter != "" {
+		opts = append(opts, scopes.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, scopes.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, scopes.WithRecursive(true))
+	}
 
 	switch c.Func {
 	case "create":
@@ -161,7 +171,7 @@ func (c *Command) Run(args []string) int {
 			err = nil
 		}
 	case "list":
-		listResult, err = scopeClient.List(c.Context, c.FlagScopeId, opts...)
+		listIter, err = scopeClient.ListIterator(c.Context, c.FlagScopeId, opts...)
 	}
 
 	plural := "scope"
@@ -195,54 +205,42 @@ func (c *Command) Run(args []string) int {
 		return 0
 
 	case "list":
-		listedScopes := listResult.GetItems().([]*scopes.Scope)
-		switch base.Format(c.UI) {
-		case "json":
-			if len(listedScopes) == 0 {
-				c.UI.Output("null")
-				return 0
+		printer := base.NewListPrinter(c.UI, "Scope information:", "No child scopes found")
+		for listIter.Next() {
+			s := listIter.Item()
+			var output []string
+			if true {
+				output = append(output,
+					fmt.Sprintf("  ID:             %s", s.Id),
+					fmt.Sprintf("    Version:      %d", s.Version),
+				)
 			}
-			b, err := base.JsonFormatter{}.Format(listedScopes)
-			if err != nil {
-				c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
-				return 1
+			if s.Name != "" {
+				output = append(output,
+					fmt.Sprintf("    Name:         %s", s.Name),
+				)
 			}
-			c.UI.Output(string(b))
-
-		case "table":
-			if len(listedScopes) == 0 {
-				c.UI.Output("No child scopes found")
-				return 0
+			if s.Description != "" {
+				output = append(output,
+					fmt.Sprintf("    Description:  %s", s.Description),
+				)
 			}
-			var output []string
-			output = []string{
-				"",
-				"Scope information:",
+			if c.FlagRecursive {
+				output = append(output,
+					fmt.Sprintf("    Scope ID:     %s", s.Scope.Id),
+				)
 			}
-			for i, s := range listedScopes {
-				if i > 0 {
-					output = append(output, "")
-				}
-				if true {
-					output = append(output,
-						fmt.Sprintf("  ID:             %s", s.Id),
-						fmt.Sprintf("    Version:      %d", s.Version),
-					)
-				}
-				if s.Name != "" {
-					output = append(output,
-						fmt.Sprintf("    Name:         %s", s.Name),
-					)
-				}
-				if s.Description != "" {
-					output = append(output,
-						fmt.Sprintf("    Description:  %s", s.Description),
-					)
-				}
+			printer.Print(s, output)
+		}
+		if err := listIter.Err(); err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+				return 1
 			}
-			c.UI.Output(base.WrapForHelpText(output))
+			c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+			return 2
 		}
-		return 0
+		return printer.Finish()
 	}
 
 	scope := result.GetItem().(*scopes.Scope)
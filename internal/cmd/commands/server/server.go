@@ -1,9 +1,11 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/cmd/base"
@@ -29,6 +31,11 @@ type Command struct {
 	SighupCh      chan struct{}
 	ReloadedCh    chan struct{}
 	SigUSR2Ch     chan struct{}
+	SigUSR1Ch     chan struct{}
+
+	// ForceShutdownCh receives the shutdown signals after the first, which
+	// stop waiting for the worker to drain
+	ForceShutdownCh chan struct{}
 
 	Config     *config.Config
 	controller *controller.Controller
@@ -193,6 +200,7 @@ func (c *Command) Run(args []string) int {
 				foundApi = true
 			case "proxy":
 				foundProxy = true
+			case "ops", "grpc":
 			default:
 				c.UI.Error(fmt.Sprintf("Unknown listener purpose %q", lnConfig.Purpose[0]))
 				return 1
@@ -233,10 +241,14 @@ func (c *Command) Run(args []string) int {
 			c.Config.Worker.Controllers = []string{clusterAddr}
 		}
 	}
-	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy"}); err != nil {
+	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy", "ops", "grpc"}); err != nil {
 		c.UI.Error(err.Error())
 		return 1
 	}
+	if err := c.StartOpsServers(); err != nil {
+		c.UI.Error(fmt.Errorf("Error starting ops servers: %w", err).Error())
+		return 1
+	}
 
 	if c.Config.Worker != nil {
 		if err := c.SetupWorkerPublicAddress(c.Config, ""); err != nil {
@@ -416,6 +428,9 @@ func (c *Command) WaitForInterrupt() int {
 			c.UI.Output("==> Boundary server shutdown triggered")
 
 			if c.Config.Worker != nil {
+				if timeout := c.Config.Worker.DrainTimeout; timeout > 0 {
+					c.drainWorker(timeout)
+				}
 				if err := c.worker.Shutdown(false); err != nil {
 					c.UI.Error(fmt.Errorf("Error shutting down worker: %w", err).Error())
 				}
@@ -478,6 +493,12 @@ func (c *Command) WaitForInterrupt() int {
 				c.UI.Error(fmt.Errorf("Error(s) were encountered during controller reload: %w", err).Error())
 			}
 
+		case <-c.SigUSR1Ch:
+			if c.Config.Worker != nil {
+				c.UI.Output("==> Boundary worker drain triggered")
+				c.worker.StartDrain()
+			}
+
 		case <-c.SigUSR2Ch:
 			buf := make([]byte, 32*1024*1024)
 			n := runtime.Stack(buf[:], true)
@@ -488,6 +509,31 @@ func (c *Command) WaitForInterrupt() int {
 	return 0
 }
 
+// drainWorker waits for up to timeout for the connections of the worker to
+// finish. The drain runs in its own goroutine so that a second shutdown
+// signal can cancel it rather than wait for the timeout.
+func (c *Command) drainWorker(timeout time.Duration) {
+	c.UI.Output(fmt.Sprintf("==> Draining worker connections for up to %s", timeout))
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- c.worker.Drain(ctx)
+	}()
+	var err error
+	select {
+	case err = <-errCh:
+	case <-c.ForceShutdownCh:
+		c.UI.Output("==> Second shutdown signal received, no longer draining worker connections")
+		cancel()
+		err = <-errCh
+	}
+	if err != nil {
+		c.UI.Warn(fmt.Sprintf("Worker did not finish draining: %v", err))
+	}
+}
+
 func (c *Command) Reload() error {
 	c.ReloadFuncsLock.RLock()
 	defer c.ReloadFuncsLock.RUnlock()
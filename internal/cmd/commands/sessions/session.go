@@ -1,7 +1,10 @@
 package sessions
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/hashicorp/boundary/api"
@@ -21,16 +24,22 @@ type Command struct {
 	*base.Command
 
 	Func string
+
+	flagOutputDir string
 }
 
 func (c *Command) Synopsis() string {
+	if c.Func == "download-recording" {
+		return "Download the recordings of a session's connections"
+	}
 	return common.SynopsisFunc(c.Func, "session")
 }
 
 var flagsMap = map[string][]string{
-	"read":   {"id"},
-	"cancel": {"id"},
-	"list":   {"scope-id"},
+	"read":               {"id"},
+	"cancel":             {"id"},
+	"list":               {"scope-id", "filter", "page-size", "recursive"},
+	"download-recording": {"id"},
 }
 
 func (c *Command) Help() string {
@@ -59,6 +68,19 @@ func (c *Command) Help() string {
 			"",
 			"",
 		})
+	case "download-recording":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions download-recording [options] [args]",
+			"",
+			"  Download the recordings of the connections of the session specified by ID.",
+			"  One file is written per connection, which can be replayed with",
+			"  \"boundary recording play\". Recordings which can't be downloaded or are",
+			"  incomplete are reported, and the others are still written. Example:",
+			"",
+			`    $ boundary sessions download-recording -id s_1234567890 -output-dir ./recordings`,
+			"",
+			"",
+		})
 	default:
 		helpStr = helpMap[c.Func]()
 	}
@@ -70,6 +92,16 @@ func (c *Command) Flags() *base.FlagSets {
 	f := set.NewFlagSet("Command Options")
 	common.PopulateCommonFlags(c.Command, f, resource.Session.String(), flagsMap[c.Func])
 
+	if c.Func == "download-recording" {
+		f.StringVar(&base.StringVar{
+			Name:       "output-dir",
+			Target:     &c.flagOutputDir,
+			Default:    ".",
+			Completion: complete.PredictDirs("*"),
+			Usage:      "The directory in which to write the recordings.",
+		})
+	}
+
 	return set
 }
 
@@ -110,8 +142,23 @@ func (c *Command) Run(args []string) int {
 
 	sessionClient := sessions.NewClient(client)
 
+	if c.Func == "download-recording" {
+		return c.downloadRecording(sessionClient)
+	}
+
 	var result api.GenericResult
-	var listResult api.GenericListResult
+	var listIter *sessions.SessionListIterator
+
+	var opts []sessions.Option
+	if c.FlagFilter != "" {
+		opts = append(opts, sessions.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, sessions.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, sessions.WithRecursive(true))
+	}
 
 	switch c.Func {
 	case "read":
@@ -119,7 +166,7 @@ func (c *Command) Run(args []string) int {
 	case "cancel":
 		result, err = sessionClient.Cancel(c.Context, c.FlagId, 0, sessions.WithAutomaticVersioning(true))
 	case "list":
-		listResult, err = sessionClient.List(c.Context, c.FlagScopeId)
+		listIter, err = sessionClient.ListIterator(c.Context, c.FlagScopeId, opts...)
 	}
 
 	plural := "session"
@@ -137,47 +184,35 @@ func (c *Command) Run(args []string) int {
 
 	switch c.Func {
 	case "list":
-		listedSessions := listResult.GetItems().([]*sessions.Session)
-		switch base.Format(c.UI) {
-		case "json":
-			if len(listedSessions) == 0 {
-				c.UI.Output("null")
-				return 0
-			}
-			b, err := base.JsonFormatter{}.Format(listedSessions)
-			if err != nil {
-				c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
-				return 1
-			}
-			c.UI.Output(string(b))
-
-		case "table":
-			if len(listedSessions) == 0 {
-				c.UI.Output("No sessions found")
-				return 0
-			}
+		printer := base.NewListPrinter(c.UI, "Session information:", "No sessions found")
+		for listIter.Next() {
+			t := listIter.Item()
 			var output []string
-			output = []string{
-				"",
-				"Session information:",
-			}
-			for i, t := range listedSessions {
-				if i > 0 {
-					output = append(output, "")
-				}
+			output = append(output,
+				fmt.Sprintf("  ID:                 %s", t.Id),
+				fmt.Sprintf("    Status:           %s", t.Status),
+				fmt.Sprintf("    Created Time:     %s", t.CreatedTime.Local().Format(time.RFC1123)),
+				fmt.Sprintf("    Expiration Time:  %s", t.ExpirationTime.Local().Format(time.RFC1123)),
+				fmt.Sprintf("    Updated Time:     %s", t.UpdatedTime.Local().Format(time.RFC1123)),
+				fmt.Sprintf("    User ID:          %s", t.UserId),
+				fmt.Sprintf("    Target ID:        %s", t.TargetId),
+			)
+			if c.FlagRecursive {
 				output = append(output,
-					fmt.Sprintf("  ID:                 %s", t.Id),
-					fmt.Sprintf("    Status:           %s", t.Status),
-					fmt.Sprintf("    Created Time:     %s", t.CreatedTime.Local().Format(time.RFC1123)),
-					fmt.Sprintf("    Expiration Time:  %s", t.ExpirationTime.Local().Format(time.RFC1123)),
-					fmt.Sprintf("    Updated Time:     %s", t.UpdatedTime.Local().Format(time.RFC1123)),
-					fmt.Sprintf("    User ID:          %s", t.UserId),
-					fmt.Sprintf("    Target ID:        %s", t.TargetId),
+					fmt.Sprintf("    Scope ID:         %s", t.Scope.Id),
 				)
 			}
-			c.UI.Output(base.WrapForHelpText(output))
+			printer.Print(t, output)
+		}
+		if err := listIter.Err(); err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+				return 1
+			}
+			c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+			return 2
 		}
-		return 0
+		return printer.Finish()
 	}
 
 	sess := result.GetItem().(*sessions.Session)
@@ -195,3 +230,92 @@ func (c *Command) Run(args []string) int {
 
 	return 0
 }
+
+func (c *Command) downloadRecording(sessionClient *sessions.Client) int {
+	result, err := sessionClient.ListRecordings(c.Context, c.FlagId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing %s on session: %s", c.Func, base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to %s session: %s", c.Func, err.Error()))
+		return 2
+	}
+
+	if err := os.MkdirAll(c.flagOutputDir, 0o700); err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating output directory: %s", err))
+		return 1
+	}
+	// Each recording is downloaded on its own so that one which can't be
+	// fetched or decrypted doesn't prevent writing the others
+	var failed bool
+	written := make([]string, 0, len(result.Items))
+	for _, item := range result.Items {
+		path := filepath.Join(c.flagOutputDir, fmt.Sprintf("%s_%s.rec", c.FlagId, item.ConnectionId))
+		if err := c.downloadRecordingTo(sessionClient, item.ConnectionId, path); err != nil {
+			if errors.Is(err, sessions.ErrIncompleteRecording) {
+				// What could be read of the recording is still written
+				c.UI.Error(fmt.Sprintf("Error downloading recording of connection %s: %s", item.ConnectionId, err))
+				failed = true
+				written = append(written, path)
+				continue
+			}
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				err = errors.New(base.PrintApiError(apiErr))
+			}
+			c.UI.Error(fmt.Sprintf("Error downloading recording of connection %s: %s", item.ConnectionId, err))
+			failed = true
+			continue
+		}
+		written = append(written, path)
+	}
+	ret := 0
+	if failed {
+		ret = 1
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		b, err := base.JsonFormatter{}.Format(written)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(b))
+
+	case "table":
+		if len(written) == 0 {
+			if len(result.Items) == 0 {
+				c.UI.Output("No recordings found")
+			}
+			return ret
+		}
+		output := []string{
+			"",
+			"Recordings written:",
+		}
+		for _, path := range written {
+			output = append(output, fmt.Sprintf("  %s", path))
+		}
+		c.UI.Output(base.WrapForHelpText(output))
+	}
+	return ret
+}
+
+// downloadRecordingTo writes the recording of the connection to a new file at
+// path as it is downloaded. The file is removed unless at least part of the
+// recording could be read.
+func (c *Command) downloadRecordingTo(sessionClient *sessions.Client, connectionId, path string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("error creating recording file: %w", err)
+	}
+	err = sessionClient.DownloadRecording(c.Context, c.FlagId, connectionId, f)
+	if cerr := f.Close(); cerr != nil && (err == nil || errors.Is(err, sessions.ErrIncompleteRecording)) {
+		err = fmt.Errorf("error writing recording file: %w", cerr)
+	}
+	if err != nil && !errors.Is(err, sessions.ErrIncompleteRecording) {
+		os.Remove(path)
+	}
+	return err
+}
@@ -24,6 +24,19 @@ func hostSetSynopsisFunc(inFunc string) string {
 	return wordwrap.WrapString(fmt.Sprintf("%s a target", in), base.TermWidth)
 }
 
+func credentialSynopsisFunc(inFunc string) string {
+	var in string
+	switch {
+	case strings.HasPrefix(inFunc, "add"):
+		in = "Add credentials to"
+	case strings.HasPrefix(inFunc, "set"):
+		in = "Set the full contents of the credentials on"
+	case strings.HasPrefix(inFunc, "remove"):
+		in = "Remove credentials from"
+	}
+	return wordwrap.WrapString(fmt.Sprintf("%s a target", in), base.TermWidth)
+}
+
 func generateTargetTableOutput(in *targets.Target) string {
 	nonAttributeMap := map[string]interface{}{
 		"ID":                       in.Id,
@@ -41,6 +54,9 @@ func generateTargetTableOutput(in *targets.Target) string {
 	if in.Description != "" {
 		nonAttributeMap["Description"] = in.Description
 	}
+	if in.WorkerFilter != "" {
+		nonAttributeMap["Worker Filter"] = in.WorkerFilter
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, in.Attributes, keySubstMap)
 
@@ -80,6 +96,14 @@ func generateTargetTableOutput(in *targets.Target) string {
 		}
 	}
 
+	if len(in.CredentialIds) > 0 {
+		ret = append(ret,
+			"  Credential IDs:",
+			base.WrapSlice(4, in.CredentialIds),
+			"",
+		)
+	}
+
 	if len(in.Attributes) > 0 {
 		ret = append(ret,
 			"  Attributes:",
@@ -118,11 +142,35 @@ func generateAuthorizationTableOutput(in *targets.SessionAuthorization) string {
 		base.WrapMap(2, maxLength+2, nonAttributeMap),
 	)
 
+	if in.CredentialInjection != "" {
+		ret = append(ret,
+			"",
+			fmt.Sprintf("  Credentials are injected by the worker using the %s protocol.", in.CredentialInjection),
+		)
+	}
+	for _, cred := range in.Credentials {
+		credMap := map[string]interface{}{
+			"Credential ID":       cred.CredentialId,
+			"Credential Store ID": cred.CredentialStoreId,
+			"Username":            cred.Username,
+		}
+		if cred.Password != "" {
+			credMap["Password"] = cred.Password
+		}
+		ret = append(ret,
+			"",
+			"  Credential:",
+			base.WrapMap(4, maxLength, credMap),
+		)
+	}
+
 	return base.WrapForHelpText(ret)
 }
 
 var keySubstMap = map[string]string{
-	"default_port": "Default Port",
+	"default_port":         "Default Port",
+	"record_sessions":      "Record Sessions",
+	"credential_injection": "Credential Injection",
 }
 
 func exampleOutput() string {
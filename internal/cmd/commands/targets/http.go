@@ -0,0 +1,373 @@
+package targets
+
+import (
+	"fmt"
+	"net/textproto"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/hashicorp/boundary/sdk/strutil"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*HttpCommand)(nil)
+var _ cli.CommandAutocomplete = (*HttpCommand)(nil)
+
+type HttpCommand struct {
+	*base.Command
+
+	Func                       string
+	flagDefaultPort            string
+	flagSessionMaxSeconds      string
+	flagSessionConnectionLimit string
+	flagWorkerFilter           string
+	flagMaxBytesPerSecond      string
+	flagIdleTimeoutSeconds     string
+	flagUseTls                 string
+	flagAllowedMethods         []string
+	flagAllowedPathPrefixes    []string
+	flagInjectedHeaders        []string
+}
+
+func (c *HttpCommand) Synopsis() string {
+	return fmt.Sprintf("%s an http-type target", textproto.CanonicalMIMEHeaderKey(c.Func))
+}
+
+var httpFlagsMap = map[string][]string{
+	"create": {"scope-id", "name", "description", "default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "session-max-bytes-per-second", "connection-idle-timeout-seconds", "use-tls", "allowed-methods", "allowed-path-prefixes", "injected-headers"},
+	"update": {"id", "name", "description", "version", "default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "session-max-bytes-per-second", "connection-idle-timeout-seconds", "use-tls", "allowed-methods", "allowed-path-prefixes", "injected-headers"},
+}
+
+func (c *HttpCommand) Help() string {
+	var info string
+	switch c.Func {
+	case "create":
+		info = base.WrapForHelpText([]string{
+			"Usage: boundary targets create http [options] [args]",
+			"",
+			"  Create an http-type target. Workers terminate the HTTP requests sent over its connections and forward only those allowed by its methods and path prefixes. Example:",
+			"",
+			`    $ boundary targets create http -name grafana -default-port 3000 -allowed-methods GET -allowed-path-prefixes /d -allowed-path-prefixes /api/dashboards`,
+			"",
+			"",
+		})
+
+	case "update":
+		info = base.WrapForHelpText([]string{
+			"Usage: boundary targets update http [options] [args]",
+			"",
+			"  Update an http-type target given its ID. Example:",
+			"",
+			`    $ boundary targets update http -id thttp_1234567890 -use-tls true -injected-headers "X-Forwarded-User: boundary"`,
+			"",
+			"",
+		})
+	}
+	return info + c.Flags().Help()
+}
+
+func (c *HttpCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	common.PopulateCommonFlags(c.Command, f, "http-type target", httpFlagsMap[c.Func])
+
+	for _, name := range httpFlagsMap[c.Func] {
+		switch name {
+		case "default-port":
+			f.StringVar(&base.StringVar{
+				Name:   "default-port",
+				Target: &c.flagDefaultPort,
+				Usage:  "The default port to set on the target.",
+			})
+		case "session-max-seconds":
+			f.StringVar(&base.StringVar{
+				Name:   "session-max-seconds",
+				Target: &c.flagSessionMaxSeconds,
+				Usage:  `The maximum lifetime of the session, including all connections. Can be specified as an integer number of seconds or a duration string.`,
+			})
+		case "session-connection-limit":
+			f.StringVar(&base.StringVar{
+				Name:   "session-connection-limit",
+				Target: &c.flagSessionConnectionLimit,
+				Usage:  "The maximum number of connections allowed for a session. -1 means unlimited.",
+			})
+		case "worker-filter":
+			f.StringVar(&base.StringVar{
+				Name:   "worker-filter",
+				Target: &c.flagWorkerFilter,
+				Usage:  `A boolean expression over worker tags that selects the workers which may handle sessions for the target, e.g. '"vpc-a" in tags.network'. If unset, any worker may be used.`,
+			})
+		case "session-max-bytes-per-second":
+			f.StringVar(&base.StringVar{
+				Name:   "session-max-bytes-per-second",
+				Target: &c.flagMaxBytesPerSecond,
+				Usage:  "The maximum number of bytes per second workers proxy in each direction, shared by all connections of a session. 0 means unlimited.",
+			})
+		case "connection-idle-timeout-seconds":
+			f.StringVar(&base.StringVar{
+				Name:   "connection-idle-timeout-seconds",
+				Target: &c.flagIdleTimeoutSeconds,
+				Usage:  "The time without data proxied in either direction after which workers close a connection. Can be specified as an integer number of seconds or a duration string. 0 means no timeout.",
+			})
+		case "use-tls":
+			f.StringVar(&base.StringVar{
+				Name:   "use-tls",
+				Target: &c.flagUseTls,
+				Usage:  "Whether workers connect to the endpoint with TLS, verifying its certificate against the system roots of the worker.",
+			})
+		case "allowed-methods":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "allowed-methods",
+				Target: &c.flagAllowedMethods,
+				Usage:  `An HTTP method workers forward requests for. Can be specified multiple times. If unset, all methods are allowed; "null" clears the list.`,
+			})
+		case "allowed-path-prefixes":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "allowed-path-prefixes",
+				Target: &c.flagAllowedPathPrefixes,
+				Usage:  `A path prefix workers forward requests for, matched on whole path segments. Can be specified multiple times. If unset, all paths are allowed; "null" clears the list.`,
+			})
+		case "injected-headers":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "injected-headers",
+				Target: &c.flagInjectedHeaders,
+				Usage:  `A header, in "Name: value" form, workers set on every forwarded request. Can be specified multiple times; "null" clears the list.`,
+			})
+		}
+	}
+
+	return set
+}
+
+func (c *HttpCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *HttpCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *HttpCommand) Run(args []string) int {
+	if c.Func == "" {
+		return cli.RunResultHelp
+	}
+
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if strutil.StrListContains(httpFlagsMap[c.Func], "id") && c.FlagId == "" {
+		c.UI.Error("ID is required but not passed in via -id")
+		return 1
+	}
+	if strutil.StrListContains(httpFlagsMap[c.Func], "scope-id") && c.FlagScopeId == "" {
+		c.UI.Error("Scope ID must be passed in via -scope-id")
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating API client: %s", err.Error()))
+		return 2
+	}
+
+	var opts []targets.Option
+
+	switch c.FlagName {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultName())
+	default:
+		opts = append(opts, targets.WithName(c.FlagName))
+	}
+
+	switch c.FlagDescription {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultDescription())
+	default:
+		opts = append(opts, targets.WithDescription(c.FlagDescription))
+	}
+
+	switch c.flagDefaultPort {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultHttpTargetDefaultPort())
+	default:
+		port, err := strconv.ParseUint(c.flagDefaultPort, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDefaultPort, err))
+			return 1
+		}
+		opts = append(opts, targets.WithHttpTargetDefaultPort(uint32(port)))
+	}
+
+	switch c.flagSessionMaxSeconds {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultSessionMaxSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagSessionMaxSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagSessionMaxSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxSeconds, err))
+				return 1
+			}
+			final = uint32(dur.Seconds())
+		}
+		opts = append(opts, targets.WithSessionMaxSeconds(final))
+	}
+
+	switch c.flagSessionConnectionLimit {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultSessionConnectionLimit())
+	default:
+		limit, err := strconv.ParseInt(c.flagSessionConnectionLimit, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionConnectionLimit, err))
+			return 1
+		}
+		opts = append(opts, targets.WithSessionConnectionLimit(int32(limit)))
+	}
+
+	switch c.flagWorkerFilter {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultWorkerFilter())
+	default:
+		opts = append(opts, targets.WithWorkerFilter(c.flagWorkerFilter))
+	}
+
+	switch c.flagMaxBytesPerSecond {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultHttpTargetSessionMaxBytesPerSecond())
+	default:
+		bytes, err := strconv.ParseUint(c.flagMaxBytesPerSecond, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMaxBytesPerSecond, err))
+			return 1
+		}
+		opts = append(opts, targets.WithHttpTargetSessionMaxBytesPerSecond(uint32(bytes)))
+	}
+
+	switch c.flagIdleTimeoutSeconds {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultHttpTargetConnectionIdleTimeoutSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagIdleTimeoutSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagIdleTimeoutSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagIdleTimeoutSeconds, err))
+				return 1
+			}
+			final = uint32(dur.Seconds())
+		}
+		opts = append(opts, targets.WithHttpTargetConnectionIdleTimeoutSeconds(final))
+	}
+
+	switch c.flagUseTls {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultHttpTargetUseTls())
+	default:
+		useTls, err := strconv.ParseBool(c.flagUseTls)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagUseTls, err))
+			return 1
+		}
+		opts = append(opts, targets.WithHttpTargetUseTls(useTls))
+	}
+
+	switch {
+	case len(c.flagAllowedMethods) == 0:
+	case len(c.flagAllowedMethods) == 1 && c.flagAllowedMethods[0] == "null":
+		opts = append(opts, targets.DefaultHttpTargetAllowedMethods())
+	default:
+		opts = append(opts, targets.WithHttpTargetAllowedMethods(c.flagAllowedMethods))
+	}
+
+	switch {
+	case len(c.flagAllowedPathPrefixes) == 0:
+	case len(c.flagAllowedPathPrefixes) == 1 && c.flagAllowedPathPrefixes[0] == "null":
+		opts = append(opts, targets.DefaultHttpTargetAllowedPathPrefixes())
+	default:
+		opts = append(opts, targets.WithHttpTargetAllowedPathPrefixes(c.flagAllowedPathPrefixes))
+	}
+
+	switch {
+	case len(c.flagInjectedHeaders) == 0:
+	case len(c.flagInjectedHeaders) == 1 && c.flagInjectedHeaders[0] == "null":
+		opts = append(opts, targets.DefaultHttpTargetInjectedHeaders())
+	default:
+		opts = append(opts, targets.WithHttpTargetInjectedHeaders(c.flagInjectedHeaders))
+	}
+
+	targetClient := targets.NewClient(client)
+
+	// Perform check-and-set when needed
+	var version uint32
+	switch c.Func {
+	case "create":
+		// These don't update so don't need the existing version
+	default:
+		switch c.FlagVersion {
+		case 0:
+			opts = append(opts, targets.WithAutomaticVersioning(true))
+		default:
+			version = uint32(c.FlagVersion)
+		}
+	}
+
+	var result api.GenericResult
+
+	switch c.Func {
+	case "create":
+		result, err = targetClient.Create(c.Context, "http", c.FlagScopeId, opts...)
+	case "update":
+		result, err = targetClient.Update(c.Context, c.FlagId, version, opts...)
+	}
+
+	plural := "http-type target"
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+		return 2
+	}
+
+	target := result.GetItem().(*targets.Target)
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(generateTargetTableOutput(target))
+	case "json":
+		b, err := base.JsonFormatter{}.Format(target)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(b))
+	}
+
+	return 0
+}
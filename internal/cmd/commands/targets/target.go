@@ -23,14 +23,17 @@ type Command struct {
 
 	Func string
 
-	flagHostSets []string
-	flagHostId   string
+	flagHostSets    []string
+	flagCredentials []string
+	flagHostId      string
 }
 
 func (c *Command) Synopsis() string {
 	switch c.Func {
 	case "add-host-sets", "set-host-sets", "remove-host-sets":
 		return hostSetSynopsisFunc(c.Func)
+	case "add-credentials", "set-credentials", "remove-credentials":
+		return credentialSynopsisFunc(c.Func)
 	case "authorize-session":
 		return "Request session authorization against the target"
 	default:
@@ -39,13 +42,16 @@ func (c *Command) Synopsis() string {
 }
 
 var flagsMap = map[string][]string{
-	"authorize-session": {"id", "host-id"},
-	"read":              {"id"},
-	"delete":            {"id"},
-	"list":              {"scope-id"},
-	"add-host-sets":     {"id", "host-set", "version"},
-	"remove-host-sets":  {"id", "host-set", "version"},
-	"set-host-sets":     {"id", "host-set", "version"},
+	"authorize-session":  {"id", "host-id"},
+	"read":               {"id"},
+	"delete":             {"id"},
+	"list":               {"scope-id", "filter", "page-size", "recursive"},
+	"add-host-sets":      {"id", "host-set", "version"},
+	"remove-host-sets":   {"id", "host-set", "version"},
+	"set-host-sets":      {"id", "host-set", "version"},
+	"add-credentials":    {"id", "credential", "version"},
+	"remove-credentials": {"id", "credential", "version"},
+	"set-credentials":    {"id", "credential", "version"},
 }
 
 func (c *Command) Help() string {
@@ -74,6 +80,14 @@ func (c *Command) Help() string {
 			"",
 			`      $ boundary targets create tcp -name prodops -description "For ProdOps usage"`,
 			"",
+			"    Create a udp-type target:",
+			"",
+			`      $ boundary targets create udp -name dns -description "For DNS lookups"`,
+			"",
+			"    Create an http-type target:",
+			"",
+			`      $ boundary targets create http -name grafana -description "For dashboards"`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "update":
@@ -86,6 +100,14 @@ func (c *Command) Help() string {
 			"",
 			`      $ boundary targets update tcp -id ttcp_1234567890 -name devops -description "For DevOps usage"`,
 			"",
+			"    Update a udp-type target:",
+			"",
+			`      $ boundary targets update udp -id tudp_1234567890 -name syslog -description "For syslog receivers"`,
+			"",
+			"    Update an http-type target:",
+			"",
+			`      $ boundary targets update http -id thttp_1234567890 -name kibana -description "For log searches"`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "add-host-sets":
@@ -124,6 +146,42 @@ func (c *Command) Help() string {
 			"",
 			"",
 		})
+	case "add-credentials":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary target add-credentials [options] [args]",
+			"",
+			"  This command allows adding credential resources to target resources. Example:",
+			"",
+			"    Add credential resources to a tcp-type target:",
+			"",
+			`      $ boundary targets add-credentials -id ttcp_1234567890 -credential cred_1234567890`,
+			"",
+			"",
+		})
+	case "remove-credentials":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary target remove-credentials [options] [args]",
+			"",
+			"  This command allows removing credential resources from target resources. Example:",
+			"",
+			"    Remove credential resources from a tcp-type target:",
+			"",
+			`      $ boundary targets remove-credentials -id ttcp_1234567890 -credential cred_1234567890`,
+			"",
+			"",
+		})
+	case "set-credentials":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary target set-credentials [options] [args]",
+			"",
+			"  This command allows setting the complete set of credential resources on a target resource. Example:",
+			"",
+			"    Set credential resources on a tcp-type target:",
+			"",
+			`      $ boundary targets set-credentials -id ttcp_1234567890 -credential cred_1234567890`,
+			"",
+			"",
+		})
 	case "authorize-session":
 		helpStr = base.WrapForHelpText([]string{
 			"Usage: boundary target authorize-session [options] [args]",
@@ -159,6 +217,12 @@ func (c *Command) Flags() *base.FlagSets {
 				Target: &c.flagHostSets,
 				Usage:  "The host-set resources to add, remove, or set. May be specified multiple times.",
 			})
+		case "credential":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "credential",
+				Target: &c.flagCredentials,
+				Usage:  "The credential resources to add, remove, or set. May be specified multiple times.",
+			})
 		case "host-id":
 			f.StringVar(&base.StringVar{
 				Name:   "host-id",
@@ -248,6 +312,26 @@ func (c *Command) Run(args []string) int {
 				hostSets = nil
 			}
 		}
+	}
+
+	credentials := c.flagCredentials
+	switch c.Func {
+	case "add-credentials", "remove-credentials":
+		if len(c.flagCredentials) == 0 {
+			c.UI.Error("No credentials supplied via -credential")
+			return 1
+		}
+
+	case "set-credentials":
+		switch len(c.flagCredentials) {
+		case 0:
+			c.UI.Error("No credentials supplied via -credential")
+			return 1
+		case 1:
+			if c.flagCredentials[0] == "null" {
+				credentials = nil
+			}
+		}
 	case "authorize-session":
 		if len(c.flagHostId) != 0 {
 			opts = append(opts, targets.WithHostId(c.flagHostId))
@@ -257,7 +341,8 @@ func (c *Command) Run(args []string) int {
 	// Perform check-and-set when needed
 	var version uint32
 	switch c.Func {
-	case "add-host-sets", "remove-host-sets", "set-host-sets":
+	case "add-host-sets", "remove-host-sets", "set-host-sets",
+		"add-credentials", "remove-credentials", "set-credentials":
 		switch c.FlagVersion {
 		case 0:
 			opts = append(opts, targets.WithAutomaticVersioning(true))
@@ -273,9 +358,19 @@ func (c *Command) Run(args []string) int {
 
 	existed := true
 	var result api.GenericResult
-	var listResult api.GenericListResult
+	var listIter *targets.TargetListIterator
 	var sar *targets.SessionAuthorizationResult
 
+	if c.FlagFilter != "" {
+		opts = append(opts, targets.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, targets.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, targets.WithRecursive(true))
+	}
+
 	switch c.Func {
 	case "read":
 		result, err = targetClient.Read(c.Context, c.FlagId, opts...)
@@ -286,13 +381,19 @@ func (c *Command) Run(args []string) int {
 			err = nil
 		}
 	case "list":
-		listResult, err = targetClient.List(c.Context, c.FlagScopeId, opts...)
+		listIter, err = targetClient.ListIterator(c.Context, c.FlagScopeId, opts...)
 	case "add-host-sets":
 		result, err = targetClient.AddHostSets(c.Context, c.FlagId, version, hostSets, opts...)
 	case "remove-host-sets":
 		result, err = targetClient.RemoveHostSets(c.Context, c.FlagId, version, hostSets, opts...)
 	case "set-host-sets":
 		result, err = targetClient.SetHostSets(c.Context, c.FlagId, version, hostSets, opts...)
+	case "add-credentials":
+		result, err = targetClient.AddCredentials(c.Context, c.FlagId, version, credentials, opts...)
+	case "remove-credentials":
+		result, err = targetClient.RemoveCredentials(c.Context, c.FlagId, version, credentials, opts...)
+	case "set-credentials":
+		result, err = targetClient.SetCredentials(c.Context, c.FlagId, version, credentials, opts...)
 	case "authorize-session":
 		sar, err = targetClient.AuthorizeSession(c.Context, c.FlagId, opts...)
 	}
@@ -331,55 +432,43 @@ func (c *Command) Run(args []string) int {
 		return 0
 
 	case "list":
-		listedTargets := listResult.GetItems().([]*targets.Target)
-		switch base.Format(c.UI) {
-		case "json":
-			if len(listedTargets) == 0 {
-				c.UI.Output("null")
-				return 0
+		printer := base.NewListPrinter(c.UI, "Target information:", "No targets found")
+		for listIter.Next() {
+			m := listIter.Item()
+			var output []string
+			if true {
+				output = append(output,
+					fmt.Sprintf("  ID:             %s", m.Id),
+					fmt.Sprintf("    Version:      %d", m.Version),
+					fmt.Sprintf("    Type:         %s", m.Type),
+				)
 			}
-			b, err := base.JsonFormatter{}.Format(listedTargets)
-			if err != nil {
-				c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
-				return 1
+			if m.Name != "" {
+				output = append(output,
+					fmt.Sprintf("    Name:         %s", m.Name),
+				)
 			}
-			c.UI.Output(string(b))
-
-		case "table":
-			if len(listedTargets) == 0 {
-				c.UI.Output("No targets found")
-				return 0
+			if m.Description != "" {
+				output = append(output,
+					fmt.Sprintf("    Description:  %s", m.Description),
+				)
 			}
-			var output []string
-			output = []string{
-				"",
-				"Target information:",
+			if c.FlagRecursive {
+				output = append(output,
+					fmt.Sprintf("    Scope ID:     %s", m.Scope.Id),
+				)
 			}
-			for i, m := range listedTargets {
-				if i > 0 {
-					output = append(output, "")
-				}
-				if true {
-					output = append(output,
-						fmt.Sprintf("  ID:             %s", m.Id),
-						fmt.Sprintf("    Version:      %d", m.Version),
-						fmt.Sprintf("    Type:         %s", m.Type),
-					)
-				}
-				if m.Name != "" {
-					output = append(output,
-						fmt.Sprintf("    Name:         %s", m.Name),
-					)
-				}
-				if m.Description != "" {
-					output = append(output,
-						fmt.Sprintf("    Description:  %s", m.Description),
-					)
-				}
+			printer.Print(m, output)
+		}
+		if err := listIter.Err(); err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+				return 1
 			}
-			c.UI.Output(base.WrapForHelpText(output))
+			c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+			return 2
 		}
-		return 0
+		return printer.Finish()
 
 	case "authorize-session":
 		sa := sar.GetItem().(*targets.SessionAuthorization)
@@ -25,6 +25,11 @@ type TcpCommand struct {
 	flagDefaultPort            string
 	flagSessionMaxSeconds      string
 	flagSessionConnectionLimit string
+	flagRecordSessions         string
+	flagCredentialInjection    string
+	flagWorkerFilter           string
+	flagMaxBytesPerSecond      string
+	flagIdleTimeoutSeconds     string
 }
 
 func (c *TcpCommand) Synopsis() string {
@@ -32,8 +37,8 @@ func (c *TcpCommand) Synopsis() string {
 }
 
 var tcpFlagsMap = map[string][]string{
-	"create": {"scope-id", "name", "description", "default-port", "session-max-seconds", "session-connection-limit"},
-	"update": {"id", "name", "description", "version", "default-port", "session-max-seconds", "session-connection-limit"},
+	"create": {"scope-id", "name", "description", "default-port", "session-max-seconds", "session-connection-limit", "record-sessions", "credential-injection", "worker-filter", "session-max-bytes-per-second", "connection-idle-timeout-seconds"},
+	"update": {"id", "name", "description", "version", "default-port", "session-max-seconds", "session-connection-limit", "record-sessions", "credential-injection", "worker-filter", "session-max-bytes-per-second", "connection-idle-timeout-seconds"},
 }
 
 func (c *TcpCommand) Help() string {
@@ -89,6 +94,37 @@ func (c *TcpCommand) Flags() *base.FlagSets {
 				Target: &c.flagSessionConnectionLimit,
 				Usage:  "The maximum number of connections allowed for a session. -1 means unlimited.",
 			})
+		case "record-sessions":
+			f.StringVar(&base.StringVar{
+				Name:   "record-sessions",
+				Target: &c.flagRecordSessions,
+				Usage:  "Whether workers record the data proxied over the connections of sessions for the target. Recordings can be retrieved with \"boundary sessions download-recording\".",
+			})
+		case "credential-injection":
+			f.StringVar(&base.StringVar{
+				Name:       "credential-injection",
+				Target:     &c.flagCredentialInjection,
+				Completion: complete.PredictSet("postgres"),
+				Usage:      `The protocol workers use to authenticate to the endpoint with the credentials of the target, which are then never returned to the user. The only supported value is "postgres". If unset, the credentials are returned when a session is authorized.`,
+			})
+		case "worker-filter":
+			f.StringVar(&base.StringVar{
+				Name:   "worker-filter",
+				Target: &c.flagWorkerFilter,
+				Usage:  `A boolean expression over worker tags that selects the workers which may handle sessions for the target, e.g. '"vpc-a" in tags.network'. If unset, any worker may be used.`,
+			})
+		case "session-max-bytes-per-second":
+			f.StringVar(&base.StringVar{
+				Name:   "session-max-bytes-per-second",
+				Target: &c.flagMaxBytesPerSecond,
+				Usage:  "The maximum number of bytes per second workers proxy in each direction, shared by all connections of a session. 0 means unlimited.",
+			})
+		case "connection-idle-timeout-seconds":
+			f.StringVar(&base.StringVar{
+				Name:   "connection-idle-timeout-seconds",
+				Target: &c.flagIdleTimeoutSeconds,
+				Usage:  "The time without data proxied in either direction after which workers close a connection. Can be specified as an integer number of seconds or a duration string. 0 means idle connections are never closed.",
+			})
 		}
 	}
 
@@ -194,6 +230,68 @@ func (c *TcpCommand) Run(args []string) int {
 		opts = append(opts, targets.WithSessionConnectionLimit(int32(limit)))
 	}
 
+	switch c.flagRecordSessions {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultTcpTargetRecordSessions())
+	default:
+		record, err := strconv.ParseBool(c.flagRecordSessions)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagRecordSessions, err))
+			return 1
+		}
+		opts = append(opts, targets.WithTcpTargetRecordSessions(record))
+	}
+
+	switch c.flagCredentialInjection {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultTcpTargetCredentialInjection())
+	default:
+		opts = append(opts, targets.WithTcpTargetCredentialInjection(c.flagCredentialInjection))
+	}
+
+	switch c.flagWorkerFilter {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultWorkerFilter())
+	default:
+		opts = append(opts, targets.WithWorkerFilter(c.flagWorkerFilter))
+	}
+
+	switch c.flagMaxBytesPerSecond {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultTcpTargetSessionMaxBytesPerSecond())
+	default:
+		bytes, err := strconv.ParseUint(c.flagMaxBytesPerSecond, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMaxBytesPerSecond, err))
+			return 1
+		}
+		opts = append(opts, targets.WithTcpTargetSessionMaxBytesPerSecond(uint32(bytes)))
+	}
+
+	switch c.flagIdleTimeoutSeconds {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultTcpTargetConnectionIdleTimeoutSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagIdleTimeoutSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagIdleTimeoutSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagIdleTimeoutSeconds, err))
+				return 1
+			}
+			final = uint32(dur.Seconds())
+		}
+		opts = append(opts, targets.WithTcpTargetConnectionIdleTimeoutSeconds(final))
+	}
+
 	targetClient := targets.NewClient(client)
 
 	// Perform check-and-set when needed
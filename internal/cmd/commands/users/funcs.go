@@ -55,6 +55,18 @@ func removeAccountsHelp() string {
 	})
 }
 
+func effectiveGrantsHelp() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary users effective-grants [options] [args]",
+		"",
+		"  List every grant held by a user given its ID, grouped by the scope the grants apply to. This includes grants held through groups the user is a member of and through the special u_anon and u_auth users, along with the principals each grant is held through. If an auth method is given, the account of the user in it fills in {{account.id}} templates. Example:",
+		"",
+		`    $ boundary users effective-grants -id u_1234567890`,
+		"",
+		"",
+	})
+}
+
 func populateFlags(c *Command, f *base.FlagSet, flagNames []string) {
 	common.PopulateCommonFlags(c.Command, f, resource.User.String(), flagNames)
 
@@ -125,3 +137,32 @@ func generateUserTableOutput(in *users.User) string {
 
 	return base.WrapForHelpText(ret)
 }
+
+func generateEffectiveGrantsTableOutput(in *users.EffectiveGrants) string {
+	if len(in.Scopes) == 0 {
+		return fmt.Sprintf("No grants found for user %s", in.UserId)
+	}
+
+	ret := []string{
+		"",
+		fmt.Sprintf("Effective grants of user %s:", in.UserId),
+	}
+	for _, scp := range in.Scopes {
+		ret = append(ret,
+			"",
+			fmt.Sprintf("  Scope %s:", scp.ScopeId),
+		)
+		for i, g := range scp.Grants {
+			if i > 0 {
+				ret = append(ret, "")
+			}
+			ret = append(ret,
+				fmt.Sprintf("    Grant:          %s", g.Canonical),
+				fmt.Sprintf("      Role ID:      %s", g.RoleId),
+				fmt.Sprintf("      Granted Via:  %s", strings.Join(g.PrincipalIds, ", ")),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(ret)
+}
@@ -28,6 +28,8 @@ func (c *Command) Synopsis() string {
 	switch c.Func {
 	case "add-accounts", "set-accounts", "remove-accounts":
 		return accountSynopsisFunc(c.Func)
+	case "effective-grants":
+		return "List every grant held by a user, grouped by scope"
 	default:
 		return common.SynopsisFunc(c.Func, "user")
 	}
@@ -38,18 +40,20 @@ var helpMap = func() map[string]func() string {
 	ret["add-accounts"] = addAccountsHelp
 	ret["set-accounts"] = setAccountsHelp
 	ret["remove-accounts"] = removeAccountsHelp
+	ret["effective-grants"] = effectiveGrantsHelp
 	return ret
 }
 
 var flagsMap = map[string][]string{
-	"create":          {"scope-id", "name", "description"},
-	"update":          {"id", "name", "description", "version"},
-	"read":            {"id"},
-	"delete":          {"id"},
-	"list":            {"scope-id"},
-	"add-accounts":    {"id", "account", "version"},
-	"set-accounts":    {"id", "account", "version"},
-	"remove-accounts": {"id", "account", "version"},
+	"create":           {"scope-id", "name", "description"},
+	"update":           {"id", "name", "description", "version"},
+	"read":             {"id"},
+	"delete":           {"id"},
+	"list":             {"scope-id", "filter", "page-size", "recursive"},
+	"add-accounts":     {"id", "account", "version"},
+	"set-accounts":     {"id", "account", "version"},
+	"remove-accounts":  {"id", "account", "version"},
+	"effective-grants": {"id", "auth-method-id"},
 }
 
 func (c *Command) Help() string {
@@ -146,7 +150,7 @@ func (c *Command) Run(args []string) int {
 	// Perform check-and-set when needed
 	var version uint32
 	switch c.Func {
-	case "create", "read", "delete", "list":
+	case "create", "read", "delete", "list", "effective-grants":
 		// These don't udpate so don't need the existing version
 	default:
 		switch c.FlagVersion {
@@ -159,7 +163,21 @@ func (c *Command) Run(args []string) int {
 
 	existed := true
 	var result api.GenericResult
-	var listResult api.GenericListResult
+	var grantsResult *users.EffectiveGrantsResult
+	var listIter *users.UserListIterator
+
+	if c.FlagFilter != "" {
+		opts = append(opts, users.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, users.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, users.WithRecursive(true))
+	}
+	if c.FlagAuthMethodId != "" {
+		opts = append(opts, users.WithAuthMethodId(c.FlagAuthMethodId))
+	}
 
 	switch c.Func {
 	case "create":
@@ -175,13 +193,15 @@ func (c *Command) Run(args []string) int {
 			err = nil
 		}
 	case "list":
-		listResult, err = userClient.List(c.Context, c.FlagScopeId, opts...)
+		listIter, err = userClient.ListIterator(c.Context, c.FlagScopeId, opts...)
 	case "add-accounts":
 		result, err = userClient.AddAccounts(c.Context, c.FlagId, version, accounts, opts...)
 	case "set-accounts":
 		result, err = userClient.SetAccounts(c.Context, c.FlagId, version, accounts, opts...)
 	case "remove-accounts":
 		result, err = userClient.RemoveAccounts(c.Context, c.FlagId, version, accounts, opts...)
+	case "effective-grants":
+		grantsResult, err = userClient.EffectiveGrants(c.Context, c.FlagId, opts...)
 	}
 
 	plural := "user"
@@ -214,55 +234,58 @@ func (c *Command) Run(args []string) int {
 		}
 		return 0
 
-	case "list":
-		listedUsers := listResult.GetItems().([]*users.User)
+	case "effective-grants":
+		grants := grantsResult.GetItem().(*users.EffectiveGrants)
 		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(generateEffectiveGrantsTableOutput(grants))
 		case "json":
-			if len(listedUsers) == 0 {
-				c.UI.Output("null")
-				return 0
-			}
-			b, err := base.JsonFormatter{}.Format(listedUsers)
+			b, err := base.JsonFormatter{}.Format(grants)
 			if err != nil {
 				c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
 				return 1
 			}
 			c.UI.Output(string(b))
+		}
+		return 0
 
-		case "table":
-			if len(listedUsers) == 0 {
-				c.UI.Output("No users found")
-				return 0
-			}
+	case "list":
+		printer := base.NewListPrinter(c.UI, "User information:", "No users found")
+		for listIter.Next() {
+			u := listIter.Item()
 			var output []string
-			output = []string{
-				"",
-				"User information:",
+			if true {
+				output = append(output,
+					fmt.Sprintf("  ID:             %s", u.Id),
+					fmt.Sprintf("    Version:      %d", u.Version),
+				)
+			}
+			if u.Name != "" {
+				output = append(output,
+					fmt.Sprintf("    Name:         %s", u.Name),
+				)
+			}
+			if u.Description != "" {
+				output = append(output,
+					fmt.Sprintf("    Description:  %s", u.Description),
+				)
 			}
-			for i, u := range listedUsers {
-				if i > 0 {
-					output = append(output, "")
-				}
-				if true {
-					output = append(output,
-						fmt.Sprintf("  ID:             %s", u.Id),
-						fmt.Sprintf("    Version:      %d", u.Version),
-					)
-				}
-				if u.Name != "" {
-					output = append(output,
-						fmt.Sprintf("    Name:         %s", u.Name),
-					)
-				}
-				if u.Description != "" {
-					output = append(output,
-						fmt.Sprintf("    Description:  %s", u.Description),
-					)
-				}
+			if c.FlagRecursive {
+				output = append(output,
+					fmt.Sprintf("    Scope ID:     %s", u.Scope.Id),
+				)
 			}
-			c.UI.Output(base.WrapForHelpText(output))
+			printer.Print(u, output)
 		}
-		return 0
+		if err := listIter.Err(); err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+				return 1
+			}
+			c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+			return 2
+		}
+		return printer.Finish()
 	}
 
 	user := result.GetItem().(*users.User)
@@ -50,6 +50,24 @@ func PopulateCommonFlags(c *base.Command, f *base.FlagSet, resourceType string,
 				Target: &c.FlagAuthMethodId,
 				Usage:  "The auth-method resource to use for the operation",
 			})
+		case "filter":
+			f.StringVar(&base.StringVar{
+				Name:   "filter",
+				Target: &c.FlagFilter,
+				Usage:  fmt.Sprintf(`If set, the list operation will be filtered before being returned. The filter operates against each %s item, selected with JSON pointers under "/item", e.g. '"/item/name" == "foo"'.`, resourceType),
+			})
+		case "page-size":
+			f.UintVar(&base.UintVar{
+				Name:   "page-size",
+				Target: &c.FlagPageSize,
+				Usage:  fmt.Sprintf("The number of %s items to request from the controller at a time. Items are output as their pages arrive. If not set, the controller's default page size is used.", resourceType),
+			})
+		case "recursive":
+			f.BoolVar(&base.BoolVar{
+				Name:   "recursive",
+				Target: &c.FlagRecursive,
+				Usage:  fmt.Sprintf("If set, the list operation will also include the %s items of the scopes below the given scope in which it is allowed.", resourceType),
+			})
 		case "host-catalog-id":
 			f.StringVar(&base.StringVar{
 				Name:   "host-catalog-id",
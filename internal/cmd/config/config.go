@@ -10,8 +10,11 @@ import (
 	"io/ioutil"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/boundary/sdk/parseutil"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
 	"github.com/hashicorp/hcl"
 	"github.com/hashicorp/shared-secure-libs/configutil"
@@ -98,6 +101,74 @@ type Controller struct {
 	Name        string    `hcl:"name"`
 	Description string    `hcl:"description"`
 	Database    *Database `hcl:"database"`
+
+	// StatusGracePeriod is how long a worker may go without reporting its
+	// status before it is considered dead. The connections of dead workers
+	// are closed, their sessions terminated and they are no longer offered
+	// to clients. It can be given as a duration string or a number of
+	// seconds.
+	StatusGracePeriod    time.Duration `hcl:"-"`
+	StatusGracePeriodRaw interface{}   `hcl:"status_grace_period"`
+
+	// Audit configures where the audit events of the controller are sent.
+	// No events are emitted if it isn't set.
+	Audit *Audit `hcl:"audit"`
+
+	// DynamicHostCatalogs configures the sources dynamic host catalogs can
+	// read their inventories from. No sources are allowed if it isn't set.
+	DynamicHostCatalogs *DynamicHostCatalogs `hcl:"dynamic_host_catalogs"`
+}
+
+// DynamicHostCatalogs is the configuration of the sources the inventories of
+// dynamic host catalogs can be read from
+type DynamicHostCatalogs struct {
+	// AllowedDirectories are the absolute paths of the directories, including
+	// their subdirectories, which file sources can be read from
+	AllowedDirectories []string `hcl:"allowed_directories"`
+
+	// AllowedHosts are the hosts, optionally with a port, which http and
+	// https sources can be fetched from. A host without a port matches any
+	// port.
+	AllowedHosts []string `hcl:"allowed_hosts"`
+}
+
+// Audit is the configuration of the audit events of a controller
+type Audit struct {
+	// HmacFields are the fields of events which are replaced by their
+	// HMAC-SHA256, keyed with a key derived from the database key of the
+	// global scope. Can be "auth_token_id", "client_ip" and "user_id".
+	HmacFields []string `hcl:"hmac_fields"`
+
+	// Sinks are the destinations events are written to. Every event is
+	// written to every sink.
+	Sinks []*AuditSink `hcl:"sink"`
+
+	// FailClosed refuses the API requests changing resources while a sink
+	// has fallen too far behind for their events to be written, rather than
+	// serving them and dropping their events
+	FailClosed bool `hcl:"fail_closed"`
+}
+
+// AuditSink is a destination of audit events. Type is "file", "syslog" or
+// "webhook", and determines which of the other fields are used.
+type AuditSink struct {
+	Type string `hcl:",key"`
+
+	// Path is the file events are appended to by a file sink
+	Path string `hcl:"path"`
+
+	// Facility and Tag are the syslog facility, "AUTH" by default, and tag,
+	// "boundary" by default, of the messages of a syslog sink
+	Facility string `hcl:"facility"`
+	Tag      string `hcl:"tag"`
+
+	// Url is the address events are posted to by a webhook sink, with
+	// Headers set on each request. Timeout bounds each request and can be
+	// given as a duration string or a number of seconds.
+	Url        string            `hcl:"url"`
+	Headers    map[string]string `hcl:"headers"`
+	Timeout    time.Duration     `hcl:"-"`
+	TimeoutRaw interface{}       `hcl:"timeout"`
 }
 
 type Worker struct {
@@ -105,6 +176,29 @@ type Worker struct {
 	Description string   `hcl:"description"`
 	Controllers []string `hcl:"controllers"`
 	PublicAddr  string   `hcl:"public_addr"`
+
+	// RecordingPath is the directory in which connection recordings are
+	// written before being uploaded to a controller
+	RecordingPath string `hcl:"recording_path"`
+
+	// Tags are key/value pairs describing the worker, such as the network
+	// it can reach, which target worker filters are evaluated against. A key
+	// can have multiple values.
+	Tags    map[string][]string `hcl:"-"`
+	TagsRaw interface{}         `hcl:"tags"`
+
+	// Upstreams are the proxy addresses of the workers this worker connects
+	// to as a downstream worker. Connections for this worker are forwarded
+	// to it over an outbound tunnel to the first upstream that can be
+	// reached, so it needs no inbound connectivity.
+	Upstreams []string `hcl:"upstreams"`
+
+	// DrainTimeout is how long a worker shutting down waits for its
+	// connections to finish, having stopped accepting new ones, before
+	// closing them. Workers shut down immediately if it isn't set. It can be
+	// given as a duration string or a number of seconds.
+	DrainTimeout    time.Duration `hcl:"-"`
+	DrainTimeoutRaw interface{}   `hcl:"drain_timeout"`
 }
 
 type Database struct {
@@ -210,6 +304,45 @@ func Parse(d string) (*Config, error) {
 		return nil, err
 	}
 
+	if result.Worker != nil && result.Worker.TagsRaw != nil {
+		result.Worker.Tags, err = parseTags(result.Worker.TagsRaw)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing worker tags: %w", err)
+		}
+	}
+
+	if result.Worker != nil && result.Worker.DrainTimeoutRaw != nil {
+		result.Worker.DrainTimeout, err = parseutil.ParseDurationSecond(result.Worker.DrainTimeoutRaw)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing worker drain timeout: %w", err)
+		}
+		if result.Worker.DrainTimeout < 0 {
+			return nil, errors.New("worker drain timeout must not be negative")
+		}
+	}
+
+	if result.Controller != nil && result.Controller.StatusGracePeriodRaw != nil {
+		result.Controller.StatusGracePeriod, err = parseutil.ParseDurationSecond(result.Controller.StatusGracePeriodRaw)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing controller status grace period: %w", err)
+		}
+		if result.Controller.StatusGracePeriod <= 0 {
+			return nil, errors.New("controller status grace period must be positive")
+		}
+	}
+
+	if result.Controller != nil && result.Controller.Audit != nil {
+		if err := parseAudit(result.Controller.Audit); err != nil {
+			return nil, fmt.Errorf("error parsing controller audit: %w", err)
+		}
+	}
+
+	if result.Controller != nil && result.Controller.DynamicHostCatalogs != nil {
+		if err := parseDynamicHostCatalogs(result.Controller.DynamicHostCatalogs); err != nil {
+			return nil, fmt.Errorf("error parsing controller dynamic host catalogs: %w", err)
+		}
+	}
+
 	sharedConfig, err := configutil.ParseConfig(d)
 	if err != nil {
 		return nil, err
@@ -219,6 +352,100 @@ func Parse(d string) (*Config, error) {
 	return result, nil
 }
 
+// parseAudit validates the audit configuration of a controller and parses
+// the timeouts of its webhook sinks
+func parseAudit(audit *Audit) error {
+	for _, f := range audit.HmacFields {
+		switch f {
+		case "auth_token_id", "client_ip", "user_id":
+		default:
+			return fmt.Errorf("unknown hmac field %q", f)
+		}
+	}
+	for _, sink := range audit.Sinks {
+		switch sink.Type {
+		case "file":
+			if sink.Path == "" {
+				return errors.New("file sink requires a path")
+			}
+		case "syslog":
+		case "webhook":
+			if sink.Url == "" {
+				return errors.New("webhook sink requires a url")
+			}
+			if sink.TimeoutRaw != nil {
+				var err error
+				if sink.Timeout, err = parseutil.ParseDurationSecond(sink.TimeoutRaw); err != nil {
+					return fmt.Errorf("error parsing webhook sink timeout: %w", err)
+				}
+				if sink.Timeout <= 0 {
+					return errors.New("webhook sink timeout must be positive")
+				}
+			}
+		default:
+			return fmt.Errorf("unknown sink type %q", sink.Type)
+		}
+	}
+	return nil
+}
+
+// parseDynamicHostCatalogs validates the allowed sources of dynamic host
+// catalogs and cleans the allowed directories
+func parseDynamicHostCatalogs(dhc *DynamicHostCatalogs) error {
+	for i, dir := range dhc.AllowedDirectories {
+		if !filepath.IsAbs(dir) {
+			return fmt.Errorf("allowed directory %q is not an absolute path", dir)
+		}
+		dhc.AllowedDirectories[i] = filepath.Clean(dir)
+	}
+	for _, host := range dhc.AllowedHosts {
+		if host == "" || strings.ContainsAny(host, "/@") {
+			return fmt.Errorf("allowed host %q must be a host name or address, optionally with a port", host)
+		}
+	}
+	return nil
+}
+
+// parseTags converts the raw tags of a worker into a map of keys to values.
+// Tags can be given as a block or an object, and each value can be a string
+// or a list of strings:
+//
+//  tags {
+//    region = "us-east-1"
+//    network = ["vpc-a", "vpc-b"]
+//  }
+func parseTags(raw interface{}) (map[string][]string, error) {
+	tags := map[string][]string{}
+	var objs []map[string]interface{}
+	switch t := raw.(type) {
+	case map[string]interface{}:
+		objs = append(objs, t)
+	case []map[string]interface{}:
+		objs = t
+	default:
+		return nil, fmt.Errorf("tags must be a block or an object, got %T", raw)
+	}
+	for _, obj := range objs {
+		for k, v := range obj {
+			switch val := v.(type) {
+			case string:
+				tags[k] = append(tags[k], val)
+			case []interface{}:
+				for _, e := range val {
+					s, ok := e.(string)
+					if !ok {
+						return nil, fmt.Errorf("value of tag %q must be a string or a list of strings", k)
+					}
+					tags[k] = append(tags[k], s)
+				}
+			default:
+				return nil, fmt.Errorf("value of tag %q must be a string or a list of strings", k)
+			}
+		}
+	}
+	return tags, nil
+}
+
 // Sanitized returns a copy of the config with all values that are considered
 // sensitive stripped. It also strips all `*Raw` values that are mainly
 // used for parsing.
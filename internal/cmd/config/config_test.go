@@ -1,11 +1,13 @@
 package config
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/shared-secure-libs/configutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDevController(t *testing.T) {
@@ -115,3 +117,279 @@ func TestDevWorker(t *testing.T) {
 
 	assert.Equal(t, exp, actual)
 }
+
+func TestWorkerTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    map[string][]string
+		wantErr bool
+	}{
+		{
+			name: "block",
+			in: `
+worker {
+	name = "w"
+	tags {
+		region = "us-east-1"
+		network = ["vpc-a", "vpc-b"]
+	}
+}`,
+			want: map[string][]string{
+				"region":  {"us-east-1"},
+				"network": {"vpc-a", "vpc-b"},
+			},
+		},
+		{
+			name: "object",
+			in: `
+worker {
+	name = "w"
+	tags = {
+		region = "us-east-1"
+	}
+}`,
+			want: map[string][]string{
+				"region": {"us-east-1"},
+			},
+		},
+		{
+			name: "none",
+			in: `
+worker {
+	name = "w"
+}`,
+		},
+		{
+			name: "bad-value",
+			in: `
+worker {
+	name = "w"
+	tags {
+		port = 5432
+	}
+}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			got, err := Parse(tt.in)
+			if tt.wantErr {
+				assert.Error(err)
+				return
+			}
+			if assert.NoError(err) {
+				assert.Equal(tt.want, got.Worker.Tags)
+			}
+		})
+	}
+}
+
+func TestControllerStatusGracePeriod(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{
+			name: "duration",
+			in: `
+controller {
+	name = "c"
+	status_grace_period = "1m"
+}`,
+			want: time.Minute,
+		},
+		{
+			name: "seconds",
+			in: `
+controller {
+	name = "c"
+	status_grace_period = 30
+}`,
+			want: 30 * time.Second,
+		},
+		{
+			name: "none",
+			in: `
+controller {
+	name = "c"
+}`,
+		},
+		{
+			name: "not-positive",
+			in: `
+controller {
+	name = "c"
+	status_grace_period = "0s"
+}`,
+			wantErr: true,
+		},
+		{
+			name: "bad-value",
+			in: `
+controller {
+	name = "c"
+	status_grace_period = "soon"
+}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			got, err := Parse(tt.in)
+			if tt.wantErr {
+				assert.Error(err)
+				return
+			}
+			if assert.NoError(err) {
+				assert.Equal(tt.want, got.Controller.StatusGracePeriod)
+			}
+		})
+	}
+}
+
+func TestWorkerDrainTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{
+			name: "duration",
+			in: `
+worker {
+	name = "w"
+	drain_timeout = "10m"
+}`,
+			want: 10 * time.Minute,
+		},
+		{
+			name: "seconds",
+			in: `
+worker {
+	name = "w"
+	drain_timeout = 90
+}`,
+			want: 90 * time.Second,
+		},
+		{
+			name: "none",
+			in: `
+worker {
+	name = "w"
+}`,
+		},
+		{
+			name: "negative",
+			in: `
+worker {
+	name = "w"
+	drain_timeout = "-1s"
+}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			got, err := Parse(tt.in)
+			if tt.wantErr {
+				assert.Error(err)
+				return
+			}
+			if assert.NoError(err) {
+				assert.Equal(tt.want, got.Worker.DrainTimeout)
+			}
+		})
+	}
+}
+
+func TestControllerAudit(t *testing.T) {
+	t.Run("sinks", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := Parse(`
+controller {
+	name = "c"
+	audit {
+		hmac_fields = ["auth_token_id", "client_ip"]
+		fail_closed = true
+		sink "file" {
+			path = "/var/log/boundary/audit.log"
+		}
+		sink "syslog" {
+			facility = "LOCAL0"
+		}
+		sink "webhook" {
+			url = "https://siem.example.com/boundary"
+			headers = {
+				Authorization = "Bearer token"
+			}
+			timeout = "5s"
+		}
+	}
+}`)
+		require.NoError(err)
+		audit := got.Controller.Audit
+		require.NotNil(audit)
+		assert.Equal([]string{"auth_token_id", "client_ip"}, audit.HmacFields)
+		assert.True(audit.FailClosed)
+		require.Len(audit.Sinks, 3)
+		assert.Equal("file", audit.Sinks[0].Type)
+		assert.Equal("/var/log/boundary/audit.log", audit.Sinks[0].Path)
+		assert.Equal("syslog", audit.Sinks[1].Type)
+		assert.Equal("LOCAL0", audit.Sinks[1].Facility)
+		assert.Equal("webhook", audit.Sinks[2].Type)
+		assert.Equal("https://siem.example.com/boundary", audit.Sinks[2].Url)
+		assert.Equal(map[string]string{"Authorization": "Bearer token"}, audit.Sinks[2].Headers)
+		assert.Equal(5*time.Second, audit.Sinks[2].Timeout)
+	})
+
+	for name, in := range map[string]string{
+		"unknown-sink":     `sink "kafka" {}`,
+		"file-no-path":     `sink "file" {}`,
+		"webhook-no-url":   `sink "webhook" {}`,
+		"bad-timeout":      `sink "webhook" { url = "https://siem" timeout = "soon" }`,
+		"unknown-hmac-key": `hmac_fields = ["password"]`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(fmt.Sprintf("controller {\n\taudit {\n\t\t%s\n\t}\n}", in))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestControllerDynamicHostCatalogs(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := Parse(`
+controller {
+	name = "c"
+	dynamic_host_catalogs {
+		allowed_directories = ["/etc/boundary/inventory/", "/srv/../var/inventory"]
+		allowed_hosts = ["cmdb.example.com", "10.0.0.1:8080"]
+	}
+}`)
+		require.NoError(err)
+		dhc := got.Controller.DynamicHostCatalogs
+		require.NotNil(dhc)
+		assert.Equal([]string{"/etc/boundary/inventory", "/var/inventory"}, dhc.AllowedDirectories)
+		assert.Equal([]string{"cmdb.example.com", "10.0.0.1:8080"}, dhc.AllowedHosts)
+	})
+
+	for name, in := range map[string]string{
+		"relative-directory": `allowed_directories = ["inventory"]`,
+		"empty-host":         `allowed_hosts = [""]`,
+		"url-host":           `allowed_hosts = ["https://cmdb.example.com/hosts"]`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(fmt.Sprintf("controller {\n\tdynamic_host_catalogs {\n\t\t%s\n\t}\n}", in))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package credential
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// A Credential is a username and password contained in a credential store.
+// The password is encrypted with the credentials key of the scope of the
+// store and is only decrypted when the credential is used for a session.
+type Credential struct {
+	*store.Credential
+	tableName string
+}
+
+func allocCredential() Credential {
+	return Credential{
+		Credential: &store.Credential{},
+	}
+}
+
+// NewCredential creates a new in memory Credential for username and
+// password assigned to storeId. Name and description are the only valid
+// options. All other options are ignored.
+func NewCredential(storeId, username, password string, opt ...Option) (*Credential, error) {
+	if storeId == "" {
+		return nil, fmt.Errorf("new: credential: no store id: %w", db.ErrInvalidParameter)
+	}
+	if strings.TrimSpace(username) == "" {
+		return nil, fmt.Errorf("new: credential: no username: %w", db.ErrInvalidParameter)
+	}
+	if password == "" {
+		return nil, fmt.Errorf("new: credential: no password: %w", db.ErrInvalidParameter)
+	}
+
+	opts := getOpts(opt...)
+	c := &Credential{
+		Credential: &store.Credential{
+			StoreId:     storeId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			Username:    username,
+			Password:    []byte(password),
+		},
+	}
+	return c, nil
+}
+
+func (c *Credential) clone() *Credential {
+	cp := proto.Clone(c.Credential)
+	return &Credential{
+		Credential: cp.(*store.Credential),
+	}
+}
+
+// TableName returns the table name.
+func (c *Credential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential"
+}
+
+// SetTableName sets the table name.
+func (c *Credential) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *Credential) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	if err := structwrapping.WrapStruct(ctx, cipher, c.Credential, nil); err != nil {
+		return fmt.Errorf("error encrypting credential password: %w", err)
+	}
+	c.KeyId = cipher.KeyID()
+	return nil
+}
+
+func (c *Credential) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	if err := structwrapping.UnwrapStruct(ctx, cipher, c.Credential, nil); err != nil {
+		return fmt.Errorf("error decrypting credential password: %w", err)
+	}
+	return nil
+}
+
+func (c *Credential) oplog(op oplog.OpType, scopeId string) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{c.GetPublicId()},
+		"resource-type":      []string{"credential"},
+		"op-type":            []string{op.String()},
+	}
+	if scopeId != "" {
+		metadata["scope-id"] = []string{scopeId}
+	}
+	return metadata
+}
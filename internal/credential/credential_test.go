@@ -0,0 +1,66 @@
+package credential
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCredential(t *testing.T) {
+	var tests = []struct {
+		name      string
+		storeId   string
+		username  string
+		password  string
+		opts      []Option
+		wantIsErr error
+	}{
+		{
+			name:     "valid",
+			storeId:  "cs_1234567890",
+			username: "admin",
+			password: "secret",
+			opts:     []Option{WithName("db"), WithDescription("desc")},
+		},
+		{
+			name:      "no-store",
+			username:  "admin",
+			password:  "secret",
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "blank-username",
+			storeId:   "cs_1234567890",
+			username:  "  ",
+			password:  "secret",
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "no-password",
+			storeId:   "cs_1234567890",
+			username:  "admin",
+			wantIsErr: db.ErrInvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewCredential(tt.storeId, tt.username, tt.password, tt.opts...)
+			if tt.wantIsErr != nil {
+				assert.Truef(errors.Is(err, tt.wantIsErr), "want err: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.storeId, got.StoreId)
+			assert.Equal(tt.username, got.Username)
+			assert.Equal([]byte(tt.password), got.Password)
+			assert.Equal("db", got.Name)
+			assert.Equal("desc", got.Description)
+			assert.Empty(got.PublicId)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package credential
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// PublicId prefixes for the resources in the credential package.
+const (
+	CredentialStorePrefix = "cs"
+	CredentialPrefix      = "cred"
+)
+
+func newCredentialStoreId() (string, error) {
+	id, err := db.NewPublicId(CredentialStorePrefix)
+	if err != nil {
+		return "", fmt.Errorf("new credential store id: %w", err)
+	}
+	return id, err
+}
+
+func newCredentialId() (string, error) {
+	id, err := db.NewPublicId(CredentialPrefix)
+	if err != nil {
+		return "", fmt.Errorf("new credential id: %w", err)
+	}
+	return id, err
+}
@@ -0,0 +1,309 @@
+package credential
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	dbcommon "github.com/hashicorp/boundary/internal/db/common"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// CreateCredential inserts c into the repository and returns a new
+// Credential containing the credential's PublicId. c is not changed. c must
+// contain a valid StoreId, Username, and Password. c must not contain a
+// PublicId. The PublicId is generated and assigned by this method. The
+// password is encrypted with the credentials key of scopeId, the scope of
+// the store, before it is stored and it is not included in the returned
+// Credential.
+//
+// WithPublicId is the only valid option. All other options are ignored.
+//
+// Both c.Name and c.Description are optional. If c.Name is set, it must be
+// unique within c.StoreId.
+func (r *Repository) CreateCredential(ctx context.Context, scopeId string, c *Credential, opt ...Option) (*Credential, error) {
+	if c == nil {
+		return nil, fmt.Errorf("create: credential: %w", db.ErrInvalidParameter)
+	}
+	if c.Credential == nil {
+		return nil, fmt.Errorf("create: credential: embedded Credential: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return nil, fmt.Errorf("create: credential: no scope id: %w", db.ErrInvalidParameter)
+	}
+	if c.StoreId == "" {
+		return nil, fmt.Errorf("create: credential: no store id: %w", db.ErrInvalidParameter)
+	}
+	if c.PublicId != "" {
+		return nil, fmt.Errorf("create: credential: public id not empty: %w", db.ErrInvalidParameter)
+	}
+	if strings.TrimSpace(c.Username) == "" {
+		return nil, fmt.Errorf("create: credential: no username: %w", db.ErrInvalidParameter)
+	}
+	if len(c.Password) == 0 {
+		return nil, fmt.Errorf("create: credential: no password: %w", db.ErrInvalidParameter)
+	}
+	c = c.clone()
+
+	opts := getOpts(opt...)
+
+	if opts.withPublicId != "" {
+		if !strings.HasPrefix(opts.withPublicId, CredentialPrefix+"_") {
+			return nil, fmt.Errorf("create: credential: passed-in public ID %q has wrong prefix, should be %q: %w", opts.withPublicId, CredentialPrefix, db.ErrInvalidPublicId)
+		}
+		c.PublicId = opts.withPublicId
+	} else {
+		id, err := newCredentialId()
+		if err != nil {
+			return nil, fmt.Errorf("create: credential: %w", err)
+		}
+		c.PublicId = id
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, fmt.Errorf("create: credential: unable to get oplog wrapper: %w", err)
+	}
+	credWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeCredentials)
+	if err != nil {
+		return nil, fmt.Errorf("create: credential: unable to get credentials wrapper: %w", err)
+	}
+	if err := c.encrypt(ctx, credWrapper); err != nil {
+		return nil, fmt.Errorf("create: credential: %w", err)
+	}
+
+	var newCredential *Credential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newCredential = c.clone()
+			return w.Create(ctx, newCredential, db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_CREATE, scopeId)))
+		},
+	)
+
+	if err != nil {
+		if db.IsUniqueError(err) {
+			return nil, fmt.Errorf("create: credential: in store: %s: name %s already exists: %w",
+				c.StoreId, c.Name, db.ErrNotUnique)
+		}
+		return nil, fmt.Errorf("create: credential: in store: %s: %w", c.StoreId, err)
+	}
+	newCredential.Password = nil
+	return newCredential, nil
+}
+
+// LookupCredential will look up a credential in the repository. If the
+// credential is not found, it will return nil, nil. The password of the
+// returned credential is not decrypted. All options are ignored.
+func (r *Repository) LookupCredential(ctx context.Context, publicId string, opt ...Option) (*Credential, error) {
+	if publicId == "" {
+		return nil, fmt.Errorf("lookup: credential: missing public id %w", db.ErrInvalidParameter)
+	}
+	c := allocCredential()
+	c.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, &c); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("lookup: credential: failed %w for %s", err, publicId)
+	}
+	return &c, nil
+}
+
+// LookupCredentialsWithSecret looks up the credentials with publicIds and
+// decrypts their passwords with the credentials key of scopeId. Credentials
+// which are not found or are not in a store of scopeId are not returned.
+// The credentials are returned in the order of publicIds.
+func (r *Repository) LookupCredentialsWithSecret(ctx context.Context, scopeId string, publicIds []string) ([]*Credential, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("lookup: credentials with secret: missing scope id: %w", db.ErrInvalidParameter)
+	}
+	if len(publicIds) == 0 {
+		return nil, nil
+	}
+	var found []*Credential
+	err := r.reader.SearchWhere(ctx, &found,
+		"public_id in (?) and store_id in (select public_id from credential_store where scope_id = ?)",
+		[]interface{}{publicIds, scopeId})
+	if err != nil {
+		return nil, fmt.Errorf("lookup: credentials with secret: %w", err)
+	}
+	byId := make(map[string]*Credential, len(found))
+	for _, c := range found {
+		credWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeCredentials, kms.WithKeyId(c.GetKeyId()))
+		if err != nil {
+			return nil, fmt.Errorf("lookup: credentials with secret: unable to get credentials wrapper: %w", err)
+		}
+		if err := c.decrypt(ctx, credWrapper); err != nil {
+			return nil, fmt.Errorf("lookup: credentials with secret: %s: %w", c.PublicId, err)
+		}
+		byId[c.PublicId] = c
+	}
+	creds := make([]*Credential, 0, len(found))
+	for _, id := range publicIds {
+		if c, ok := byId[id]; ok {
+			creds = append(creds, c)
+		}
+	}
+	return creds, nil
+}
+
+// ListCredentials returns a slice of Credentials for the storeId. The
+// passwords of the credentials are not decrypted. Supports the WithLimit
+// and WithPageAfter options.
+func (r *Repository) ListCredentials(ctx context.Context, storeId string, opt ...Option) ([]*Credential, error) {
+	if storeId == "" {
+		return nil, fmt.Errorf("list: credential: missing store id: %w", db.ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var creds []*Credential
+	err := r.reader.SearchWhere(ctx, &creds, "store_id = ?", []interface{}{storeId}, db.WithLimit(limit), db.WithPageAfter(opts.withPageAfter))
+	if err != nil {
+		return nil, fmt.Errorf("list: credential: %w", err)
+	}
+	return creds, nil
+}
+
+// UpdateCredential will update a credential in the repository and return
+// the written credential. scopeId is the scope of the store of the
+// credential. fieldMaskPaths provides field_mask.proto paths for fields
+// that should be updated. Fields will be set to NULL if the field is a zero
+// value and included in fieldMask. Name, Description, Username, and
+// Password are the only updatable fields. Username and Password cannot be
+// set to NULL. If no updatable fields are included in the fieldMaskPaths,
+// then an error is returned.
+func (r *Repository) UpdateCredential(ctx context.Context, scopeId string, c *Credential, version uint32, fieldMaskPaths []string, opt ...Option) (*Credential, int, error) {
+	if c == nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: credential: missing credential: %w", db.ErrInvalidParameter)
+	}
+	if c.Credential == nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: credential: embedded Credential: %w", db.ErrInvalidParameter)
+	}
+	if c.PublicId == "" {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: credential: missing public id: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: credential: scope id empty: %w", db.ErrInvalidParameter)
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: credential: no version supplied: %w", db.ErrInvalidParameter)
+	}
+	upCredential := c.clone()
+	var withSecret bool
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold("name", f):
+		case strings.EqualFold("description", f):
+		case strings.EqualFold("username", f):
+			if strings.TrimSpace(upCredential.Username) == "" {
+				return nil, db.NoRowsAffected, fmt.Errorf("update: credential: no username: %w", db.ErrInvalidParameter)
+			}
+		case strings.EqualFold("password", f):
+			if len(upCredential.Password) == 0 {
+				return nil, db.NoRowsAffected, fmt.Errorf("update: credential: no password: %w", db.ErrInvalidParameter)
+			}
+			withSecret = true
+		default:
+			return nil, db.NoRowsAffected, fmt.Errorf("update: credential: field: %s: %w", f, db.ErrInvalidFieldMask)
+		}
+	}
+	var dbMask, nullFields []string
+	dbMask, nullFields = dbcommon.BuildUpdatePaths(
+		map[string]interface{}{
+			"Name":        upCredential.Name,
+			"Description": upCredential.Description,
+			"Username":    upCredential.Username,
+		},
+		fieldMaskPaths,
+		nil,
+	)
+	if withSecret {
+		dbMask = append(dbMask, "CtPassword", "KeyId")
+	}
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: credential: %w", db.ErrEmptyFieldMask)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: credential: unable to get oplog wrapper: %w", err)
+	}
+	if withSecret {
+		credWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeCredentials)
+		if err != nil {
+			return nil, db.NoRowsAffected, fmt.Errorf("update: credential: unable to get credentials wrapper: %w", err)
+		}
+		if err := upCredential.encrypt(ctx, credWrapper); err != nil {
+			return nil, db.NoRowsAffected, fmt.Errorf("update: credential: %w", err)
+		}
+	}
+
+	var rowsUpdated int
+	var returnedCredential *Credential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedCredential = upCredential.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedCredential, dbMask, nullFields,
+				db.WithOplog(oplogWrapper, upCredential.oplog(oplog.OpType_OP_TYPE_UPDATE, scopeId)),
+				db.WithVersion(&version))
+			if err == nil && rowsUpdated > 1 {
+				return db.ErrMultipleRecords
+			}
+			return err
+		},
+	)
+	if err != nil {
+		if db.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, fmt.Errorf("update: credential: %s: name %s already exists: %w", c.PublicId, c.Name, db.ErrNotUnique)
+		}
+		return nil, db.NoRowsAffected, fmt.Errorf("update: credential: %s: %w", c.PublicId, err)
+	}
+	if rowsUpdated == 0 {
+		return nil, db.NoRowsAffected, nil
+	}
+	returnedCredential.Password = nil
+	return returnedCredential, rowsUpdated, nil
+}
+
+// DeleteCredential deletes the credential for the provided id from the
+// repository returning a count of the number of records deleted. scopeId is
+// the scope of the store of the credential. All options are ignored.
+func (r *Repository) DeleteCredential(ctx context.Context, scopeId, publicId string, opt ...Option) (int, error) {
+	if publicId == "" {
+		return db.NoRowsAffected, fmt.Errorf("delete: credential: missing public id: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return db.NoRowsAffected, fmt.Errorf("delete: credential: missing scope id: %w", db.ErrInvalidParameter)
+	}
+	c := allocCredential()
+	c.PublicId = publicId
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("delete: credential: unable to get oplog wrapper: %w", err)
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			dc := c.clone()
+			rowsDeleted, err = w.Delete(ctx, dc, db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_DELETE, scopeId)))
+			if err == nil && rowsDeleted > 1 {
+				return db.ErrMultipleRecords
+			}
+			return err
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("delete: credential: %s: %w", publicId, err)
+	}
+	return rowsDeleted, nil
+}
@@ -0,0 +1,225 @@
+package credential
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	dbcommon "github.com/hashicorp/boundary/internal/db/common"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// CreateCredentialStore inserts cs into the repository and returns a new
+// CredentialStore containing the store's PublicId. cs is not changed. cs
+// must contain a valid ScopeId. cs must not contain a PublicId. The
+// PublicId is generated and assigned by this method.
+//
+// WithPublicId is the only valid option. All other options are ignored.
+//
+// Both cs.Name and cs.Description are optional. If cs.Name is set, it must
+// be unique within cs.ScopeId.
+func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialStore, opt ...Option) (*CredentialStore, error) {
+	if cs == nil {
+		return nil, fmt.Errorf("create: credential store: %w", db.ErrInvalidParameter)
+	}
+	if cs.CredentialStore == nil {
+		return nil, fmt.Errorf("create: credential store: embedded CredentialStore: %w", db.ErrInvalidParameter)
+	}
+	if cs.ScopeId == "" {
+		return nil, fmt.Errorf("create: credential store: no scope id: %w", db.ErrInvalidParameter)
+	}
+	if cs.PublicId != "" {
+		return nil, fmt.Errorf("create: credential store: public id not empty: %w", db.ErrInvalidParameter)
+	}
+	cs = cs.clone()
+
+	opts := getOpts(opt...)
+
+	if opts.withPublicId != "" {
+		if !strings.HasPrefix(opts.withPublicId, CredentialStorePrefix+"_") {
+			return nil, fmt.Errorf("create: credential store: passed-in public ID %q has wrong prefix, should be %q: %w", opts.withPublicId, CredentialStorePrefix, db.ErrInvalidPublicId)
+		}
+		cs.PublicId = opts.withPublicId
+	} else {
+		id, err := newCredentialStoreId()
+		if err != nil {
+			return nil, fmt.Errorf("create: credential store: %w", err)
+		}
+		cs.PublicId = id
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, cs.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, fmt.Errorf("create: credential store: unable to get oplog wrapper: %w", err)
+	}
+
+	var newStore *CredentialStore
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newStore = cs.clone()
+			return w.Create(ctx, newStore, db.WithOplog(oplogWrapper, cs.oplog(oplog.OpType_OP_TYPE_CREATE)))
+		},
+	)
+
+	if err != nil {
+		if db.IsUniqueError(err) {
+			return nil, fmt.Errorf("create: credential store: in scope: %s: name %s already exists: %w",
+				cs.ScopeId, cs.Name, db.ErrNotUnique)
+		}
+		return nil, fmt.Errorf("create: credential store: in scope: %s: %w", cs.ScopeId, err)
+	}
+	return newStore, nil
+}
+
+// LookupCredentialStore will look up a credential store in the repository.
+// If the store is not found, it will return nil, nil. All options are
+// ignored.
+func (r *Repository) LookupCredentialStore(ctx context.Context, publicId string, opt ...Option) (*CredentialStore, error) {
+	if publicId == "" {
+		return nil, fmt.Errorf("lookup: credential store: missing public id %w", db.ErrInvalidParameter)
+	}
+	cs := allocCredentialStore()
+	cs.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, &cs); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("lookup: credential store: failed %w for %s", err, publicId)
+	}
+	return &cs, nil
+}
+
+// ListCredentialStores returns a slice of CredentialStores for the scopeId.
+// Supports the WithLimit and WithPageAfter options.
+func (r *Repository) ListCredentialStores(ctx context.Context, scopeId string, opt ...Option) ([]*CredentialStore, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("list: credential store: missing scope id: %w", db.ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var stores []*CredentialStore
+	err := r.reader.SearchWhere(ctx, &stores, "scope_id = ?", []interface{}{scopeId}, db.WithLimit(limit), db.WithPageAfter(opts.withPageAfter))
+	if err != nil {
+		return nil, fmt.Errorf("list: credential store: %w", err)
+	}
+	return stores, nil
+}
+
+// UpdateCredentialStore will update a credential store in the repository
+// and return the written store. fieldMaskPaths provides field_mask.proto
+// paths for fields that should be updated. Fields will be set to NULL if
+// the field is a zero value and included in fieldMask. Name and
+// Description are the only updatable fields. If no updatable fields are
+// included in the fieldMaskPaths, then an error is returned.
+func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialStore, version uint32, fieldMaskPaths []string, opt ...Option) (*CredentialStore, int, error) {
+	if cs == nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: credential store: missing credential store: %w", db.ErrInvalidParameter)
+	}
+	if cs.CredentialStore == nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: credential store: embedded CredentialStore: %w", db.ErrInvalidParameter)
+	}
+	if cs.PublicId == "" {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: credential store: missing public id: %w", db.ErrInvalidParameter)
+	}
+	if cs.ScopeId == "" {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: credential store: scope id empty: %w", db.ErrInvalidParameter)
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: credential store: no version supplied: %w", db.ErrInvalidParameter)
+	}
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold("name", f):
+		case strings.EqualFold("description", f):
+		default:
+			return nil, db.NoRowsAffected, fmt.Errorf("update: credential store: field: %s: %w", f, db.ErrInvalidFieldMask)
+		}
+	}
+	var dbMask, nullFields []string
+	dbMask, nullFields = dbcommon.BuildUpdatePaths(
+		map[string]interface{}{
+			"Name":        cs.Name,
+			"Description": cs.Description,
+		},
+		fieldMaskPaths,
+		nil,
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: credential store: %w", db.ErrEmptyFieldMask)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, cs.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: credential store: unable to get oplog wrapper: %w", err)
+	}
+
+	var rowsUpdated int
+	var returnedStore *CredentialStore
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedStore = cs.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedStore, dbMask, nullFields,
+				db.WithOplog(oplogWrapper, cs.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err == nil && rowsUpdated > 1 {
+				return db.ErrMultipleRecords
+			}
+			return err
+		},
+	)
+	if err != nil {
+		if db.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, fmt.Errorf("update: credential store: %s: name %s already exists: %w", cs.PublicId, cs.Name, db.ErrNotUnique)
+		}
+		return nil, db.NoRowsAffected, fmt.Errorf("update: credential store: %s: %w", cs.PublicId, err)
+	}
+	if rowsUpdated == 0 {
+		return nil, db.NoRowsAffected, nil
+	}
+	return returnedStore, rowsUpdated, nil
+}
+
+// DeleteCredentialStore deletes the credential store for the provided id
+// from the repository returning a count of the number of records deleted.
+// The credentials of the store are deleted with it. All options are
+// ignored.
+func (r *Repository) DeleteCredentialStore(ctx context.Context, scopeId, publicId string, opt ...Option) (int, error) {
+	if publicId == "" {
+		return db.NoRowsAffected, fmt.Errorf("delete: credential store: missing public id: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return db.NoRowsAffected, fmt.Errorf("delete: credential store: missing scope id: %w", db.ErrInvalidParameter)
+	}
+	cs := allocCredentialStore()
+	cs.PublicId = publicId
+	cs.ScopeId = scopeId
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("delete: credential store: unable to get oplog wrapper: %w", err)
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			dcs := cs.clone()
+			rowsDeleted, err = w.Delete(ctx, dcs, db.WithOplog(oplogWrapper, cs.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err == nil && rowsDeleted > 1 {
+				return db.ErrMultipleRecords
+			}
+			return err
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("delete: credential store: %s: %w", publicId, err)
+	}
+	return rowsDeleted, nil
+}
@@ -0,0 +1,73 @@
+package credential
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CredentialStore(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	org, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	ctx := context.Background()
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	t.Run("org-scope", func(t *testing.T) {
+		cs, err := NewCredentialStore(org.PublicId)
+		require.NoError(t, err)
+		_, err = repo.CreateCredentialStore(ctx, cs)
+		assert.Error(t, err)
+	})
+
+	t.Run("lifecycle", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cs, err := NewCredentialStore(proj.PublicId, WithName("vault"), WithDescription("desc"))
+		require.NoError(err)
+		got, err := repo.CreateCredentialStore(ctx, cs)
+		require.NoError(err)
+		assert.Empty(cs.PublicId)
+		assert.NotEmpty(got.PublicId)
+		assert.Equal(uint32(1), got.Version)
+
+		_, err = repo.CreateCredentialStore(ctx, cs)
+		assert.Truef(errors.Is(err, db.ErrNotUnique), "want err: %q got: %q", db.ErrNotUnique, err)
+
+		found, err := repo.LookupCredentialStore(ctx, got.PublicId)
+		require.NoError(err)
+		assert.Equal("vault", found.Name)
+
+		list, err := repo.ListCredentialStores(ctx, proj.PublicId)
+		require.NoError(err)
+		assert.Len(list, 1)
+
+		up := got.clone()
+		up.Name = "renamed"
+		up.Description = ""
+		updated, n, err := repo.UpdateCredentialStore(ctx, up, got.Version, []string{"Name", "Description"})
+		require.NoError(err)
+		assert.Equal(1, n)
+		assert.Equal("renamed", updated.Name)
+		assert.Empty(updated.Description)
+
+		_, _, err = repo.UpdateCredentialStore(ctx, up, updated.Version, []string{"ScopeId"})
+		assert.Truef(errors.Is(err, db.ErrInvalidFieldMask), "want err: %q got: %q", db.ErrInvalidFieldMask, err)
+
+		n, err = repo.DeleteCredentialStore(ctx, proj.PublicId, got.PublicId)
+		require.NoError(err)
+		assert.Equal(1, n)
+		found, err = repo.LookupCredentialStore(ctx, got.PublicId)
+		require.NoError(err)
+		assert.Nil(found)
+	})
+}
@@ -0,0 +1,92 @@
+package credential
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Credential(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStores(t, conn, proj.PublicId, 1)[0]
+
+	ctx := context.Background()
+	repo, err := NewRepository(rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	t.Run("invalid", func(t *testing.T) {
+		c, err := NewCredential(cs.PublicId, "admin", "secret")
+		require.NoError(t, err)
+		_, err = repo.CreateCredential(ctx, "", c)
+		assert.Truef(t, errors.Is(err, db.ErrInvalidParameter), "want err: %q got: %q", db.ErrInvalidParameter, err)
+		c.Password = nil
+		_, err = repo.CreateCredential(ctx, proj.PublicId, c)
+		assert.Truef(t, errors.Is(err, db.ErrInvalidParameter), "want err: %q got: %q", db.ErrInvalidParameter, err)
+	})
+
+	t.Run("lifecycle", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		c, err := NewCredential(cs.PublicId, "admin", "secret", WithName("db"))
+		require.NoError(err)
+		got, err := repo.CreateCredential(ctx, proj.PublicId, c)
+		require.NoError(err)
+		assert.NotEmpty(got.PublicId)
+		assert.NotEmpty(got.KeyId)
+		assert.Empty(got.Password)
+		assert.NotEqual([]byte("secret"), got.CtPassword)
+
+		found, err := repo.LookupCredential(ctx, got.PublicId)
+		require.NoError(err)
+		assert.Equal("admin", found.Username)
+		assert.Empty(found.Password)
+
+		withSecret, err := repo.LookupCredentialsWithSecret(ctx, proj.PublicId, []string{got.PublicId})
+		require.NoError(err)
+		require.Len(withSecret, 1)
+		assert.Equal([]byte("secret"), withSecret[0].Password)
+
+		// credentials of other scopes are not returned
+		withSecret, err = repo.LookupCredentialsWithSecret(ctx, org.PublicId, []string{got.PublicId})
+		require.NoError(err)
+		assert.Empty(withSecret)
+
+		up := got.clone()
+		up.Username = "root"
+		up.Password = []byte("changed")
+		updated, n, err := repo.UpdateCredential(ctx, proj.PublicId, up, got.Version, []string{"Username", "Password"})
+		require.NoError(err)
+		assert.Equal(1, n)
+		assert.Equal("root", updated.Username)
+		assert.Empty(updated.Password)
+
+		withSecret, err = repo.LookupCredentialsWithSecret(ctx, proj.PublicId, []string{got.PublicId})
+		require.NoError(err)
+		require.Len(withSecret, 1)
+		assert.Equal([]byte("changed"), withSecret[0].Password)
+
+		up.Username = ""
+		_, _, err = repo.UpdateCredential(ctx, proj.PublicId, up, updated.Version, []string{"Username"})
+		assert.Truef(errors.Is(err, db.ErrInvalidParameter), "want err: %q got: %q", db.ErrInvalidParameter, err)
+
+		list, err := repo.ListCredentials(ctx, cs.PublicId)
+		require.NoError(err)
+		assert.Len(list, 1)
+
+		n, err = repo.DeleteCredential(ctx, proj.PublicId, got.PublicId)
+		require.NoError(err)
+		assert.Equal(1, n)
+		found, err = repo.LookupCredential(ctx, got.PublicId)
+		require.NoError(err)
+		assert.Nil(found)
+	})
+}
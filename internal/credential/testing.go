@@ -0,0 +1,78 @@
+package credential
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCredentialStores creates count number of credential stores to the
+// provided DB with the provided scope id. If any errors are encountered
+// during the creation of the stores, the test will fail.
+func TestCredentialStores(t *testing.T, conn *gorm.DB, scopeId string, count int) []*CredentialStore {
+	t.Helper()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	w := db.New(conn)
+	var stores []*CredentialStore
+	for i := 0; i < count; i++ {
+		cs, err := NewCredentialStore(scopeId)
+		assert.NoError(err)
+		require.NotNil(cs)
+		id, err := newCredentialStoreId()
+		assert.NoError(err)
+		require.NotEmpty(id)
+		cs.PublicId = id
+
+		_, err2 := w.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+			func(_ db.Reader, iw db.Writer) error {
+				return iw.Create(ctx, cs)
+			},
+		)
+
+		require.NoError(err2)
+		stores = append(stores, cs)
+	}
+	return stores
+}
+
+// TestCredentials creates count number of credentials to the provided DB
+// with the provided store id. The usernames of the credentials are "user0"
+// to "user<count-1>" and their passwords are "password0" to
+// "password<count-1>". The passwords are encrypted with wrapper, which must
+// be the credentials wrapper of the scope of the store. If any errors are
+// encountered during the creation of the credentials, the test will fail.
+func TestCredentials(t *testing.T, conn *gorm.DB, wrapper wrapping.Wrapper, storeId string, count int) []*Credential {
+	t.Helper()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	w := db.New(conn)
+	var creds []*Credential
+	for i := 0; i < count; i++ {
+		c, err := NewCredential(storeId, fmt.Sprintf("user%d", i), fmt.Sprintf("password%d", i))
+		assert.NoError(err)
+		require.NotNil(c)
+		id, err := newCredentialId()
+		assert.NoError(err)
+		require.NotEmpty(id)
+		c.PublicId = id
+		require.NoError(c.encrypt(ctx, wrapper))
+
+		_, err2 := w.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+			func(_ db.Reader, iw db.Writer) error {
+				return iw.Create(ctx, c)
+			},
+		)
+
+		require.NoError(err2)
+		c.Password = nil
+		creds = append(creds, c)
+	}
+	return creds
+}
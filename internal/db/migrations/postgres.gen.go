@@ -5355,6 +5355,2768 @@ begin;
 
 commit;
 
+`),
+	},
+	"migrations/70_session_recording.down.sql": {
+		name: "70_session_recording.down.sql",
+		bytes: []byte(`
+begin;
+
+  drop table session_connection_recording;
+
+  drop view target_all_subtypes;
+
+  alter table target_tcp
+    drop column record_sessions;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp;
+
+commit;
+
+`),
+	},
+	"migrations/70_session_recording.up.sql": {
+		name: "70_session_recording.up.sql",
+		bytes: []byte(`
+begin;
+
+  -- record_sessions controls whether workers record the data proxied over
+  -- the connections of sessions created for the target
+  alter table target_tcp
+    add column record_sessions boolean not null default false;
+
+  drop view target_all_subtypes;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp;
+
+  -- session_connection_recording contains the encrypted recording of a
+  -- session connection, uploaded by the worker which proxied the connection
+  -- once the connection is closed. The recording is encrypted with a key
+  -- derived from the session's scope session key, so it is not wrapped again.
+  create table session_connection_recording (
+    connection_id wt_public_id primary key
+      references session_connection (public_id)
+      on delete cascade
+      on update cascade,
+    session_id wt_public_id not null
+      references session (public_id)
+      on delete cascade
+      on update cascade,
+    recording bytea not null
+      constraint recording_must_not_be_empty
+      check(length(recording) > 0),
+    create_time wt_timestamp
+  );
+
+  create index session_connection_recording_session_id_ix
+    on session_connection_recording (session_id);
+
+  create trigger
+    immutable_columns
+  before
+  update on session_connection_recording
+    for each row execute procedure immutable_columns('connection_id', 'session_id', 'recording', 'create_time');
+
+  create trigger
+    default_create_time_column
+  before
+  insert on session_connection_recording
+    for each row execute procedure default_create_time();
+
+commit;
+
+`),
+	},
+	"migrations/71_dynamic_host.down.sql": {
+		name: "71_dynamic_host.down.sql",
+		bytes: []byte(`
+begin;
+
+  -- whx_host_dimension_source must be restored before the dynamic tables are
+  -- dropped, otherwise the cascade would drop the view.
+  create or replace view whx_host_dimension_source as
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'static host'                   as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         coalesce(h.description, 'None') as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'static host set'               as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'static host catalog'           as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from static_host as h,
+         static_host_catalog as c,
+         static_host_set_member as m,
+         static_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  ;
+
+  drop table dynamic_host_set_member cascade;
+  drop table dynamic_host_set cascade;
+  drop table dynamic_host cascade;
+  drop table dynamic_host_catalog cascade;
+
+  drop function insert_dynamic_host_set_member;
+
+  delete
+    from oplog_ticket
+   where name in (
+          'dynamic_host_catalog',
+          'dynamic_host',
+          'dynamic_host_set',
+          'dynamic_host_set_member'
+        );
+
+commit;
+
+`),
+	},
+	"migrations/71_dynamic_host.up.sql": {
+		name: "71_dynamic_host.up.sql",
+		bytes: []byte(`
+begin;
+
+/*
+
+  ┌─────────────────┐          ┌──────────────────────────┐
+  │      host       │          │       dynamic_host       │
+  ├─────────────────┤          ├──────────────────────────┤
+  │ public_id  (pk) │          │ public_id  (pk)          │
+  │ catalog_id (fk) │┼┼──────○┼│ catalog_id (fk)          │┼┼─────────────────────┐
+  │                 │          │ external_id              │             ◀fk1      │
+  └─────────────────┘          │ address                  │                       │
+          ╲│╱                  │ attributes               │                       │
+           ○                   └──────────────────────────┘                       │
+           │                              ╲│╱                                     │
+           ┼                               ○                                      │
+           ┼                               │                                      ○
+  ┌─────────────────┐          ┌──────────────────────────┐          ┌─────────────────────────┐
+  │  host_catalog   │          │   dynamic_host_catalog   │          │ dynamic_host_set_member │
+  ├─────────────────┤          ├──────────────────────────┤          ├─────────────────────────┤
+  │ public_id (pk)  │          │ public_id (pk)           │          │ host_id    (pk,fk1)     │
+  │ scope_id  (fk)  │┼┼──────○┼│ scope_id  (fk)           │          │ set_id     (pk,fk2)     │
+  │                 │          │ source                   │          │ catalog_id (fk1,fk2)    │
+  └─────────────────┘          │ refresh_interval_seconds │          └─────────────────────────┘
+           ┼                   └──────────────────────────┘                      ╲│╱
+           ┼                               ┼                                      ○
+           │                               ┼                                      │
+           ○                               ○                                      │
+          ╱│╲                             ╱│╲                                     │
+  ┌─────────────────┐          ┌──────────────────────────┐                       │
+  │    host_set     │          │     dynamic_host_set     │                       │
+  ├─────────────────┤          ├──────────────────────────┤                       │
+  │ public_id  (pk) │          │ public_id  (pk)          │             ◀fk2      │
+  │ catalog_id (fk) │┼┼──────○┼│ catalog_id (fk)          │┼┼─────────────────────┘
+  │                 │          │ filter                   │
+  └─────────────────┘          └──────────────────────────┘
+
+  Hosts in a dynamic host catalog are not managed through the API. They are
+  synced from the catalog's source by the controllers, and the membership of
+  each dynamic host set is recomputed from the set's filter whenever the
+  hosts or the filter change.
+
+*/
+
+  create table dynamic_host_catalog (
+    public_id wt_public_id
+      primary key,
+    scope_id wt_scope_id
+      not null
+      references iam_scope (public_id)
+      on delete cascade
+      on update cascade,
+    name text,
+    description text,
+    source text not null
+      constraint source_must_not_be_empty
+      check(length(trim(source)) > 0),
+    refresh_interval_seconds integer not null default 300
+      constraint refresh_interval_seconds_must_be_positive
+      check(refresh_interval_seconds > 0),
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    foreign key (scope_id, public_id)
+      references host_catalog (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(scope_id, name)
+  );
+
+  create trigger update_version_column after update on dynamic_host_catalog
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on dynamic_host_catalog
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on dynamic_host_catalog
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on dynamic_host_catalog
+    for each row execute procedure immutable_columns('public_id', 'scope_id','create_time');
+
+  create trigger insert_host_catalog_subtype before insert on dynamic_host_catalog
+    for each row execute procedure insert_host_catalog_subtype();
+
+  create trigger delete_host_catalog_subtype after delete on dynamic_host_catalog
+    for each row execute procedure delete_host_catalog_subtype();
+
+  create table dynamic_host (
+    public_id wt_public_id primary key,
+    catalog_id wt_public_id not null
+      references dynamic_host_catalog (public_id)
+      on delete cascade
+      on update cascade,
+    external_id text not null
+      constraint external_id_must_not_be_empty
+      check(length(trim(external_id)) > 0),
+    name text,
+    address text not null
+      constraint address_must_be_more_than_2_characters
+      check(length(trim(address)) > 2)
+      constraint address_must_be_less_than_256_characters
+      check(length(trim(address)) < 256),
+    attributes text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    unique(catalog_id, external_id),
+
+    foreign key (catalog_id, public_id)
+      references host (catalog_id, public_id)
+      on delete cascade
+      on update cascade,
+
+    unique(catalog_id, public_id)
+  );
+
+  create trigger update_version_column after update on dynamic_host
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on dynamic_host
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on dynamic_host
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on dynamic_host
+    for each row execute procedure immutable_columns('public_id', 'catalog_id', 'external_id', 'create_time');
+
+  create trigger insert_host_subtype before insert on dynamic_host
+    for each row execute procedure insert_host_subtype();
+
+  create trigger delete_host_subtype after delete on dynamic_host
+    for each row execute procedure delete_host_subtype();
+
+  create table dynamic_host_set (
+    public_id wt_public_id primary key,
+    catalog_id wt_public_id not null
+      references dynamic_host_catalog (public_id)
+      on delete cascade
+      on update cascade,
+    name text,
+    description text,
+    filter text not null
+      constraint filter_must_not_be_empty
+      check(length(trim(filter)) > 0),
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    unique(catalog_id, name),
+    foreign key (catalog_id, public_id)
+      references host_set (catalog_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(catalog_id, public_id)
+  );
+
+  create trigger update_version_column after update on dynamic_host_set
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on dynamic_host_set
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on dynamic_host_set
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on dynamic_host_set
+    for each row execute procedure immutable_columns('public_id', 'catalog_id','create_time');
+
+  create trigger insert_host_set_subtype before insert on dynamic_host_set
+    for each row execute procedure insert_host_set_subtype();
+
+  create trigger delete_host_set_subtype after delete on dynamic_host_set
+    for each row execute procedure delete_host_set_subtype();
+
+  create table dynamic_host_set_member (
+    host_id wt_public_id not null,
+    set_id wt_public_id not null,
+    catalog_id wt_public_id not null,
+    primary key(host_id, set_id),
+    foreign key (catalog_id, host_id) -- fk1
+      references dynamic_host (catalog_id, public_id)
+      on delete cascade
+      on update cascade,
+    foreign key (catalog_id, set_id) -- fk2
+      references dynamic_host_set (catalog_id, public_id)
+      on delete cascade
+      on update cascade
+  );
+
+  create trigger immutable_columns before update on dynamic_host_set_member
+    for each row execute procedure immutable_columns('host_id', 'set_id', 'catalog_id');
+
+  create or replace function insert_dynamic_host_set_member()
+    returns trigger
+  as $$
+  begin
+    select dynamic_host_set.catalog_id
+      into new.catalog_id
+    from dynamic_host_set
+    where dynamic_host_set.public_id = new.set_id;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger insert_dynamic_host_set_member before insert on dynamic_host_set_member
+    for each row execute procedure insert_dynamic_host_set_member();
+
+  -- whx_host_dimension_source is replaced to include dynamic hosts so that
+  -- sessions to them are recorded in the warehouse.
+  create or replace view whx_host_dimension_source as
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'static host'                   as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         coalesce(h.description, 'None') as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'static host set'               as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'static host catalog'           as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from static_host as h,
+         static_host_catalog as c,
+         static_host_set_member as m,
+         static_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+   union all
+  select h.public_id                     as host_id,
+         'dynamic host'                  as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         'None'                          as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'dynamic host set'              as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'dynamic host catalog'          as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from dynamic_host as h,
+         dynamic_host_catalog as c,
+         dynamic_host_set_member as m,
+         dynamic_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  ;
+
+  insert into oplog_ticket (name, version)
+  values
+    ('dynamic_host_catalog', 1),
+    ('dynamic_host', 1),
+    ('dynamic_host_set', 1),
+    ('dynamic_host_set_member', 1);
+
+commit;
+
+`),
+	},
+	"migrations/72_auth_oidc.down.sql": {
+		name: "72_auth_oidc.down.sql",
+		bytes: []byte(`
+begin;
+
+  -- whx_user_dimension_source must be restored before the oidc tables are
+  -- dropped, otherwise the cascade would drop the view.
+  create or replace view whx_user_dimension_source as
+       select -- id is the first column in the target view
+              u.public_id                       as user_id,
+              coalesce(u.name, 'None')          as user_name,
+              coalesce(u.description, 'None')   as user_description,
+              coalesce(aa.public_id, 'None')    as auth_account_id,
+              case when aa.public_id is null then 'None'
+                   else 'password auth account'
+                   end                          as auth_account_type,
+              coalesce(apa.name, 'None')        as auth_account_name,
+              coalesce(apa.description, 'None') as auth_account_description,
+              coalesce(am.public_id, 'None')    as auth_method_id,
+              case when am.public_id is null then 'None'
+                   else 'password auth method'
+                   end                          as auth_method_type,
+              coalesce(apm.name, 'None')        as auth_method_name,
+              coalesce(apm.description, 'None') as auth_method_description,
+              org.public_id                     as user_organization_id,
+              coalesce(org.name, 'None')        as user_organization_name,
+              coalesce(org.description, 'None') as user_organization_description
+         from iam_user as u
+    left join auth_account as aa on           u.public_id = aa.iam_user_id
+    left join auth_method as am on            aa.auth_method_id = am.public_id
+    left join auth_password_account as apa on aa.public_id = apa.public_id
+    left join auth_password_method as apm on  am.public_id = apm.public_id
+         join iam_scope as org on             u.scope_id = org.public_id
+  ;
+
+  drop table auth_oidc_state cascade;
+  drop table auth_oidc_account cascade;
+  drop table auth_oidc_method cascade;
+
+  delete
+    from oplog_ticket
+   where name in (
+          'auth_oidc_method',
+          'auth_oidc_account'
+        );
+
+commit;
+
+`),
+	},
+	"migrations/72_auth_oidc.up.sql": {
+		name: "72_auth_oidc.up.sql",
+		bytes: []byte(`
+begin;
+
+/*
+
+       ┌────────────────┐                 ┌──────────────────────┐             ┌────────────────────────┐
+       │  auth_method   │                 │   auth_oidc_method   │             │    auth_oidc_state     │
+       ├────────────────┤                 ├──────────────────────┤             ├────────────────────────┤
+       │ public_id (pk) │                 │ public_id (pk,fk)    │            ╱│ state          (pk)    │
+       │ scope_id  (fk) │┼┼─────────────○┼│ scope_id  (fk)       │┼┼─────────○─│ auth_method_id (fk)    │
+       │                │                 │ issuer               │            ╲│ nonce                  │
+       └────────────────┘                 │ client_id            │             │ code_verifier          │
+                ┼                         │ ...                  │             │ redirect_url           │
+                ┼                         └──────────────────────┘             │ expiration_time        │
+                │                                     ┼                        └────────────────────────┘
+                │ ▲fk1                                ┼
+                │                                     │ ▲fk1
+                ○                                     ○
+               ╱│╲                                   ╱│╲
+  ┌──────────────────────────┐          ┌──────────────────────────┐
+  │       auth_account       │          │    auth_oidc_account     │
+  ├──────────────────────────┤          ├──────────────────────────┤
+  │ public_id         (pk)   │          │ public_id      (pk,fk2)  │
+  │ scope_id          (fk1)  │   ◀fk2   │ scope_id       (fk1,fk2) │
+  │ auth_method_id    (fk1)  │┼┼──────○┼│ auth_method_id (fk1,fk2) │
+  │ iam_user_scope_id (fk2)  │          │ subject                  │
+  │ iam_user_id       (fk2)  │          │ ...                      │
+  └──────────────────────────┘          └──────────────────────────┘
+
+  An auth_oidc_method is an auth_method subtype and an auth_oidc_account is an
+  auth_account subtype, in the same way as the password subtypes.
+
+  An auth_oidc_account is identified by the subject of the ID tokens issued by
+  the provider. Accounts are usually created the first time a user
+  authenticates but can also be created ahead of time for a known subject.
+
+  An auth_oidc_state holds the state, nonce, and PKCE code verifier of an
+  authentication request which has been started but not yet completed. A
+  state is deleted when it is used to complete an authentication request or
+  after it has expired.
+
+*/
+
+  create table auth_oidc_method (
+    public_id wt_public_id
+      primary key,
+    scope_id wt_scope_id
+      not null,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    issuer text not null
+      constraint issuer_must_not_be_empty
+      check(length(trim(issuer)) > 0),
+    client_id text not null
+      constraint client_id_must_not_be_empty
+      check(length(trim(client_id)) > 0),
+    client_secret bytea not null
+      constraint client_secret_must_not_be_empty
+      check(length(client_secret) > 0),
+    -- TODO: Make key_id a foreign key once we have DEKs
+    key_id text not null
+      constraint key_id_must_not_be_empty
+      check(length(trim(key_id)) > 0),
+    claims_scopes text,
+    email_claim text not null default 'email'
+      constraint email_claim_must_not_be_empty
+      check(length(trim(email_claim)) > 0),
+    name_claim text not null default 'name'
+      constraint name_claim_must_not_be_empty
+      check(length(trim(name_claim)) > 0),
+    foreign key (scope_id, public_id)
+      references auth_method (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(scope_id, name),
+    unique(scope_id, public_id)
+  );
+
+  create trigger update_version_column after update on auth_oidc_method
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on auth_oidc_method
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_oidc_method
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_oidc_method
+    for each row execute procedure default_create_time();
+
+  create trigger insert_auth_method_subtype before insert on auth_oidc_method
+    for each row execute procedure insert_auth_method_subtype();
+
+  create table auth_oidc_account (
+    public_id wt_public_id
+      primary key,
+    auth_method_id wt_public_id
+      not null,
+    -- NOTE: The scope_id type is not wt_scope_id because the domain check is
+    -- executed before the insert trigger which retrieves the scope_id causing
+    -- an insert to fail.
+    scope_id text not null,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    subject text not null
+      constraint subject_must_not_be_empty
+      check(length(trim(subject)) > 0),
+    email text,
+    full_name text,
+    foreign key (scope_id, auth_method_id)
+      references auth_oidc_method (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    foreign key (scope_id, auth_method_id, public_id)
+      references auth_account (scope_id, auth_method_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(auth_method_id, name),
+    unique(auth_method_id, subject),
+    unique(auth_method_id, public_id)
+  );
+
+  create trigger update_version_column after update on auth_oidc_account
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on auth_oidc_account
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_oidc_account
+    for each row execute procedure immutable_columns('public_id', 'auth_method_id', 'scope_id', 'subject', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_oidc_account
+    for each row execute procedure default_create_time();
+
+  create trigger insert_auth_account_subtype before insert on auth_oidc_account
+    for each row execute procedure insert_auth_account_subtype();
+
+  create table auth_oidc_state (
+    state text
+      primary key
+      constraint state_must_not_be_empty
+      check(length(trim(state)) > 0),
+    auth_method_id wt_public_id not null
+      references auth_oidc_method (public_id)
+      on delete cascade
+      on update cascade,
+    nonce text not null
+      constraint nonce_must_not_be_empty
+      check(length(trim(nonce)) > 0),
+    code_verifier text not null
+      constraint code_verifier_must_not_be_empty
+      check(length(trim(code_verifier)) > 0),
+    redirect_url text not null
+      constraint redirect_url_must_not_be_empty
+      check(length(trim(redirect_url)) > 0),
+    create_time wt_timestamp,
+    expiration_time wt_timestamp
+      constraint expiration_time_must_be_after_create_time
+      check(expiration_time > create_time)
+  );
+
+  create trigger default_create_time_column before insert on auth_oidc_state
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_oidc_state
+    for each row execute procedure immutable_columns('state', 'auth_method_id', 'nonce', 'code_verifier', 'redirect_url', 'create_time', 'expiration_time');
+
+  -- The user dimension of the warehouse includes the names of oidc accounts
+  -- and auth methods.
+  create or replace view whx_user_dimension_source as
+       select -- id is the first column in the target view
+              u.public_id                                       as user_id,
+              coalesce(u.name, 'None')                          as user_name,
+              coalesce(u.description, 'None')                   as user_description,
+              coalesce(aa.public_id, 'None')                    as auth_account_id,
+              case when aa.public_id is null then 'None'
+                   when aoa.public_id is not null then 'oidc auth account'
+                   else 'password auth account'
+                   end                                          as auth_account_type,
+              coalesce(apa.name, aoa.name, 'None')              as auth_account_name,
+              coalesce(apa.description, aoa.description, 'None') as auth_account_description,
+              coalesce(am.public_id, 'None')                    as auth_method_id,
+              case when am.public_id is null then 'None'
+                   when aom.public_id is not null then 'oidc auth method'
+                   else 'password auth method'
+                   end                                          as auth_method_type,
+              coalesce(apm.name, aom.name, 'None')              as auth_method_name,
+              coalesce(apm.description, aom.description, 'None') as auth_method_description,
+              org.public_id                                     as user_organization_id,
+              coalesce(org.name, 'None')                        as user_organization_name,
+              coalesce(org.description, 'None')                 as user_organization_description
+         from iam_user as u
+    left join auth_account as aa on           u.public_id = aa.iam_user_id
+    left join auth_method as am on            aa.auth_method_id = am.public_id
+    left join auth_password_account as apa on aa.public_id = apa.public_id
+    left join auth_password_method as apm on  am.public_id = apm.public_id
+    left join auth_oidc_account as aoa on     aa.public_id = aoa.public_id
+    left join auth_oidc_method as aom on      am.public_id = aom.public_id
+         join iam_scope as org on             u.scope_id = org.public_id
+  ;
+
+  -- The tickets for oplog are the subtypes not the base types because no updates
+  -- are done to any values in the base types. auth_oidc_state is not written
+  -- to the oplog.
+  insert into oplog_ticket (name, version)
+  values
+    ('auth_oidc_method', 1),
+    ('auth_oidc_account', 1);
+
+commit;
+
+`),
+	},
+	"migrations/73_auth_ldap.down.sql": {
+		name: "73_auth_ldap.down.sql",
+		bytes: []byte(`
+begin;
+
+  -- whx_user_dimension_source must be restored before the ldap tables are
+  -- dropped, otherwise the cascade would drop the view.
+  create or replace view whx_user_dimension_source as
+       select -- id is the first column in the target view
+              u.public_id                                       as user_id,
+              coalesce(u.name, 'None')                          as user_name,
+              coalesce(u.description, 'None')                   as user_description,
+              coalesce(aa.public_id, 'None')                    as auth_account_id,
+              case when aa.public_id is null then 'None'
+                   when aoa.public_id is not null then 'oidc auth account'
+                   else 'password auth account'
+                   end                                          as auth_account_type,
+              coalesce(apa.name, aoa.name, 'None')              as auth_account_name,
+              coalesce(apa.description, aoa.description, 'None') as auth_account_description,
+              coalesce(am.public_id, 'None')                    as auth_method_id,
+              case when am.public_id is null then 'None'
+                   when aom.public_id is not null then 'oidc auth method'
+                   else 'password auth method'
+                   end                                          as auth_method_type,
+              coalesce(apm.name, aom.name, 'None')              as auth_method_name,
+              coalesce(apm.description, aom.description, 'None') as auth_method_description,
+              org.public_id                                     as user_organization_id,
+              coalesce(org.name, 'None')                        as user_organization_name,
+              coalesce(org.description, 'None')                 as user_organization_description
+         from iam_user as u
+    left join auth_account as aa on           u.public_id = aa.iam_user_id
+    left join auth_method as am on            aa.auth_method_id = am.public_id
+    left join auth_password_account as apa on aa.public_id = apa.public_id
+    left join auth_password_method as apm on  am.public_id = apm.public_id
+    left join auth_oidc_account as aoa on     aa.public_id = aoa.public_id
+    left join auth_oidc_method as aom on      am.public_id = aom.public_id
+         join iam_scope as org on             u.scope_id = org.public_id
+  ;
+
+  drop table auth_ldap_group_mapping cascade;
+  drop table auth_ldap_account cascade;
+  drop table auth_ldap_method cascade;
+
+  delete
+    from oplog_ticket
+   where name in (
+          'auth_ldap_method',
+          'auth_ldap_account'
+        );
+
+commit;
+
+`),
+	},
+	"migrations/73_auth_ldap.up.sql": {
+		name: "73_auth_ldap.up.sql",
+		bytes: []byte(`
+begin;
+
+/*
+
+       ┌────────────────┐                 ┌──────────────────────┐             ┌────────────────────────┐
+       │  auth_method   │                 │   auth_ldap_method   │             │ auth_ldap_group_mapping│
+       ├────────────────┤                 ├──────────────────────┤             ├────────────────────────┤
+       │ public_id (pk) │                 │ public_id (pk,fk)    │            ╱│ auth_method_id (pk,fk1)│
+       │ scope_id  (fk) │┼┼─────────────○┼│ scope_id  (fk)       │┼┼─────────○─│ group_name     (pk)    │
+       │                │                 │ urls                 │            ╲│ group_id       (pk,fk2)│
+       └────────────────┘                 │ user_dn              │             │ scope_id   (fk1,fk2)   │
+                ┼                         │ ...                  │             └────────────────────────┘
+                ┼                         └──────────────────────┘                         ╲│╱
+                │                                     ┼                                     ○
+                │ ▲fk1                                ┼                                     │ ▼fk2
+                │                                     │ ▲fk1                                ┼
+                ○                                     ○                                     ┼
+               ╱│╲                                   ╱│╲                        ┌────────────────────────┐
+  ┌──────────────────────────┐          ┌──────────────────────────┐          │       iam_group        │
+  │       auth_account       │          │    auth_ldap_account     │          ├────────────────────────┤
+  ├──────────────────────────┤          ├──────────────────────────┤          │ public_id (pk)         │
+  │ public_id         (pk)   │          │ public_id      (pk,fk2)  │          │ scope_id  (fk)         │
+  │ scope_id          (fk1)  │   ◀fk2   │ scope_id       (fk1,fk2) │          └────────────────────────┘
+  │ auth_method_id    (fk1)  │┼┼──────○┼│ auth_method_id (fk1,fk2) │
+  │ iam_user_scope_id (fk2)  │          │ login_name               │
+  │ iam_user_id       (fk2)  │          │ ...                      │
+  └──────────────────────────┘          └──────────────────────────┘
+
+  An auth_ldap_method is an auth_method subtype and an auth_ldap_account is an
+  auth_account subtype, in the same way as the password subtypes.
+
+  An auth_ldap_account is identified by the login name the user authenticates
+  with. Accounts are usually created the first time a user authenticates but
+  can also be created ahead of time for a known login name.
+
+  An auth_ldap_group_mapping maps a directory group onto an iam_group in the
+  scope of the auth method. When an account authenticates, the user of the
+  account is added to the iam groups mapped from the directory groups the
+  account is a member of and removed from the other iam groups mapped by the
+  auth method.
+
+*/
+
+  create table auth_ldap_method (
+    public_id wt_public_id
+      primary key,
+    scope_id wt_scope_id
+      not null,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    urls text not null
+      constraint urls_must_not_be_empty
+      check(length(trim(urls)) > 0),
+    start_tls boolean not null default false,
+    insecure_tls boolean not null default false,
+    certificate text
+      constraint certificate_must_not_be_empty
+      check(length(trim(certificate)) > 0),
+    bind_dn text
+      constraint bind_dn_must_not_be_empty
+      check(length(trim(bind_dn)) > 0),
+    bind_password bytea
+      constraint bind_password_must_not_be_empty
+      check(length(bind_password) > 0),
+    -- TODO: Make key_id a foreign key once we have DEKs
+    key_id text
+      constraint key_id_must_not_be_empty
+      check(length(trim(key_id)) > 0),
+    discover_dn boolean not null default false,
+    user_dn text not null
+      constraint user_dn_must_not_be_empty
+      check(length(trim(user_dn)) > 0),
+    user_attr text not null default 'uid'
+      constraint user_attr_must_not_be_empty
+      check(length(trim(user_attr)) > 0),
+    group_dn text
+      constraint group_dn_must_not_be_empty
+      check(length(trim(group_dn)) > 0),
+    group_attr text not null default 'cn'
+      constraint group_attr_must_not_be_empty
+      check(length(trim(group_attr)) > 0),
+    group_filter text not null default '(|(memberUid={{.Username}})(member={{.UserDN}})(uniqueMember={{.UserDN}}))'
+      constraint group_filter_must_not_be_empty
+      check(length(trim(group_filter)) > 0),
+    email_attr text not null default 'mail'
+      constraint email_attr_must_not_be_empty
+      check(length(trim(email_attr)) > 0),
+    name_attr text not null default 'cn'
+      constraint name_attr_must_not_be_empty
+      check(length(trim(name_attr)) > 0),
+    constraint bind_password_requires_key_id
+      check((bind_password is null) = (key_id is null)),
+    foreign key (scope_id, public_id)
+      references auth_method (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(scope_id, name),
+    unique(scope_id, public_id)
+  );
+
+  create trigger update_version_column after update on auth_ldap_method
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on auth_ldap_method
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_ldap_method
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_ldap_method
+    for each row execute procedure default_create_time();
+
+  create trigger insert_auth_method_subtype before insert on auth_ldap_method
+    for each row execute procedure insert_auth_method_subtype();
+
+  create table auth_ldap_account (
+    public_id wt_public_id
+      primary key,
+    auth_method_id wt_public_id
+      not null,
+    -- NOTE: The scope_id type is not wt_scope_id because the domain check is
+    -- executed before the insert trigger which retrieves the scope_id causing
+    -- an insert to fail.
+    scope_id text not null,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    login_name text not null
+      constraint login_name_must_be_lowercase
+      check(lower(trim(login_name)) = login_name)
+      constraint login_name_must_not_be_empty
+      check(length(trim(login_name)) > 0),
+    dn text,
+    email text,
+    full_name text,
+    foreign key (scope_id, auth_method_id)
+      references auth_ldap_method (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    foreign key (scope_id, auth_method_id, public_id)
+      references auth_account (scope_id, auth_method_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(auth_method_id, name),
+    unique(auth_method_id, login_name),
+    unique(auth_method_id, public_id)
+  );
+
+  create trigger update_version_column after update on auth_ldap_account
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on auth_ldap_account
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_ldap_account
+    for each row execute procedure immutable_columns('public_id', 'auth_method_id', 'scope_id', 'login_name', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_ldap_account
+    for each row execute procedure default_create_time();
+
+  create trigger insert_auth_account_subtype before insert on auth_ldap_account
+    for each row execute procedure insert_auth_account_subtype();
+
+  create table auth_ldap_group_mapping (
+    auth_method_id wt_public_id not null,
+    group_name text not null
+      constraint group_name_must_not_be_empty
+      check(length(trim(group_name)) > 0),
+    group_id wt_public_id not null,
+    scope_id wt_scope_id not null,
+    create_time wt_timestamp,
+    primary key(auth_method_id, group_name, group_id),
+    -- The iam group must be in the scope of the auth method.
+    foreign key (scope_id, auth_method_id)
+      references auth_ldap_method (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    foreign key (scope_id, group_id)
+      references iam_group (scope_id, public_id)
+      on delete cascade
+      on update cascade
+  );
+
+  create trigger default_create_time_column before insert on auth_ldap_group_mapping
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_ldap_group_mapping
+    for each row execute procedure immutable_columns('auth_method_id', 'group_name', 'group_id', 'scope_id', 'create_time');
+
+  -- The user dimension of the warehouse includes the names of ldap accounts
+  -- and auth methods.
+  create or replace view whx_user_dimension_source as
+       select -- id is the first column in the target view
+              u.public_id                                       as user_id,
+              coalesce(u.name, 'None')                          as user_name,
+              coalesce(u.description, 'None')                   as user_description,
+              coalesce(aa.public_id, 'None')                    as auth_account_id,
+              case when aa.public_id is null then 'None'
+                   when aoa.public_id is not null then 'oidc auth account'
+                   when ala.public_id is not null then 'ldap auth account'
+                   else 'password auth account'
+                   end                                          as auth_account_type,
+              coalesce(apa.name, aoa.name, ala.name, 'None')    as auth_account_name,
+              coalesce(apa.description, aoa.description, ala.description, 'None') as auth_account_description,
+              coalesce(am.public_id, 'None')                    as auth_method_id,
+              case when am.public_id is null then 'None'
+                   when aom.public_id is not null then 'oidc auth method'
+                   when alm.public_id is not null then 'ldap auth method'
+                   else 'password auth method'
+                   end                                          as auth_method_type,
+              coalesce(apm.name, aom.name, alm.name, 'None')    as auth_method_name,
+              coalesce(apm.description, aom.description, alm.description, 'None') as auth_method_description,
+              org.public_id                                     as user_organization_id,
+              coalesce(org.name, 'None')                        as user_organization_name,
+              coalesce(org.description, 'None')                 as user_organization_description
+         from iam_user as u
+    left join auth_account as aa on           u.public_id = aa.iam_user_id
+    left join auth_method as am on            aa.auth_method_id = am.public_id
+    left join auth_password_account as apa on aa.public_id = apa.public_id
+    left join auth_password_method as apm on  am.public_id = apm.public_id
+    left join auth_oidc_account as aoa on     aa.public_id = aoa.public_id
+    left join auth_oidc_method as aom on      am.public_id = aom.public_id
+    left join auth_ldap_account as ala on     aa.public_id = ala.public_id
+    left join auth_ldap_method as alm on      am.public_id = alm.public_id
+         join iam_scope as org on             u.scope_id = org.public_id
+  ;
+
+  -- The tickets for oplog are the subtypes not the base types because no updates
+  -- are done to any values in the base types. Changes to the group mappings
+  -- of an auth method are written with the ticket of the auth method.
+  insert into oplog_ticket (name, version)
+  values
+    ('auth_ldap_method', 1),
+    ('auth_ldap_account', 1);
+
+commit;
+
+`),
+	},
+	"migrations/74_credential_store.down.sql": {
+		name: "74_credential_store.down.sql",
+		bytes: []byte(`
+begin;
+
+  drop view target_all_subtypes;
+
+  alter table target_tcp
+    drop column credential_injection;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp;
+
+  drop table target_credential;
+  drop table credential;
+  drop table credential_store;
+  drop table kms_credential_key_version;
+  drop table kms_credential_key;
+
+  drop function target_credential_scope_valid;
+  drop function credential_store_scope_valid;
+
+  delete
+    from oplog_ticket
+   where name in (
+          'credential_store',
+          'credential'
+        );
+
+commit;
+
+`),
+	},
+	"migrations/74_credential_store.up.sql": {
+		name: "74_credential_store.up.sql",
+		bytes: []byte(`
+begin;
+
+/*
+
+  ┌──────────────────┐            ┌──────────────────┐            ┌───────────────────┐
+  │ credential_store │            │    credential    │            │ target_credential │
+  ├──────────────────┤            ├──────────────────┤            ├───────────────────┤
+  │ public_id  (pk)  │           ╱│ public_id  (pk)  │           ╱│ target_id     (pk)│
+  │ scope_id   (fk)  │┼┼───────○─│ store_id   (fk)  │┼┼───────○─│ credential_id (pk)│
+  │ name             │           ╲│ username         │           ╲│                   │
+  └──────────────────┘            │ password         │            └───────────────────┘
+                                  │ key_id           │                      ╲│╱
+                                  └──────────────────┘                       ○
+                                                                             │
+                                                                             ┼
+                                                                   ┌──────────────────┐
+                                                                   │      target      │
+                                                                   └──────────────────┘
+
+  A credential_store is a container for credentials in a project. A
+  credential holds a username and a password. The password is encrypted with
+  the credentials key of the scope of its store, a new kms key purpose with
+  its own DEK (kms_credential_key).
+
+  Credentials are attached to targets in the same scope through
+  target_credential. When a session is authorized for a target, the
+  credentials of the target are either returned to the user (brokered) or,
+  if the target has a credential_injection protocol, handed to the worker
+  which authenticates to the endpoint with them.
+
+*/
+
+  create table kms_credential_key (
+    private_id wt_private_id primary key,
+    root_key_id wt_private_id not null unique -- there can be only one credential dek per root key
+      references kms_root_key(private_id)
+      on delete cascade
+      on update cascade,
+    create_time wt_timestamp
+  );
+
+  -- define the immutable fields for kms_credential_key (all of them)
+  create trigger
+    immutable_columns
+  before
+  update on kms_credential_key
+    for each row execute procedure immutable_columns('private_id', 'root_key_id', 'create_time');
+
+  create trigger
+    default_create_time_column
+  before
+  insert on kms_credential_key
+    for each row execute procedure default_create_time();
+
+  create table kms_credential_key_version (
+    private_id wt_private_id primary key,
+    credential_key_id wt_private_id not null
+      references kms_credential_key(private_id)
+      on delete cascade
+      on update cascade,
+    root_key_version_id wt_private_id not null
+      references kms_root_key_version(private_id)
+      on delete cascade
+      on update cascade,
+    version wt_version,
+    key bytea not null,
+    create_time wt_timestamp,
+    unique(credential_key_id, version)
+  );
+
+  -- define the immutable fields for kms_credential_key_version (all of them)
+  create trigger
+    immutable_columns
+  before
+  update on kms_credential_key_version
+    for each row execute procedure immutable_columns('private_id', 'credential_key_id', 'root_key_version_id', 'version', 'key', 'create_time');
+
+  create trigger
+    default_create_time_column
+  before
+  insert on kms_credential_key_version
+    for each row execute procedure default_create_time();
+
+  create trigger
+    kms_version_column
+  before insert on kms_credential_key_version
+    for each row execute procedure kms_version_column('credential_key_id');
+
+  -- credential_store_scope_valid() is a before insert trigger function for
+  -- credential_store
+  create or replace function
+    credential_store_scope_valid()
+    returns trigger
+  as $$
+  declare scope_type text;
+  begin
+    -- Fetch the type of scope
+    select isc.type from iam_scope isc where isc.public_id = new.scope_id into scope_type;
+    if scope_type = 'project' then
+      return new;
+    end if;
+    raise exception 'invalid credential store scope type % (must be project)', scope_type;
+  end;
+  $$ language plpgsql;
+
+  create table credential_store (
+    public_id wt_public_id
+      primary key,
+    scope_id wt_scope_id
+      not null
+      references iam_scope (public_id)
+      on delete cascade
+      on update cascade,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    unique(scope_id, name),
+    unique(scope_id, public_id)
+  );
+
+  create trigger update_version_column after update on credential_store
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_store
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on credential_store
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger default_create_time_column before insert on credential_store
+    for each row execute procedure default_create_time();
+
+  create trigger credential_store_scope_valid before insert on credential_store
+    for each row execute procedure credential_store_scope_valid();
+
+  create table credential (
+    public_id wt_public_id
+      primary key,
+    store_id wt_public_id
+      not null
+      references credential_store (public_id)
+      on delete cascade
+      on update cascade,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    username text not null
+      constraint username_must_not_be_empty
+      check(length(trim(username)) > 0),
+    password bytea not null
+      constraint password_must_not_be_empty
+      check(length(password) > 0),
+    key_id text not null
+      references kms_credential_key_version (private_id)
+      on delete restrict
+      on update cascade,
+    unique(store_id, name)
+  );
+
+  create trigger update_version_column after update on credential
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on credential
+    for each row execute procedure immutable_columns('public_id', 'store_id', 'create_time');
+
+  create trigger default_create_time_column before insert on credential
+    for each row execute procedure default_create_time();
+
+  -- target_credential_scope_valid() is a before insert trigger function for
+  -- target_credential
+  create or replace function
+    target_credential_scope_valid()
+    returns trigger
+  as $$
+  begin
+    perform from
+      credential c,
+      credential_store cs,
+      target t
+    where
+      c.public_id = new.credential_id and
+      c.store_id = cs.public_id and
+      cs.scope_id = t.scope_id and
+      t.public_id = new.target_id;
+    if not found then
+      raise exception 'target scope and credential scope are not equal';
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create table target_credential (
+    target_id wt_public_id
+      references target (public_id)
+      on delete cascade
+      on update cascade,
+    credential_id wt_public_id
+      references credential (public_id)
+      on delete cascade
+      on update cascade,
+    primary key(target_id, credential_id),
+    create_time wt_timestamp
+  );
+
+  create trigger immutable_columns before update on target_credential
+    for each row execute procedure immutable_columns('target_id', 'credential_id', 'create_time');
+
+  create trigger default_create_time_column before insert on target_credential
+    for each row execute procedure default_create_time();
+
+  create trigger target_credential_scope_valid before insert on target_credential
+    for each row execute procedure target_credential_scope_valid();
+
+  -- credential_injection is the protocol the worker uses to authenticate to
+  -- the endpoint with the credentials of the target. If it is null the
+  -- credentials are returned to the user when a session is authorized.
+  alter table target_tcp
+    add column credential_injection text
+      constraint credential_injection_must_be_known
+      check(credential_injection in ('postgres'));
+
+  drop view target_all_subtypes;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    credential_injection,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp;
+
+  insert into oplog_ticket (name, version)
+  values
+    ('credential_store', 1),
+    ('credential', 1);
+
+commit;
+
+`),
+	},
+	"migrations/75_worker_tags.down.sql": {
+		name: "75_worker_tags.down.sql",
+		bytes: []byte(`
+begin;
+
+  drop view target_all_subtypes;
+
+  alter table target_tcp
+    drop column worker_filter;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    credential_injection,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp;
+
+  drop table server_tag;
+
+commit;
+
+`),
+	},
+	"migrations/75_worker_tags.up.sql": {
+		name: "75_worker_tags.up.sql",
+		bytes: []byte(`
+begin;
+
+  -- server_tag holds the tags a worker reports in its status updates. The
+  -- tags of a server are replaced on every update.
+  create table server_tag (
+    server_id text not null,
+    server_type text not null,
+    key text not null
+      constraint key_must_not_be_empty
+      check(length(trim(key)) > 0),
+    value text not null,
+    primary key (server_id, server_type, key, value),
+    foreign key (server_id, server_type)
+      references server (private_id, type)
+      on delete cascade
+      on update cascade
+  );
+
+  -- worker_filter is a boolean expression over worker tags. If it is set only
+  -- the workers matching it are given to the user for sessions of the
+  -- target.
+  alter table target_tcp
+    add column worker_filter text
+      constraint worker_filter_must_not_be_empty
+      check(length(trim(worker_filter)) > 0);
+
+  drop view target_all_subtypes;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    credential_injection,
+    worker_filter,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp;
+
+commit;
+
+`),
+	},
+	"migrations/76_worker_upstream.down.sql": {
+		name: "76_worker_upstream.down.sql",
+		bytes: []byte(`
+begin;
+
+  alter table server
+    drop column upstream;
+
+commit;
+
+`),
+	},
+	"migrations/76_worker_upstream.up.sql": {
+		name: "76_worker_upstream.up.sql",
+		bytes: []byte(`
+begin;
+
+  -- upstream is the name of the worker a downstream worker is connected
+  -- through. Clients reach downstream workers through their upstream.
+  alter table server
+    add column upstream text
+      constraint upstream_must_not_be_empty
+      check(length(trim(upstream)) > 0);
+
+commit;
+
+`),
+	},
+	"migrations/77_worker_dead_closed_reason.down.sql": {
+		name: "77_worker_dead_closed_reason.down.sql",
+		bytes: []byte(`
+begin;
+
+  delete from session_connection_closed_reason_enm
+    where name = 'worker dead';
+
+  alter table session_connection_closed_reason_enm
+    drop constraint only_predefined_session_connection_closed_reasons_allowed;
+
+  alter table session_connection_closed_reason_enm
+    add constraint only_predefined_session_connection_closed_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'canceled',
+          'network error',
+          'system error'
+        )
+      );
+
+commit;
+
+`),
+	},
+	"migrations/77_worker_dead_closed_reason.up.sql": {
+		name: "77_worker_dead_closed_reason.up.sql",
+		bytes: []byte(`
+begin;
+
+  -- 'worker dead' is the reason for connections closed by a controller
+  -- because the worker proxying them stopped reporting its status.
+  alter table session_connection_closed_reason_enm
+    drop constraint only_predefined_session_connection_closed_reasons_allowed;
+
+  alter table session_connection_closed_reason_enm
+    add constraint only_predefined_session_connection_closed_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'canceled',
+          'network error',
+          'system error',
+          'worker dead'
+        )
+      );
+
+  insert into session_connection_closed_reason_enm (name)
+  values
+    ('worker dead');
+
+commit;
+
+`),
+	},
+	"migrations/78_worker_draining.down.sql": {
+		name: "78_worker_draining.down.sql",
+		bytes: []byte(`
+begin;
+
+  alter table server
+    drop column draining;
+
+commit;
+
+`),
+	},
+	"migrations/78_worker_draining.up.sql": {
+		name: "78_worker_draining.up.sql",
+		bytes: []byte(`
+begin;
+
+  -- draining is set by workers that are draining their connections, which
+  -- are no longer offered to clients.
+  alter table server
+    add column draining boolean not null default false;
+
+commit;
+
+`),
+	},
+	"migrations/79_worker_connection_count.down.sql": {
+		name: "79_worker_connection_count.down.sql",
+		bytes: []byte(`
+begin;
+
+  alter table server
+    drop column active_connection_count;
+
+commit;
+
+`),
+	},
+	"migrations/79_worker_connection_count.up.sql": {
+		name: "79_worker_connection_count.up.sql",
+		bytes: []byte(`
+begin;
+
+  -- active_connection_count is the number of connections a worker reported
+  -- proxying in its last status, used to spread sessions across workers.
+  alter table server
+    add column active_connection_count integer not null default 0
+      constraint active_connection_count_must_not_be_negative
+      check(active_connection_count >= 0);
+
+commit;
+
+`),
+	},
+	"migrations/80_session_bandwidth_idle_timeout.down.sql": {
+		name: "80_session_bandwidth_idle_timeout.down.sql",
+		bytes: []byte(`
+begin;
+
+  delete from session_connection_closed_reason_enm
+    where name = 'idle timeout';
+
+  alter table session_connection_closed_reason_enm
+    drop constraint only_predefined_session_connection_closed_reasons_allowed;
+
+  alter table session_connection_closed_reason_enm
+    add constraint only_predefined_session_connection_closed_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'canceled',
+          'network error',
+          'system error',
+          'worker dead'
+        )
+      );
+
+  drop view target_all_subtypes;
+
+  alter table target_tcp
+    drop column connection_idle_timeout_seconds,
+    drop column session_max_bytes_per_second;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    credential_injection,
+    worker_filter,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp;
+
+commit;
+
+`),
+	},
+	"migrations/80_session_bandwidth_idle_timeout.up.sql": {
+		name: "80_session_bandwidth_idle_timeout.up.sql",
+		bytes: []byte(`
+begin;
+
+  -- session_max_bytes_per_second limits the bytes per second a worker proxies
+  -- in each direction for a session of the target. 0 means unlimited.
+  alter table target_tcp
+    add column session_max_bytes_per_second integer not null default 0
+      constraint session_max_bytes_per_second_must_not_be_negative
+      check(session_max_bytes_per_second >= 0);
+
+  -- connection_idle_timeout_seconds is the number of seconds without data in
+  -- either direction after which a worker closes a connection. 0 means
+  -- connections are never closed for being idle.
+  alter table target_tcp
+    add column connection_idle_timeout_seconds integer not null default 0
+      constraint connection_idle_timeout_seconds_must_not_be_negative
+      check(connection_idle_timeout_seconds >= 0);
+
+  drop view target_all_subtypes;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    credential_injection,
+    worker_filter,
+    session_max_bytes_per_second,
+    connection_idle_timeout_seconds,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp;
+
+  -- 'idle timeout' is the reason for connections closed by a worker because
+  -- no data was proxied for longer than the idle timeout of the target.
+  alter table session_connection_closed_reason_enm
+    drop constraint only_predefined_session_connection_closed_reasons_allowed;
+
+  alter table session_connection_closed_reason_enm
+    add constraint only_predefined_session_connection_closed_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'canceled',
+          'network error',
+          'system error',
+          'worker dead',
+          'idle timeout'
+        )
+      );
+
+  insert into session_connection_closed_reason_enm (name)
+  values
+    ('idle timeout');
+
+commit;
+
+`),
+	},
+	"migrations/81_udp_target.down.sql": {
+		name: "81_udp_target.down.sql",
+		bytes: []byte(`
+begin;
+
+  delete from oplog_ticket where name = 'target_udp';
+
+  create or replace view whx_host_dimension_source as
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'static host'                   as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         coalesce(h.description, 'None') as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'static host set'               as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'static host catalog'           as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from static_host as h,
+         static_host_catalog as c,
+         static_host_set_member as m,
+         static_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+   union all
+  select h.public_id                     as host_id,
+         'dynamic host'                  as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         'None'                          as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'dynamic host set'              as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'dynamic host catalog'          as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from dynamic_host as h,
+         dynamic_host_catalog as c,
+         dynamic_host_set_member as m,
+         dynamic_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  ;
+
+  drop view target_all_subtypes;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    credential_injection,
+    worker_filter,
+    session_max_bytes_per_second,
+    connection_idle_timeout_seconds,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp;
+
+  drop table target_udp;
+
+commit;
+
+`),
+	},
+	"migrations/81_udp_target.up.sql": {
+		name: "81_udp_target.up.sql",
+		bytes: []byte(`
+begin;
+
+  -- target_udp is the udp subtype of target. Each flow of datagrams from a
+  -- client address is proxied over its own session connection.
+  create table target_udp (
+    public_id wt_public_id primary key
+      references target(public_id)
+      on delete cascade
+      on update cascade,
+    scope_id wt_scope_id not null
+      references iam_scope(public_id)
+      on delete cascade
+      on update cascade,
+    name text not null, -- name is not optional for a target subtype
+    description text,
+    default_port int, -- default_port can be null
+    -- max duration of the session in seconds.
+    -- default is 8 hours
+    session_max_seconds int not null default 28800
+      constraint session_max_seconds_must_be_greater_than_0
+      check(session_max_seconds > 0),
+    -- limit on number of session connections allowed. -1 equals no limit
+    session_connection_limit int not null default 1
+      constraint session_connection_limit_must_be_greater_than_0_or_negative_1
+      check(session_connection_limit > 0 or session_connection_limit = -1),
+    worker_filter text
+      constraint worker_filter_must_not_be_empty
+      check(length(trim(worker_filter)) > 0),
+    session_max_bytes_per_second integer not null default 0
+      constraint session_max_bytes_per_second_must_not_be_negative
+      check(session_max_bytes_per_second >= 0),
+    -- 0 means flows are closed after the default idle timeout of the worker
+    connection_idle_timeout_seconds integer not null default 0
+      constraint connection_idle_timeout_seconds_must_not_be_negative
+      check(connection_idle_timeout_seconds >= 0),
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    unique(scope_id, name) -- name must be unique within a scope
+  );
+
+  create trigger insert_target_subtype before insert on target_udp
+    for each row execute procedure insert_target_subtype();
+
+  create trigger delete_target_subtype after delete on target_udp
+    for each row execute procedure delete_target_subtype();
+
+  create trigger immutable_columns before update on target_udp
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger update_version_column after update on target_udp
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on target_udp
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on target_udp
+    for each row execute procedure default_create_time();
+
+  create trigger target_scope_valid before insert on target_udp
+    for each row execute procedure target_scope_valid();
+
+  drop view target_all_subtypes;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    credential_injection,
+    worker_filter,
+    session_max_bytes_per_second,
+    connection_idle_timeout_seconds,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp
+  union all
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    false as record_sessions,
+    null as credential_injection,
+    worker_filter,
+    session_max_bytes_per_second,
+    connection_idle_timeout_seconds,
+    version,
+    create_time,
+    update_time,
+    'udp' as type
+    from target_udp;
+
+  -- whx_host_dimension_source is replaced to include udp targets so that
+  -- sessions to them are recorded in the warehouse.
+  create or replace view whx_host_dimension_source as
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'static host'                   as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         coalesce(h.description, 'None') as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'static host set'               as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'static host catalog'           as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         t.type                          as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from static_host as h,
+         static_host_catalog as c,
+         static_host_set_member as m,
+         static_host_set as s,
+         target_host_set as ts,
+         (select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'tcp target' as type
+            from target_tcp
+          union all
+          select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'udp target' as type
+            from target_udp) as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+   union all
+  select h.public_id                     as host_id,
+         'dynamic host'                  as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         'None'                          as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'dynamic host set'              as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'dynamic host catalog'          as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         t.type                          as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from dynamic_host as h,
+         dynamic_host_catalog as c,
+         dynamic_host_set_member as m,
+         dynamic_host_set as s,
+         target_host_set as ts,
+         (select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'tcp target' as type
+            from target_tcp
+          union all
+          select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'udp target' as type
+            from target_udp) as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  ;
+
+  insert into oplog_ticket (name, version)
+  values
+    ('target_udp', 1);
+
+commit;
+
+`),
+	},
+	"migrations/82_http_target.down.sql": {
+		name: "82_http_target.down.sql",
+		bytes: []byte(`
+begin;
+
+  delete from oplog_ticket where name = 'target_http';
+
+  drop view target_all_subtypes;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    credential_injection,
+    worker_filter,
+    session_max_bytes_per_second,
+    connection_idle_timeout_seconds,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp
+  union all
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    false as record_sessions,
+    null as credential_injection,
+    worker_filter,
+    session_max_bytes_per_second,
+    connection_idle_timeout_seconds,
+    version,
+    create_time,
+    update_time,
+    'udp' as type
+    from target_udp;
+
+  create or replace view whx_host_dimension_source as
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'static host'                   as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         coalesce(h.description, 'None') as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'static host set'               as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'static host catalog'           as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         t.type                          as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from static_host as h,
+         static_host_catalog as c,
+         static_host_set_member as m,
+         static_host_set as s,
+         target_host_set as ts,
+         (select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'tcp target' as type
+            from target_tcp
+          union all
+          select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'udp target' as type
+            from target_udp) as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+   union all
+  select h.public_id                     as host_id,
+         'dynamic host'                  as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         'None'                          as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'dynamic host set'              as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'dynamic host catalog'          as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         t.type                          as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from dynamic_host as h,
+         dynamic_host_catalog as c,
+         dynamic_host_set_member as m,
+         dynamic_host_set as s,
+         target_host_set as ts,
+         (select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'tcp target' as type
+            from target_tcp
+          union all
+          select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'udp target' as type
+            from target_udp) as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  ;
+
+  drop table target_http;
+
+commit;
+
+`),
+	},
+	"migrations/82_http_target.up.sql": {
+		name: "82_http_target.up.sql",
+		bytes: []byte(`
+begin;
+
+  -- target_http is the http subtype of target. Workers proxy the connections
+  -- of its sessions as http requests to the endpoint, only letting through
+  -- the allowed methods and paths and adding the injected headers.
+  create table target_http (
+    public_id wt_public_id primary key
+      references target(public_id)
+      on delete cascade
+      on update cascade,
+    scope_id wt_scope_id not null
+      references iam_scope(public_id)
+      on delete cascade
+      on update cascade,
+    name text not null, -- name is not optional for a target subtype
+    description text,
+    default_port int, -- default_port can be null
+    -- max duration of the session in seconds.
+    -- default is 8 hours
+    session_max_seconds int not null default 28800
+      constraint session_max_seconds_must_be_greater_than_0
+      check(session_max_seconds > 0),
+    -- limit on number of session connections allowed. -1 equals no limit
+    session_connection_limit int not null default 1
+      constraint session_connection_limit_must_be_greater_than_0_or_negative_1
+      check(session_connection_limit > 0 or session_connection_limit = -1),
+    worker_filter text
+      constraint worker_filter_must_not_be_empty
+      check(length(trim(worker_filter)) > 0),
+    session_max_bytes_per_second integer not null default 0
+      constraint session_max_bytes_per_second_must_not_be_negative
+      check(session_max_bytes_per_second >= 0),
+    connection_idle_timeout_seconds integer not null default 0
+      constraint connection_idle_timeout_seconds_must_not_be_negative
+      check(connection_idle_timeout_seconds >= 0),
+    -- use_tls is true if the endpoint is reached over https
+    use_tls boolean not null default false,
+    -- allowed_methods is a space separated list of the request methods let
+    -- through. If null, all methods are.
+    allowed_methods text
+      constraint allowed_methods_must_not_be_empty
+      check(length(trim(allowed_methods)) > 0),
+    -- allowed_path_prefixes is a space separated list of the path prefixes of
+    -- the requests let through. If null, all paths are.
+    allowed_path_prefixes text
+      constraint allowed_path_prefixes_must_not_be_empty
+      check(length(trim(allowed_path_prefixes)) > 0),
+    -- injected_headers is a newline separated list of "Name: value" headers
+    -- set on every request.
+    injected_headers text
+      constraint injected_headers_must_not_be_empty
+      check(length(trim(injected_headers)) > 0),
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    unique(scope_id, name) -- name must be unique within a scope
+  );
+
+  create trigger insert_target_subtype before insert on target_http
+    for each row execute procedure insert_target_subtype();
+
+  create trigger delete_target_subtype after delete on target_http
+    for each row execute procedure delete_target_subtype();
+
+  create trigger immutable_columns before update on target_http
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger update_version_column after update on target_http
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on target_http
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on target_http
+    for each row execute procedure default_create_time();
+
+  create trigger target_scope_valid before insert on target_http
+    for each row execute procedure target_scope_valid();
+
+  drop view target_all_subtypes;
+
+  -- target_all_subtypes is a union of all target subtypes. The columns only
+  -- target_http has are false or null for the other subtypes.
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    credential_injection,
+    worker_filter,
+    session_max_bytes_per_second,
+    connection_idle_timeout_seconds,
+    false as use_tls,
+    null::text as allowed_methods,
+    null::text as allowed_path_prefixes,
+    null::text as injected_headers,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp
+  union all
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    false as record_sessions,
+    null as credential_injection,
+    worker_filter,
+    session_max_bytes_per_second,
+    connection_idle_timeout_seconds,
+    false as use_tls,
+    null::text as allowed_methods,
+    null::text as allowed_path_prefixes,
+    null::text as injected_headers,
+    version,
+    create_time,
+    update_time,
+    'udp' as type
+    from target_udp
+  union all
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    false as record_sessions,
+    null as credential_injection,
+    worker_filter,
+    session_max_bytes_per_second,
+    connection_idle_timeout_seconds,
+    use_tls,
+    allowed_methods,
+    allowed_path_prefixes,
+    injected_headers,
+    version,
+    create_time,
+    update_time,
+    'http' as type
+    from target_http;
+
+  -- whx_host_dimension_source is replaced to include http targets so that
+  -- sessions to them are recorded in the warehouse.
+  create or replace view whx_host_dimension_source as
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'static host'                   as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         coalesce(h.description, 'None') as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'static host set'               as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'static host catalog'           as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         t.type                          as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from static_host as h,
+         static_host_catalog as c,
+         static_host_set_member as m,
+         static_host_set as s,
+         target_host_set as ts,
+         (select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'tcp target' as type
+            from target_tcp
+          union all
+          select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'udp target' as type
+            from target_udp
+          union all
+          select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'http target' as type
+            from target_http) as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+   union all
+  select h.public_id                     as host_id,
+         'dynamic host'                  as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         'None'                          as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'dynamic host set'              as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'dynamic host catalog'          as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         t.type                          as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from dynamic_host as h,
+         dynamic_host_catalog as c,
+         dynamic_host_set_member as m,
+         dynamic_host_set as s,
+         target_host_set as ts,
+         (select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'tcp target' as type
+            from target_tcp
+          union all
+          select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'udp target' as type
+            from target_udp
+          union all
+          select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'http target' as type
+            from target_http) as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  ;
+
+  insert into oplog_ticket (name, version)
+  values
+    ('target_http', 1);
+
+commit;
+
+`),
+	},
+	"migrations/83_session_list_pagination.down.sql": {
+		name: "83_session_list_pagination.down.sql",
+		bytes: []byte(`
+begin;
+
+  drop index session_scope_id_create_time_public_id_ix;
+  drop index session_create_time_public_id_ix;
+
+commit;
+
+`),
+	},
+	"migrations/83_session_list_pagination.up.sql": {
+		name: "83_session_list_pagination.up.sql",
+		bytes: []byte(`
+begin;
+
+  -- Sessions are listed a page at a time in order of create time and public
+  -- id, within a scope or across all scopes. These indexes keep listing a
+  -- page of a large session table from scanning and sorting all of it.
+  create index session_create_time_public_id_ix
+    on session (create_time, public_id);
+  create index session_scope_id_create_time_public_id_ix
+    on session (scope_id, create_time, public_id);
+
+commit;
+
+`),
+	},
+	"migrations/84_event_watch.down.sql": {
+		name: "84_event_watch.down.sql",
+		bytes: []byte(`
+begin;
+
+  drop index oplog_metadata_entry_id_ix;
+  drop index oplog_entry_create_time_ix;
+  drop index session_connection_state_start_time_ix;
+  drop index session_state_start_time_ix;
+
+commit;
+
+`),
+	},
+	"migrations/84_event_watch.up.sql": {
+		name: "84_event_watch.up.sql",
+		bytes: []byte(`
+begin;
+
+  -- Watches of events read the changes made to sessions, their connections
+  -- and IAM resources after a point in time, from the session and connection
+  -- states and the oplog. These indexes keep reading the latest changes from
+  -- scanning the whole of these tables.
+  create index session_state_start_time_ix
+    on session_state (start_time);
+  create index session_connection_state_start_time_ix
+    on session_connection_state (start_time);
+  create index oplog_entry_create_time_ix
+    on oplog_entry (create_time);
+  create index oplog_metadata_entry_id_ix
+    on oplog_metadata (entry_id);
+
+commit;
+
+`),
+	},
+	"migrations/85_session_recording_chunks.down.sql": {
+		name: "85_session_recording_chunks.down.sql",
+		bytes: []byte(`
+begin;
+
+  drop trigger immutable_columns on session_connection_recording;
+
+  alter table session_connection_recording
+    add column recording bytea;
+  update session_connection_recording r
+     set recording = (
+       select string_agg(c.data, '' order by c.sequence)
+         from session_connection_recording_chunk c
+        where c.connection_id = r.connection_id
+     );
+  alter table session_connection_recording
+    alter column recording set not null,
+    add constraint recording_must_not_be_empty
+      check(length(recording) > 0),
+    drop column size;
+
+  create trigger
+    immutable_columns
+  before
+  update on session_connection_recording
+    for each row execute procedure immutable_columns('connection_id', 'session_id', 'recording', 'create_time');
+
+  drop table session_connection_recording_chunk;
+
+commit;
+
+`),
+	},
+	"migrations/85_session_recording_chunks.up.sql": {
+		name: "85_session_recording_chunks.up.sql",
+		bytes: []byte(`
+begin;
+
+  -- session_connection_recording_chunk holds the data of connection
+  -- recordings, stored in chunks as the worker uploads them so that
+  -- controllers never hold a whole recording in memory. The chunks of a
+  -- connection are only a complete recording once its
+  -- session_connection_recording row exists; chunks left by an upload that
+  -- failed are replaced when the worker uploads the recording again.
+  create table session_connection_recording_chunk (
+    connection_id wt_public_id not null
+      references session_connection (public_id)
+      on delete cascade
+      on update cascade,
+    sequence integer not null
+      constraint sequence_must_not_be_negative
+      check(sequence >= 0),
+    data bytea not null
+      constraint data_must_not_be_empty
+      check(length(data) > 0),
+    create_time wt_timestamp,
+    primary key(connection_id, sequence)
+  );
+
+  create trigger
+    immutable_columns
+  before
+  update on session_connection_recording_chunk
+    for each row execute procedure immutable_columns('connection_id', 'sequence', 'data', 'create_time');
+
+  create trigger
+    default_create_time_column
+  before
+  insert on session_connection_recording_chunk
+    for each row execute procedure default_create_time();
+
+  insert into session_connection_recording_chunk
+    (connection_id, sequence, data)
+  select connection_id, 0, recording
+    from session_connection_recording;
+
+  -- session_connection_recording now only records that the recording of a
+  -- connection is complete, along with its size
+  drop trigger immutable_columns on session_connection_recording;
+
+  alter table session_connection_recording
+    add column size bigint;
+  update session_connection_recording
+     set size = length(recording);
+  alter table session_connection_recording
+    alter column size set not null,
+    add constraint size_must_be_positive
+      check(size > 0),
+    drop column recording;
+
+  create trigger
+    immutable_columns
+  before
+  update on session_connection_recording
+    for each row execute procedure immutable_columns('connection_id', 'session_id', 'size', 'create_time');
+
+commit;
+
+`),
+	},
+	"migrations/86_worker_dead_termination_reason.down.sql": {
+		name: "86_worker_dead_termination_reason.down.sql",
+		bytes: []byte(`
+begin;
+
+  update session
+    set termination_reason = 'system error'
+  where termination_reason = 'worker dead';
+
+  delete from session_termination_reason_enm
+    where name = 'worker dead';
+
+  alter table session_termination_reason_enm
+    drop constraint only_predefined_session_termination_reasons_allowed;
+
+  alter table session_termination_reason_enm
+    add constraint only_predefined_session_termination_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'terminated',
+          'network error',
+          'system error',
+          'connection limit',
+          'canceled'
+        )
+      );
+
+commit;
+
+`),
+	},
+	"migrations/86_worker_dead_termination_reason.up.sql": {
+		name: "86_worker_dead_termination_reason.up.sql",
+		bytes: []byte(`
+begin;
+
+  -- 'worker dead' is the reason for sessions terminated by a controller
+  -- because the worker proxying them stopped reporting its status.
+  alter table session_termination_reason_enm
+    drop constraint only_predefined_session_termination_reasons_allowed;
+
+  alter table session_termination_reason_enm
+    add constraint only_predefined_session_termination_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'terminated',
+          'network error',
+          'system error',
+          'connection limit',
+          'canceled',
+          'worker dead'
+        )
+      );
+
+  insert into session_termination_reason_enm (name)
+  values
+    ('worker dead');
+
+commit;
+
 `),
 	},
 }
@@ -0,0 +1,62 @@
+begin;
+
+  -- record_sessions controls whether workers record the data proxied over
+  -- the connections of sessions created for the target
+  alter table target_tcp
+    add column record_sessions boolean not null default false;
+
+  drop view target_all_subtypes;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp;
+
+  -- session_connection_recording contains the encrypted recording of a
+  -- session connection, uploaded by the worker which proxied the connection
+  -- once the connection is closed. The recording is encrypted with a key
+  -- derived from the session's scope session key, so it is not wrapped again.
+  create table session_connection_recording (
+    connection_id wt_public_id primary key
+      references session_connection (public_id)
+      on delete cascade
+      on update cascade,
+    session_id wt_public_id not null
+      references session (public_id)
+      on delete cascade
+      on update cascade,
+    recording bytea not null
+      constraint recording_must_not_be_empty
+      check(length(recording) > 0),
+    create_time wt_timestamp
+  );
+
+  create index session_connection_recording_session_id_ix
+    on session_connection_recording (session_id);
+
+  create trigger
+    immutable_columns
+  before
+  update on session_connection_recording
+    for each row execute procedure immutable_columns('connection_id', 'session_id', 'recording', 'create_time');
+
+  create trigger
+    default_create_time_column
+  before
+  insert on session_connection_recording
+    for each row execute procedure default_create_time();
+
+commit;
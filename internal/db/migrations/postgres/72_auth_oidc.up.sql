@@ -0,0 +1,215 @@
+begin;
+
+/*
+
+       ┌────────────────┐                 ┌──────────────────────┐             ┌────────────────────────┐
+       │  auth_method   │                 │   auth_oidc_method   │             │    auth_oidc_state     │
+       ├────────────────┤                 ├──────────────────────┤             ├────────────────────────┤
+       │ public_id (pk) │                 │ public_id (pk,fk)    │            ╱│ state          (pk)    │
+       │ scope_id  (fk) │┼┼─────────────○┼│ scope_id  (fk)       │┼┼─────────○─│ auth_method_id (fk)    │
+       │                │                 │ issuer               │            ╲│ nonce                  │
+       └────────────────┘                 │ client_id            │             │ code_verifier          │
+                ┼                         │ ...                  │             │ redirect_url           │
+                ┼                         └──────────────────────┘             │ expiration_time        │
+                │                                     ┼                        └────────────────────────┘
+                │ ▲fk1                                ┼
+                │                                     │ ▲fk1
+                ○                                     ○
+               ╱│╲                                   ╱│╲
+  ┌──────────────────────────┐          ┌──────────────────────────┐
+  │       auth_account       │          │    auth_oidc_account     │
+  ├──────────────────────────┤          ├──────────────────────────┤
+  │ public_id         (pk)   │          │ public_id      (pk,fk2)  │
+  │ scope_id          (fk1)  │   ◀fk2   │ scope_id       (fk1,fk2) │
+  │ auth_method_id    (fk1)  │┼┼──────○┼│ auth_method_id (fk1,fk2) │
+  │ iam_user_scope_id (fk2)  │          │ subject                  │
+  │ iam_user_id       (fk2)  │          │ ...                      │
+  └──────────────────────────┘          └──────────────────────────┘
+
+  An auth_oidc_method is an auth_method subtype and an auth_oidc_account is an
+  auth_account subtype, in the same way as the password subtypes.
+
+  An auth_oidc_account is identified by the subject of the ID tokens issued by
+  the provider. Accounts are usually created the first time a user
+  authenticates but can also be created ahead of time for a known subject.
+
+  An auth_oidc_state holds the state, nonce, and PKCE code verifier of an
+  authentication request which has been started but not yet completed. A
+  state is deleted when it is used to complete an authentication request or
+  after it has expired.
+
+*/
+
+  create table auth_oidc_method (
+    public_id wt_public_id
+      primary key,
+    scope_id wt_scope_id
+      not null,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    issuer text not null
+      constraint issuer_must_not_be_empty
+      check(length(trim(issuer)) > 0),
+    client_id text not null
+      constraint client_id_must_not_be_empty
+      check(length(trim(client_id)) > 0),
+    client_secret bytea not null
+      constraint client_secret_must_not_be_empty
+      check(length(client_secret) > 0),
+    -- TODO: Make key_id a foreign key once we have DEKs
+    key_id text not null
+      constraint key_id_must_not_be_empty
+      check(length(trim(key_id)) > 0),
+    claims_scopes text,
+    email_claim text not null default 'email'
+      constraint email_claim_must_not_be_empty
+      check(length(trim(email_claim)) > 0),
+    name_claim text not null default 'name'
+      constraint name_claim_must_not_be_empty
+      check(length(trim(name_claim)) > 0),
+    foreign key (scope_id, public_id)
+      references auth_method (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(scope_id, name),
+    unique(scope_id, public_id)
+  );
+
+  create trigger update_version_column after update on auth_oidc_method
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on auth_oidc_method
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_oidc_method
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_oidc_method
+    for each row execute procedure default_create_time();
+
+  create trigger insert_auth_method_subtype before insert on auth_oidc_method
+    for each row execute procedure insert_auth_method_subtype();
+
+  create table auth_oidc_account (
+    public_id wt_public_id
+      primary key,
+    auth_method_id wt_public_id
+      not null,
+    -- NOTE: The scope_id type is not wt_scope_id because the domain check is
+    -- executed before the insert trigger which retrieves the scope_id causing
+    -- an insert to fail.
+    scope_id text not null,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    subject text not null
+      constraint subject_must_not_be_empty
+      check(length(trim(subject)) > 0),
+    email text,
+    full_name text,
+    foreign key (scope_id, auth_method_id)
+      references auth_oidc_method (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    foreign key (scope_id, auth_method_id, public_id)
+      references auth_account (scope_id, auth_method_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(auth_method_id, name),
+    unique(auth_method_id, subject),
+    unique(auth_method_id, public_id)
+  );
+
+  create trigger update_version_column after update on auth_oidc_account
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on auth_oidc_account
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_oidc_account
+    for each row execute procedure immutable_columns('public_id', 'auth_method_id', 'scope_id', 'subject', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_oidc_account
+    for each row execute procedure default_create_time();
+
+  create trigger insert_auth_account_subtype before insert on auth_oidc_account
+    for each row execute procedure insert_auth_account_subtype();
+
+  create table auth_oidc_state (
+    state text
+      primary key
+      constraint state_must_not_be_empty
+      check(length(trim(state)) > 0),
+    auth_method_id wt_public_id not null
+      references auth_oidc_method (public_id)
+      on delete cascade
+      on update cascade,
+    nonce text not null
+      constraint nonce_must_not_be_empty
+      check(length(trim(nonce)) > 0),
+    code_verifier text not null
+      constraint code_verifier_must_not_be_empty
+      check(length(trim(code_verifier)) > 0),
+    redirect_url text not null
+      constraint redirect_url_must_not_be_empty
+      check(length(trim(redirect_url)) > 0),
+    create_time wt_timestamp,
+    expiration_time wt_timestamp
+      constraint expiration_time_must_be_after_create_time
+      check(expiration_time > create_time)
+  );
+
+  create trigger default_create_time_column before insert on auth_oidc_state
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_oidc_state
+    for each row execute procedure immutable_columns('state', 'auth_method_id', 'nonce', 'code_verifier', 'redirect_url', 'create_time', 'expiration_time');
+
+  -- The user dimension of the warehouse includes the names of oidc accounts
+  -- and auth methods.
+  create or replace view whx_user_dimension_source as
+       select -- id is the first column in the target view
+              u.public_id                                       as user_id,
+              coalesce(u.name, 'None')                          as user_name,
+              coalesce(u.description, 'None')                   as user_description,
+              coalesce(aa.public_id, 'None')                    as auth_account_id,
+              case when aa.public_id is null then 'None'
+                   when aoa.public_id is not null then 'oidc auth account'
+                   else 'password auth account'
+                   end                                          as auth_account_type,
+              coalesce(apa.name, aoa.name, 'None')              as auth_account_name,
+              coalesce(apa.description, aoa.description, 'None') as auth_account_description,
+              coalesce(am.public_id, 'None')                    as auth_method_id,
+              case when am.public_id is null then 'None'
+                   when aom.public_id is not null then 'oidc auth method'
+                   else 'password auth method'
+                   end                                          as auth_method_type,
+              coalesce(apm.name, aom.name, 'None')              as auth_method_name,
+              coalesce(apm.description, aom.description, 'None') as auth_method_description,
+              org.public_id                                     as user_organization_id,
+              coalesce(org.name, 'None')                        as user_organization_name,
+              coalesce(org.description, 'None')                 as user_organization_description
+         from iam_user as u
+    left join auth_account as aa on           u.public_id = aa.iam_user_id
+    left join auth_method as am on            aa.auth_method_id = am.public_id
+    left join auth_password_account as apa on aa.public_id = apa.public_id
+    left join auth_password_method as apm on  am.public_id = apm.public_id
+    left join auth_oidc_account as aoa on     aa.public_id = aoa.public_id
+    left join auth_oidc_method as aom on      am.public_id = aom.public_id
+         join iam_scope as org on             u.scope_id = org.public_id
+  ;
+
+  -- The tickets for oplog are the subtypes not the base types because no updates
+  -- are done to any values in the base types. auth_oidc_state is not written
+  -- to the oplog.
+  insert into oplog_ticket (name, version)
+  values
+    ('auth_oidc_method', 1),
+    ('auth_oidc_account', 1);
+
+commit;
@@ -0,0 +1,241 @@
+begin;
+
+/*
+
+       ┌────────────────┐                 ┌──────────────────────┐             ┌────────────────────────┐
+       │  auth_method   │                 │   auth_ldap_method   │             │ auth_ldap_group_mapping│
+       ├────────────────┤                 ├──────────────────────┤             ├────────────────────────┤
+       │ public_id (pk) │                 │ public_id (pk,fk)    │            ╱│ auth_method_id (pk,fk1)│
+       │ scope_id  (fk) │┼┼─────────────○┼│ scope_id  (fk)       │┼┼─────────○─│ group_name     (pk)    │
+       │                │                 │ urls                 │            ╲│ group_id       (pk,fk2)│
+       └────────────────┘                 │ user_dn              │             │ scope_id   (fk1,fk2)   │
+                ┼                         │ ...                  │             └────────────────────────┘
+                ┼                         └──────────────────────┘                         ╲│╱
+                │                                     ┼                                     ○
+                │ ▲fk1                                ┼                                     │ ▼fk2
+                │                                     │ ▲fk1                                ┼
+                ○                                     ○                                     ┼
+               ╱│╲                                   ╱│╲                        ┌────────────────────────┐
+  ┌──────────────────────────┐          ┌──────────────────────────┐          │       iam_group        │
+  │       auth_account       │          │    auth_ldap_account     │          ├────────────────────────┤
+  ├──────────────────────────┤          ├──────────────────────────┤          │ public_id (pk)         │
+  │ public_id         (pk)   │          │ public_id      (pk,fk2)  │          │ scope_id  (fk)         │
+  │ scope_id          (fk1)  │   ◀fk2   │ scope_id       (fk1,fk2) │          └────────────────────────┘
+  │ auth_method_id    (fk1)  │┼┼──────○┼│ auth_method_id (fk1,fk2) │
+  │ iam_user_scope_id (fk2)  │          │ login_name               │
+  │ iam_user_id       (fk2)  │          │ ...                      │
+  └──────────────────────────┘          └──────────────────────────┘
+
+  An auth_ldap_method is an auth_method subtype and an auth_ldap_account is an
+  auth_account subtype, in the same way as the password subtypes.
+
+  An auth_ldap_account is identified by the login name the user authenticates
+  with. Accounts are usually created the first time a user authenticates but
+  can also be created ahead of time for a known login name.
+
+  An auth_ldap_group_mapping maps a directory group onto an iam_group in the
+  scope of the auth method. When an account authenticates, the user of the
+  account is added to the iam groups mapped from the directory groups the
+  account is a member of and removed from the other iam groups mapped by the
+  auth method.
+
+*/
+
+  create table auth_ldap_method (
+    public_id wt_public_id
+      primary key,
+    scope_id wt_scope_id
+      not null,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    urls text not null
+      constraint urls_must_not_be_empty
+      check(length(trim(urls)) > 0),
+    start_tls boolean not null default false,
+    insecure_tls boolean not null default false,
+    certificate text
+      constraint certificate_must_not_be_empty
+      check(length(trim(certificate)) > 0),
+    bind_dn text
+      constraint bind_dn_must_not_be_empty
+      check(length(trim(bind_dn)) > 0),
+    bind_password bytea
+      constraint bind_password_must_not_be_empty
+      check(length(bind_password) > 0),
+    -- TODO: Make key_id a foreign key once we have DEKs
+    key_id text
+      constraint key_id_must_not_be_empty
+      check(length(trim(key_id)) > 0),
+    discover_dn boolean not null default false,
+    user_dn text not null
+      constraint user_dn_must_not_be_empty
+      check(length(trim(user_dn)) > 0),
+    user_attr text not null default 'uid'
+      constraint user_attr_must_not_be_empty
+      check(length(trim(user_attr)) > 0),
+    group_dn text
+      constraint group_dn_must_not_be_empty
+      check(length(trim(group_dn)) > 0),
+    group_attr text not null default 'cn'
+      constraint group_attr_must_not_be_empty
+      check(length(trim(group_attr)) > 0),
+    group_filter text not null default '(|(memberUid={{.Username}})(member={{.UserDN}})(uniqueMember={{.UserDN}}))'
+      constraint group_filter_must_not_be_empty
+      check(length(trim(group_filter)) > 0),
+    email_attr text not null default 'mail'
+      constraint email_attr_must_not_be_empty
+      check(length(trim(email_attr)) > 0),
+    name_attr text not null default 'cn'
+      constraint name_attr_must_not_be_empty
+      check(length(trim(name_attr)) > 0),
+    constraint bind_password_requires_key_id
+      check((bind_password is null) = (key_id is null)),
+    foreign key (scope_id, public_id)
+      references auth_method (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(scope_id, name),
+    unique(scope_id, public_id)
+  );
+
+  create trigger update_version_column after update on auth_ldap_method
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on auth_ldap_method
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_ldap_method
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_ldap_method
+    for each row execute procedure default_create_time();
+
+  create trigger insert_auth_method_subtype before insert on auth_ldap_method
+    for each row execute procedure insert_auth_method_subtype();
+
+  create table auth_ldap_account (
+    public_id wt_public_id
+      primary key,
+    auth_method_id wt_public_id
+      not null,
+    -- NOTE: The scope_id type is not wt_scope_id because the domain check is
+    -- executed before the insert trigger which retrieves the scope_id causing
+    -- an insert to fail.
+    scope_id text not null,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    login_name text not null
+      constraint login_name_must_be_lowercase
+      check(lower(trim(login_name)) = login_name)
+      constraint login_name_must_not_be_empty
+      check(length(trim(login_name)) > 0),
+    dn text,
+    email text,
+    full_name text,
+    foreign key (scope_id, auth_method_id)
+      references auth_ldap_method (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    foreign key (scope_id, auth_method_id, public_id)
+      references auth_account (scope_id, auth_method_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(auth_method_id, name),
+    unique(auth_method_id, login_name),
+    unique(auth_method_id, public_id)
+  );
+
+  create trigger update_version_column after update on auth_ldap_account
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on auth_ldap_account
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_ldap_account
+    for each row execute procedure immutable_columns('public_id', 'auth_method_id', 'scope_id', 'login_name', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_ldap_account
+    for each row execute procedure default_create_time();
+
+  create trigger insert_auth_account_subtype before insert on auth_ldap_account
+    for each row execute procedure insert_auth_account_subtype();
+
+  create table auth_ldap_group_mapping (
+    auth_method_id wt_public_id not null,
+    group_name text not null
+      constraint group_name_must_not_be_empty
+      check(length(trim(group_name)) > 0),
+    group_id wt_public_id not null,
+    scope_id wt_scope_id not null,
+    create_time wt_timestamp,
+    primary key(auth_method_id, group_name, group_id),
+    -- The iam group must be in the scope of the auth method.
+    foreign key (scope_id, auth_method_id)
+      references auth_ldap_method (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    foreign key (scope_id, group_id)
+      references iam_group (scope_id, public_id)
+      on delete cascade
+      on update cascade
+  );
+
+  create trigger default_create_time_column before insert on auth_ldap_group_mapping
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_ldap_group_mapping
+    for each row execute procedure immutable_columns('auth_method_id', 'group_name', 'group_id', 'scope_id', 'create_time');
+
+  -- The user dimension of the warehouse includes the names of ldap accounts
+  -- and auth methods.
+  create or replace view whx_user_dimension_source as
+       select -- id is the first column in the target view
+              u.public_id                                       as user_id,
+              coalesce(u.name, 'None')                          as user_name,
+              coalesce(u.description, 'None')                   as user_description,
+              coalesce(aa.public_id, 'None')                    as auth_account_id,
+              case when aa.public_id is null then 'None'
+                   when aoa.public_id is not null then 'oidc auth account'
+                   when ala.public_id is not null then 'ldap auth account'
+                   else 'password auth account'
+                   end                                          as auth_account_type,
+              coalesce(apa.name, aoa.name, ala.name, 'None')    as auth_account_name,
+              coalesce(apa.description, aoa.description, ala.description, 'None') as auth_account_description,
+              coalesce(am.public_id, 'None')                    as auth_method_id,
+              case when am.public_id is null then 'None'
+                   when aom.public_id is not null then 'oidc auth method'
+                   when alm.public_id is not null then 'ldap auth method'
+                   else 'password auth method'
+                   end                                          as auth_method_type,
+              coalesce(apm.name, aom.name, alm.name, 'None')    as auth_method_name,
+              coalesce(apm.description, aom.description, alm.description, 'None') as auth_method_description,
+              org.public_id                                     as user_organization_id,
+              coalesce(org.name, 'None')                        as user_organization_name,
+              coalesce(org.description, 'None')                 as user_organization_description
+         from iam_user as u
+    left join auth_account as aa on           u.public_id = aa.iam_user_id
+    left join auth_method as am on            aa.auth_method_id = am.public_id
+    left join auth_password_account as apa on aa.public_id = apa.public_id
+    left join auth_password_method as apm on  am.public_id = apm.public_id
+    left join auth_oidc_account as aoa on     aa.public_id = aoa.public_id
+    left join auth_oidc_method as aom on      am.public_id = aom.public_id
+    left join auth_ldap_account as ala on     aa.public_id = ala.public_id
+    left join auth_ldap_method as alm on      am.public_id = alm.public_id
+         join iam_scope as org on             u.scope_id = org.public_id
+  ;
+
+  -- The tickets for oplog are the subtypes not the base types because no updates
+  -- are done to any values in the base types. Changes to the group mappings
+  -- of an auth method are written with the ticket of the auth method.
+  insert into oplog_ticket (name, version)
+  values
+    ('auth_ldap_method', 1),
+    ('auth_ldap_account', 1);
+
+commit;
@@ -0,0 +1,42 @@
+begin;
+
+  drop view target_all_subtypes;
+
+  alter table target_tcp
+    drop column credential_injection;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp;
+
+  drop table target_credential;
+  drop table credential;
+  drop table credential_store;
+  drop table kms_credential_key_version;
+  drop table kms_credential_key;
+
+  drop function target_credential_scope_valid;
+  drop function credential_store_scope_valid;
+
+  delete
+    from oplog_ticket
+   where name in (
+          'credential_store',
+          'credential'
+        );
+
+commit;
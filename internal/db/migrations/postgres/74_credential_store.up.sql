@@ -0,0 +1,255 @@
+begin;
+
+/*
+
+  ┌──────────────────┐            ┌──────────────────┐            ┌───────────────────┐
+  │ credential_store │            │    credential    │            │ target_credential │
+  ├──────────────────┤            ├──────────────────┤            ├───────────────────┤
+  │ public_id  (pk)  │           ╱│ public_id  (pk)  │           ╱│ target_id     (pk)│
+  │ scope_id   (fk)  │┼┼───────○─│ store_id   (fk)  │┼┼───────○─│ credential_id (pk)│
+  │ name             │           ╲│ username         │           ╲│                   │
+  └──────────────────┘            │ password         │            └───────────────────┘
+                                  │ key_id           │                      ╲│╱
+                                  └──────────────────┘                       ○
+                                                                             │
+                                                                             ┼
+                                                                   ┌──────────────────┐
+                                                                   │      target      │
+                                                                   └──────────────────┘
+
+  A credential_store is a container for credentials in a project. A
+  credential holds a username and a password. The password is encrypted with
+  the credentials key of the scope of its store, a new kms key purpose with
+  its own DEK (kms_credential_key).
+
+  Credentials are attached to targets in the same scope through
+  target_credential. When a session is authorized for a target, the
+  credentials of the target are either returned to the user (brokered) or,
+  if the target has a credential_injection protocol, handed to the worker
+  which authenticates to the endpoint with them.
+
+*/
+
+  create table kms_credential_key (
+    private_id wt_private_id primary key,
+    root_key_id wt_private_id not null unique -- there can be only one credential dek per root key
+      references kms_root_key(private_id)
+      on delete cascade
+      on update cascade,
+    create_time wt_timestamp
+  );
+
+  -- define the immutable fields for kms_credential_key (all of them)
+  create trigger
+    immutable_columns
+  before
+  update on kms_credential_key
+    for each row execute procedure immutable_columns('private_id', 'root_key_id', 'create_time');
+
+  create trigger
+    default_create_time_column
+  before
+  insert on kms_credential_key
+    for each row execute procedure default_create_time();
+
+  create table kms_credential_key_version (
+    private_id wt_private_id primary key,
+    credential_key_id wt_private_id not null
+      references kms_credential_key(private_id)
+      on delete cascade
+      on update cascade,
+    root_key_version_id wt_private_id not null
+      references kms_root_key_version(private_id)
+      on delete cascade
+      on update cascade,
+    version wt_version,
+    key bytea not null,
+    create_time wt_timestamp,
+    unique(credential_key_id, version)
+  );
+
+  -- define the immutable fields for kms_credential_key_version (all of them)
+  create trigger
+    immutable_columns
+  before
+  update on kms_credential_key_version
+    for each row execute procedure immutable_columns('private_id', 'credential_key_id', 'root_key_version_id', 'version', 'key', 'create_time');
+
+  create trigger
+    default_create_time_column
+  before
+  insert on kms_credential_key_version
+    for each row execute procedure default_create_time();
+
+  create trigger
+    kms_version_column
+  before insert on kms_credential_key_version
+    for each row execute procedure kms_version_column('credential_key_id');
+
+  -- credential_store_scope_valid() is a before insert trigger function for
+  -- credential_store
+  create or replace function
+    credential_store_scope_valid()
+    returns trigger
+  as $$
+  declare scope_type text;
+  begin
+    -- Fetch the type of scope
+    select isc.type from iam_scope isc where isc.public_id = new.scope_id into scope_type;
+    if scope_type = 'project' then
+      return new;
+    end if;
+    raise exception 'invalid credential store scope type % (must be project)', scope_type;
+  end;
+  $$ language plpgsql;
+
+  create table credential_store (
+    public_id wt_public_id
+      primary key,
+    scope_id wt_scope_id
+      not null
+      references iam_scope (public_id)
+      on delete cascade
+      on update cascade,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    unique(scope_id, name),
+    unique(scope_id, public_id)
+  );
+
+  create trigger update_version_column after update on credential_store
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_store
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on credential_store
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger default_create_time_column before insert on credential_store
+    for each row execute procedure default_create_time();
+
+  create trigger credential_store_scope_valid before insert on credential_store
+    for each row execute procedure credential_store_scope_valid();
+
+  create table credential (
+    public_id wt_public_id
+      primary key,
+    store_id wt_public_id
+      not null
+      references credential_store (public_id)
+      on delete cascade
+      on update cascade,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    username text not null
+      constraint username_must_not_be_empty
+      check(length(trim(username)) > 0),
+    password bytea not null
+      constraint password_must_not_be_empty
+      check(length(password) > 0),
+    key_id text not null
+      references kms_credential_key_version (private_id)
+      on delete restrict
+      on update cascade,
+    unique(store_id, name)
+  );
+
+  create trigger update_version_column after update on credential
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on credential
+    for each row execute procedure immutable_columns('public_id', 'store_id', 'create_time');
+
+  create trigger default_create_time_column before insert on credential
+    for each row execute procedure default_create_time();
+
+  -- target_credential_scope_valid() is a before insert trigger function for
+  -- target_credential
+  create or replace function
+    target_credential_scope_valid()
+    returns trigger
+  as $$
+  begin
+    perform from
+      credential c,
+      credential_store cs,
+      target t
+    where
+      c.public_id = new.credential_id and
+      c.store_id = cs.public_id and
+      cs.scope_id = t.scope_id and
+      t.public_id = new.target_id;
+    if not found then
+      raise exception 'target scope and credential scope are not equal';
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create table target_credential (
+    target_id wt_public_id
+      references target (public_id)
+      on delete cascade
+      on update cascade,
+    credential_id wt_public_id
+      references credential (public_id)
+      on delete cascade
+      on update cascade,
+    primary key(target_id, credential_id),
+    create_time wt_timestamp
+  );
+
+  create trigger immutable_columns before update on target_credential
+    for each row execute procedure immutable_columns('target_id', 'credential_id', 'create_time');
+
+  create trigger default_create_time_column before insert on target_credential
+    for each row execute procedure default_create_time();
+
+  create trigger target_credential_scope_valid before insert on target_credential
+    for each row execute procedure target_credential_scope_valid();
+
+  -- credential_injection is the protocol the worker uses to authenticate to
+  -- the endpoint with the credentials of the target. If it is null the
+  -- credentials are returned to the user when a session is authorized.
+  alter table target_tcp
+    add column credential_injection text
+      constraint credential_injection_must_be_known
+      check(credential_injection in ('postgres'));
+
+  drop view target_all_subtypes;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    credential_injection,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp;
+
+  insert into oplog_ticket (name, version)
+  values
+    ('credential_store', 1),
+    ('credential', 1);
+
+commit;
@@ -0,0 +1,29 @@
+begin;
+
+  drop view target_all_subtypes;
+
+  alter table target_tcp
+    drop column worker_filter;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    credential_injection,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp;
+
+  drop table server_tag;
+
+commit;
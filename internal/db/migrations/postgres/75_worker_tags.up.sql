@@ -0,0 +1,49 @@
+begin;
+
+  -- server_tag holds the tags a worker reports in its status updates. The
+  -- tags of a server are replaced on every update.
+  create table server_tag (
+    server_id text not null,
+    server_type text not null,
+    key text not null
+      constraint key_must_not_be_empty
+      check(length(trim(key)) > 0),
+    value text not null,
+    primary key (server_id, server_type, key, value),
+    foreign key (server_id, server_type)
+      references server (private_id, type)
+      on delete cascade
+      on update cascade
+  );
+
+  -- worker_filter is a boolean expression over worker tags. If it is set only
+  -- the workers matching it are given to the user for sessions of the
+  -- target.
+  alter table target_tcp
+    add column worker_filter text
+      constraint worker_filter_must_not_be_empty
+      check(length(trim(worker_filter)) > 0);
+
+  drop view target_all_subtypes;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    credential_injection,
+    worker_filter,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp;
+
+commit;
@@ -0,0 +1,6 @@
+begin;
+
+  alter table server
+    drop column upstream;
+
+commit;
@@ -0,0 +1,10 @@
+begin;
+
+  -- upstream is the name of the worker a downstream worker is connected
+  -- through. Clients reach downstream workers through their upstream.
+  alter table server
+    add column upstream text
+      constraint upstream_must_not_be_empty
+      check(length(trim(upstream)) > 0);
+
+commit;
@@ -0,0 +1,22 @@
+begin;
+
+  delete from session_connection_closed_reason_enm
+    where name = 'worker dead';
+
+  alter table session_connection_closed_reason_enm
+    drop constraint only_predefined_session_connection_closed_reasons_allowed;
+
+  alter table session_connection_closed_reason_enm
+    add constraint only_predefined_session_connection_closed_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'canceled',
+          'network error',
+          'system error'
+        )
+      );
+
+commit;
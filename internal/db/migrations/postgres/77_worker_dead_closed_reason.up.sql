@@ -0,0 +1,26 @@
+begin;
+
+  -- 'worker dead' is the reason for connections closed by a controller
+  -- because the worker proxying them stopped reporting its status.
+  alter table session_connection_closed_reason_enm
+    drop constraint only_predefined_session_connection_closed_reasons_allowed;
+
+  alter table session_connection_closed_reason_enm
+    add constraint only_predefined_session_connection_closed_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'canceled',
+          'network error',
+          'system error',
+          'worker dead'
+        )
+      );
+
+  insert into session_connection_closed_reason_enm (name)
+  values
+    ('worker dead');
+
+commit;
@@ -0,0 +1,8 @@
+begin;
+
+  -- draining is set by workers that are draining their connections, which
+  -- are no longer offered to clients.
+  alter table server
+    add column draining boolean not null default false;
+
+commit;
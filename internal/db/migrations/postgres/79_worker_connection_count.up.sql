@@ -0,0 +1,10 @@
+begin;
+
+  -- active_connection_count is the number of connections a worker reported
+  -- proxying in its last status, used to spread sessions across workers.
+  alter table server
+    add column active_connection_count integer not null default 0
+      constraint active_connection_count_must_not_be_negative
+      check(active_connection_count >= 0);
+
+commit;
@@ -0,0 +1,66 @@
+begin;
+
+  -- session_max_bytes_per_second limits the bytes per second a worker proxies
+  -- in each direction for a session of the target. 0 means unlimited.
+  alter table target_tcp
+    add column session_max_bytes_per_second integer not null default 0
+      constraint session_max_bytes_per_second_must_not_be_negative
+      check(session_max_bytes_per_second >= 0);
+
+  -- connection_idle_timeout_seconds is the number of seconds without data in
+  -- either direction after which a worker closes a connection. 0 means
+  -- connections are never closed for being idle.
+  alter table target_tcp
+    add column connection_idle_timeout_seconds integer not null default 0
+      constraint connection_idle_timeout_seconds_must_not_be_negative
+      check(connection_idle_timeout_seconds >= 0);
+
+  drop view target_all_subtypes;
+
+  -- target_all_subtypes is a union of all target subtypes
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    credential_injection,
+    worker_filter,
+    session_max_bytes_per_second,
+    connection_idle_timeout_seconds,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp;
+
+  -- 'idle timeout' is the reason for connections closed by a worker because
+  -- no data was proxied for longer than the idle timeout of the target.
+  alter table session_connection_closed_reason_enm
+    drop constraint only_predefined_session_connection_closed_reasons_allowed;
+
+  alter table session_connection_closed_reason_enm
+    add constraint only_predefined_session_connection_closed_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'canceled',
+          'network error',
+          'system error',
+          'worker dead',
+          'idle timeout'
+        )
+      );
+
+  insert into session_connection_closed_reason_enm (name)
+  values
+    ('idle timeout');
+
+commit;
@@ -0,0 +1,278 @@
+begin;
+
+  -- target_http is the http subtype of target. Workers proxy the connections
+  -- of its sessions as http requests to the endpoint, only letting through
+  -- the allowed methods and paths and adding the injected headers.
+  create table target_http (
+    public_id wt_public_id primary key
+      references target(public_id)
+      on delete cascade
+      on update cascade,
+    scope_id wt_scope_id not null
+      references iam_scope(public_id)
+      on delete cascade
+      on update cascade,
+    name text not null, -- name is not optional for a target subtype
+    description text,
+    default_port int, -- default_port can be null
+    -- max duration of the session in seconds.
+    -- default is 8 hours
+    session_max_seconds int not null default 28800
+      constraint session_max_seconds_must_be_greater_than_0
+      check(session_max_seconds > 0),
+    -- limit on number of session connections allowed. -1 equals no limit
+    session_connection_limit int not null default 1
+      constraint session_connection_limit_must_be_greater_than_0_or_negative_1
+      check(session_connection_limit > 0 or session_connection_limit = -1),
+    worker_filter text
+      constraint worker_filter_must_not_be_empty
+      check(length(trim(worker_filter)) > 0),
+    session_max_bytes_per_second integer not null default 0
+      constraint session_max_bytes_per_second_must_not_be_negative
+      check(session_max_bytes_per_second >= 0),
+    connection_idle_timeout_seconds integer not null default 0
+      constraint connection_idle_timeout_seconds_must_not_be_negative
+      check(connection_idle_timeout_seconds >= 0),
+    -- use_tls is true if the endpoint is reached over https
+    use_tls boolean not null default false,
+    -- allowed_methods is a space separated list of the request methods let
+    -- through. If null, all methods are.
+    allowed_methods text
+      constraint allowed_methods_must_not_be_empty
+      check(length(trim(allowed_methods)) > 0),
+    -- allowed_path_prefixes is a space separated list of the path prefixes of
+    -- the requests let through. If null, all paths are.
+    allowed_path_prefixes text
+      constraint allowed_path_prefixes_must_not_be_empty
+      check(length(trim(allowed_path_prefixes)) > 0),
+    -- injected_headers is a newline separated list of "Name: value" headers
+    -- set on every request.
+    injected_headers text
+      constraint injected_headers_must_not_be_empty
+      check(length(trim(injected_headers)) > 0),
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    unique(scope_id, name) -- name must be unique within a scope
+  );
+
+  create trigger insert_target_subtype before insert on target_http
+    for each row execute procedure insert_target_subtype();
+
+  create trigger delete_target_subtype after delete on target_http
+    for each row execute procedure delete_target_subtype();
+
+  create trigger immutable_columns before update on target_http
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger update_version_column after update on target_http
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on target_http
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on target_http
+    for each row execute procedure default_create_time();
+
+  create trigger target_scope_valid before insert on target_http
+    for each row execute procedure target_scope_valid();
+
+  drop view target_all_subtypes;
+
+  -- target_all_subtypes is a union of all target subtypes. The columns only
+  -- target_http has are false or null for the other subtypes.
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    record_sessions,
+    credential_injection,
+    worker_filter,
+    session_max_bytes_per_second,
+    connection_idle_timeout_seconds,
+    false as use_tls,
+    null::text as allowed_methods,
+    null::text as allowed_path_prefixes,
+    null::text as injected_headers,
+    version,
+    create_time,
+    update_time,
+    'tcp' as type
+    from target_tcp
+  union all
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    false as record_sessions,
+    null as credential_injection,
+    worker_filter,
+    session_max_bytes_per_second,
+    connection_idle_timeout_seconds,
+    false as use_tls,
+    null::text as allowed_methods,
+    null::text as allowed_path_prefixes,
+    null::text as injected_headers,
+    version,
+    create_time,
+    update_time,
+    'udp' as type
+    from target_udp
+  union all
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    false as record_sessions,
+    null as credential_injection,
+    worker_filter,
+    session_max_bytes_per_second,
+    connection_idle_timeout_seconds,
+    use_tls,
+    allowed_methods,
+    allowed_path_prefixes,
+    injected_headers,
+    version,
+    create_time,
+    update_time,
+    'http' as type
+    from target_http;
+
+  -- whx_host_dimension_source is replaced to include http targets so that
+  -- sessions to them are recorded in the warehouse.
+  create or replace view whx_host_dimension_source as
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'static host'                   as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         coalesce(h.description, 'None') as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'static host set'               as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'static host catalog'           as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         t.type                          as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from static_host as h,
+         static_host_catalog as c,
+         static_host_set_member as m,
+         static_host_set as s,
+         target_host_set as ts,
+         (select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'tcp target' as type
+            from target_tcp
+          union all
+          select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'udp target' as type
+            from target_udp
+          union all
+          select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'http target' as type
+            from target_http) as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+   union all
+  select h.public_id                     as host_id,
+         'dynamic host'                  as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         'None'                          as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'dynamic host set'              as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'dynamic host catalog'          as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         t.type                          as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from dynamic_host as h,
+         dynamic_host_catalog as c,
+         dynamic_host_set_member as m,
+         dynamic_host_set as s,
+         target_host_set as ts,
+         (select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'tcp target' as type
+            from target_tcp
+          union all
+          select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'udp target' as type
+            from target_udp
+          union all
+          select public_id, scope_id, name, description, default_port,
+                 session_max_seconds, session_connection_limit,
+                 'http target' as type
+            from target_http) as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  ;
+
+  insert into oplog_ticket (name, version)
+  values
+    ('target_http', 1);
+
+commit;
@@ -0,0 +1,6 @@
+begin;
+
+  drop index session_scope_id_create_time_public_id_ix;
+  drop index session_create_time_public_id_ix;
+
+commit;
@@ -0,0 +1,11 @@
+begin;
+
+  -- Sessions are listed a page at a time in order of create time and public
+  -- id, within a scope or across all scopes. These indexes keep listing a
+  -- page of a large session table from scanning and sorting all of it.
+  create index session_create_time_public_id_ix
+    on session (create_time, public_id);
+  create index session_scope_id_create_time_public_id_ix
+    on session (scope_id, create_time, public_id);
+
+commit;
@@ -0,0 +1,8 @@
+begin;
+
+  drop index oplog_metadata_entry_id_ix;
+  drop index oplog_entry_create_time_ix;
+  drop index session_connection_state_start_time_ix;
+  drop index session_state_start_time_ix;
+
+commit;
@@ -0,0 +1,16 @@
+begin;
+
+  -- Watches of events read the changes made to sessions, their connections
+  -- and IAM resources after a point in time, from the session and connection
+  -- states and the oplog. These indexes keep reading the latest changes from
+  -- scanning the whole of these tables.
+  create index session_state_start_time_ix
+    on session_state (start_time);
+  create index session_connection_state_start_time_ix
+    on session_connection_state (start_time);
+  create index oplog_entry_create_time_ix
+    on oplog_entry (create_time);
+  create index oplog_metadata_entry_id_ix
+    on oplog_metadata (entry_id);
+
+commit;
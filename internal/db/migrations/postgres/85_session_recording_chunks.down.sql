@@ -0,0 +1,27 @@
+begin;
+
+  drop trigger immutable_columns on session_connection_recording;
+
+  alter table session_connection_recording
+    add column recording bytea;
+  update session_connection_recording r
+     set recording = (
+       select string_agg(c.data, '' order by c.sequence)
+         from session_connection_recording_chunk c
+        where c.connection_id = r.connection_id
+     );
+  alter table session_connection_recording
+    alter column recording set not null,
+    add constraint recording_must_not_be_empty
+      check(length(recording) > 0),
+    drop column size;
+
+  create trigger
+    immutable_columns
+  before
+  update on session_connection_recording
+    for each row execute procedure immutable_columns('connection_id', 'session_id', 'recording', 'create_time');
+
+  drop table session_connection_recording_chunk;
+
+commit;
@@ -0,0 +1,61 @@
+begin;
+
+  -- session_connection_recording_chunk holds the data of connection
+  -- recordings, stored in chunks as the worker uploads them so that
+  -- controllers never hold a whole recording in memory. The chunks of a
+  -- connection are only a complete recording once its
+  -- session_connection_recording row exists; chunks left by an upload that
+  -- failed are replaced when the worker uploads the recording again.
+  create table session_connection_recording_chunk (
+    connection_id wt_public_id not null
+      references session_connection (public_id)
+      on delete cascade
+      on update cascade,
+    sequence integer not null
+      constraint sequence_must_not_be_negative
+      check(sequence >= 0),
+    data bytea not null
+      constraint data_must_not_be_empty
+      check(length(data) > 0),
+    create_time wt_timestamp,
+    primary key(connection_id, sequence)
+  );
+
+  create trigger
+    immutable_columns
+  before
+  update on session_connection_recording_chunk
+    for each row execute procedure immutable_columns('connection_id', 'sequence', 'data', 'create_time');
+
+  create trigger
+    default_create_time_column
+  before
+  insert on session_connection_recording_chunk
+    for each row execute procedure default_create_time();
+
+  insert into session_connection_recording_chunk
+    (connection_id, sequence, data)
+  select connection_id, 0, recording
+    from session_connection_recording;
+
+  -- session_connection_recording now only records that the recording of a
+  -- connection is complete, along with its size
+  drop trigger immutable_columns on session_connection_recording;
+
+  alter table session_connection_recording
+    add column size bigint;
+  update session_connection_recording
+     set size = length(recording);
+  alter table session_connection_recording
+    alter column size set not null,
+    add constraint size_must_be_positive
+      check(size > 0),
+    drop column recording;
+
+  create trigger
+    immutable_columns
+  before
+  update on session_connection_recording
+    for each row execute procedure immutable_columns('connection_id', 'session_id', 'size', 'create_time');
+
+commit;
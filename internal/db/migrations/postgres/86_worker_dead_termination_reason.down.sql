@@ -0,0 +1,28 @@
+begin;
+
+  update session
+    set termination_reason = 'system error'
+  where termination_reason = 'worker dead';
+
+  delete from session_termination_reason_enm
+    where name = 'worker dead';
+
+  alter table session_termination_reason_enm
+    drop constraint only_predefined_session_termination_reasons_allowed;
+
+  alter table session_termination_reason_enm
+    add constraint only_predefined_session_termination_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'terminated',
+          'network error',
+          'system error',
+          'connection limit',
+          'canceled'
+        )
+      );
+
+commit;
@@ -0,0 +1,28 @@
+begin;
+
+  -- 'worker dead' is the reason for sessions terminated by a controller
+  -- because the worker proxying them stopped reporting its status.
+  alter table session_termination_reason_enm
+    drop constraint only_predefined_session_termination_reasons_allowed;
+
+  alter table session_termination_reason_enm
+    add constraint only_predefined_session_termination_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'terminated',
+          'network error',
+          'system error',
+          'connection limit',
+          'canceled',
+          'worker dead'
+        )
+      );
+
+  insert into session_termination_reason_enm (name)
+  values
+    ('worker dead');
+
+commit;
@@ -1,6 +1,8 @@
 package db
 
 import (
+	"time"
+
 	"github.com/hashicorp/boundary/internal/oplog"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
 )
@@ -40,6 +42,7 @@ type Options struct {
 	withWhereClause     string
 	withWhereClauseArgs []interface{}
 	withOrder           string
+	withPageAfter       *PageAfter
 }
 
 type oplogOpts struct {
@@ -155,3 +158,20 @@ func WithOrder(withOrder string) Option {
 		o.withOrder = withOrder
 	}
 }
+
+// PageAfter is a position in a list of resources ordered by create time and
+// public id. An empty PublicId is the position before the first resource.
+type PageAfter struct {
+	CreateTime time.Time
+	PublicId   string
+}
+
+// WithPageAfter provides an option to order the results of a search by create
+// time and public id, returning only the resources after the given position,
+// so that a search can be paged through with WithLimit. A nil position does
+// not change the search.
+func WithPageAfter(after *PageAfter) Option {
+	return func(o *Options) {
+		o.withPageAfter = after
+	}
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/stretchr/testify/assert"
@@ -175,4 +176,17 @@ func Test_getOpts(t *testing.T) {
 		testOpts.withOrder = "version desc"
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithPageAfter", func(t *testing.T) {
+		assert := assert.New(t)
+		// test default of nil
+		opts := GetOpts()
+		testOpts := getDefaultOptions()
+		testOpts.withPageAfter = nil
+		assert.Equal(opts, testOpts)
+
+		after := &PageAfter{CreateTime: time.Now(), PublicId: "u_1234567890"}
+		opts = GetOpts(WithPageAfter(after))
+		testOpts.withPageAfter = after
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/hashicorp/boundary/internal/db/common"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/oplog/store"
@@ -24,6 +25,9 @@ const (
 	DefaultLimit = 10000
 )
 
+// txRetryKey is the key of the number of transactions retried by DoTx
+var txRetryKey = []string{"db", "transaction", "retry"}
+
 // Reader interface defines lookups/searching for resources
 type Reader interface {
 	// LookupById will lookup a resource by its primary key id, which must be
@@ -894,6 +898,7 @@ func (w *Db) DoTx(ctx context.Context, retries uint, backOff Backoff, Handler Tx
 			}
 			if errors.Is(err, oplog.ErrTicketAlreadyRedeemed) {
 				d := backOff.Duration(attempts)
+				metrics.IncrCounter(txRetryKey, 1)
 				info.Retries++
 				info.Backoff = info.Backoff + d
 				time.Sleep(d)
@@ -978,7 +983,8 @@ func (rw *Db) LookupWhere(ctx context.Context, resource interface{}, where strin
 // SearchWhere will search for all the resources it can find using a where
 // clause with parameters.  Supports the WithLimit option.  If
 // WithLimit < 0, then unlimited results are returned.  If WithLimit == 0, then
-// default limits are used for results.  Supports the WithOrder option.
+// default limits are used for results.  Supports the WithOrder and
+// WithPageAfter options; WithPageAfter takes precedence over WithOrder.
 func (rw *Db) SearchWhere(ctx context.Context, resources interface{}, where string, args []interface{}, opt ...Option) error {
 	opts := GetOpts(opt...)
 	if rw.underlying == nil {
@@ -1006,6 +1012,14 @@ func (rw *Db) SearchWhere(ctx context.Context, resources interface{}, where stri
 		db = db.Where(where, args...)
 	}
 
+	// Perform paging
+	if opts.withPageAfter != nil {
+		db = db.Order("create_time, public_id", true)
+		if opts.withPageAfter.PublicId != "" {
+			db = db.Where("(create_time, public_id) > (?, ?)", opts.withPageAfter.CreateTime, opts.withPageAfter.PublicId)
+		}
+	}
+
 	// Perform the query
 	err = db.Find(resources).Error
 	if err != nil {
@@ -1013,6 +1013,35 @@ func TestDb_SearchWhere(t *testing.T) {
 	}
 }
 
+func TestDb_SearchWhere_PageAfter(t *testing.T) {
+	t.Parallel()
+	conn, _ := TestSetup(t, "postgres")
+	assert, require := assert.New(t), require.New(t)
+	rw := Db{underlying: conn}
+
+	var want []string
+	for i := 0; i < 5; i++ {
+		want = append(want, testUser(t, conn, "page-after-"+strconv.Itoa(i), "", "").PublicId)
+	}
+
+	var got []string
+	after := &PageAfter{}
+	for {
+		var page []db_test.TestUser
+		err := rw.SearchWhere(context.Background(), &page, "name like ?", []interface{}{"page-after-%"}, WithLimit(2), WithPageAfter(after))
+		require.NoError(err)
+		for _, u := range page {
+			got = append(got, u.PublicId)
+		}
+		if len(page) < 2 {
+			break
+		}
+		last := page[len(page)-1]
+		after = &PageAfter{CreateTime: last.GetCreateTime().GetTimestamp().AsTime(), PublicId: last.PublicId}
+	}
+	assert.ElementsMatch(want, got)
+}
+
 func TestDb_Exec(t *testing.T) {
 	t.Parallel()
 	t.Run("update", func(t *testing.T) {
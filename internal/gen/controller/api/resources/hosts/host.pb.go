@@ -218,6 +218,74 @@ func (x *StaticHostAttributes) GetAddress() *wrappers.StringValue {
 	return nil
 }
 
+// The attributes of a dynamic Host. Dynamic Hosts are read only and are
+// synced from the Host Catalog's inventory.
+type DynamicHostAttributes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output only. The identifier of the Host in the inventory.
+	ExternalId string `protobuf:"bytes,10,opt,name=external_id,proto3" json:"external_id,omitempty"`
+	// Output only. The address (DNS or IP name) used to reach the Host.
+	Address string `protobuf:"bytes,20,opt,name=address,proto3" json:"address,omitempty"`
+	// Output only. The attributes attached to the Host in the inventory.
+	Attributes *_struct.Struct `protobuf:"bytes,30,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *DynamicHostAttributes) Reset() {
+	*x = DynamicHostAttributes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_api_resources_hosts_v1_host_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DynamicHostAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicHostAttributes) ProtoMessage() {}
+
+func (x *DynamicHostAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_api_resources_hosts_v1_host_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicHostAttributes.ProtoReflect.Descriptor instead.
+func (*DynamicHostAttributes) Descriptor() ([]byte, []int) {
+	return file_controller_api_resources_hosts_v1_host_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DynamicHostAttributes) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *DynamicHostAttributes) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DynamicHostAttributes) GetAttributes() *_struct.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 var File_controller_api_resources_hosts_v1_host_proto protoreflect.FileDescriptor
 
 var file_controller_api_resources_hosts_v1_host_proto_rawDesc = []byte{
@@ -281,13 +349,22 @@ var file_controller_api_resources_hosts_v1_host_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x25, 0xa0, 0xda, 0x29, 0x01, 0xc2, 0xdd,
 	0x29, 0x1d, 0x0a, 0x12, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x61,
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x51, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
-	0x2f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f,
-	0x68, 0x6f, 0x73, 0x74, 0x73, 0x3b, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x15, 0x44, 0x79, 0x6e,
+	0x61, 0x6d, 0x69, 0x63, 0x48, 0x6f, 0x73, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x42, 0x51, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x68,
+	0x6f, 0x73, 0x74, 0x73, 0x3b, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -302,28 +379,30 @@ func file_controller_api_resources_hosts_v1_host_proto_rawDescGZIP() []byte {
 	return file_controller_api_resources_hosts_v1_host_proto_rawDescData
 }
 
-var file_controller_api_resources_hosts_v1_host_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_controller_api_resources_hosts_v1_host_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_controller_api_resources_hosts_v1_host_proto_goTypes = []interface{}{
-	(*Host)(nil),                 // 0: controller.api.resources.hosts.v1.Host
-	(*StaticHostAttributes)(nil), // 1: controller.api.resources.hosts.v1.StaticHostAttributes
-	(*scopes.ScopeInfo)(nil),     // 2: controller.api.resources.scopes.v1.ScopeInfo
-	(*wrappers.StringValue)(nil), // 3: google.protobuf.StringValue
-	(*timestamp.Timestamp)(nil),  // 4: google.protobuf.Timestamp
-	(*_struct.Struct)(nil),       // 5: google.protobuf.Struct
+	(*Host)(nil),                  // 0: controller.api.resources.hosts.v1.Host
+	(*StaticHostAttributes)(nil),  // 1: controller.api.resources.hosts.v1.StaticHostAttributes
+	(*DynamicHostAttributes)(nil), // 2: controller.api.resources.hosts.v1.DynamicHostAttributes
+	(*scopes.ScopeInfo)(nil),      // 3: controller.api.resources.scopes.v1.ScopeInfo
+	(*wrappers.StringValue)(nil),  // 4: google.protobuf.StringValue
+	(*timestamp.Timestamp)(nil),   // 5: google.protobuf.Timestamp
+	(*_struct.Struct)(nil),        // 6: google.protobuf.Struct
 }
 var file_controller_api_resources_hosts_v1_host_proto_depIdxs = []int32{
-	2, // 0: controller.api.resources.hosts.v1.Host.scope:type_name -> controller.api.resources.scopes.v1.ScopeInfo
-	3, // 1: controller.api.resources.hosts.v1.Host.name:type_name -> google.protobuf.StringValue
-	3, // 2: controller.api.resources.hosts.v1.Host.description:type_name -> google.protobuf.StringValue
-	4, // 3: controller.api.resources.hosts.v1.Host.created_time:type_name -> google.protobuf.Timestamp
-	4, // 4: controller.api.resources.hosts.v1.Host.updated_time:type_name -> google.protobuf.Timestamp
-	5, // 5: controller.api.resources.hosts.v1.Host.attributes:type_name -> google.protobuf.Struct
-	3, // 6: controller.api.resources.hosts.v1.StaticHostAttributes.address:type_name -> google.protobuf.StringValue
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	3, // 0: controller.api.resources.hosts.v1.Host.scope:type_name -> controller.api.resources.scopes.v1.ScopeInfo
+	4, // 1: controller.api.resources.hosts.v1.Host.name:type_name -> google.protobuf.StringValue
+	4, // 2: controller.api.resources.hosts.v1.Host.description:type_name -> google.protobuf.StringValue
+	5, // 3: controller.api.resources.hosts.v1.Host.created_time:type_name -> google.protobuf.Timestamp
+	5, // 4: controller.api.resources.hosts.v1.Host.updated_time:type_name -> google.protobuf.Timestamp
+	6, // 5: controller.api.resources.hosts.v1.Host.attributes:type_name -> google.protobuf.Struct
+	4, // 6: controller.api.resources.hosts.v1.StaticHostAttributes.address:type_name -> google.protobuf.StringValue
+	6, // 7: controller.api.resources.hosts.v1.DynamicHostAttributes.attributes:type_name -> google.protobuf.Struct
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_controller_api_resources_hosts_v1_host_proto_init() }
@@ -356,6 +435,18 @@ func file_controller_api_resources_hosts_v1_host_proto_init() {
 				return nil
 			}
 		}
+		file_controller_api_resources_hosts_v1_host_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DynamicHostAttributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -363,7 +454,7 @@ func file_controller_api_resources_hosts_v1_host_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_controller_api_resources_hosts_v1_host_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
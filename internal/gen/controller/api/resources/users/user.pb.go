@@ -217,6 +217,386 @@ func (x *User) GetAccounts() []*Account {
 	return nil
 }
 
+// PermissionGrant is a grant of a User that applies in the scope of a resource.
+type PermissionGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output only. The ID of the Role the grant belongs to.
+	RoleId string `protobuf:"bytes,10,opt,name=role_id,proto3" json:"role_id,omitempty"`
+	// Output only. The ID of the Scope the grant applies to.
+	GrantScopeId string `protobuf:"bytes,20,opt,name=grant_scope_id,proto3" json:"grant_scope_id,omitempty"`
+	// Output only. The canonical string of the grant.
+	Canonical string `protobuf:"bytes,30,opt,name=canonical,proto3" json:"canonical,omitempty"`
+}
+
+func (x *PermissionGrant) Reset() {
+	*x = PermissionGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_api_resources_users_v1_user_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionGrant) ProtoMessage() {}
+
+func (x *PermissionGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_api_resources_users_v1_user_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionGrant.ProtoReflect.Descriptor instead.
+func (*PermissionGrant) Descriptor() ([]byte, []int) {
+	return file_controller_api_resources_users_v1_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PermissionGrant) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *PermissionGrant) GetGrantScopeId() string {
+	if x != nil {
+		return x.GrantScopeId
+	}
+	return ""
+}
+
+func (x *PermissionGrant) GetCanonical() string {
+	if x != nil {
+		return x.Canonical
+	}
+	return ""
+}
+
+// PermissionCheck is the result of evaluating the grants of a User for an action on a resource.
+type PermissionCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output only. The ID of the User whose grants were evaluated.
+	UserId string `protobuf:"bytes,10,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	// Output only. The ID of the resource, if the action is not on a collection.
+	ResourceId string `protobuf:"bytes,20,opt,name=resource_id,proto3" json:"resource_id,omitempty"`
+	// Output only. The type of the resource.
+	ResourceType string `protobuf:"bytes,30,opt,name=resource_type,proto3" json:"resource_type,omitempty"`
+	// Output only. The ID of the Scope containing the resource.
+	ScopeId string `protobuf:"bytes,40,opt,name=scope_id,proto3" json:"scope_id,omitempty"`
+	// Output only. The ID of the resource the resource is pinned to, such as the Host Catalog of a Host.
+	Pin string `protobuf:"bytes,50,opt,name=pin,proto3" json:"pin,omitempty"`
+	// Output only. The action checked.
+	Action string `protobuf:"bytes,60,opt,name=action,proto3" json:"action,omitempty"`
+	// Output only. Whether the action is allowed.
+	Allowed bool `protobuf:"varint,70,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// Output only. A description of why the action is or is not allowed.
+	Reason string `protobuf:"bytes,80,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Output only. The grant that allowed or denied the action, if any.
+	Grant *PermissionGrant `protobuf:"bytes,90,opt,name=grant,proto3" json:"grant,omitempty"`
+	// Output only. All grants of the User that apply in the scope of the resource.
+	ScopeGrants []*PermissionGrant `protobuf:"bytes,100,rep,name=scope_grants,proto3" json:"scope_grants,omitempty"`
+}
+
+func (x *PermissionCheck) Reset() {
+	*x = PermissionCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_api_resources_users_v1_user_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionCheck) ProtoMessage() {}
+
+func (x *PermissionCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_api_resources_users_v1_user_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionCheck.ProtoReflect.Descriptor instead.
+func (*PermissionCheck) Descriptor() ([]byte, []int) {
+	return file_controller_api_resources_users_v1_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PermissionCheck) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PermissionCheck) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *PermissionCheck) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *PermissionCheck) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *PermissionCheck) GetPin() string {
+	if x != nil {
+		return x.Pin
+	}
+	return ""
+}
+
+func (x *PermissionCheck) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PermissionCheck) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *PermissionCheck) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PermissionCheck) GetGrant() *PermissionGrant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+func (x *PermissionCheck) GetScopeGrants() []*PermissionGrant {
+	if x != nil {
+		return x.ScopeGrants
+	}
+	return nil
+}
+
+// EffectiveGrant is a grant held by a User and the principals it is held through.
+type EffectiveGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output only. The ID of the Role the grant belongs to.
+	RoleId string `protobuf:"bytes,10,opt,name=role_id,proto3" json:"role_id,omitempty"`
+	// Output only. The canonical string of the grant, with templates filled in for the User.
+	Canonical string `protobuf:"bytes,20,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	// Output only. The IDs of the principals the Role is assigned to that the grant is held through: the User, Groups the User is a member of, or the special u_anon and u_auth users.
+	PrincipalIds []string `protobuf:"bytes,30,rep,name=principal_ids,proto3" json:"principal_ids,omitempty"`
+}
+
+func (x *EffectiveGrant) Reset() {
+	*x = EffectiveGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_api_resources_users_v1_user_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EffectiveGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectiveGrant) ProtoMessage() {}
+
+func (x *EffectiveGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_api_resources_users_v1_user_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectiveGrant.ProtoReflect.Descriptor instead.
+func (*EffectiveGrant) Descriptor() ([]byte, []int) {
+	return file_controller_api_resources_users_v1_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EffectiveGrant) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *EffectiveGrant) GetCanonical() string {
+	if x != nil {
+		return x.Canonical
+	}
+	return ""
+}
+
+func (x *EffectiveGrant) GetPrincipalIds() []string {
+	if x != nil {
+		return x.PrincipalIds
+	}
+	return nil
+}
+
+// EffectiveGrantScope contains the grants of a User that apply in a Scope.
+type EffectiveGrantScope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output only. The ID of the Scope the grants apply to.
+	ScopeId string `protobuf:"bytes,10,opt,name=scope_id,proto3" json:"scope_id,omitempty"`
+	// Output only. The grants that apply in the Scope.
+	Grants []*EffectiveGrant `protobuf:"bytes,20,rep,name=grants,proto3" json:"grants,omitempty"`
+}
+
+func (x *EffectiveGrantScope) Reset() {
+	*x = EffectiveGrantScope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_api_resources_users_v1_user_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EffectiveGrantScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectiveGrantScope) ProtoMessage() {}
+
+func (x *EffectiveGrantScope) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_api_resources_users_v1_user_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectiveGrantScope.ProtoReflect.Descriptor instead.
+func (*EffectiveGrantScope) Descriptor() ([]byte, []int) {
+	return file_controller_api_resources_users_v1_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EffectiveGrantScope) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *EffectiveGrantScope) GetGrants() []*EffectiveGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+// EffectiveGrants contains every grant a User holds, grouped by the Scope they apply to.
+type EffectiveGrants struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output only. The ID of the User.
+	UserId string `protobuf:"bytes,10,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	// Output only. The grants of the User for each Scope.
+	Scopes []*EffectiveGrantScope `protobuf:"bytes,20,rep,name=scopes,proto3" json:"scopes,omitempty"`
+}
+
+func (x *EffectiveGrants) Reset() {
+	*x = EffectiveGrants{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_api_resources_users_v1_user_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EffectiveGrants) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectiveGrants) ProtoMessage() {}
+
+func (x *EffectiveGrants) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_api_resources_users_v1_user_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectiveGrants.ProtoReflect.Descriptor instead.
+func (*EffectiveGrants) Descriptor() ([]byte, []int) {
+	return file_controller_api_resources_users_v1_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EffectiveGrants) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EffectiveGrants) GetScopes() []*EffectiveGrantScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
 var File_controller_api_resources_users_v1_user_proto protoreflect.FileDescriptor
 
 var file_controller_api_resources_users_v1_user_proto_rawDesc = []byte{
@@ -272,13 +652,68 @@ var file_controller_api_resources_users_v1_user_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
 	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
 	0x72, 0x63, 0x65, 0x73, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42,
-	0x51, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61,
-	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79,
-	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x3b, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22,
+	0x71, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0e,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x5f, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61,
+	0x6c, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63,
+	0x61, 0x6c, 0x22, 0x8d, 0x03, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x5f, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x32, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x3c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x46, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x50, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x48, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x52, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x0c, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x52, 0x0c, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x5f, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x73, 0x22, 0x6e, 0x0a, 0x0e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d,
+	0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x1e, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x73, 0x22, 0x7c, 0x0a, 0x13, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x49, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18,
+	0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x22, 0x7b, 0x0a, 0x0f, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x4e, 0x0a,
+	0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x42, 0x51, 0x5a,
+	0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x3b, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -293,26 +728,35 @@ func file_controller_api_resources_users_v1_user_proto_rawDescGZIP() []byte {
 	return file_controller_api_resources_users_v1_user_proto_rawDescData
 }
 
-var file_controller_api_resources_users_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_controller_api_resources_users_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_controller_api_resources_users_v1_user_proto_goTypes = []interface{}{
 	(*Account)(nil),              // 0: controller.api.resources.users.v1.Account
 	(*User)(nil),                 // 1: controller.api.resources.users.v1.User
-	(*scopes.ScopeInfo)(nil),     // 2: controller.api.resources.scopes.v1.ScopeInfo
-	(*wrappers.StringValue)(nil), // 3: google.protobuf.StringValue
-	(*timestamp.Timestamp)(nil),  // 4: google.protobuf.Timestamp
+	(*PermissionGrant)(nil),      // 2: controller.api.resources.users.v1.PermissionGrant
+	(*PermissionCheck)(nil),      // 3: controller.api.resources.users.v1.PermissionCheck
+	(*EffectiveGrant)(nil),       // 4: controller.api.resources.users.v1.EffectiveGrant
+	(*EffectiveGrantScope)(nil),  // 5: controller.api.resources.users.v1.EffectiveGrantScope
+	(*EffectiveGrants)(nil),      // 6: controller.api.resources.users.v1.EffectiveGrants
+	(*scopes.ScopeInfo)(nil),     // 7: controller.api.resources.scopes.v1.ScopeInfo
+	(*wrappers.StringValue)(nil), // 8: google.protobuf.StringValue
+	(*timestamp.Timestamp)(nil),  // 9: google.protobuf.Timestamp
 }
 var file_controller_api_resources_users_v1_user_proto_depIdxs = []int32{
-	2, // 0: controller.api.resources.users.v1.User.scope:type_name -> controller.api.resources.scopes.v1.ScopeInfo
-	3, // 1: controller.api.resources.users.v1.User.name:type_name -> google.protobuf.StringValue
-	3, // 2: controller.api.resources.users.v1.User.description:type_name -> google.protobuf.StringValue
-	4, // 3: controller.api.resources.users.v1.User.created_time:type_name -> google.protobuf.Timestamp
-	4, // 4: controller.api.resources.users.v1.User.updated_time:type_name -> google.protobuf.Timestamp
-	0, // 5: controller.api.resources.users.v1.User.accounts:type_name -> controller.api.resources.users.v1.Account
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	7,  // 0: controller.api.resources.users.v1.User.scope:type_name -> controller.api.resources.scopes.v1.ScopeInfo
+	8,  // 1: controller.api.resources.users.v1.User.name:type_name -> google.protobuf.StringValue
+	8,  // 2: controller.api.resources.users.v1.User.description:type_name -> google.protobuf.StringValue
+	9,  // 3: controller.api.resources.users.v1.User.created_time:type_name -> google.protobuf.Timestamp
+	9,  // 4: controller.api.resources.users.v1.User.updated_time:type_name -> google.protobuf.Timestamp
+	0,  // 5: controller.api.resources.users.v1.User.accounts:type_name -> controller.api.resources.users.v1.Account
+	2,  // 6: controller.api.resources.users.v1.PermissionCheck.grant:type_name -> controller.api.resources.users.v1.PermissionGrant
+	2,  // 7: controller.api.resources.users.v1.PermissionCheck.scope_grants:type_name -> controller.api.resources.users.v1.PermissionGrant
+	4,  // 8: controller.api.resources.users.v1.EffectiveGrantScope.grants:type_name -> controller.api.resources.users.v1.EffectiveGrant
+	5,  // 9: controller.api.resources.users.v1.EffectiveGrants.scopes:type_name -> controller.api.resources.users.v1.EffectiveGrantScope
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_controller_api_resources_users_v1_user_proto_init() }
@@ -345,6 +789,66 @@ func file_controller_api_resources_users_v1_user_proto_init() {
 				return nil
 			}
 		}
+		file_controller_api_resources_users_v1_user_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_controller_api_resources_users_v1_user_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_controller_api_resources_users_v1_user_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EffectiveGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_controller_api_resources_users_v1_user_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EffectiveGrantScope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_controller_api_resources_users_v1_user_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EffectiveGrants); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -352,7 +856,7 @@ func file_controller_api_resources_users_v1_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_controller_api_resources_users_v1_user_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -45,6 +45,11 @@ type AuthMethodServiceClient interface {
 	DeleteAuthMethod(ctx context.Context, in *DeleteAuthMethodRequest, opts ...grpc.CallOption) (*DeleteAuthMethodResponse, error)
 	// Authenticate validates credentials provided and returns an Auth Token.
 	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
+	// AuthenticateStart begins an authentication flow which is completed outside
+	// of Boundary, such as the authorization code flow of an oidc Auth Method.
+	// The user must be sent to the returned URL. The flow is completed by
+	// calling Authenticate with the values returned to the redirect URL.
+	AuthenticateStart(ctx context.Context, in *AuthenticateStartRequest, opts ...grpc.CallOption) (*AuthenticateStartResponse, error)
 }
 
 type authMethodServiceClient struct {
@@ -109,6 +114,15 @@ func (c *authMethodServiceClient) Authenticate(ctx context.Context, in *Authenti
 	return out, nil
 }
 
+func (c *authMethodServiceClient) AuthenticateStart(ctx context.Context, in *AuthenticateStartRequest, opts ...grpc.CallOption) (*AuthenticateStartResponse, error) {
+	out := new(AuthenticateStartResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AuthMethodService/AuthenticateStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthMethodServiceServer is the server API for AuthMethodService service.
 type AuthMethodServiceServer interface {
 	// GetAuthMethod returns a stored Auth Method if present.  The provided request
@@ -139,6 +153,11 @@ type AuthMethodServiceServer interface {
 	DeleteAuthMethod(context.Context, *DeleteAuthMethodRequest) (*DeleteAuthMethodResponse, error)
 	// Authenticate validates credentials provided and returns an Auth Token.
 	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
+	// AuthenticateStart begins an authentication flow which is completed outside
+	// of Boundary, such as the authorization code flow of an oidc Auth Method.
+	// The user must be sent to the returned URL. The flow is completed by
+	// calling Authenticate with the values returned to the redirect URL.
+	AuthenticateStart(context.Context, *AuthenticateStartRequest) (*AuthenticateStartResponse, error)
 }
 
 // UnimplementedAuthMethodServiceServer can be embedded to have forward compatible implementations.
@@ -163,6 +182,9 @@ func (*UnimplementedAuthMethodServiceServer) DeleteAuthMethod(context.Context, *
 func (*UnimplementedAuthMethodServiceServer) Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
 }
+func (*UnimplementedAuthMethodServiceServer) AuthenticateStart(context.Context, *AuthenticateStartRequest) (*AuthenticateStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateStart not implemented")
+}
 
 func RegisterAuthMethodServiceServer(s *grpc.Server, srv AuthMethodServiceServer) {
 	s.RegisterService(&_AuthMethodService_serviceDesc, srv)
@@ -276,6 +298,24 @@ func _AuthMethodService_Authenticate_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthMethodService_AuthenticateStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthMethodServiceServer).AuthenticateStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AuthMethodService/AuthenticateStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthMethodServiceServer).AuthenticateStart(ctx, req.(*AuthenticateStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AuthMethodService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "controller.api.services.v1.AuthMethodService",
 	HandlerType: (*AuthMethodServiceServer)(nil),
@@ -304,6 +344,10 @@ var _AuthMethodService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Authenticate",
 			Handler:    _AuthMethodService_Authenticate_Handler,
 		},
+		{
+			MethodName: "AuthenticateStart",
+			Handler:    _AuthMethodService_AuthenticateStart_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/auth_method_service.proto",
@@ -0,0 +1,270 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// CredentialStoreServiceClient is the client API for CredentialStoreService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CredentialStoreServiceClient interface {
+	// GetCredentialStore returns a stored Credential Store if present.  The provided
+	// request must include the Credential Store ID for the Credential Store being retrieved.
+	// If missing, malformed, or referencing a non-existing resource an error is
+	// returned.
+	GetCredentialStore(ctx context.Context, in *GetCredentialStoreRequest, opts ...grpc.CallOption) (*GetCredentialStoreResponse, error)
+	// ListCredentialStores returns a list of stored Credential Stores which exist inside
+	// the scope referenced in the request. The request must include the scope
+	// ID and if it is  missing, malformed, or references a non-existing resource
+	// an error is returned.
+	ListCredentialStores(ctx context.Context, in *ListCredentialStoresRequest, opts ...grpc.CallOption) (*ListCredentialStoresResponse, error)
+	// CreateCredentialStore creates and stores a Credential Store in boundary.  The
+	// provided request must include the scope ID in which the Credential Store will be
+	// created. If the scope ID is missing, malformed, or reference a non-existing parent resource an
+	// error is returned.
+	CreateCredentialStore(ctx context.Context, in *CreateCredentialStoreRequest, opts ...grpc.CallOption) (*CreateCredentialStoreResponse, error)
+	// UpdateCredentialStore updates an existing Credential Store in Boundary. The
+	// provided Credential Store must not have any read only fields set. The update
+	// mask must be provided and include at least 1 mutable field. To unset a
+	// field's value include that field name in the update mask and don't set the
+	// field in the Credential Store.
+	UpdateCredentialStore(ctx context.Context, in *UpdateCredentialStoreRequest, opts ...grpc.CallOption) (*UpdateCredentialStoreResponse, error)
+	// DeleteCredentialStore removes a Credential Store and all associated
+	// Credentials from Boundary. If the provided Credential Store ID is malformed
+	// or not provided DeleteCredentialStore returns an error.
+	DeleteCredentialStore(ctx context.Context, in *DeleteCredentialStoreRequest, opts ...grpc.CallOption) (*DeleteCredentialStoreResponse, error)
+}
+
+type credentialStoreServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCredentialStoreServiceClient(cc grpc.ClientConnInterface) CredentialStoreServiceClient {
+	return &credentialStoreServiceClient{cc}
+}
+
+func (c *credentialStoreServiceClient) GetCredentialStore(ctx context.Context, in *GetCredentialStoreRequest, opts ...grpc.CallOption) (*GetCredentialStoreResponse, error) {
+	out := new(GetCredentialStoreResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialStoreService/GetCredentialStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialStoreServiceClient) ListCredentialStores(ctx context.Context, in *ListCredentialStoresRequest, opts ...grpc.CallOption) (*ListCredentialStoresResponse, error) {
+	out := new(ListCredentialStoresResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialStoreService/ListCredentialStores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialStoreServiceClient) CreateCredentialStore(ctx context.Context, in *CreateCredentialStoreRequest, opts ...grpc.CallOption) (*CreateCredentialStoreResponse, error) {
+	out := new(CreateCredentialStoreResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialStoreService/CreateCredentialStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialStoreServiceClient) UpdateCredentialStore(ctx context.Context, in *UpdateCredentialStoreRequest, opts ...grpc.CallOption) (*UpdateCredentialStoreResponse, error) {
+	out := new(UpdateCredentialStoreResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialStoreService/UpdateCredentialStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialStoreServiceClient) DeleteCredentialStore(ctx context.Context, in *DeleteCredentialStoreRequest, opts ...grpc.CallOption) (*DeleteCredentialStoreResponse, error) {
+	out := new(DeleteCredentialStoreResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialStoreService/DeleteCredentialStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CredentialStoreServiceServer is the server API for CredentialStoreService service.
+type CredentialStoreServiceServer interface {
+	// GetCredentialStore returns a stored Credential Store if present.  The provided
+	// request must include the Credential Store ID for the Credential Store being retrieved.
+	// If missing, malformed, or referencing a non-existing resource an error is
+	// returned.
+	GetCredentialStore(context.Context, *GetCredentialStoreRequest) (*GetCredentialStoreResponse, error)
+	// ListCredentialStores returns a list of stored Credential Stores which exist inside
+	// the scope referenced in the request. The request must include the scope
+	// ID and if it is  missing, malformed, or references a non-existing resource
+	// an error is returned.
+	ListCredentialStores(context.Context, *ListCredentialStoresRequest) (*ListCredentialStoresResponse, error)
+	// CreateCredentialStore creates and stores a Credential Store in boundary.  The
+	// provided request must include the scope ID in which the Credential Store will be
+	// created. If the scope ID is missing, malformed, or reference a non-existing parent resource an
+	// error is returned.
+	CreateCredentialStore(context.Context, *CreateCredentialStoreRequest) (*CreateCredentialStoreResponse, error)
+	// UpdateCredentialStore updates an existing Credential Store in Boundary. The
+	// provided Credential Store must not have any read only fields set. The update
+	// mask must be provided and include at least 1 mutable field. To unset a
+	// field's value include that field name in the update mask and don't set the
+	// field in the Credential Store.
+	UpdateCredentialStore(context.Context, *UpdateCredentialStoreRequest) (*UpdateCredentialStoreResponse, error)
+	// DeleteCredentialStore removes a Credential Store and all associated
+	// Credentials from Boundary. If the provided Credential Store ID is malformed
+	// or not provided DeleteCredentialStore returns an error.
+	DeleteCredentialStore(context.Context, *DeleteCredentialStoreRequest) (*DeleteCredentialStoreResponse, error)
+}
+
+// UnimplementedCredentialStoreServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedCredentialStoreServiceServer struct {
+}
+
+func (*UnimplementedCredentialStoreServiceServer) GetCredentialStore(context.Context, *GetCredentialStoreRequest) (*GetCredentialStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCredentialStore not implemented")
+}
+func (*UnimplementedCredentialStoreServiceServer) ListCredentialStores(context.Context, *ListCredentialStoresRequest) (*ListCredentialStoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialStores not implemented")
+}
+func (*UnimplementedCredentialStoreServiceServer) CreateCredentialStore(context.Context, *CreateCredentialStoreRequest) (*CreateCredentialStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredentialStore not implemented")
+}
+func (*UnimplementedCredentialStoreServiceServer) UpdateCredentialStore(context.Context, *UpdateCredentialStoreRequest) (*UpdateCredentialStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentialStore not implemented")
+}
+func (*UnimplementedCredentialStoreServiceServer) DeleteCredentialStore(context.Context, *DeleteCredentialStoreRequest) (*DeleteCredentialStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredentialStore not implemented")
+}
+
+func RegisterCredentialStoreServiceServer(s *grpc.Server, srv CredentialStoreServiceServer) {
+	s.RegisterService(&_CredentialStoreService_serviceDesc, srv)
+}
+
+func _CredentialStoreService_GetCredentialStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCredentialStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialStoreServiceServer).GetCredentialStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.CredentialStoreService/GetCredentialStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialStoreServiceServer).GetCredentialStore(ctx, req.(*GetCredentialStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialStoreService_ListCredentialStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialStoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialStoreServiceServer).ListCredentialStores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.CredentialStoreService/ListCredentialStores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialStoreServiceServer).ListCredentialStores(ctx, req.(*ListCredentialStoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialStoreService_CreateCredentialStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCredentialStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialStoreServiceServer).CreateCredentialStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.CredentialStoreService/CreateCredentialStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialStoreServiceServer).CreateCredentialStore(ctx, req.(*CreateCredentialStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialStoreService_UpdateCredentialStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialStoreServiceServer).UpdateCredentialStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.CredentialStoreService/UpdateCredentialStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialStoreServiceServer).UpdateCredentialStore(ctx, req.(*UpdateCredentialStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialStoreService_DeleteCredentialStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCredentialStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialStoreServiceServer).DeleteCredentialStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.CredentialStoreService/DeleteCredentialStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialStoreServiceServer).DeleteCredentialStore(ctx, req.(*DeleteCredentialStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CredentialStoreService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "controller.api.services.v1.CredentialStoreService",
+	HandlerType: (*CredentialStoreServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCredentialStore",
+			Handler:    _CredentialStoreService_GetCredentialStore_Handler,
+		},
+		{
+			MethodName: "ListCredentialStores",
+			Handler:    _CredentialStoreService_ListCredentialStores_Handler,
+		},
+		{
+			MethodName: "CreateCredentialStore",
+			Handler:    _CredentialStoreService_CreateCredentialStore_Handler,
+		},
+		{
+			MethodName: "UpdateCredentialStore",
+			Handler:    _CredentialStoreService_UpdateCredentialStore_Handler,
+		},
+		{
+			MethodName: "DeleteCredentialStore",
+			Handler:    _CredentialStoreService_DeleteCredentialStore_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "controller/api/services/v1/credential_store_service.proto",
+}
@@ -31,6 +31,19 @@ type SessionServiceClient interface {
 	// is returned if the request attempts to cancel a Session that does
 	// not exist.
 	CancelSession(ctx context.Context, in *CancelSessionRequest, opts ...grpc.CallOption) (*CancelSessionResponse, error)
+	// ListSessionRecordings returns the recordings available for the
+	// connections of a Session, if the Session's Target records sessions,
+	// without their data. Recordings are only available once the worker has
+	// uploaded them after the connection closed.
+	ListSessionRecordings(ctx context.Context, in *ListSessionRecordingsRequest, opts ...grpc.CallOption) (*ListSessionRecordingsResponse, error)
+	// DownloadSessionRecording streams the decrypted recording of a
+	// connection of a Session as it is read from storage. The request must
+	// include the Session and connection IDs. If the recording can not be
+	// read completely, what could be read of it is sent before a last
+	// response holding the reason. Over HTTP, the recording is served as the
+	// body of "/v1/sessions/{id}:download-recording", and the reason is sent
+	// in the "Boundary-Recording-Error" trailer.
+	DownloadSessionRecording(ctx context.Context, in *DownloadSessionRecordingRequest, opts ...grpc.CallOption) (SessionService_DownloadSessionRecordingClient, error)
 }
 
 type sessionServiceClient struct {
@@ -68,6 +81,47 @@ func (c *sessionServiceClient) CancelSession(ctx context.Context, in *CancelSess
 	return out, nil
 }
 
+func (c *sessionServiceClient) ListSessionRecordings(ctx context.Context, in *ListSessionRecordingsRequest, opts ...grpc.CallOption) (*ListSessionRecordingsResponse, error) {
+	out := new(ListSessionRecordingsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.SessionService/ListSessionRecordings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) DownloadSessionRecording(ctx context.Context, in *DownloadSessionRecordingRequest, opts ...grpc.CallOption) (SessionService_DownloadSessionRecordingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SessionService_serviceDesc.Streams[0], "/controller.api.services.v1.SessionService/DownloadSessionRecording", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sessionServiceDownloadSessionRecordingClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SessionService_DownloadSessionRecordingClient interface {
+	Recv() (*DownloadSessionRecordingResponse, error)
+	grpc.ClientStream
+}
+
+type sessionServiceDownloadSessionRecordingClient struct {
+	grpc.ClientStream
+}
+
+func (x *sessionServiceDownloadSessionRecordingClient) Recv() (*DownloadSessionRecordingResponse, error) {
+	m := new(DownloadSessionRecordingResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 type SessionServiceServer interface {
 	// GetSession returns a stored Session if present.  The provided request
@@ -84,6 +138,19 @@ type SessionServiceServer interface {
 	// is returned if the request attempts to cancel a Session that does
 	// not exist.
 	CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error)
+	// ListSessionRecordings returns the recordings available for the
+	// connections of a Session, if the Session's Target records sessions,
+	// without their data. Recordings are only available once the worker has
+	// uploaded them after the connection closed.
+	ListSessionRecordings(context.Context, *ListSessionRecordingsRequest) (*ListSessionRecordingsResponse, error)
+	// DownloadSessionRecording streams the decrypted recording of a
+	// connection of a Session as it is read from storage. The request must
+	// include the Session and connection IDs. If the recording can not be
+	// read completely, what could be read of it is sent before a last
+	// response holding the reason. Over HTTP, the recording is served as the
+	// body of "/v1/sessions/{id}:download-recording", and the reason is sent
+	// in the "Boundary-Recording-Error" trailer.
+	DownloadSessionRecording(*DownloadSessionRecordingRequest, SessionService_DownloadSessionRecordingServer) error
 }
 
 // UnimplementedSessionServiceServer can be embedded to have forward compatible implementations.
@@ -99,6 +166,12 @@ func (*UnimplementedSessionServiceServer) ListSessions(context.Context, *ListSes
 func (*UnimplementedSessionServiceServer) CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSession not implemented")
 }
+func (*UnimplementedSessionServiceServer) ListSessionRecordings(context.Context, *ListSessionRecordingsRequest) (*ListSessionRecordingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessionRecordings not implemented")
+}
+func (*UnimplementedSessionServiceServer) DownloadSessionRecording(*DownloadSessionRecordingRequest, SessionService_DownloadSessionRecordingServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadSessionRecording not implemented")
+}
 
 func RegisterSessionServiceServer(s *grpc.Server, srv SessionServiceServer) {
 	s.RegisterService(&_SessionService_serviceDesc, srv)
@@ -158,6 +231,45 @@ func _SessionService_CancelSession_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_ListSessionRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionRecordingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ListSessionRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.SessionService/ListSessionRecordings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ListSessionRecordings(ctx, req.(*ListSessionRecordingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_DownloadSessionRecording_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadSessionRecordingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SessionServiceServer).DownloadSessionRecording(m, &sessionServiceDownloadSessionRecordingServer{stream})
+}
+
+type SessionService_DownloadSessionRecordingServer interface {
+	Send(*DownloadSessionRecordingResponse) error
+	grpc.ServerStream
+}
+
+type sessionServiceDownloadSessionRecordingServer struct {
+	grpc.ServerStream
+}
+
+func (x *sessionServiceDownloadSessionRecordingServer) Send(m *DownloadSessionRecordingResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _SessionService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "controller.api.services.v1.SessionService",
 	HandlerType: (*SessionServiceServer)(nil),
@@ -174,7 +286,17 @@ var _SessionService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSession",
 			Handler:    _SessionService_CancelSession_Handler,
 		},
+		{
+			MethodName: "ListSessionRecordings",
+			Handler:    _SessionService_ListSessionRecordings_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadSessionRecording",
+			Handler:       _SessionService_DownloadSessionRecording_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/session_service.proto",
 }
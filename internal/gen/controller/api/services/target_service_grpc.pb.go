@@ -70,6 +70,20 @@ type TargetServiceClient interface {
 	// returned.  An error is returned if a Host Set is attempted to be
 	// removed from the Target when the Target does not have the Host Set.
 	RemoveTargetHostSets(ctx context.Context, in *RemoveTargetHostSetsRequest, opts ...grpc.CallOption) (*RemoveTargetHostSetsResponse, error)
+	// AddTargetCredentials attaches Credentials to this Target. The provided
+	// request must include the Target ID to which the Credentials will be
+	// attached. All Credentials must be in a Credential Store in the same scope
+	// as this Target. An error is returned if a Credential is already attached
+	// to the Target.
+	AddTargetCredentials(ctx context.Context, in *AddTargetCredentialsRequest, opts ...grpc.CallOption) (*AddTargetCredentialsResponse, error)
+	// SetTargetCredentials sets the Target's Credentials. Any Credentials
+	// attached to the Target are detached if they are not included in this
+	// request. All Credentials in the request must be in a Credential Store in
+	// the same scope as the Target.
+	SetTargetCredentials(ctx context.Context, in *SetTargetCredentialsRequest, opts ...grpc.CallOption) (*SetTargetCredentialsResponse, error)
+	// RemoveTargetCredentials detaches Credentials from the specified Target.
+	// An error is returned if a Credential is not attached to the Target.
+	RemoveTargetCredentials(ctx context.Context, in *RemoveTargetCredentialsRequest, opts ...grpc.CallOption) (*RemoveTargetCredentialsResponse, error)
 }
 
 type targetServiceClient struct {
@@ -161,6 +175,33 @@ func (c *targetServiceClient) RemoveTargetHostSets(ctx context.Context, in *Remo
 	return out, nil
 }
 
+func (c *targetServiceClient) AddTargetCredentials(ctx context.Context, in *AddTargetCredentialsRequest, opts ...grpc.CallOption) (*AddTargetCredentialsResponse, error) {
+	out := new(AddTargetCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.TargetService/AddTargetCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetServiceClient) SetTargetCredentials(ctx context.Context, in *SetTargetCredentialsRequest, opts ...grpc.CallOption) (*SetTargetCredentialsResponse, error) {
+	out := new(SetTargetCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.TargetService/SetTargetCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetServiceClient) RemoveTargetCredentials(ctx context.Context, in *RemoveTargetCredentialsRequest, opts ...grpc.CallOption) (*RemoveTargetCredentialsResponse, error) {
+	out := new(RemoveTargetCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.TargetService/RemoveTargetCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TargetServiceServer is the server API for TargetService service.
 type TargetServiceServer interface {
 	// GetTarget returns a stored Target if present.  The provided request
@@ -216,6 +257,20 @@ type TargetServiceServer interface {
 	// returned.  An error is returned if a Host Set is attempted to be
 	// removed from the Target when the Target does not have the Host Set.
 	RemoveTargetHostSets(context.Context, *RemoveTargetHostSetsRequest) (*RemoveTargetHostSetsResponse, error)
+	// AddTargetCredentials attaches Credentials to this Target. The provided
+	// request must include the Target ID to which the Credentials will be
+	// attached. All Credentials must be in a Credential Store in the same scope
+	// as this Target. An error is returned if a Credential is already attached
+	// to the Target.
+	AddTargetCredentials(context.Context, *AddTargetCredentialsRequest) (*AddTargetCredentialsResponse, error)
+	// SetTargetCredentials sets the Target's Credentials. Any Credentials
+	// attached to the Target are detached if they are not included in this
+	// request. All Credentials in the request must be in a Credential Store in
+	// the same scope as the Target.
+	SetTargetCredentials(context.Context, *SetTargetCredentialsRequest) (*SetTargetCredentialsResponse, error)
+	// RemoveTargetCredentials detaches Credentials from the specified Target.
+	// An error is returned if a Credential is not attached to the Target.
+	RemoveTargetCredentials(context.Context, *RemoveTargetCredentialsRequest) (*RemoveTargetCredentialsResponse, error)
 }
 
 // UnimplementedTargetServiceServer can be embedded to have forward compatible implementations.
@@ -249,6 +304,15 @@ func (*UnimplementedTargetServiceServer) SetTargetHostSets(context.Context, *Set
 func (*UnimplementedTargetServiceServer) RemoveTargetHostSets(context.Context, *RemoveTargetHostSetsRequest) (*RemoveTargetHostSetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTargetHostSets not implemented")
 }
+func (*UnimplementedTargetServiceServer) AddTargetCredentials(context.Context, *AddTargetCredentialsRequest) (*AddTargetCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTargetCredentials not implemented")
+}
+func (*UnimplementedTargetServiceServer) SetTargetCredentials(context.Context, *SetTargetCredentialsRequest) (*SetTargetCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTargetCredentials not implemented")
+}
+func (*UnimplementedTargetServiceServer) RemoveTargetCredentials(context.Context, *RemoveTargetCredentialsRequest) (*RemoveTargetCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTargetCredentials not implemented")
+}
 
 func RegisterTargetServiceServer(s *grpc.Server, srv TargetServiceServer) {
 	s.RegisterService(&_TargetService_serviceDesc, srv)
@@ -416,6 +480,60 @@ func _TargetService_RemoveTargetHostSets_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TargetService_AddTargetCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTargetCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).AddTargetCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.TargetService/AddTargetCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).AddTargetCredentials(ctx, req.(*AddTargetCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetService_SetTargetCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTargetCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).SetTargetCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.TargetService/SetTargetCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).SetTargetCredentials(ctx, req.(*SetTargetCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetService_RemoveTargetCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTargetCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).RemoveTargetCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.TargetService/RemoveTargetCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).RemoveTargetCredentials(ctx, req.(*RemoveTargetCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TargetService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "controller.api.services.v1.TargetService",
 	HandlerType: (*TargetServiceServer)(nil),
@@ -456,6 +574,18 @@ var _TargetService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveTargetHostSets",
 			Handler:    _TargetService_RemoveTargetHostSets_Handler,
 		},
+		{
+			MethodName: "AddTargetCredentials",
+			Handler:    _TargetService_AddTargetCredentials_Handler,
+		},
+		{
+			MethodName: "SetTargetCredentials",
+			Handler:    _TargetService_SetTargetCredentials_Handler,
+		},
+		{
+			MethodName: "RemoveTargetCredentials",
+			Handler:    _TargetService_RemoveTargetCredentials_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/target_service.proto",
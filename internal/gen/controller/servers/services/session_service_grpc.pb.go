@@ -28,6 +28,9 @@ type SessionServiceClient interface {
 	ConnectConnection(ctx context.Context, in *ConnectConnectionRequest, opts ...grpc.CallOption) (*ConnectConnectionResponse, error)
 	// CloseConnections updates a connection to set it to closed
 	CloseConnection(ctx context.Context, in *CloseConnectionRequest, opts ...grpc.CallOption) (*CloseConnectionResponse, error)
+	// UploadRecording streams the encrypted recording of a closed connection
+	// to the controller for storage
+	UploadRecording(ctx context.Context, opts ...grpc.CallOption) (SessionService_UploadRecordingClient, error)
 }
 
 type sessionServiceClient struct {
@@ -83,6 +86,40 @@ func (c *sessionServiceClient) CloseConnection(ctx context.Context, in *CloseCon
 	return out, nil
 }
 
+func (c *sessionServiceClient) UploadRecording(ctx context.Context, opts ...grpc.CallOption) (SessionService_UploadRecordingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SessionService_serviceDesc.Streams[0], "/controller.servers.services.v1.SessionService/UploadRecording", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sessionServiceUploadRecordingClient{stream}
+	return x, nil
+}
+
+type SessionService_UploadRecordingClient interface {
+	Send(*UploadRecordingRequest) error
+	CloseAndRecv() (*UploadRecordingResponse, error)
+	grpc.ClientStream
+}
+
+type sessionServiceUploadRecordingClient struct {
+	grpc.ClientStream
+}
+
+func (x *sessionServiceUploadRecordingClient) Send(m *UploadRecordingRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sessionServiceUploadRecordingClient) CloseAndRecv() (*UploadRecordingResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadRecordingResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 type SessionServiceServer interface {
 	// GetSession allows a worker to retrieve session information from the
@@ -96,6 +133,9 @@ type SessionServiceServer interface {
 	ConnectConnection(context.Context, *ConnectConnectionRequest) (*ConnectConnectionResponse, error)
 	// CloseConnections updates a connection to set it to closed
 	CloseConnection(context.Context, *CloseConnectionRequest) (*CloseConnectionResponse, error)
+	// UploadRecording streams the encrypted recording of a closed connection
+	// to the controller for storage
+	UploadRecording(SessionService_UploadRecordingServer) error
 }
 
 // UnimplementedSessionServiceServer can be embedded to have forward compatible implementations.
@@ -117,6 +157,9 @@ func (*UnimplementedSessionServiceServer) ConnectConnection(context.Context, *Co
 func (*UnimplementedSessionServiceServer) CloseConnection(context.Context, *CloseConnectionRequest) (*CloseConnectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseConnection not implemented")
 }
+func (*UnimplementedSessionServiceServer) UploadRecording(SessionService_UploadRecordingServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadRecording not implemented")
+}
 
 func RegisterSessionServiceServer(s *grpc.Server, srv SessionServiceServer) {
 	s.RegisterService(&_SessionService_serviceDesc, srv)
@@ -212,6 +255,32 @@ func _SessionService_CloseConnection_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_UploadRecording_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SessionServiceServer).UploadRecording(&sessionServiceUploadRecordingServer{stream})
+}
+
+type SessionService_UploadRecordingServer interface {
+	SendAndClose(*UploadRecordingResponse) error
+	Recv() (*UploadRecordingRequest, error)
+	grpc.ServerStream
+}
+
+type sessionServiceUploadRecordingServer struct {
+	grpc.ServerStream
+}
+
+func (x *sessionServiceUploadRecordingServer) SendAndClose(m *UploadRecordingResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *sessionServiceUploadRecordingServer) Recv() (*UploadRecordingRequest, error) {
+	m := new(UploadRecordingRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _SessionService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "controller.servers.services.v1.SessionService",
 	HandlerType: (*SessionServiceServer)(nil),
@@ -237,6 +306,12 @@ var _SessionService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _SessionService_CloseConnection_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadRecording",
+			Handler:       _SessionService_UploadRecording_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "controller/servers/services/v1/session_service.proto",
 }
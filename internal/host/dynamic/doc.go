@@ -0,0 +1,35 @@
+// Package dynamic provides a host, a host catalog, and a host set for hosts
+// which are discovered from an external inventory rather than entered
+// through the API.
+//
+// A host catalog has a source, which is either the path to a JSON or YAML
+// file readable by the controllers or an http or https URL returning the
+// same. The controllers periodically load the inventory from the source and
+// sync the catalog's hosts with it: hosts are created, updated, and deleted
+// so that the catalog matches the inventory. Hosts are identified by their
+// id in the inventory, so a host keeps its public id across refreshes as
+// long as its id in the inventory does not change.
+//
+// A host set has a filter, which is a boolean expression evaluated against
+// each host in its catalog. The hosts for which the filter evaluates to true
+// are the members of the set. Membership is recomputed whenever the hosts in
+// the catalog or the filter of the set change. See Filter for the fields
+// available to filters.
+//
+// An inventory is either a JSON or YAML object with a hosts key or a list
+// of hosts:
+//
+//  {
+//    "hosts": [
+//      {
+//        "id": "web-1",
+//        "name": "web-1",
+//        "address": "10.0.0.1",
+//        "attributes": { "env": "prod", "roles": ["web"] }
+//      }
+//    ]
+//  }
+//
+// Only address is required. If id is not set the address is used as the
+// id.
+package dynamic
@@ -0,0 +1,23 @@
+package dynamic
+
+import "errors"
+
+var (
+	// ErrInvalidSource results from attempting to set the source of a host
+	// catalog to a value that is neither a file path nor an http or https
+	// URL.
+	ErrInvalidSource = errors.New("invalid source")
+
+	// ErrSourceNotAllowed results from attempting to set the source of a
+	// host catalog to, or load an inventory from, a file outside of the
+	// allowed directories or a URL whose host is not allowed.
+	ErrSourceNotAllowed = errors.New("source not allowed")
+
+	// ErrInvalidFilter results from attempting to set the filter of a host
+	// set to an expression that cannot be parsed.
+	ErrInvalidFilter = errors.New("invalid filter")
+
+	// ErrInvalidInventory results from loading an inventory that cannot be
+	// parsed or contains invalid hosts.
+	ErrInvalidInventory = errors.New("invalid inventory")
+)
@@ -0,0 +1,59 @@
+package dynamic
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-bexpr"
+)
+
+// Filter is a compiled host set filter.
+//
+// Filters use the go-bexpr syntax and are evaluated against a document
+// with the following fields for each host:
+//
+//  id          the id of the host in the inventory
+//  name        the name of the host in the inventory
+//  address     the address of the host
+//  attributes  the attributes of the host in the inventory
+//
+// For example, `attributes.env == "prod" and "web" in attributes.roles`.
+// A filter that references a field a host does not have does not match
+// that host.
+type Filter struct {
+	eval *bexpr.Evaluator
+}
+
+// NewFilter compiles filter. It returns an error wrapping ErrInvalidFilter
+// if filter is empty or cannot be parsed.
+func NewFilter(filter string) (*Filter, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, fmt.Errorf("filter is empty: %w", ErrInvalidFilter)
+	}
+	eval, err := bexpr.CreateEvaluator(filter)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrInvalidFilter)
+	}
+	return &Filter{eval: eval}, nil
+}
+
+// Match reports whether h matches the filter.
+func (f *Filter) Match(h *InventoryHost) bool {
+	attrs := h.Attributes
+	if attrs == nil {
+		attrs = map[string]interface{}{}
+	}
+	ok, err := f.eval.Evaluate(map[string]interface{}{
+		"id":         h.Id,
+		"name":       h.Name,
+		"address":    h.Address,
+		"attributes": attrs,
+	})
+	if err != nil {
+		// Evaluation errors mean a selector doesn't resolve for this host
+		// or resolves to a value of the wrong type, neither of which is a
+		// match.
+		return false
+	}
+	return ok
+}
@@ -0,0 +1,77 @@
+package dynamic
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFilter(t *testing.T) {
+	t.Parallel()
+	var tests = []struct {
+		name    string
+		filter  string
+		wantErr bool
+	}{
+		{name: "valid", filter: `attributes.env == "prod"`},
+		{name: "empty", filter: "", wantErr: true},
+		{name: "blank", filter: "  ", wantErr: true},
+		{name: "unparsable", filter: `attributes.env ==`, wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFilter(tt.filter)
+			if tt.wantErr {
+				assert.Truef(t, errors.Is(err, ErrInvalidFilter), "want err: %q got: %q", ErrInvalidFilter, err)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, got)
+		})
+	}
+}
+
+func TestFilter_Match(t *testing.T) {
+	t.Parallel()
+	web := &InventoryHost{
+		Id:      "web-1",
+		Name:    "web one",
+		Address: "10.0.0.1",
+		Attributes: map[string]interface{}{
+			"env":   "prod",
+			"roles": []interface{}{"web", "edge"},
+		},
+	}
+	bare := &InventoryHost{
+		Id:      "10.0.0.2",
+		Address: "10.0.0.2",
+	}
+
+	var tests = []struct {
+		name   string
+		filter string
+		host   *InventoryHost
+		want   bool
+	}{
+		{name: "attribute-match", filter: `attributes.env == "prod"`, host: web, want: true},
+		{name: "attribute-no-match", filter: `attributes.env == "dev"`, host: web},
+		{name: "list-contains", filter: `"edge" in attributes.roles`, host: web, want: true},
+		{name: "combined", filter: `"web" in attributes.roles and name == "web one"`, host: web, want: true},
+		{name: "id", filter: `id == "web-1"`, host: web, want: true},
+		{name: "address", filter: `address matches "^10\\.0\\.0\\."`, host: bare, want: true},
+		{name: "missing-attribute", filter: `attributes.env == "prod"`, host: bare},
+		{name: "negated-missing-attribute", filter: `attributes.env != "prod"`, host: bare},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewFilter(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, f.Match(tt.host))
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package dynamic
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/host/dynamic/store"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	MinHostAddressLength = 3
+	MaxHostAddressLength = 255
+)
+
+// A Host is a host synced from the inventory of its catalog. Hosts are
+// created, updated, and deleted by refreshing the catalog and cannot be
+// changed through the API.
+type Host struct {
+	*store.Host
+	tableName string `gorm:"-"`
+}
+
+// TableName returns the table name for the host.
+func (h *Host) TableName() string {
+	if h.tableName != "" {
+		return h.tableName
+	}
+	return "dynamic_host"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (h *Host) SetTableName(n string) {
+	h.tableName = n
+}
+
+// DecodedAttributes returns the attributes of the host from the inventory.
+// It returns an empty map if the host has no attributes.
+func (h *Host) DecodedAttributes() (map[string]interface{}, error) {
+	attrs := map[string]interface{}{}
+	if h.GetAttributes() == "" {
+		return attrs, nil
+	}
+	if err := json.Unmarshal([]byte(h.GetAttributes()), &attrs); err != nil {
+		return nil, fmt.Errorf("decode attributes: dynamic host: %s: %w", h.GetPublicId(), err)
+	}
+	return attrs, nil
+}
+
+func allocHost() *Host {
+	return &Host{
+		Host: &store.Host{},
+	}
+}
+
+func (h *Host) clone() *Host {
+	cp := proto.Clone(h.Host)
+	return &Host{
+		Host: cp.(*store.Host),
+	}
+}
+
+func (h *Host) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{h.PublicId},
+		"resource-type":      []string{"dynamic-host"},
+		"op-type":            []string{op.String()},
+	}
+	if h.CatalogId != "" {
+		metadata["catalog-id"] = []string{h.CatalogId}
+	}
+	return metadata
+}
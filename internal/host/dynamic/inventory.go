@@ -0,0 +1,275 @@
+package dynamic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+const (
+	// maxInventorySize bounds the size of an inventory so that a bad source
+	// can't exhaust the memory of the controller
+	maxInventorySize = 32 << 20
+
+	// httpSourceTimeout bounds the time spent fetching an inventory from an
+	// http or https source
+	httpSourceTimeout = 30 * time.Second
+)
+
+// An InventoryHost is a single host read from an inventory.
+type InventoryHost struct {
+	Id         string                 `json:"id"`
+	Name       string                 `json:"name"`
+	Address    string                 `json:"address"`
+	Attributes map[string]interface{} `json:"attributes"`
+}
+
+// ValidateSource returns an error wrapping ErrInvalidSource if source is
+// neither a file path nor an http or https URL.
+func ValidateSource(source string) error {
+	source = strings.TrimSpace(source)
+	if source == "" {
+		return fmt.Errorf("source is empty: %w", ErrInvalidSource)
+	}
+	if !strings.Contains(source, "://") {
+		return nil
+	}
+	u, err := url.Parse(source)
+	if err != nil {
+		return fmt.Errorf("%s: %v: %w", source, err, ErrInvalidSource)
+	}
+	switch u.Scheme {
+	case "file":
+		if u.Path == "" {
+			return fmt.Errorf("%s: missing path: %w", source, ErrInvalidSource)
+		}
+	case "http", "https":
+		if u.Host == "" {
+			return fmt.Errorf("%s: missing host: %w", source, ErrInvalidSource)
+		}
+	default:
+		return fmt.Errorf("%s: unsupported scheme %q: %w", source, u.Scheme, ErrInvalidSource)
+	}
+	return nil
+}
+
+// AllowedSources are the sources inventories can be read from. File sources
+// must be in one of Directories or their subdirectories, and http and https
+// sources must be on one of Hosts, which match the host of a URL with or
+// without its port. No sources are allowed by the zero value.
+type AllowedSources struct {
+	Directories []string
+	Hosts       []string
+}
+
+// Check returns an error wrapping ErrSourceNotAllowed if source is not one
+// of the allowed sources, or ErrInvalidSource if it is not valid.
+func (a AllowedSources) Check(source string) error {
+	if err := ValidateSource(source); err != nil {
+		return err
+	}
+	if path, ok := SourceFile(source); ok {
+		return a.checkFile(path)
+	}
+	u, err := url.Parse(strings.TrimSpace(source))
+	if err != nil {
+		return fmt.Errorf("%s: %v: %w", source, err, ErrInvalidSource)
+	}
+	return a.checkHost(u)
+}
+
+func (a AllowedSources) checkFile(path string) error {
+	if !filepath.IsAbs(path) {
+		return fmt.Errorf("%s: path is not absolute: %w", path, ErrSourceNotAllowed)
+	}
+	path = filepath.Clean(path)
+	for _, dir := range a.Directories {
+		dir = filepath.Clean(dir)
+		if path == dir || strings.HasPrefix(path, strings.TrimSuffix(dir, string(filepath.Separator))+string(filepath.Separator)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s: not in an allowed directory: %w", path, ErrSourceNotAllowed)
+}
+
+func (a AllowedSources) checkHost(u *url.URL) error {
+	for _, h := range a.Hosts {
+		if strings.EqualFold(h, u.Host) || strings.EqualFold(h, u.Hostname()) {
+			return nil
+		}
+	}
+	return fmt.Errorf("host %q is not allowed: %w", u.Host, ErrSourceNotAllowed)
+}
+
+// SourceFile returns the path of the file read for source and true if
+// source is a file, or "" and false if it is a URL.
+func SourceFile(source string) (string, bool) {
+	source = strings.TrimSpace(source)
+	if strings.HasPrefix(source, "file://") {
+		return strings.TrimPrefix(source, "file://"), true
+	}
+	if strings.Contains(source, "://") {
+		return "", false
+	}
+	return source, true
+}
+
+// LoadInventory reads and parses the inventory at source, which must be one
+// of the allowed sources. Symbolic links in the path of a file source, and
+// redirects of an http or https source, must also stay within them.
+func LoadInventory(ctx context.Context, source string, allowed AllowedSources) ([]*InventoryHost, error) {
+	if err := allowed.Check(source); err != nil {
+		return nil, fmt.Errorf("load inventory: %w", err)
+	}
+	var raw []byte
+	var err error
+	if path, ok := SourceFile(source); ok {
+		raw, err = readFile(path, allowed)
+	} else {
+		raw, err = fetchURL(ctx, strings.TrimSpace(source), allowed)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load inventory: %w", err)
+	}
+	hosts, err := ParseInventory(raw)
+	if err != nil {
+		return nil, fmt.Errorf("load inventory: %s: %w", source, err)
+	}
+	return hosts, nil
+}
+
+func readFile(path string, allowed AllowedSources) ([]byte, error) {
+	// Compare the file and the allowed directories with their symbolic links
+	// resolved, so a link can't lead outside of the allowed directories
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return nil, err
+	}
+	var resolvedAllowed AllowedSources
+	for _, dir := range allowed.Directories {
+		if d, err := filepath.EvalSymlinks(dir); err == nil {
+			dir = d
+		}
+		resolvedAllowed.Directories = append(resolvedAllowed.Directories, dir)
+	}
+	if err := resolvedAllowed.checkFile(resolved); err != nil {
+		return nil, err
+	}
+	f, err := os.Open(resolved)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readLimited(f)
+}
+
+func fetchURL(ctx context.Context, source string, allowed AllowedSources) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, httpSourceTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json, application/yaml")
+	client := cleanhttp.DefaultClient()
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 10 {
+			return errors.New("stopped after 10 redirects")
+		}
+		return allowed.checkHost(req.URL)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status fetching %s: %s", source, resp.Status)
+	}
+	return readLimited(resp.Body)
+}
+
+func readLimited(r io.Reader) ([]byte, error) {
+	raw, err := ioutil.ReadAll(io.LimitReader(r, maxInventorySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) > maxInventorySize {
+		return nil, fmt.Errorf("inventory is larger than %d bytes: %w", maxInventorySize, ErrInvalidInventory)
+	}
+	return raw, nil
+}
+
+// ParseInventory parses a JSON or YAML inventory. The inventory is either
+// an object with a hosts key or a list of hosts. Every host must have an
+// address; hosts without an id use their address as the id. Ids must be
+// unique within the inventory.
+func ParseInventory(raw []byte) ([]*InventoryHost, error) {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 {
+		return nil, fmt.Errorf("inventory is empty: %w", ErrInvalidInventory)
+	}
+	js := trimmed
+	if trimmed[0] != '{' && trimmed[0] != '[' {
+		var err error
+		if js, err = yaml.YAMLToJSON(trimmed); err != nil {
+			return nil, fmt.Errorf("%v: %w", err, ErrInvalidInventory)
+		}
+	}
+
+	var hosts []*InventoryHost
+	switch bytes.TrimSpace(js)[0] {
+	case '[':
+		if err := json.Unmarshal(js, &hosts); err != nil {
+			return nil, fmt.Errorf("%v: %w", err, ErrInvalidInventory)
+		}
+	case '{':
+		var doc struct {
+			Hosts []*InventoryHost `json:"hosts"`
+		}
+		if err := json.Unmarshal(js, &doc); err != nil {
+			return nil, fmt.Errorf("%v: %w", err, ErrInvalidInventory)
+		}
+		hosts = doc.Hosts
+	default:
+		return nil, fmt.Errorf("inventory must be an object or a list: %w", ErrInvalidInventory)
+	}
+
+	seen := make(map[string]bool, len(hosts))
+	for i, h := range hosts {
+		if h == nil {
+			return nil, fmt.Errorf("host %d is empty: %w", i, ErrInvalidInventory)
+		}
+		h.Id = strings.TrimSpace(h.Id)
+		h.Address = strings.TrimSpace(h.Address)
+		h.Name = strings.TrimSpace(h.Name)
+		if h.Address == "" {
+			return nil, fmt.Errorf("host %d has no address: %w", i, ErrInvalidInventory)
+		}
+		if len(h.Address) < MinHostAddressLength || len(h.Address) > MaxHostAddressLength {
+			return nil, fmt.Errorf("host %d: address %q must be between %d and %d characters: %w",
+				i, h.Address, MinHostAddressLength, MaxHostAddressLength, ErrInvalidInventory)
+		}
+		if h.Id == "" {
+			h.Id = h.Address
+		}
+		if seen[h.Id] {
+			return nil, fmt.Errorf("host %d: duplicate id %q: %w", i, h.Id, ErrInvalidInventory)
+		}
+		seen[h.Id] = true
+	}
+	return hosts, nil
+}
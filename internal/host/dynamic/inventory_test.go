@@ -0,0 +1,281 @@
+package dynamic
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSource(t *testing.T) {
+	t.Parallel()
+	var tests = []struct {
+		source  string
+		wantErr bool
+	}{
+		{source: "/etc/inventory.json"},
+		{source: "inventory.yaml"},
+		{source: "file:///etc/inventory.json"},
+		{source: "http://127.0.0.1:8080/inventory"},
+		{source: "https://inventory.example.com/hosts.json"},
+		{source: "", wantErr: true},
+		{source: "   ", wantErr: true},
+		{source: "file://", wantErr: true},
+		{source: "http://", wantErr: true},
+		{source: "ftp://inventory.example.com/hosts.json", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.source, func(t *testing.T) {
+			err := ValidateSource(tt.source)
+			if tt.wantErr {
+				assert.Truef(t, errors.Is(err, ErrInvalidSource), "want err: %q got: %q", ErrInvalidSource, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSourceFile(t *testing.T) {
+	t.Parallel()
+	path, ok := SourceFile("/etc/inventory.json")
+	assert.True(t, ok)
+	assert.Equal(t, "/etc/inventory.json", path)
+
+	path, ok = SourceFile("file:///etc/inventory.json")
+	assert.True(t, ok)
+	assert.Equal(t, "/etc/inventory.json", path)
+
+	path, ok = SourceFile("http://127.0.0.1/inventory.json")
+	assert.False(t, ok)
+	assert.Empty(t, path)
+}
+
+func TestParseInventory(t *testing.T) {
+	t.Parallel()
+	var tests = []struct {
+		name    string
+		in      string
+		want    []*InventoryHost
+		wantErr bool
+	}{
+		{
+			name: "json-object",
+			in:   `{"hosts": [{"id": "web-1", "name": "web one", "address": "10.0.0.1", "attributes": {"env": "prod"}}]}`,
+			want: []*InventoryHost{
+				{Id: "web-1", Name: "web one", Address: "10.0.0.1", Attributes: map[string]interface{}{"env": "prod"}},
+			},
+		},
+		{
+			name: "json-list",
+			in:   `[{"address": "10.0.0.1"}, {"id": "db", "address": "10.0.0.2"}]`,
+			want: []*InventoryHost{
+				{Id: "10.0.0.1", Address: "10.0.0.1"},
+				{Id: "db", Address: "10.0.0.2"},
+			},
+		},
+		{
+			name: "yaml-object",
+			in: `
+hosts:
+  - id: web-1
+    address: web-1.internal
+    attributes:
+      roles: [web, edge]
+`,
+			want: []*InventoryHost{
+				{Id: "web-1", Address: "web-1.internal", Attributes: map[string]interface{}{"roles": []interface{}{"web", "edge"}}},
+			},
+		},
+		{
+			name: "yaml-list",
+			in: `
+- address: 10.0.0.1
+- address: 10.0.0.2
+`,
+			want: []*InventoryHost{
+				{Id: "10.0.0.1", Address: "10.0.0.1"},
+				{Id: "10.0.0.2", Address: "10.0.0.2"},
+			},
+		},
+		{
+			name:    "empty",
+			in:      "  ",
+			wantErr: true,
+		},
+		{
+			name:    "missing-address",
+			in:      `[{"id": "web-1"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "short-address",
+			in:      `[{"address": "ab"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate-id",
+			in:      `[{"id": "web", "address": "10.0.0.1"}, {"id": "web", "address": "10.0.0.2"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "scalar",
+			in:      `hosts`,
+			wantErr: true,
+		},
+		{
+			name:    "bad-json",
+			in:      `{"hosts": [`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			got, err := ParseInventory([]byte(tt.in))
+			if tt.wantErr {
+				assert.Truef(errors.Is(err, ErrInvalidInventory), "want err: %q got: %q", ErrInvalidInventory, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
+func TestAllowedSources(t *testing.T) {
+	t.Parallel()
+	allowed := AllowedSources{
+		Directories: []string{"/etc/boundary/inventory", "/srv/"},
+		Hosts:       []string{"cmdb.example.com", "10.0.0.1:8080"},
+	}
+	var tests = []struct {
+		source  string
+		wantErr error
+	}{
+		{source: "/etc/boundary/inventory/hosts.json"},
+		{source: "/etc/boundary/inventory/prod/hosts.yaml"},
+		{source: "file:///srv/hosts.json"},
+		{source: "https://cmdb.example.com/hosts.json"},
+		{source: "https://CMDB.example.com:8443/hosts.json"},
+		{source: "http://10.0.0.1:8080/inventory"},
+		{source: "/etc/boundary/inventory-other/hosts.json", wantErr: ErrSourceNotAllowed},
+		{source: "/etc/boundary/inventory/../../passwd", wantErr: ErrSourceNotAllowed},
+		{source: "/etc/passwd", wantErr: ErrSourceNotAllowed},
+		{source: "hosts.json", wantErr: ErrSourceNotAllowed},
+		{source: "http://10.0.0.1/inventory", wantErr: ErrSourceNotAllowed},
+		{source: "http://169.254.169.254/latest/meta-data", wantErr: ErrSourceNotAllowed},
+		{source: "https://cmdb.example.com.evil.com/hosts.json", wantErr: ErrSourceNotAllowed},
+		{source: "ftp://cmdb.example.com/hosts.json", wantErr: ErrInvalidSource},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.source, func(t *testing.T) {
+			err := allowed.Check(tt.source)
+			if tt.wantErr != nil {
+				assert.Truef(t, errors.Is(err, tt.wantErr), "want err: %q got: %q", tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("none", func(t *testing.T) {
+		err := AllowedSources{}.Check("/etc/boundary/inventory/hosts.json")
+		assert.Truef(t, errors.Is(err, ErrSourceNotAllowed), "want err: %q got: %q", ErrSourceNotAllowed, err)
+	})
+}
+
+func TestLoadInventory(t *testing.T) {
+	t.Parallel()
+	const inventory = `{"hosts": [{"id": "web-1", "address": "10.0.0.1"}]}`
+	want := []*InventoryHost{{Id: "web-1", Address: "10.0.0.1"}}
+
+	t.Run("file", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		dir, err := ioutil.TempDir("", "dynamic-inventory")
+		require.NoError(err)
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "inventory.json")
+		require.NoError(ioutil.WriteFile(path, []byte(inventory), 0600))
+		allowed := AllowedSources{Directories: []string{dir}}
+
+		got, err := LoadInventory(context.Background(), path, allowed)
+		require.NoError(err)
+		assert.Equal(want, got)
+
+		got, err = LoadInventory(context.Background(), "file://"+path, allowed)
+		require.NoError(err)
+		assert.Equal(want, got)
+
+		got, err = LoadInventory(context.Background(), path, AllowedSources{})
+		assert.Truef(errors.Is(err, ErrSourceNotAllowed), "want err: %q got: %q", ErrSourceNotAllowed, err)
+		assert.Nil(got)
+	})
+	t.Run("symlink", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		dir, err := ioutil.TempDir("", "dynamic-inventory")
+		require.NoError(err)
+		defer os.RemoveAll(dir)
+		outside := filepath.Join(dir, "outside.json")
+		require.NoError(ioutil.WriteFile(outside, []byte(inventory), 0600))
+		allowedDir := filepath.Join(dir, "allowed")
+		require.NoError(os.Mkdir(allowedDir, 0700))
+		link := filepath.Join(allowedDir, "inventory.json")
+		require.NoError(os.Symlink(outside, link))
+
+		got, err := LoadInventory(context.Background(), link, AllowedSources{Directories: []string{allowedDir}})
+		assert.Truef(errors.Is(err, ErrSourceNotAllowed), "want err: %q got: %q", ErrSourceNotAllowed, err)
+		assert.Nil(got)
+	})
+	t.Run("missing-file", func(t *testing.T) {
+		got, err := LoadInventory(context.Background(), "/this/does/not/exist.json", AllowedSources{Directories: []string{"/this"}})
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+	t.Run("http", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/inventory"):
+				w.Write([]byte(inventory))
+			case strings.HasSuffix(r.URL.Path, "/redirect"):
+				http.Redirect(w, r, "http://localhost.invalid/inventory", http.StatusFound)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer srv.Close()
+		u, err := url.Parse(srv.URL)
+		require.NoError(err)
+		allowed := AllowedSources{Hosts: []string{u.Host}}
+
+		got, err := LoadInventory(context.Background(), srv.URL+"/inventory", allowed)
+		require.NoError(err)
+		assert.Equal(want, got)
+
+		got, err = LoadInventory(context.Background(), srv.URL+"/missing", allowed)
+		assert.Error(err)
+		assert.Nil(got)
+
+		got, err = LoadInventory(context.Background(), srv.URL+"/redirect", allowed)
+		assert.Truef(errors.Is(err, ErrSourceNotAllowed), "want err: %q got: %q", ErrSourceNotAllowed, err)
+		assert.Nil(got)
+
+		got, err = LoadInventory(context.Background(), srv.URL+"/inventory", AllowedSources{Hosts: []string{"cmdb.example.com"}})
+		assert.Truef(errors.Is(err, ErrSourceNotAllowed), "want err: %q got: %q", ErrSourceNotAllowed, err)
+		assert.Nil(got)
+	})
+}
@@ -0,0 +1,104 @@
+package dynamic
+
+import "github.com/hashicorp/boundary/internal/db"
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName                   string
+	withDescription            string
+	withLimit                  int
+	withPageAfter              *db.PageAfter
+	withPublicId               string
+	withSource                 string
+	withRefreshIntervalSeconds uint32
+	withFilter                 string
+	withAllowedSources         AllowedSources
+}
+
+func getDefaultOptions() options {
+	return options{
+		withDescription: "",
+		withName:        "",
+	}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are
+// returned. If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithPageAfter provides an option to list only the items after the given
+// position, ordered by create time and public id, so that a list can be paged
+// through with WithLimit.
+func WithPageAfter(after *db.PageAfter) Option {
+	return func(o *options) {
+		o.withPageAfter = after
+	}
+}
+
+// WithPublicId provides an optional public id
+func WithPublicId(id string) Option {
+	return func(o *options) {
+		o.withPublicId = id
+	}
+}
+
+// WithSource provides an optional inventory source for a host catalog.
+func WithSource(source string) Option {
+	return func(o *options) {
+		o.withSource = source
+	}
+}
+
+// WithRefreshIntervalSeconds provides an optional refresh interval for a
+// host catalog.
+func WithRefreshIntervalSeconds(s uint32) Option {
+	return func(o *options) {
+		o.withRefreshIntervalSeconds = s
+	}
+}
+
+// WithFilter provides an optional filter for a host set.
+func WithFilter(filter string) Option {
+	return func(o *options) {
+		o.withFilter = filter
+	}
+}
+
+// WithAllowedSources provides the sources host catalogs of a repository can
+// read their inventories from.
+func WithAllowedSources(allowed AllowedSources) Option {
+	return func(o *options) {
+		o.withAllowedSources = allowed
+	}
+}
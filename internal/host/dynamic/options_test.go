@@ -0,0 +1,61 @@
+package dynamic
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithName", func(t *testing.T) {
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		opts := getOpts(WithLimit(5))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithPageAfter", func(t *testing.T) {
+		after := &db.PageAfter{PublicId: "test"}
+		opts := getOpts(WithPageAfter(after))
+		testOpts := getDefaultOptions()
+		testOpts.withPageAfter = after
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithPublicId", func(t *testing.T) {
+		opts := getOpts(WithPublicId("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withPublicId = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSource", func(t *testing.T) {
+		opts := getOpts(WithSource("/etc/inventory.json"))
+		testOpts := getDefaultOptions()
+		testOpts.withSource = "/etc/inventory.json"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithRefreshIntervalSeconds", func(t *testing.T) {
+		opts := getOpts(WithRefreshIntervalSeconds(60))
+		testOpts := getDefaultOptions()
+		testOpts.withRefreshIntervalSeconds = 60
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithFilter", func(t *testing.T) {
+		opts := getOpts(WithFilter(`attributes.env == "prod"`))
+		testOpts := getDefaultOptions()
+		testOpts.withFilter = `attributes.env == "prod"`
+		assert.Equal(t, opts, testOpts)
+	})
+}
@@ -0,0 +1,114 @@
+package dynamic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/plugin"
+)
+
+// Plugin implements plugin.Plugin for dynamic host catalogs.
+type Plugin struct {
+	repoFn func() (*Repository, error)
+}
+
+var _ plugin.Plugin = (*Plugin)(nil)
+
+// NewPlugin creates a new Plugin which uses repoFn to create a new
+// Repository for each call.
+func NewPlugin(repoFn func() (*Repository, error)) (*Plugin, error) {
+	if repoFn == nil {
+		return nil, fmt.Errorf("new: dynamic host plugin: missing repository function: %w", db.ErrInvalidParameter)
+	}
+	return &Plugin{repoFn: repoFn}, nil
+}
+
+// ListSets implements plugin.Plugin.
+func (p *Plugin) ListSets(ctx context.Context, catalogId string) ([]*plugin.HostSet, error) {
+	repo, err := p.repoFn()
+	if err != nil {
+		return nil, fmt.Errorf("list sets: dynamic host plugin: %w", err)
+	}
+	sets, err := repo.ListSets(ctx, catalogId, WithLimit(unlimited))
+	if err != nil {
+		return nil, fmt.Errorf("list sets: dynamic host plugin: %w", err)
+	}
+	var out []*plugin.HostSet
+	for _, s := range sets {
+		out = append(out, &plugin.HostSet{PublicId: s.GetPublicId(), CatalogId: s.GetCatalogId()})
+	}
+	return out, nil
+}
+
+// ResolveMembers implements plugin.Plugin.
+func (p *Plugin) ResolveMembers(ctx context.Context, setId string) ([]*plugin.Host, error) {
+	repo, err := p.repoFn()
+	if err != nil {
+		return nil, fmt.Errorf("resolve members: dynamic host plugin: %w", err)
+	}
+	_, hosts, err := repo.LookupSet(ctx, setId, WithLimit(unlimited))
+	if err != nil {
+		return nil, fmt.Errorf("resolve members: dynamic host plugin: %w", err)
+	}
+	var out []*plugin.Host
+	for _, h := range hosts {
+		out = append(out, toPluginHost(h))
+	}
+	return out, nil
+}
+
+// LookupHost implements plugin.Plugin.
+func (p *Plugin) LookupHost(ctx context.Context, hostId string) (*plugin.Host, error) {
+	repo, err := p.repoFn()
+	if err != nil {
+		return nil, fmt.Errorf("lookup host: dynamic host plugin: %w", err)
+	}
+	h, err := repo.LookupHost(ctx, hostId)
+	if err != nil {
+		return nil, fmt.Errorf("lookup host: dynamic host plugin: %w", err)
+	}
+	if h == nil {
+		return nil, nil
+	}
+	return toPluginHost(h), nil
+}
+
+// Refresh implements plugin.Plugin. It loads the inventory from the
+// catalog's source and syncs the catalog's hosts with it.
+func (p *Plugin) Refresh(ctx context.Context, catalogId string) error {
+	_, err := p.RefreshCatalog(ctx, catalogId)
+	return err
+}
+
+// RefreshCatalog is Refresh but also returns the changes that were made.
+func (p *Plugin) RefreshCatalog(ctx context.Context, catalogId string) (*SyncResult, error) {
+	repo, err := p.repoFn()
+	if err != nil {
+		return nil, fmt.Errorf("refresh: dynamic host plugin: %w", err)
+	}
+	c, err := repo.LookupCatalog(ctx, catalogId)
+	if err != nil {
+		return nil, fmt.Errorf("refresh: dynamic host plugin: %w", err)
+	}
+	if c == nil {
+		return nil, fmt.Errorf("refresh: dynamic host plugin: catalog %s: %w", catalogId, db.ErrRecordNotFound)
+	}
+	inventory, err := LoadInventory(ctx, c.GetSource(), repo.allowedSources)
+	if err != nil {
+		return nil, fmt.Errorf("refresh: dynamic host plugin: catalog %s: %w", catalogId, err)
+	}
+	result, err := repo.SyncHosts(ctx, catalogId, inventory)
+	if err != nil {
+		return nil, fmt.Errorf("refresh: dynamic host plugin: %w", err)
+	}
+	return result, nil
+}
+
+func toPluginHost(h *Host) *plugin.Host {
+	return &plugin.Host{
+		PublicId:  h.GetPublicId(),
+		CatalogId: h.GetCatalogId(),
+		Address:   h.GetAddress(),
+	}
+}
@@ -0,0 +1,55 @@
+package dynamic
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+// A Repository stores and retrieves the persistent types in the dynamic
+// package. It is not safe to use a repository concurrently.
+type Repository struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+	// defaultLimit provides a default for limiting the number of results
+	// returned from the repo
+	defaultLimit int
+	// allowedSources are the sources host catalogs can read their
+	// inventories from
+	allowedSources AllowedSources
+}
+
+// NewRepository creates a new Repository. The returned repository should
+// only be used for one transaction and it is not safe for concurrent go
+// routines to access it. WithLimit option is used as a repo wide default
+// limit applied to all ListX methods. WithAllowedSources sets the sources
+// host catalogs can read their inventories from; no sources are allowed
+// without it.
+func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
+	switch {
+	case r == nil:
+		return nil, fmt.Errorf("db.Reader: %w", db.ErrInvalidParameter)
+	case w == nil:
+		return nil, fmt.Errorf("db.Writer: %w", db.ErrInvalidParameter)
+	case kms == nil:
+		return nil, fmt.Errorf("kms: %w", db.ErrInvalidParameter)
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+
+	return &Repository{
+		reader:         r,
+		writer:         w,
+		kms:            kms,
+		defaultLimit:   opts.withLimit,
+		allowedSources: opts.withAllowedSources,
+	}, nil
+}
+
+const unlimited = -1
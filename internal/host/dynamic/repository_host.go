@@ -0,0 +1,351 @@
+package dynamic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// LookupHost will look up a host in the repository. If the host is not
+// found, it will return nil, nil. All options are ignored.
+func (r *Repository) LookupHost(ctx context.Context, publicId string, opt ...Option) (*Host, error) {
+	if publicId == "" {
+		return nil, fmt.Errorf("lookup: dynamic host: missing public id %w", db.ErrInvalidParameter)
+	}
+	h := allocHost()
+	h.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, h); err != nil {
+		if err == db.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("lookup: dynamic host: failed %w for %s", err, publicId)
+	}
+	return h, nil
+}
+
+// ListHosts returns a slice of Hosts for the catalogId. Supports the WithLimit
+// and WithPageAfter options.
+func (r *Repository) ListHosts(ctx context.Context, catalogId string, opt ...Option) ([]*Host, error) {
+	if catalogId == "" {
+		return nil, fmt.Errorf("list: dynamic host: missing catalog id: %w", db.ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var hosts []*Host
+	err := r.reader.SearchWhere(ctx, &hosts, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit), db.WithPageAfter(opts.withPageAfter))
+	if err != nil {
+		return nil, fmt.Errorf("list: dynamic host: %w", err)
+	}
+	return hosts, nil
+}
+
+// ListHostSetIds returns the ids of the host sets hostId is a member of.
+func (r *Repository) ListHostSetIds(ctx context.Context, hostId string, opt ...Option) ([]string, error) {
+	if hostId == "" {
+		return nil, fmt.Errorf("list set ids: dynamic host: missing host id: %w", db.ErrInvalidParameter)
+	}
+	var members []*HostSetMember
+	if err := r.reader.SearchWhere(ctx, &members, "host_id = ?", []interface{}{hostId}, db.WithLimit(unlimited)); err != nil {
+		return nil, fmt.Errorf("list set ids: dynamic host: %w", err)
+	}
+	var ids []string
+	for _, m := range members {
+		ids = append(ids, m.GetSetId())
+	}
+	return ids, nil
+}
+
+// SyncResult reports the changes made by SyncHosts.
+type SyncResult struct {
+	HostsCreated   int
+	HostsUpdated   int
+	HostsDeleted   int
+	MembersAdded   int
+	MembersRemoved int
+}
+
+// SyncHosts makes the hosts in catalogId match inventory. Hosts are
+// matched to the inventory by their external id: hosts missing from the
+// inventory are deleted, hosts new to the inventory are created, and hosts
+// whose name, address, or attributes changed are updated. The membership of
+// every host set in the catalog is then recomputed. All changes are made in
+// a single transaction. All options are ignored.
+func (r *Repository) SyncHosts(ctx context.Context, catalogId string, inventory []*InventoryHost, opt ...Option) (*SyncResult, error) {
+	if catalogId == "" {
+		return nil, fmt.Errorf("sync: dynamic host: missing catalog id: %w", db.ErrInvalidParameter)
+	}
+	c, err := r.LookupCatalog(ctx, catalogId)
+	if err != nil {
+		return nil, fmt.Errorf("sync: dynamic host: %w", err)
+	}
+	if c == nil {
+		return nil, fmt.Errorf("sync: dynamic host: catalog %s: %w", catalogId, db.ErrRecordNotFound)
+	}
+
+	wanted := make(map[string]*Host, len(inventory))
+	for _, ih := range inventory {
+		h, err := newHostFromInventory(catalogId, ih)
+		if err != nil {
+			return nil, fmt.Errorf("sync: dynamic host: %w", err)
+		}
+		wanted[h.ExternalId] = h
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, c.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, fmt.Errorf("sync: dynamic host: unable to get oplog wrapper: %w", err)
+	}
+
+	var result *SyncResult
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			result = &SyncResult{}
+			// Work on a copy of wanted so that the transaction can be
+			// retried.
+			pending := make(map[string]*Host, len(wanted))
+			for k, v := range wanted {
+				pending[k] = v.clone()
+			}
+			var current []*Host
+			if err := reader.SearchWhere(ctx, &current, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(unlimited)); err != nil {
+				return fmt.Errorf("unable to list current hosts: %w", err)
+			}
+
+			var creates, deletes []interface{}
+			for _, h := range current {
+				want, ok := pending[h.ExternalId]
+				if !ok {
+					deletes = append(deletes, h.clone())
+					continue
+				}
+				delete(pending, h.ExternalId)
+				dbMask, nullFields := hostChanges(h, want)
+				if len(dbMask) == 0 && len(nullFields) == 0 {
+					continue
+				}
+				updated := h.clone()
+				updated.Name, updated.Address, updated.Attributes = want.Name, want.Address, want.Attributes
+				rowsUpdated, err := w.Update(ctx, updated, dbMask, nullFields,
+					db.WithOplog(oplogWrapper, updated.oplog(oplog.OpType_OP_TYPE_UPDATE)))
+				if err != nil {
+					return fmt.Errorf("unable to update host %s: %w", h.PublicId, err)
+				}
+				if rowsUpdated > 1 {
+					return db.ErrMultipleRecords
+				}
+				result.HostsUpdated++
+			}
+			for _, h := range pending {
+				id, err := newHostId()
+				if err != nil {
+					return err
+				}
+				h.PublicId = id
+				creates = append(creates, h)
+			}
+
+			if len(deletes) > 0 {
+				metadata := oplog.Metadata{
+					"resource-type": []string{"dynamic-host"},
+					"op-type":       []string{oplog.OpType_OP_TYPE_DELETE.String()},
+					"catalog-id":    []string{catalogId},
+				}
+				rowsDeleted, err := w.DeleteItems(ctx, deletes, db.WithOplog(oplogWrapper, metadata))
+				if err != nil {
+					return fmt.Errorf("unable to delete hosts: %w", err)
+				}
+				result.HostsDeleted = rowsDeleted
+			}
+			if len(creates) > 0 {
+				metadata := oplog.Metadata{
+					"resource-type": []string{"dynamic-host"},
+					"op-type":       []string{oplog.OpType_OP_TYPE_CREATE.String()},
+					"catalog-id":    []string{catalogId},
+				}
+				if err := w.CreateItems(ctx, creates, db.WithOplog(oplogWrapper, metadata)); err != nil {
+					return fmt.Errorf("unable to create hosts: %w", err)
+				}
+				result.HostsCreated = len(creates)
+			}
+
+			var sets []*HostSet
+			if err := reader.SearchWhere(ctx, &sets, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(unlimited)); err != nil {
+				return fmt.Errorf("unable to list host sets: %w", err)
+			}
+			if len(sets) == 0 {
+				return nil
+			}
+			hosts, err := catalogHosts(ctx, reader, catalogId)
+			if err != nil {
+				return err
+			}
+			for _, s := range sets {
+				added, removed, err := syncMembers(ctx, reader, w, oplogWrapper, s, hosts)
+				if err != nil {
+					return err
+				}
+				result.MembersAdded += added
+				result.MembersRemoved += removed
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("sync: dynamic host: catalog %s: %w", catalogId, err)
+	}
+	return result, nil
+}
+
+// newHostFromInventory creates a new in memory Host for ih. The public id
+// is not set.
+func newHostFromInventory(catalogId string, ih *InventoryHost) (*Host, error) {
+	h := allocHost()
+	h.CatalogId = catalogId
+	h.ExternalId = ih.Id
+	h.Name = ih.Name
+	h.Address = ih.Address
+	if len(ih.Attributes) > 0 {
+		raw, err := json.Marshal(ih.Attributes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode attributes of host %s: %w", ih.Id, err)
+		}
+		h.Attributes = string(raw)
+	}
+	return h, nil
+}
+
+// hostChanges returns the field masks needed to update cur to want.
+func hostChanges(cur, want *Host) (dbMask, nullFields []string) {
+	if cur.Name != want.Name {
+		if want.Name == "" {
+			nullFields = append(nullFields, "Name")
+		} else {
+			dbMask = append(dbMask, "Name")
+		}
+	}
+	if cur.Address != want.Address {
+		dbMask = append(dbMask, "Address")
+	}
+	// Attributes are always encoded by json.Marshal on a map, which sorts
+	// the keys, so equal attributes have equal encodings.
+	if cur.Attributes != want.Attributes {
+		if want.Attributes == "" {
+			nullFields = append(nullFields, "Attributes")
+		} else {
+			dbMask = append(dbMask, "Attributes")
+		}
+	}
+	return dbMask, nullFields
+}
+
+func (h *Host) inventoryHost() (*InventoryHost, error) {
+	attrs, err := h.DecodedAttributes()
+	if err != nil {
+		return nil, err
+	}
+	return &InventoryHost{
+		Id:         h.ExternalId,
+		Name:       h.Name,
+		Address:    h.Address,
+		Attributes: attrs,
+	}, nil
+}
+
+// catalogHosts returns every host in catalogId keyed by public id in the
+// form filters are evaluated against.
+func catalogHosts(ctx context.Context, reader db.Reader, catalogId string) (map[string]*InventoryHost, error) {
+	var hosts []*Host
+	if err := reader.SearchWhere(ctx, &hosts, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(unlimited)); err != nil {
+		return nil, fmt.Errorf("unable to list hosts: %w", err)
+	}
+	out := make(map[string]*InventoryHost, len(hosts))
+	for _, h := range hosts {
+		ih, err := h.inventoryHost()
+		if err != nil {
+			return nil, err
+		}
+		out[h.PublicId] = ih
+	}
+	return out, nil
+}
+
+// syncMembers makes the members of s match the hosts its filter selects
+// from hosts. It returns the number of members added and removed.
+func syncMembers(ctx context.Context, reader db.Reader, w db.Writer, wrapper wrapping.Wrapper, s *HostSet, hosts map[string]*InventoryHost) (int, int, error) {
+	filter, err := NewFilter(s.Filter)
+	if err != nil {
+		return 0, 0, fmt.Errorf("host set %s: %w", s.PublicId, err)
+	}
+	want := make(map[string]bool)
+	for id, h := range hosts {
+		if filter.Match(h) {
+			want[id] = true
+		}
+	}
+
+	var current []*HostSetMember
+	if err := reader.SearchWhere(ctx, &current, "set_id = ?", []interface{}{s.PublicId}, db.WithLimit(unlimited)); err != nil {
+		return 0, 0, fmt.Errorf("unable to list members of host set %s: %w", s.PublicId, err)
+	}
+	var removes, adds []interface{}
+	for _, m := range current {
+		if want[m.HostId] {
+			delete(want, m.HostId)
+			continue
+		}
+		removes = append(removes, m)
+	}
+	for id := range want {
+		m, err := NewHostSetMember(s.PublicId, id)
+		if err != nil {
+			return 0, 0, err
+		}
+		adds = append(adds, m)
+	}
+
+	var removed int
+	if len(removes) > 0 {
+		removed, err = w.DeleteItems(ctx, removes, db.WithOplog(wrapper, s.oplog(oplog.OpType_OP_TYPE_DELETE)))
+		if err != nil {
+			return 0, 0, fmt.Errorf("unable to remove members of host set %s: %w", s.PublicId, err)
+		}
+	}
+	if len(adds) > 0 {
+		if err := w.CreateItems(ctx, adds, db.WithOplog(wrapper, s.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+			return 0, 0, fmt.Errorf("unable to add members to host set %s: %w", s.PublicId, err)
+		}
+	}
+	return len(adds), removed, nil
+}
+
+// getHosts returns the hosts in setId.
+func getHosts(ctx context.Context, reader db.Reader, setId string, limit int) ([]*Host, error) {
+	const where = `public_id in
+       ( select host_id
+           from dynamic_host_set_member
+          where set_id = ?
+       )`
+
+	var hosts []*Host
+	if err := reader.SearchWhere(ctx, &hosts,
+		where,
+		[]interface{}{setId},
+		db.WithLimit(limit),
+	); err != nil {
+		return nil, fmt.Errorf("get hosts: %w", err)
+	}
+	if len(hosts) == 0 {
+		return nil, nil
+	}
+	return hosts, nil
+}
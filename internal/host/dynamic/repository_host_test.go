@@ -0,0 +1,84 @@
+package dynamic
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_SyncHosts(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+	catalog := TestCatalogs(t, conn, prj.PublicId, "/etc/inventory.json", 1)[0]
+
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+	require.NotNil(repo)
+
+	prod := TestSets(t, conn, catalog.PublicId, `attributes.env == "prod"`, 1)[0]
+
+	inventory := []*InventoryHost{
+		{Id: "web-1", Address: "10.0.0.1", Attributes: map[string]interface{}{"env": "prod"}},
+		{Id: "web-2", Address: "10.0.0.2", Attributes: map[string]interface{}{"env": "prod"}},
+		{Id: "dev-1", Address: "10.0.1.1", Attributes: map[string]interface{}{"env": "dev"}},
+	}
+	res, err := repo.SyncHosts(ctx, catalog.PublicId, inventory)
+	require.NoError(err)
+	assert.Equal(&SyncResult{HostsCreated: 3, MembersAdded: 2}, res)
+	assert.ElementsMatch([]string{"10.0.0.1", "10.0.0.2"}, memberAddresses(t, repo, prod.PublicId))
+
+	// Syncing the same inventory again is a no-op.
+	res, err = repo.SyncHosts(ctx, catalog.PublicId, inventory)
+	require.NoError(err)
+	assert.Equal(&SyncResult{}, res)
+
+	// Move web-2 to dev, remove dev-1 and add db-1.
+	inventory = []*InventoryHost{
+		{Id: "web-1", Address: "10.0.0.1", Attributes: map[string]interface{}{"env": "prod"}},
+		{Id: "web-2", Address: "10.0.0.2", Attributes: map[string]interface{}{"env": "dev"}},
+		{Id: "db-1", Address: "10.0.2.1", Attributes: map[string]interface{}{"env": "prod"}},
+	}
+	res, err = repo.SyncHosts(ctx, catalog.PublicId, inventory)
+	require.NoError(err)
+	assert.Equal(&SyncResult{HostsCreated: 1, HostsUpdated: 1, HostsDeleted: 1, MembersAdded: 1, MembersRemoved: 1}, res)
+	assert.ElementsMatch([]string{"10.0.0.1", "10.0.2.1"}, memberAddresses(t, repo, prod.PublicId))
+
+	hosts, err := repo.ListHosts(ctx, catalog.PublicId)
+	require.NoError(err)
+	assert.Len(hosts, 3)
+
+	// Changing the filter of the set recomputes its members.
+	prod.Filter = `attributes.env == "dev"`
+	_, members, _, err := repo.UpdateSet(ctx, prj.PublicId, prod, prod.Version, []string{"Filter"})
+	require.NoError(err)
+	require.Len(members, 1)
+	assert.Equal("10.0.0.2", members[0].Address)
+
+	_, err = repo.SyncHosts(ctx, "", inventory)
+	assert.Truef(errors.Is(err, db.ErrInvalidParameter), "want err: %q got: %q", db.ErrInvalidParameter, err)
+}
+
+func memberAddresses(t *testing.T, repo *Repository, setId string) []string {
+	t.Helper()
+	_, hosts, err := repo.LookupSet(context.Background(), setId)
+	require.NoError(t, err)
+	var addrs []string
+	for _, h := range hosts {
+		addrs = append(addrs, h.Address)
+	}
+	sort.Strings(addrs)
+	return addrs
+}
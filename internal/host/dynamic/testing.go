@@ -0,0 +1,61 @@
+package dynamic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCatalogs creates count number of dynamic host catalogs reading from
+// source to the provided DB with the provided scope id. If any errors are
+// encountered during the creation of the host catalog, the test will fail.
+func TestCatalogs(t *testing.T, conn *gorm.DB, scopeId, source string, count int) []*HostCatalog {
+	t.Helper()
+	assert := assert.New(t)
+	var cats []*HostCatalog
+	for i := 0; i < count; i++ {
+		cat, err := NewHostCatalog(scopeId, WithSource(source))
+		assert.NoError(err)
+		assert.NotNil(cat)
+		id, err := newHostCatalogId()
+		assert.NoError(err)
+		assert.NotEmpty(id)
+		cat.PublicId = id
+
+		w := db.New(conn)
+		err2 := w.Create(context.Background(), cat)
+		assert.NoError(err2)
+		cats = append(cats, cat)
+	}
+	return cats
+}
+
+// TestSets creates count number of dynamic host sets with filter to the
+// provided DB with the provided catalog id. The catalog must have been
+// created previously. The members of the sets are not computed. If any
+// errors are encountered during the creation of the host set, the test will
+// fail.
+func TestSets(t *testing.T, conn *gorm.DB, catalogId, filter string, count int) []*HostSet {
+	t.Helper()
+	assert := assert.New(t)
+	var sets []*HostSet
+
+	for i := 0; i < count; i++ {
+		set, err := NewHostSet(catalogId, WithFilter(filter))
+		assert.NoError(err)
+		assert.NotNil(set)
+		id, err := newHostSetId()
+		assert.NoError(err)
+		assert.NotEmpty(id)
+		set.PublicId = id
+
+		w := db.New(conn)
+		err2 := w.Create(context.Background(), set)
+		assert.NoError(err2)
+		sets = append(sets, set)
+	}
+	return sets
+}
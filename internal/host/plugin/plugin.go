@@ -0,0 +1,43 @@
+// Package plugin defines the interface implemented by each host catalog
+// subtype so that the rest of the controller can resolve the hosts behind a
+// host set without knowing how the catalog stores or discovers them.
+//
+// A Plugin is obtained for a subtype from the controller and is safe to use
+// for the lifetime of the controller. Implementations create a new
+// repository for each call.
+package plugin
+
+import "context"
+
+// Host is the subtype independent view of a host returned by a Plugin.
+type Host struct {
+	PublicId  string
+	CatalogId string
+	Address   string
+}
+
+// HostSet is the subtype independent view of a host set returned by a
+// Plugin.
+type HostSet struct {
+	PublicId  string
+	CatalogId string
+}
+
+// Plugin is implemented by each host catalog subtype.
+type Plugin interface {
+	// ListSets returns the host sets in catalogId.
+	ListSets(ctx context.Context, catalogId string) ([]*HostSet, error)
+
+	// ResolveMembers returns the hosts currently in setId. It returns nil,
+	// nil if the set does not exist or has no members.
+	ResolveMembers(ctx context.Context, setId string) ([]*Host, error)
+
+	// LookupHost returns the host for hostId. It returns nil, nil if no
+	// host is found for hostId.
+	LookupHost(ctx context.Context, hostId string) (*Host, error)
+
+	// Refresh brings the hosts and host set memberships of catalogId up to
+	// date with the catalog's source of truth. Subtypes whose hosts are
+	// managed directly through the API return nil without doing anything.
+	Refresh(ctx context.Context, catalogId string) error
+}
@@ -0,0 +1,34 @@
+package host
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/host/dynamic"
+	"github.com/hashicorp/boundary/internal/host/plugin"
+	"github.com/hashicorp/boundary/internal/host/static"
+)
+
+// PluginFactory returns the plugin.Plugin for a host subtype.
+type PluginFactory func(SubType) (plugin.Plugin, error)
+
+// NewPluginFactory returns a PluginFactory serving the built in host
+// subtypes using the provided repository functions.
+func NewPluginFactory(staticRepoFn func() (*static.Repository, error), dynamicRepoFn func() (*dynamic.Repository, error)) (PluginFactory, error) {
+	staticPlugin, err := static.NewPlugin(staticRepoFn)
+	if err != nil {
+		return nil, err
+	}
+	dynamicPlugin, err := dynamic.NewPlugin(dynamicRepoFn)
+	if err != nil {
+		return nil, err
+	}
+	return func(t SubType) (plugin.Plugin, error) {
+		switch t {
+		case StaticSubtype:
+			return staticPlugin, nil
+		case DynamicSubtype:
+			return dynamicPlugin, nil
+		}
+		return nil, fmt.Errorf("no host plugin for subtype %q", t)
+	}, nil
+}
@@ -1,5 +1,7 @@
 package static
 
+import "github.com/hashicorp/boundary/internal/db"
+
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -17,6 +19,7 @@ type options struct {
 	withName        string
 	withDescription string
 	withLimit       int
+	withPageAfter   *db.PageAfter
 	withAddress     string
 	withPublicId    string
 }
@@ -52,6 +55,15 @@ func WithLimit(l int) Option {
 	}
 }
 
+// WithPageAfter provides an option to list only the items after the given
+// position, ordered by create time and public id, so that a list can be paged
+// through with WithLimit.
+func WithPageAfter(after *db.PageAfter) Option {
+	return func(o *options) {
+		o.withPageAfter = after
+	}
+}
+
 // WithAddress provides an optional address.
 func WithAddress(address string) Option {
 	return func(o *options) {
@@ -3,6 +3,7 @@ package static
 import (
 	"testing"
 
+	"github.com/hashicorp/boundary/internal/db"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,6 +27,13 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withLimit = 5
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithPageAfter", func(t *testing.T) {
+		after := &db.PageAfter{PublicId: "test"}
+		opts := getOpts(WithPageAfter(after))
+		testOpts := getDefaultOptions()
+		testOpts.withPageAfter = after
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithAddress", func(t *testing.T) {
 		opts := getOpts(WithAddress("test"))
 		testOpts := getDefaultOptions()
@@ -0,0 +1,89 @@
+package static
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/plugin"
+)
+
+// Plugin implements plugin.Plugin for static host catalogs.
+type Plugin struct {
+	repoFn func() (*Repository, error)
+}
+
+var _ plugin.Plugin = (*Plugin)(nil)
+
+// NewPlugin creates a new Plugin which uses repoFn to create a new
+// Repository for each call.
+func NewPlugin(repoFn func() (*Repository, error)) (*Plugin, error) {
+	if repoFn == nil {
+		return nil, fmt.Errorf("new: static host plugin: missing repository function: %w", db.ErrInvalidParameter)
+	}
+	return &Plugin{repoFn: repoFn}, nil
+}
+
+// ListSets implements plugin.Plugin.
+func (p *Plugin) ListSets(ctx context.Context, catalogId string) ([]*plugin.HostSet, error) {
+	repo, err := p.repoFn()
+	if err != nil {
+		return nil, fmt.Errorf("list sets: static host plugin: %w", err)
+	}
+	sets, err := repo.ListSets(ctx, catalogId, WithLimit(unlimited))
+	if err != nil {
+		return nil, fmt.Errorf("list sets: static host plugin: %w", err)
+	}
+	var out []*plugin.HostSet
+	for _, s := range sets {
+		out = append(out, &plugin.HostSet{PublicId: s.GetPublicId(), CatalogId: s.GetCatalogId()})
+	}
+	return out, nil
+}
+
+// ResolveMembers implements plugin.Plugin.
+func (p *Plugin) ResolveMembers(ctx context.Context, setId string) ([]*plugin.Host, error) {
+	repo, err := p.repoFn()
+	if err != nil {
+		return nil, fmt.Errorf("resolve members: static host plugin: %w", err)
+	}
+	_, hosts, err := repo.LookupSet(ctx, setId, WithLimit(unlimited))
+	if err != nil {
+		return nil, fmt.Errorf("resolve members: static host plugin: %w", err)
+	}
+	var out []*plugin.Host
+	for _, h := range hosts {
+		out = append(out, toPluginHost(h))
+	}
+	return out, nil
+}
+
+// LookupHost implements plugin.Plugin.
+func (p *Plugin) LookupHost(ctx context.Context, hostId string) (*plugin.Host, error) {
+	repo, err := p.repoFn()
+	if err != nil {
+		return nil, fmt.Errorf("lookup host: static host plugin: %w", err)
+	}
+	h, err := repo.LookupHost(ctx, hostId)
+	if err != nil {
+		return nil, fmt.Errorf("lookup host: static host plugin: %w", err)
+	}
+	if h == nil {
+		return nil, nil
+	}
+	return toPluginHost(h), nil
+}
+
+// Refresh implements plugin.Plugin. Static hosts are managed through the
+// API so there is nothing to refresh.
+func (p *Plugin) Refresh(_ context.Context, _ string) error {
+	return nil
+}
+
+func toPluginHost(h *Host) *plugin.Host {
+	return &plugin.Host{
+		PublicId:  h.GetPublicId(),
+		CatalogId: h.GetCatalogId(),
+		Address:   h.GetAddress(),
+	}
+}
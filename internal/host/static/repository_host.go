@@ -194,7 +194,7 @@ func (r *Repository) LookupHost(ctx context.Context, publicId string, opt ...Opt
 }
 
 // ListHosts returns a slice of Hosts for the catalogId.
-// WithLimit is the only option supported.
+// Supports the WithLimit and WithPageAfter options.
 func (r *Repository) ListHosts(ctx context.Context, catalogId string, opt ...Option) ([]*Host, error) {
 	if catalogId == "" {
 		return nil, fmt.Errorf("list: static host: missing catalog id: %w", db.ErrInvalidParameter)
@@ -206,7 +206,7 @@ func (r *Repository) ListHosts(ctx context.Context, catalogId string, opt ...Opt
 		limit = opts.withLimit
 	}
 	var hosts []*Host
-	err := r.reader.SearchWhere(ctx, &hosts, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &hosts, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit), db.WithPageAfter(opts.withPageAfter))
 	if err != nil {
 		return nil, fmt.Errorf("list: static host: %w", err)
 	}
@@ -188,7 +188,7 @@ func (r *Repository) LookupCatalog(ctx context.Context, id string, opt ...Option
 	return c, nil
 }
 
-// ListCatalogs returns a slice of HostCatalogs for the scopeId. WithLimit is the only option supported.
+// ListCatalogs returns a slice of HostCatalogs for the scopeId. Supports the WithLimit and WithPageAfter options.
 func (r *Repository) ListCatalogs(ctx context.Context, scopeId string, opt ...Option) ([]*HostCatalog, error) {
 	if scopeId == "" {
 		return nil, fmt.Errorf("list: static host catalog: missing scope id: %w", db.ErrInvalidParameter)
@@ -200,7 +200,7 @@ func (r *Repository) ListCatalogs(ctx context.Context, scopeId string, opt ...Op
 		limit = opts.withLimit
 	}
 	var hostCatalogs []*HostCatalog
-	err := r.reader.SearchWhere(ctx, &hostCatalogs, "scope_id = ?", []interface{}{scopeId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &hostCatalogs, "scope_id = ?", []interface{}{scopeId}, db.WithLimit(limit), db.WithPageAfter(opts.withPageAfter))
 	if err != nil {
 		return nil, fmt.Errorf("list: static host catalog: %w", err)
 	}
@@ -210,8 +210,8 @@ func (r *Repository) LookupSet(ctx context.Context, publicId string, opt ...Opti
 	return s, hosts, nil
 }
 
-// ListSets returns a slice of HostSets for the catalogId. WithLimit is the
-// only option supported.
+// ListSets returns a slice of HostSets for the catalogId. Supports the WithLimit
+// and WithPageAfter options.
 func (r *Repository) ListSets(ctx context.Context, catalogId string, opt ...Option) ([]*HostSet, error) {
 	if catalogId == "" {
 		return nil, fmt.Errorf("list: static host set: missing catalog id: %w", db.ErrInvalidParameter)
@@ -223,7 +223,7 @@ func (r *Repository) ListSets(ctx context.Context, catalogId string, opt ...Opti
 		limit = opts.withLimit
 	}
 	var sets []*HostSet
-	err := r.reader.SearchWhere(ctx, &sets, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &sets, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit), db.WithPageAfter(opts.withPageAfter))
 	if err != nil {
 		return nil, fmt.Errorf("list: static host set: %w", err)
 	}
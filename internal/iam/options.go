@@ -1,6 +1,10 @@
 package iam
 
-import "io"
+import (
+	"io"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
 
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
@@ -21,6 +25,7 @@ type options struct {
 	withDescription             string
 	withGroupGrants             bool
 	withLimit                   int
+	withPageAfter               *db.PageAfter
 	withAutoVivify              bool
 	withGrantScopeId            string
 	withSkipVetForWrite         bool
@@ -29,6 +34,7 @@ type options struct {
 	withSkipDefaultRoleCreation bool
 	withUserId                  string
 	withRandomReader            io.Reader
+	withAuthMethodId            string
 }
 
 func getDefaultOptions() options {
@@ -81,6 +87,15 @@ func WithLimit(limit int) Option {
 	}
 }
 
+// WithPageAfter provides an option to list only the items after the given
+// position, ordered by create time and public id, so that a list can be paged
+// through with WithLimit.
+func WithPageAfter(after *db.PageAfter) Option {
+	return func(o *options) {
+		o.withPageAfter = after
+	}
+}
+
 // WithAutoVivify provides an option to enable user auto vivification when
 // calling repo.LookupUserWithLogin().
 func WithAutoVivify(enable bool) Option {
@@ -142,3 +157,11 @@ func WithRandomReader(reader io.Reader) Option {
 		o.withRandomReader = reader
 	}
 }
+
+// WithAuthMethodId provides an option to only list the accounts of an auth
+// method.
+func WithAuthMethodId(id string) Option {
+	return func(o *options) {
+		o.withAuthMethodId = id
+	}
+}
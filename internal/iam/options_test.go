@@ -3,6 +3,7 @@ package iam
 import (
 	"testing"
 
+	"github.com/hashicorp/boundary/internal/db"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,6 +42,14 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withLimit = 1
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithPageAfter", func(t *testing.T) {
+		assert := assert.New(t)
+		after := &db.PageAfter{PublicId: "test"}
+		opts := getOpts(WithPageAfter(after))
+		testOpts := getDefaultOptions()
+		testOpts.withPageAfter = after
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithAutoVivify", func(t *testing.T) {
 		assert := assert.New(t)
 		// test default of false
@@ -74,4 +83,11 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withDisassociate = true
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithAuthMethodId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithAuthMethodId("ampw_1234"))
+		testOpts := getDefaultOptions()
+		testOpts.withAuthMethodId = "ampw_1234"
+		assert.Equal(opts, testOpts)
+	})
 }
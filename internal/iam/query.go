@@ -108,4 +108,131 @@ const (
 	select * from final
 	order by action, member_id;
 	`
+
+	// resourceQuery - given the public id of any resource, return its type,
+	// the scope it is in and the id of the resource it is pinned to, if any.
+	resourceQuery = `
+	with
+	resources (public_id, scope_id, type, pin) as (
+	  select public_id, coalesce(parent_id, public_id), 'scope', ''
+		from iam_scope
+	   union all
+	  select public_id, scope_id, 'user', ''
+		from iam_user
+	   union all
+	  select public_id, scope_id, 'group', ''
+		from iam_group
+	   union all
+	  select public_id, scope_id, 'role', ''
+		from iam_role
+	   union all
+	  select public_id, scope_id, 'auth-method', ''
+		from auth_method
+	   union all
+	  select public_id, scope_id, 'account', auth_method_id
+		from auth_account
+	   union all
+	  select auth_token.public_id, auth_account.scope_id, 'auth-token', ''
+		from auth_token
+	   inner join auth_account
+		  on auth_token.auth_account_id = auth_account.public_id
+	   union all
+	  select public_id, scope_id, 'host-catalog', ''
+		from host_catalog
+	   union all
+	  select host_set.public_id, host_catalog.scope_id, 'host-set', host_set.catalog_id
+		from host_set
+	   inner join host_catalog
+		  on host_set.catalog_id = host_catalog.public_id
+	   union all
+	  select host.public_id, host_catalog.scope_id, 'host', host.catalog_id
+		from host
+	   inner join host_catalog
+		  on host.catalog_id = host_catalog.public_id
+	   union all
+	  select public_id, scope_id, 'target', ''
+		from target
+	   union all
+	  select public_id, scope_id, 'session', ''
+		from session
+	   union all
+	  select public_id, scope_id, 'credential-store', ''
+		from credential_store
+	   union all
+	  select credential.public_id, credential_store.scope_id, 'credential', credential.store_id
+		from credential
+	   inner join credential_store
+		  on credential.store_id = credential_store.public_id
+	)
+	select public_id, scope_id, type, pin
+	  from resources
+	 where public_id = $1;
+	`
+
+	grantsForAnonUser = `where public_id in ($1)`
+	grantsForAuthUser = `where public_id in ('u_anon', 'u_auth', $1)`
+
+	// grantsForUserQuery is completed with one of grantsForAnonUser or
+	// grantsForAuthUser and with one of the selects from final below it
+	grantsForUserQuery = `
+with
+users (id) as (
+  select public_id
+    from iam_user
+  %s -- grantsForAnonUser || grantsForAuthUser
+),
+user_groups (id) as (
+  select group_id
+    from iam_group_member_user,
+         users
+   where member_id in (users.id)
+),
+group_roles (role_id, principal_id) as (
+  select role_id,
+         principal_id
+    from iam_group_role,
+         user_groups
+   where principal_id in (user_groups.id)
+),
+user_roles (role_id, principal_id) as (
+  select role_id,
+         principal_id
+    from iam_user_role,
+         users
+   where principal_id in (users.id)
+),
+user_group_roles (role_id, principal_id) as (
+  select role_id, principal_id
+    from group_roles
+   union
+  select role_id, principal_id
+    from user_roles
+),
+roles (role_id, grant_scope_id, principal_id) as (
+  select iam_role.public_id,
+         iam_role.grant_scope_id,
+         user_group_roles.principal_id
+    from iam_role,
+         user_group_roles
+   where public_id in (user_group_roles.role_id)
+),
+final (role_id, role_scope, role_grant, principal_id) as (
+  select roles.role_id,
+         roles.grant_scope_id,
+         iam_role_grant.canonical_grant,
+         roles.principal_id
+    from roles
+   inner
+    join iam_role_grant
+      on roles.role_id = iam_role_grant.role_id
+)
+%s;
+`
+
+	grantsForUserSelect = `select distinct role_id, role_scope as scope_id, role_grant as grant from final`
+
+	effectiveGrantsForUserSelect = `
+select role_id, role_scope as scope_id, role_grant as grant, principal_id
+  from final
+ order by role_scope, role_id, role_grant, principal_id`
 )
@@ -166,7 +166,7 @@ func (r *Repository) DeleteGroup(ctx context.Context, withPublicId string, opt .
 	return rowsDeleted, nil
 }
 
-// ListGroups in a scope and supports WithLimit option.
+// ListGroups in a scope and supports the WithLimit and WithPageAfter options.
 func (r *Repository) ListGroups(ctx context.Context, withScopeId string, opt ...Option) ([]*Group, error) {
 	if withScopeId == "" {
 		return nil, fmt.Errorf("list groups: missing scope id %w", db.ErrInvalidParameter)
@@ -0,0 +1,43 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// LookupResource returns the type, scope and pin of the resource with the
+// given public id as a perms.Resource, which can be checked against an ACL.
+// The resource may be of any type. If no resource with the id is found, it
+// will return nil, nil.
+func (r *Repository) LookupResource(ctx context.Context, withPublicId string, opt ...Option) (*perms.Resource, error) {
+	if withPublicId == "" {
+		return nil, fmt.Errorf("lookup resource: missing public id %w", db.ErrInvalidParameter)
+	}
+	rows, err := r.reader.Query(ctx, resourceQuery, []interface{}{withPublicId})
+	if err != nil {
+		return nil, fmt.Errorf("lookup resource: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var res struct {
+			PublicId string
+			ScopeId  string
+			Type     string
+			Pin      string
+		}
+		if err := r.reader.ScanRows(rows, &res); err != nil {
+			return nil, fmt.Errorf("lookup resource: %w", err)
+		}
+		return &perms.Resource{
+			ScopeId: res.ScopeId,
+			Id:      res.PublicId,
+			Type:    resource.Map[res.Type],
+			Pin:     res.Pin,
+		}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,84 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_LookupResource(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, proj := TestScopes(t, repo)
+	user := TestUser(t, repo, org.PublicId)
+	group := TestGroup(t, conn, proj.PublicId)
+	role := TestRole(t, conn, proj.PublicId)
+
+	tests := []struct {
+		name      string
+		id        string
+		want      *perms.Resource
+		wantIsErr error
+	}{
+		{
+			name:      "missing-id",
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name: "not-found",
+			id:   "u_1234567890",
+		},
+		{
+			name: "global",
+			id:   scope.Global.String(),
+			want: &perms.Resource{ScopeId: scope.Global.String(), Id: scope.Global.String(), Type: resource.Scope},
+		},
+		{
+			name: "org",
+			id:   org.PublicId,
+			want: &perms.Resource{ScopeId: scope.Global.String(), Id: org.PublicId, Type: resource.Scope},
+		},
+		{
+			name: "project",
+			id:   proj.PublicId,
+			want: &perms.Resource{ScopeId: org.PublicId, Id: proj.PublicId, Type: resource.Scope},
+		},
+		{
+			name: "user",
+			id:   user.PublicId,
+			want: &perms.Resource{ScopeId: org.PublicId, Id: user.PublicId, Type: resource.User},
+		},
+		{
+			name: "group",
+			id:   group.PublicId,
+			want: &perms.Resource{ScopeId: proj.PublicId, Id: group.PublicId, Type: resource.Group},
+		},
+		{
+			name: "role",
+			id:   role.PublicId,
+			want: &perms.Resource{ScopeId: proj.PublicId, Id: role.PublicId, Type: resource.Role},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.LookupResource(context.Background(), tt.id)
+			if tt.wantIsErr != nil {
+				require.Error(err)
+				assert.True(errors.Is(err, tt.wantIsErr))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
@@ -177,7 +177,7 @@ func (r *Repository) DeleteRole(ctx context.Context, withPublicId string, opt ..
 	return rowsDeleted, nil
 }
 
-// ListRoles in a scope and supports WithLimit option.
+// ListRoles in a scope and supports the WithLimit and WithPageAfter options.
 func (r *Repository) ListRoles(ctx context.Context, withScopeId string, opt ...Option) ([]*Role, error) {
 	if withScopeId == "" {
 		return nil, fmt.Errorf("list roles: missing scope id %w", db.ErrInvalidParameter)
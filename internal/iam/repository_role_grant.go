@@ -385,70 +385,8 @@ func (r *Repository) GrantsForUser(ctx context.Context, userId string, opt ...Op
 		return nil, fmt.Errorf("get grants for user: missing user id: %w", db.ErrInvalidParameter)
 	}
 
-	const (
-		anonUser    = `where public_id in ($1)`
-		authUser    = `where public_id in ('u_anon', 'u_auth', $1)`
-		grantsQuery = `
-with
-users (id) as (
-  select public_id
-    from iam_user
-  %s -- anonUser || authUser
-),
-user_groups (id) as (
-  select group_id
-    from iam_group_member_user,
-         users
-   where member_id in (users.id)
-),
-group_roles (role_id) as (
-  select role_id
-    from iam_group_role,
-         user_groups
-   where principal_id in (user_groups.id)
-),
-user_roles (role_id) as (
-  select role_id
-    from iam_user_role,
-         users
-   where principal_id in (users.id)
-),
-user_group_roles (role_id) as (
-  select role_id
-    from group_roles
-   union
-  select role_id
-    from user_roles
-),
-roles (role_id, grant_scope_id) as (
-  select iam_role.public_id,
-         iam_role.grant_scope_id
-    from iam_role,
-         user_group_roles
-   where public_id in (user_group_roles.role_id)
-),
-final (role_scope, role_grant) as (
-  select roles.grant_scope_id,
-         iam_role_grant.canonical_grant
-    from roles
-   inner
-    join iam_role_grant
-      on roles.role_id = iam_role_grant.role_id
-)
-select role_scope as scope_id, role_grant as grant from final;
-	`
-	)
-
-	var query string
-	switch userId {
-	case "u_anon":
-		query = fmt.Sprintf(grantsQuery, anonUser)
-	default:
-		query = fmt.Sprintf(grantsQuery, authUser)
-	}
-
 	var grants []perms.GrantPair
-	rows, err := r.reader.Query(ctx, query, []interface{}{userId})
+	rows, err := r.reader.Query(ctx, userGrantsQuery(userId, grantsForUserSelect), []interface{}{userId})
 	if err != nil {
 		return nil, err
 	}
@@ -462,3 +400,47 @@ select role_scope as scope_id, role_grant as grant from final;
 	}
 	return grants, nil
 }
+
+// EffectiveGrant is a grant held by a user along with the principal the role
+// containing it is assigned to, which is either the user, one of the groups
+// the user is a member of, or one of the u_anon and u_auth special users.
+type EffectiveGrant struct {
+	RoleId      string
+	ScopeId     string
+	Grant       string
+	PrincipalId string
+}
+
+// EffectiveGrantsForUser returns the grants held by the user, using the same
+// query as GrantsForUser, with a row for each principal a grant is held
+// through. Grants are ordered by scope, role, grant and principal.
+func (r *Repository) EffectiveGrantsForUser(ctx context.Context, userId string, opt ...Option) ([]EffectiveGrant, error) {
+	if userId == "" {
+		return nil, fmt.Errorf("get effective grants for user: missing user id: %w", db.ErrInvalidParameter)
+	}
+
+	var grants []EffectiveGrant
+	rows, err := r.reader.Query(ctx, userGrantsQuery(userId, effectiveGrantsForUserSelect), []interface{}{userId})
+	if err != nil {
+		return nil, fmt.Errorf("get effective grants for user: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var g EffectiveGrant
+		if err := r.reader.ScanRows(rows, &g); err != nil {
+			return nil, fmt.Errorf("get effective grants for user: %w", err)
+		}
+		grants = append(grants, g)
+	}
+	return grants, nil
+}
+
+// userGrantsQuery returns the query for the grants of userId, which includes
+// those of u_anon and u_auth unless userId is u_anon, completed by sel
+func userGrantsQuery(userId, sel string) string {
+	users := grantsForAuthUser
+	if userId == "u_anon" {
+		users = grantsForAnonUser
+	}
+	return fmt.Sprintf(grantsForUserQuery, users, sel)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"sort"
 	"testing"
 	"time"
 
@@ -564,3 +565,108 @@ func TestRepository_SetRoleGrants_Parameters(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_EffectiveGrantsForUser(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, proj := TestScopes(t, repo)
+	user := TestUser(t, repo, org.PublicId)
+
+	userRole := TestRole(t, conn, proj.PublicId)
+	TestRoleGrant(t, conn, userRole.PublicId, "id=*;type=target;actions=read")
+	TestUserRole(t, conn, userRole.PublicId, user.PublicId)
+
+	grp := TestGroup(t, conn, org.PublicId)
+	TestGroupMember(t, conn, grp.PublicId, user.PublicId)
+	grpRole := TestRole(t, conn, org.PublicId)
+	TestRoleGrant(t, conn, grpRole.PublicId, "id=*;type=user;actions=list")
+	TestGroupRole(t, conn, grpRole.PublicId, grp.PublicId)
+	// The same role held directly and through a group
+	TestUserRole(t, conn, grpRole.PublicId, user.PublicId)
+
+	authRole := TestRole(t, conn, org.PublicId)
+	TestRoleGrant(t, conn, authRole.PublicId, "id={{user.id}};actions=read")
+	TestUserRole(t, conn, authRole.PublicId, "u_auth")
+
+	otherUser := TestUser(t, repo, org.PublicId)
+	otherRole := TestRole(t, conn, org.PublicId)
+	TestRoleGrant(t, conn, otherRole.PublicId, "id=*;type=group;actions=read")
+	TestUserRole(t, conn, otherRole.PublicId, otherUser.PublicId)
+
+	tests := []struct {
+		name    string
+		userId  string
+		want    []EffectiveGrant
+		wantErr bool
+	}{
+		{
+			name:    "missing-user-id",
+			wantErr: true,
+		},
+		{
+			name:   "user",
+			userId: user.PublicId,
+			want: []EffectiveGrant{
+				{RoleId: authRole.PublicId, ScopeId: org.PublicId, Grant: "id={{user.id}};actions=read", PrincipalId: "u_auth"},
+				{RoleId: grpRole.PublicId, ScopeId: org.PublicId, Grant: "id=*;type=user;actions=list", PrincipalId: grp.PublicId},
+				{RoleId: grpRole.PublicId, ScopeId: org.PublicId, Grant: "id=*;type=user;actions=list", PrincipalId: user.PublicId},
+				{RoleId: userRole.PublicId, ScopeId: proj.PublicId, Grant: "id=*;type=target;actions=read", PrincipalId: user.PublicId},
+			},
+		},
+		{
+			name:   "anonymous",
+			userId: "u_anon",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.EffectiveGrantsForUser(context.Background(), tt.userId)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Is(err, db.ErrInvalidParameter))
+				return
+			}
+			require.NoError(err)
+
+			// Only consider the grants of the roles created above, since
+			// other roles may exist in the database
+			roles := map[string]bool{userRole.PublicId: true, grpRole.PublicId: true, authRole.PublicId: true, otherRole.PublicId: true}
+			var filtered []EffectiveGrant
+			for _, g := range got {
+				if roles[g.RoleId] {
+					filtered = append(filtered, g)
+				}
+			}
+			want := append([]EffectiveGrant(nil), tt.want...)
+			sort.Slice(want, func(i, j int) bool {
+				if want[i].ScopeId != want[j].ScopeId {
+					return want[i].ScopeId < want[j].ScopeId
+				}
+				if want[i].RoleId != want[j].RoleId {
+					return want[i].RoleId < want[j].RoleId
+				}
+				return want[i].PrincipalId < want[j].PrincipalId
+			})
+			assert.Equal(want, filtered)
+
+			// GrantsForUser returns each grant once however many principals
+			// it is held through
+			pairs, err := repo.GrantsForUser(context.Background(), tt.userId)
+			require.NoError(err)
+			var count int
+			for _, p := range pairs {
+				if roles[p.RoleId] {
+					count++
+				}
+			}
+			uniq := map[string]bool{}
+			for _, g := range want {
+				uniq[g.RoleId+g.Grant] = true
+			}
+			assert.Equal(len(uniq), count)
+		})
+	}
+}
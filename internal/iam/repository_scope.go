@@ -420,7 +420,7 @@ func (r *Repository) DeleteScope(ctx context.Context, withPublicId string, opt .
 	return rowsDeleted, nil
 }
 
-// ListProjects in an org and supports the WithLimit option.
+// ListProjects in an org and supports the WithLimit and WithPageAfter options.
 func (r *Repository) ListProjects(ctx context.Context, withOrgId string, opt ...Option) ([]*Scope, error) {
 	if withOrgId == "" {
 		return nil, fmt.Errorf("list projects: missing org id %w", db.ErrInvalidParameter)
@@ -433,7 +433,7 @@ func (r *Repository) ListProjects(ctx context.Context, withOrgId string, opt ...
 	return projects, nil
 }
 
-// ListOrgs and supports the WithLimit option.
+// ListOrgs and supports the WithLimit and WithPageAfter options.
 func (r *Repository) ListOrgs(ctx context.Context, opt ...Option) ([]*Scope, error) {
 	var orgs []*Scope
 	err := r.list(ctx, &orgs, "parent_id = ? and type = ?", []interface{}{"global", scope.Org.String()}, opt...)
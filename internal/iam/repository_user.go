@@ -194,7 +194,7 @@ func (r *Repository) DeleteUser(ctx context.Context, withPublicId string, opt ..
 	return rowsDeleted, nil
 }
 
-// ListUsers in an org and supports the WithLimit option.
+// ListUsers in an org and supports the WithLimit and WithPageAfter options.
 func (r *Repository) ListUsers(ctx context.Context, withOrgId string, opt ...Option) ([]*User, error) {
 	if withOrgId == "" {
 		return nil, fmt.Errorf("list users: missing org id %w", db.ErrInvalidParameter)
@@ -328,13 +328,20 @@ func (r *Repository) getUserWithAccount(ctx context.Context, withAccountId strin
 }
 
 // ListUserAccounts returns the account ids for the userId and supports the
-// WithLimit option. Returns nil, nil when no associated accounts are found.
+// WithLimit and WithAuthMethodId options. Returns nil, nil when no associated
+// accounts are found.
 func (r *Repository) ListUserAccounts(ctx context.Context, userId string, opt ...Option) ([]string, error) {
 	if userId == "" {
 		return nil, fmt.Errorf("list auth account ids: missing user id: %w", db.ErrInvalidParameter)
 	}
+	opts := getOpts(opt...)
+	where, args := "iam_user_id = ?", []interface{}{userId}
+	if opts.withAuthMethodId != "" {
+		where += " and auth_method_id = ?"
+		args = append(args, opts.withAuthMethodId)
+	}
 	var accounts []*authAccount
-	if err := r.list(ctx, &accounts, "iam_user_id = ?", []interface{}{userId}, opt...); err != nil {
+	if err := r.list(ctx, &accounts, where, args, opt...); err != nil {
 		return nil, fmt.Errorf("list auth account ids: %w", err)
 	}
 	if len(accounts) == 0 {
@@ -83,6 +83,21 @@ func TestRoleGrant_Create(t *testing.T) {
 			}(),
 			create: true,
 		},
+		{
+			name: "valid-deny-grant",
+			args: args{
+				roleId: projRole.PublicId,
+				grant:  "effect=deny;id=*;type=target;actions=authorize-session",
+			},
+			want: func() *RoleGrant {
+				g := allocRoleGrant()
+				g.RoleId = projRole.PublicId
+				g.RawGrant = "effect=deny;id=*;type=target;actions=authorize-session"
+				g.CanonicalGrant = "id=*;type=target;actions=authorize-session;deny=true"
+				return &g
+			}(),
+			create: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -79,6 +79,24 @@ func TestOrg(t *testing.T, repo *Repository, opt ...Option) (org *Scope) {
 	return
 }
 
+// TestProject creates a project in the org with the given ID suitable for
+// testing.
+func TestProject(t *testing.T, repo *Repository, orgId string, opt ...Option) (prj *Scope) {
+	t.Helper()
+	require := require.New(t)
+
+	opts := getOpts(opt...)
+
+	prj, err := NewProject(orgId, opt...)
+	require.NoError(err)
+	prj, err = repo.CreateScope(context.Background(), prj, opts.withUserId, opt...)
+	require.NoError(err)
+	require.NotNil(prj)
+	require.NotEmpty(prj.GetPublicId())
+
+	return
+}
+
 func testOrg(t *testing.T, repo *Repository, name, description string) (org *Scope) {
 	t.Helper()
 	require := require.New(t)
@@ -0,0 +1,114 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms/store"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DefaultCredentialKeyVersionTableName = "kms_credential_key_version"
+)
+
+type CredentialKeyVersion struct {
+	*store.CredentialKeyVersion
+	tableName string `gorm:"-"`
+}
+
+// CredentialKeyVersion creates a new in memory key version. No options are
+// currently supported.
+func NewCredentialKeyVersion(credentialKeyId string, key []byte, rootKeyVersionId string, opt ...Option) (*CredentialKeyVersion, error) {
+	if credentialKeyId == "" {
+		return nil, fmt.Errorf("new credential key version: missing credential key id: %w", db.ErrInvalidParameter)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("new credential key version: missing key: %w", db.ErrInvalidParameter)
+	}
+	if rootKeyVersionId == "" {
+		return nil, fmt.Errorf("new credential key version: missing root key version id: %w", db.ErrInvalidParameter)
+	}
+
+	k := &CredentialKeyVersion{
+		CredentialKeyVersion: &store.CredentialKeyVersion{
+			CredentialKeyId:  credentialKeyId,
+			RootKeyVersionId: rootKeyVersionId,
+			Key:              key,
+		},
+	}
+	return k, nil
+}
+
+// AllocCredentialKeyVersion allocates a key version
+func AllocCredentialKeyVersion() CredentialKeyVersion {
+	return CredentialKeyVersion{
+		CredentialKeyVersion: &store.CredentialKeyVersion{},
+	}
+}
+
+// Clone creates a clone of the key version
+func (k *CredentialKeyVersion) Clone() interface{} {
+	cp := proto.Clone(k.CredentialKeyVersion)
+	return &CredentialKeyVersion{
+		CredentialKeyVersion: cp.(*store.CredentialKeyVersion),
+	}
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the key
+// version before it's written.
+func (k *CredentialKeyVersion) VetForWrite(ctx context.Context, r db.Reader, opType db.OpType, opt ...db.Option) error {
+	if k.PrivateId == "" {
+		return fmt.Errorf("credential key version vet for write: missing private id: %w", db.ErrInvalidParameter)
+	}
+	if opType == db.CreateOp {
+		if k.CtKey == nil {
+			return fmt.Errorf("credential key version vet for write: missing key: %w", db.ErrInvalidParameter)
+		}
+		if k.CredentialKeyId == "" {
+			return fmt.Errorf("credential key version vet for write: missing credential key id: %w", db.ErrInvalidParameter)
+		}
+		if k.RootKeyVersionId == "" {
+			return fmt.Errorf("credential key version vet for write: missing root key version id: %w", db.ErrInvalidParameter)
+		}
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (k *CredentialKeyVersion) TableName() string {
+	if k.tableName != "" {
+		return k.tableName
+	}
+	return DefaultCredentialKeyVersionTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (k *CredentialKeyVersion) SetTableName(n string) {
+	k.tableName = n
+}
+
+// Encrypt will encrypt the key version's key
+func (k *CredentialKeyVersion) Encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	// structwrapping doesn't support embedding, so we'll pass in the
+	// store.CredentialKeyVersion directly
+	if err := structwrapping.WrapStruct(ctx, cipher, k.CredentialKeyVersion, nil); err != nil {
+		return fmt.Errorf("error encrypting kms credential key version: %w", err)
+	}
+	return nil
+}
+
+// Decrypt will decrypt the key version's key
+func (k *CredentialKeyVersion) Decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	// structwrapping doesn't support embedding, so we'll pass in the
+	// store.CredentialKeyVersion directly
+	if err := structwrapping.UnwrapStruct(ctx, cipher, k.CredentialKeyVersion, nil); err != nil {
+		return fmt.Errorf("error decrypting kms credential key version: %w", err)
+	}
+	return nil
+}
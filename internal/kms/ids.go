@@ -7,16 +7,18 @@ import (
 )
 
 const (
-	RootKeyPrefix            = "krk"
-	RootKeyVersionPrefix     = "krkv"
-	DatabaseKeyPrefix        = "kdk"
-	DatabaseKeyVersionPrefix = "kdkv"
-	OplogKeyPrefix           = "kopk"
-	OplogKeyVersionPrefix    = "kopkv"
-	TokenKeyPrefix           = "ktk"
-	TokenKeyVersionPrefix    = "ktv"
-	SessionKeyPrefix         = "ksk"
-	SessionKeyVersionPrefix  = "kskv"
+	RootKeyPrefix              = "krk"
+	RootKeyVersionPrefix       = "krkv"
+	DatabaseKeyPrefix          = "kdk"
+	DatabaseKeyVersionPrefix   = "kdkv"
+	OplogKeyPrefix             = "kopk"
+	OplogKeyVersionPrefix      = "kopkv"
+	TokenKeyPrefix             = "ktk"
+	TokenKeyVersionPrefix      = "ktv"
+	SessionKeyPrefix           = "ksk"
+	SessionKeyVersionPrefix    = "kskv"
+	CredentialKeyPrefix        = "kck"
+	CredentialKeyVersionPrefix = "kckv"
 )
 
 func newRootKeyId() (string, error) {
@@ -98,3 +100,19 @@ func newSessionKeyVersionId() (string, error) {
 	}
 	return id, nil
 }
+
+func newCredentialKeyId() (string, error) {
+	id, err := db.NewPublicId(CredentialKeyPrefix)
+	if err != nil {
+		return "", fmt.Errorf("new credential key id: %w", err)
+	}
+	return id, nil
+}
+
+func newCredentialKeyVersionId() (string, error) {
+	id, err := db.NewPublicId(CredentialKeyVersionPrefix)
+	if err != nil {
+		return "", fmt.Errorf("new credential key version id: %w", err)
+	}
+	return id, nil
+}
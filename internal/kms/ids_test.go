@@ -60,4 +60,14 @@ func Test_Ids(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(t, strings.HasPrefix(id, SessionKeyVersionPrefix+"_"))
 	})
+	t.Run("kck", func(t *testing.T) {
+		id, err := newCredentialKeyId()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(id, CredentialKeyPrefix+"_"))
+	})
+	t.Run("kckv", func(t *testing.T) {
+		id, err := newCredentialKeyVersionId()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(id, CredentialKeyVersionPrefix+"_"))
+	})
 }
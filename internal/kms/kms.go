@@ -2,10 +2,12 @@ package kms
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"sync"
 
+	"github.com/armon/go-metrics"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/go-hclog"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
@@ -13,6 +15,13 @@ import (
 	"github.com/hashicorp/go-kms-wrapping/wrappers/multiwrapper"
 )
 
+var (
+	// cacheHitKey and cacheMissKey are the keys of the number of wrappers
+	// served from and missing in the scope/purpose cache of GetWrapper
+	cacheHitKey  = []string{"kms", "cache", "hit"}
+	cacheMissKey = []string{"kms", "cache", "miss"}
+)
+
 // ExternalWrappers holds wrappers defined outside of Boundary, e.g. in its
 // configuration file.
 type ExternalWrappers struct {
@@ -151,7 +160,7 @@ func (k *Kms) GetWrapper(ctx context.Context, scopeId string, purpose KeyPurpose
 	}
 
 	switch purpose {
-	case KeyPurposeOplog, KeyPurposeDatabase, KeyPurposeTokens, KeyPurposeSessions:
+	case KeyPurposeOplog, KeyPurposeDatabase, KeyPurposeTokens, KeyPurposeSessions, KeyPurposeCredentials:
 	case KeyPurposeUnknown:
 		return nil, errors.New("key purpose not specified")
 	default:
@@ -166,11 +175,14 @@ func (k *Kms) GetWrapper(ctx context.Context, scopeId string, purpose KeyPurpose
 	if ok {
 		wrapper := val.(*multiwrapper.MultiWrapper)
 		if opts.withKeyId == "" || wrapper.WrapperForKeyID(opts.withKeyId) != nil {
+			metrics.IncrCounterWithLabels(cacheHitKey, 1, []metrics.Label{{Name: "purpose", Value: purpose.String()}})
 			return wrapper, nil
 		}
 		// Fall through to refresh our multiwrapper for this scope/purpose from the DB
 	}
 
+	metrics.IncrCounterWithLabels(cacheMissKey, 1, []metrics.Label{{Name: "purpose", Value: purpose.String()}})
+
 	// We don't have it cached, so we'll need to read from the database. Get the
 	// root for the scope as we'll need it to decrypt the value coming from the
 	// DB. We don't cache the roots as we expect that after a few calls the
@@ -192,6 +204,25 @@ func (k *Kms) GetWrapper(ctx context.Context, scopeId string, purpose KeyPurpose
 	return wrapper, nil
 }
 
+// BaseKeyBytes returns the key material of the aead wrapper backing wrapper,
+// such as one returned by GetWrapper, for deriving other keys from it.
+func BaseKeyBytes(wrapper wrapping.Wrapper) ([]byte, error) {
+	var aeadWrapper *aead.Wrapper
+	switch w := wrapper.(type) {
+	case *multiwrapper.MultiWrapper:
+		raw := w.WrapperForKeyID("__base__")
+		var ok bool
+		if aeadWrapper, ok = raw.(*aead.Wrapper); !ok {
+			return nil, errors.New("unexpected wrapper type from multiwrapper base")
+		}
+	case *aead.Wrapper:
+		aeadWrapper = w
+	default:
+		return nil, errors.New("unknown wrapper type")
+	}
+	return aeadWrapper.GetKeyBytes(), nil
+}
+
 func (k *Kms) loadRoot(ctx context.Context, scopeId string, opt ...Option) (*multiwrapper.MultiWrapper, string, error) {
 	opts := getOpts(opt...)
 	repo := opts.withRepository
@@ -294,6 +325,8 @@ func (k *Kms) loadDek(ctx context.Context, scopeId string, purpose KeyPurpose, r
 		keys, err = repo.ListTokenKeys(ctx)
 	case KeyPurposeSessions:
 		keys, err = repo.ListSessionKeys(ctx)
+	case KeyPurposeCredentials:
+		keys, err = repo.ListCredentialKeys(ctx)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("error listing root keys: %w", err)
@@ -305,6 +338,14 @@ func (k *Kms) loadDek(ctx context.Context, scopeId string, purpose KeyPurpose, r
 			break
 		}
 	}
+	if keyId == "" && purpose == KeyPurposeCredentials {
+		// Scopes created before credential keys were introduced don't have
+		// one, so it is created the first time it is needed
+		keyId, err = createMissingCredentialKey(ctx, repo, rootWrapper, rootKeyId)
+		if err != nil {
+			return nil, fmt.Errorf("error creating %s key for scope %s: %w", purpose.String(), scopeId, err)
+		}
+	}
 	if keyId == "" {
 		return nil, fmt.Errorf("error finding %s key for scope %s", purpose.String(), scopeId)
 	}
@@ -319,6 +360,8 @@ func (k *Kms) loadDek(ctx context.Context, scopeId string, purpose KeyPurpose, r
 		keyVersions, err = repo.ListTokenKeyVersions(ctx, rootWrapper, keyId, WithOrder("version desc"))
 	case KeyPurposeSessions:
 		keyVersions, err = repo.ListSessionKeyVersions(ctx, rootWrapper, keyId, WithOrder("version desc"))
+	case KeyPurposeCredentials:
+		keyVersions, err = repo.ListCredentialKeyVersions(ctx, rootWrapper, keyId, WithOrder("version desc"))
 	}
 	if err != nil {
 		return nil, fmt.Errorf("error looking up %s key versions for scope %s with key ID %s: %w", purpose.String(), scopeId, rootWrapper.KeyID(), err)
@@ -347,3 +390,27 @@ func (k *Kms) loadDek(ctx context.Context, scopeId string, purpose KeyPurpose, r
 
 	return multi, err
 }
+
+// createMissingCredentialKey creates the credential key for the root key
+// rootKeyId, encrypted with rootWrapper, and returns its ID. If another
+// controller created the key first, the ID of that key is returned.
+func createMissingCredentialKey(ctx context.Context, repo *Repository, rootWrapper wrapping.Wrapper, rootKeyId string) (string, error) {
+	key, err := generateKey(rand.Reader)
+	if err != nil {
+		return "", err
+	}
+	ck, _, createErr := repo.CreateCredentialKey(ctx, rootWrapper, key)
+	if createErr == nil {
+		return ck.GetPrivateId(), nil
+	}
+	keys, err := repo.ListCredentialKeys(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error listing credential keys: %w", err)
+	}
+	for _, k := range keys {
+		if k.GetRootKeyId() == rootKeyId {
+			return k.GetPrivateId(), nil
+		}
+	}
+	return "", createErr
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/types/scope"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
 	"github.com/hashicorp/go-kms-wrapping/wrappers/aead"
 	"github.com/hashicorp/go-kms-wrapping/wrappers/multiwrapper"
 	"github.com/stretchr/testify/assert"
@@ -73,14 +74,14 @@ func TestKms(t *testing.T) {
 	keyIds := map[string]bool{}
 	scopePurposeMap := map[string]interface{}{}
 	for i := 1; i < 3; i++ {
-		// This iterates through wrappers for all three scopes and four purposes,
+		// This iterates through wrappers for all three scopes and five purposes,
 		// ensuring that the key bytes and IDs are different for each of them,
 		// simulating calling the KMS object from different scopes for different
 		// purposes and ensuring the keys are different when that happens.
 		t.Run(fmt.Sprintf("verify wrappers different x %d", i), func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 			for _, scopeId := range []string{scope.Global.String(), org.GetPublicId(), proj.GetPublicId()} {
-				for _, purpose := range []kms.KeyPurpose{kms.KeyPurposeUnknown, kms.KeyPurposeOplog, kms.KeyPurposeDatabase, kms.KeyPurposeSessions, kms.KeyPurposeTokens} {
+				for _, purpose := range []kms.KeyPurpose{kms.KeyPurposeUnknown, kms.KeyPurposeOplog, kms.KeyPurposeDatabase, kms.KeyPurposeSessions, kms.KeyPurposeTokens, kms.KeyPurposeCredentials} {
 					wrapper, err := kmsCache.GetWrapper(ctx, scopeId, purpose)
 					if purpose == kms.KeyPurposeUnknown {
 						require.Error(err)
@@ -120,8 +121,36 @@ func TestKms(t *testing.T) {
 				}
 				return true
 			})
-			// four purposes x 3 scopes
-			assert.Equal(t, 12, count)
+			// five purposes x 3 scopes
+			assert.Equal(t, 15, count)
 		})
 	}
 }
+
+func TestBaseKeyBytes(t *testing.T) {
+	key := make([]byte, 32)
+	for i := range key {
+		key[i] = byte(i)
+	}
+	aeadWrapper := aead.NewWrapper(nil)
+	require.NoError(t, aeadWrapper.SetAESGCMKeyBytes(key))
+
+	t.Run("aead", func(t *testing.T) {
+		got, err := kms.BaseKeyBytes(aeadWrapper)
+		require.NoError(t, err)
+		assert.Equal(t, key, got)
+	})
+	t.Run("multiwrapper", func(t *testing.T) {
+		got, err := kms.BaseKeyBytes(multiwrapper.NewMultiWrapper(aeadWrapper))
+		require.NoError(t, err)
+		assert.Equal(t, key, got)
+	})
+	t.Run("multiwrapper of another type", func(t *testing.T) {
+		_, err := kms.BaseKeyBytes(multiwrapper.NewMultiWrapper(wrapping.NewTestWrapper(key)))
+		assert.Error(t, err)
+	})
+	t.Run("another type", func(t *testing.T) {
+		_, err := kms.BaseKeyBytes(wrapping.NewTestWrapper(key))
+		assert.Error(t, err)
+	})
+}
@@ -138,6 +138,15 @@ func CreateKeysTx(ctx context.Context, dbReader db.Reader, dbWriter db.Writer, r
 		return nil, fmt.Errorf("create keys: unable to create session key in scope %s: %w", scopeId, err)
 	}
 
+	k, err = generateKey(randomReader)
+	if err != nil {
+		return nil, fmt.Errorf("create keys: error generating random bytes for credential key in scope %s: %w", scopeId, err)
+	}
+	credentialKey, credentialKeyVersion, err := createCredentialKeyTx(ctx, dbReader, dbWriter, rkvWrapper, k)
+	if err != nil {
+		return nil, fmt.Errorf("create keys: unable to create credential key in scope %s: %w", scopeId, err)
+	}
+
 	k, err = generateKey(randomReader)
 	if err != nil {
 		return nil, fmt.Errorf("create keys: error generating random bytes for token key in scope %s: %w", scopeId, err)
@@ -148,16 +157,18 @@ func CreateKeysTx(ctx context.Context, dbReader db.Reader, dbWriter db.Writer, r
 	}
 
 	keys := Keys{
-		KeyTypeRootKey:            rootKey,
-		KeyTypeRootKeyVersion:     rootKeyVersion,
-		KeyTypeDatabaseKey:        dbKey,
-		KeyTypeDatabaseKeyVersion: dbKeyVersion,
-		KeyTypeOplogKey:           oplogKey,
-		KeyTypeOplogKeyVersion:    oplogKeyVersion,
-		KeyTypeSessionKey:         sessionKey,
-		KeyTypeSessionKeyVersion:  sessionKeyVersion,
-		KeyTypeTokenKey:           tokenKey,
-		KeyTypeTokenKeyVersion:    tokenKeyVersion,
+		KeyTypeRootKey:              rootKey,
+		KeyTypeRootKeyVersion:       rootKeyVersion,
+		KeyTypeDatabaseKey:          dbKey,
+		KeyTypeDatabaseKeyVersion:   dbKeyVersion,
+		KeyTypeOplogKey:             oplogKey,
+		KeyTypeOplogKeyVersion:      oplogKeyVersion,
+		KeyTypeSessionKey:           sessionKey,
+		KeyTypeSessionKeyVersion:    sessionKeyVersion,
+		KeyTypeCredentialKey:        credentialKey,
+		KeyTypeCredentialKeyVersion: credentialKeyVersion,
+		KeyTypeTokenKey:             tokenKey,
+		KeyTypeTokenKeyVersion:      tokenKeyVersion,
 	}
 	return keys, nil
 }
@@ -234,6 +234,19 @@ func TestCreateKeysTx(t *testing.T) {
 			assert.Equal(sk.PrivateId, skv.SessionKeyId)
 			assert.Equal(rkv.PrivateId, skv.RootKeyVersionId)
 
+			ck := kms.AllocCredentialKey()
+			ck.PrivateId = keys[kms.KeyTypeCredentialKey].GetPrivateId()
+			err = rw.LookupById(context.Background(), &ck)
+			require.NoError(err)
+			assert.Equal(rk.PrivateId, ck.RootKeyId)
+
+			ckv := kms.AllocCredentialKeyVersion()
+			ckv.PrivateId = keys[kms.KeyTypeCredentialKeyVersion].GetPrivateId()
+			err = rw.LookupById(context.Background(), &ckv)
+			require.NoError(err)
+			assert.Equal(ck.PrivateId, ckv.CredentialKeyId)
+			assert.Equal(rkv.PrivateId, ckv.RootKeyVersionId)
+
 			tk := kms.AllocTokenKey()
 			tk.PrivateId = keys[kms.KeyTypeTokenKey].GetPrivateId()
 			err = rw.LookupById(context.Background(), &tk)
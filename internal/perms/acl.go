@@ -30,10 +30,49 @@ type ACL struct {
 type ACLResults struct {
 	Allowed bool
 
+	// Reason describes how the result was reached
+	Reason Reason
+
+	// Grant is the grant that determined the result: the deny grant that
+	// denied the action, or otherwise the first grant that allowed it. It is
+	// nil if no grant matched.
+	Grant *Grant
+
 	// This is included but unexported for testing/debugging
 	scopeMap map[string][]Grant
 }
 
+// Reason describes why an ACL check had the result it did
+type Reason uint
+
+const (
+	ReasonUnknown Reason = iota
+	ReasonNoGrantsInScope
+	ReasonNoGrantForAction
+	ReasonNoGrantForResource
+	ReasonConditionsNotMet
+	ReasonAllowed
+	ReasonDenied
+)
+
+func (r Reason) String() string {
+	switch r {
+	case ReasonNoGrantsInScope:
+		return "no grants in the scope of the resource"
+	case ReasonNoGrantForAction:
+		return "no grant in the scope of the resource includes the action"
+	case ReasonNoGrantForResource:
+		return "no grant including the action matches the resource"
+	case ReasonConditionsNotMet:
+		return "the conditions of the grants matching the resource and action do not hold"
+	case ReasonAllowed:
+		return "allowed by a grant"
+	case ReasonDenied:
+		return "denied by a deny grant"
+	}
+	return "unknown"
+}
+
 // Resource defines something within boundary that requires authorization
 // capabilities.  Resources must have a ScopeId.
 type Resource struct {
@@ -65,16 +104,31 @@ func NewACL(grants ...Grant) ACL {
 }
 
 // Allowed determines if the grants for an ACL allow an action for a resource.
-func (a ACL) Allowed(r Resource, aType action.Type) (results ACLResults) {
+// The action is allowed if at least one grant in the resource's scope allows
+// it and no grant in that scope denies it; a matching deny grant always takes
+// precedence. Grants with conditions only match if their conditions hold for
+// the request described by the WithClientIp, WithAuthMethodId and WithTime
+// options.
+func (a ACL) Allowed(r Resource, aType action.Type, opt ...Option) (results ACLResults) {
+	opts := getOpts(opt...)
+
 	// First, get the grants within the specified scope
 	grants := a.scopeMap[r.ScopeId]
 	results.scopeMap = a.scopeMap
+	results.Reason = ReasonNoGrantsInScope
+	if len(grants) > 0 {
+		results.Reason = ReasonNoGrantForAction
+	}
 
 	// Now, go through and check the cases indicated above
-	for _, grant := range grants {
+	for i, grant := range grants {
 		if !(grant.actions[aType] || grant.actions[action.All]) {
 			continue
 		}
+		if results.Reason == ReasonNoGrantForAction {
+			results.Reason = ReasonNoGrantForResource
+		}
+		var matched bool
 		switch {
 		// id=<resource.id>;actions=<action> where ID cannot be a wildcard
 		case grant.id == r.Id &&
@@ -82,8 +136,7 @@ func (a ACL) Allowed(r Resource, aType action.Type) (results ACLResults) {
 			grant.id != "*" &&
 			grant.typ == resource.Unknown:
 
-			results.Allowed = true
-			return
+			matched = true
 
 		// type=<resource.type>;actions=<action> when action is list or create.
 		// Must be a top level collection, otherwise must be one of the two
@@ -95,8 +148,7 @@ func (a ACL) Allowed(r Resource, aType action.Type) (results ACLResults) {
 			topLevelType(r.Type) &&
 			(aType == action.List || aType == action.Create):
 
-			results.Allowed = true
-			return
+			matched = true
 
 		// id=*;type=<resource.type>;actions=<action> where type cannot be
 		// unknown but can be a wildcard to allow any resource at all
@@ -105,8 +157,7 @@ func (a ACL) Allowed(r Resource, aType action.Type) (results ACLResults) {
 			(grant.typ == r.Type ||
 				grant.typ == resource.All):
 
-			results.Allowed = true
-			return
+			matched = true
 
 		// id=<pin>;type=<resource.type>;actions=<action> where type can be a
 		// wildcard and this this is operating on a non-top-level type
@@ -116,17 +167,43 @@ func (a ACL) Allowed(r Resource, aType action.Type) (results ACLResults) {
 			(grant.typ == r.Type || grant.typ == resource.All) &&
 			!topLevelType(r.Type):
 
-			results.Allowed = true
+			matched = true
+		}
+		if !matched {
+			continue
+		}
+		if !grant.conditionsHold(opts) {
+			if !results.Allowed {
+				results.Reason = ReasonConditionsNotMet
+			}
+			continue
+		}
+		if grant.deny {
+			results.Allowed = false
+			results.Reason = ReasonDenied
+			results.Grant = &grants[i]
 			return
 		}
+		// Keep going as a later deny grant can still override this
+		if !results.Allowed {
+			results.Allowed = true
+			results.Reason = ReasonAllowed
+			results.Grant = &grants[i]
+		}
 	}
 	return
 }
 
+// ScopeGrants returns the grants of the ACL that apply in the given scope
+func (a ACL) ScopeGrants(scopeId string) []Grant {
+	return a.scopeMap[scopeId]
+}
+
 func topLevelType(typ resource.Type) bool {
 	switch typ {
 	case resource.AuthMethod,
 		resource.AuthToken,
+		resource.CredentialStore,
 		resource.Group,
 		resource.HostCatalog,
 		resource.Role,
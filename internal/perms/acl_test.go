@@ -1,7 +1,9 @@
 package perms
 
 import (
+	"net"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
@@ -61,6 +63,14 @@ func Test_ACLAllowed(t *testing.T) {
 				"id=*;type=*;actions=create,update",
 			},
 		},
+		{
+			scope: "o_e",
+			grants: []string{
+				"id=ttcp_secret;actions=*;deny=true",
+				"id=*;type=target;actions=*",
+				"id=*;type=*;actions=delete;effect=deny",
+			},
+		},
 	}
 
 	// See acl.go for expected allowed formats. The goal here is to basically
@@ -252,6 +262,33 @@ func Test_ACLAllowed(t *testing.T) {
 			},
 			userId: "u_abcd1234",
 		},
+		{
+			name:        "deny by id wins over allow",
+			resource:    Resource{ScopeId: "o_e", Id: "ttcp_secret", Type: resource.Target},
+			scopeGrants: commonGrants,
+			actionsAllowed: []actionAllowed{
+				{action: action.Read},
+				{action: action.AuthorizeSession},
+			},
+		},
+		{
+			name:        "deny of one action",
+			resource:    Resource{ScopeId: "o_e", Id: "ttcp_other", Type: resource.Target},
+			scopeGrants: commonGrants,
+			actionsAllowed: []actionAllowed{
+				{action: action.Read, allowed: true},
+				{action: action.AuthorizeSession, allowed: true},
+				{action: action.Delete},
+			},
+		},
+		{
+			name:        "deny in another scope",
+			resource:    Resource{ScopeId: "o_d", Id: "ttcp_secret", Type: resource.Target},
+			scopeGrants: commonGrants,
+			actionsAllowed: []actionAllowed{
+				{action: action.Update, allowed: true},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -271,3 +308,202 @@ func Test_ACLAllowed(t *testing.T) {
 		})
 	}
 }
+
+func Test_ACLAllowedConditions(t *testing.T) {
+	t.Parallel()
+
+	grants := []string{
+		"id=*;type=target;actions=read",
+		"id=*;type=target;actions=authorize-session;cidrs=10.0.0.0/8,192.168.1.10;time=22:00-06:00",
+		"id=*;type=target;actions=update;auth_method_ids=ampw_1234567890",
+		"id=ttcp_prod;actions=*;cidrs=172.16.0.0/12;deny=true",
+	}
+	var parsed []Grant
+	for _, g := range grants {
+		grant, err := Parse("o_a", g)
+		require.NoError(t, err)
+		parsed = append(parsed, grant)
+	}
+	acl := NewACL(parsed...)
+
+	night := time.Date(2020, 10, 1, 23, 30, 0, 0, time.UTC)
+	morning := time.Date(2020, 10, 2, 5, 59, 0, 0, time.UTC)
+	day := time.Date(2020, 10, 2, 12, 0, 0, 0, time.UTC)
+	target := Resource{ScopeId: "o_a", Id: "ttcp_dev", Type: resource.Target}
+	prod := Resource{ScopeId: "o_a", Id: "ttcp_prod", Type: resource.Target}
+
+	tests := []struct {
+		name     string
+		resource Resource
+		action   action.Type
+		opts     []Option
+		allowed  bool
+	}{
+		{
+			name:     "unconditional",
+			resource: target,
+			action:   action.Read,
+			allowed:  true,
+		},
+		{
+			name:     "cidr and time hold",
+			resource: target,
+			action:   action.AuthorizeSession,
+			opts:     []Option{WithClientIp(net.ParseIP("10.1.2.3")), WithTime(night)},
+			allowed:  true,
+		},
+		{
+			name:     "single address and time across midnight hold",
+			resource: target,
+			action:   action.AuthorizeSession,
+			opts:     []Option{WithClientIp(net.ParseIP("192.168.1.10")), WithTime(morning)},
+			allowed:  true,
+		},
+		{
+			name:     "outside cidr",
+			resource: target,
+			action:   action.AuthorizeSession,
+			opts:     []Option{WithClientIp(net.ParseIP("192.168.1.11")), WithTime(night)},
+		},
+		{
+			name:     "outside time window",
+			resource: target,
+			action:   action.AuthorizeSession,
+			opts:     []Option{WithClientIp(net.ParseIP("10.1.2.3")), WithTime(day)},
+		},
+		{
+			name:     "unknown client address",
+			resource: target,
+			action:   action.AuthorizeSession,
+			opts:     []Option{WithTime(night)},
+		},
+		{
+			name:     "matching auth method",
+			resource: target,
+			action:   action.Update,
+			opts:     []Option{WithAuthMethodId("ampw_1234567890")},
+			allowed:  true,
+		},
+		{
+			name:     "other auth method",
+			resource: target,
+			action:   action.Update,
+			opts:     []Option{WithAuthMethodId("ampw_0987654321")},
+		},
+		{
+			name:     "unknown auth method",
+			resource: target,
+			action:   action.Update,
+		},
+		{
+			name:     "conditional deny holds",
+			resource: prod,
+			action:   action.Read,
+			opts:     []Option{WithClientIp(net.ParseIP("172.16.5.5"))},
+		},
+		{
+			name:     "conditional deny does not hold",
+			resource: prod,
+			action:   action.Read,
+			opts:     []Option{WithClientIp(net.ParseIP("10.1.2.3"))},
+			allowed:  true,
+		},
+		{
+			name:     "conditional deny with unknown client address",
+			resource: prod,
+			action:   action.Read,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.allowed, acl.Allowed(tt.resource, tt.action, tt.opts...).Allowed)
+		})
+	}
+}
+
+func Test_ACLResultsReason(t *testing.T) {
+	t.Parallel()
+
+	grants := []GrantPair{
+		{ScopeId: "o_a", RoleId: "r_read", Grant: "id=*;type=target;actions=read"},
+		{ScopeId: "o_a", RoleId: "r_all", Grant: "id=*;type=target;actions=*"},
+		{ScopeId: "o_a", RoleId: "r_deny", Grant: "id=ttcp_prod;actions=update;deny=true"},
+		{ScopeId: "o_a", RoleId: "r_vpn", Grant: "id=*;type=host-catalog;actions=read;cidrs=10.0.0.0/8"},
+		{ScopeId: "o_b", RoleId: "r_list", Grant: "type=target;actions=list"},
+	}
+	var parsed []Grant
+	for _, pair := range grants {
+		grant, err := Parse(pair.ScopeId, pair.Grant, WithRoleId(pair.RoleId))
+		require.NoError(t, err)
+		parsed = append(parsed, grant)
+	}
+	acl := NewACL(parsed...)
+
+	tests := []struct {
+		name      string
+		resource  Resource
+		action    action.Type
+		allowed   bool
+		reason    Reason
+		wantRole  string
+		wantGrant string
+	}{
+		{
+			name:     "no grants in scope",
+			resource: Resource{ScopeId: "o_c", Id: "ttcp_dev", Type: resource.Target},
+			action:   action.Read,
+			reason:   ReasonNoGrantsInScope,
+		},
+		{
+			name:     "no grant for action",
+			resource: Resource{ScopeId: "o_b", Id: "ttcp_dev", Type: resource.Target},
+			action:   action.Read,
+			reason:   ReasonNoGrantForAction,
+		},
+		{
+			name:     "no grant for resource",
+			resource: Resource{ScopeId: "o_b", Id: "u_1234567890", Type: resource.User},
+			action:   action.List,
+			reason:   ReasonNoGrantForResource,
+		},
+		{
+			name:     "conditions not met",
+			resource: Resource{ScopeId: "o_a", Id: "hcst_1234567890", Type: resource.HostCatalog},
+			action:   action.Read,
+			reason:   ReasonConditionsNotMet,
+		},
+		{
+			name:      "first allowing grant",
+			resource:  Resource{ScopeId: "o_a", Id: "ttcp_dev", Type: resource.Target},
+			action:    action.Read,
+			allowed:   true,
+			reason:    ReasonAllowed,
+			wantRole:  "r_read",
+			wantGrant: "id=*;type=target;actions=read",
+		},
+		{
+			name:      "denying grant",
+			resource:  Resource{ScopeId: "o_a", Id: "ttcp_prod", Type: resource.Target},
+			action:    action.Update,
+			reason:    ReasonDenied,
+			wantRole:  "r_deny",
+			wantGrant: "id=ttcp_prod;actions=update;deny=true",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			results := acl.Allowed(tt.resource, tt.action)
+			assert.Equal(tt.allowed, results.Allowed)
+			assert.Equal(tt.reason, results.Reason)
+			if tt.wantGrant == "" {
+				assert.Nil(results.Grant)
+				return
+			}
+			require.NotNil(results.Grant)
+			assert.Equal(tt.wantRole, results.Grant.RoleId())
+			assert.Equal(tt.resource.ScopeId, results.Grant.ScopeId())
+			assert.Equal(tt.wantGrant, results.Grant.CanonicalString())
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package perms
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+)
+
+// timeWindow is a daily window of time in UTC, expressed in minutes since
+// midnight. The start is inclusive and the end exclusive. If the end is before
+// the start the window spans midnight.
+type timeWindow struct {
+	start int
+	end   int
+}
+
+// parseTimeWindow parses a window formatted as "HH:MM-HH:MM"
+func parseTimeWindow(s string) (*timeWindow, error) {
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("time window %q not formatted as HH:MM-HH:MM", s)
+	}
+	var minutes [2]int
+	for i, part := range parts {
+		t, err := time.Parse("15:04", strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("time window %q not formatted as HH:MM-HH:MM", s)
+		}
+		minutes[i] = t.Hour()*60 + t.Minute()
+	}
+	if minutes[0] == minutes[1] {
+		return nil, fmt.Errorf("time window %q is empty", s)
+	}
+	return &timeWindow{start: minutes[0], end: minutes[1]}, nil
+}
+
+func (w timeWindow) String() string {
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", w.start/60, w.start%60, w.end/60, w.end%60)
+}
+
+// contains returns whether t falls within the window
+func (w timeWindow) contains(t time.Time) bool {
+	t = t.UTC()
+	m := t.Hour()*60 + t.Minute()
+	if w.start < w.end {
+		return m >= w.start && m < w.end
+	}
+	return m >= w.start || m < w.end
+}
+
+// parseCidrs parses a list of CIDR blocks. A bare IP address is treated as a
+// block containing only that address.
+func parseCidrs(in []string) ([]*net.IPNet, error) {
+	ret := make([]*net.IPNet, 0, len(in))
+	for _, c := range in {
+		c = strings.TrimSpace(c)
+		if c == "" {
+			return nil, fmt.Errorf("empty cidr found")
+		}
+		if !strings.Contains(c, "/") {
+			ip := net.ParseIP(c)
+			if ip == nil {
+				return nil, fmt.Errorf("unable to parse %q as cidr", c)
+			}
+			bits := 128
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			ret = append(ret, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse %q as cidr", c)
+		}
+		ret = append(ret, n)
+	}
+	return ret, nil
+}
+
+// hasConditions returns whether the grant only applies under some conditions
+func (g Grant) hasConditions() bool {
+	return len(g.cidrs) > 0 || len(g.authMethodIds) > 0 || g.timeWindow != nil
+}
+
+// cidrStrings returns the sorted string forms of the grant's CIDR blocks
+func (g Grant) cidrStrings() []string {
+	ret := make([]string, 0, len(g.cidrs))
+	for _, c := range g.cidrs {
+		ret = append(ret, c.String())
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// sortedAuthMethodIds returns a sorted copy of the grant's auth method IDs
+func (g Grant) sortedAuthMethodIds() []string {
+	ret := append([]string(nil), g.authMethodIds...)
+	sort.Strings(ret)
+	return ret
+}
+
+// conditionsHold returns whether the conditions of the grant hold for the
+// request described by opts. A condition that depends on a detail of the
+// request that is not known holds for a deny grant but not for an allow grant,
+// so a missing detail never widens what is allowed.
+func (g Grant) conditionsHold(opts options) bool {
+	if len(g.cidrs) > 0 {
+		switch opts.withClientIp {
+		case nil:
+			if !g.deny {
+				return false
+			}
+		default:
+			var found bool
+			for _, c := range g.cidrs {
+				if c.Contains(opts.withClientIp) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	if len(g.authMethodIds) > 0 {
+		switch opts.withAuthMethodId {
+		case "":
+			if !g.deny {
+				return false
+			}
+		default:
+			var found bool
+			for _, id := range g.authMethodIds {
+				if id == opts.withAuthMethodId {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	if g.timeWindow != nil {
+		now := opts.withTime
+		if now.IsZero() {
+			now = time.Now()
+		}
+		if !g.timeWindow.contains(now) {
+			return false
+		}
+	}
+
+	return true
+}
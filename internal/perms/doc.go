@@ -15,6 +15,15 @@ ACLs that are ever allowed:
 
 and of course a matching scope.
 
+Any of these can be made a deny grant by adding deny=true (or effect=deny).
+Within a scope a matching deny grant always wins over matching allow grants, so
+e.g. id=*;type=target;actions=* together with
+id=ttcp_1234567890;actions=*;deny=true allows every target but one.
+
+Grants can also carry conditions (cidrs, auth_method_ids and time) on the
+request being authorized; a grant with conditions only matches when they all
+hold. See conditions.go.
+
 This makes it actually quite simple to perform the ACL checking. Much of ACL
 construction is thus synthesizing something reasonable from a set of Grants.
 */
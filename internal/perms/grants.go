@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/types/action"
@@ -17,6 +19,7 @@ import (
 type GrantPair struct {
 	ScopeId string
 	Grant   string
+	RoleId  string
 }
 
 // Scope provides an in-memory representation of iam.Scope without the
@@ -34,6 +37,9 @@ type Grant struct {
 	// The scope ID, which will be a project ID or an org ID
 	scope Scope
 
+	// The ID of the role the grant came from, if known
+	roleId string
+
 	// The ID in the grant, if provided.
 	id string
 
@@ -43,11 +49,32 @@ type Grant struct {
 	// The set of actions being granted
 	actions map[action.Type]bool
 
+	// Whether the grant denies rather than allows the actions. A matching deny
+	// grant takes precedence over any matching allow grant in the same scope.
+	deny bool
+
+	// Conditions on the request under which the grant applies. A grant with
+	// no conditions always applies.
+	cidrs         []*net.IPNet
+	authMethodIds []string
+	timeWindow    *timeWindow
+
 	// This is used as a temporary staging area before validating permissions to
 	// allow the same validation code across grant string formats
 	actionsBeingParsed []string
 }
 
+// ScopeId returns the ID of the scope the grant applies to
+func (g Grant) ScopeId() string {
+	return g.scope.Id
+}
+
+// RoleId returns the ID of the role the grant came from, if it was parsed
+// with WithRoleId
+func (g Grant) RoleId() string {
+	return g.roleId
+}
+
 func (g Grant) Id() string {
 	return g.id
 }
@@ -56,6 +83,38 @@ func (g Grant) Type() resource.Type {
 	return g.typ
 }
 
+// Deny returns whether the grant denies rather than allows its actions
+func (g Grant) Deny() bool {
+	return g.deny
+}
+
+// Cidrs returns the CIDR blocks the client address of a request must be in for
+// the grant to apply, if any
+func (g Grant) Cidrs() []string {
+	if len(g.cidrs) == 0 {
+		return nil
+	}
+	return g.cidrStrings()
+}
+
+// AuthMethodIds returns the auth methods the client of a request must have
+// authenticated with for the grant to apply, if any
+func (g Grant) AuthMethodIds() []string {
+	if len(g.authMethodIds) == 0 {
+		return nil
+	}
+	return g.sortedAuthMethodIds()
+}
+
+// Time returns the daily UTC window a request must be made in for the grant to
+// apply, if any
+func (g Grant) Time() string {
+	if g.timeWindow == nil {
+		return ""
+	}
+	return g.timeWindow.String()
+}
+
 func (g Grant) Actions() (typs []action.Type, strs []string) {
 	typs = make([]action.Type, 0, len(g.actions))
 	strs = make([]string, 0, len(g.actions))
@@ -72,9 +131,21 @@ func (g Grant) Actions() (typs []action.Type, strs []string) {
 
 func (g Grant) clone() *Grant {
 	ret := &Grant{
-		scope: g.scope,
-		id:    g.id,
-		typ:   g.typ,
+		scope:  g.scope,
+		roleId: g.roleId,
+		id:     g.id,
+		typ:    g.typ,
+		deny:   g.deny,
+	}
+	if g.cidrs != nil {
+		ret.cidrs = append(ret.cidrs, g.cidrs...)
+	}
+	if g.authMethodIds != nil {
+		ret.authMethodIds = append(ret.authMethodIds, g.authMethodIds...)
+	}
+	if g.timeWindow != nil {
+		tw := *g.timeWindow
+		ret.timeWindow = &tw
 	}
 	if g.actionsBeingParsed != nil {
 		ret.actionsBeingParsed = append(ret.actionsBeingParsed, g.actionsBeingParsed...)
@@ -109,6 +180,22 @@ func (g Grant) CanonicalString() string {
 		builder = append(builder, fmt.Sprintf("actions=%s", strings.Join(actions, ",")))
 	}
 
+	if len(g.authMethodIds) > 0 {
+		builder = append(builder, fmt.Sprintf("auth_method_ids=%s", strings.Join(g.sortedAuthMethodIds(), ",")))
+	}
+
+	if len(g.cidrs) > 0 {
+		builder = append(builder, fmt.Sprintf("cidrs=%s", strings.Join(g.cidrStrings(), ",")))
+	}
+
+	if g.timeWindow != nil {
+		builder = append(builder, fmt.Sprintf("time=%s", g.timeWindow))
+	}
+
+	if g.deny {
+		builder = append(builder, "deny=true")
+	}
+
 	return strings.Join(builder, ";")
 }
 
@@ -129,6 +216,18 @@ func (g Grant) MarshalJSON() ([]byte, error) {
 		sort.Strings(actions)
 		res["actions"] = actions
 	}
+	if len(g.authMethodIds) > 0 {
+		res["auth_method_ids"] = g.sortedAuthMethodIds()
+	}
+	if len(g.cidrs) > 0 {
+		res["cidrs"] = g.cidrStrings()
+	}
+	if g.timeWindow != nil {
+		res["time"] = g.timeWindow.String()
+	}
+	if g.deny {
+		res["deny"] = true
+	}
 	return json.Marshal(res)
 }
 
@@ -177,10 +276,75 @@ func (g *Grant) unmarshalJSON(data []byte) error {
 			}
 		}
 	}
+	if rawDeny, ok := raw["deny"]; ok {
+		deny, ok := rawDeny.(bool)
+		if !ok {
+			return fmt.Errorf("unable to interpret %q as boolean", "deny")
+		}
+		g.deny = deny
+	}
+	if rawEffect, ok := raw["effect"]; ok {
+		if _, ok := raw["deny"]; ok {
+			return errDenyAndEffect
+		}
+		effect, ok := rawEffect.(string)
+		if !ok {
+			return fmt.Errorf("unable to interpret %q as string", "effect")
+		}
+		if err := g.setEffect(effect); err != nil {
+			return err
+		}
+	}
+	if rawIds, ok := raw["auth_method_ids"]; ok {
+		ids, err := jsonStringArray("auth_method_ids", rawIds)
+		if err != nil {
+			return err
+		}
+		if err := g.setAuthMethodIds(ids); err != nil {
+			return err
+		}
+	}
+	if rawCidrs, ok := raw["cidrs"]; ok {
+		cidrs, err := jsonStringArray("cidrs", rawCidrs)
+		if err != nil {
+			return err
+		}
+		if g.cidrs, err = parseCidrs(cidrs); err != nil {
+			return err
+		}
+	}
+	if rawTime, ok := raw["time"]; ok {
+		window, ok := rawTime.(string)
+		if !ok {
+			return fmt.Errorf("unable to interpret %q as string", "time")
+		}
+		var err error
+		if g.timeWindow, err = parseTimeWindow(window); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// jsonStringArray converts a decoded JSON array of strings
+func jsonStringArray(name string, raw interface{}) ([]string, error) {
+	interfaceVals, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unable to interpret %q as array", name)
+	}
+	ret := make([]string, 0, len(interfaceVals))
+	for _, v := range interfaceVals {
+		str, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("unable to interpret %v in %s array as string", v, name)
+		}
+		ret = append(ret, str)
+	}
+	return ret, nil
+}
+
 func (g *Grant) unmarshalText(grantString string) error {
+	var denySet, effectSet bool
 	segments := strings.Split(grantString, ";")
 	for _, segment := range segments {
 		kv := strings.Split(segment, "=")
@@ -217,12 +381,78 @@ func (g *Grant) unmarshalText(grantString string) error {
 					g.actionsBeingParsed = append(g.actionsBeingParsed, strings.ToLower(action))
 				}
 			}
+
+		case "deny":
+			if effectSet {
+				return errDenyAndEffect
+			}
+			denySet = true
+			deny, err := strconv.ParseBool(kv[1])
+			if err != nil {
+				return fmt.Errorf("unable to interpret %q value %q as boolean", "deny", kv[1])
+			}
+			g.deny = deny
+
+		case "effect":
+			if denySet {
+				return errDenyAndEffect
+			}
+			effectSet = true
+			if err := g.setEffect(kv[1]); err != nil {
+				return err
+			}
+
+		case "auth_method_ids":
+			if err := g.setAuthMethodIds(strings.Split(kv[1], ",")); err != nil {
+				return err
+			}
+
+		case "cidrs":
+			var err error
+			if g.cidrs, err = parseCidrs(strings.Split(kv[1], ",")); err != nil {
+				return err
+			}
+
+		case "time":
+			var err error
+			if g.timeWindow, err = parseTimeWindow(kv[1]); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
+// setAuthMethodIds sets the auth method IDs condition
+func (g *Grant) setAuthMethodIds(ids []string) error {
+	g.authMethodIds = make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" {
+			return errors.New("empty auth method id found")
+		}
+		g.authMethodIds = append(g.authMethodIds, strings.ToLower(id))
+	}
+	return nil
+}
+
+// errDenyAndEffect is returned when a grant sets both deny and effect, which
+// would otherwise overwrite each other
+var errDenyAndEffect = fmt.Errorf("only one of %q and %q can be set", "deny", "effect")
+
+// setEffect sets whether the grant denies from an "allow" or "deny" effect
+func (g *Grant) setEffect(effect string) error {
+	switch strings.ToLower(effect) {
+	case "allow":
+		g.deny = false
+	case "deny":
+		g.deny = true
+	default:
+		return fmt.Errorf("unknown effect %q", effect)
+	}
+	return nil
+}
+
 // Parse parses a grant string. Note that this does not do checking
 // of the validity of IDs and such; that's left for other parts of the system.
 // We may not check at all (e.g. let it be an authz-time failure) or could check
@@ -239,8 +469,11 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 		return Grant{}, errors.New("no scope ID provided")
 	}
 
+	opts := getOpts(opt...)
+
 	grant := Grant{
-		scope: Scope{Id: scopeId},
+		scope:  Scope{Id: scopeId},
+		roleId: opts.withRoleId,
 	}
 
 	switch {
@@ -266,8 +499,6 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 		}
 	}
 
-	opts := getOpts(opt...)
-
 	// Check for templated values ID, and substitute in with the authenticated values
 	// if so
 	if grant.id != "" && strings.HasPrefix(grant.id, "{{") {
@@ -297,8 +528,13 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 
 	if !opts.withSkipFinalValidation {
 		// Validate the grant. Create a dummy resource and pass it through
-		// Allowed and ensure that we get allowed.
-		acl := NewACL(grant)
+		// Allowed and ensure that we get allowed. A deny grant is checked as
+		// if it allowed, and conditions are ignored, so that it is known to
+		// match something.
+		check := grant
+		check.deny = false
+		check.cidrs, check.authMethodIds, check.timeWindow = nil, nil, nil
+		acl := NewACL(check)
 		r := Resource{
 			ScopeId: scopeId,
 			Id:      grant.id,
@@ -336,7 +572,9 @@ func (g Grant) validateType() error {
 		resource.HostSet,
 		resource.Host,
 		resource.Target,
-		resource.Session:
+		resource.Session,
+		resource.CredentialStore,
+		resource.Credential:
 		return nil
 	}
 	return fmt.Errorf("unknown type specifier %q", g.typ)
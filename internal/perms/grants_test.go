@@ -1,6 +1,7 @@
 package perms
 
 import (
+	"net"
 	"strings"
 	"testing"
 
@@ -175,6 +176,43 @@ func Test_MarshalingAndCloning(t *testing.T) {
 			jsonOutput:      `{"actions":["create","read"],"id":"baz","type":"group"}`,
 			canonicalString: `id=baz;type=group;actions=create,read`,
 		},
+		{
+			name: "deny",
+			input: Grant{
+				id: "*",
+				scope: Scope{
+					Type: scope.Project,
+				},
+				typ: resource.Target,
+				actions: map[action.Type]bool{
+					action.AuthorizeSession: true,
+				},
+				deny: true,
+			},
+			jsonOutput:      `{"actions":["authorize-session"],"deny":true,"id":"*","type":"target"}`,
+			canonicalString: `id=*;type=target;actions=authorize-session;deny=true`,
+		},
+		{
+			name: "conditions",
+			input: Grant{
+				id: "*",
+				scope: Scope{
+					Type: scope.Project,
+				},
+				typ: resource.Target,
+				actions: map[action.Type]bool{
+					action.AuthorizeSession: true,
+				},
+				authMethodIds: []string{"amoidc_1234567890", "ampw_1234567890"},
+				cidrs: []*net.IPNet{
+					{IP: net.IPv4(192, 168, 0, 0).To4(), Mask: net.CIDRMask(16, 32)},
+					{IP: net.IPv4(10, 0, 0, 0).To4(), Mask: net.CIDRMask(8, 32)},
+				},
+				timeWindow: &timeWindow{start: 9 * 60, end: 17*60 + 30},
+			},
+			jsonOutput:      `{"actions":["authorize-session"],"auth_method_ids":["amoidc_1234567890","ampw_1234567890"],"cidrs":["10.0.0.0/8","192.168.0.0/16"],"id":"*","time":"09:00-17:30","type":"target"}`,
+			canonicalString: `id=*;type=target;actions=authorize-session;auth_method_ids=amoidc_1234567890,ampw_1234567890;cidrs=10.0.0.0/8,192.168.0.0/16;time=09:00-17:30`,
+		},
 	}
 
 	for _, test := range tests {
@@ -277,6 +315,100 @@ func Test_Unmarshaling(t *testing.T) {
 			jsonInput: `{"actions":[1, true]}`,
 			jsonErr:   `unable to interpret 1 in actions array as string`,
 		},
+		{
+			name: "good deny",
+			expected: Grant{
+				deny: true,
+			},
+			jsonInput: `{"deny":true}`,
+			textInput: `deny=true`,
+		},
+		{
+			name:      "bad deny",
+			jsonInput: `{"deny":"yes"}`,
+			jsonErr:   `unable to interpret "deny" as boolean`,
+			textInput: `deny=yes`,
+			textErr:   `unable to interpret "deny" value "yes" as boolean`,
+		},
+		{
+			name: "good effect",
+			expected: Grant{
+				deny: true,
+			},
+			jsonInput: `{"effect":"deny"}`,
+			textInput: `effect=Deny`,
+		},
+		{
+			name:      "allow effect",
+			expected:  Grant{},
+			jsonInput: `{"effect":"allow"}`,
+			textInput: `effect=allow`,
+		},
+		{
+			name: "good conditions",
+			expected: Grant{
+				authMethodIds: []string{"ampw_1234567890"},
+				cidrs: []*net.IPNet{
+					{IP: net.IPv4(10, 0, 0, 0).To4(), Mask: net.CIDRMask(8, 32)},
+					{IP: net.IPv4(192, 168, 1, 10).To4(), Mask: net.CIDRMask(32, 32)},
+				},
+				timeWindow: &timeWindow{start: 22 * 60, end: 6 * 60},
+			},
+			jsonInput: `{"auth_method_ids":["AMPW_1234567890"],"cidrs":["10.0.0.0/8","192.168.1.10"],"time":"22:00-06:00"}`,
+			textInput: `auth_method_ids=AMPW_1234567890;cidrs=10.0.0.0/8,192.168.1.10;time=22:00-06:00`,
+		},
+		{
+			name:      "bad cidr",
+			jsonInput: `{"cidrs":["10.0.0.0/33"]}`,
+			jsonErr:   `unable to parse "10.0.0.0/33" as cidr`,
+			textInput: `cidrs=10.0.0.0/8,foo`,
+			textErr:   `unable to parse "foo" as cidr`,
+		},
+		{
+			name:      "bad cidrs array",
+			jsonInput: `{"cidrs":"10.0.0.0/8"}`,
+			jsonErr:   `unable to interpret "cidrs" as array`,
+		},
+		{
+			name:      "empty auth method id",
+			jsonInput: `{"auth_method_ids":[""]}`,
+			jsonErr:   `empty auth method id found`,
+			textInput: `auth_method_ids=ampw_1234567890,`,
+			textErr:   `empty auth method id found`,
+		},
+		{
+			name:      "bad time",
+			jsonInput: `{"time":"9am-5pm"}`,
+			jsonErr:   `time window "9am-5pm" not formatted as HH:MM-HH:MM`,
+			textInput: `time=25:00-05:00`,
+			textErr:   `time window "25:00-05:00" not formatted as HH:MM-HH:MM`,
+		},
+		{
+			name:      "empty time window",
+			textInput: `time=09:00-09:00`,
+			textErr:   `time window "09:00-09:00" is empty`,
+		},
+		{
+			name:      "bad effect",
+			jsonInput: `{"effect":"maybe"}`,
+			jsonErr:   `unknown effect "maybe"`,
+			textInput: `effect=maybe`,
+			textErr:   `unknown effect "maybe"`,
+		},
+		{
+			name:      "deny and effect",
+			jsonInput: `{"deny":true,"effect":"deny"}`,
+			jsonErr:   `only one of "deny" and "effect" can be set`,
+			textInput: `deny=true;effect=deny`,
+			textErr:   `only one of "deny" and "effect" can be set`,
+		},
+		{
+			name:      "conflicting effect and deny",
+			jsonInput: `{"effect":"allow","deny":true}`,
+			jsonErr:   `only one of "deny" and "effect" can be set`,
+			textInput: `effect=allow;deny=true`,
+			textErr:   `only one of "deny" and "effect" can be set`,
+		},
 	}
 
 	for _, test := range tests {
@@ -395,6 +527,41 @@ func Test_Parse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "good text deny",
+			input: `id=*;type=target;actions=authorize-session;deny=true`,
+			expected: Grant{
+				scope: Scope{
+					Id:   "o_scope",
+					Type: scope.Org,
+				},
+				id:  "*",
+				typ: resource.Target,
+				actions: map[action.Type]bool{
+					action.AuthorizeSession: true,
+				},
+				deny: true,
+			},
+		},
+		{
+			name:  "good text conditions",
+			input: `id=*;type=target;actions=authorize-session;cidrs=10.0.0.0/8;time=09:00-17:00`,
+			expected: Grant{
+				scope: Scope{
+					Id:   "o_scope",
+					Type: scope.Org,
+				},
+				id:  "*",
+				typ: resource.Target,
+				actions: map[action.Type]bool{
+					action.AuthorizeSession: true,
+				},
+				cidrs: []*net.IPNet{
+					{IP: net.IPv4(10, 0, 0, 0).To4(), Mask: net.CIDRMask(8, 32)},
+				},
+				timeWindow: &timeWindow{start: 9 * 60, end: 17 * 60},
+			},
+		},
 		{
 			name:  "good text id",
 			input: `id=foobar;actions=read`,
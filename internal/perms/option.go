@@ -1,5 +1,10 @@
 package perms
 
+import (
+	"net"
+	"time"
+)
+
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -17,6 +22,10 @@ type options struct {
 	withUserId              string
 	withAccountId           string
 	withSkipFinalValidation bool
+	withRoleId              string
+	withClientIp            net.IP
+	withAuthMethodId        string
+	withTime                time.Time
 }
 
 func getDefaultOptions() options {
@@ -45,3 +54,36 @@ func WithSkipFinalValidation(skipFinalValidation bool) Option {
 		o.withSkipFinalValidation = skipFinalValidation
 	}
 }
+
+// WithRoleId provides the ID of the role a grant string came from, which is
+// reported back with ACL results
+func WithRoleId(roleId string) Option {
+	return func(o *options) {
+		o.withRoleId = roleId
+	}
+}
+
+// WithClientIp provides the address of the client making a request, against
+// which the cidrs condition of grants is checked
+func WithClientIp(ip net.IP) Option {
+	return func(o *options) {
+		o.withClientIp = ip
+	}
+}
+
+// WithAuthMethodId provides the ID of the auth method the client making a
+// request authenticated with, against which the auth_method_ids condition of
+// grants is checked
+func WithAuthMethodId(authMethodId string) Option {
+	return func(o *options) {
+		o.withAuthMethodId = authMethodId
+	}
+}
+
+// WithTime provides the time of a request, against which the time condition of
+// grants is checked. If not provided the current time is used.
+func WithTime(t time.Time) Option {
+	return func(o *options) {
+		o.withTime = t
+	}
+}
@@ -136,6 +136,214 @@ func (x *HandshakeResult) GetConnectionsLeft() int32 {
 	return 0
 }
 
+// UpstreamHello is sent by an upstream worker to a downstream worker once
+// the tunnel between them is authenticated
+type UpstreamHello struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the upstream worker
+	Name string `protobuf:"bytes,10,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *UpstreamHello) Reset() {
+	*x = UpstreamHello{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_worker_proxy_v1_proxy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpstreamHello) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpstreamHello) ProtoMessage() {}
+
+func (x *UpstreamHello) ProtoReflect() protoreflect.Message {
+	mi := &file_worker_proxy_v1_proxy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpstreamHello.ProtoReflect.Descriptor instead.
+func (*UpstreamHello) Descriptor() ([]byte, []int) {
+	return file_worker_proxy_v1_proxy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpstreamHello) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// DownstreamDialRequest is sent by an upstream worker on a new stream of the
+// tunnel, asking the downstream worker to dial the endpoint of a session.
+// Once the dial succeeds the stream carries the proxied connection.
+type DownstreamDialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId    string `protobuf:"bytes,10,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ConnectionId string `protobuf:"bytes,20,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Endpoint     string `protobuf:"bytes,30,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The names of the workers allowed to dial the endpoint. If empty, the
+	// downstream worker dials it itself.
+	EligibleWorkers []string `protobuf:"bytes,40,rep,name=eligible_workers,json=eligibleWorkers,proto3" json:"eligible_workers,omitempty"`
+	// The number of tunnels the request has already crossed
+	Hops uint32 `protobuf:"varint,50,opt,name=hops,proto3" json:"hops,omitempty"`
+}
+
+func (x *DownstreamDialRequest) Reset() {
+	*x = DownstreamDialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_worker_proxy_v1_proxy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownstreamDialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownstreamDialRequest) ProtoMessage() {}
+
+func (x *DownstreamDialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_worker_proxy_v1_proxy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownstreamDialRequest.ProtoReflect.Descriptor instead.
+func (*DownstreamDialRequest) Descriptor() ([]byte, []int) {
+	return file_worker_proxy_v1_proxy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DownstreamDialRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DownstreamDialRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *DownstreamDialRequest) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *DownstreamDialRequest) GetEligibleWorkers() []string {
+	if x != nil {
+		return x.EligibleWorkers
+	}
+	return nil
+}
+
+func (x *DownstreamDialRequest) GetHops() uint32 {
+	if x != nil {
+		return x.Hops
+	}
+	return 0
+}
+
+type DownstreamDialResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Set if the endpoint could not be dialed
+	Error              string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	EndpointTcpAddress string `protobuf:"bytes,20,opt,name=endpoint_tcp_address,json=endpointTcpAddress,proto3" json:"endpoint_tcp_address,omitempty"`
+	EndpointTcpPort    uint32 `protobuf:"varint,30,opt,name=endpoint_tcp_port,json=endpointTcpPort,proto3" json:"endpoint_tcp_port,omitempty"`
+	// The name of the worker that dialed the endpoint
+	WorkerName string `protobuf:"bytes,40,opt,name=worker_name,json=workerName,proto3" json:"worker_name,omitempty"`
+}
+
+func (x *DownstreamDialResponse) Reset() {
+	*x = DownstreamDialResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_worker_proxy_v1_proxy_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownstreamDialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownstreamDialResponse) ProtoMessage() {}
+
+func (x *DownstreamDialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_worker_proxy_v1_proxy_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownstreamDialResponse.ProtoReflect.Descriptor instead.
+func (*DownstreamDialResponse) Descriptor() ([]byte, []int) {
+	return file_worker_proxy_v1_proxy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DownstreamDialResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DownstreamDialResponse) GetEndpointTcpAddress() string {
+	if x != nil {
+		return x.EndpointTcpAddress
+	}
+	return ""
+}
+
+func (x *DownstreamDialResponse) GetEndpointTcpPort() uint32 {
+	if x != nil {
+		return x.EndpointTcpPort
+	}
+	return 0
+}
+
+func (x *DownstreamDialResponse) GetWorkerName() string {
+	if x != nil {
+		return x.WorkerName
+	}
+	return ""
+}
+
 var File_worker_proxy_v1_proxy_proto protoreflect.FileDescriptor
 
 var file_worker_proxy_v1_proxy_proto_rawDesc = []byte{
@@ -157,11 +365,36 @@ var file_worker_proxy_v1_proxy_proto_rawDesc = []byte{
 	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x10,
 	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74,
 	0x18, 0x1e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x3b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x22, 0x23, 0x0a, 0x0d, 0x55, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb6, 0x01, 0x0a,
+	0x15, 0x44, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x69, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62,
+	0x6c, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x28, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x68, 0x6f, 0x70, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x16, 0x44, 0x6f, 0x77, 0x6e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x44, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x63,
+	0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x63, 0x70,
+	0x50, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x3b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -176,14 +409,17 @@ func file_worker_proxy_v1_proxy_proto_rawDescGZIP() []byte {
 	return file_worker_proxy_v1_proxy_proto_rawDescData
 }
 
-var file_worker_proxy_v1_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_worker_proxy_v1_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_worker_proxy_v1_proxy_proto_goTypes = []interface{}{
-	(*ClientHandshake)(nil),     // 0: worker.proxy.v1.ClientHandshake
-	(*HandshakeResult)(nil),     // 1: worker.proxy.v1.HandshakeResult
-	(*timestamp.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*ClientHandshake)(nil),        // 0: worker.proxy.v1.ClientHandshake
+	(*HandshakeResult)(nil),        // 1: worker.proxy.v1.HandshakeResult
+	(*UpstreamHello)(nil),          // 2: worker.proxy.v1.UpstreamHello
+	(*DownstreamDialRequest)(nil),  // 3: worker.proxy.v1.DownstreamDialRequest
+	(*DownstreamDialResponse)(nil), // 4: worker.proxy.v1.DownstreamDialResponse
+	(*timestamp.Timestamp)(nil),    // 5: google.protobuf.Timestamp
 }
 var file_worker_proxy_v1_proxy_proto_depIdxs = []int32{
-	2, // 0: worker.proxy.v1.HandshakeResult.expiration:type_name -> google.protobuf.Timestamp
+	5, // 0: worker.proxy.v1.HandshakeResult.expiration:type_name -> google.protobuf.Timestamp
 	1, // [1:1] is the sub-list for method output_type
 	1, // [1:1] is the sub-list for method input_type
 	1, // [1:1] is the sub-list for extension type_name
@@ -221,6 +457,42 @@ func file_worker_proxy_v1_proxy_proto_init() {
 				return nil
 			}
 		}
+		file_worker_proxy_v1_proxy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpstreamHello); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_worker_proxy_v1_proxy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownstreamDialRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_worker_proxy_v1_proxy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownstreamDialResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -228,7 +500,7 @@ func file_worker_proxy_v1_proxy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_worker_proxy_v1_proxy_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,271 @@
+package recording
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	// encryptedVersion is the version of the encrypted container format
+	encryptedVersion = 1
+
+	// fileIdSize is the size of the random identifier written at the start
+	// of each encrypted recording and bound into every chunk
+	fileIdSize = 16
+
+	// maxChunkSize bounds the size of a single encrypted chunk
+	maxChunkSize = maxFrameSize + 1024
+)
+
+// encryptedMagic identifies an encrypted recording
+var encryptedMagic = []byte("BNDRYENC")
+
+// ErrTruncated is returned by Decrypt when the recording ends without the
+// final chunk written by Writer.Close, which indicates that the recording is
+// incomplete or has been tampered with.
+var ErrTruncated = errors.New("recording is truncated")
+
+// Writer records frames into an encrypted recording. It is safe for
+// concurrent use. Each call to Record is sealed into its own chunk so that
+// everything proxied up until a failure is recoverable.
+type Writer struct {
+	l      sync.Mutex
+	w      io.Writer
+	aead   cipher.AEAD
+	fileId []byte
+	start  time.Time
+	chunk  uint64
+	err    error
+	closed bool
+}
+
+// NewWriter creates a Writer that writes an encrypted recording to w using
+// key, which must be 32 bytes long. The header is written immediately; the
+// header's StartTime is used as the reference point for frame offsets and
+// is set to the current time if zero.
+func NewWriter(w io.Writer, key []byte, h *Header) (*Writer, error) {
+	if w == nil {
+		return nil, errors.New("nil writer")
+	}
+	if h == nil {
+		return nil, errors.New("nil header")
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if h.StartTime.IsZero() {
+		h.StartTime = time.Now()
+	}
+	h.Version = Version
+
+	rw := &Writer{
+		w:      w,
+		aead:   aead,
+		fileId: make([]byte, fileIdSize),
+		start:  h.StartTime,
+	}
+	if _, err := io.ReadFull(rand.Reader, rw.fileId); err != nil {
+		return nil, fmt.Errorf("error generating recording id: %w", err)
+	}
+	prefix := make([]byte, 0, len(encryptedMagic)+1+fileIdSize)
+	prefix = append(prefix, encryptedMagic...)
+	prefix = append(prefix, encryptedVersion)
+	prefix = append(prefix, rw.fileId...)
+	if _, err := w.Write(prefix); err != nil {
+		return nil, fmt.Errorf("error writing recording prefix: %w", err)
+	}
+
+	hw := &chunkBuffer{}
+	if err := writeHeader(hw, h); err != nil {
+		return nil, err
+	}
+	if err := rw.seal(hw.buf, false); err != nil {
+		return nil, err
+	}
+	return rw, nil
+}
+
+// Record appends data flowing in the given direction to the recording. Once
+// an error has been returned, all subsequent calls will return the same
+// error.
+func (w *Writer) Record(dir Direction, data []byte) error {
+	w.l.Lock()
+	defer w.l.Unlock()
+	if w.closed {
+		return errors.New("recording writer is closed")
+	}
+	if w.err != nil {
+		return w.err
+	}
+	offset := time.Since(w.start)
+	for len(data) > 0 {
+		n := len(data)
+		if n > maxFrameSize {
+			n = maxFrameSize
+		}
+		if err := w.seal(appendFrame(nil, dir, offset, data[:n]), false); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return nil
+}
+
+// Tee returns an io.Writer that records everything written to it as
+// flowing in the given direction. It is intended to be combined with the
+// real destination using io.MultiWriter; placing it first means that data is
+// never forwarded without having been recorded.
+func (w *Writer) Tee(dir Direction) io.Writer {
+	return teeWriter{w: w, dir: dir}
+}
+
+// Close writes the final chunk that marks the recording as complete. It does
+// not close the underlying writer.
+func (w *Writer) Close() error {
+	w.l.Lock()
+	defer w.l.Unlock()
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if w.err != nil {
+		return w.err
+	}
+	return w.seal(nil, true)
+}
+
+// seal encrypts plaintext as the next chunk and writes it out. It must be
+// called with the lock held (or before the writer is shared).
+func (w *Writer) seal(plaintext []byte, final bool) error {
+	nonce := make([]byte, w.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		w.err = fmt.Errorf("error generating nonce: %w", err)
+		return w.err
+	}
+	out := make([]byte, 4, 4+len(nonce)+len(plaintext)+w.aead.Overhead())
+	out = append(out, nonce...)
+	out = w.aead.Seal(out, nonce, plaintext, chunkAAD(w.fileId, w.chunk, final))
+	binary.BigEndian.PutUint32(out[:4], uint32(len(out)-4))
+	if _, err := w.w.Write(out); err != nil {
+		w.err = fmt.Errorf("error writing recording chunk: %w", err)
+		return w.err
+	}
+	w.chunk++
+	return nil
+}
+
+type teeWriter struct {
+	w   *Writer
+	dir Direction
+}
+
+func (t teeWriter) Write(p []byte) (int, error) {
+	if err := t.w.Record(t.dir, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// chunkBuffer is a minimal io.Writer used to collect the header before it is
+// sealed
+type chunkBuffer struct {
+	buf []byte
+}
+
+func (c *chunkBuffer) Write(p []byte) (int, error) {
+	c.buf = append(c.buf, p...)
+	return len(p), nil
+}
+
+// Decrypt reads an encrypted recording from src, verifies its integrity and
+// writes the decrypted stream to dst. The output can be read with NewReader.
+// ErrTruncated is returned if the recording was not closed properly; all
+// data prior to the truncation point will already have been written to dst.
+func Decrypt(dst io.Writer, src io.Reader, key []byte) error {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+	prefix := make([]byte, len(encryptedMagic)+1+fileIdSize)
+	if _, err := io.ReadFull(src, prefix); err != nil {
+		return fmt.Errorf("error reading recording prefix: %w", err)
+	}
+	if string(prefix[:len(encryptedMagic)]) != string(encryptedMagic) {
+		return errors.New("input is not an encrypted session recording")
+	}
+	if v := prefix[len(encryptedMagic)]; v != encryptedVersion {
+		return fmt.Errorf("unsupported encrypted recording version %d", v)
+	}
+	fileId := prefix[len(encryptedMagic)+1:]
+
+	for chunk := uint64(0); ; chunk++ {
+		var lenBuf [4]byte
+		if _, err := io.ReadFull(src, lenBuf[:]); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return ErrTruncated
+			}
+			return fmt.Errorf("error reading chunk length: %w", err)
+		}
+		size := binary.BigEndian.Uint32(lenBuf[:])
+		if size > maxChunkSize || int(size) < aead.NonceSize()+aead.Overhead() {
+			return fmt.Errorf("invalid chunk size %d", size)
+		}
+		raw := make([]byte, size)
+		if _, err := io.ReadFull(src, raw); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return ErrTruncated
+			}
+			return fmt.Errorf("error reading chunk: %w", err)
+		}
+		nonce, ciphertext := raw[:aead.NonceSize()], raw[aead.NonceSize():]
+
+		// A chunk authenticates as final only if it was written by Close, so
+		// try that first for empty chunks and otherwise treat it as data
+		if len(ciphertext) == aead.Overhead() {
+			if _, err := aead.Open(nil, nonce, ciphertext, chunkAAD(fileId, chunk, true)); err == nil {
+				return nil
+			}
+		}
+		plaintext, err := aead.Open(nil, nonce, ciphertext, chunkAAD(fileId, chunk, false))
+		if err != nil {
+			return fmt.Errorf("error decrypting chunk %d: %w", chunk, err)
+		}
+		if _, err := dst.Write(plaintext); err != nil {
+			return fmt.Errorf("error writing decrypted data: %w", err)
+		}
+	}
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("invalid recording key length %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkAAD binds a chunk to its recording, its position and whether it is
+// the last chunk, preventing chunks from being reordered, dropped or moved
+// between recordings
+func chunkAAD(fileId []byte, chunk uint64, final bool) []byte {
+	aad := make([]byte, 0, len(fileId)+9)
+	aad = append(aad, fileId...)
+	var idx [8]byte
+	binary.BigEndian.PutUint64(idx[:], chunk)
+	aad = append(aad, idx[:]...)
+	if final {
+		return append(aad, 1)
+	}
+	return append(aad, 0)
+}
@@ -0,0 +1,185 @@
+// Package recording implements the on-disk format used by workers to record
+// the bytes proxied over a session connection, and to read those recordings
+// back for replay.
+//
+// A recording consists of a header describing the connection followed by a
+// sequence of frames. Each frame carries the direction of the data, the time
+// since the start of the recording, and the data itself. Recordings are
+// always stored encrypted; see NewWriter and Decrypt.
+package recording
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	// Version is the current version of the recording format
+	Version = 1
+
+	// maxFrameSize bounds the size of a single frame so that a corrupt
+	// recording can't cause us to allocate arbitrary amounts of memory
+	maxFrameSize = 1 << 24
+
+	// maxHeaderSize bounds the size of the serialized header for the same
+	// reason
+	maxHeaderSize = 1 << 16
+)
+
+// plaintextMagic identifies a decrypted recording stream
+var plaintextMagic = []byte("BNDRYREC")
+
+// Direction indicates which way the data in a frame was flowing
+type Direction uint8
+
+const (
+	// DirectionUnknown is not valid in a recording
+	DirectionUnknown Direction = 0
+	// DirectionUp is data sent from the client to the endpoint
+	DirectionUp Direction = 1
+	// DirectionDown is data sent from the endpoint to the client
+	DirectionDown Direction = 2
+)
+
+func (d Direction) String() string {
+	switch d {
+	case DirectionUp:
+		return "up"
+	case DirectionDown:
+		return "down"
+	default:
+		return "unknown"
+	}
+}
+
+// Header contains information about the recorded connection. It is written
+// once at the start of a recording.
+type Header struct {
+	Version      int       `json:"version"`
+	SessionId    string    `json:"session_id"`
+	ConnectionId string    `json:"connection_id"`
+	TargetId     string    `json:"target_id,omitempty"`
+	UserId       string    `json:"user_id,omitempty"`
+	Endpoint     string    `json:"endpoint,omitempty"`
+	StartTime    time.Time `json:"start_time"`
+}
+
+// Frame is a single chunk of data recorded from a connection
+type Frame struct {
+	Direction Direction
+	// Offset is the time elapsed between the start of the recording and the
+	// moment the data was proxied
+	Offset time.Duration
+	Data   []byte
+}
+
+// writeHeader writes the magic and the length-prefixed header to w
+func writeHeader(w io.Writer, h *Header) error {
+	raw, err := json.Marshal(h)
+	if err != nil {
+		return fmt.Errorf("error marshaling recording header: %w", err)
+	}
+	if len(raw) > maxHeaderSize {
+		return errors.New("recording header too large")
+	}
+	buf := make([]byte, 0, len(plaintextMagic)+4+len(raw))
+	buf = append(buf, plaintextMagic...)
+	buf = appendUint32(buf, uint32(len(raw)))
+	buf = append(buf, raw...)
+	_, err = w.Write(buf)
+	return err
+}
+
+// appendFrame appends the encoding of a frame to buf
+func appendFrame(buf []byte, dir Direction, offset time.Duration, data []byte) []byte {
+	buf = append(buf, byte(dir))
+	var off [8]byte
+	binary.BigEndian.PutUint64(off[:], uint64(offset))
+	buf = append(buf, off[:]...)
+	buf = appendUint32(buf, uint32(len(data)))
+	return append(buf, data...)
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return append(buf, b[:]...)
+}
+
+// Reader reads frames from a decrypted recording
+type Reader struct {
+	r      *bufio.Reader
+	header *Header
+}
+
+// NewReader reads and validates the recording header from r and returns a
+// Reader positioned at the first frame. r must provide a decrypted recording,
+// such as the output of Decrypt.
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(plaintextMagic))
+	if _, err := io.ReadFull(br, magic); err != nil {
+		return nil, fmt.Errorf("error reading recording magic: %w", err)
+	}
+	if string(magic) != string(plaintextMagic) {
+		return nil, errors.New("input is not a decrypted session recording")
+	}
+	var lenBuf [4]byte
+	if _, err := io.ReadFull(br, lenBuf[:]); err != nil {
+		return nil, fmt.Errorf("error reading recording header length: %w", err)
+	}
+	hdrLen := binary.BigEndian.Uint32(lenBuf[:])
+	if hdrLen > maxHeaderSize {
+		return nil, errors.New("recording header too large")
+	}
+	raw := make([]byte, hdrLen)
+	if _, err := io.ReadFull(br, raw); err != nil {
+		return nil, fmt.Errorf("error reading recording header: %w", err)
+	}
+	h := new(Header)
+	if err := json.Unmarshal(raw, h); err != nil {
+		return nil, fmt.Errorf("error unmarshaling recording header: %w", err)
+	}
+	if h.Version != Version {
+		return nil, fmt.Errorf("unsupported recording version %d", h.Version)
+	}
+	return &Reader{r: br, header: h}, nil
+}
+
+// Header returns the header of the recording
+func (r *Reader) Header() *Header {
+	return r.header
+}
+
+// Next returns the next frame in the recording. It returns io.EOF once all
+// frames have been read.
+func (r *Reader) Next() (*Frame, error) {
+	var prefix [13]byte
+	if _, err := io.ReadFull(r.r, prefix[:]); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("error reading frame: %w", err)
+	}
+	f := &Frame{
+		Direction: Direction(prefix[0]),
+		Offset:    time.Duration(binary.BigEndian.Uint64(prefix[1:9])),
+	}
+	if f.Direction != DirectionUp && f.Direction != DirectionDown {
+		return nil, fmt.Errorf("invalid frame direction %d", prefix[0])
+	}
+	size := binary.BigEndian.Uint32(prefix[9:13])
+	if size > maxFrameSize {
+		return nil, errors.New("frame too large")
+	}
+	f.Data = make([]byte, size)
+	if _, err := io.ReadFull(r.r, f.Data); err != nil {
+		return nil, fmt.Errorf("error reading frame data: %w", err)
+	}
+	return f, nil
+}
@@ -0,0 +1,122 @@
+package recording
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testKey(t *testing.T) []byte {
+	t.Helper()
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func TestRecording_RoundTrip(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	key := testKey(t)
+
+	var enc bytes.Buffer
+	w, err := NewWriter(&enc, key, &Header{
+		SessionId:    "s_1234567890",
+		ConnectionId: "sc_1234567890",
+		Endpoint:     "tcp://127.0.0.1:22",
+	})
+	require.NoError(err)
+
+	_, err = io.MultiWriter(w.Tee(DirectionUp), ioutil.Discard).Write([]byte("hello"))
+	require.NoError(err)
+	require.NoError(w.Record(DirectionDown, []byte("world")))
+	require.NoError(w.Close())
+
+	assert.NotContains(enc.String(), "hello")
+	assert.NotContains(enc.String(), "s_1234567890")
+
+	var dec bytes.Buffer
+	require.NoError(Decrypt(&dec, bytes.NewReader(enc.Bytes()), key))
+
+	r, err := NewReader(&dec)
+	require.NoError(err)
+	assert.Equal(Version, r.Header().Version)
+	assert.Equal("s_1234567890", r.Header().SessionId)
+	assert.Equal("sc_1234567890", r.Header().ConnectionId)
+	assert.False(r.Header().StartTime.IsZero())
+
+	f, err := r.Next()
+	require.NoError(err)
+	assert.Equal(DirectionUp, f.Direction)
+	assert.Equal("hello", string(f.Data))
+
+	f2, err := r.Next()
+	require.NoError(err)
+	assert.Equal(DirectionDown, f2.Direction)
+	assert.Equal("world", string(f2.Data))
+	assert.True(f2.Offset >= f.Offset)
+
+	_, err = r.Next()
+	assert.Equal(io.EOF, err)
+}
+
+func TestDecrypt_Errors(t *testing.T) {
+	key := testKey(t)
+	var enc bytes.Buffer
+	w, err := NewWriter(&enc, key, &Header{SessionId: "s_1234567890"})
+	require.NoError(t, err)
+	require.NoError(t, w.Record(DirectionDown, []byte("some data")))
+	unclosed := append([]byte(nil), enc.Bytes()...)
+	require.NoError(t, w.Close())
+	closed := enc.Bytes()
+
+	tampered := append([]byte(nil), closed...)
+	tampered[len(tampered)-20] ^= 0xff
+
+	tests := []struct {
+		name    string
+		input   []byte
+		key     []byte
+		wantErr error
+	}{
+		{
+			name:    "truncated",
+			input:   unclosed,
+			key:     key,
+			wantErr: ErrTruncated,
+		},
+		{
+			name:  "wrong-key",
+			input: closed,
+			key:   testKey(t),
+		},
+		{
+			name:  "tampered",
+			input: tampered,
+			key:   key,
+		},
+		{
+			name:  "bad-key-length",
+			input: closed,
+			key:   key[:16],
+		},
+		{
+			name:  "not-a-recording",
+			input: []byte("definitely not a recording"),
+			key:   key,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Decrypt(ioutil.Discard, bytes.NewReader(tt.input), tt.key)
+			require.Error(t, err)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/hashicorp/boundary/internal/audit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// wrapHandlerWithAudit emits an audit event recording the result of the API
+// requests served by h which change resources. They are refused if their
+// event can't be written and the auditor fails closed. It must be wrapped by
+// wrapHandlerWithMetrics for the method of a request to be known.
+func wrapHandlerWithAudit(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+			h.ServeHTTP(w, r)
+			return
+		}
+		if err := audit.CheckAvailable(r.Context()); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		h.ServeHTTP(rec, r)
+
+		rpcMethod, _ := r.Context().Value(rpcMethodKey{}).(*string)
+		if rpcMethod == nil || *rpcMethod == "" {
+			// Not routed to a method, so nothing was changed
+			return
+		}
+		_, method := splitRpcMethod(*rpcMethod)
+		event := &audit.Event{
+			Type:       audit.MutationEvent,
+			Action:     method,
+			Result:     &audit.Result{Status: audit.StatusSuccess, Code: rec.status},
+			Attributes: map[string]string{"method": r.Method, "path": r.URL.Path},
+		}
+		if rec.status >= http.StatusBadRequest {
+			event.Result.Status = audit.StatusFailure
+		}
+		if req := audit.RequestFromContext(r.Context()); req != nil {
+			event.Resource = req.Resource
+		}
+		audit.Emit(r.Context(), event)
+	})
+}
+
+// auditInterceptor adds the auditor of the controller to the context of the
+// gRPC requests of workers, for the events of the sessions they activate and
+// terminate.
+func (c *Controller) auditInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if c.auditor != nil {
+		ctx = audit.NewContext(ctx, c.auditor)
+	}
+	return handler(ctx, req)
+}
+
+// auditStreamInterceptor adds the auditor of the controller to the context of
+// the streaming gRPC requests of workers, as auditInterceptor does for unary
+// ones
+func (c *Controller) auditStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if c.auditor != nil {
+		ss = &contextServerStream{ServerStream: ss, ctx: audit.NewContext(ss.Context(), c.auditor)}
+	}
+	return handler(srv, ss)
+}
+
+// grpcApiAuditInterceptor emits an audit event recording the result of the
+// requests made over the gRPC API listeners which change resources, the same
+// as wrapHandlerWithAudit does for HTTP requests.
+func grpcApiAuditInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !isGrpcApiMutation(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	if err := audit.CheckAvailable(ctx); err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	resp, err := handler(ctx, req)
+	emitGrpcApiMutation(ctx, info.FullMethod, err)
+	return resp, err
+}
+
+// grpcApiStreamAuditInterceptor emits an audit event recording the result of
+// the streaming requests made over the gRPC API listeners which change
+// resources, once the stream ends
+func grpcApiStreamAuditInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !isGrpcApiMutation(info.FullMethod) {
+		return handler(srv, ss)
+	}
+	if err := audit.CheckAvailable(ss.Context()); err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	err := handler(srv, ss)
+	emitGrpcApiMutation(ss.Context(), info.FullMethod, err)
+	return err
+}
+
+// isGrpcApiMutation reports whether the API method fullMethod may change
+// resources. Get and List methods are the ones served by GET requests over
+// HTTP, and Watch methods only stream changes, so they don't.
+func isGrpcApiMutation(fullMethod string) bool {
+	_, method := splitRpcMethod(fullMethod)
+	return !strings.HasPrefix(method, "Get") && !strings.HasPrefix(method, "List") && !strings.HasPrefix(method, "Watch")
+}
+
+// emitGrpcApiMutation emits the mutation event of a request to the API method
+// fullMethod which ended with err
+func emitGrpcApiMutation(ctx context.Context, fullMethod string, err error) {
+	_, method := splitRpcMethod(fullMethod)
+	code := runtime.HTTPStatusFromCode(status.Code(err))
+	event := &audit.Event{
+		Type:       audit.MutationEvent,
+		Action:     method,
+		Result:     &audit.Result{Status: audit.StatusSuccess, Code: code},
+		Attributes: map[string]string{"grpc_method": fullMethod},
+	}
+	if code >= http.StatusBadRequest {
+		event.Result.Status = audit.StatusFailure
+	}
+	if r := audit.RequestFromContext(ctx); r != nil {
+		event.Resource = r.Resource
+	}
+	audit.Emit(ctx, event)
+}
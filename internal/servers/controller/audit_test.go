@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/audit"
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsGrpcApiMutation(t *testing.T) {
+	for method, want := range map[string]bool{
+		"/controller.api.services.v1.TargetService/CreateTarget":     true,
+		"/controller.api.services.v1.TargetService/AuthorizeSession": true,
+		"/controller.api.services.v1.TargetService/GetTarget":        false,
+		"/controller.api.services.v1.TargetService/ListTargets":      false,
+		"/controller.api.services.v1.EventService/WatchEvents":       false,
+	} {
+		assert.Equal(t, want, isGrpcApiMutation(method), method)
+	}
+}
+
+// testServerStream is a server stream with only a context
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestGrpcApiStreamAuditInterceptor(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	dir, err := ioutil.TempDir("", "boundary-audit-test")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+	auditor, err := audit.New(hclog.NewNullLogger(), nil, &config.Audit{
+		Sinks: []*config.AuditSink{{Type: "file", Path: path}},
+	})
+	require.NoError(err)
+
+	ss := &testServerStream{ctx: audit.NewContext(context.Background(), auditor)}
+	handler := func(err error) grpc.StreamHandler {
+		return func(interface{}, grpc.ServerStream) error { return err }
+	}
+	require.NoError(grpcApiStreamAuditInterceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: "/controller.api.services.v1.EventService/WatchEvents"}, handler(nil)))
+	require.NoError(grpcApiStreamAuditInterceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: "/controller.api.services.v1.TestService/UploadThing"}, handler(nil)))
+	denied := status.Error(codes.PermissionDenied, "denied")
+	assert.Equal(denied, grpcApiStreamAuditInterceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: "/controller.api.services.v1.TestService/DeleteThing"}, handler(denied)))
+	require.NoError(auditor.Close())
+
+	b, err := ioutil.ReadFile(path)
+	require.NoError(err)
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	require.Len(lines, 2)
+	var events []*audit.Event
+	for _, l := range lines {
+		e := new(audit.Event)
+		require.NoError(json.Unmarshal([]byte(l), e))
+		events = append(events, e)
+	}
+	assert.Equal(audit.MutationEvent, events[0].Type)
+	assert.Equal("UploadThing", events[0].Action)
+	assert.Equal(audit.StatusSuccess, events[0].Result.Status)
+	assert.Equal("DeleteThing", events[1].Action)
+	assert.Equal(audit.StatusFailure, events[1].Result.Status)
+	assert.Equal(403, events[1].Result.Code)
+}
@@ -1,21 +1,33 @@
 package common
 
 import (
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dynamic"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/watch"
 )
 
 type (
 	AuthTokenRepoFactory    func() (*authtoken.Repository, error)
+	CredentialRepoFactory   func() (*credential.Repository, error)
+	DynamicRepoFactory      func() (*dynamic.Repository, error)
+	HostPluginFactory       = host.PluginFactory
 	IamRepoFactory          func() (*iam.Repository, error)
+	LdapAuthRepoFactory     func() (*ldap.Repository, error)
+	OidcAuthRepoFactory     func() (*oidc.Repository, error)
 	PasswordAuthRepoFactory func() (*password.Repository, error)
 	ServersRepoFactory      func() (*servers.Repository, error)
 	StaticRepoFactory       func() (*static.Repository, error)
 	SessionRepoFactory      func() (*session.Repository, error)
 	TargetRepoFactory       func() (*target.Repository, error)
+	WatchRepoFactory        func() (*watch.Repository, error)
 )
@@ -0,0 +1,133 @@
+// Package scopeids resolves the scopes covered by list requests: the scope of
+// the request or, for recursive lists, that scope and the scopes below it in
+// which the caller is allowed to list.
+package scopeids
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+)
+
+// scopeTypes are the types of the scopes items of each resource type can be
+// in. Items of the resource types not listed can be in scopes of any type.
+var scopeTypes = map[resource.Type][]scope.Type{
+	resource.AuthMethod:      {scope.Global, scope.Org},
+	resource.AuthToken:       {scope.Global, scope.Org},
+	resource.CredentialStore: {scope.Project},
+	resource.HostCatalog:     {scope.Project},
+	resource.Scope:           {scope.Global, scope.Org},
+	resource.Session:         {scope.Project},
+	resource.Target:          {scope.Project},
+	resource.User:            {scope.Global, scope.Org},
+}
+
+// GetListingScopeIds returns the IDs of the scopes a list of typ items
+// requested in rootScopeId covers, and the info of each of them to set on
+// their items. authResults are the results of the list check in rootScopeId.
+//
+// A list that is not recursive covers rootScopeId. A recursive list covers
+// rootScopeId if the list check passed, and the orgs and projects below it in
+// which authResults allows listing typ items. The error of authResults is
+// returned, unless the list is recursive and the caller is authenticated but
+// forbidden from listing in rootScopeId, as they may be allowed to list in the
+// scopes below it.
+func GetListingScopeIds(ctx context.Context, iamRepoFn common.IamRepoFactory, authResults *auth.VerifyResults, rootScopeId string, typ resource.Type, recursive bool) ([]string, map[string]*scopes.ScopeInfo, error) {
+	if authResults.Error != nil && (!recursive || !authResults.Authenticated || !errors.Is(authResults.Error, handlers.ForbiddenError())) {
+		return nil, nil, authResults.Error
+	}
+	if !recursive {
+		return []string{authResults.Scope.GetId()}, map[string]*scopes.ScopeInfo{authResults.Scope.GetId(): authResults.Scope}, nil
+	}
+
+	var scopeIds []string
+	scopeInfos := make(map[string]*scopes.ScopeInfo)
+	if authResults.Error == nil && canContain(typ, scopeType(rootScopeId)) {
+		scopeIds = append(scopeIds, authResults.Scope.GetId())
+		scopeInfos[authResults.Scope.GetId()] = authResults.Scope
+	}
+
+	repo, err := iamRepoFn()
+	if err != nil {
+		return nil, nil, err
+	}
+	var children []*iam.Scope
+	var orgIds []string
+	switch scopeType(rootScopeId) {
+	case scope.Global:
+		orgs, err := repo.ListOrgs(ctx, iam.WithLimit(-1))
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to list orgs: %w", err)
+		}
+		children = append(children, orgs...)
+		for _, org := range orgs {
+			orgIds = append(orgIds, org.GetPublicId())
+		}
+	case scope.Org:
+		orgIds = append(orgIds, rootScopeId)
+	}
+	if canContain(typ, scope.Project) {
+		for _, orgId := range orgIds {
+			projects, err := repo.ListProjects(ctx, orgId, iam.WithLimit(-1))
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to list projects of %s: %w", orgId, err)
+			}
+			children = append(children, projects...)
+		}
+	}
+
+	for _, scp := range children {
+		if !canContain(typ, scope.Map[scp.GetType()]) || !authResults.ScopeAllowed(ctx, scp.GetPublicId(), typ, action.List) {
+			continue
+		}
+		scopeIds = append(scopeIds, scp.GetPublicId())
+		scopeInfos[scp.GetPublicId()] = &scopes.ScopeInfo{
+			Id:            scp.GetPublicId(),
+			Type:          scp.GetType(),
+			Name:          scp.GetName(),
+			Description:   scp.GetDescription(),
+			ParentScopeId: scp.GetParentId(),
+		}
+	}
+	if len(scopeIds) == 0 && authResults.Error != nil {
+		return nil, nil, authResults.Error
+	}
+	return scopeIds, scopeInfos, nil
+}
+
+// canContain reports whether items of typ can be in scopes of type st
+func canContain(typ resource.Type, st scope.Type) bool {
+	types, ok := scopeTypes[typ]
+	if !ok {
+		return true
+	}
+	for _, t := range types {
+		if t == st {
+			return true
+		}
+	}
+	return false
+}
+
+// scopeType returns the type of the scope with the given ID
+func scopeType(scopeId string) scope.Type {
+	switch {
+	case scopeId == scope.Global.String():
+		return scope.Global
+	case strings.HasPrefix(scopeId, scope.Org.Prefix()+"_"):
+		return scope.Org
+	case strings.HasPrefix(scopeId, scope.Project.Prefix()+"_"):
+		return scope.Project
+	}
+	return scope.Unknown
+}
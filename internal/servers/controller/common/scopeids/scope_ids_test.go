@@ -0,0 +1,95 @@
+package scopeids_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetListingScopeIds(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return repo, nil
+	}
+
+	org, proj1 := iam.TestScopes(t, repo)
+	proj2 := iam.TestProject(t, repo, org.GetPublicId())
+
+	verify := func(t *testing.T, scopeId string, typ resource.Type) auth.VerifyResults {
+		t.Helper()
+		ctx := auth.DisabledAuthTestContext(auth.WithScopeId(scopeId))
+		res := auth.Verify(ctx, auth.WithScopeId(scopeId), auth.WithType(typ), auth.WithAction(action.List))
+		require.NoError(t, res.Error)
+		return res
+	}
+
+	tests := []struct {
+		name      string
+		scopeId   string
+		typ       resource.Type
+		recursive bool
+		want      []string
+	}{
+		{"not recursive", org.GetPublicId(), resource.Role, false, []string{org.GetPublicId()}},
+		{"recursive", org.GetPublicId(), resource.Role, true, []string{org.GetPublicId(), proj1.GetPublicId(), proj2.GetPublicId()}},
+		{"recursive project only type", org.GetPublicId(), resource.Target, true, []string{proj1.GetPublicId(), proj2.GetPublicId()}},
+		{"recursive from project", proj1.GetPublicId(), resource.Role, true, []string{proj1.GetPublicId()}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			res := verify(t, tt.scopeId, tt.typ)
+			got, infos, err := scopeids.GetListingScopeIds(context.Background(), iamRepoFn, &res, tt.scopeId, tt.typ, tt.recursive)
+			require.NoError(err)
+			assert.ElementsMatch(tt.want, got)
+			for _, id := range got {
+				require.NotNil(infos[id])
+				assert.Equal(id, infos[id].GetId())
+			}
+			if tt.recursive && tt.scopeId == org.GetPublicId() {
+				assert.Equal(org.GetPublicId(), infos[proj2.GetPublicId()].GetParentScopeId())
+				assert.Equal(scope.Project.String(), infos[proj2.GetPublicId()].GetType())
+			}
+		})
+	}
+
+	t.Run("recursive from global", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		res := verify(t, scope.Global.String(), resource.User)
+		got, _, err := scopeids.GetListingScopeIds(context.Background(), iamRepoFn, &res, scope.Global.String(), resource.User, true)
+		require.NoError(err)
+		assert.Contains(got, scope.Global.String())
+		assert.Contains(got, org.GetPublicId())
+		assert.NotContains(got, proj1.GetPublicId())
+	})
+
+	t.Run("forbidden in root", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		res := verify(t, org.GetPublicId(), resource.Role)
+		res.Error = handlers.ForbiddenError()
+
+		// Only an authenticated caller of a recursive list may go on
+		_, _, err := scopeids.GetListingScopeIds(context.Background(), iamRepoFn, &res, org.GetPublicId(), resource.Role, false)
+		assert.True(errors.Is(err, handlers.ForbiddenError()))
+		_, _, err = scopeids.GetListingScopeIds(context.Background(), iamRepoFn, &res, org.GetPublicId(), resource.Role, true)
+		assert.True(errors.Is(err, handlers.ForbiddenError()))
+
+		res.Authenticated = true
+		got, _, err := scopeids.GetListingScopeIds(context.Background(), iamRepoFn, &res, org.GetPublicId(), resource.Role, true)
+		require.NoError(err)
+		assert.ElementsMatch([]string{proj1.GetPublicId(), proj2.GetPublicId()}, got)
+	})
+}
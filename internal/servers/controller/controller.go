@@ -6,10 +6,16 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/hashicorp/boundary/internal/audit"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dynamic"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -17,6 +23,7 @@ import (
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/watch"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/vault/sdk/helper/base62"
 	"github.com/hashicorp/vault/sdk/helper/mlock"
@@ -39,15 +46,31 @@ type Controller struct {
 
 	// Repo factory methods
 	AuthTokenRepoFn    common.AuthTokenRepoFactory
+	CredentialRepoFn   common.CredentialRepoFactory
+	DynamicHostRepoFn  common.DynamicRepoFactory
 	IamRepoFn          common.IamRepoFactory
+	LdapAuthRepoFn     common.LdapAuthRepoFactory
+	OidcAuthRepoFn     common.OidcAuthRepoFactory
 	PasswordAuthRepoFn common.PasswordAuthRepoFactory
 	ServersRepoFn      common.ServersRepoFactory
 	SessionRepoFn      common.SessionRepoFactory
 	StaticHostRepoFn   common.StaticRepoFactory
 	TargetRepoFn       common.TargetRepoFactory
+	WatchRepoFn        common.WatchRepoFactory
+
+	// HostPluginFn returns the host catalog plugin for a host subtype
+	HostPluginFn common.HostPluginFactory
+
+	// watchPoller reads the changes sent to the event watches served by the
+	// controller
+	watchPoller *watch.Poller
 
 	kms *kms.Kms
 
+	// auditor writes the audit events of the controller; it is nil unless
+	// audit events are configured
+	auditor *audit.Auditor
+
 	clusterAddress string
 }
 
@@ -113,21 +136,49 @@ func New(conf *Config) (*Controller, error) {
 	c.StaticHostRepoFn = func() (*static.Repository, error) {
 		return static.NewRepository(dbase, dbase, c.kms)
 	}
+	var allowedSources dynamic.AllowedSources
+	if dhc := c.conf.RawConfig.Controller.DynamicHostCatalogs; dhc != nil {
+		allowedSources = dynamic.AllowedSources{
+			Directories: dhc.AllowedDirectories,
+			Hosts:       dhc.AllowedHosts,
+		}
+	}
+	c.DynamicHostRepoFn = func() (*dynamic.Repository, error) {
+		return dynamic.NewRepository(dbase, dbase, c.kms, dynamic.WithAllowedSources(allowedSources))
+	}
 	c.AuthTokenRepoFn = func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(dbase, dbase, c.kms)
 	}
 	c.ServersRepoFn = func() (*servers.Repository, error) {
-		return servers.NewRepository(dbase, dbase, c.kms)
+		return servers.NewRepository(dbase, dbase, c.kms, servers.WithWorkerLiveness(c.statusGracePeriod()))
 	}
 	c.PasswordAuthRepoFn = func() (*password.Repository, error) {
 		return password.NewRepository(dbase, dbase, c.kms)
 	}
+	c.OidcAuthRepoFn = func() (*oidc.Repository, error) {
+		return oidc.NewRepository(dbase, dbase, c.kms)
+	}
+	c.LdapAuthRepoFn = func() (*ldap.Repository, error) {
+		return ldap.NewRepository(dbase, dbase, c.kms)
+	}
+	c.CredentialRepoFn = func() (*credential.Repository, error) {
+		return credential.NewRepository(dbase, dbase, c.kms)
+	}
 	c.TargetRepoFn = func() (*target.Repository, error) {
 		return target.NewRepository(dbase, dbase, c.kms)
 	}
 	c.SessionRepoFn = func() (*session.Repository, error) {
 		return session.NewRepository(dbase, dbase, c.kms)
 	}
+	c.WatchRepoFn = func() (*watch.Repository, error) {
+		return watch.NewRepository(dbase)
+	}
+	if c.watchPoller, err = watch.NewPoller(c.WatchRepoFn); err != nil {
+		return nil, fmt.Errorf("error creating event watch poller: %w", err)
+	}
+	if c.HostPluginFn, err = host.NewPluginFactory(c.StaticHostRepoFn, c.DynamicHostRepoFn); err != nil {
+		return nil, fmt.Errorf("error creating host plugin factory: %w", err)
+	}
 
 	c.workerAuthCache = cache.New(0, 0)
 
@@ -141,6 +192,13 @@ func (c *Controller) Start() error {
 	}
 	c.baseContext, c.baseCancel = context.WithCancel(context.Background())
 
+	if auditConf := c.conf.RawConfig.Controller.Audit; auditConf != nil {
+		var err error
+		if c.auditor, err = audit.New(c.logger.Named("audit"), c.kms, auditConf); err != nil {
+			return fmt.Errorf("error creating auditor: %w", err)
+		}
+	}
+
 	if err := c.startListeners(); err != nil {
 		return fmt.Errorf("error starting controller listeners: %w", err)
 	}
@@ -148,6 +206,9 @@ func (c *Controller) Start() error {
 	c.startStatusTicking(c.baseContext)
 	c.startRecoveryNonceCleanupTicking(c.baseContext)
 	c.startTerminateCompletedSessionsTicking(c.baseContext)
+	c.startCloseDeadWorkerConnectionsTicking(c.baseContext)
+	c.startDynamicHostRefreshTicking(c.baseContext)
+	c.watchPoller.Start(c.baseContext)
 	c.started.Store(true)
 
 	return nil
@@ -162,6 +223,10 @@ func (c *Controller) Shutdown(serversOnly bool) error {
 	if err := c.stopListeners(serversOnly); err != nil {
 		return fmt.Errorf("error stopping controller listeners: %w", err)
 	}
+	if err := c.auditor.Close(); err != nil {
+		return fmt.Errorf("error closing auditor: %w", err)
+	}
+	c.auditor = nil
 	c.clusterAddress = ""
 	c.started.Store(false)
 	return nil
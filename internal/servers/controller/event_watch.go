@@ -0,0 +1,220 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// eventWatchPath is the path of the endpoint serving watches of events
+	// over HTTP
+	eventWatchPath = "/v1/events:watch"
+
+	// eventWatchRpcMethod is the method of the event service watches of
+	// events served over HTTP are made with
+	eventWatchRpcMethod = "/controller.api.services.v1.EventService/WatchEvents"
+
+	// eventWatchKeepaliveInterval is how often comments are sent to the
+	// clients of watches of events served over HTTP, so that proxies between
+	// them and the controller do not close idle watches
+	eventWatchKeepaliveInterval = 15 * time.Second
+)
+
+// handleEventWatch serves the watches of events of svc over HTTP, as
+// server-sent events, for the clients which can not use the gRPC API. The
+// request is that of WatchEvents, in query parameters; resource_types can be
+// repeated or a comma separated list. Each event holds the JSON of a
+// WatchEventsResponse and has its cursor as ID, and the Last-Event-ID header
+// set by clients reconnecting is used as the cursor of the request.
+//
+// Like every HTTP request, watches end at the maximum request duration of the
+// listener, after which clients reconnect to resume them. Errors returned
+// once the watch started are sent as an "error" event before it ends.
+func handleEventWatch(logger hclog.Logger, svc services.EventServiceServer) http.Handler {
+	mar := &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{
+			// Ensures the json marshaler uses the snake casing as defined in the proto field names.
+			UseProtoNames: true,
+		},
+	}
+	errorHandler := handlers.ErrorHandler(logger)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rpcMethod, ok := r.Context().Value(rpcMethodKey{}).(*string); ok {
+			*rpcMethod = eventWatchRpcMethod
+		}
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			errorHandler(r.Context(), nil, mar, w, r, errors.New("response writer does not support flushing"))
+			return
+		}
+		req, err := eventWatchRequest(r)
+		if err != nil {
+			errorHandler(r.Context(), nil, mar, w, r, err)
+			return
+		}
+
+		stream := &sseWatchStream{ctx: r.Context(), w: w, flusher: flusher, mar: mar}
+		done := make(chan struct{})
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ticker := time.NewTicker(eventWatchKeepaliveInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-done:
+					return
+				case <-ticker.C:
+					if err := stream.keepalive(); err != nil {
+						logger.Trace("error sending event watch keepalive", "error", err)
+					}
+				}
+			}
+		}()
+		err = svc.WatchEvents(req, stream)
+		close(done)
+		wg.Wait()
+		if err == nil {
+			return
+		}
+		if !stream.started {
+			errorHandler(r.Context(), nil, mar, w, r, err)
+			return
+		}
+		if err := stream.write("event: error\ndata: %s\n\n", handlers.ErrorJSON(logger, mar, err)); err != nil {
+			logger.Trace("error sending event watch error", "error", err)
+		}
+	})
+}
+
+// eventWatchRequest returns the watch request of the query parameters of r
+func eventWatchRequest(r *http.Request) (*services.WatchEventsRequest, error) {
+	q := r.URL.Query()
+	req := &services.WatchEventsRequest{
+		ScopeId: q.Get("scope_id"),
+		Filter:  q.Get("filter"),
+		Cursor:  q.Get("cursor"),
+	}
+	if v := q.Get("recursive"); v != "" {
+		recursive, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, handlers.InvalidArgumentErrorf("Improperly formatted identifier.", map[string]string{"recursive": "This field must be a boolean."})
+		}
+		req.Recursive = recursive
+	}
+	for _, v := range q["resource_types"] {
+		for _, typ := range strings.Split(v, ",") {
+			if typ = strings.TrimSpace(typ); typ != "" {
+				req.ResourceTypes = append(req.ResourceTypes, typ)
+			}
+		}
+	}
+	if id := r.Header.Get("Last-Event-ID"); id != "" {
+		req.Cursor = id
+	}
+	return req, nil
+}
+
+// sseWatchStream is the stream of a watch of events served as server-sent
+// events. The response is started by the first header or event sent.
+type sseWatchStream struct {
+	ctx     context.Context
+	w       http.ResponseWriter
+	flusher http.Flusher
+	mar     runtime.Marshaler
+
+	// l serializes the writes of the watch and its keepalives
+	l       sync.Mutex
+	started bool
+}
+
+var _ services.EventService_WatchEventsServer = (*sseWatchStream)(nil)
+
+func (s *sseWatchStream) Send(resp *services.WatchEventsResponse) error {
+	data, err := s.mar.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("error marshaling event: %w", err)
+	}
+	return s.write("id: %s\ndata: %s\n\n", resp.GetCursor(), data)
+}
+
+func (s *sseWatchStream) SendHeader(metadata.MD) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.start()
+	return nil
+}
+
+func (s *sseWatchStream) SetHeader(metadata.MD) error { return nil }
+
+func (s *sseWatchStream) SetTrailer(metadata.MD) {}
+
+func (s *sseWatchStream) Context() context.Context { return s.ctx }
+
+func (s *sseWatchStream) SendMsg(m interface{}) error {
+	resp, ok := m.(*services.WatchEventsResponse)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	return s.Send(resp)
+}
+
+func (s *sseWatchStream) RecvMsg(interface{}) error {
+	return errors.New("event watches do not receive messages")
+}
+
+// keepalive sends a comment, if the response started
+func (s *sseWatchStream) keepalive() error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	if !s.started {
+		return nil
+	}
+	return s.writeLocked(": keepalive\n\n")
+}
+
+// write starts the response if needed and writes and flushes an event
+func (s *sseWatchStream) write(format string, a ...interface{}) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.start()
+	return s.writeLocked(format, a...)
+}
+
+func (s *sseWatchStream) writeLocked(format string, a ...interface{}) error {
+	if _, err := fmt.Fprintf(s.w, format, a...); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// start writes the headers of the response, once
+func (s *sseWatchStream) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	s.w.Header().Set("Content-Type", "text/event-stream")
+	s.w.WriteHeader(http.StatusOK)
+	s.flusher.Flush()
+}
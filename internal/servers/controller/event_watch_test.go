@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/events"
+	"github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testEventService is an event service sending the events of its responses
+// and then returning err
+type testEventService struct {
+	gotReq    *services.WatchEventsRequest
+	responses []*services.WatchEventsResponse
+	err       error
+}
+
+func (s *testEventService) WatchEvents(req *services.WatchEventsRequest, stream services.EventService_WatchEventsServer) error {
+	s.gotReq = req
+	for _, resp := range s.responses {
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return s.err
+}
+
+// readEvents returns the events of a server-sent events body, as the lines
+// of each of them
+func readEvents(t *testing.T, body string) [][]string {
+	t.Helper()
+	var events [][]string
+	var lines []string
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		if scanner.Text() == "" {
+			events = append(events, lines)
+			lines = nil
+			continue
+		}
+		lines = append(lines, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+	require.Empty(t, lines, "unterminated event")
+	return events
+}
+
+func TestHandleEventWatch(t *testing.T) {
+	t.Run("events", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		svc := &testEventService{
+			responses: []*services.WatchEventsResponse{
+				{Item: &pb.Event{Id: "s_1234567890:pending", Type: "created", ResourceType: "session"}, Cursor: "w1_first"},
+				{Item: &pb.Event{Id: "s_1234567890:active", Type: "updated", ResourceType: "session", State: "active"}, Cursor: "w1_second"},
+			},
+		}
+		req := httptest.NewRequest(http.MethodGet, eventWatchPath+"?scope_id=global&recursive=true&resource_types=session,connection&resource_types=user&cursor=w1_query", nil)
+		req.Header.Set("Last-Event-ID", "w1_header")
+		rec := httptest.NewRecorder()
+		handleEventWatch(hclog.L(), svc).ServeHTTP(rec, req)
+
+		require.NotNil(svc.gotReq)
+		assert.Equal("global", svc.gotReq.GetScopeId())
+		assert.True(svc.gotReq.GetRecursive())
+		assert.Equal([]string{"session", "connection", "user"}, svc.gotReq.GetResourceTypes())
+		// The cursor of a reconnecting client takes precedence
+		assert.Equal("w1_header", svc.gotReq.GetCursor())
+
+		assert.Equal(http.StatusOK, rec.Code)
+		assert.Equal("text/event-stream", rec.Header().Get("Content-Type"))
+		events := readEvents(t, rec.Body.String())
+		require.Len(events, 2)
+		assert.Equal("id: w1_first", events[0][0])
+		require.True(strings.HasPrefix(events[0][1], "data: "))
+		var got map[string]interface{}
+		require.NoError(json.Unmarshal([]byte(strings.TrimPrefix(events[0][1], "data: ")), &got))
+		assert.Equal(map[string]interface{}{
+			"item":   map[string]interface{}{"id": "s_1234567890:pending", "type": "created", "resource_type": "session"},
+			"cursor": "w1_first",
+		}, got)
+		assert.Equal("id: w1_second", events[1][0])
+	})
+	t.Run("error before the watch starts", func(t *testing.T) {
+		assert := assert.New(t)
+		svc := &testEventService{err: handlers.InvalidArgumentErrorf("Invalid cursor.", map[string]string{"cursor": "This field could not be decoded."})}
+		rec := httptest.NewRecorder()
+		handleEventWatch(hclog.L(), svc).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, eventWatchPath+"?scope_id=global", nil))
+		assert.Equal(http.StatusBadRequest, rec.Code)
+		assert.Contains(rec.Body.String(), "Invalid cursor.")
+	})
+	t.Run("error once the watch started", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		svc := &testEventService{
+			responses: []*services.WatchEventsResponse{{Item: &pb.Event{Id: "s_1234567890:pending"}, Cursor: "w1_first"}},
+			err:       handlers.ForbiddenError(),
+		}
+		rec := httptest.NewRecorder()
+		handleEventWatch(hclog.L(), svc).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, eventWatchPath+"?scope_id=global", nil))
+		assert.Equal(http.StatusOK, rec.Code)
+		events := readEvents(t, rec.Body.String())
+		require.Len(events, 2)
+		assert.Equal("event: error", events[1][0])
+		var got map[string]interface{}
+		require.NoError(json.Unmarshal([]byte(strings.TrimPrefix(events[1][1], "data: ")), &got))
+		assert.Equal("PermissionDenied", got["code"])
+	})
+	t.Run("bad request", func(t *testing.T) {
+		assert := assert.New(t)
+		svc := &testEventService{}
+		rec := httptest.NewRecorder()
+		handleEventWatch(hclog.L(), svc).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, eventWatchPath+"?scope_id=global&recursive=maybe", nil))
+		assert.Equal(http.StatusBadRequest, rec.Code)
+		assert.Nil(svc.gotReq)
+
+		rec = httptest.NewRecorder()
+		handleEventWatch(hclog.L(), svc).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, eventWatchPath, nil))
+		assert.Equal(http.StatusMethodNotAllowed, rec.Code)
+		assert.Nil(svc.gotReq)
+	})
+}
@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"errors"
+	"net"
+	"strings"
+
+	sockaddr "github.com/hashicorp/go-sockaddr"
+	"github.com/hashicorp/shared-secure-libs/configutil"
+)
+
+// clientIp returns the IP address of the client of a request received over a
+// connection from remoteAddr. If the listener trusts the X-Forwarded-For
+// header of requests from remoteAddr, which it only does when its
+// x_forwarded_for_authorized_addrs are set, the address is taken from
+// forwardedFor, the values of the header, instead. An error is returned if the
+// request must be rejected per the X-Forwarded-For settings of the listener.
+func clientIp(listener *configutil.Listener, remoteAddr string, forwardedFor []string) (string, error) {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return "", nil
+	}
+	if listener == nil || len(listener.XForwardedForAuthorizedAddrs) == 0 {
+		return host, nil
+	}
+
+	// Proxies append to the header, and multiple headers are read as one
+	// list, so the last address is the one the closest proxy connected from
+	var addrs []string
+	for _, v := range forwardedFor {
+		for _, a := range strings.Split(v, ",") {
+			if a = strings.TrimSpace(a); a != "" {
+				addrs = append(addrs, a)
+			}
+		}
+	}
+	if len(addrs) == 0 {
+		if listener.XForwardedForRejectNotPresent {
+			return "", errors.New("missing X-Forwarded-For header, which the listener requires")
+		}
+		return host, nil
+	}
+
+	var authorized bool
+	if addr, err := sockaddr.NewIPAddr(host); err == nil {
+		for _, a := range listener.XForwardedForAuthorizedAddrs {
+			if a.Contains(addr) {
+				authorized = true
+				break
+			}
+		}
+	}
+	if !authorized {
+		if listener.XForwardedForRejectNotAuthorized {
+			return "", errors.New("X-Forwarded-For header sent from an address not authorized to send it")
+		}
+		return host, nil
+	}
+
+	i := len(addrs) - 1 - int(listener.XForwardedForHopSkips)
+	if i < 0 {
+		return "", errors.New("X-Forwarded-For header has fewer addresses than the hops to skip")
+	}
+	ip := net.ParseIP(addrs[i])
+	if ip == nil {
+		return "", errors.New("malformed address in X-Forwarded-For header")
+	}
+	return ip.String(), nil
+}
@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"testing"
+
+	sockaddr "github.com/hashicorp/go-sockaddr"
+	"github.com/hashicorp/shared-secure-libs/configutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientIp(t *testing.T) {
+	trusting := func(modify func(*configutil.Listener)) *configutil.Listener {
+		l := &configutil.Listener{
+			XForwardedForAuthorizedAddrs: []*sockaddr.SockAddrMarshaler{
+				{SockAddr: sockaddr.MustIPAddr("10.0.0.0/8")},
+			},
+		}
+		if modify != nil {
+			modify(l)
+		}
+		return l
+	}
+	tests := []struct {
+		name         string
+		listener     *configutil.Listener
+		remoteAddr   string
+		forwardedFor []string
+		want         string
+		wantErr      bool
+	}{
+		{
+			name:         "not-enabled",
+			listener:     &configutil.Listener{},
+			remoteAddr:   "10.0.0.1:1234",
+			forwardedFor: []string{"192.0.2.1"},
+			want:         "10.0.0.1",
+		},
+		{
+			name:       "no-listener",
+			remoteAddr: "[2001:db8::1]:1234",
+			want:       "2001:db8::1",
+		},
+		{
+			name:       "bad-remote-addr",
+			listener:   trusting(nil),
+			remoteAddr: "pipe",
+		},
+		{
+			name:         "trusted",
+			listener:     trusting(nil),
+			remoteAddr:   "10.0.0.1:1234",
+			forwardedFor: []string{"192.0.2.1"},
+			want:         "192.0.2.1",
+		},
+		{
+			name:         "trusted-last-of-chain",
+			listener:     trusting(nil),
+			remoteAddr:   "10.0.0.1:1234",
+			forwardedFor: []string{"198.51.100.1, 192.0.2.1", "192.0.2.2"},
+			want:         "192.0.2.2",
+		},
+		{
+			name:         "hop-skips",
+			listener:     trusting(func(l *configutil.Listener) { l.XForwardedForHopSkips = 2 }),
+			remoteAddr:   "10.0.0.1:1234",
+			forwardedFor: []string{"198.51.100.1, 192.0.2.1", "192.0.2.2"},
+			want:         "198.51.100.1",
+		},
+		{
+			name:         "too-many-hop-skips",
+			listener:     trusting(func(l *configutil.Listener) { l.XForwardedForHopSkips = 1 }),
+			remoteAddr:   "10.0.0.1:1234",
+			forwardedFor: []string{"192.0.2.1"},
+			wantErr:      true,
+		},
+		{
+			name:         "malformed",
+			listener:     trusting(nil),
+			remoteAddr:   "10.0.0.1:1234",
+			forwardedFor: []string{"client"},
+			wantErr:      true,
+		},
+		{
+			name:         "not-authorized",
+			listener:     trusting(nil),
+			remoteAddr:   "192.0.2.9:1234",
+			forwardedFor: []string{"192.0.2.1"},
+			want:         "192.0.2.9",
+		},
+		{
+			name:         "not-authorized-rejected",
+			listener:     trusting(func(l *configutil.Listener) { l.XForwardedForRejectNotAuthorized = true }),
+			remoteAddr:   "192.0.2.9:1234",
+			forwardedFor: []string{"192.0.2.1"},
+			wantErr:      true,
+		},
+		{
+			name:       "not-present",
+			listener:   trusting(nil),
+			remoteAddr: "10.0.0.1:1234",
+			want:       "10.0.0.1",
+		},
+		{
+			name:         "not-present-rejected",
+			listener:     trusting(func(l *configutil.Listener) { l.XForwardedForRejectNotPresent = true }),
+			remoteAddr:   "10.0.0.1:1234",
+			forwardedFor: []string{" "},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := clientIp(tt.listener, tt.remoteAddr, tt.forwardedFor)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package controller
+
+import (
+	"context"
+	"math"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/shared-secure-libs/configutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// grpcApiServer returns a gRPC server serving the services of the controller
+// API natively, for the listeners with the "grpc" purpose. Requests are
+// authenticated and authorized the same as those made over HTTP: the token is
+// taken from the authorization metadata and checked by auth.Verify in the
+// service handlers.
+func (c *Controller) grpcApiServer(props HandlerProperties) (*grpc.Server, error) {
+	svcs, err := newApiServices(c)
+	if err != nil {
+		return nil, err
+	}
+
+	_, maxRequestSize := requestLimits(props)
+	maxRecvMsgSize := math.MaxInt32
+	if maxRequestSize > 0 && maxRequestSize < math.MaxInt32 {
+		maxRecvMsgSize = int(maxRequestSize)
+	}
+
+	server := grpc.NewServer(
+		grpc.MaxRecvMsgSize(maxRecvMsgSize),
+		grpc.MaxSendMsgSize(math.MaxInt32),
+		grpc.ChainUnaryInterceptor(
+			metricsInterceptor(grpcApiRequestKey),
+			c.grpcApiUnaryContextInterceptor(props),
+			grpcApiAuditInterceptor,
+			c.grpcApiUnaryErrorInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			streamMetricsInterceptor(grpcApiRequestKey),
+			c.grpcApiStreamContextInterceptor(props),
+			grpcApiStreamAuditInterceptor,
+			c.grpcApiStreamErrorInterceptor,
+		),
+	)
+	registerApiServices(server, svcs)
+	return server, nil
+}
+
+// grpcApiRequestContext adds the values the service handlers expect of an API
+// request received on listener to ctx, as wrapHandlerWithCommonFuncs does for
+// HTTP requests
+func (c *Controller) grpcApiRequestContext(ctx context.Context, listener *configutil.Listener, fullMethod string, disableAuthzFailures bool) (context.Context, error) {
+	// gRPC requests are HTTP/2 POST requests to the path of their method
+	requestInfo := auth.RequestInfo{
+		Path:                 fullMethod,
+		Method:               "POST",
+		DisableAuthzFailures: disableAuthzFailures,
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		var err error
+		if requestInfo.ClientIp, err = clientIp(listener, p.Addr.String(), md.Get("x-forwarded-for")); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	requestInfo.PublicId, requestInfo.EncryptedToken, requestInfo.TokenFormat = auth.GetTokenFromGrpcMetadata(c.logger, md)
+	ctx = auth.NewVerifierContext(ctx, c.logger, c.IamRepoFn, c.AuthTokenRepoFn, c.ServersRepoFn, c.kms, requestInfo)
+
+	return c.auditRequestContext(ctx, requestInfo.ClientIp), nil
+}
+
+// grpcApiUnaryContextInterceptor bounds the duration of unary requests by the
+// maximum request duration of the listener and adds the values of an API
+// request to their context
+func (c *Controller) grpcApiUnaryContextInterceptor(props HandlerProperties) grpc.UnaryServerInterceptor {
+	maxRequestDuration, _ := requestLimits(props)
+	disableAuthzFailures := c.authzFailuresDisabled()
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, cancel := context.WithTimeout(ctx, maxRequestDuration)
+		defer cancel()
+		ctx, err := c.grpcApiRequestContext(ctx, props.ListenerConfig, info.FullMethod, disableAuthzFailures)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// grpcApiStreamContextInterceptor adds the values of an API request to the
+// context of streaming requests. Streams are not bound by the maximum request
+// duration, as they may be watched for as long as the client wants.
+func (c *Controller) grpcApiStreamContextInterceptor(props HandlerProperties) grpc.StreamServerInterceptor {
+	disableAuthzFailures := c.authzFailuresDisabled()
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := c.grpcApiRequestContext(ss.Context(), props.ListenerConfig, info.FullMethod, disableAuthzFailures)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// grpcApiUnaryErrorInterceptor converts the errors of the service handlers
+// to gRPC status errors
+func (c *Controller) grpcApiUnaryErrorInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, handlers.GrpcStatusError(c.logger, err)
+}
+
+// grpcApiStreamErrorInterceptor converts the errors of the streaming service
+// handlers to gRPC status errors
+func (c *Controller) grpcApiStreamErrorInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handlers.GrpcStatusError(c.logger, handler(srv, ss))
+}
+
+// contextServerStream is a server stream with the context given to it by an
+// interceptor
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
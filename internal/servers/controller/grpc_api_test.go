@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGrpcApi(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	tc := NewTestController(t, &TestControllerOpts{EnableGrpcListener: true})
+	defer tc.Shutdown()
+
+	require.Len(tc.GrpcAddrs(), 1)
+	client := tc.Client()
+	assert.Equal(tc.GrpcAddrs()[0], client.GrpcAddr())
+	conn, err := client.GrpcDial(tc.Context())
+	require.NoError(err)
+	defer conn.Close()
+	scopeClient := pbs.NewScopeServiceClient(conn)
+	authTokenClient := pbs.NewAuthTokenServiceClient(conn)
+
+	// Without a token the request is made as the anonymous user
+	_, err = authTokenClient.ListAuthTokens(tc.Context(), &pbs.ListAuthTokensRequest{ScopeId: scope.Global.String()})
+	require.Error(err)
+	assert.Equal(codes.Unauthenticated, status.Code(err))
+	assert.True(errors.Is(api.AsServerError(err), api.ErrUnauthorized))
+
+	token := tc.Token()
+	require.NotNil(token)
+	client.SetToken(token.Token)
+
+	// The services are the same as those served over HTTP
+	httpResult, err := scopes.NewClient(client).List(tc.Context(), scope.Global.String())
+	require.NoError(err)
+	grpcResult, err := scopeClient.ListScopes(tc.Context(), &pbs.ListScopesRequest{ScopeId: scope.Global.String()})
+	require.NoError(err)
+	require.Len(grpcResult.GetItems(), len(httpResult.Items))
+	for i, item := range httpResult.Items {
+		assert.Equal(item.Id, grpcResult.GetItems()[i].GetId())
+	}
+
+	authTokens, err := authTokenClient.ListAuthTokens(tc.Context(), &pbs.ListAuthTokensRequest{ScopeId: scope.Global.String()})
+	require.NoError(err)
+	assert.NotEmpty(authTokens.GetItems())
+
+	// Errors carry their field details
+	_, err = scopeClient.GetScope(tc.Context(), &pbs.GetScopeRequest{Id: "o_bad"})
+	require.Error(err)
+	st, ok := status.FromError(err)
+	require.True(ok)
+	assert.Equal(codes.InvalidArgument, st.Code())
+	require.Len(st.Details(), 1)
+	_, ok = st.Details()[0].(*errdetails.BadRequest)
+	assert.True(ok)
+	apiErr := api.AsServerError(err)
+	require.NotNil(apiErr)
+	assert.True(errors.Is(apiErr, api.ErrInvalidArgument))
+	require.NotNil(apiErr.Details)
+	assert.NotEmpty(apiErr.Details.RequestFields)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/audit"
 	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/accounts"
@@ -20,16 +21,21 @@ import (
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/targets"
 	"github.com/hashicorp/boundary/sdk/strutil"
+	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/shared-secure-libs/configutil"
 
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/authtokens"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credential_stores"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credentials"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/events"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/groups"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/host_catalogs"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/hosts"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/roles"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/scopes"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/users"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -44,11 +50,16 @@ func (c *Controller) handler(props HandlerProperties) (http.Handler, error) {
 	// Create the muxer to handle the actual endpoints
 	mux := http.NewServeMux()
 
-	h, err := handleGrpcGateway(c, props)
+	svcs, err := newApiServices(c)
 	if err != nil {
 		return nil, err
 	}
-	mux.Handle("/v1/", h)
+	h, err := handleGrpcGateway(c, props, svcs)
+	if err != nil {
+		return nil, err
+	}
+	mux.Handle("/v1/", wrapHandlerWithMetrics(wrapHandlerWithAudit(h)))
+	mux.Handle(eventWatchPath, wrapHandlerWithMetrics(wrapHandlerWithAudit(handleEventWatch(c.logger, svcs.events))))
 	mux.Handle("/", handleUi(c))
 
 	corsWrappedHandler := wrapHandlerWithCors(mux, props)
@@ -57,7 +68,7 @@ func (c *Controller) handler(props HandlerProperties) (http.Handler, error) {
 	return commonWrappedHandler, nil
 }
 
-func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, error) {
+func handleGrpcGateway(c *Controller, props HandlerProperties, svcs *apiServices) (http.Handler, error) {
 	// Register*ServiceHandlerServer methods ignore the passed in ctx.  Using
 	// the a context now just in case this changes in the future
 	ctx := props.CancelCtx
@@ -78,104 +89,177 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}),
 		runtime.WithErrorHandler(handlers.ErrorHandler(c.logger)),
 		runtime.WithForwardResponseOption(handlers.OutgoingInterceptor),
+		runtime.WithMetadata(recordRpcMethod),
 	)
-	hcs, err := host_catalogs.NewService(c.StaticHostRepoFn, c.IamRepoFn)
+	if err := services.RegisterHostCatalogServiceHandlerServer(ctx, mux, svcs.hostCatalogs); err != nil {
+		return nil, fmt.Errorf("failed to register host catalog service handler: %w", err)
+	}
+	if err := services.RegisterHostSetServiceHandlerServer(ctx, mux, svcs.hostSets); err != nil {
+		return nil, fmt.Errorf("failed to register host set service handler: %w", err)
+	}
+	if err := services.RegisterHostServiceHandlerServer(ctx, mux, svcs.hosts); err != nil {
+		return nil, fmt.Errorf("failed to register host service handler: %w", err)
+	}
+	if err := services.RegisterAccountServiceHandlerServer(ctx, mux, svcs.accounts); err != nil {
+		return nil, fmt.Errorf("failed to register account service handler: %w", err)
+	}
+	if err := services.RegisterAuthMethodServiceHandlerServer(ctx, mux, svcs.authMethods); err != nil {
+		return nil, fmt.Errorf("failed to register auth method service handler: %w", err)
+	}
+	if err := services.RegisterAuthTokenServiceHandlerServer(ctx, mux, svcs.authTokens); err != nil {
+		return nil, fmt.Errorf("failed to register auth token service handler: %w", err)
+	}
+	if err := services.RegisterScopeServiceHandlerServer(ctx, mux, svcs.scopes); err != nil {
+		return nil, fmt.Errorf("failed to register scope service handler: %w", err)
+	}
+	if err := services.RegisterUserServiceHandlerServer(ctx, mux, svcs.users); err != nil {
+		return nil, fmt.Errorf("failed to register user service handler: %w", err)
+	}
+	if err := services.RegisterTargetServiceHandlerServer(ctx, mux, svcs.targets); err != nil {
+		return nil, fmt.Errorf("failed to register target service handler: %w", err)
+	}
+	if err := services.RegisterCredentialStoreServiceHandlerServer(ctx, mux, svcs.credentialStores); err != nil {
+		return nil, fmt.Errorf("failed to register credential store service handler: %w", err)
+	}
+	if err := services.RegisterCredentialServiceHandlerServer(ctx, mux, svcs.credentials); err != nil {
+		return nil, fmt.Errorf("failed to register credential service handler: %w", err)
+	}
+	if err := services.RegisterGroupServiceHandlerServer(ctx, mux, svcs.groups); err != nil {
+		return nil, fmt.Errorf("failed to register group service handler: %w", err)
+	}
+	if err := services.RegisterRoleServiceHandlerServer(ctx, mux, svcs.roles); err != nil {
+		return nil, fmt.Errorf("failed to register role service handler: %w", err)
+	}
+	if err := services.RegisterSessionServiceHandlerServer(ctx, mux, svcs.sessions); err != nil {
+		return nil, fmt.Errorf("failed to register session service handler: %w", err)
+	}
+	// Recording downloads stream, which the gateway does not support, so they
+	// are served by a handler of their own
+	if err := mux.HandlePath(http.MethodGet, recordingDownloadPath, handleRecordingDownload(c.logger, svcs.sessions)); err != nil {
+		return nil, fmt.Errorf("failed to register session recording download handler: %w", err)
+	}
+
+	return mux, nil
+}
+
+// apiServices are the services of the controller API, served by both the
+// grpc-gateway mux of the api listeners and the gRPC server of the grpc
+// listeners. The event service and the downloads of session recordings stream
+// their responses, which the gateway does not support, and are served over
+// HTTP by handleEventWatch and handleRecordingDownload instead.
+type apiServices struct {
+	hostCatalogs     services.HostCatalogServiceServer
+	hostSets         services.HostSetServiceServer
+	hosts            services.HostServiceServer
+	accounts         services.AccountServiceServer
+	authMethods      services.AuthMethodServiceServer
+	authTokens       services.AuthTokenServiceServer
+	scopes           services.ScopeServiceServer
+	users            services.UserServiceServer
+	targets          services.TargetServiceServer
+	credentialStores services.CredentialStoreServiceServer
+	credentials      services.CredentialServiceServer
+	groups           services.GroupServiceServer
+	roles            services.RoleServiceServer
+	sessions         services.SessionServiceServer
+	events           services.EventServiceServer
+}
+
+func newApiServices(c *Controller) (*apiServices, error) {
+	svcs := new(apiServices)
+	var err error
+	svcs.hostCatalogs, err = host_catalogs.NewService(c.StaticHostRepoFn, c.DynamicHostRepoFn, c.IamRepoFn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create host catalog handler service: %w", err)
 	}
-	if err := services.RegisterHostCatalogServiceHandlerServer(ctx, mux, hcs); err != nil {
-		return nil, fmt.Errorf("failed to register host catalog service handler: %w", err)
-	}
-	hss, err := host_sets.NewService(c.StaticHostRepoFn)
+	svcs.hostSets, err = host_sets.NewService(c.StaticHostRepoFn, c.DynamicHostRepoFn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create host set handler service: %w", err)
 	}
-	if err := services.RegisterHostSetServiceHandlerServer(ctx, mux, hss); err != nil {
-		return nil, fmt.Errorf("failed to register host set service handler: %w", err)
-	}
-	hs, err := hosts.NewService(c.StaticHostRepoFn)
+	svcs.hosts, err = hosts.NewService(c.StaticHostRepoFn, c.DynamicHostRepoFn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create host handler service: %w", err)
 	}
-	if err := services.RegisterHostServiceHandlerServer(ctx, mux, hs); err != nil {
-		return nil, fmt.Errorf("failed to register host service handler: %w", err)
-	}
-	accts, err := accounts.NewService(c.PasswordAuthRepoFn)
+	svcs.accounts, err = accounts.NewService(c.PasswordAuthRepoFn, c.OidcAuthRepoFn, c.LdapAuthRepoFn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create account handler service: %w", err)
 	}
-	if err := services.RegisterAccountServiceHandlerServer(ctx, mux, accts); err != nil {
-		return nil, fmt.Errorf("failed to register account service handler: %w", err)
-	}
-	authMethods, err := authmethods.NewService(c.kms, c.PasswordAuthRepoFn, c.IamRepoFn, c.AuthTokenRepoFn)
+	svcs.authMethods, err = authmethods.NewService(c.kms, c.PasswordAuthRepoFn, c.OidcAuthRepoFn, c.LdapAuthRepoFn, c.IamRepoFn, c.AuthTokenRepoFn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create auth method handler service: %w", err)
 	}
-	if err := services.RegisterAuthMethodServiceHandlerServer(ctx, mux, authMethods); err != nil {
-		return nil, fmt.Errorf("failed to register auth method service handler: %w", err)
-	}
-	authtoks, err := authtokens.NewService(c.AuthTokenRepoFn, c.IamRepoFn)
+	svcs.authTokens, err = authtokens.NewService(c.AuthTokenRepoFn, c.IamRepoFn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create auth token handler service: %w", err)
 	}
-	if err := services.RegisterAuthTokenServiceHandlerServer(ctx, mux, authtoks); err != nil {
-		return nil, fmt.Errorf("failed to register auth token service handler: %w", err)
-	}
-	os, err := scopes.NewService(c.IamRepoFn)
+	svcs.scopes, err = scopes.NewService(c.IamRepoFn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create scope handler service: %w", err)
 	}
-	if err := services.RegisterScopeServiceHandlerServer(ctx, mux, os); err != nil {
-		return nil, fmt.Errorf("failed to register scope service handler: %w", err)
-	}
-	us, err := users.NewService(c.IamRepoFn)
+	svcs.users, err = users.NewService(c.IamRepoFn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create user handler service: %w", err)
 	}
-	if err := services.RegisterUserServiceHandlerServer(ctx, mux, us); err != nil {
-		return nil, fmt.Errorf("failed to register user service handler: %w", err)
-	}
-	ts, err := targets.NewService(
+	svcs.targets, err = targets.NewService(
 		c.kms,
 		c.TargetRepoFn,
 		c.IamRepoFn,
 		c.ServersRepoFn,
 		c.SessionRepoFn,
-		c.StaticHostRepoFn)
+		c.HostPluginFn,
+		c.CredentialRepoFn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create target handler service: %w", err)
 	}
-	if err := services.RegisterTargetServiceHandlerServer(ctx, mux, ts); err != nil {
-		return nil, fmt.Errorf("failed to register target service handler: %w", err)
+	svcs.credentialStores, err = credential_stores.NewService(c.CredentialRepoFn, c.IamRepoFn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create credential store handler service: %w", err)
 	}
-	gs, err := groups.NewService(c.IamRepoFn)
+	svcs.credentials, err = credentials.NewService(c.CredentialRepoFn)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create group handler service: %w", err)
+		return nil, fmt.Errorf("failed to create credential handler service: %w", err)
 	}
-	if err := services.RegisterGroupServiceHandlerServer(ctx, mux, gs); err != nil {
-		return nil, fmt.Errorf("failed to register group service handler: %w", err)
+	svcs.groups, err = groups.NewService(c.IamRepoFn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create group handler service: %w", err)
 	}
-	rs, err := roles.NewService(c.IamRepoFn)
+	svcs.roles, err = roles.NewService(c.IamRepoFn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create role handler service: %w", err)
 	}
-	if err := services.RegisterRoleServiceHandlerServer(ctx, mux, rs); err != nil {
-		return nil, fmt.Errorf("failed to register role service handler: %w", err)
-	}
-	ss, err := sessions.NewService(c.SessionRepoFn, c.IamRepoFn)
+	svcs.sessions, err = sessions.NewService(c.kms, c.SessionRepoFn, c.IamRepoFn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create session handler service: %w", err)
 	}
-	if err := services.RegisterSessionServiceHandlerServer(ctx, mux, ss); err != nil {
-		return nil, fmt.Errorf("failed to register session service handler: %w", err)
+	svcs.events, err = events.NewService(c.WatchRepoFn, c.IamRepoFn, c.watchPoller)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event handler service: %w", err)
 	}
+	return svcs, nil
+}
 
-	return mux, nil
+// registerApiServices registers the services of svcs with a gRPC server
+func registerApiServices(server *grpc.Server, svcs *apiServices) {
+	services.RegisterHostCatalogServiceServer(server, svcs.hostCatalogs)
+	services.RegisterHostSetServiceServer(server, svcs.hostSets)
+	services.RegisterHostServiceServer(server, svcs.hosts)
+	services.RegisterAccountServiceServer(server, svcs.accounts)
+	services.RegisterAuthMethodServiceServer(server, svcs.authMethods)
+	services.RegisterAuthTokenServiceServer(server, svcs.authTokens)
+	services.RegisterScopeServiceServer(server, svcs.scopes)
+	services.RegisterUserServiceServer(server, svcs.users)
+	services.RegisterTargetServiceServer(server, svcs.targets)
+	services.RegisterCredentialStoreServiceServer(server, svcs.credentialStores)
+	services.RegisterCredentialServiceServer(server, svcs.credentials)
+	services.RegisterGroupServiceServer(server, svcs.groups)
+	services.RegisterRoleServiceServer(server, svcs.roles)
+	services.RegisterSessionServiceServer(server, svcs.sessions)
+	services.RegisterEventServiceServer(server, svcs.events)
 }
 
-func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProperties) http.Handler {
-	var maxRequestDuration time.Duration
-	var maxRequestSize int64
+// requestLimits returns the maximum duration and size of the requests to the
+// listener of props, or the defaults if it sets none
+func requestLimits(props HandlerProperties) (maxRequestDuration time.Duration, maxRequestSize int64) {
 	if props.ListenerConfig != nil {
 		maxRequestDuration = props.ListenerConfig.MaxRequestDuration
 		maxRequestSize = props.ListenerConfig.MaxRequestSize
@@ -186,11 +270,22 @@ func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProp
 	if maxRequestSize == 0 {
 		maxRequestSize = globals.DefaultMaxRequestSize
 	}
+	return maxRequestDuration, maxRequestSize
+}
+
+// authzFailuresDisabled returns whether authz checks are performed without
+// failing the requests they deny
+func (c *Controller) authzFailuresDisabled() bool {
+	return c.conf.DisableAuthorizationFailures ||
+		(c.conf.RawConfig.DevController && os.Getenv("BOUNDARY_DEV_SKIP_AUTHZ") != "")
+}
+
+func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProperties) http.Handler {
+	maxRequestDuration, maxRequestSize := requestLimits(props)
 
 	logUrls := os.Getenv("BOUNDARY_LOG_URLS") != ""
 
-	disableAuthzFailures := c.conf.DisableAuthorizationFailures ||
-		(c.conf.RawConfig.DevController && os.Getenv("BOUNDARY_DEV_SKIP_AUTHZ") != "")
+	disableAuthzFailures := c.authzFailuresDisabled()
 	if disableAuthzFailures {
 		c.logger.Warn("AUTHORIZATION CHECKING DISABLED")
 	}
@@ -218,10 +313,19 @@ func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProp
 			Method:               r.Method,
 			DisableAuthzFailures: disableAuthzFailures,
 		}
+		var err error
+		if requestInfo.ClientIp, err = clientIp(props.ListenerConfig, r.RemoteAddr, r.Header.Values("X-Forwarded-For")); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 
 		requestInfo.PublicId, requestInfo.EncryptedToken, requestInfo.TokenFormat = auth.GetTokenFromRequest(c.logger, c.kms, r)
 		ctx = auth.NewVerifierContext(ctx, c.logger, c.IamRepoFn, c.AuthTokenRepoFn, c.ServersRepoFn, c.kms, requestInfo)
 
+		// Add the auditor and the state shared by the audit events of the
+		// request
+		ctx = c.auditRequestContext(ctx, requestInfo.ClientIp)
+
 		// Set the context back on the request
 		r = r.WithContext(ctx)
 
@@ -229,6 +333,23 @@ func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProp
 	})
 }
 
+// auditRequestContext adds the auditor of the controller, if any, and the
+// state shared by the audit events of an API request from clientIp to ctx
+func (c *Controller) auditRequestContext(ctx context.Context, clientIp string) context.Context {
+	if c.auditor == nil {
+		return ctx
+	}
+	requestId, err := uuid.GenerateUUID()
+	if err != nil {
+		c.logger.Error("error generating request id", "error", err)
+	}
+	ctx = audit.NewContext(ctx, c.auditor)
+	return audit.NewRequestContext(ctx, &audit.Request{
+		Id:   requestId,
+		Auth: audit.Auth{ClientIp: clientIp},
+	})
+}
+
 func wrapHandlerWithCors(h http.Handler, props HandlerProperties) http.Handler {
 	allowedMethods := []string{
 		http.MethodDelete,
@@ -4,8 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	ldapstore "github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	oidcstore "github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/auth/password/store"
 	"github.com/hashicorp/boundary/internal/db"
@@ -20,7 +25,9 @@ import (
 )
 
 var (
-	maskManager handlers.MaskManager
+	maskManager     handlers.MaskManager
+	oidcMaskManager handlers.MaskManager
+	ldapMaskManager handlers.MaskManager
 )
 
 func init() {
@@ -28,19 +35,33 @@ func init() {
 	if maskManager, err = handlers.NewMaskManager(&store.Account{}, &pb.Account{}, &pb.PasswordAccountAttributes{}); err != nil {
 		panic(err)
 	}
+	if oidcMaskManager, err = handlers.NewMaskManager(&oidcstore.Account{}, &pb.Account{}, &pb.OidcAccountAttributes{}); err != nil {
+		panic(err)
+	}
+	if ldapMaskManager, err = handlers.NewMaskManager(&ldapstore.Account{}, &pb.Account{}, &pb.LdapAccountAttributes{}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.AccountServiceServer interface.
 type Service struct {
-	repoFn common.PasswordAuthRepoFactory
+	repoFn     common.PasswordAuthRepoFactory
+	oidcRepoFn common.OidcAuthRepoFactory
+	ldapRepoFn common.LdapAuthRepoFactory
 }
 
 // NewService returns a user service which handles user related requests to boundary.
-func NewService(repo common.PasswordAuthRepoFactory) (Service, error) {
+func NewService(repo common.PasswordAuthRepoFactory, oidcRepo common.OidcAuthRepoFactory, ldapRepo common.LdapAuthRepoFactory) (Service, error) {
 	if repo == nil {
 		return Service{}, fmt.Errorf("nil password repository provided")
 	}
-	return Service{repoFn: repo}, nil
+	if oidcRepo == nil {
+		return Service{}, fmt.Errorf("nil oidc repository provided")
+	}
+	if ldapRepo == nil {
+		return Service{}, fmt.Errorf("nil ldap repository provided")
+	}
+	return Service{repoFn: repo, oidcRepoFn: oidcRepo, ldapRepoFn: ldapRepo}, nil
 }
 
 var _ pbs.AccountServiceServer = Service{}
@@ -54,14 +75,26 @@ func (s Service) ListAccounts(ctx context.Context, req *pbs.ListAccountsRequest)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	ul, err := s.listFromRepo(ctx, req.GetAuthMethodId())
+	page, err := handlers.PaginateList(ctx, auth.KmsFromContext(ctx), req, resource.Account, req.GetAuthMethodId(), &authResults, func(after *db.PageAfter, limit int) ([]handlers.PageItem, error) {
+		ul, err := s.listFromRepo(ctx, req.GetAuthMethodId(), after, limit)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]handlers.PageItem, 0, len(ul))
+		for _, item := range ul {
+			item.Scope = authResults.Scope
+			items = append(items, item)
+		}
+		return items, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range ul {
-		item.Scope = authResults.Scope
+	var ul []*pb.Account
+	for _, item := range page.Items {
+		ul = append(ul, item.(*pb.Account))
 	}
-	return &pbs.ListAccountsResponse{Items: ul}, nil
+	return &pbs.ListAccountsResponse{Items: ul, NextPageToken: page.NextPageToken}, nil
 }
 
 // GetAccount implements the interface pbs.AccountServiceServer.
@@ -86,11 +119,11 @@ func (s Service) CreateAccount(ctx context.Context, req *pbs.CreateAccountReques
 	if err := validateCreateRequest(req); err != nil {
 		return nil, err
 	}
-	authMeth, authResults := s.parentAndAuthResult(ctx, req.GetItem().GetAuthMethodId(), action.Create)
+	authMethId, authResults := s.parentAndAuthResult(ctx, req.GetItem().GetAuthMethodId(), action.Create)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	u, err := s.createInRepo(ctx, authMeth.GetPublicId(), authResults.Scope.GetId(), req.GetItem())
+	u, err := s.createInRepo(ctx, authMethId, authResults.Scope.GetId(), req.GetItem())
 	if err != nil {
 		return nil, err
 	}
@@ -103,11 +136,11 @@ func (s Service) UpdateAccount(ctx context.Context, req *pbs.UpdateAccountReques
 	if err := validateUpdateRequest(req); err != nil {
 		return nil, err
 	}
-	authMeth, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Update)
+	authMethId, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Update)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	u, err := s.updateInRepo(ctx, authResults.Scope.GetId(), authMeth.GetPublicId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	u, err := s.updateInRepo(ctx, authResults.Scope.GetId(), authMethId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
 	if err != nil {
 		return nil, err
 	}
@@ -166,6 +199,34 @@ func (s Service) SetPassword(ctx context.Context, req *pbs.SetPasswordRequest) (
 }
 
 func (s Service) getFromRepo(ctx context.Context, id string) (*pb.Account, error) {
+	switch auth.SubtypeFromId(id) {
+	case auth.OidcSubtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		a, err := repo.LookupAccount(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if a == nil {
+			return nil, handlers.NotFoundErrorf("Account %q doesn't exist.", id)
+		}
+		return toOidcProto(a)
+	case auth.LdapSubtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		a, err := repo.LookupAccount(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if a == nil {
+			return nil, handlers.NotFoundErrorf("Account %q doesn't exist.", id)
+		}
+		return toLdapProto(a)
+	}
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
@@ -184,6 +245,12 @@ func (s Service) getFromRepo(ctx context.Context, id string) (*pb.Account, error
 }
 
 func (s Service) createInRepo(ctx context.Context, authMethodId, scopeId string, item *pb.Account) (*pb.Account, error) {
+	switch auth.SubtypeFromId(authMethodId) {
+	case auth.OidcSubtype:
+		return s.createOidcInRepo(ctx, authMethodId, scopeId, item)
+	case auth.LdapSubtype:
+		return s.createLdapInRepo(ctx, authMethodId, scopeId, item)
+	}
 	pwAttrs := &pb.PasswordAccountAttributes{}
 	if err := handlers.StructToProto(item.GetAttributes(), pwAttrs); err != nil {
 		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
@@ -219,7 +286,75 @@ func (s Service) createInRepo(ctx context.Context, authMethodId, scopeId string,
 	return toProto(out)
 }
 
+func (s Service) createOidcInRepo(ctx context.Context, authMethodId, scopeId string, item *pb.Account) (*pb.Account, error) {
+	oidcAttrs := &pb.OidcAccountAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), oidcAttrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	var opts []oidc.Option
+	if item.GetName() != nil {
+		opts = append(opts, oidc.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, oidc.WithDescription(item.GetDescription().GetValue()))
+	}
+	a, err := oidc.NewAccount(authMethodId, oidcAttrs.GetSubject(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build account for creation: %v.", err)
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateAccount(ctx, scopeId, a)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create account: %w", err)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
+	}
+	return toOidcProto(out)
+}
+
+func (s Service) createLdapInRepo(ctx context.Context, authMethodId, scopeId string, item *pb.Account) (*pb.Account, error) {
+	ldapAttrs := &pb.LdapAccountAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), ldapAttrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	var opts []ldap.Option
+	if item.GetName() != nil {
+		opts = append(opts, ldap.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, ldap.WithDescription(item.GetDescription().GetValue()))
+	}
+	a, err := ldap.NewAccount(authMethodId, ldapAttrs.GetLoginName(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build account for creation: %v.", err)
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateAccount(ctx, scopeId, a)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create account: %w", err)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
+	}
+	return toLdapProto(out)
+}
+
 func (s Service) updateInRepo(ctx context.Context, scopeId, authMethId, id string, mask []string, item *pb.Account) (*pb.Account, error) {
+	switch auth.SubtypeFromId(id) {
+	case auth.OidcSubtype:
+		return s.updateOidcInRepo(ctx, scopeId, authMethId, id, mask, item)
+	case auth.LdapSubtype:
+		return s.updateLdapInRepo(ctx, scopeId, authMethId, id, mask, item)
+	}
 	var opts []password.Option
 	if desc := item.GetDescription(); desc != nil {
 		opts = append(opts, password.WithDescription(desc.GetValue()))
@@ -265,12 +400,95 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, authMethId, id strin
 	return toProto(out)
 }
 
-func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
-	repo, err := s.repoFn()
+func (s Service) updateOidcInRepo(ctx context.Context, scopeId, authMethId, id string, mask []string, item *pb.Account) (*pb.Account, error) {
+	var opts []oidc.Option
+	if desc := item.GetDescription(); desc != nil {
+		opts = append(opts, oidc.WithDescription(desc.GetValue()))
+	}
+	if name := item.GetName(); name != nil {
+		opts = append(opts, oidc.WithName(name.GetValue()))
+	}
+	u, err := oidc.NewAccount(authMethId, "", opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build account for update: %v.", err)
+	}
+	u.PublicId = id
+	version := item.GetVersion()
+
+	dbMask := oidcMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, rowsUpdated, err := repo.UpdateAccount(ctx, scopeId, u, version, dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update account: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Account %q doesn't exist or incorrect version provided.", id)
+	}
+	return toOidcProto(out)
+}
+
+func (s Service) updateLdapInRepo(ctx context.Context, scopeId, authMethId, id string, mask []string, item *pb.Account) (*pb.Account, error) {
+	var opts []ldap.Option
+	if desc := item.GetDescription(); desc != nil {
+		opts = append(opts, ldap.WithDescription(desc.GetValue()))
+	}
+	if name := item.GetName(); name != nil {
+		opts = append(opts, ldap.WithName(name.GetValue()))
+	}
+	u, err := ldap.NewAccount(authMethId, "", opts...)
 	if err != nil {
-		return false, err
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build account for update: %v.", err)
+	}
+	u.PublicId = id
+	version := item.GetVersion()
+
+	dbMask := ldapMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, rowsUpdated, err := repo.UpdateAccount(ctx, scopeId, u, version, dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update account: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Account %q doesn't exist or incorrect version provided.", id)
+	}
+	return toLdapProto(out)
+}
+
+func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
+	var rows int
+	var err error
+	switch auth.SubtypeFromId(id) {
+	case auth.OidcSubtype:
+		repo, rErr := s.oidcRepoFn()
+		if rErr != nil {
+			return false, rErr
+		}
+		rows, err = repo.DeleteAccount(ctx, scopeId, id)
+	case auth.LdapSubtype:
+		repo, rErr := s.ldapRepoFn()
+		if rErr != nil {
+			return false, rErr
+		}
+		rows, err = repo.DeleteAccount(ctx, scopeId, id)
+	default:
+		repo, rErr := s.repoFn()
+		if rErr != nil {
+			return false, rErr
+		}
+		rows, err = repo.DeleteAccount(ctx, scopeId, id)
 	}
-	rows, err := repo.DeleteAccount(ctx, scopeId, id)
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
 			return false, nil
@@ -280,12 +498,50 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, authMethodId string) ([]*pb.Account, error) {
+func (s Service) listFromRepo(ctx context.Context, authMethodId string, after *db.PageAfter, limit int) ([]*pb.Account, error) {
+	switch auth.SubtypeFromId(authMethodId) {
+	case auth.OidcSubtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		al, err := repo.ListAccounts(ctx, authMethodId, oidc.WithPageAfter(after), oidc.WithLimit(limit))
+		if err != nil {
+			return nil, err
+		}
+		var outAl []*pb.Account
+		for _, a := range al {
+			oa, err := toOidcProto(a)
+			if err != nil {
+				return nil, err
+			}
+			outAl = append(outAl, oa)
+		}
+		return outAl, nil
+	case auth.LdapSubtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		al, err := repo.ListAccounts(ctx, authMethodId, ldap.WithPageAfter(after), ldap.WithLimit(limit))
+		if err != nil {
+			return nil, err
+		}
+		var outAl []*pb.Account
+		for _, a := range al {
+			oa, err := toLdapProto(a)
+			if err != nil {
+				return nil, err
+			}
+			outAl = append(outAl, oa)
+		}
+		return outAl, nil
+	}
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListAccounts(ctx, authMethodId)
+	ul, err := repo.ListAccounts(ctx, authMethodId, password.WithPageAfter(after), password.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
@@ -344,45 +600,111 @@ func (s Service) setPasswordInRepo(ctx context.Context, scopeId, id string, vers
 	return toProto(out)
 }
 
-func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (*password.AuthMethod, auth.VerifyResults) {
+func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (string, auth.VerifyResults) {
 	res := auth.VerifyResults{}
-	repo, err := s.repoFn()
-	if err != nil {
-		res.Error = err
-		return nil, res
-	}
 
 	var parentId string
-	var authMeth *password.AuthMethod
 	opts := []auth.Option{auth.WithType(resource.Account), auth.WithAction(a)}
 	switch a {
 	case action.List, action.Create:
 		parentId = id
 	default:
-		acct, err := repo.LookupAccount(ctx, id)
+		authMethId, err := s.accountAuthMethodId(ctx, id)
 		if err != nil {
 			res.Error = err
-			return nil, res
+			return "", res
 		}
-		if acct == nil {
+		if authMethId == "" {
 			res.Error = handlers.NotFoundError()
-			return nil, res
+			return "", res
 		}
-		parentId = acct.GetAuthMethodId()
+		parentId = authMethId
 		opts = append(opts, auth.WithId(id))
 	}
 
-	authMeth, err = repo.LookupAuthMethod(ctx, parentId)
+	scopeId, err := s.authMethodScopeId(ctx, parentId)
 	if err != nil {
 		res.Error = err
-		return nil, res
+		return "", res
 	}
-	if authMeth == nil {
+	if scopeId == "" {
 		res.Error = handlers.NotFoundError()
-		return nil, res
+		return "", res
+	}
+	opts = append(opts, auth.WithScopeId(scopeId), auth.WithPin(parentId))
+	return parentId, auth.Verify(ctx, opts...)
+}
+
+// accountAuthMethodId returns the auth method id of the account id or an
+// empty string if the account doesn't exist.
+func (s Service) accountAuthMethodId(ctx context.Context, id string) (string, error) {
+	switch auth.SubtypeFromId(id) {
+	case auth.OidcSubtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return "", err
+		}
+		acct, err := repo.LookupAccount(ctx, id)
+		if err != nil || acct == nil {
+			return "", err
+		}
+		return acct.GetAuthMethodId(), nil
+	case auth.LdapSubtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return "", err
+		}
+		acct, err := repo.LookupAccount(ctx, id)
+		if err != nil || acct == nil {
+			return "", err
+		}
+		return acct.GetAuthMethodId(), nil
 	}
-	opts = append(opts, auth.WithScopeId(authMeth.GetScopeId()), auth.WithPin(parentId))
-	return authMeth, auth.Verify(ctx, opts...)
+	repo, err := s.repoFn()
+	if err != nil {
+		return "", err
+	}
+	acct, err := repo.LookupAccount(ctx, id)
+	if err != nil || acct == nil {
+		return "", err
+	}
+	return acct.GetAuthMethodId(), nil
+}
+
+// authMethodScopeId returns the scope id of the auth method id or an empty
+// string if the auth method doesn't exist.
+func (s Service) authMethodScopeId(ctx context.Context, id string) (string, error) {
+	switch auth.SubtypeFromId(id) {
+	case auth.OidcSubtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return "", err
+		}
+		am, err := repo.LookupAuthMethod(ctx, id)
+		if err != nil || am == nil {
+			return "", err
+		}
+		return am.GetScopeId(), nil
+	case auth.LdapSubtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return "", err
+		}
+		am, err := repo.LookupAuthMethod(ctx, id)
+		if err != nil || am == nil {
+			return "", err
+		}
+		return am.GetScopeId(), nil
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return "", err
+	}
+	am, err := repo.LookupAuthMethod(ctx, id)
+	if err != nil || am == nil {
+		return "", err
+	}
+	return am.GetScopeId(), nil
 }
 
 func toProto(in *password.Account) (*pb.Account, error) {
@@ -408,13 +730,68 @@ func toProto(in *password.Account) (*pb.Account, error) {
 	return &out, nil
 }
 
+func toOidcProto(in *oidc.Account) (*pb.Account, error) {
+	out := pb.Account{
+		Id:           in.GetPublicId(),
+		CreatedTime:  in.GetCreateTime().GetTimestamp(),
+		UpdatedTime:  in.GetUpdateTime().GetTimestamp(),
+		AuthMethodId: in.GetAuthMethodId(),
+		Version:      in.GetVersion(),
+		Type:         auth.OidcSubtype.String(),
+	}
+	if in.GetDescription() != "" {
+		out.Description = &wrapperspb.StringValue{Value: in.GetDescription()}
+	}
+	if in.GetName() != "" {
+		out.Name = &wrapperspb.StringValue{Value: in.GetName()}
+	}
+	st, err := handlers.ProtoToStruct(&pb.OidcAccountAttributes{
+		Subject:  in.GetSubject(),
+		Email:    in.GetEmail(),
+		FullName: in.GetFullName(),
+	})
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building oidc attribute struct: %v", err)
+	}
+	out.Attributes = st
+	return &out, nil
+}
+
+func toLdapProto(in *ldap.Account) (*pb.Account, error) {
+	out := pb.Account{
+		Id:           in.GetPublicId(),
+		CreatedTime:  in.GetCreateTime().GetTimestamp(),
+		UpdatedTime:  in.GetUpdateTime().GetTimestamp(),
+		AuthMethodId: in.GetAuthMethodId(),
+		Version:      in.GetVersion(),
+		Type:         auth.LdapSubtype.String(),
+	}
+	if in.GetDescription() != "" {
+		out.Description = &wrapperspb.StringValue{Value: in.GetDescription()}
+	}
+	if in.GetName() != "" {
+		out.Name = &wrapperspb.StringValue{Value: in.GetName()}
+	}
+	st, err := handlers.ProtoToStruct(&pb.LdapAccountAttributes{
+		LoginName: in.GetLoginName(),
+		Dn:        in.GetDn(),
+		Email:     in.GetEmail(),
+		FullName:  in.GetFullName(),
+	})
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building ldap attribute struct: %v", err)
+	}
+	out.Attributes = st
+	return &out, nil
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //  * The path passed in is correctly formatted
 //  * All required parameters are set
 //  * There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetAccountRequest) error {
-	return handlers.ValidateGetRequest(password.AccountPrefix, req, handlers.NoopValidatorFn)
+	return handlers.ValidateGetRequest(idPrefix(req.GetId()), req, handlers.NoopValidatorFn)
 }
 
 func validateCreateRequest(req *pbs.CreateAccountRequest) error {
@@ -435,6 +812,28 @@ func validateCreateRequest(req *pbs.CreateAccountRequest) error {
 			if pwAttrs.GetLoginName() == "" {
 				badFields["login_name"] = "This is a required field for this type."
 			}
+		case auth.OidcSubtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != auth.OidcSubtype.String() {
+				badFields["type"] = "Doesn't match the parent resource's type."
+			}
+			oidcAttrs := &pb.OidcAccountAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), oidcAttrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+			}
+			if oidcAttrs.GetSubject() == "" {
+				badFields["attributes.subject"] = "This is a required field for this type."
+			}
+		case auth.LdapSubtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != auth.LdapSubtype.String() {
+				badFields["type"] = "Doesn't match the parent resource's type."
+			}
+			ldapAttrs := &pb.LdapAccountAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), ldapAttrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+			}
+			if strings.TrimSpace(ldapAttrs.GetLoginName()) == "" {
+				badFields["attributes.login_name"] = "This is a required field for this type."
+			}
 		default:
 			badFields["auth_method_id"] = "Unknown auth method type from ID."
 		}
@@ -443,7 +842,7 @@ func validateCreateRequest(req *pbs.CreateAccountRequest) error {
 }
 
 func validateUpdateRequest(req *pbs.UpdateAccountRequest) error {
-	return handlers.ValidateUpdateRequest(password.AccountPrefix, req, req.GetItem(), func() map[string]string {
+	return handlers.ValidateUpdateRequest(idPrefix(req.GetId()), req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
 		switch auth.SubtypeFromId(req.GetId()) {
 		case auth.PasswordSubtype:
@@ -454,20 +853,40 @@ func validateUpdateRequest(req *pbs.UpdateAccountRequest) error {
 			if err := handlers.StructToProto(req.GetItem().GetAttributes(), pwAttrs); err != nil {
 				badFields["attributes"] = "Attribute fields do not match the expected format."
 			}
+		case auth.OidcSubtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != auth.OidcSubtype.String() {
+				badFields["type"] = "Cannot modify the resource type."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "attributes.subject") {
+				badFields["attributes.subject"] = "The subject of an oidc account cannot be modified."
+			}
+		case auth.LdapSubtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != auth.LdapSubtype.String() {
+				badFields["type"] = "Cannot modify the resource type."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "attributes.login_name") {
+				badFields["attributes.login_name"] = "The login name of an ldap account cannot be modified."
+			}
 		}
 		return badFields
 	})
 }
 
 func validateDeleteRequest(req *pbs.DeleteAccountRequest) error {
-	return handlers.ValidateDeleteRequest(password.AccountPrefix, req, handlers.NoopValidatorFn)
+	return handlers.ValidateDeleteRequest(idPrefix(req.GetId()), req, handlers.NoopValidatorFn)
 }
 
 func validateListRequest(req *pbs.ListAccountsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(password.AuthMethodPrefix, req.GetAuthMethodId()) {
+	if !handlers.ValidId(authMethodIdPrefix(req.GetAuthMethodId()), req.GetAuthMethodId()) {
 		badFields["auth_method_id"] = "Invalid formatted identifier."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("Must be at most %d.", handlers.MaxPageSize)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
 	}
@@ -507,3 +926,27 @@ func validateSetPasswordRequest(req *pbs.SetPasswordRequest) error {
 	}
 	return nil
 }
+
+// idPrefix returns the account prefix for the subtype of id so the id can be
+// validated against it.
+func idPrefix(id string) string {
+	switch auth.SubtypeFromId(id) {
+	case auth.OidcSubtype:
+		return oidc.AccountPrefix
+	case auth.LdapSubtype:
+		return ldap.AccountPrefix
+	}
+	return password.AccountPrefix
+}
+
+// authMethodIdPrefix returns the auth method prefix for the subtype of id
+// so the id can be validated against it.
+func authMethodIdPrefix(id string) string {
+	switch auth.SubtypeFromId(id) {
+	case auth.OidcSubtype:
+		return oidc.AuthMethodPrefix
+	case auth.LdapSubtype:
+		return ldap.AuthMethodPrefix
+	}
+	return password.AuthMethodPrefix
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/db"
@@ -37,8 +39,14 @@ func TestGet(t *testing.T) {
 	repoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 
-	s, err := accounts.NewService(repoFn)
+	s, err := accounts.NewService(repoFn, oidcRepoFn, ldapRepoFn)
 	require.NoError(t, err, "Couldn't create new auth token service.")
 
 	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -107,6 +115,12 @@ func TestList(t *testing.T) {
 	repoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 	ams := password.TestAuthMethods(t, conn, o.GetPublicId(), 3)
@@ -170,7 +184,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := accounts.NewService(repoFn)
+			s, err := accounts.NewService(repoFn, oidcRepoFn, ldapRepoFn)
 			require.NoError(err, "Couldn't create new user service.")
 
 			got, gErr := s.ListAccounts(auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId())), &pbs.ListAccountsRequest{AuthMethodId: tc.authMethod})
@@ -191,12 +205,18 @@ func TestDelete(t *testing.T) {
 	repoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 	am1 := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
 	ac := password.TestAccounts(t, conn, am1.GetPublicId(), 1)[0]
 
-	s, err := accounts.NewService(repoFn)
+	s, err := accounts.NewService(repoFn, oidcRepoFn, ldapRepoFn)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	cases := []struct {
@@ -250,12 +270,18 @@ func TestDelete_twice(t *testing.T) {
 	repoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
 	ac := password.TestAccounts(t, conn, am.GetPublicId(), 1)[0]
 
-	s, err := accounts.NewService(repoFn)
+	s, err := accounts.NewService(repoFn, oidcRepoFn, ldapRepoFn)
 	require.NoError(err, "Error when getting new user service")
 	req := &pbs.DeleteAccountRequest{
 		Id: ac.GetPublicId(),
@@ -275,8 +301,14 @@ func TestCreate(t *testing.T) {
 	repoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 
-	s, err := accounts.NewService(repoFn)
+	s, err := accounts.NewService(repoFn, oidcRepoFn, ldapRepoFn)
 	require.NoError(t, err, "Error when getting new account service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -467,10 +499,16 @@ func TestUpdate(t *testing.T) {
 	repoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
-	tested, err := accounts.NewService(repoFn)
+	tested, err := accounts.NewService(repoFn, oidcRepoFn, ldapRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType()}
@@ -819,9 +857,15 @@ func TestSetPassword(t *testing.T) {
 	repoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
-	tested, err := accounts.NewService(repoFn)
+	tested, err := accounts.NewService(repoFn, oidcRepoFn, ldapRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	createAccount := func(t *testing.T, pw string) *pb.Account {
@@ -932,9 +976,15 @@ func TestChangePassword(t *testing.T) {
 	repoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
-	tested, err := accounts.NewService(repoFn)
+	tested, err := accounts.NewService(repoFn, oidcRepoFn, ldapRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	createAccount := func(t *testing.T, pw string) *pb.Account {
@@ -1066,3 +1116,145 @@ func TestChangePassword(t *testing.T) {
 		})
 	}
 }
+
+func TestOidcAccount(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	repoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
+
+	s, err := accounts.NewService(repoFn, oidcRepoFn, ldapRepoFn)
+	require.NoError(err, "Error when getting new account service.")
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	am := oidc.TestAuthMethods(t, conn, kms, o.GetPublicId(), "https://accounts.example.com", 1)[0]
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId()))
+
+	attrs, err := handlers.ProtoToStruct(&pb.OidcAccountAttributes{Subject: "alice"})
+	require.NoError(err)
+	created, err := s.CreateAccount(ctx, &pbs.CreateAccountRequest{
+		Item: &pb.Account{
+			AuthMethodId: am.GetPublicId(),
+			Name:         wrapperspb.String("alice"),
+			Type:         "oidc",
+			Attributes:   attrs,
+		},
+	})
+	require.NoError(err)
+	acct := created.GetItem()
+	assert.True(strings.HasPrefix(acct.GetId(), oidc.AccountPrefix+"_"))
+	assert.Equal("oidc", acct.GetType())
+	assert.Equal("alice", acct.GetAttributes().GetFields()["subject"].GetStringValue())
+
+	_, err = s.CreateAccount(ctx, &pbs.CreateAccountRequest{
+		Item: &pb.Account{AuthMethodId: am.GetPublicId()},
+	})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "Got error %v", err)
+
+	got, err := s.GetAccount(ctx, &pbs.GetAccountRequest{Id: acct.GetId()})
+	require.NoError(err)
+	assert.Equal(acct.GetId(), got.GetItem().GetId())
+
+	list, err := s.ListAccounts(ctx, &pbs.ListAccountsRequest{AuthMethodId: am.GetPublicId()})
+	require.NoError(err)
+	assert.Len(list.GetItems(), 1)
+
+	updated, err := s.UpdateAccount(ctx, &pbs.UpdateAccountRequest{
+		Id:         acct.GetId(),
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"name"}},
+		Item:       &pb.Account{Name: wrapperspb.String("renamed"), Version: acct.GetVersion()},
+	})
+	require.NoError(err)
+	assert.Equal("renamed", updated.GetItem().GetName().GetValue())
+
+	_, err = s.UpdateAccount(ctx, &pbs.UpdateAccountRequest{
+		Id:         acct.GetId(),
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"attributes.subject"}},
+		Item:       &pb.Account{Version: updated.GetItem().GetVersion()},
+	})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "Got error %v", err)
+
+	_, err = s.DeleteAccount(ctx, &pbs.DeleteAccountRequest{Id: acct.GetId()})
+	require.NoError(err)
+}
+
+func TestLdapAccount(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	repoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
+
+	s, err := accounts.NewService(repoFn, oidcRepoFn, ldapRepoFn)
+	require.NoError(err, "Error when getting new account service.")
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	am := ldap.TestAuthMethods(t, conn, o.GetPublicId(), "ldaps://ldap.example.com", 1)[0]
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId()))
+
+	attrs, err := handlers.ProtoToStruct(&pb.LdapAccountAttributes{LoginName: "Alice"})
+	require.NoError(err)
+	created, err := s.CreateAccount(ctx, &pbs.CreateAccountRequest{
+		Item: &pb.Account{
+			AuthMethodId: am.GetPublicId(),
+			Name:         wrapperspb.String("alice"),
+			Type:         "ldap",
+			Attributes:   attrs,
+		},
+	})
+	require.NoError(err)
+	acct := created.GetItem()
+	assert.True(strings.HasPrefix(acct.GetId(), ldap.AccountPrefix+"_"))
+	assert.Equal("ldap", acct.GetType())
+	assert.Equal("alice", acct.GetAttributes().GetFields()["login_name"].GetStringValue())
+
+	_, err = s.CreateAccount(ctx, &pbs.CreateAccountRequest{
+		Item: &pb.Account{AuthMethodId: am.GetPublicId()},
+	})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "Got error %v", err)
+
+	got, err := s.GetAccount(ctx, &pbs.GetAccountRequest{Id: acct.GetId()})
+	require.NoError(err)
+	assert.Equal(acct.GetId(), got.GetItem().GetId())
+
+	list, err := s.ListAccounts(ctx, &pbs.ListAccountsRequest{AuthMethodId: am.GetPublicId()})
+	require.NoError(err)
+	assert.Len(list.GetItems(), 1)
+
+	updated, err := s.UpdateAccount(ctx, &pbs.UpdateAccountRequest{
+		Id:         acct.GetId(),
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"name"}},
+		Item:       &pb.Account{Name: wrapperspb.String("renamed"), Version: acct.GetVersion()},
+	})
+	require.NoError(err)
+	assert.Equal("renamed", updated.GetItem().GetName().GetValue())
+
+	_, err = s.UpdateAccount(ctx, &pbs.UpdateAccountRequest{
+		Id:         acct.GetId(),
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"attributes.login_name"}},
+		Item:       &pb.Account{Version: updated.GetItem().GetVersion()},
+	})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "Got error %v", err)
+
+	_, err = s.DeleteAccount(ctx, &pbs.DeleteAccountRequest{Id: acct.GetId()})
+	require.NoError(err)
+}
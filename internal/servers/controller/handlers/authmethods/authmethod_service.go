@@ -7,6 +7,10 @@ import (
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	ldapstore "github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	oidcstore "github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/auth/password/store"
 	"github.com/hashicorp/boundary/internal/authtoken"
@@ -18,21 +22,27 @@ import (
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 const (
 	loginNameKey = "login_name"
 	pwKey        = "password"
+	stateKey     = "state"
+	codeKey      = "code"
 )
 
 var (
-	maskManager handlers.MaskManager
+	maskManager     handlers.MaskManager
+	oidcMaskManager handlers.MaskManager
+	ldapMaskManager handlers.MaskManager
 )
 
 func init() {
@@ -40,28 +50,42 @@ func init() {
 	if maskManager, err = handlers.NewMaskManager(&store.AuthMethod{}, &pb.AuthMethod{}, &pb.PasswordAuthMethodAttributes{}); err != nil {
 		panic(err)
 	}
+	if oidcMaskManager, err = handlers.NewMaskManager(&oidcstore.AuthMethod{}, &pb.AuthMethod{}, &pb.OidcAuthMethodAttributes{}); err != nil {
+		panic(err)
+	}
+	if ldapMaskManager, err = handlers.NewMaskManager(&ldapstore.AuthMethod{}, &pb.AuthMethod{}, &pb.LdapAuthMethodAttributes{}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.AuthMethodServiceServer interface.
 type Service struct {
-	kms       *kms.Kms
-	pwRepoFn  common.PasswordAuthRepoFactory
-	iamRepoFn common.IamRepoFactory
-	atRepoFn  common.AuthTokenRepoFactory
+	kms        *kms.Kms
+	pwRepoFn   common.PasswordAuthRepoFactory
+	oidcRepoFn common.OidcAuthRepoFactory
+	ldapRepoFn common.LdapAuthRepoFactory
+	iamRepoFn  common.IamRepoFactory
+	atRepoFn   common.AuthTokenRepoFactory
 }
 
 // NewService returns a auth method service which handles auth method related requests to boundary.
-func NewService(kms *kms.Kms, pwRepoFn common.PasswordAuthRepoFactory, iamRepoFn common.IamRepoFactory, atRepoFn common.AuthTokenRepoFactory) (Service, error) {
+func NewService(kms *kms.Kms, pwRepoFn common.PasswordAuthRepoFactory, oidcRepoFn common.OidcAuthRepoFactory, ldapRepoFn common.LdapAuthRepoFactory, iamRepoFn common.IamRepoFactory, atRepoFn common.AuthTokenRepoFactory) (Service, error) {
 	if kms == nil {
 		return Service{}, errors.New("nil kms provided")
 	}
 	if pwRepoFn == nil {
 		return Service{}, fmt.Errorf("nil password repository provided")
 	}
+	if oidcRepoFn == nil {
+		return Service{}, fmt.Errorf("nil oidc repository provided")
+	}
+	if ldapRepoFn == nil {
+		return Service{}, fmt.Errorf("nil ldap repository provided")
+	}
 	if iamRepoFn == nil {
 		return Service{}, fmt.Errorf("nil iam repository provided")
 	}
-	return Service{kms: kms, pwRepoFn: pwRepoFn, iamRepoFn: iamRepoFn, atRepoFn: atRepoFn}, nil
+	return Service{kms: kms, pwRepoFn: pwRepoFn, oidcRepoFn: oidcRepoFn, ldapRepoFn: ldapRepoFn, iamRepoFn: iamRepoFn, atRepoFn: atRepoFn}, nil
 }
 
 var _ pbs.AuthMethodServiceServer = Service{}
@@ -72,17 +96,35 @@ func (s Service) ListAuthMethods(ctx context.Context, req *pbs.ListAuthMethodsRe
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeInfos, err := scopeids.GetListingScopeIds(ctx, s.iamRepoFn, &authResults, req.GetScopeId(), resource.AuthMethod, req.GetRecursive())
+	if err != nil {
+		return nil, err
 	}
-	ul, err := s.listFromRepo(ctx, authResults.Scope.GetId())
+	page, err := handlers.PaginateList(ctx, auth.KmsFromContext(ctx), req, resource.AuthMethod, authResults.Scope.GetId(), &authResults, handlers.MergeListPages(scopeIds, func(scopeId string, after *db.PageAfter, limit int) ([]handlers.PageItem, error) {
+		ul, err := s.listFromRepo(ctx, scopeId, after, limit)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]handlers.PageItem, 0, len(ul))
+		for _, item := range ul {
+			item.Scope = scopeInfos[scopeId]
+			items = append(items, item)
+		}
+		// The items of the repositories are merged into a single list
+		handlers.SortPageItems(items)
+		if len(items) > limit {
+			items = items[:limit]
+		}
+		return items, nil
+	}))
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range ul {
-		item.Scope = authResults.Scope
+	var ul []*pb.AuthMethod
+	for _, item := range page.Items {
+		ul = append(ul, item.(*pb.AuthMethod))
 	}
-	return &pbs.ListAuthMethodsResponse{Items: ul}, nil
+	return &pbs.ListAuthMethodsResponse{Items: ul, NextPageToken: page.NextPageToken}, nil
 }
 
 // GetAuthMethod implements the interface pbs.AuthMethodServiceServer.
@@ -161,15 +203,65 @@ func (s Service) Authenticate(ctx context.Context, req *pbs.AuthenticateRequest)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	creds := req.GetCredentials().GetFields()
-	tok, err := s.authenticateWithRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), creds[loginNameKey].GetStringValue(), creds[pwKey].GetStringValue())
+	tok, err := s.authenticateWithRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), req.GetCredentials().GetFields())
 	if err != nil {
 		return nil, err
 	}
 	return &pbs.AuthenticateResponse{Item: tok, TokenType: req.GetTokenType()}, nil
 }
 
+// AuthenticateStart implements the interface pbs.AuthMethodServiceServer.
+func (s Service) AuthenticateStart(ctx context.Context, req *pbs.AuthenticateStartRequest) (*pbs.AuthenticateStartResponse, error) {
+	if err := validateAuthenticateStartRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetAuthMethodId(), action.Authenticate)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	authURL, state, err := repo.StartAuthentication(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), req.GetRedirectUrl())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist.", req.GetAuthMethodId())
+		}
+		return nil, err
+	}
+	return &pbs.AuthenticateStartResponse{AuthUrl: authURL, State: state}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*pb.AuthMethod, error) {
+	switch auth.SubtypeFromId(id) {
+	case auth.OidcSubtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		am, err := repo.LookupAuthMethod(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if am == nil {
+			return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist.", id)
+		}
+		return toOidcAuthMethodProto(am)
+	case auth.LdapSubtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		am, err := repo.LookupAuthMethod(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if am == nil {
+			return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist.", id)
+		}
+		return toLdapAuthMethodProto(am)
+	}
 	repo, err := s.pwRepoFn()
 	if err != nil {
 		return nil, err
@@ -187,12 +279,12 @@ func (s Service) getFromRepo(ctx context.Context, id string) (*pb.AuthMethod, er
 	return toAuthMethodProto(u)
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.AuthMethod, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeId string, after *db.PageAfter, limit int) ([]*pb.AuthMethod, error) {
 	repo, err := s.pwRepoFn()
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListAuthMethods(ctx, scopeId)
+	ul, err := repo.ListAuthMethods(ctx, scopeId, password.WithPageAfter(after), password.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
@@ -204,10 +296,48 @@ func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.AuthMe
 		}
 		outUl = append(outUl, ou)
 	}
+
+	oidcRepo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	ol, err := oidcRepo.ListAuthMethods(ctx, scopeId, oidc.WithPageAfter(after), oidc.WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range ol {
+		ou, err := toOidcAuthMethodProto(o)
+		if err != nil {
+			return nil, err
+		}
+		outUl = append(outUl, ou)
+	}
+
+	ldapRepo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	ll, err := ldapRepo.ListAuthMethods(ctx, scopeId, ldap.WithPageAfter(after), ldap.WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range ll {
+		lu, err := toLdapAuthMethodProto(l)
+		if err != nil {
+			return nil, err
+		}
+		outUl = append(outUl, lu)
+	}
 	return outUl, nil
 }
 
 func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.AuthMethod) (*pb.AuthMethod, error) {
+	switch auth.SubtypeFromType(item.GetType()) {
+	case auth.OidcSubtype:
+		return s.createOidcInRepo(ctx, scopeId, item)
+	case auth.LdapSubtype:
+		return s.createLdapInRepo(ctx, scopeId, item)
+	}
 	var opts []password.Option
 	if item.GetName() != nil {
 		opts = append(opts, password.WithName(item.GetName().GetValue()))
@@ -233,7 +363,113 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Auth
 	return toAuthMethodProto(out)
 }
 
+func (s Service) createOidcInRepo(ctx context.Context, scopeId string, item *pb.AuthMethod) (*pb.AuthMethod, error) {
+	var opts []oidc.Option
+	if item.GetName() != nil {
+		opts = append(opts, oidc.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, oidc.WithDescription(item.GetDescription().GetValue()))
+	}
+	oidcAttrs := &pb.OidcAuthMethodAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), oidcAttrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	opts = append(opts,
+		oidc.WithClientSecret(oidcAttrs.GetClientSecret().GetValue()),
+		oidc.WithClaimsScopes(oidcAttrs.GetClaimsScopes()...),
+		oidc.WithEmailClaim(oidcAttrs.GetEmailClaim()),
+		oidc.WithNameClaim(oidcAttrs.GetNameClaim()),
+	)
+	am, err := oidc.NewAuthMethod(scopeId, oidcAttrs.GetIssuer(), oidcAttrs.GetClientId(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method for creation: %v.", err)
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateAuthMethod(ctx, am)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create auth method: %w", err)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create auth method but no error returned from repository.")
+	}
+	return toOidcAuthMethodProto(out)
+}
+
+func (s Service) createLdapInRepo(ctx context.Context, scopeId string, item *pb.AuthMethod) (*pb.AuthMethod, error) {
+	ldapAttrs := &pb.LdapAuthMethodAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), ldapAttrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	opts, err := ldapOptions(item, ldapAttrs)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method for creation: %v.", err)
+	}
+	am, err := ldap.NewAuthMethod(scopeId, ldapAttrs.GetUrls(), ldapAttrs.GetUserDn(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method for creation: %v.", err)
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateAuthMethod(ctx, am)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create auth method: %w", err)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create auth method but no error returned from repository.")
+	}
+	return toLdapAuthMethodProto(out)
+}
+
+// ldapOptions returns the ldap options for the name, description, and
+// attributes of item.
+func ldapOptions(item *pb.AuthMethod, attrs *pb.LdapAuthMethodAttributes) ([]ldap.Option, error) {
+	var opts []ldap.Option
+	if item.GetName() != nil {
+		opts = append(opts, ldap.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, ldap.WithDescription(item.GetDescription().GetValue()))
+	}
+	var mappings []*ldap.GroupMapping
+	for _, gm := range attrs.GetGroupMappings() {
+		m, err := ldap.NewGroupMapping(gm.GetGroup(), gm.GetGroupId())
+		if err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, m)
+	}
+	opts = append(opts,
+		ldap.WithStartTls(attrs.GetStartTls()),
+		ldap.WithInsecureTls(attrs.GetInsecureTls()),
+		ldap.WithCertificate(attrs.GetCertificate()),
+		ldap.WithBindCredential(attrs.GetBindDn(), attrs.GetBindPassword().GetValue()),
+		ldap.WithDiscoverDn(attrs.GetDiscoverDn()),
+		ldap.WithUserAttr(attrs.GetUserAttr()),
+		ldap.WithGroupDn(attrs.GetGroupDn()),
+		ldap.WithGroupAttr(attrs.GetGroupAttr()),
+		ldap.WithGroupFilter(attrs.GetGroupFilter()),
+		ldap.WithEmailAttr(attrs.GetEmailAttr()),
+		ldap.WithNameAttr(attrs.GetNameAttr()),
+		ldap.WithGroupMappings(mappings...),
+	)
+	return opts, nil
+}
+
 func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.AuthMethod) (*pb.AuthMethod, error) {
+	switch auth.SubtypeFromId(id) {
+	case auth.OidcSubtype:
+		return s.updateOidcInRepo(ctx, scopeId, id, mask, item)
+	case auth.LdapSubtype:
+		return s.updateLdapInRepo(ctx, scopeId, id, mask, item)
+	}
 	var opts []password.Option
 	if desc := item.GetDescription(); desc != nil {
 		opts = append(opts, password.WithDescription(desc.GetValue()))
@@ -278,12 +514,113 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	return toAuthMethodProto(out)
 }
 
-func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
-	repo, err := s.pwRepoFn()
+func (s Service) updateOidcInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.AuthMethod) (*pb.AuthMethod, error) {
+	var opts []oidc.Option
+	if desc := item.GetDescription(); desc != nil {
+		opts = append(opts, oidc.WithDescription(desc.GetValue()))
+	}
+	if name := item.GetName(); name != nil {
+		opts = append(opts, oidc.WithName(name.GetValue()))
+	}
+	oidcAttrs := &pb.OidcAuthMethodAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), oidcAttrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	opts = append(opts,
+		oidc.WithClientSecret(oidcAttrs.GetClientSecret().GetValue()),
+		oidc.WithClaimsScopes(oidcAttrs.GetClaimsScopes()...),
+		oidc.WithEmailClaim(oidcAttrs.GetEmailClaim()),
+		oidc.WithNameClaim(oidcAttrs.GetNameClaim()),
+	)
+	am, err := oidc.NewAuthMethod(scopeId, oidcAttrs.GetIssuer(), oidcAttrs.GetClientId(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method for update: %v.", err)
+	}
+	version := item.GetVersion()
+
+	am.PublicId = id
+	dbMask := oidcMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, rowsUpdated, err := repo.UpdateAuthMethod(ctx, am, version, dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update auth method: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist or incorrect version provided.", id)
+	}
+	return toOidcAuthMethodProto(out)
+}
+
+func (s Service) updateLdapInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.AuthMethod) (*pb.AuthMethod, error) {
+	ldapAttrs := &pb.LdapAuthMethodAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), ldapAttrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	opts, err := ldapOptions(item, ldapAttrs)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method for update: %v.", err)
+	}
+	am, err := ldap.NewAuthMethod(scopeId, ldapAttrs.GetUrls(), ldapAttrs.GetUserDn(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method for update: %v.", err)
+	}
+	version := item.GetVersion()
+
+	am.PublicId = id
+	dbMask := ldapMaskManager.Translate(mask)
+	if handlers.MaskContains(mask, "attributes.group_mappings") {
+		// Group mappings are stored in their own table and have no column
+		// in the auth method to map onto.
+		dbMask = append(dbMask, "GroupMappings")
+	}
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.ldapRepoFn()
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+	out, rowsUpdated, err := repo.UpdateAuthMethod(ctx, am, version, dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update auth method: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist or incorrect version provided.", id)
+	}
+	return toLdapAuthMethodProto(out)
+}
+
+func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
+	var rows int
+	var err error
+	switch auth.SubtypeFromId(id) {
+	case auth.OidcSubtype:
+		repo, rErr := s.oidcRepoFn()
+		if rErr != nil {
+			return false, rErr
+		}
+		rows, err = repo.DeleteAuthMethod(ctx, scopeId, id)
+	case auth.LdapSubtype:
+		repo, rErr := s.ldapRepoFn()
+		if rErr != nil {
+			return false, rErr
+		}
+		rows, err = repo.DeleteAuthMethod(ctx, scopeId, id)
+	default:
+		repo, rErr := s.pwRepoFn()
+		if rErr != nil {
+			return false, rErr
+		}
+		rows, err = repo.DeleteAuthMethod(ctx, scopeId, id)
 	}
-	rows, err := repo.DeleteAuthMethod(ctx, scopeId, id)
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
 			return false, nil
@@ -293,7 +630,7 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	return rows > 0, nil
 }
 
-func (s Service) authenticateWithRepo(ctx context.Context, scopeId, authMethodId, loginName, pw string) (*pba.AuthToken, error) {
+func (s Service) authenticateWithRepo(ctx context.Context, scopeId, authMethodId string, creds map[string]*structpb.Value) (*pba.AuthToken, error) {
 	iamRepo, err := s.iamRepoFn()
 	if err != nil {
 		return nil, err
@@ -302,24 +639,25 @@ func (s Service) authenticateWithRepo(ctx context.Context, scopeId, authMethodId
 	if err != nil {
 		return nil, err
 	}
-	pwRepo, err := s.pwRepoFn()
-	if err != nil {
-		return nil, err
-	}
 
-	acct, err := pwRepo.Authenticate(ctx, scopeId, authMethodId, loginName, pw)
+	acctId, groups, err := s.authenticateAccount(ctx, scopeId, authMethodId, creds)
 	if err != nil {
 		return nil, err
 	}
-	if acct == nil {
+	if acctId == "" {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
 	}
 
-	u, err := iamRepo.LookupUserWithLogin(ctx, acct.GetPublicId(), iam.WithAutoVivify(true))
+	u, err := iamRepo.LookupUserWithLogin(ctx, acctId, iam.WithAutoVivify(true))
 	if err != nil {
 		return nil, err
 	}
-	tok, err := atRepo.CreateAuthToken(ctx, u, acct.GetPublicId())
+	if auth.SubtypeFromId(authMethodId) == auth.LdapSubtype {
+		if err := s.syncLdapGroups(ctx, iamRepo, authMethodId, u.GetPublicId(), groups); err != nil {
+			return nil, err
+		}
+	}
+	tok, err := atRepo.CreateAuthToken(ctx, u, acctId)
 	if err != nil {
 		return nil, err
 	}
@@ -348,6 +686,105 @@ func (s Service) authenticateWithRepo(ctx context.Context, scopeId, authMethodId
 	return prot, nil
 }
 
+// authenticateAccount authenticates creds with the auth method authMethodId
+// and returns the id of the authenticated account or an empty string if the
+// credentials are not valid. For ldap auth methods the names of the
+// directory groups of the account are returned as well.
+func (s Service) authenticateAccount(ctx context.Context, scopeId, authMethodId string, creds map[string]*structpb.Value) (string, []string, error) {
+	switch auth.SubtypeFromId(authMethodId) {
+	case auth.OidcSubtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return "", nil, err
+		}
+		acct, err := repo.Authenticate(ctx, scopeId, authMethodId, creds[stateKey].GetStringValue(), creds[codeKey].GetStringValue())
+		switch {
+		case errors.Is(err, oidc.ErrInvalidState), errors.Is(err, oidc.ErrAuthenticationFailed):
+			return "", nil, nil
+		case err != nil:
+			return "", nil, err
+		}
+		return acct.GetPublicId(), nil, nil
+	case auth.LdapSubtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return "", nil, err
+		}
+		acct, groups, err := repo.Authenticate(ctx, scopeId, authMethodId, creds[loginNameKey].GetStringValue(), creds[pwKey].GetStringValue())
+		switch {
+		case errors.Is(err, ldap.ErrAuthenticationFailed):
+			return "", nil, nil
+		case err != nil:
+			return "", nil, err
+		}
+		return acct.GetPublicId(), groups, nil
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return "", nil, err
+	}
+	acct, err := repo.Authenticate(ctx, scopeId, authMethodId, creds[loginNameKey].GetStringValue(), creds[pwKey].GetStringValue())
+	if err != nil || acct == nil {
+		return "", nil, err
+	}
+	return acct.GetPublicId(), nil, nil
+}
+
+// syncLdapGroups makes the user userId a member of the iam groups the group
+// mappings of the ldap auth method authMethodId map any of the directory
+// groups onto and removes the user from the other mapped iam groups.
+// Memberships of iam groups which are not mapped are not changed.
+func (s Service) syncLdapGroups(ctx context.Context, iamRepo *iam.Repository, authMethodId, userId string, groups []string) error {
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return err
+	}
+	memberOf, notMemberOf, err := repo.MappedGroups(ctx, authMethodId, groups)
+	if err != nil {
+		return err
+	}
+	isMember := func(groupId string) (bool, uint32, error) {
+		g, members, err := iamRepo.LookupGroup(ctx, groupId)
+		if err != nil {
+			return false, 0, err
+		}
+		if g == nil {
+			return false, 0, fmt.Errorf("mapped group %q not found", groupId)
+		}
+		for _, m := range members {
+			if m.GetMemberId() == userId {
+				return true, g.GetVersion(), nil
+			}
+		}
+		return false, g.GetVersion(), nil
+	}
+	for _, id := range memberOf {
+		member, version, err := isMember(id)
+		if err != nil {
+			return fmt.Errorf("unable to sync ldap groups: %w", err)
+		}
+		if member {
+			continue
+		}
+		if _, err := iamRepo.AddGroupMembers(ctx, id, version, []string{userId}); err != nil {
+			return fmt.Errorf("unable to sync ldap groups: %w", err)
+		}
+	}
+	for _, id := range notMemberOf {
+		member, version, err := isMember(id)
+		if err != nil {
+			return fmt.Errorf("unable to sync ldap groups: %w", err)
+		}
+		if !member {
+			continue
+		}
+		if _, err := iamRepo.DeleteGroupMembers(ctx, id, version, []string{userId}); err != nil {
+			return fmt.Errorf("unable to sync ldap groups: %w", err)
+		}
+	}
+	return nil
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 
@@ -371,27 +808,58 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			return res
 		}
 	default:
-		repo, err := s.pwRepoFn()
+		scopeId, err := s.authMethodScopeId(ctx, id)
 		if err != nil {
 			res.Error = err
 			return res
 		}
-		authMeth, err := repo.LookupAuthMethod(ctx, id)
-		if err != nil {
-			res.Error = err
-			return res
-		}
-		if authMeth == nil {
+		if scopeId == "" {
 			res.Error = handlers.NotFoundError()
 			return res
 		}
-		parentId = authMeth.GetScopeId()
+		parentId = scopeId
 		opts = append(opts, auth.WithId(id))
 	}
 	opts = append(opts, auth.WithScopeId(parentId))
 	return auth.Verify(ctx, opts...)
 }
 
+// authMethodScopeId returns the scope id of the auth method id or an empty
+// string if the auth method doesn't exist.
+func (s Service) authMethodScopeId(ctx context.Context, id string) (string, error) {
+	switch auth.SubtypeFromId(id) {
+	case auth.OidcSubtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return "", err
+		}
+		am, err := repo.LookupAuthMethod(ctx, id)
+		if err != nil || am == nil {
+			return "", err
+		}
+		return am.GetScopeId(), nil
+	case auth.LdapSubtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return "", err
+		}
+		am, err := repo.LookupAuthMethod(ctx, id)
+		if err != nil || am == nil {
+			return "", err
+		}
+		return am.GetScopeId(), nil
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return "", err
+	}
+	am, err := repo.LookupAuthMethod(ctx, id)
+	if err != nil || am == nil {
+		return "", err
+	}
+	return am.GetScopeId(), nil
+}
+
 func toAuthMethodProto(in *password.AuthMethod) (*pb.AuthMethod, error) {
 	out := pb.AuthMethod{
 		Id:          in.GetPublicId(),
@@ -418,6 +886,83 @@ func toAuthMethodProto(in *password.AuthMethod) (*pb.AuthMethod, error) {
 	return &out, nil
 }
 
+// toOidcAuthMethodProto converts an oidc auth method to its API
+// representation. The client secret is never returned.
+func toOidcAuthMethodProto(in *oidc.AuthMethod) (*pb.AuthMethod, error) {
+	out := pb.AuthMethod{
+		Id:          in.GetPublicId(),
+		ScopeId:     in.GetScopeId(),
+		CreatedTime: in.GetCreateTime().GetTimestamp(),
+		UpdatedTime: in.GetUpdateTime().GetTimestamp(),
+		Version:     in.GetVersion(),
+		Type:        auth.OidcSubtype.String(),
+	}
+	if in.GetDescription() != "" {
+		out.Description = wrapperspb.String(in.GetDescription())
+	}
+	if in.GetName() != "" {
+		out.Name = wrapperspb.String(in.GetName())
+	}
+	st, err := handlers.ProtoToStruct(&pb.OidcAuthMethodAttributes{
+		Issuer:       in.GetIssuer(),
+		ClientId:     in.GetClientId(),
+		ClaimsScopes: in.Scopes(),
+		EmailClaim:   in.GetEmailClaim(),
+		NameClaim:    in.GetNameClaim(),
+	})
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building oidc attribute struct: %v", err)
+	}
+	out.Attributes = st
+	return &out, nil
+}
+
+// toLdapAuthMethodProto converts an ldap auth method to its API
+// representation. The bind password is never returned.
+func toLdapAuthMethodProto(in *ldap.AuthMethod) (*pb.AuthMethod, error) {
+	out := pb.AuthMethod{
+		Id:          in.GetPublicId(),
+		ScopeId:     in.GetScopeId(),
+		CreatedTime: in.GetCreateTime().GetTimestamp(),
+		UpdatedTime: in.GetUpdateTime().GetTimestamp(),
+		Version:     in.GetVersion(),
+		Type:        auth.LdapSubtype.String(),
+	}
+	if in.GetDescription() != "" {
+		out.Description = wrapperspb.String(in.GetDescription())
+	}
+	if in.GetName() != "" {
+		out.Name = wrapperspb.String(in.GetName())
+	}
+	attrs := &pb.LdapAuthMethodAttributes{
+		Urls:        in.UrlList(),
+		StartTls:    in.GetStartTls(),
+		InsecureTls: in.GetInsecureTls(),
+		Certificate: in.GetCertificate(),
+		BindDn:      in.GetBindDn(),
+		DiscoverDn:  in.GetDiscoverDn(),
+		UserDn:      in.GetUserDn(),
+		UserAttr:    in.GetUserAttr(),
+		GroupDn:     in.GetGroupDn(),
+		GroupAttr:   in.GetGroupAttr(),
+		GroupFilter: in.GetGroupFilter(),
+		EmailAttr:   in.GetEmailAttr(),
+		NameAttr:    in.GetNameAttr(),
+	}
+	for _, m := range in.GroupMappings {
+		attrs.GroupMappings = append(attrs.GroupMappings, &pb.LdapGroupMapping{
+			Group:   m.GetGroupName(),
+			GroupId: m.GetGroupId(),
+		})
+	}
+	st, err := handlers.ProtoToStruct(attrs)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building ldap attribute struct: %v", err)
+	}
+	out.Attributes = st
+	return &out, nil
+}
+
 func toAuthTokenProto(t *authtoken.AuthToken) *pba.AuthToken {
 	return &pba.AuthToken{
 		Id:                      t.GetPublicId(),
@@ -437,8 +982,10 @@ func toAuthTokenProto(t *authtoken.AuthToken) *pba.AuthToken {
 //  * The path passed in is correctly formatted
 //  * All required parameters are set
 //  * There are no conflicting parameters provided
+//  * The type asserted by the ID and/or field is known
+//  * If relevant, the type derived from the id prefix matches what is claimed by the type field
 func validateGetRequest(req *pbs.GetAuthMethodRequest) error {
-	return handlers.ValidateGetRequest(password.AuthMethodPrefix, req, handlers.NoopValidatorFn)
+	return handlers.ValidateGetRequest(idPrefix(req.GetId()), req, handlers.NoopValidatorFn)
 }
 
 func validateCreateRequest(req *pbs.CreateAuthMethodRequest) error {
@@ -454,15 +1001,37 @@ func validateCreateRequest(req *pbs.CreateAuthMethodRequest) error {
 			if err := handlers.StructToProto(req.GetItem().GetAttributes(), pwAttrs); err != nil {
 				badFields["attributes"] = "Attribute fields do not match the expected format."
 			}
+		case auth.OidcSubtype:
+			oidcAttrs := &pb.OidcAuthMethodAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), oidcAttrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+				break
+			}
+			if err := oidc.ValidateIssuer(oidcAttrs.GetIssuer()); err != nil {
+				badFields["attributes.issuer"] = "This is a required field and must be an https URL or an http URL on a loopback address."
+			}
+			if strings.TrimSpace(oidcAttrs.GetClientId()) == "" {
+				badFields["attributes.client_id"] = "This is a required field."
+			}
+			if oidcAttrs.GetClientSecret().GetValue() == "" {
+				badFields["attributes.client_secret"] = "This is a required field."
+			}
+		case auth.LdapSubtype:
+			ldapAttrs := &pb.LdapAuthMethodAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), ldapAttrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+				break
+			}
+			validateLdapAttributes(ldapAttrs, nil, badFields)
 		default:
-			badFields["type"] = fmt.Sprintf("This is a required field and must be %q.", auth.PasswordSubtype.String())
+			badFields["type"] = fmt.Sprintf("This is a required field and must be %q, %q, or %q.", auth.PasswordSubtype.String(), auth.OidcSubtype.String(), auth.LdapSubtype.String())
 		}
 		return badFields
 	})
 }
 
 func validateUpdateRequest(req *pbs.UpdateAuthMethodRequest) error {
-	return handlers.ValidateUpdateRequest(password.AuthMethodPrefix, req, req.GetItem(), func() map[string]string {
+	return handlers.ValidateUpdateRequest(idPrefix(req.GetId()), req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
 		switch auth.SubtypeFromId(req.GetId()) {
 		case auth.PasswordSubtype:
@@ -473,6 +1042,37 @@ func validateUpdateRequest(req *pbs.UpdateAuthMethodRequest) error {
 			if err := handlers.StructToProto(req.GetItem().GetAttributes(), pwAttrs); err != nil {
 				badFields["attributes"] = "Attribute fields do not match the expected format."
 			}
+		case auth.OidcSubtype:
+			if req.GetItem().GetType() != "" && auth.SubtypeFromType(req.GetItem().GetType()) != auth.OidcSubtype {
+				badFields["type"] = "Cannot modify the resource type."
+			}
+			oidcAttrs := &pb.OidcAuthMethodAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), oidcAttrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+				break
+			}
+			paths := req.GetUpdateMask().GetPaths()
+			if handlers.MaskContains(paths, "attributes.issuer") {
+				if err := oidc.ValidateIssuer(oidcAttrs.GetIssuer()); err != nil {
+					badFields["attributes.issuer"] = "This field cannot be unset and must be an https URL or an http URL on a loopback address."
+				}
+			}
+			if handlers.MaskContains(paths, "attributes.client_id") && strings.TrimSpace(oidcAttrs.GetClientId()) == "" {
+				badFields["attributes.client_id"] = "This field cannot be unset."
+			}
+			if handlers.MaskContains(paths, "attributes.client_secret") && oidcAttrs.GetClientSecret().GetValue() == "" {
+				badFields["attributes.client_secret"] = "This field cannot be unset."
+			}
+		case auth.LdapSubtype:
+			if req.GetItem().GetType() != "" && auth.SubtypeFromType(req.GetItem().GetType()) != auth.LdapSubtype {
+				badFields["type"] = "Cannot modify the resource type."
+			}
+			ldapAttrs := &pb.LdapAuthMethodAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), ldapAttrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+				break
+			}
+			validateLdapAttributes(ldapAttrs, req.GetUpdateMask().GetPaths(), badFields)
 		default:
 			badFields["id"] = "Incorrectly formatted identifier."
 		}
@@ -480,8 +1080,45 @@ func validateUpdateRequest(req *pbs.UpdateAuthMethodRequest) error {
 	})
 }
 
+// validateLdapAttributes adds the invalid fields of attrs to badFields. If
+// paths is not nil only the fields in paths are validated.
+func validateLdapAttributes(attrs *pb.LdapAuthMethodAttributes, paths []string, badFields map[string]string) {
+	check := func(f string) bool {
+		return paths == nil || handlers.MaskContains(paths, f)
+	}
+	if check("attributes.urls") {
+		if err := ldap.ValidateUrls(attrs.GetUrls()); err != nil {
+			badFields["attributes.urls"] = "This is a required field and must only contain ldap:// or ldaps:// URLs."
+		}
+	}
+	if check("attributes.user_dn") && strings.TrimSpace(attrs.GetUserDn()) == "" {
+		badFields["attributes.user_dn"] = "This is a required field."
+	}
+	if check("attributes.certificate") {
+		if err := ldap.ValidateCertificate(attrs.GetCertificate()); err != nil {
+			badFields["attributes.certificate"] = "This must be a PEM encoded x509 certificate."
+		}
+	}
+	if check("attributes.group_filter") {
+		if err := ldap.ValidateGroupFilter(attrs.GetGroupFilter()); err != nil {
+			badFields["attributes.group_filter"] = "This must be a valid LDAP search filter."
+		}
+	}
+	if paths == nil && attrs.GetBindPassword().GetValue() != "" && attrs.GetBindDn() == "" {
+		badFields["attributes.bind_password"] = "This field requires a bind_dn."
+	}
+	if check("attributes.group_mappings") {
+		for _, gm := range attrs.GetGroupMappings() {
+			if strings.TrimSpace(gm.GetGroup()) == "" || !handlers.ValidId(iam.GroupPrefix, gm.GetGroupId()) {
+				badFields["attributes.group_mappings"] = "Each group mapping must contain a group name and a valid group id."
+				break
+			}
+		}
+	}
+}
+
 func validateDeleteRequest(req *pbs.DeleteAuthMethodRequest) error {
-	return handlers.ValidateDeleteRequest(password.AuthMethodPrefix, req, handlers.NoopValidatorFn)
+	return handlers.ValidateDeleteRequest(idPrefix(req.GetId()), req, handlers.NoopValidatorFn)
 }
 
 func validateListRequest(req *pbs.ListAuthMethodsRequest) error {
@@ -490,6 +1127,12 @@ func validateListRequest(req *pbs.ListAuthMethodsRequest) error {
 		req.GetScopeId() != scope.Global.String() {
 		badFields["scope_id"] = "This field must be 'global' or a valid org scope id."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("Must be at most %d.", handlers.MaxPageSize)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
@@ -500,19 +1143,21 @@ func validateAuthenticateRequest(req *pbs.AuthenticateRequest) error {
 	badFields := make(map[string]string)
 	if strings.TrimSpace(req.GetAuthMethodId()) == "" {
 		badFields["auth_method_id"] = "This is a required field."
-	} else if !handlers.ValidId(password.AuthMethodPrefix, req.GetAuthMethodId()) {
+	} else if !handlers.ValidId(idPrefix(req.GetAuthMethodId()), req.GetAuthMethodId()) {
 		badFields["auth_method_id"] = "Invalid formatted identifier."
 	}
-	// TODO: Update this when we enable different auth method types.
 	if req.GetCredentials() == nil {
 		badFields["credentials"] = "This is a required field."
 	}
 	creds := req.GetCredentials().GetFields()
-	if _, ok := creds[loginNameKey]; !ok {
-		badFields["credentials.login_name"] = "This is a required field."
+	requiredKeys := []string{loginNameKey, pwKey}
+	if auth.SubtypeFromId(req.GetAuthMethodId()) == auth.OidcSubtype {
+		requiredKeys = []string{stateKey, codeKey}
 	}
-	if _, ok := creds[pwKey]; !ok {
-		badFields["credentials.password"] = "This is a required field."
+	for _, k := range requiredKeys {
+		if _, ok := creds[k]; !ok {
+			badFields["credentials."+k] = "This is a required field."
+		}
 	}
 	tType := strings.ToLower(strings.TrimSpace(req.GetTokenType()))
 	if tType != "" && tType != "token" && tType != "cookie" {
@@ -523,3 +1168,31 @@ func validateAuthenticateRequest(req *pbs.AuthenticateRequest) error {
 	}
 	return nil
 }
+
+func validateAuthenticateStartRequest(req *pbs.AuthenticateStartRequest) error {
+	badFields := make(map[string]string)
+	if strings.TrimSpace(req.GetAuthMethodId()) == "" {
+		badFields["auth_method_id"] = "This is a required field."
+	} else if !handlers.ValidId(oidc.AuthMethodPrefix, req.GetAuthMethodId()) {
+		badFields["auth_method_id"] = "Must be the identifier of an oidc auth method."
+	}
+	if err := oidc.ValidateRedirectURL(req.GetRedirectUrl()); err != nil {
+		badFields["redirect_url"] = "This is a required field and must be an http URL with a port on a loopback address."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
+
+// idPrefix returns the auth method prefix for the subtype of id so the id
+// can be validated against it.
+func idPrefix(id string) string {
+	switch auth.SubtypeFromId(id) {
+	case auth.OidcSubtype:
+		return oidc.AuthMethodPrefix
+	case auth.LdapSubtype:
+		return ldap.AuthMethodPrefix
+	}
+	return password.AuthMethodPrefix
+}
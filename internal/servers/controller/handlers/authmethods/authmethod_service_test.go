@@ -10,6 +10,8 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/db"
@@ -47,6 +49,12 @@ func TestGet(t *testing.T) {
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -111,7 +119,7 @@ func TestGet(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := authmethods.NewService(kms, pwRepoFn, iamRepoFn, atRepoFn)
+			s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn)
 			require.NoError(err, "Couldn't create new auth_method service.")
 
 			got, gErr := s.GetAuthMethod(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), tc.req)
@@ -135,6 +143,12 @@ func TestList(t *testing.T) {
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -208,7 +222,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := authmethods.NewService(kms, pwRepoFn, iamRepoFn, atRepoFn)
+			s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn)
 			require.NoError(err, "Couldn't create new auth_method service.")
 
 			got, gErr := s.ListAuthMethods(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), &pbs.ListAuthMethodsRequest{ScopeId: tc.scopeId})
@@ -232,6 +246,12 @@ func TestDelete(t *testing.T) {
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -240,7 +260,7 @@ func TestDelete(t *testing.T) {
 	o, _ := iam.TestScopes(t, iamRepo)
 	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
 
-	s, err := authmethods.NewService(kms, pwRepoFn, iamRepoFn, atRepoFn)
+	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	cases := []struct {
@@ -296,6 +316,12 @@ func TestDelete_twice(t *testing.T) {
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -304,7 +330,7 @@ func TestDelete_twice(t *testing.T) {
 	o, _ := iam.TestScopes(t, iamRepo)
 	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
 
-	s, err := authmethods.NewService(kms, pwRepoFn, iamRepoFn, atRepoFn)
+	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn)
 	require.NoError(err, "Error when getting new auth_method service.")
 
 	req := &pbs.DeleteAuthMethodRequest{
@@ -328,6 +354,12 @@ func TestCreate(t *testing.T) {
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -467,7 +499,7 @@ func TestCreate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := authmethods.NewService(kms, pwRepoFn, iamRepoFn, atRepoFn)
+			s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn)
 			require.NoError(err, "Error when getting new auth_method service.")
 
 			got, gErr := s.CreateAuthMethod(auth.DisabledAuthTestContext(auth.WithScopeId(tc.req.GetItem().GetScopeId())), tc.req)
@@ -510,13 +542,19 @@ func TestUpdate(t *testing.T) {
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
 	iamRepo := iam.TestRepo(t, conn, wrapper)
 
 	o, _ := iam.TestScopes(t, iamRepo)
-	tested, err := authmethods.NewService(kms, pwRepoFn, iamRepoFn, atRepoFn)
+	tested, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType()}
@@ -902,6 +940,12 @@ func TestAuthenticate(t *testing.T) {
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -1028,7 +1072,7 @@ func TestAuthenticate(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := authmethods.NewService(kms, pwRepoFn, iamRepoFn, atRepoFn)
+			s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn)
 			require.NoError(err)
 
 			resp, err := s.Authenticate(auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId())), tc.request)
@@ -1066,6 +1110,12 @@ func TestAuthenticate_AuthAccountConnectedToIamUser(t *testing.T) {
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -1085,7 +1135,7 @@ func TestAuthenticate_AuthAccountConnectedToIamUser(t *testing.T) {
 	iamUser, err := iamRepo.LookupUserWithLogin(context.Background(), acct.GetPublicId(), iam.WithAutoVivify(true))
 	require.NoError(err)
 
-	s, err := authmethods.NewService(kms, pwRepoFn, iamRepoFn, atRepoFn)
+	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn)
 	require.NoError(err)
 	resp, err := s.Authenticate(auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId())), &pbs.AuthenticateRequest{
 		AuthMethodId: am.GetPublicId(),
@@ -1108,3 +1158,216 @@ func TestAuthenticate_AuthAccountConnectedToIamUser(t *testing.T) {
 	assert.NotEmpty(aToken.GetToken())
 	assert.True(strings.HasPrefix(aToken.GetToken(), aToken.GetId()))
 }
+
+func TestAuthenticate_Oidc(t *testing.T) {
+	const redirectURL = "http://127.0.0.1:49152/callback"
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kms)
+	}
+
+	tp := oidc.NewTestProvider(t)
+	am := oidc.TestAuthMethods(t, conn, kms, o.GetPublicId(), tp.Issuer(), 1)[0]
+
+	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn)
+	require.NoError(err)
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId()))
+
+	_, err = s.AuthenticateStart(ctx, &pbs.AuthenticateStartRequest{
+		AuthMethodId: am.GetPublicId(),
+		RedirectUrl:  "https://example.com/callback",
+	})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "Got error %v", err)
+
+	start, err := s.AuthenticateStart(ctx, &pbs.AuthenticateStartRequest{
+		AuthMethodId: am.GetPublicId(),
+		RedirectUrl:  redirectURL,
+	})
+	require.NoError(err)
+	state, code := tp.Authorize(t, start.GetAuthUrl())
+	require.Equal(start.GetState(), state)
+
+	authenticate := func(state, code string) (*pbs.AuthenticateResponse, error) {
+		return s.Authenticate(ctx, &pbs.AuthenticateRequest{
+			AuthMethodId: am.GetPublicId(),
+			Credentials: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"state": structpb.NewStringValue(state),
+				"code":  structpb.NewStringValue(code),
+			}},
+		})
+	}
+	resp, err := authenticate(state, code)
+	require.NoError(err)
+	aToken := resp.GetItem()
+	assert.Equal(am.GetPublicId(), aToken.GetAuthMethodId())
+	assert.True(strings.HasPrefix(aToken.GetAccountId(), oidc.AccountPrefix))
+	assert.NotEmpty(aToken.GetUserId())
+	assert.True(strings.HasPrefix(aToken.GetToken(), aToken.GetId()))
+
+	// The state and code cannot be replayed.
+	_, err = authenticate(state, code)
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.Unauthenticated)), "Got error %v", err)
+}
+
+func TestLdap(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iamRepo)
+	admins := iam.TestGroup(t, conn, o.GetPublicId())
+	devs := iam.TestGroup(t, conn, o.GetPublicId())
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kms)
+	}
+
+	td := ldap.NewTestDirectory(t)
+	aliceDn := td.AddUser(t, "alice", "alice-pass", "Alice", "alice@example.com")
+	td.AddGroup(t, "admins", aliceDn)
+	td.AddGroup(t, "devs")
+
+	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn)
+	require.NoError(err)
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId()))
+
+	badAttrs, err := handlers.ProtoToStruct(&pb.LdapAuthMethodAttributes{
+		Urls:   []string{"https://ldap.example.com"},
+		UserDn: ldap.TestUserDn,
+	})
+	require.NoError(err)
+	_, err = s.CreateAuthMethod(ctx, &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+		ScopeId:    o.GetPublicId(),
+		Type:       "ldap",
+		Attributes: badAttrs,
+	}})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "Got error %v", err)
+
+	attrs, err := handlers.ProtoToStruct(&pb.LdapAuthMethodAttributes{
+		Urls:    []string{td.Url()},
+		UserDn:  ldap.TestUserDn,
+		GroupDn: ldap.TestGroupDn,
+		GroupMappings: []*pb.LdapGroupMapping{
+			{Group: "admins", GroupId: admins.GetPublicId()},
+			{Group: "devs", GroupId: devs.GetPublicId()},
+		},
+	})
+	require.NoError(err)
+	created, err := s.CreateAuthMethod(ctx, &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+		ScopeId:    o.GetPublicId(),
+		Name:       wrapperspb.String("directory"),
+		Type:       "ldap",
+		Attributes: attrs,
+	}})
+	require.NoError(err)
+	am := created.GetItem()
+	assert.True(strings.HasPrefix(am.GetId(), ldap.AuthMethodPrefix+"_"))
+	assert.Equal("ldap", am.GetType())
+	assert.Len(am.GetAttributes().GetFields()["group_mappings"].GetListValue().GetValues(), 2)
+
+	got, err := s.GetAuthMethod(ctx, &pbs.GetAuthMethodRequest{Id: am.GetId()})
+	require.NoError(err)
+	assert.Empty(cmp.Diff(am, got.GetItem(), protocmp.Transform()))
+
+	// A user who is not a member of the admins directory group is removed
+	// from the mapped iam group when authenticating.
+	bob := iam.TestUser(t, iamRepo, o.GetPublicId())
+	_, err = iamRepo.AddGroupMembers(context.Background(), devs.GetPublicId(), devs.GetVersion(), []string{bob.GetPublicId()})
+	require.NoError(err)
+
+	authenticate := func(loginName, pw string) (*pbs.AuthenticateResponse, error) {
+		return s.Authenticate(ctx, &pbs.AuthenticateRequest{
+			AuthMethodId: am.GetId(),
+			Credentials: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"login_name": structpb.NewStringValue(loginName),
+				"password":   structpb.NewStringValue(pw),
+			}},
+		})
+	}
+	_, err = authenticate("alice", "wrong")
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.Unauthenticated)), "Got error %v", err)
+
+	resp, err := authenticate("alice", "alice-pass")
+	require.NoError(err)
+	aToken := resp.GetItem()
+	assert.Equal(am.GetId(), aToken.GetAuthMethodId())
+	assert.True(strings.HasPrefix(aToken.GetAccountId(), ldap.AccountPrefix))
+
+	_, members, err := iamRepo.LookupGroup(context.Background(), admins.GetPublicId())
+	require.NoError(err)
+	require.Len(members, 1)
+	assert.Equal(aToken.GetUserId(), members[0].GetMemberId())
+	_, members, err = iamRepo.LookupGroup(context.Background(), devs.GetPublicId())
+	require.NoError(err)
+	require.Len(members, 1)
+	assert.Equal(bob.GetPublicId(), members[0].GetMemberId())
+
+	// Removing the mapping of the admins group and adding alice to the devs
+	// directory group moves her to the devs iam group on her next login.
+	td.AddGroup(t, "devs-2", aliceDn)
+	updAttrs, err := handlers.ProtoToStruct(&pb.LdapAuthMethodAttributes{
+		GroupMappings: []*pb.LdapGroupMapping{
+			{Group: "devs-2", GroupId: devs.GetPublicId()},
+		},
+	})
+	require.NoError(err)
+	updated, err := s.UpdateAuthMethod(ctx, &pbs.UpdateAuthMethodRequest{
+		Id:         am.GetId(),
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"attributes.group_mappings"}},
+		Item:       &pb.AuthMethod{Version: am.GetVersion(), Attributes: updAttrs},
+	})
+	require.NoError(err)
+	assert.Len(updated.GetItem().GetAttributes().GetFields()["group_mappings"].GetListValue().GetValues(), 1)
+
+	_, err = authenticate("alice", "alice-pass")
+	require.NoError(err)
+	_, members, err = iamRepo.LookupGroup(context.Background(), admins.GetPublicId())
+	require.NoError(err)
+	assert.Len(members, 1, "unmapped iam groups are not changed")
+	_, members, err = iamRepo.LookupGroup(context.Background(), devs.GetPublicId())
+	require.NoError(err)
+	assert.Len(members, 2)
+
+	list, err := s.ListAuthMethods(ctx, &pbs.ListAuthMethodsRequest{ScopeId: o.GetPublicId()})
+	require.NoError(err)
+	var found bool
+	for _, item := range list.GetItems() {
+		found = found || item.GetId() == am.GetId()
+	}
+	assert.True(found)
+
+	_, err = s.DeleteAuthMethod(ctx, &pbs.DeleteAuthMethodRequest{Id: am.GetId()})
+	require.NoError(err)
+}
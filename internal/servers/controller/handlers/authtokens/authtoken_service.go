@@ -11,6 +11,7 @@ import (
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/authtokens"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
@@ -42,17 +43,30 @@ func (s Service) ListAuthTokens(ctx context.Context, req *pbs.ListAuthTokensRequ
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeInfos, err := scopeids.GetListingScopeIds(ctx, s.iamRepoFn, &authResults, req.GetScopeId(), resource.AuthToken, req.GetRecursive())
+	if err != nil {
+		return nil, err
 	}
-	ul, err := s.listFromRepo(ctx, req.GetScopeId())
+	page, err := handlers.PaginateList(ctx, auth.KmsFromContext(ctx), req, resource.AuthToken, req.GetScopeId(), &authResults, handlers.MergeListPages(scopeIds, func(scopeId string, after *db.PageAfter, limit int) ([]handlers.PageItem, error) {
+		ul, err := s.listFromRepo(ctx, scopeId, after, limit)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]handlers.PageItem, 0, len(ul))
+		for _, item := range ul {
+			item.Scope = scopeInfos[scopeId]
+			items = append(items, item)
+		}
+		return items, nil
+	}))
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range ul {
-		item.Scope = authResults.Scope
+	var ul []*pb.AuthToken
+	for _, item := range page.Items {
+		ul = append(ul, item.(*pb.AuthToken))
 	}
-	return &pbs.ListAuthTokensResponse{Items: ul}, nil
+	return &pbs.ListAuthTokensResponse{Items: ul, NextPageToken: page.NextPageToken}, nil
 }
 
 // GetAuthToken implements the interface pbs.AuthTokenServiceServer.
@@ -121,13 +135,13 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, orgId string) ([]*pb.AuthToken, error) {
+func (s Service) listFromRepo(ctx context.Context, orgId string, after *db.PageAfter, limit int) ([]*pb.AuthToken, error) {
 	repo, err := s.repoFn()
 	_ = repo
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListAuthTokens(ctx, orgId)
+	ul, err := repo.ListAuthTokens(ctx, orgId, authtoken.WithPageAfter(after), authtoken.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
@@ -216,6 +230,12 @@ func validateListRequest(req *pbs.ListAuthTokensRequest) error {
 		req.GetScopeId() != scope.Global.String() {
 		badFields["scope_id"] = "This field must be 'global' or a valid org scope id."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("Must be at most %d.", handlers.MaxPageSize)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
@@ -0,0 +1,363 @@
+package credential_stores
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/store"
+	"github.com/hashicorp/boundary/internal/db"
+	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/credentialstores"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	maskManager handlers.MaskManager
+)
+
+func init() {
+	var err error
+	if maskManager, err = handlers.NewMaskManager(&store.CredentialStore{}, &pb.CredentialStore{}); err != nil {
+		panic(err)
+	}
+}
+
+type Service struct {
+	repoFn    common.CredentialRepoFactory
+	iamRepoFn common.IamRepoFactory
+}
+
+var _ pbs.CredentialStoreServiceServer = Service{}
+
+// NewService returns a credential store Service which handles credential store related requests to boundary and uses the provided
+// repositories for storage and retrieval.
+func NewService(repoFn common.CredentialRepoFactory, iamRepoFn common.IamRepoFactory) (Service, error) {
+	if repoFn == nil {
+		return Service{}, fmt.Errorf("nil credential repository provided")
+	}
+	if iamRepoFn == nil {
+		return Service{}, fmt.Errorf("nil iam repository provided")
+	}
+	return Service{repoFn: repoFn, iamRepoFn: iamRepoFn}, nil
+}
+
+// ListCredentialStores implements the interface pbs.CredentialStoreServiceServer.
+func (s Service) ListCredentialStores(ctx context.Context, req *pbs.ListCredentialStoresRequest) (*pbs.ListCredentialStoresResponse, error) {
+	if err := validateListRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
+	scopeIds, scopeInfos, err := scopeids.GetListingScopeIds(ctx, s.iamRepoFn, &authResults, req.GetScopeId(), resource.CredentialStore, req.GetRecursive())
+	if err != nil {
+		return nil, err
+	}
+	page, err := handlers.PaginateList(ctx, auth.KmsFromContext(ctx), req, resource.CredentialStore, authResults.Scope.GetId(), &authResults, handlers.MergeListPages(scopeIds, func(scopeId string, after *db.PageAfter, limit int) ([]handlers.PageItem, error) {
+		ul, err := s.listFromRepo(ctx, scopeId, after, limit)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]handlers.PageItem, 0, len(ul))
+		for _, item := range ul {
+			item.Scope = scopeInfos[scopeId]
+			items = append(items, item)
+		}
+		return items, nil
+	}))
+	if err != nil {
+		return nil, err
+	}
+	var ul []*pb.CredentialStore
+	for _, item := range page.Items {
+		ul = append(ul, item.(*pb.CredentialStore))
+	}
+	return &pbs.ListCredentialStoresResponse{Items: ul, NextPageToken: page.NextPageToken}, nil
+}
+
+// GetCredentialStore implements the interface pbs.CredentialStoreServiceServer.
+func (s Service) GetCredentialStore(ctx context.Context, req *pbs.GetCredentialStoreRequest) (*pbs.GetCredentialStoreResponse, error) {
+	if err := validateGetRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	cs, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	cs.Scope = authResults.Scope
+	return &pbs.GetCredentialStoreResponse{Item: cs}, nil
+}
+
+// CreateCredentialStore implements the interface pbs.CredentialStoreServiceServer.
+func (s Service) CreateCredentialStore(ctx context.Context, req *pbs.CreateCredentialStoreRequest) (*pbs.CreateCredentialStoreResponse, error) {
+	if err := validateCreateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetItem().GetScopeId(), action.Create)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	cs, err := s.createInRepo(ctx, authResults.Scope.GetId(), req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+	cs.Scope = authResults.Scope
+	return &pbs.CreateCredentialStoreResponse{
+		Item: cs,
+		Uri:  fmt.Sprintf("credential-stores/%s", cs.GetId()),
+	}, nil
+}
+
+// UpdateCredentialStore implements the interface pbs.CredentialStoreServiceServer.
+func (s Service) UpdateCredentialStore(ctx context.Context, req *pbs.UpdateCredentialStoreRequest) (*pbs.UpdateCredentialStoreResponse, error) {
+	if err := validateUpdateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Update)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	cs, err := s.updateInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+	cs.Scope = authResults.Scope
+	return &pbs.UpdateCredentialStoreResponse{Item: cs}, nil
+}
+
+// DeleteCredentialStore implements the interface pbs.CredentialStoreServiceServer.
+func (s Service) DeleteCredentialStore(ctx context.Context, req *pbs.DeleteCredentialStoreRequest) (*pbs.DeleteCredentialStoreResponse, error) {
+	if err := validateDeleteRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Delete)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	_, err := s.deleteFromRepo(ctx, authResults.Scope.GetId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.DeleteCredentialStoreResponse{}, nil
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (*pb.CredentialStore, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	cs, err := repo.LookupCredentialStore(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if cs == nil {
+		return nil, handlers.NotFoundErrorf("Credential Store %q doesn't exist.", id)
+	}
+	return toProto(cs), nil
+}
+
+func (s Service) listFromRepo(ctx context.Context, scopeId string, after *db.PageAfter, limit int) ([]*pb.CredentialStore, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	ul, err := repo.ListCredentialStores(ctx, scopeId, credential.WithPageAfter(after), credential.WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	var outUl []*pb.CredentialStore
+	for _, u := range ul {
+		outUl = append(outUl, toProto(u))
+	}
+	return outUl, nil
+}
+
+func (s Service) createInRepo(ctx context.Context, projId string, item *pb.CredentialStore) (*pb.CredentialStore, error) {
+	var opts []credential.Option
+	if item.GetName() != nil {
+		opts = append(opts, credential.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, credential.WithDescription(item.GetDescription().GetValue()))
+	}
+	cs, err := credential.NewCredentialStore(projId, opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build credential store for creation: %v.", err)
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateCredentialStore(ctx, cs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create credential store: %w", err)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential store but no error returned from repository.")
+	}
+	return toProto(out), nil
+}
+
+func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []string, item *pb.CredentialStore) (*pb.CredentialStore, error) {
+	var opts []credential.Option
+	if desc := item.GetDescription(); desc != nil {
+		opts = append(opts, credential.WithDescription(desc.GetValue()))
+	}
+	if name := item.GetName(); name != nil {
+		opts = append(opts, credential.WithName(name.GetValue()))
+	}
+	version := item.GetVersion()
+	cs, err := credential.NewCredentialStore(projId, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build credential store for update: %w", err)
+	}
+	cs.PublicId = id
+	dbMask := maskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, rowsUpdated, err := repo.UpdateCredentialStore(ctx, cs, version, dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update credential store: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Credential Store %q doesn't exist or incorrect version provided.", id)
+	}
+	return toProto(out), nil
+}
+
+func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return false, err
+	}
+	rows, err := repo.DeleteCredentialStore(ctx, scopeId, id)
+	if err != nil {
+		return false, fmt.Errorf("unable to delete credential store: %w", err)
+	}
+	return rows > 0, nil
+}
+
+func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
+	res := auth.VerifyResults{}
+
+	var parentId string
+	opts := []auth.Option{auth.WithType(resource.CredentialStore), auth.WithAction(a)}
+	switch a {
+	case action.List, action.Create:
+		parentId = id
+		iamRepo, err := s.iamRepoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		scp, err := iamRepo.LookupScope(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if scp == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+	default:
+		repo, err := s.repoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		cs, err := repo.LookupCredentialStore(ctx, id)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if cs == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		parentId = cs.GetScopeId()
+		opts = append(opts, auth.WithId(id))
+	}
+	opts = append(opts, auth.WithScopeId(parentId))
+	return auth.Verify(ctx, opts...)
+}
+
+func toProto(in *credential.CredentialStore) *pb.CredentialStore {
+	out := pb.CredentialStore{
+		Id:          in.GetPublicId(),
+		ScopeId:     in.GetScopeId(),
+		CreatedTime: in.GetCreateTime().GetTimestamp(),
+		UpdatedTime: in.GetUpdateTime().GetTimestamp(),
+		Version:     in.GetVersion(),
+	}
+	if in.GetDescription() != "" {
+		out.Description = &wrapperspb.StringValue{Value: in.GetDescription()}
+	}
+	if in.GetName() != "" {
+		out.Name = &wrapperspb.StringValue{Value: in.GetName()}
+	}
+	return &out
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//  * The path passed in is correctly formatted
+//  * All required parameters are set
+//  * There are no conflicting parameters provided
+func validateGetRequest(req *pbs.GetCredentialStoreRequest) error {
+	return handlers.ValidateGetRequest(credential.CredentialStorePrefix, req, handlers.NoopValidatorFn)
+}
+
+func validateCreateRequest(req *pbs.CreateCredentialStoreRequest) error {
+	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
+		badFields := map[string]string{}
+		if !handlers.ValidId(scope.Project.Prefix(), req.GetItem().GetScopeId()) {
+			badFields["scope_id"] = "This field must be a valid project scope id."
+		}
+		return badFields
+	})
+}
+
+func validateUpdateRequest(req *pbs.UpdateCredentialStoreRequest) error {
+	return handlers.ValidateUpdateRequest(credential.CredentialStorePrefix, req, req.GetItem(), handlers.NoopValidatorFn)
+}
+
+func validateDeleteRequest(req *pbs.DeleteCredentialStoreRequest) error {
+	return handlers.ValidateDeleteRequest(credential.CredentialStorePrefix, req, handlers.NoopValidatorFn)
+}
+
+func validateListRequest(req *pbs.ListCredentialStoresRequest) error {
+	badFields := map[string]string{}
+	switch {
+	case handlers.ValidId(scope.Project.Prefix(), req.GetScopeId()):
+	case req.GetRecursive() && (req.GetScopeId() == scope.Global.String() || handlers.ValidId(scope.Org.Prefix(), req.GetScopeId())):
+		// Recursive lists can start above the projects
+	default:
+		badFields["scope_id"] = "This field must be a valid project scope id."
+	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("Must be at most %d.", handlers.MaxPageSize)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package credential_stores_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/credentialstores"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credential_stores"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCrud(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	org, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	repoFn := func() (*credential.Repository, error) {
+		return credential.NewRepository(rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	s, err := credential_stores.NewService(repoFn, iamRepoFn)
+	require.NoError(t, err)
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId()))
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := s.CreateCredentialStore(ctx, &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{ScopeId: org.GetPublicId()}})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+		_, err = s.GetCredentialStore(ctx, &pbs.GetCredentialStoreRequest{Id: "j_1234567890"})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+		_, err = s.GetCredentialStore(ctx, &pbs.GetCredentialStoreRequest{Id: credential.CredentialStorePrefix + "_DoesntExis"})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "got error %v", err)
+	})
+
+	t.Run("lifecycle", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		created, err := s.CreateCredentialStore(ctx, &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+			ScopeId: proj.GetPublicId(),
+			Name:    &wrappers.StringValue{Value: "vault"},
+		}})
+		require.NoError(err)
+		id := created.GetItem().GetId()
+		assert.Equal("credential-stores/"+id, created.GetUri())
+		assert.Equal(proj.GetPublicId(), created.GetItem().GetScope().GetId())
+
+		got, err := s.GetCredentialStore(ctx, &pbs.GetCredentialStoreRequest{Id: id})
+		require.NoError(err)
+		assert.Equal("vault", got.GetItem().GetName().GetValue())
+
+		list, err := s.ListCredentialStores(ctx, &pbs.ListCredentialStoresRequest{ScopeId: proj.GetPublicId()})
+		require.NoError(err)
+		assert.Len(list.GetItems(), 1)
+
+		updated, err := s.UpdateCredentialStore(ctx, &pbs.UpdateCredentialStoreRequest{
+			Id:         id,
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"name", "description"}},
+			Item: &pb.CredentialStore{
+				Description: &wrappers.StringValue{Value: "desc"},
+				Version:     got.GetItem().GetVersion(),
+			},
+		})
+		require.NoError(err)
+		assert.Nil(updated.GetItem().GetName())
+		assert.Equal("desc", updated.GetItem().GetDescription().GetValue())
+
+		_, err = s.DeleteCredentialStore(ctx, &pbs.DeleteCredentialStoreRequest{Id: id})
+		require.NoError(err)
+		_, err = s.GetCredentialStore(ctx, &pbs.GetCredentialStoreRequest{Id: id})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "got error %v", err)
+	})
+}
@@ -0,0 +1,369 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/store"
+	"github.com/hashicorp/boundary/internal/db"
+	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/credentials"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	maskManager handlers.MaskManager
+)
+
+func init() {
+	var err error
+	if maskManager, err = handlers.NewMaskManager(&store.Credential{}, &pb.Credential{}); err != nil {
+		panic(err)
+	}
+}
+
+type Service struct {
+	repoFn common.CredentialRepoFactory
+}
+
+var _ pbs.CredentialServiceServer = Service{}
+
+// NewService returns a credential Service which handles credential related requests to boundary and uses the provided
+// repositories for storage and retrieval.
+func NewService(repoFn common.CredentialRepoFactory) (Service, error) {
+	if repoFn == nil {
+		return Service{}, fmt.Errorf("nil credential repository provided")
+	}
+	return Service{repoFn: repoFn}, nil
+}
+
+// ListCredentials implements the interface pbs.CredentialServiceServer.
+func (s Service) ListCredentials(ctx context.Context, req *pbs.ListCredentialsRequest) (*pbs.ListCredentialsResponse, error) {
+	if err := validateListRequest(req); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetCredentialStoreId(), action.List)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	page, err := handlers.PaginateList(ctx, auth.KmsFromContext(ctx), req, resource.Credential, req.GetCredentialStoreId(), &authResults, func(after *db.PageAfter, limit int) ([]handlers.PageItem, error) {
+		cl, err := s.listFromRepo(ctx, req.GetCredentialStoreId(), after, limit)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]handlers.PageItem, 0, len(cl))
+		for _, item := range cl {
+			item.Scope = authResults.Scope
+			items = append(items, item)
+		}
+		return items, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var cl []*pb.Credential
+	for _, item := range page.Items {
+		cl = append(cl, item.(*pb.Credential))
+	}
+	return &pbs.ListCredentialsResponse{Items: cl, NextPageToken: page.NextPageToken}, nil
+}
+
+// GetCredential implements the interface pbs.CredentialServiceServer.
+func (s Service) GetCredential(ctx context.Context, req *pbs.GetCredentialRequest) (*pbs.GetCredentialResponse, error) {
+	if err := validateGetRequest(req); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	c, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	c.Scope = authResults.Scope
+	return &pbs.GetCredentialResponse{Item: c}, nil
+}
+
+// CreateCredential implements the interface pbs.CredentialServiceServer.
+func (s Service) CreateCredential(ctx context.Context, req *pbs.CreateCredentialRequest) (*pbs.CreateCredentialResponse, error) {
+	if err := validateCreateRequest(req); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetItem().GetCredentialStoreId(), action.Create)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	c, err := s.createInRepo(ctx, authResults.Scope.GetId(), req.GetItem().GetCredentialStoreId(), req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+	c.Scope = authResults.Scope
+	return &pbs.CreateCredentialResponse{
+		Item: c,
+		Uri:  fmt.Sprintf("credentials/%s", c.GetId()),
+	}, nil
+}
+
+// UpdateCredential implements the interface pbs.CredentialServiceServer.
+func (s Service) UpdateCredential(ctx context.Context, req *pbs.UpdateCredentialRequest) (*pbs.UpdateCredentialResponse, error) {
+	if err := validateUpdateRequest(req); err != nil {
+		return nil, err
+	}
+	storeId, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Update)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	c, err := s.updateInRepo(ctx, authResults.Scope.GetId(), storeId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+	c.Scope = authResults.Scope
+	return &pbs.UpdateCredentialResponse{Item: c}, nil
+}
+
+// DeleteCredential implements the interface pbs.CredentialServiceServer.
+func (s Service) DeleteCredential(ctx context.Context, req *pbs.DeleteCredentialRequest) (*pbs.DeleteCredentialResponse, error) {
+	if err := validateDeleteRequest(req); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Delete)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	_, err := s.deleteFromRepo(ctx, authResults.Scope.GetId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.DeleteCredentialResponse{}, nil
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (*pb.Credential, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	c, err := repo.LookupCredential(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, handlers.NotFoundErrorf("Credential %q doesn't exist.", id)
+	}
+	return toProto(c), nil
+}
+
+func (s Service) listFromRepo(ctx context.Context, storeId string, after *db.PageAfter, limit int) ([]*pb.Credential, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	cl, err := repo.ListCredentials(ctx, storeId, credential.WithPageAfter(after), credential.WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	var outCl []*pb.Credential
+	for _, c := range cl {
+		outCl = append(outCl, toProto(c))
+	}
+	return outCl, nil
+}
+
+func (s Service) createInRepo(ctx context.Context, scopeId, storeId string, item *pb.Credential) (*pb.Credential, error) {
+	var opts []credential.Option
+	if item.GetName() != nil {
+		opts = append(opts, credential.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, credential.WithDescription(item.GetDescription().GetValue()))
+	}
+	c, err := credential.NewCredential(storeId, item.GetUsername().GetValue(), item.GetPassword().GetValue(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build credential for creation: %v.", err)
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateCredential(ctx, scopeId, c)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create credential: %w", err)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential but no error returned from repository.")
+	}
+	return toProto(out), nil
+}
+
+func (s Service) updateInRepo(ctx context.Context, scopeId, storeId, id string, mask []string, item *pb.Credential) (*pb.Credential, error) {
+	c := &credential.Credential{Credential: &store.Credential{
+		PublicId: id,
+		StoreId:  storeId,
+		Username: item.GetUsername().GetValue(),
+		Password: []byte(item.GetPassword().GetValue()),
+	}}
+	if desc := item.GetDescription(); desc != nil {
+		c.Description = desc.GetValue()
+	}
+	if name := item.GetName(); name != nil {
+		c.Name = name.GetValue()
+	}
+	version := item.GetVersion()
+	dbMask := maskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, rowsUpdated, err := repo.UpdateCredential(ctx, scopeId, c, version, dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update credential: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Credential %q doesn't exist or incorrect version provided.", id)
+	}
+	return toProto(out), nil
+}
+
+func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return false, err
+	}
+	rows, err := repo.DeleteCredential(ctx, scopeId, id)
+	if err != nil {
+		return false, fmt.Errorf("unable to delete credential: %w", err)
+	}
+	return rows > 0, nil
+}
+
+func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (string, auth.VerifyResults) {
+	res := auth.VerifyResults{}
+	repo, err := s.repoFn()
+	if err != nil {
+		res.Error = err
+		return "", res
+	}
+
+	var parentId string
+	opts := []auth.Option{auth.WithType(resource.Credential), auth.WithAction(a)}
+	switch a {
+	case action.List, action.Create:
+		parentId = id
+	default:
+		c, err := repo.LookupCredential(ctx, id)
+		if err != nil {
+			res.Error = err
+			return "", res
+		}
+		if c == nil {
+			res.Error = handlers.NotFoundError()
+			return "", res
+		}
+		parentId = c.GetStoreId()
+		opts = append(opts, auth.WithId(id))
+	}
+
+	cs, err := repo.LookupCredentialStore(ctx, parentId)
+	if err != nil {
+		res.Error = err
+		return "", res
+	}
+	if cs == nil {
+		res.Error = handlers.NotFoundError()
+		return "", res
+	}
+	opts = append(opts, auth.WithScopeId(cs.GetScopeId()), auth.WithPin(parentId))
+	return parentId, auth.Verify(ctx, opts...)
+}
+
+// toProto never includes the password of the credential, it can only be
+// written.
+func toProto(in *credential.Credential) *pb.Credential {
+	out := pb.Credential{
+		Id:                in.GetPublicId(),
+		CredentialStoreId: in.GetStoreId(),
+		CreatedTime:       in.GetCreateTime().GetTimestamp(),
+		UpdatedTime:       in.GetUpdateTime().GetTimestamp(),
+		Version:           in.GetVersion(),
+		Username:          &wrapperspb.StringValue{Value: in.GetUsername()},
+	}
+	if in.GetDescription() != "" {
+		out.Description = &wrapperspb.StringValue{Value: in.GetDescription()}
+	}
+	if in.GetName() != "" {
+		out.Name = &wrapperspb.StringValue{Value: in.GetName()}
+	}
+	return &out
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//  * The path passed in is correctly formatted
+//  * All required parameters are set
+//  * There are no conflicting parameters provided
+func validateGetRequest(req *pbs.GetCredentialRequest) error {
+	return handlers.ValidateGetRequest(credential.CredentialPrefix, req, handlers.NoopValidatorFn)
+}
+
+func validateCreateRequest(req *pbs.CreateCredentialRequest) error {
+	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
+		badFields := map[string]string{}
+		if !handlers.ValidId(credential.CredentialStorePrefix, req.GetItem().GetCredentialStoreId()) {
+			badFields["credential_store_id"] = "The field is incorrectly formatted."
+		}
+		if strings.TrimSpace(req.GetItem().GetUsername().GetValue()) == "" {
+			badFields["username"] = "This is a required field."
+		}
+		if req.GetItem().GetPassword().GetValue() == "" {
+			badFields["password"] = "This is a required field."
+		}
+		return badFields
+	})
+}
+
+func validateUpdateRequest(req *pbs.UpdateCredentialRequest) error {
+	return handlers.ValidateUpdateRequest(credential.CredentialPrefix, req, req.GetItem(), func() map[string]string {
+		badFields := map[string]string{}
+		if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "username") &&
+			strings.TrimSpace(req.GetItem().GetUsername().GetValue()) == "" {
+			badFields["username"] = "This field cannot be unset."
+		}
+		if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "password") &&
+			req.GetItem().GetPassword().GetValue() == "" {
+			badFields["password"] = "This field cannot be unset."
+		}
+		return badFields
+	})
+}
+
+func validateDeleteRequest(req *pbs.DeleteCredentialRequest) error {
+	return handlers.ValidateDeleteRequest(credential.CredentialPrefix, req, handlers.NoopValidatorFn)
+}
+
+func validateListRequest(req *pbs.ListCredentialsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(credential.CredentialStorePrefix, req.GetCredentialStoreId()) {
+		badFields["credential_store_id"] = "The field is incorrectly formatted."
+	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("Must be at most %d.", handlers.MaxPageSize)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
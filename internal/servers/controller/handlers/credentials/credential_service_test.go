@@ -0,0 +1,96 @@
+package credentials_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/credentials"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credentials"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCrud(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := credential.TestCredentialStores(t, conn, proj.GetPublicId(), 1)[0]
+
+	repoFn := func() (*credential.Repository, error) {
+		return credential.NewRepository(rw, rw, kmsCache)
+	}
+	s, err := credentials.NewService(repoFn)
+	require.NoError(t, err)
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId()))
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := s.CreateCredential(ctx, &pbs.CreateCredentialRequest{Item: &pb.Credential{
+			CredentialStoreId: cs.GetPublicId(),
+			Username:          &wrappers.StringValue{Value: "admin"},
+		}})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+		_, err = s.ListCredentials(ctx, &pbs.ListCredentialsRequest{CredentialStoreId: "j_1234567890"})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+	})
+
+	t.Run("lifecycle", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		created, err := s.CreateCredential(ctx, &pbs.CreateCredentialRequest{Item: &pb.Credential{
+			CredentialStoreId: cs.GetPublicId(),
+			Name:              &wrappers.StringValue{Value: "db"},
+			Username:          &wrappers.StringValue{Value: "admin"},
+			Password:          &wrappers.StringValue{Value: "secret"},
+		}})
+		require.NoError(err)
+		id := created.GetItem().GetId()
+		assert.Equal("credentials/"+id, created.GetUri())
+		assert.Equal("admin", created.GetItem().GetUsername().GetValue())
+		assert.Nil(created.GetItem().GetPassword())
+
+		got, err := s.GetCredential(ctx, &pbs.GetCredentialRequest{Id: id})
+		require.NoError(err)
+		assert.Nil(got.GetItem().GetPassword())
+
+		list, err := s.ListCredentials(ctx, &pbs.ListCredentialsRequest{CredentialStoreId: cs.GetPublicId()})
+		require.NoError(err)
+		assert.Len(list.GetItems(), 1)
+
+		updated, err := s.UpdateCredential(ctx, &pbs.UpdateCredentialRequest{
+			Id:         id,
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"password"}},
+			Item: &pb.Credential{
+				Password: &wrappers.StringValue{Value: "changed"},
+				Version:  got.GetItem().GetVersion(),
+			},
+		})
+		require.NoError(err)
+		assert.Equal("admin", updated.GetItem().GetUsername().GetValue())
+		assert.Nil(updated.GetItem().GetPassword())
+
+		repo, err := repoFn()
+		require.NoError(err)
+		withSecret, err := repo.LookupCredentialsWithSecret(context.Background(), proj.GetPublicId(), []string{id})
+		require.NoError(err)
+		require.Len(withSecret, 1)
+		assert.Equal([]byte("changed"), withSecret[0].GetPassword())
+
+		_, err = s.DeleteCredential(ctx, &pbs.DeleteCredentialRequest{Id: id})
+		require.NoError(err)
+		_, err = s.GetCredential(ctx, &pbs.GetCredentialRequest{Id: id})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "got error %v", err)
+	})
+}
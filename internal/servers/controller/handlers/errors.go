@@ -14,8 +14,10 @@ import (
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/vault/sdk/helper/base62"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
 )
 
 const (
@@ -164,28 +166,13 @@ func internalErrorId() (string, error) {
 	return errId, nil
 }
 
+// errorFallback is the body of the responses of errors which could not be
+// marshaled
+const errorFallback = `{"error": "failed to marshal error message"}`
+
 func ErrorHandler(logger hclog.Logger) runtime.ErrorHandlerFunc {
-	const errorFallback = `{"error": "failed to marshal error message"}`
 	return func(ctx context.Context, _ *runtime.ServeMux, mar runtime.Marshaler, w http.ResponseWriter, r *http.Request, inErr error) {
-		// API specified error, otherwise we need to translate repo/db errors.
-		var apiErr *apiError
-		isApiErr := errors.As(inErr, &apiErr)
-		if !isApiErr {
-			if err := backendErrorToApiError(inErr); err != nil && !errors.As(err, &apiErr) {
-				logger.Error("failed to cast error to api error", "error", err)
-			}
-		}
-
-		if apiErr == nil || apiErr.inner.GetStatus() == http.StatusInternalServerError {
-			errId, err := internalErrorId()
-			if err != nil {
-				logger.Error("unable to generate internal error id", "error", err)
-				errId = "failed_to_generate_error_id"
-			}
-			logger.Error("internal error returned", "error id", errId, "error", inErr)
-			apiErr = getInternalError(errId)
-		}
-
+		apiErr := toApiError(logger, inErr)
 		buf, merr := mar.Marshal(apiErr.inner)
 		if merr != nil {
 			logger.Error("failed to marshal error response", "response", fmt.Sprintf("%#v", apiErr.inner), "error", merr)
@@ -204,3 +191,104 @@ func ErrorHandler(logger hclog.Logger) runtime.ErrorHandlerFunc {
 		}
 	}
 }
+
+// ErrorJSON returns the error presented to an end user for the error returned
+// by a service handler, marshaled with mar as ErrorHandler writes it. It is
+// used by streaming responses which can no longer set their status when an
+// error occurs, such as those of server-sent events.
+func ErrorJSON(logger hclog.Logger, mar runtime.Marshaler, inErr error) []byte {
+	apiErr := toApiError(logger, inErr)
+	buf, err := mar.Marshal(apiErr.inner)
+	if err != nil {
+		logger.Error("failed to marshal error response", "response", fmt.Sprintf("%#v", apiErr.inner), "error", err)
+		return []byte(errorFallback)
+	}
+	return buf
+}
+
+// toApiError returns the error presented to an end user for the error
+// returned by a service handler. Errors which are neither API errors nor known
+// backend errors are logged and presented as internal errors, identified by
+// an ID found in the log.
+func toApiError(logger hclog.Logger, inErr error) *apiError {
+	// API specified error, otherwise we need to translate repo/db errors.
+	var apiErr *apiError
+	isApiErr := errors.As(inErr, &apiErr)
+	if !isApiErr {
+		if err := backendErrorToApiError(inErr); err != nil && !errors.As(err, &apiErr) {
+			logger.Error("failed to cast error to api error", "error", err)
+		}
+	}
+
+	if apiErr == nil || apiErr.inner.GetStatus() == http.StatusInternalServerError {
+		errId, err := internalErrorId()
+		if err != nil {
+			logger.Error("unable to generate internal error id", "error", err)
+			errId = "failed_to_generate_error_id"
+		}
+		logger.Error("internal error returned", "error id", errId, "error", inErr)
+		apiErr = getInternalError(errId)
+	}
+	return apiErr
+}
+
+// grpcCodes maps the codes of API errors to the gRPC codes they are named
+// after
+var grpcCodes = func() map[string]codes.Code {
+	ret := make(map[string]codes.Code)
+	for c := codes.OK; c <= codes.Unauthenticated; c++ {
+		ret[c.String()] = c
+	}
+	return ret
+}()
+
+// GrpcStatusError converts the error returned by a service handler to the
+// status error returned by the gRPC API listener. It is the counterpart of
+// ErrorHandler for gRPC clients: the fields of invalid arguments are
+// carried as BadRequest details and the ID of internal errors as ErrorInfo
+// details.
+func GrpcStatusError(logger hclog.Logger, inErr error) error {
+	if inErr == nil {
+		return nil
+	}
+	apiErr := toApiError(logger, inErr)
+	c, ok := grpcCodes[apiErr.inner.GetCode()]
+	if !ok {
+		c = codes.Unknown
+	}
+	// Errors of unimplemented methods and unmatched paths have no message of
+	// their own
+	msg := apiErr.inner.GetMessage()
+	if msg == "" {
+		msg = http.StatusText(int(apiErr.inner.GetStatus()))
+	}
+	st := status.New(c, msg)
+
+	var details []protoiface.MessageV1
+	if fields := apiErr.inner.GetDetails().GetRequestFields(); len(fields) > 0 {
+		br := &errdetails.BadRequest{}
+		for _, f := range fields {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       f.GetName(),
+				Description: f.GetDescription(),
+			})
+		}
+		details = append(details, br)
+	}
+	if errId := apiErr.inner.GetDetails().GetErrorId(); errId != "" {
+		details = append(details, &errdetails.ErrorInfo{
+			Reason:   apiErr.inner.GetCode(),
+			Domain:   "boundary",
+			Metadata: map[string]string{"error_id": errId},
+		})
+	}
+	if len(details) > 0 {
+		withDetails, err := st.WithDetails(details...)
+		if err != nil {
+			logger.Error("failed to add details to grpc status", "error", err)
+		} else {
+			st = withDetails
+		}
+	}
+	return st.Err()
+}
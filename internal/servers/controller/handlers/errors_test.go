@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
@@ -185,3 +186,52 @@ func TestApiErrorHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestGrpcStatusError(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	assert.NoError(GrpcStatusError(hclog.L(), nil))
+
+	st, ok := status.FromError(GrpcStatusError(hclog.L(), NotFoundErrorf("Test")))
+	require.True(ok)
+	assert.Equal(codes.NotFound, st.Code())
+	assert.Equal("Test", st.Message())
+	assert.Empty(st.Details())
+
+	st, ok = status.FromError(GrpcStatusError(hclog.L(), status.Error(codes.Unimplemented, "Test")))
+	require.True(ok)
+	assert.Equal(codes.Unimplemented, st.Code())
+
+	st, ok = status.FromError(GrpcStatusError(hclog.L(), fmt.Errorf("test error: %w", db.ErrInvalidFieldMask)))
+	require.True(ok)
+	assert.Equal(codes.InvalidArgument, st.Code())
+	assert.Equal("Error in provided request", st.Message())
+	require.Len(st.Details(), 1)
+	br, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(ok)
+	require.Len(br.GetFieldViolations(), 1)
+	assert.Equal("update_mask", br.GetFieldViolations()[0].GetField())
+	assert.Equal("Invalid update mask provided.", br.GetFieldViolations()[0].GetDescription())
+
+	st, ok = status.FromError(GrpcStatusError(hclog.L(), errors.New("Some random error")))
+	require.True(ok)
+	assert.Equal(codes.Internal, st.Code())
+	require.Len(st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(ok)
+	assert.NotEmpty(info.GetMetadata()["error_id"])
+}
+
+func TestErrorJSON(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	mar := &runtime.JSONPb{}
+
+	var got pb.Error
+	require.NoError(mar.Unmarshal(ErrorJSON(hclog.L(), mar, NotFoundErrorf("Test")), &got))
+	assert.EqualValues(http.StatusNotFound, got.GetStatus())
+	assert.Equal(codes.NotFound.String(), got.GetCode())
+	assert.Equal("Test", got.GetMessage())
+
+	require.NoError(mar.Unmarshal(ErrorJSON(hclog.L(), mar, errors.New("Some random error")), &got))
+	assert.EqualValues(http.StatusInternalServerError, got.GetStatus())
+	assert.NotEmpty(got.GetDetails().GetErrorId())
+}
@@ -0,0 +1,292 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
+	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/events"
+	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/gen/controller/tokens"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/internal/watch"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// reverifyInterval is how often watches verify their request again, so
+	// that they end when the token of the request expires or is deleted, and
+	// follow the changes made to the grants of the caller and the scopes
+	// below recursive watches
+	reverifyInterval = time.Minute
+)
+
+// aclTypes are the resource types that can be watched and the resource type
+// their events are checked as. The events of connections are checked as those
+// of their session.
+var aclTypes = map[string]resource.Type{
+	resource.Session.String():    resource.Session,
+	watch.ConnectionResourceType: resource.Session,
+	resource.Scope.String():      resource.Scope,
+	resource.User.String():       resource.User,
+	resource.Group.String():      resource.Group,
+	resource.Role.String():       resource.Role,
+}
+
+// allTypes are the resource types watched by requests that set none
+var allTypes = []string{
+	resource.Session.String(),
+	watch.ConnectionResourceType,
+	resource.Scope.String(),
+	resource.User.String(),
+	resource.Group.String(),
+	resource.Role.String(),
+}
+
+// Service handles request as described by the pbs.EventServiceServer interface.
+type Service struct {
+	repoFn    common.WatchRepoFactory
+	iamRepoFn common.IamRepoFactory
+	poller    *watch.Poller
+}
+
+// NewService returns an event service which handles requests to watch the
+// changes made to resources. The changes are read by poller, which is shared
+// by all the watches of the controller.
+func NewService(repoFn common.WatchRepoFactory, iamRepoFn common.IamRepoFactory, poller *watch.Poller) (Service, error) {
+	if repoFn == nil {
+		return Service{}, fmt.Errorf("nil watch repository provided")
+	}
+	if iamRepoFn == nil {
+		return Service{}, fmt.Errorf("nil iam repository provided")
+	}
+	if poller == nil {
+		return Service{}, fmt.Errorf("nil watch poller provided")
+	}
+	return Service{repoFn: repoFn, iamRepoFn: iamRepoFn, poller: poller}, nil
+}
+
+var _ pbs.EventServiceServer = Service{}
+
+// WatchEvents implements the interface pbs.EventServiceServer.
+//
+// The watch is authorized like a list of each resource type it watches: the
+// caller must be allowed to list the resources of the type in a scope to
+// watch their changes there. Each event is then only sent if the caller is
+// allowed to read the resource changed.
+func (s Service) WatchEvents(req *pbs.WatchEventsRequest, stream pbs.EventService_WatchEventsServer) error {
+	ctx := stream.Context()
+	if err := validateWatchRequest(req); err != nil {
+		return err
+	}
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return err
+	}
+
+	kmsCache := auth.KmsFromContext(ctx)
+	// Watches resumed from a cursor start with the changes made after the
+	// event it was sent with, and the others with the changes made after
+	// they start
+	var start *db.PageAfter
+	if req.GetCursor() != "" {
+		cur, err := handlers.DecryptWatchCursor(ctx, kmsCache, req.GetCursor())
+		if err != nil {
+			return handlers.InvalidArgumentErrorf("Invalid cursor.", map[string]string{"cursor": "This field could not be decoded."})
+		}
+		if cur.GetScopeId() != req.GetScopeId() || cur.GetRecursive() != req.GetRecursive() {
+			return handlers.InvalidArgumentErrorf("Invalid cursor.", map[string]string{"cursor": "This cursor was issued for a different watch."})
+		}
+		start = &db.PageAfter{CreateTime: cur.GetLastEventCreateTime().AsTime(), PublicId: cur.GetLastEventId()}
+	}
+
+	authResults, watched, err := s.authorize(ctx, req)
+	if err != nil {
+		return err
+	}
+	verified := time.Now()
+	if start == nil {
+		// The start is read from the clock of the database, which sets the
+		// create times of the changes, rather than that of the controller
+		repo, err := s.repoFn()
+		if err != nil {
+			return err
+		}
+		now, err := repo.Now(ctx)
+		if err != nil {
+			return err
+		}
+		start = &db.PageAfter{CreateTime: now}
+	}
+	// Send the headers of the response now that the watch is authorized, so
+	// that clients know it started before the first event
+	if err := stream.SendHeader(nil); err != nil {
+		return err
+	}
+
+	watcher := s.poller.Watch(start)
+	defer watcher.Close()
+	for {
+		nextCtx, cancel := context.WithDeadline(ctx, verified.Add(reverifyInterval))
+		changes, err := watcher.Next(nextCtx)
+		timedOut := nextCtx.Err() != nil
+		cancel()
+		switch {
+		case ctx.Err() != nil:
+			// The watch ended while waiting for the changes
+			return nil
+		case err != nil && !timedOut:
+			return err
+		}
+		for _, c := range changes {
+			item := toProto(c, watched)
+			if item == nil || !allowed(authResults, c) {
+				continue
+			}
+			resp := &pbs.WatchEventsResponse{Item: item}
+			if !filter.Match(resp.GetItem()) {
+				continue
+			}
+			if resp.Cursor, err = handlers.EncryptWatchCursor(ctx, kmsCache, &tokens.WatchCursor{
+				ScopeId:             req.GetScopeId(),
+				Recursive:           req.GetRecursive(),
+				LastEventCreateTime: item.GetCreatedTime(),
+				LastEventId:         item.GetId(),
+			}); err != nil {
+				return err
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+		if time.Since(verified) >= reverifyInterval {
+			if authResults, watched, err = s.authorize(ctx, req); err != nil {
+				return err
+			}
+			verified = time.Now()
+		}
+	}
+}
+
+// authorize verifies the request as a list of each resource type it watches,
+// and returns the results and the scopes watched for each resource type with
+// their info. A watch of all resource types skips those the caller is not
+// allowed to list, but fails if the caller can list none of them.
+func (s Service) authorize(ctx context.Context, req *pbs.WatchEventsRequest) (*auth.VerifyResults, map[string]map[string]*scopes.ScopeInfo, error) {
+	types := req.GetResourceTypes()
+	if len(types) == 0 {
+		types = allTypes
+	}
+	var results *auth.VerifyResults
+	var firstErr error
+	watched := make(map[string]map[string]*scopes.ScopeInfo)
+	for _, typ := range types {
+		authResults := s.authResult(ctx, req.GetScopeId(), aclTypes[typ])
+		_, scopeInfos, err := scopeids.GetListingScopeIds(ctx, s.iamRepoFn, &authResults, req.GetScopeId(), aclTypes[typ], req.GetRecursive())
+		if err != nil {
+			if len(req.GetResourceTypes()) > 0 || !errors.Is(err, handlers.ForbiddenError()) {
+				return nil, nil, err
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		watched[typ] = scopeInfos
+		results = &authResults
+	}
+	if results == nil {
+		return nil, nil, firstErr
+	}
+	return results, watched, nil
+}
+
+func (s Service) authResult(ctx context.Context, scopeId string, typ resource.Type) auth.VerifyResults {
+	res := auth.VerifyResults{}
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+	scp, err := iamRepo.LookupScope(ctx, scopeId)
+	if err != nil {
+		res.Error = err
+		return res
+	}
+	if scp == nil {
+		res.Error = handlers.NotFoundError()
+		return res
+	}
+	return auth.Verify(ctx, auth.WithScopeId(scopeId), auth.WithType(typ), auth.WithAction(action.List))
+}
+
+// allowed reports whether the caller is allowed to read the resource changed
+// by c
+func allowed(authResults *auth.VerifyResults, c *watch.Change) bool {
+	id := c.ResourceId
+	if c.ResourceType == watch.ConnectionResourceType {
+		id = c.SessionId
+	}
+	return authResults.ResourceAllowed(c.ScopeId, id, aclTypes[c.ResourceType], action.Read)
+}
+
+// toProto returns the event of c, or nil if the resource type of c is not
+// watched in the scope of c
+func toProto(c *watch.Change, watched map[string]map[string]*scopes.ScopeInfo) *pb.Event {
+	scopeInfo, ok := watched[c.ResourceType][c.ScopeId]
+	if !ok {
+		return nil
+	}
+	return &pb.Event{
+		Id:           c.Id,
+		Type:         string(c.Type),
+		CreatedTime:  timestamppb.New(c.CreateTime),
+		ResourceType: c.ResourceType,
+		ResourceId:   c.ResourceId,
+		Scope:        scopeInfo,
+		State:        c.State,
+		SessionId:    c.SessionId,
+		UserId:       c.UserId,
+		TargetId:     c.TargetId,
+	}
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//  * The path passed in is correctly formatted
+//  * All required parameters are set
+//  * There are no conflicting parameters provided
+func validateWatchRequest(req *pbs.WatchEventsRequest) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != scope.Global.String() && !handlers.ValidId(scope.Org.Prefix(), req.GetScopeId()) && !handlers.ValidId(scope.Project.Prefix(), req.GetScopeId()) {
+		badFields["scope_id"] = "This field is required to have a properly formatted scope id."
+	}
+	seen := make(map[string]bool)
+	for _, typ := range req.GetResourceTypes() {
+		if _, ok := aclTypes[typ]; !ok {
+			badFields["resource_types"] = fmt.Sprintf("Unknown resource type %q.", typ)
+			break
+		}
+		if seen[typ] {
+			badFields["resource_types"] = fmt.Sprintf("Resource type %q is set more than once.", typ)
+			break
+		}
+		seen[typ] = true
+	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/events"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/internal/watch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// testStream is the stream of a watch, passing the responses sent to it to
+// responses. started is closed when the watch started.
+type testStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	started   chan struct{}
+	responses chan *pbs.WatchEventsResponse
+}
+
+func (s *testStream) Context() context.Context { return s.ctx }
+
+func (s *testStream) SendHeader(metadata.MD) error {
+	close(s.started)
+	return nil
+}
+
+func (s *testStream) Send(resp *pbs.WatchEventsResponse) error {
+	select {
+	case s.responses <- resp:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+// startWatch starts watching req with s, and returns the stream of the watch
+// once it started, or failed, and a function ending the watch and returning
+// its error
+func startWatch(t *testing.T, s events.Service, ctx context.Context, req *pbs.WatchEventsRequest) (*testStream, func() error) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(ctx)
+	stream := &testStream{ctx: ctx, started: make(chan struct{}), responses: make(chan *pbs.WatchEventsResponse, 100)}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.WatchEvents(req, stream)
+	}()
+	select {
+	case <-stream.started:
+	case err := <-errs:
+		errs <- err
+	}
+	return stream, func() error {
+		cancel()
+		return <-errs
+	}
+}
+
+// nextEvent returns the next response sent to stream with an event of the
+// resource with the given id
+func nextEvent(t *testing.T, stream *testStream, resourceId string) *pbs.WatchEventsResponse {
+	t.Helper()
+	timeout := time.After(30 * time.Second)
+	for {
+		select {
+		case resp := <-stream.responses:
+			if resp.GetItem().GetResourceId() == resourceId {
+				return resp
+			}
+		case <-timeout:
+			require.FailNow(t, "timed out waiting for an event", resourceId)
+		}
+	}
+}
+
+func TestWatchEvents_Validation(t *testing.T) {
+	repoFn := func() (*watch.Repository, error) { return nil, errors.New("unused") }
+	poller, err := watch.NewPoller(repoFn)
+	require.NoError(t, err)
+	s, err := events.NewService(
+		repoFn,
+		func() (*iam.Repository, error) { return nil, errors.New("unused") },
+		poller,
+	)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name string
+		req  *pbs.WatchEventsRequest
+	}{
+		{"no scope", &pbs.WatchEventsRequest{}},
+		{"bad scope", &pbs.WatchEventsRequest{ScopeId: "j_1234567890"}},
+		{"unknown resource type", &pbs.WatchEventsRequest{ScopeId: scope.Global.String(), ResourceTypes: []string{"target"}}},
+		{"repeated resource type", &pbs.WatchEventsRequest{ScopeId: scope.Global.String(), ResourceTypes: []string{"session", "session"}}},
+		{"bad filter", &pbs.WatchEventsRequest{ScopeId: scope.Global.String(), Filter: `"/item/type" ==`}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, stop := startWatch(t, s, auth.DisabledAuthTestContext(auth.WithScopeId(tc.req.GetScopeId())), tc.req)
+			err := stop()
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+		})
+	}
+
+	_, stop := startWatch(t, s, auth.DisabledAuthTestContext(auth.WithScopeId(scope.Global.String())), &pbs.WatchEventsRequest{ScopeId: scope.Global.String(), Cursor: "w1_notacursor"})
+	err = stop()
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+}
+
+func TestWatchEvents(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	rw := db.New(conn)
+	watchRepo, err := watch.NewRepository(rw)
+	require.NoError(err)
+	repoFn := func() (*watch.Repository, error) { return watchRepo, nil }
+	poller, err := watch.NewPoller(repoFn)
+	require.NoError(err)
+	pollCtx, stopPolling := context.WithCancel(context.Background())
+	defer stopPolling()
+	poller.Start(pollCtx)
+
+	s, err := events.NewService(
+		repoFn,
+		func() (*iam.Repository, error) { return iamRepo, nil },
+		poller,
+	)
+	require.NoError(err)
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(scope.Global.String()), auth.WithKms(kmsCache))
+
+	req := &pbs.WatchEventsRequest{ScopeId: scope.Global.String(), Recursive: true}
+	stream, stop := startWatch(t, s, ctx, req)
+
+	org, _ := iam.TestScopes(t, iamRepo)
+	u := iam.TestUser(t, iamRepo, org.GetPublicId())
+	sess := session.TestDefaultSession(t, conn, wrap, iamRepo)
+	session.TestState(t, conn, sess.PublicId, session.StatusActive)
+
+	orgEvent := nextEvent(t, stream, org.GetPublicId())
+	assert.Equal("created", orgEvent.GetItem().GetType())
+	assert.Equal("scope", orgEvent.GetItem().GetResourceType())
+	assert.Equal(scope.Global.String(), orgEvent.GetItem().GetScope().GetId())
+	assert.NotEmpty(orgEvent.GetCursor())
+
+	userEvent := nextEvent(t, stream, u.GetPublicId())
+	assert.Equal("created", userEvent.GetItem().GetType())
+	assert.Equal("user", userEvent.GetItem().GetResourceType())
+
+	pending := nextEvent(t, stream, sess.PublicId)
+	assert.Equal("created", pending.GetItem().GetType())
+	assert.Equal("pending", pending.GetItem().GetState())
+	assert.Equal(sess.ScopeId, pending.GetItem().GetScope().GetId())
+	assert.Equal(sess.UserId, pending.GetItem().GetUserId())
+	active := nextEvent(t, stream, sess.PublicId)
+	assert.Equal("updated", active.GetItem().GetType())
+	assert.Equal("active", active.GetItem().GetState())
+	assert.NoError(stop())
+
+	// A watch resumed from a cursor starts after its event
+	resumed, stop := startWatch(t, s, ctx, &pbs.WatchEventsRequest{ScopeId: scope.Global.String(), Recursive: true, ResourceTypes: []string{"session"}, Cursor: pending.GetCursor()})
+	got := nextEvent(t, resumed, sess.PublicId)
+	assert.Equal(active.GetItem().GetId(), got.GetItem().GetId())
+	assert.NoError(stop())
+
+	// Cursors are bound to the watch they were issued for
+	_, stop = startWatch(t, s, ctx, &pbs.WatchEventsRequest{ScopeId: scope.Global.String(), Cursor: pending.GetCursor()})
+	err = stop()
+	require.Error(err)
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+
+	// Filters select the events sent
+	filtered, stop := startWatch(t, s, ctx, &pbs.WatchEventsRequest{ScopeId: scope.Global.String(), Recursive: true, Filter: `"/item/state" == "canceling"`})
+	session.TestState(t, conn, sess.PublicId, session.StatusCanceling)
+	session.TestState(t, conn, sess.PublicId, session.StatusTerminated)
+	got = nextEvent(t, filtered, sess.PublicId)
+	assert.Equal("canceling", got.GetItem().GetState())
+	assert.NoError(stop())
+	for resp := range drain(filtered) {
+		assert.Equal("canceling", resp.GetItem().GetState())
+	}
+}
+
+// drain returns the responses left in the stream of an ended watch
+func drain(stream *testStream) <-chan *pbs.WatchEventsResponse {
+	close(stream.responses)
+	return stream.responses
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-bexpr"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Filter is a compiled list filter.
+//
+// List filters use the go-bexpr syntax and are evaluated against a document
+// holding the JSON of each listed item, as returned by the API, under "item".
+// Fields can be selected with JSON pointers, e.g.
+//
+//  "/item/status" == "active" and "/item/user_id" == "u_1234567890"
+//
+// A filter that references a field an item does not have does not match that
+// item.
+type Filter struct {
+	eval *bexpr.Evaluator
+}
+
+// NewFilter compiles filter. An empty filter matches every item.
+func NewFilter(filter string) (*Filter, error) {
+	if filter == "" {
+		return &Filter{}, nil
+	}
+	eval, err := bexpr.CreateEvaluator(filter)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't build filter: %w", err)
+	}
+	return &Filter{eval: eval}, nil
+}
+
+// Match reports whether item matches the filter.
+func (f *Filter) Match(item proto.Message) bool {
+	if f.eval == nil {
+		return true
+	}
+	js, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(item)
+	if err != nil {
+		return false
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(js, &m); err != nil {
+		return false
+	}
+	ok, err := f.eval.Evaluate(map[string]interface{}{"item": m})
+	if err != nil {
+		// Evaluation errors mean a selector doesn't resolve for this item,
+		// which is not a match.
+		return false
+	}
+	return ok
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"testing"
+
+	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/sessions"
+	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	item := &pb.Session{
+		Id:      "s_1234567890",
+		Status:  "active",
+		UserId:  "u_1234567890",
+		ScopeId: "p_1234567890",
+		Scope:   &scopes.ScopeInfo{Id: "p_1234567890", Type: "project"},
+	}
+	tests := []struct {
+		name   string
+		filter string
+		want   bool
+	}{
+		{"empty", "", true},
+		{"pointer", `"/item/status" == "active"`, true},
+		{"pointer mismatch", `"/item/status" == "terminated"`, false},
+		{"and", `"/item/status" == "active" and "/item/user_id" == "u_1234567890"`, true},
+		{"nested", `"/item/scope/type" == "project"`, true},
+		{"dotted", `item.id == "s_1234567890"`, true},
+		{"matches", `"/item/id" matches "^s_"`, true},
+		{"missing field", `"/item/endpoint" == "tcp://127.0.0.1:22"`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewFilter(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, f.Match(item))
+		})
+	}
+}
+
+func TestNewFilter_Invalid(t *testing.T) {
+	for _, filter := range []string{
+		`"/item/status" ==`,
+		`"//id/"=="bad"`,
+		`item.status = "active"`,
+	} {
+		t.Run(filter, func(t *testing.T) {
+			_, err := NewFilter(filter)
+			assert.Error(t, err)
+		})
+	}
+}
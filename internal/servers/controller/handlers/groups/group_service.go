@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
@@ -53,17 +54,30 @@ func (s Service) ListGroups(ctx context.Context, req *pbs.ListGroupsRequest) (*p
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeInfos, err := scopeids.GetListingScopeIds(ctx, s.repoFn, &authResults, req.GetScopeId(), resource.Group, req.GetRecursive())
+	if err != nil {
+		return nil, err
 	}
-	gl, err := s.listFromRepo(ctx, req.GetScopeId())
+	page, err := handlers.PaginateList(ctx, auth.KmsFromContext(ctx), req, resource.Group, req.GetScopeId(), &authResults, handlers.MergeListPages(scopeIds, func(scopeId string, after *db.PageAfter, limit int) ([]handlers.PageItem, error) {
+		gl, err := s.listFromRepo(ctx, scopeId, after, limit)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]handlers.PageItem, 0, len(gl))
+		for _, item := range gl {
+			item.Scope = scopeInfos[scopeId]
+			items = append(items, item)
+		}
+		return items, nil
+	}))
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range gl {
-		item.Scope = authResults.Scope
+	var gl []*pb.Group
+	for _, item := range page.Items {
+		gl = append(gl, item.(*pb.Group))
 	}
-	return &pbs.ListGroupsResponse{Items: gl}, nil
+	return &pbs.ListGroupsResponse{Items: gl, NextPageToken: page.NextPageToken}, nil
 }
 
 // GetGroups implements the interface pbs.GroupServiceServer.
@@ -275,12 +289,12 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.Group, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeId string, after *db.PageAfter, limit int) ([]*pb.Group, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	gl, err := repo.ListGroups(ctx, scopeId)
+	gl, err := repo.ListGroups(ctx, scopeId, iam.WithPageAfter(after), iam.WithLimit(limit))
 	if err != nil {
 		return nil, fmt.Errorf("unable to list groups: %w", err)
 	}
@@ -450,6 +464,12 @@ func validateListRequest(req *pbs.ListGroupsRequest) error {
 		req.GetScopeId() != scope.Global.String() {
 		badFields["scope_id"] = "Incorrectly formatted identifier."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("Must be at most %d.", handlers.MaxPageSize)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
@@ -2,15 +2,20 @@ package host_catalogs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/hostcatalogs"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dynamic"
+	dynstore "github.com/hashicorp/boundary/internal/host/dynamic/store"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/host/static/store"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
@@ -20,7 +25,8 @@ import (
 )
 
 var (
-	maskManager handlers.MaskManager
+	maskManager        handlers.MaskManager
+	dynamicMaskManager handlers.MaskManager
 )
 
 func init() {
@@ -28,25 +34,32 @@ func init() {
 	if maskManager, err = handlers.NewMaskManager(&store.HostCatalog{}, &pb.HostCatalog{}); err != nil {
 		panic(err)
 	}
+	if dynamicMaskManager, err = handlers.NewMaskManager(&dynstore.HostCatalog{}, &pb.HostCatalog{}, &pb.DynamicHostCatalogAttributes{}); err != nil {
+		panic(err)
+	}
 }
 
 type Service struct {
-	staticRepoFn common.StaticRepoFactory
-	iamRepoFn    common.IamRepoFactory
+	staticRepoFn  common.StaticRepoFactory
+	dynamicRepoFn common.DynamicRepoFactory
+	iamRepoFn     common.IamRepoFactory
 }
 
 var _ pbs.HostCatalogServiceServer = Service{}
 
 // NewService returns a host catalog Service which handles host catalog related requests to boundary and uses the provided
 // repositories for storage and retrieval.
-func NewService(repoFn common.StaticRepoFactory, iamRepoFn common.IamRepoFactory) (Service, error) {
+func NewService(repoFn common.StaticRepoFactory, dynamicRepoFn common.DynamicRepoFactory, iamRepoFn common.IamRepoFactory) (Service, error) {
 	if repoFn == nil {
 		return Service{}, fmt.Errorf("nil static repository provided")
 	}
+	if dynamicRepoFn == nil {
+		return Service{}, fmt.Errorf("nil dynamic repository provided")
+	}
 	if iamRepoFn == nil {
 		return Service{}, fmt.Errorf("nil iam repository provided")
 	}
-	return Service{staticRepoFn: repoFn, iamRepoFn: iamRepoFn}, nil
+	return Service{staticRepoFn: repoFn, dynamicRepoFn: dynamicRepoFn, iamRepoFn: iamRepoFn}, nil
 }
 
 func (s Service) ListHostCatalogs(ctx context.Context, req *pbs.ListHostCatalogsRequest) (*pbs.ListHostCatalogsResponse, error) {
@@ -54,17 +67,35 @@ func (s Service) ListHostCatalogs(ctx context.Context, req *pbs.ListHostCatalogs
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeInfos, err := scopeids.GetListingScopeIds(ctx, s.iamRepoFn, &authResults, req.GetScopeId(), resource.HostCatalog, req.GetRecursive())
+	if err != nil {
+		return nil, err
 	}
-	ul, err := s.listFromRepo(ctx, authResults.Scope.GetId())
+	page, err := handlers.PaginateList(ctx, auth.KmsFromContext(ctx), req, resource.HostCatalog, authResults.Scope.GetId(), &authResults, handlers.MergeListPages(scopeIds, func(scopeId string, after *db.PageAfter, limit int) ([]handlers.PageItem, error) {
+		ul, err := s.listFromRepo(ctx, scopeId, after, limit)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]handlers.PageItem, 0, len(ul))
+		for _, item := range ul {
+			item.Scope = scopeInfos[scopeId]
+			items = append(items, item)
+		}
+		// The items of the repositories are merged into a single list
+		handlers.SortPageItems(items)
+		if len(items) > limit {
+			items = items[:limit]
+		}
+		return items, nil
+	}))
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range ul {
-		item.Scope = authResults.Scope
+	var ul []*pb.HostCatalog
+	for _, item := range page.Items {
+		ul = append(ul, item.(*pb.HostCatalog))
 	}
-	return &pbs.ListHostCatalogsResponse{Items: ul}, nil
+	return &pbs.ListHostCatalogsResponse{Items: ul, NextPageToken: page.NextPageToken}, nil
 }
 
 // GetHostCatalog implements the interface pbs.HostCatalogServiceServer.
@@ -138,6 +169,21 @@ func (s Service) DeleteHostCatalog(ctx context.Context, req *pbs.DeleteHostCatal
 }
 
 func (s Service) getFromRepo(ctx context.Context, id string) (*pb.HostCatalog, error) {
+	switch host.SubtypeFromId(id) {
+	case host.DynamicSubtype:
+		repo, err := s.dynamicRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		hc, err := repo.LookupCatalog(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if hc == nil {
+			return nil, handlers.NotFoundErrorf("Host Catalog %q doesn't exist.", id)
+		}
+		return toDynamicProto(hc)
+	}
 	repo, err := s.staticRepoFn()
 	if err != nil {
 		return nil, err
@@ -152,12 +198,12 @@ func (s Service) getFromRepo(ctx context.Context, id string) (*pb.HostCatalog, e
 	return toProto(hc), nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.HostCatalog, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeId string, after *db.PageAfter, limit int) ([]*pb.HostCatalog, error) {
 	repo, err := s.staticRepoFn()
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListCatalogs(ctx, scopeId)
+	ul, err := repo.ListCatalogs(ctx, scopeId, static.WithPageAfter(after), static.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
@@ -165,10 +211,30 @@ func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.HostCa
 	for _, u := range ul {
 		outUl = append(outUl, toProto(u))
 	}
+
+	dynRepo, err := s.dynamicRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	dl, err := dynRepo.ListCatalogs(ctx, scopeId, dynamic.WithPageAfter(after), dynamic.WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range dl {
+		hc, err := toDynamicProto(d)
+		if err != nil {
+			return nil, err
+		}
+		outUl = append(outUl, hc)
+	}
 	return outUl, nil
 }
 
 func (s Service) createInRepo(ctx context.Context, projId string, item *pb.HostCatalog) (*pb.HostCatalog, error) {
+	switch host.SubtypeFromType(item.GetType()) {
+	case host.DynamicSubtype:
+		return s.createDynamicInRepo(ctx, projId, item)
+	}
 	var opts []static.Option
 	if item.GetName() != nil {
 		opts = append(opts, static.WithName(item.GetName().GetValue()))
@@ -194,7 +260,49 @@ func (s Service) createInRepo(ctx context.Context, projId string, item *pb.HostC
 	return toProto(out), nil
 }
 
+func (s Service) createDynamicInRepo(ctx context.Context, projId string, item *pb.HostCatalog) (*pb.HostCatalog, error) {
+	var opts []dynamic.Option
+	if item.GetName() != nil {
+		opts = append(opts, dynamic.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, dynamic.WithDescription(item.GetDescription().GetValue()))
+	}
+	dynAttrs := &pb.DynamicHostCatalogAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), dynAttrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	opts = append(opts, dynamic.WithSource(dynAttrs.GetSource().GetValue()))
+	if dynAttrs.GetRefreshIntervalSeconds() != nil {
+		opts = append(opts, dynamic.WithRefreshIntervalSeconds(dynAttrs.GetRefreshIntervalSeconds().GetValue()))
+	}
+	h, err := dynamic.NewHostCatalog(projId, opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build host catalog for creation: %v.", err)
+	}
+	repo, err := s.dynamicRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateCatalog(ctx, h)
+	if err != nil {
+		if errors.Is(err, dynamic.ErrSourceNotAllowed) {
+			return nil, sourceNotAllowedError()
+		}
+		return nil, fmt.Errorf("unable to create host catalog: %w", err)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create host catalog but no error returned from repository.")
+	}
+	return toDynamicProto(out)
+}
+
 func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []string, item *pb.HostCatalog) (*pb.HostCatalog, error) {
+	switch host.SubtypeFromId(id) {
+	case host.DynamicSubtype:
+		return s.updateDynamicInRepo(ctx, projId, id, mask, item)
+	}
 	var opts []static.Option
 	if desc := item.GetDescription(); desc != nil {
 		opts = append(opts, static.WithDescription(desc.GetValue()))
@@ -226,14 +334,78 @@ func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []str
 	return toProto(out), nil
 }
 
-func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
-	repo, err := s.staticRepoFn()
+func (s Service) updateDynamicInRepo(ctx context.Context, projId, id string, mask []string, item *pb.HostCatalog) (*pb.HostCatalog, error) {
+	var opts []dynamic.Option
+	if desc := item.GetDescription(); desc != nil {
+		opts = append(opts, dynamic.WithDescription(desc.GetValue()))
+	}
+	if name := item.GetName(); name != nil {
+		opts = append(opts, dynamic.WithName(name.GetValue()))
+	}
+	dynAttrs := &pb.DynamicHostCatalogAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), dynAttrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	if src := dynAttrs.GetSource(); src != nil {
+		opts = append(opts, dynamic.WithSource(src.GetValue()))
+	}
+	if ri := dynAttrs.GetRefreshIntervalSeconds(); ri != nil {
+		opts = append(opts, dynamic.WithRefreshIntervalSeconds(ri.GetValue()))
+	}
+	version := item.GetVersion()
+	h, err := dynamic.NewHostCatalog(projId, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build host catalog for update: %w", err)
+	}
+	h.PublicId = id
+	dbMask := dynamicMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.dynamicRepoFn()
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	rows, err := repo.DeleteCatalog(ctx, id)
+	out, rowsUpdated, err := repo.UpdateCatalog(ctx, h, version, dbMask)
 	if err != nil {
-		return false, fmt.Errorf("unable to delete host: %w", err)
+		if errors.Is(err, dynamic.ErrSourceNotAllowed) {
+			return nil, sourceNotAllowedError()
+		}
+		return nil, fmt.Errorf("unable to update host catalog: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Host Catalog %q doesn't exist or incorrect version provided.", id)
+	}
+	return toDynamicProto(out)
+}
+
+// sourceNotAllowedError is returned when the source of a dynamic host catalog
+// is not one of the sources allowed by the controller's configuration
+func sourceNotAllowedError() error {
+	return handlers.InvalidArgumentErrorf("Error in provided request.",
+		map[string]string{"attributes.source": "This source is not in a directory or on a host allowed by the controller's dynamic_host_catalogs configuration."})
+}
+
+func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
+	var rows int
+	switch host.SubtypeFromId(id) {
+	case host.DynamicSubtype:
+		repo, err := s.dynamicRepoFn()
+		if err != nil {
+			return false, err
+		}
+		if rows, err = repo.DeleteCatalog(ctx, id); err != nil {
+			return false, fmt.Errorf("unable to delete host catalog: %w", err)
+		}
+	default:
+		repo, err := s.staticRepoFn()
+		if err != nil {
+			return false, err
+		}
+		if rows, err = repo.DeleteCatalog(ctx, id); err != nil {
+			return false, fmt.Errorf("unable to delete host catalog: %w", err)
+		}
 	}
 	return rows > 0, nil
 }
@@ -261,27 +433,48 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			return res
 		}
 	default:
-		repo, err := s.staticRepoFn()
+		scopeId, err := s.catalogScopeId(ctx, id)
 		if err != nil {
 			res.Error = err
 			return res
 		}
-		cat, err := repo.LookupCatalog(ctx, id)
-		if err != nil {
-			res.Error = err
-			return res
-		}
-		if cat == nil {
+		if scopeId == "" {
 			res.Error = handlers.NotFoundError()
 			return res
 		}
-		parentId = cat.GetScopeId()
+		parentId = scopeId
 		opts = append(opts, auth.WithId(id))
 	}
 	opts = append(opts, auth.WithScopeId(parentId))
 	return auth.Verify(ctx, opts...)
 }
 
+// catalogScopeId returns the scope id of the host catalog id or an empty
+// string if the catalog doesn't exist.
+func (s Service) catalogScopeId(ctx context.Context, id string) (string, error) {
+	switch host.SubtypeFromId(id) {
+	case host.DynamicSubtype:
+		repo, err := s.dynamicRepoFn()
+		if err != nil {
+			return "", err
+		}
+		cat, err := repo.LookupCatalog(ctx, id)
+		if err != nil || cat == nil {
+			return "", err
+		}
+		return cat.GetScopeId(), nil
+	}
+	repo, err := s.staticRepoFn()
+	if err != nil {
+		return "", err
+	}
+	cat, err := repo.LookupCatalog(ctx, id)
+	if err != nil || cat == nil {
+		return "", err
+	}
+	return cat.GetScopeId(), nil
+}
+
 func toProto(in *static.HostCatalog) *pb.HostCatalog {
 	out := pb.HostCatalog{
 		Id:          in.GetPublicId(),
@@ -300,6 +493,32 @@ func toProto(in *static.HostCatalog) *pb.HostCatalog {
 	return &out
 }
 
+func toDynamicProto(in *dynamic.HostCatalog) (*pb.HostCatalog, error) {
+	out := pb.HostCatalog{
+		Id:          in.GetPublicId(),
+		ScopeId:     in.GetScopeId(),
+		CreatedTime: in.GetCreateTime().GetTimestamp(),
+		UpdatedTime: in.GetUpdateTime().GetTimestamp(),
+		Version:     in.GetVersion(),
+		Type:        host.DynamicSubtype.String(),
+	}
+	if in.GetDescription() != "" {
+		out.Description = &wrapperspb.StringValue{Value: in.GetDescription()}
+	}
+	if in.GetName() != "" {
+		out.Name = &wrapperspb.StringValue{Value: in.GetName()}
+	}
+	st, err := handlers.ProtoToStruct(&pb.DynamicHostCatalogAttributes{
+		Source:                 wrapperspb.String(in.GetSource()),
+		RefreshIntervalSeconds: wrapperspb.UInt32(in.GetRefreshIntervalSeconds()),
+	})
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building dynamic attribute struct: %v", err)
+	}
+	out.Attributes = st
+	return &out, nil
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //  * The path passed in is correctly formatted
@@ -308,7 +527,7 @@ func toProto(in *static.HostCatalog) *pb.HostCatalog {
 //  * The type asserted by the ID and/or field is known
 //  * If relevant, the type derived from the id prefix matches what is claimed by the type field
 func validateGetRequest(req *pbs.GetHostCatalogRequest) error {
-	return handlers.ValidateGetRequest(static.HostCatalogPrefix, req, handlers.NoopValidatorFn)
+	return handlers.ValidateGetRequest(idPrefix(req.GetId()), req, handlers.NoopValidatorFn)
 }
 
 func validateCreateRequest(req *pbs.CreateHostCatalogRequest) error {
@@ -319,37 +538,79 @@ func validateCreateRequest(req *pbs.CreateHostCatalogRequest) error {
 		}
 		switch host.SubtypeFromType(req.GetItem().GetType()) {
 		case host.StaticSubtype:
+		case host.DynamicSubtype:
+			dynAttrs := &pb.DynamicHostCatalogAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), dynAttrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+				break
+			}
+			if err := dynamic.ValidateSource(dynAttrs.GetSource().GetValue()); err != nil {
+				badFields["attributes.source"] = "This is a required field and must be a file path or an http or https URL."
+			}
 		default:
-			badFields["type"] = fmt.Sprintf("This is a required field and must be %q.", host.StaticSubtype.String())
+			badFields["type"] = fmt.Sprintf("This is a required field and must be %q or %q.", host.StaticSubtype.String(), host.DynamicSubtype.String())
 		}
 		return badFields
 	})
 }
 
 func validateUpdateRequest(req *pbs.UpdateHostCatalogRequest) error {
-	return handlers.ValidateUpdateRequest(static.HostCatalogPrefix, req, req.GetItem(), func() map[string]string {
+	return handlers.ValidateUpdateRequest(idPrefix(req.GetId()), req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
 		switch host.SubtypeFromId(req.GetId()) {
 		case host.StaticSubtype:
 			if req.GetItem().GetType() != "" && host.SubtypeFromType(req.GetItem().GetType()) != host.StaticSubtype {
 				badFields["type"] = "Cannot modify resource type."
 			}
+		case host.DynamicSubtype:
+			if req.GetItem().GetType() != "" && host.SubtypeFromType(req.GetItem().GetType()) != host.DynamicSubtype {
+				badFields["type"] = "Cannot modify resource type."
+			}
+			dynAttrs := &pb.DynamicHostCatalogAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), dynAttrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+				break
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "attributes.source") {
+				if err := dynamic.ValidateSource(dynAttrs.GetSource().GetValue()); err != nil {
+					badFields["attributes.source"] = "This field cannot be unset and must be a file path or an http or https URL."
+				}
+			}
 		}
 		return badFields
 	})
 }
 
 func validateDeleteRequest(req *pbs.DeleteHostCatalogRequest) error {
-	return handlers.ValidateDeleteRequest(static.HostCatalogPrefix, req, handlers.NoopValidatorFn)
+	return handlers.ValidateDeleteRequest(idPrefix(req.GetId()), req, handlers.NoopValidatorFn)
 }
 
 func validateListRequest(req *pbs.ListHostCatalogsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(scope.Project.Prefix(), req.GetScopeId()) {
+	switch {
+	case handlers.ValidId(scope.Project.Prefix(), req.GetScopeId()):
+	case req.GetRecursive() && (req.GetScopeId() == scope.Global.String() || handlers.ValidId(scope.Org.Prefix(), req.GetScopeId())):
+		// Recursive lists can start above the projects
+	default:
 		badFields["scope_id"] = "This field must be a valid project scope id."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("Must be at most %d.", handlers.MaxPageSize)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
 	return nil
 }
+
+// idPrefix returns the host catalog prefix for the subtype of id so the id
+// can be validated against it.
+func idPrefix(id string) string {
+	if host.SubtypeFromId(id) == host.DynamicSubtype {
+		return dynamic.HostCatalogPrefix
+	}
+	return static.HostCatalogPrefix
+}
@@ -15,6 +15,7 @@ import (
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/hostcatalogs"
 	scopepb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/host/dynamic"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -31,7 +32,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func createDefaultHostCatalogAndRepo(t *testing.T) (*static.HostCatalog, *iam.Scope, common.StaticRepoFactory, common.IamRepoFactory) {
+func createDefaultHostCatalogAndRepo(t *testing.T) (*static.HostCatalog, *iam.Scope, common.StaticRepoFactory, common.DynamicRepoFactory, common.IamRepoFactory) {
 	t.Helper()
 	require := require.New(t)
 	conn, _ := db.TestSetup(t, "postgres")
@@ -44,6 +45,9 @@ func createDefaultHostCatalogAndRepo(t *testing.T) (*static.HostCatalog, *iam.Sc
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dynamicRepoFn := func() (*dynamic.Repository, error) {
+		return dynamic.NewRepository(rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.TestRepo(t, conn, wrapper), nil
 	}
@@ -55,12 +59,12 @@ func createDefaultHostCatalogAndRepo(t *testing.T) (*static.HostCatalog, *iam.Sc
 	hcRes, err := repo.CreateCatalog(context.Background(), hc)
 	require.NoError(err, "Couldn't persist new catalog.")
 
-	return hcRes, pRes, repoFn, iamRepoFn
+	return hcRes, pRes, repoFn, dynamicRepoFn, iamRepoFn
 }
 
 func TestGet(t *testing.T) {
 	t.Parallel()
-	hc, proj, repo, iamRepoFn := createDefaultHostCatalogAndRepo(t)
+	hc, proj, repo, dynamicRepoFn, iamRepoFn := createDefaultHostCatalogAndRepo(t)
 	toMerge := &pbs.GetHostCatalogRequest{
 		Id: hc.GetPublicId(),
 	}
@@ -112,7 +116,7 @@ func TestGet(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetHostCatalogRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := host_catalogs.NewService(repo, iamRepoFn)
+			s, err := host_catalogs.NewService(repo, dynamicRepoFn, iamRepoFn)
 			require.NoError(err, "Couldn't create a new host catalog service.")
 
 			got, gErr := s.GetHostCatalog(auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId())), req)
@@ -140,6 +144,9 @@ func TestList(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dynamicRepoFn := func() (*dynamic.Repository, error) {
+		return dynamic.NewRepository(rw, rw, kms)
+	}
 	iamRepo := iam.TestRepo(t, conn, wrapper)
 
 	_, pNoCatalogs := iam.TestScopes(t, iamRepo)
@@ -207,7 +214,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := host_catalogs.NewService(repoFn, iamRepoFn)
+			s, err := host_catalogs.NewService(repoFn, dynamicRepoFn, iamRepoFn)
 			require.NoError(err, "Couldn't create new auth_method service.")
 
 			got, gErr := s.ListHostCatalogs(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), &pbs.ListHostCatalogsRequest{ScopeId: tc.scopeId})
@@ -222,9 +229,9 @@ func TestList(t *testing.T) {
 
 func TestDelete(t *testing.T) {
 	t.Parallel()
-	hc, proj, repo, iamRepoFn := createDefaultHostCatalogAndRepo(t)
+	hc, proj, repo, dynamicRepoFn, iamRepoFn := createDefaultHostCatalogAndRepo(t)
 
-	s, err := host_catalogs.NewService(repo, iamRepoFn)
+	s, err := host_catalogs.NewService(repo, dynamicRepoFn, iamRepoFn)
 	require.NoError(t, err, "Couldn't create a new host catalog service.")
 
 	cases := []struct {
@@ -275,9 +282,9 @@ func TestDelete(t *testing.T) {
 func TestDelete_twice(t *testing.T) {
 	t.Parallel()
 	assert, require := assert.New(t), require.New(t)
-	hc, proj, repo, iamRepoFn := createDefaultHostCatalogAndRepo(t)
+	hc, proj, repo, dynamicRepoFn, iamRepoFn := createDefaultHostCatalogAndRepo(t)
 
-	s, err := host_catalogs.NewService(repo, iamRepoFn)
+	s, err := host_catalogs.NewService(repo, dynamicRepoFn, iamRepoFn)
 	require.NoError(err, "Couldn't create a new host catalog service.")
 	req := &pbs.DeleteHostCatalogRequest{
 		Id: hc.GetPublicId(),
@@ -292,7 +299,7 @@ func TestDelete_twice(t *testing.T) {
 
 func TestCreate(t *testing.T) {
 	t.Parallel()
-	defaultHc, proj, repo, iamRepoFn := createDefaultHostCatalogAndRepo(t)
+	defaultHc, proj, repo, dynamicRepoFn, iamRepoFn := createDefaultHostCatalogAndRepo(t)
 	defaultHcCreated, err := ptypes.Timestamp(defaultHc.GetCreateTime().GetTimestamp())
 	require.NoError(t, err, "Error converting proto to timestamp.")
 	toMerge := &pbs.CreateHostCatalogRequest{}
@@ -390,7 +397,7 @@ func TestCreate(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.CreateHostCatalogRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := host_catalogs.NewService(repo, iamRepoFn)
+			s, err := host_catalogs.NewService(repo, dynamicRepoFn, iamRepoFn)
 			require.NoError(err, "Failed to create a new host catalog service.")
 
 			got, gErr := s.CreateHostCatalog(auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId())), req)
@@ -422,10 +429,80 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestCreate_DynamicSource(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	rw := db.New(conn)
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	dynamicRepoFn := func() (*dynamic.Repository, error) {
+		return dynamic.NewRepository(rw, rw, kms, dynamic.WithAllowedSources(dynamic.AllowedSources{
+			Directories: []string{"/etc/boundary/inventory"},
+			Hosts:       []string{"cmdb.example.com"},
+		}))
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	s, err := host_catalogs.NewService(repoFn, dynamicRepoFn, iamRepoFn)
+	require.NoError(t, err, "Failed to create a new host catalog service.")
+
+	cases := []struct {
+		name   string
+		source string
+		err    error
+	}{
+		{
+			name:   "Allowed directory",
+			source: "/etc/boundary/inventory/hosts.json",
+		},
+		{
+			name:   "Allowed host",
+			source: "https://cmdb.example.com/hosts.json",
+		},
+		{
+			name:   "Directory not allowed",
+			source: "/etc/passwd",
+			err:    handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:   "Host not allowed",
+			source: "http://169.254.169.254/latest/meta-data",
+			err:    handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			attrs, err := handlers.ProtoToStruct(&pb.DynamicHostCatalogAttributes{Source: &wrappers.StringValue{Value: tc.source}})
+			require.NoError(err)
+			req := &pbs.CreateHostCatalogRequest{Item: &pb.HostCatalog{
+				ScopeId:    proj.GetPublicId(),
+				Type:       "dynamic",
+				Attributes: attrs,
+			}}
+
+			got, gErr := s.CreateHostCatalog(auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId())), req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CreateHostCatalog(%+v) got error %v, wanted %v", req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.True(strings.HasPrefix(got.GetItem().GetId(), dynamic.HostCatalogPrefix))
+		})
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	t.Parallel()
-	hc, proj, repoFn, iamRepoFn := createDefaultHostCatalogAndRepo(t)
-	tested, err := host_catalogs.NewService(repoFn, iamRepoFn)
+	hc, proj, repoFn, dynamicRepoFn, iamRepoFn := createDefaultHostCatalogAndRepo(t)
+	tested, err := host_catalogs.NewService(repoFn, dynamicRepoFn, iamRepoFn)
 	require.NoError(t, err, "Failed to create a new host catalog service.")
 
 	var version uint32 = 1
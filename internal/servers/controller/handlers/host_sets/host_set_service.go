@@ -10,6 +10,8 @@ import (
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/hostsets"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dynamic"
+	dynstore "github.com/hashicorp/boundary/internal/host/dynamic/store"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/host/static/store"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
@@ -22,7 +24,8 @@ import (
 )
 
 var (
-	maskManager handlers.MaskManager
+	maskManager        handlers.MaskManager
+	dynamicMaskManager handlers.MaskManager
 )
 
 func init() {
@@ -30,21 +33,28 @@ func init() {
 	if maskManager, err = handlers.NewMaskManager(&store.HostSet{}, &pb.HostSet{}); err != nil {
 		panic(err)
 	}
+	if dynamicMaskManager, err = handlers.NewMaskManager(&dynstore.HostSet{}, &pb.HostSet{}, &pb.DynamicHostSetAttributes{}); err != nil {
+		panic(err)
+	}
 }
 
 type Service struct {
-	staticRepoFn common.StaticRepoFactory
+	staticRepoFn  common.StaticRepoFactory
+	dynamicRepoFn common.DynamicRepoFactory
 }
 
 var _ pbs.HostSetServiceServer = Service{}
 
 // NewService returns a host set Service which handles host set related requests to boundary and uses the provided
 // repositories for storage and retrieval.
-func NewService(repoFn common.StaticRepoFactory) (Service, error) {
+func NewService(repoFn common.StaticRepoFactory, dynamicRepoFn common.DynamicRepoFactory) (Service, error) {
 	if repoFn == nil {
 		return Service{}, fmt.Errorf("nil static repository provided")
 	}
-	return Service{staticRepoFn: repoFn}, nil
+	if dynamicRepoFn == nil {
+		return Service{}, fmt.Errorf("nil dynamic repository provided")
+	}
+	return Service{staticRepoFn: repoFn, dynamicRepoFn: dynamicRepoFn}, nil
 }
 
 func (s Service) ListHostSets(ctx context.Context, req *pbs.ListHostSetsRequest) (*pbs.ListHostSetsResponse, error) {
@@ -55,14 +65,26 @@ func (s Service) ListHostSets(ctx context.Context, req *pbs.ListHostSetsRequest)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	hl, err := s.listFromRepo(ctx, req.GetHostCatalogId())
+	page, err := handlers.PaginateList(ctx, auth.KmsFromContext(ctx), req, resource.HostSet, req.GetHostCatalogId(), &authResults, func(after *db.PageAfter, limit int) ([]handlers.PageItem, error) {
+		hl, err := s.listFromRepo(ctx, req.GetHostCatalogId(), after, limit)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]handlers.PageItem, 0, len(hl))
+		for _, item := range hl {
+			item.Scope = authResults.Scope
+			items = append(items, item)
+		}
+		return items, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range hl {
-		item.Scope = authResults.Scope
+	var hl []*pb.HostSet
+	for _, item := range page.Items {
+		hl = append(hl, item.(*pb.HostSet))
 	}
-	return &pbs.ListHostSetsResponse{Items: hl}, nil
+	return &pbs.ListHostSetsResponse{Items: hl, NextPageToken: page.NextPageToken}, nil
 }
 
 // GetHostSet implements the interface pbs.HostSetServiceServer.
@@ -87,11 +109,11 @@ func (s Service) CreateHostSet(ctx context.Context, req *pbs.CreateHostSetReques
 	if err := validateCreateRequest(req); err != nil {
 		return nil, err
 	}
-	cat, authResults := s.parentAndAuthResult(ctx, req.GetItem().GetHostCatalogId(), action.Create)
+	catalogId, authResults := s.parentAndAuthResult(ctx, req.GetItem().GetHostCatalogId(), action.Create)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	h, err := s.createInRepo(ctx, authResults.Scope.GetId(), cat.GetPublicId(), req.GetItem())
+	h, err := s.createInRepo(ctx, authResults.Scope.GetId(), catalogId, req.GetItem())
 	if err != nil {
 		return nil, err
 	}
@@ -107,11 +129,11 @@ func (s Service) UpdateHostSet(ctx context.Context, req *pbs.UpdateHostSetReques
 	if err := validateUpdateRequest(req); err != nil {
 		return nil, err
 	}
-	cat, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Update)
+	catalogId, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Update)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	hc, err := s.updateInRepo(ctx, authResults.Scope.GetId(), cat.GetPublicId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	hc, err := s.updateInRepo(ctx, authResults.Scope.GetId(), catalogId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
 	if err != nil {
 		return nil, err
 	}
@@ -187,6 +209,20 @@ func (s Service) RemoveHostSetHosts(ctx context.Context, req *pbs.RemoveHostSetH
 }
 
 func (s Service) getFromRepo(ctx context.Context, id string) (*pb.HostSet, error) {
+	if host.SubtypeFromId(id) == host.DynamicSubtype {
+		repo, err := s.dynamicRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		h, m, err := repo.LookupSet(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if h == nil {
+			return nil, handlers.NotFoundErrorf("Host Set %q doesn't exist.", id)
+		}
+		return toDynamicProto(h, m)
+	}
 	repo, err := s.staticRepoFn()
 	if err != nil {
 		return nil, err
@@ -202,6 +238,9 @@ func (s Service) getFromRepo(ctx context.Context, id string) (*pb.HostSet, error
 }
 
 func (s Service) createInRepo(ctx context.Context, scopeId, catalogId string, item *pb.HostSet) (*pb.HostSet, error) {
+	if host.SubtypeFromId(catalogId) == host.DynamicSubtype {
+		return s.createDynamicInRepo(ctx, scopeId, catalogId, item)
+	}
 	var opts []static.Option
 	if item.GetName() != nil {
 		opts = append(opts, static.WithName(item.GetName().GetValue()))
@@ -232,6 +271,9 @@ func (s Service) createInRepo(ctx context.Context, scopeId, catalogId string, it
 }
 
 func (s Service) updateInRepo(ctx context.Context, scopeId, catalogId, id string, mask []string, item *pb.HostSet) (*pb.HostSet, error) {
+	if host.SubtypeFromId(id) == host.DynamicSubtype {
+		return s.updateDynamicInRepo(ctx, scopeId, catalogId, id, mask, item)
+	}
 	var opts []static.Option
 	if desc := item.GetDescription(); desc != nil {
 		opts = append(opts, static.WithDescription(desc.GetValue()))
@@ -262,24 +304,137 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, catalogId, id string
 	return toProto(out, m), nil
 }
 
-func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
-	repo, err := s.staticRepoFn()
+func (s Service) createDynamicInRepo(ctx context.Context, scopeId, catalogId string, item *pb.HostSet) (*pb.HostSet, error) {
+	var opts []dynamic.Option
+	if item.GetName() != nil {
+		opts = append(opts, dynamic.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, dynamic.WithDescription(item.GetDescription().GetValue()))
+	}
+	dynAttrs := &pb.DynamicHostSetAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), dynAttrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	opts = append(opts, dynamic.WithFilter(dynAttrs.GetFilter().GetValue()))
+	h, err := dynamic.NewHostSet(catalogId, opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build host set for creation: %v.", err)
+	}
+	repo, err := s.dynamicRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateSet(ctx, scopeId, h)
+	if err != nil {
+		if db.IsUniqueError(err) || errors.Is(err, db.ErrNotUnique) {
+			// Push this error through so the error interceptor can interpret it correctly.
+			return nil, err
+		}
+		return nil, fmt.Errorf("unable to create host set: %w", err)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create host set but no error returned from repository.")
+	}
+	// The members of a dynamic host set are computed when it is created.
+	out, m, err := repo.LookupSet(ctx, out.GetPublicId())
 	if err != nil {
-		return false, err
+		return nil, fmt.Errorf("unable to look up host set after creating it: %w", err)
 	}
-	rows, err := repo.DeleteSet(ctx, scopeId, id)
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to lookup host set after creating it.")
+	}
+	return toDynamicProto(out, m)
+}
+
+func (s Service) updateDynamicInRepo(ctx context.Context, scopeId, catalogId, id string, mask []string, item *pb.HostSet) (*pb.HostSet, error) {
+	var opts []dynamic.Option
+	if desc := item.GetDescription(); desc != nil {
+		opts = append(opts, dynamic.WithDescription(desc.GetValue()))
+	}
+	if name := item.GetName(); name != nil {
+		opts = append(opts, dynamic.WithName(name.GetValue()))
+	}
+	dynAttrs := &pb.DynamicHostSetAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), dynAttrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	if filter := dynAttrs.GetFilter(); filter != nil {
+		opts = append(opts, dynamic.WithFilter(filter.GetValue()))
+	}
+	h, err := dynamic.NewHostSet(catalogId, opts...)
 	if err != nil {
-		return false, fmt.Errorf("unable to delete host: %w", err)
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build host set for update: %v.", err)
+	}
+	h.PublicId = id
+	dbMask := dynamicMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.dynamicRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, m, rowsUpdated, err := repo.UpdateSet(ctx, scopeId, h, item.GetVersion(), dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update host set: %w.", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Host Set %q doesn't exist or incorrect version provided.", id)
+	}
+	return toDynamicProto(out, m)
+}
+
+func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
+	var rows int
+	switch host.SubtypeFromId(id) {
+	case host.DynamicSubtype:
+		repo, err := s.dynamicRepoFn()
+		if err != nil {
+			return false, err
+		}
+		if rows, err = repo.DeleteSet(ctx, scopeId, id); err != nil {
+			return false, fmt.Errorf("unable to delete host set: %w", err)
+		}
+	default:
+		repo, err := s.staticRepoFn()
+		if err != nil {
+			return false, err
+		}
+		if rows, err = repo.DeleteSet(ctx, scopeId, id); err != nil {
+			return false, fmt.Errorf("unable to delete host: %w", err)
+		}
 	}
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, catalogId string) ([]*pb.HostSet, error) {
+func (s Service) listFromRepo(ctx context.Context, catalogId string, after *db.PageAfter, limit int) ([]*pb.HostSet, error) {
+	if host.SubtypeFromId(catalogId) == host.DynamicSubtype {
+		repo, err := s.dynamicRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		hl, err := repo.ListSets(ctx, catalogId, dynamic.WithPageAfter(after), dynamic.WithLimit(limit))
+		if err != nil {
+			return nil, err
+		}
+		var outH []*pb.HostSet
+		for _, h := range hl {
+			hs, err := toDynamicProto(h, nil)
+			if err != nil {
+				return nil, err
+			}
+			outH = append(outH, hs)
+		}
+		return outH, nil
+	}
 	repo, err := s.staticRepoFn()
 	if err != nil {
 		return nil, err
 	}
-	hl, err := repo.ListSets(ctx, catalogId)
+	hl, err := repo.ListSets(ctx, catalogId, static.WithPageAfter(after), static.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
@@ -351,13 +506,8 @@ func (s Service) removeInRepo(ctx context.Context, scopeId, setId string, hostId
 	return toProto(out, m), nil
 }
 
-func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (*static.HostCatalog, auth.VerifyResults) {
+func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (string, auth.VerifyResults) {
 	res := auth.VerifyResults{}
-	repo, err := s.staticRepoFn()
-	if err != nil {
-		res.Error = err
-		return nil, res
-	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.HostSet), auth.WithAction(a)}
@@ -365,30 +515,80 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 	case action.List, action.Create:
 		parentId = id
 	default:
-		set, _, err := repo.LookupSet(ctx, id)
+		catalogId, err := s.setCatalogId(ctx, id)
 		if err != nil {
 			res.Error = err
-			return nil, res
+			return "", res
 		}
-		if set == nil {
+		if catalogId == "" {
 			res.Error = handlers.NotFoundError()
-			return nil, res
+			return "", res
 		}
-		parentId = set.GetCatalogId()
+		parentId = catalogId
 		opts = append(opts, auth.WithId(id))
 	}
 
-	cat, err := repo.LookupCatalog(ctx, parentId)
+	scopeId, err := s.catalogScopeId(ctx, parentId)
 	if err != nil {
 		res.Error = err
-		return nil, res
+		return "", res
 	}
-	if cat == nil {
+	if scopeId == "" {
 		res.Error = handlers.NotFoundError()
-		return nil, res
+		return "", res
+	}
+	opts = append(opts, auth.WithScopeId(scopeId), auth.WithPin(parentId))
+	return parentId, auth.Verify(ctx, opts...)
+}
+
+// setCatalogId returns the catalog id of the host set id or an empty string
+// if the set doesn't exist.
+func (s Service) setCatalogId(ctx context.Context, id string) (string, error) {
+	if host.SubtypeFromId(id) == host.DynamicSubtype {
+		repo, err := s.dynamicRepoFn()
+		if err != nil {
+			return "", err
+		}
+		set, _, err := repo.LookupSet(ctx, id, dynamic.WithLimit(1))
+		if err != nil || set == nil {
+			return "", err
+		}
+		return set.GetCatalogId(), nil
+	}
+	repo, err := s.staticRepoFn()
+	if err != nil {
+		return "", err
+	}
+	set, _, err := repo.LookupSet(ctx, id)
+	if err != nil || set == nil {
+		return "", err
+	}
+	return set.GetCatalogId(), nil
+}
+
+// catalogScopeId returns the scope id of the host catalog id or an empty
+// string if the catalog doesn't exist.
+func (s Service) catalogScopeId(ctx context.Context, id string) (string, error) {
+	if host.SubtypeFromId(id) == host.DynamicSubtype {
+		repo, err := s.dynamicRepoFn()
+		if err != nil {
+			return "", err
+		}
+		cat, err := repo.LookupCatalog(ctx, id)
+		if err != nil || cat == nil {
+			return "", err
+		}
+		return cat.GetScopeId(), nil
+	}
+	repo, err := s.staticRepoFn()
+	if err != nil {
+		return "", err
+	}
+	cat, err := repo.LookupCatalog(ctx, id)
+	if err != nil || cat == nil {
+		return "", err
 	}
-	opts = append(opts, auth.WithScopeId(cat.GetScopeId()), auth.WithPin(parentId))
-	return cat, auth.Verify(ctx, opts...)
+	return cat.GetScopeId(), nil
 }
 
 func toProto(in *static.HostSet, hs []*static.Host) *pb.HostSet {
@@ -412,6 +612,34 @@ func toProto(in *static.HostSet, hs []*static.Host) *pb.HostSet {
 	return &out
 }
 
+func toDynamicProto(in *dynamic.HostSet, hs []*dynamic.Host) (*pb.HostSet, error) {
+	out := pb.HostSet{
+		Id:            in.GetPublicId(),
+		HostCatalogId: in.GetCatalogId(),
+		Type:          host.DynamicSubtype.String(),
+		CreatedTime:   in.GetCreateTime().GetTimestamp(),
+		UpdatedTime:   in.GetUpdateTime().GetTimestamp(),
+		Version:       in.GetVersion(),
+	}
+	if in.GetDescription() != "" {
+		out.Description = wrapperspb.String(in.GetDescription())
+	}
+	if in.GetName() != "" {
+		out.Name = wrapperspb.String(in.GetName())
+	}
+	for _, h := range hs {
+		out.HostIds = append(out.HostIds, h.GetPublicId())
+	}
+	st, err := handlers.ProtoToStruct(&pb.DynamicHostSetAttributes{
+		Filter: wrapperspb.String(in.GetFilter()),
+	})
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building dynamic attribute struct: %v", err)
+	}
+	out.Attributes = st
+	return &out, nil
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //  * The path passed in is correctly formatted
@@ -420,13 +648,13 @@ func toProto(in *static.HostSet, hs []*static.Host) *pb.HostSet {
 //  * The type asserted by the ID and/or field is known
 //  * If relevant, the type derived from the id prefix matches what is claimed by the type field
 func validateGetRequest(req *pbs.GetHostSetRequest) error {
-	return handlers.ValidateGetRequest(static.HostSetPrefix, req, handlers.NoopValidatorFn)
+	return handlers.ValidateGetRequest(setPrefix(req.GetId()), req, handlers.NoopValidatorFn)
 }
 
 func validateCreateRequest(req *pbs.CreateHostSetRequest) error {
 	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
-		if !handlers.ValidId(static.HostCatalogPrefix, req.GetItem().GetHostCatalogId()) {
+		if !handlers.ValidId(catalogPrefix(req.GetItem().GetHostCatalogId()), req.GetItem().GetHostCatalogId()) {
 			badFields["host_catalog_id"] = "The field is incorrectly formatted."
 		}
 		switch host.SubtypeFromId(req.GetItem().GetHostCatalogId()) {
@@ -434,33 +662,65 @@ func validateCreateRequest(req *pbs.CreateHostSetRequest) error {
 			if req.GetItem().GetType() != "" && req.GetItem().GetType() != host.StaticSubtype.String() {
 				badFields["type"] = "Doesn't match the parent resource's type."
 			}
+		case host.DynamicSubtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != host.DynamicSubtype.String() {
+				badFields["type"] = "Doesn't match the parent resource's type."
+			}
+			dynAttrs := &pb.DynamicHostSetAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), dynAttrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+				break
+			}
+			if _, err := dynamic.NewFilter(dynAttrs.GetFilter().GetValue()); err != nil {
+				badFields["attributes.filter"] = fmt.Sprintf("This is a required field and must be a valid filter expression: %v.", err)
+			}
 		}
 		return badFields
 	})
 }
 
 func validateUpdateRequest(req *pbs.UpdateHostSetRequest) error {
-	return handlers.ValidateUpdateRequest(static.HostSetPrefix, req, req.GetItem(), func() map[string]string {
+	return handlers.ValidateUpdateRequest(setPrefix(req.GetId()), req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
 		switch host.SubtypeFromId(req.GetId()) {
 		case host.StaticSubtype:
 			if req.GetItem().GetType() != "" && req.GetItem().GetType() != host.StaticSubtype.String() {
 				badFields["type"] = "Cannot modify the resource type."
 			}
+		case host.DynamicSubtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != host.DynamicSubtype.String() {
+				badFields["type"] = "Cannot modify the resource type."
+			}
+			dynAttrs := &pb.DynamicHostSetAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), dynAttrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+				break
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "attributes.filter") {
+				if _, err := dynamic.NewFilter(dynAttrs.GetFilter().GetValue()); err != nil {
+					badFields["attributes.filter"] = fmt.Sprintf("This field cannot be unset and must be a valid filter expression: %v.", err)
+				}
+			}
 		}
 		return badFields
 	})
 }
 
 func validateDeleteRequest(req *pbs.DeleteHostSetRequest) error {
-	return handlers.ValidateDeleteRequest(static.HostSetPrefix, req, handlers.NoopValidatorFn)
+	return handlers.ValidateDeleteRequest(setPrefix(req.GetId()), req, handlers.NoopValidatorFn)
 }
 
 func validateListRequest(req *pbs.ListHostSetsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(static.HostCatalogPrefix, req.GetHostCatalogId()) {
+	if !handlers.ValidId(catalogPrefix(req.GetHostCatalogId()), req.GetHostCatalogId()) {
 		badFields["host_catalog_id"] = "The field is incorrectly formatted."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("Must be at most %d.", handlers.MaxPageSize)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
@@ -469,7 +729,9 @@ func validateListRequest(req *pbs.ListHostSetsRequest) error {
 
 func validateAddRequest(req *pbs.AddHostSetHostsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(static.HostSetPrefix, req.GetId()) {
+	if host.SubtypeFromId(req.GetId()) == host.DynamicSubtype {
+		badFields["id"] = "The hosts in a dynamic host set are determined by its filter and cannot be changed directly."
+	} else if !handlers.ValidId(static.HostSetPrefix, req.GetId()) {
 		badFields["id"] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
@@ -492,7 +754,9 @@ func validateAddRequest(req *pbs.AddHostSetHostsRequest) error {
 
 func validateSetRequest(req *pbs.SetHostSetHostsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(static.HostSetPrefix, req.GetId()) {
+	if host.SubtypeFromId(req.GetId()) == host.DynamicSubtype {
+		badFields["id"] = "The hosts in a dynamic host set are determined by its filter and cannot be changed directly."
+	} else if !handlers.ValidId(static.HostSetPrefix, req.GetId()) {
 		badFields["id"] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
@@ -512,7 +776,9 @@ func validateSetRequest(req *pbs.SetHostSetHostsRequest) error {
 
 func validateRemoveRequest(req *pbs.RemoveHostSetHostsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(static.HostSetPrefix, req.GetId()) {
+	if host.SubtypeFromId(req.GetId()) == host.DynamicSubtype {
+		badFields["id"] = "The hosts in a dynamic host set are determined by its filter and cannot be changed directly."
+	} else if !handlers.ValidId(static.HostSetPrefix, req.GetId()) {
 		badFields["id"] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
@@ -532,3 +798,21 @@ func validateRemoveRequest(req *pbs.RemoveHostSetHostsRequest) error {
 	}
 	return nil
 }
+
+// setPrefix returns the host set prefix for the subtype of id so the id can
+// be validated against it.
+func setPrefix(id string) string {
+	if host.SubtypeFromId(id) == host.DynamicSubtype {
+		return dynamic.HostSetPrefix
+	}
+	return static.HostSetPrefix
+}
+
+// catalogPrefix returns the host catalog prefix for the subtype of id so the
+// id can be validated against it.
+func catalogPrefix(id string) string {
+	if host.SubtypeFromId(id) == host.DynamicSubtype {
+		return dynamic.HostCatalogPrefix
+	}
+	return static.HostCatalogPrefix
+}
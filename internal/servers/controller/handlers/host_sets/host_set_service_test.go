@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dynamic"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -43,6 +44,9 @@ func TestGet(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dynamicRepoFn := func() (*dynamic.Repository, error) {
+		return dynamic.NewRepository(rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
 
@@ -98,7 +102,7 @@ func TestGet(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetHostSetRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := host_sets.NewService(repoFn)
+			s, err := host_sets.NewService(repoFn, dynamicRepoFn)
 			require.NoError(err, "Couldn't create a new host set service.")
 
 			got, gErr := s.GetHostSet(auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId())), req)
@@ -126,6 +130,9 @@ func TestList(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dynamicRepoFn := func() (*dynamic.Repository, error) {
+		return dynamic.NewRepository(rw, rw, kms)
+	}
 	hcs := static.TestCatalogs(t, conn, proj.GetPublicId(), 2)
 	hc, hcNoHosts := hcs[0], hcs[1]
 
@@ -162,7 +169,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := host_sets.NewService(repoFn)
+			s, err := host_sets.NewService(repoFn, dynamicRepoFn)
 			require.NoError(err, "Couldn't create new host set service.")
 
 			got, gErr := s.ListHostSets(auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId())), &pbs.ListHostSetsRequest{HostCatalogId: tc.hostCatalogId})
@@ -187,10 +194,13 @@ func TestDelete(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dynamicRepoFn := func() (*dynamic.Repository, error) {
+		return dynamic.NewRepository(rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
 
-	s, err := host_sets.NewService(repoFn)
+	s, err := host_sets.NewService(repoFn, dynamicRepoFn)
 	require.NoError(t, err, "Couldn't create a new host set service.")
 
 	cases := []struct {
@@ -259,10 +269,13 @@ func TestDelete_twice(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dynamicRepoFn := func() (*dynamic.Repository, error) {
+		return dynamic.NewRepository(rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
 
-	s, err := host_sets.NewService(repoFn)
+	s, err := host_sets.NewService(repoFn, dynamicRepoFn)
 	require.NoError(err, "Couldn't create a new host set service.")
 	req := &pbs.DeleteHostSetRequest{
 		Id: h.GetPublicId(),
@@ -287,6 +300,9 @@ func TestCreate(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dynamicRepoFn := func() (*dynamic.Repository, error) {
+		return dynamic.NewRepository(rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 
 	defaultHcCreated, err := ptypes.Timestamp(hc.GetCreateTime().GetTimestamp())
@@ -377,7 +393,7 @@ func TestCreate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := host_sets.NewService(repoFn)
+			s, err := host_sets.NewService(repoFn, dynamicRepoFn)
 			require.NoError(err, "Failed to create a new host set service.")
 
 			got, gErr := s.CreateHostSet(auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId())), tc.req)
@@ -421,6 +437,9 @@ func TestUpdate(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dynamicRepoFn := func() (*dynamic.Repository, error) {
+		return dynamic.NewRepository(rw, rw, kms)
+	}
 	repo, err := repoFn()
 	require.NoError(t, err, "Couldn't create new static repo.")
 
@@ -451,7 +470,7 @@ func TestUpdate(t *testing.T) {
 		Id: hs.GetPublicId(),
 	}
 
-	tested, err := host_sets.NewService(repoFn)
+	tested, err := host_sets.NewService(repoFn, dynamicRepoFn)
 	require.NoError(t, err, "Failed to create a new host set service.")
 
 	cases := []struct {
@@ -776,7 +795,10 @@ func TestAddHostSetHosts(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
-	s, err := host_sets.NewService(repoFn)
+	dynamicRepoFn := func() (*dynamic.Repository, error) {
+		return dynamic.NewRepository(rw, rw, kms)
+	}
+	s, err := host_sets.NewService(repoFn, dynamicRepoFn)
 	require.NoError(t, err, "Error when getting new host set service.")
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -888,7 +910,10 @@ func TestSetHostSetHosts(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
-	s, err := host_sets.NewService(repoFn)
+	dynamicRepoFn := func() (*dynamic.Repository, error) {
+		return dynamic.NewRepository(rw, rw, kms)
+	}
+	s, err := host_sets.NewService(repoFn, dynamicRepoFn)
 	require.NoError(t, err, "Error when getting new host set service.")
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -996,7 +1021,10 @@ func TestRemoveHostSetHosts(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
-	s, err := host_sets.NewService(repoFn)
+	dynamicRepoFn := func() (*dynamic.Repository, error) {
+		return dynamic.NewRepository(rw, rw, kms)
+	}
+	s, err := host_sets.NewService(repoFn, dynamicRepoFn)
 	require.NoError(t, err, "Error when getting new host set service.")
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -12,6 +12,7 @@ import (
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/hosts"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dynamic"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/host/static/store"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -26,6 +28,10 @@ var (
 	maskManager handlers.MaskManager
 )
 
+// dynamicHostsReadOnly is reported when a request tries to change a host in
+// a dynamic host catalog.
+const dynamicHostsReadOnly = "Hosts in a dynamic host catalog are synced from its source and cannot be changed directly."
+
 func init() {
 	var err error
 	if maskManager, err = handlers.NewMaskManager(&store.Host{}, &pb.Host{}, &pb.StaticHostAttributes{}); err != nil {
@@ -34,18 +40,22 @@ func init() {
 }
 
 type Service struct {
-	staticRepoFn common.StaticRepoFactory
+	staticRepoFn  common.StaticRepoFactory
+	dynamicRepoFn common.DynamicRepoFactory
 }
 
 var _ pbs.HostServiceServer = Service{}
 
 // NewService returns a host Service which handles host related requests to boundary and uses the provided
 // repositories for storage and retrieval.
-func NewService(repoFn common.StaticRepoFactory) (Service, error) {
+func NewService(repoFn common.StaticRepoFactory, dynamicRepoFn common.DynamicRepoFactory) (Service, error) {
 	if repoFn == nil {
 		return Service{}, fmt.Errorf("nil static repository provided")
 	}
-	return Service{staticRepoFn: repoFn}, nil
+	if dynamicRepoFn == nil {
+		return Service{}, fmt.Errorf("nil dynamic repository provided")
+	}
+	return Service{staticRepoFn: repoFn, dynamicRepoFn: dynamicRepoFn}, nil
 }
 
 func (s Service) ListHosts(ctx context.Context, req *pbs.ListHostsRequest) (*pbs.ListHostsResponse, error) {
@@ -56,14 +66,26 @@ func (s Service) ListHosts(ctx context.Context, req *pbs.ListHostsRequest) (*pbs
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	hl, err := s.listFromRepo(ctx, req.GetHostCatalogId())
+	page, err := handlers.PaginateList(ctx, auth.KmsFromContext(ctx), req, resource.Host, req.GetHostCatalogId(), &authResults, func(after *db.PageAfter, limit int) ([]handlers.PageItem, error) {
+		hl, err := s.listFromRepo(ctx, req.GetHostCatalogId(), after, limit)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]handlers.PageItem, 0, len(hl))
+		for _, item := range hl {
+			item.Scope = authResults.Scope
+			items = append(items, item)
+		}
+		return items, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range hl {
-		item.Scope = authResults.Scope
+	var hl []*pb.Host
+	for _, item := range page.Items {
+		hl = append(hl, item.(*pb.Host))
 	}
-	return &pbs.ListHostsResponse{Items: hl}, nil
+	return &pbs.ListHostsResponse{Items: hl, NextPageToken: page.NextPageToken}, nil
 }
 
 // GetHost implements the interface pbs.HostServiceServer.
@@ -108,11 +130,11 @@ func (s Service) UpdateHost(ctx context.Context, req *pbs.UpdateHostRequest) (*p
 	if err := validateUpdateRequest(req); err != nil {
 		return nil, err
 	}
-	cat, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Update)
+	catalogId, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Update)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	hc, err := s.updateInRepo(ctx, authResults.Scope.GetId(), cat.GetPublicId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	hc, err := s.updateInRepo(ctx, authResults.Scope.GetId(), catalogId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
 	if err != nil {
 		return nil, err
 	}
@@ -137,6 +159,24 @@ func (s Service) DeleteHost(ctx context.Context, req *pbs.DeleteHostRequest) (*p
 }
 
 func (s Service) getFromRepo(ctx context.Context, id string) (*pb.Host, error) {
+	if host.SubtypeFromId(id) == host.DynamicSubtype {
+		repo, err := s.dynamicRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		h, err := repo.LookupHost(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if h == nil {
+			return nil, handlers.NotFoundErrorf("Host %q doesn't exist.", id)
+		}
+		setIds, err := repo.ListHostSetIds(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return toDynamicProto(h, setIds)
+	}
 	repo, err := s.staticRepoFn()
 	if err != nil {
 		return nil, err
@@ -239,12 +279,31 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, catalogId string) ([]*pb.Host, error) {
+func (s Service) listFromRepo(ctx context.Context, catalogId string, after *db.PageAfter, limit int) ([]*pb.Host, error) {
+	if host.SubtypeFromId(catalogId) == host.DynamicSubtype {
+		repo, err := s.dynamicRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		hl, err := repo.ListHosts(ctx, catalogId, dynamic.WithPageAfter(after), dynamic.WithLimit(limit))
+		if err != nil {
+			return nil, err
+		}
+		var outHl []*pb.Host
+		for _, h := range hl {
+			p, err := toDynamicProto(h, nil)
+			if err != nil {
+				return nil, err
+			}
+			outHl = append(outHl, p)
+		}
+		return outHl, nil
+	}
 	repo, err := s.staticRepoFn()
 	if err != nil {
 		return nil, err
 	}
-	hl, err := repo.ListHosts(ctx, catalogId)
+	hl, err := repo.ListHosts(ctx, catalogId, static.WithPageAfter(after), static.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
@@ -259,13 +318,8 @@ func (s Service) listFromRepo(ctx context.Context, catalogId string) ([]*pb.Host
 	return outHl, nil
 }
 
-func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (*static.HostCatalog, auth.VerifyResults) {
+func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (string, auth.VerifyResults) {
 	res := auth.VerifyResults{}
-	repo, err := s.staticRepoFn()
-	if err != nil {
-		res.Error = err
-		return nil, res
-	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.Host), auth.WithAction(a)}
@@ -273,30 +327,80 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 	case action.List, action.Create:
 		parentId = id
 	default:
-		h, err := repo.LookupHost(ctx, id)
+		catalogId, err := s.hostCatalogId(ctx, id)
 		if err != nil {
 			res.Error = err
-			return nil, res
+			return "", res
 		}
-		if h == nil {
+		if catalogId == "" {
 			res.Error = handlers.NotFoundError()
-			return nil, res
+			return "", res
 		}
-		parentId = h.GetCatalogId()
+		parentId = catalogId
 		opts = append(opts, auth.WithId(id))
 	}
 
-	cat, err := repo.LookupCatalog(ctx, parentId)
+	scopeId, err := s.catalogScopeId(ctx, parentId)
 	if err != nil {
 		res.Error = err
-		return nil, res
+		return "", res
 	}
-	if cat == nil {
+	if scopeId == "" {
 		res.Error = handlers.NotFoundError()
-		return nil, res
+		return "", res
+	}
+	opts = append(opts, auth.WithScopeId(scopeId), auth.WithPin(parentId))
+	return parentId, auth.Verify(ctx, opts...)
+}
+
+// hostCatalogId returns the catalog id of the host id or an empty string if
+// the host doesn't exist.
+func (s Service) hostCatalogId(ctx context.Context, id string) (string, error) {
+	if host.SubtypeFromId(id) == host.DynamicSubtype {
+		repo, err := s.dynamicRepoFn()
+		if err != nil {
+			return "", err
+		}
+		h, err := repo.LookupHost(ctx, id)
+		if err != nil || h == nil {
+			return "", err
+		}
+		return h.GetCatalogId(), nil
 	}
-	opts = append(opts, auth.WithScopeId(cat.GetScopeId()), auth.WithPin(parentId))
-	return cat, auth.Verify(ctx, opts...)
+	repo, err := s.staticRepoFn()
+	if err != nil {
+		return "", err
+	}
+	h, err := repo.LookupHost(ctx, id)
+	if err != nil || h == nil {
+		return "", err
+	}
+	return h.GetCatalogId(), nil
+}
+
+// catalogScopeId returns the scope id of the host catalog id or an empty
+// string if the catalog doesn't exist.
+func (s Service) catalogScopeId(ctx context.Context, id string) (string, error) {
+	if host.SubtypeFromId(id) == host.DynamicSubtype {
+		repo, err := s.dynamicRepoFn()
+		if err != nil {
+			return "", err
+		}
+		cat, err := repo.LookupCatalog(ctx, id)
+		if err != nil || cat == nil {
+			return "", err
+		}
+		return cat.GetScopeId(), nil
+	}
+	repo, err := s.staticRepoFn()
+	if err != nil {
+		return "", err
+	}
+	cat, err := repo.LookupCatalog(ctx, id)
+	if err != nil || cat == nil {
+		return "", err
+	}
+	return cat.GetScopeId(), nil
 }
 
 func toProto(in *static.Host, members []*static.HostSet) (*pb.Host, error) {
@@ -325,6 +429,40 @@ func toProto(in *static.Host, members []*static.HostSet) (*pb.Host, error) {
 	return &out, nil
 }
 
+func toDynamicProto(in *dynamic.Host, setIds []string) (*pb.Host, error) {
+	out := pb.Host{
+		Id:            in.GetPublicId(),
+		HostCatalogId: in.GetCatalogId(),
+		Type:          host.DynamicSubtype.String(),
+		CreatedTime:   in.GetCreateTime().GetTimestamp(),
+		UpdatedTime:   in.GetUpdateTime().GetTimestamp(),
+		Version:       in.GetVersion(),
+		HostSetIds:    setIds,
+	}
+	if in.GetName() != "" {
+		out.Name = wrapperspb.String(in.GetName())
+	}
+	attrs, err := in.DecodedAttributes()
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to decode dynamic host attributes: %s", err)
+	}
+	ha := &pb.DynamicHostAttributes{
+		ExternalId: in.GetExternalId(),
+		Address:    in.GetAddress(),
+	}
+	if len(attrs) > 0 {
+		if ha.Attributes, err = structpb.NewStruct(attrs); err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to convert dynamic host attributes to struct: %s", err)
+		}
+	}
+	st, err := handlers.ProtoToStruct(ha)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to convert dynamic attribute to struct: %s", err)
+	}
+	out.Attributes = st
+	return &out, nil
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //  * The path passed in is correctly formatted
@@ -333,7 +471,7 @@ func toProto(in *static.Host, members []*static.HostSet) (*pb.Host, error) {
 //  * The type asserted by the ID and/or field is known
 //  * If relevant, the type derived from the id prefix matches what is claimed by the type field
 func validateGetRequest(req *pbs.GetHostRequest) error {
-	return handlers.ValidateGetRequest(static.HostPrefix, req, func() map[string]string {
+	return handlers.ValidateGetRequest(hostPrefix(req.GetId()), req, func() map[string]string {
 		badFields := map[string]string{}
 		ct := host.SubtypeFromId(req.GetId())
 		if ct == host.UnknownSubtype {
@@ -350,6 +488,8 @@ func validateCreateRequest(req *pbs.CreateHostRequest) error {
 			badFields["host_catalog_id"] = "The field is incorrectly formatted."
 		}
 		switch host.SubtypeFromId(req.GetItem().GetHostCatalogId()) {
+		case host.DynamicSubtype:
+			badFields["host_catalog_id"] = dynamicHostsReadOnly
 		case host.StaticSubtype:
 			if req.GetItem().GetType() != "" && req.GetItem().GetType() != host.StaticSubtype.String() {
 				badFields["type"] = "Doesn't match the parent resource's type."
@@ -378,7 +518,7 @@ func validateCreateRequest(req *pbs.CreateHostRequest) error {
 }
 
 func validateUpdateRequest(req *pbs.UpdateHostRequest) error {
-	return handlers.ValidateUpdateRequest(static.HostPrefix, req, req.GetItem(), func() map[string]string {
+	return handlers.ValidateUpdateRequest(hostPrefix(req.GetId()), req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
 		switch host.SubtypeFromId(req.GetId()) {
 		case host.StaticSubtype:
@@ -398,6 +538,8 @@ func validateUpdateRequest(req *pbs.UpdateHostRequest) error {
 					}
 				}
 			}
+		case host.DynamicSubtype:
+			badFields["id"] = dynamicHostsReadOnly
 		default:
 			badFields["id"] = "Improperly formatted identifier used."
 		}
@@ -406,16 +548,35 @@ func validateUpdateRequest(req *pbs.UpdateHostRequest) error {
 }
 
 func validateDeleteRequest(req *pbs.DeleteHostRequest) error {
+	if host.SubtypeFromId(req.GetId()) == host.DynamicSubtype {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{"id": dynamicHostsReadOnly})
+	}
 	return handlers.ValidateDeleteRequest(static.HostPrefix, req, handlers.NoopValidatorFn)
 }
 
 func validateListRequest(req *pbs.ListHostsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(static.HostCatalogPrefix, req.GetHostCatalogId()) {
+	if !handlers.ValidId(static.HostCatalogPrefix, req.GetHostCatalogId()) &&
+		!handlers.ValidId(dynamic.HostCatalogPrefix, req.GetHostCatalogId()) {
 		badFields["host_catalog_id"] = "The field is incorrectly formatted."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("Must be at most %d.", handlers.MaxPageSize)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
 	return nil
 }
+
+// hostPrefix returns the host prefix for the subtype of id so the id can be
+// validated against it.
+func hostPrefix(id string) string {
+	if host.SubtypeFromId(id) == host.DynamicSubtype {
+		return dynamic.HostPrefix
+	}
+	return static.HostPrefix
+}
@@ -0,0 +1,300 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	mathrand "math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
+	"github.com/hashicorp/boundary/internal/gen/controller/tokens"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/mr-tron/base58"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultPageSize is the number of items in a page of a list when the
+	// list request does not set a page size.
+	DefaultPageSize = 1000
+
+	// MaxPageSize is the largest page size a list request can set.
+	MaxPageSize = 10000
+
+	// pageTokenV1 prefixes the page tokens encrypted by encryptPageToken.
+	pageTokenV1 = "p1_"
+)
+
+// PageItem is an item of a list that can be paged through. Lists are
+// ordered by the create time and id of their items.
+type PageItem interface {
+	proto.Message
+	GetId() string
+	GetCreatedTime() *timestamppb.Timestamp
+}
+
+// ListRequest is a request for a page of a list.
+type ListRequest interface {
+	GetFilter() string
+	GetPageSize() uint32
+	GetPageToken() string
+}
+
+// recursiveListRequest is a ListRequest that can list the items of the child
+// scopes of its scope as well.
+type recursiveListRequest interface {
+	GetRecursive() bool
+}
+
+// ListPageFunc returns up to limit items of a list, in order, starting after
+// the given position. The items it returns must have every field the filter
+// of the list may select set.
+type ListPageFunc func(after *db.PageAfter, limit int) ([]PageItem, error)
+
+// ListItemAuthorizer decides which items of a list are returned. Lists are
+// authorized for the scope or parent whose items they list, so it only leaves
+// out the items individual grants deny. It is implemented by
+// *auth.VerifyResults.
+type ListItemAuthorizer interface {
+	ListItemAllowed(scopeId, pin, id string, typ resource.Type) bool
+}
+
+// scopedItem is an item of a list which is given the scope it belongs to
+type scopedItem interface {
+	GetScope() *scopes.ScopeInfo
+}
+
+// ListPage is a page of a list.
+type ListPage struct {
+	Items []PageItem
+
+	// NextPageToken is the token of the request for the next page, or empty
+	// if this is the last page.
+	NextPageToken string
+}
+
+// PaginateList returns the page of the list of resourceType items of parentId
+// requested by req. The page holds the items listFn returns that authz allows
+// and that match the filter of req, and is followed by a page token when more
+// items may follow. Items are checked against authz in the scope set on them,
+// and with parentId as their pin when they are not top level resources.
+//
+// Page tokens are encrypted with the tokens key of the global scope in
+// kmsCache, so that clients can neither read nor forge them, and are only
+// valid for the list and filter they were issued for.
+func PaginateList(ctx context.Context, kmsCache *kms.Kms, req ListRequest, resourceType resource.Type, parentId string, authz ListItemAuthorizer, listFn ListPageFunc) (*ListPage, error) {
+	filter, err := NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, InvalidArgumentErrorf("Invalid filter.", map[string]string{"filter": fmt.Sprintf("This field could not be parsed. %v", err)})
+	}
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+	var recursive bool
+	if r, ok := req.(recursiveListRequest); ok {
+		recursive = r.GetRecursive()
+	}
+
+	after := &db.PageAfter{}
+	if req.GetPageToken() != "" {
+		tok, err := decryptPageToken(ctx, kmsCache, resourceType, req.GetPageToken())
+		if err != nil {
+			return nil, InvalidArgumentErrorf("Invalid page token.", map[string]string{"page_token": "This field could not be decoded."})
+		}
+		if tok.GetResourceType() != resourceType.String() || tok.GetParentId() != parentId || tok.GetRecursive() != recursive {
+			return nil, InvalidArgumentErrorf("Invalid page token.", map[string]string{"page_token": "This token was issued for a different list."})
+		}
+		if tok.GetFilter() != req.GetFilter() {
+			return nil, InvalidArgumentErrorf("Invalid page token.", map[string]string{"page_token": "This token was issued for a different filter."})
+		}
+		after.CreateTime = tok.GetLastItemCreateTime().AsTime()
+		after.PublicId = tok.GetLastItemId()
+	}
+
+	// Items are fetched a batch at a time until the page is full or the list
+	// is exhausted, as the filter may leave out any number of them. One more
+	// item than the page size is fetched, to know whether the list goes on.
+	limit := pageSize + 1
+	page := &ListPage{}
+	var last PageItem
+	for {
+		items, err := listFn(after, limit)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if len(page.Items) == pageSize {
+				if page.NextPageToken, err = encryptPageToken(ctx, kmsCache, &tokens.PageToken{
+					ResourceType:       resourceType.String(),
+					ParentId:           parentId,
+					Filter:             req.GetFilter(),
+					Recursive:          recursive,
+					LastItemCreateTime: last.GetCreatedTime(),
+					LastItemId:         last.GetId(),
+				}); err != nil {
+					return nil, err
+				}
+				return page, nil
+			}
+			last = item
+			if authz != nil {
+				var scopeId string
+				if si, ok := item.(scopedItem); ok {
+					scopeId = si.GetScope().GetId()
+				}
+				if !authz.ListItemAllowed(scopeId, parentId, item.GetId(), resourceType) {
+					continue
+				}
+			}
+			if filter.Match(item) {
+				page.Items = append(page.Items, item)
+			}
+		}
+		if len(items) < limit {
+			return page, nil
+		}
+		after = &db.PageAfter{CreateTime: last.GetCreatedTime().AsTime(), PublicId: last.GetId()}
+	}
+}
+
+// MergeListPages returns a ListPageFunc listing the items of several lists,
+// such as those of the scopes of a recursive list, as a single list. listFn
+// returns up to limit items of the list with the given id, in order, starting
+// after the given position.
+func MergeListPages(ids []string, listFn func(id string, after *db.PageAfter, limit int) ([]PageItem, error)) ListPageFunc {
+	return func(after *db.PageAfter, limit int) ([]PageItem, error) {
+		var items []PageItem
+		for _, id := range ids {
+			listItems, err := listFn(id, after, limit)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, listItems...)
+		}
+		if len(ids) > 1 {
+			SortPageItems(items)
+			if len(items) > limit {
+				items = items[:limit]
+			}
+		}
+		return items, nil
+	}
+}
+
+// SortPageItems sorts items in the order of lists, by create time and id. It
+// is used by lists merging the items of several repositories.
+func SortPageItems(items []PageItem) {
+	sort.Slice(items, func(i, j int) bool {
+		ti, tj := items[i].GetCreatedTime().AsTime(), items[j].GetCreatedTime().AsTime()
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return items[i].GetId() < items[j].GetId()
+	})
+}
+
+func encryptPageToken(ctx context.Context, kmsCache *kms.Kms, tok *tokens.PageToken) (string, error) {
+	wrapper, err := tokensWrapper(ctx, kmsCache)
+	if err != nil {
+		return "", err
+	}
+	return encryptPageTokenWithWrapper(ctx, wrapper, tok)
+}
+
+func decryptPageToken(ctx context.Context, kmsCache *kms.Kms, resourceType resource.Type, token string) (*tokens.PageToken, error) {
+	wrapper, err := tokensWrapper(ctx, kmsCache)
+	if err != nil {
+		return nil, err
+	}
+	return decryptPageTokenWithWrapper(ctx, wrapper, resourceType, token)
+}
+
+// tokensWrapper returns the wrapper page tokens and watch cursors are
+// encrypted with
+func tokensWrapper(ctx context.Context, kmsCache *kms.Kms) (wrapping.Wrapper, error) {
+	if kmsCache == nil {
+		return nil, fmt.Errorf("no kms available for tokens")
+	}
+	wrapper, err := kmsCache.GetWrapper(ctx, scope.Global.String(), kms.KeyPurposeTokens)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get wrapper: %w", err)
+	}
+	return wrapper, nil
+}
+
+// encryptPageTokenWithWrapper encrypts tok with wrapper, bound to its
+// resource type, and encodes it for clients
+func encryptPageTokenWithWrapper(ctx context.Context, wrapper wrapping.Wrapper, tok *tokens.PageToken) (string, error) {
+	tok.Confounder = newConfounder()
+	return encryptToken(ctx, wrapper, "page token", pageTokenV1, tok, tok.GetResourceType())
+}
+
+// decryptPageTokenWithWrapper decodes and decrypts a page token issued for a
+// list of resourceType items by encryptPageTokenWithWrapper
+func decryptPageTokenWithWrapper(ctx context.Context, wrapper wrapping.Wrapper, resourceType resource.Type, token string) (*tokens.PageToken, error) {
+	tok := new(tokens.PageToken)
+	if err := decryptToken(ctx, wrapper, "page token", pageTokenV1, token, resourceType.String(), tok); err != nil {
+		return nil, err
+	}
+	return tok, nil
+}
+
+// newConfounder returns random bytes of a random length, to set on the tokens
+// encrypted by encryptToken so that the length of the tokens varies
+func newConfounder() []byte {
+	r := mathrand.New(mathrand.NewSource(time.Now().UnixNano()))
+	confounder := make([]byte, r.Intn(30))
+	r.Read(confounder)
+	return confounder
+}
+
+// encryptToken encrypts the token msg with wrapper, bound to aad, and encodes
+// it for clients with the version prefix. name is the name of the kind of
+// token in errors.
+func encryptToken(ctx context.Context, wrapper wrapping.Wrapper, name, prefix string, msg proto.Message, aad string) (string, error) {
+	marshaledTok, err := proto.Marshal(msg)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling %s: %w", name, err)
+	}
+	blobInfo, err := wrapper.Encrypt(ctx, marshaledTok, []byte(aad))
+	if err != nil {
+		return "", fmt.Errorf("error encrypting %s: %w", name, err)
+	}
+	marshaledBlob, err := proto.Marshal(blobInfo)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling encrypted %s: %w", name, err)
+	}
+	return prefix + base58.FastBase58Encoding(marshaledBlob), nil
+}
+
+// decryptToken decodes and decrypts into msg a token encrypted by encryptToken
+// with the version prefix and bound to aad
+func decryptToken(ctx context.Context, wrapper wrapping.Wrapper, name, prefix, token, aad string, msg proto.Message) error {
+	if !strings.HasPrefix(token, prefix) {
+		return fmt.Errorf("unknown %s version", name)
+	}
+	marshaledBlob, err := base58.FastBase58Decoding(strings.TrimPrefix(token, prefix))
+	if err != nil {
+		return fmt.Errorf("error decoding %s: %w", name, err)
+	}
+	blobInfo := new(wrapping.EncryptedBlobInfo)
+	if err := proto.Unmarshal(marshaledBlob, blobInfo); err != nil {
+		return fmt.Errorf("error unmarshaling encrypted %s: %w", name, err)
+	}
+	marshaledTok, err := wrapper.Decrypt(ctx, blobInfo, []byte(aad))
+	if err != nil {
+		return fmt.Errorf("error decrypting %s: %w", name, err)
+	}
+	if err := proto.Unmarshal(marshaledTok, msg); err != nil {
+		return fmt.Errorf("error unmarshaling %s: %w", name, err)
+	}
+	return nil
+}
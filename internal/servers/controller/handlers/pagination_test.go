@@ -0,0 +1,287 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
+	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/sessions"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/gen/controller/tokens"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testListItems returns count sessions, every other one active, created a
+// second apart in the order of their ids
+func testListItems(count int) []PageItem {
+	start := time.Now().Add(-time.Hour)
+	var items []PageItem
+	for i := 0; i < count; i++ {
+		status := "active"
+		if i%2 == 1 {
+			status = "terminated"
+		}
+		items = append(items, &pb.Session{
+			Id:          fmt.Sprintf("s_%010d", i),
+			Status:      status,
+			CreatedTime: timestamppb.New(start.Add(time.Duration(i) * time.Second)),
+		})
+	}
+	return items
+}
+
+// testListPageFunc returns a ListPageFunc serving items, which are in list
+// order, and counting the calls made to it in calls
+func testListPageFunc(items []PageItem, calls *int) ListPageFunc {
+	return func(after *db.PageAfter, limit int) ([]PageItem, error) {
+		*calls++
+		var page []PageItem
+		for _, item := range items {
+			if len(page) == limit {
+				break
+			}
+			if after.PublicId != "" {
+				ct := item.GetCreatedTime().AsTime()
+				if ct.Before(after.CreateTime) || (ct.Equal(after.CreateTime) && item.GetId() <= after.PublicId) {
+					continue
+				}
+			}
+			page = append(page, item)
+		}
+		return page, nil
+	}
+}
+
+func pageIds(items []PageItem) []string {
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item.GetId())
+	}
+	return ids
+}
+
+func TestPaginateList_SinglePage(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	items := testListItems(5)
+	var calls int
+
+	// No page token is issued, so no kms is needed
+	page, err := PaginateList(context.Background(), nil, &pbs.ListSessionsRequest{}, resource.Session, "p_1234567890", nil, testListPageFunc(items, &calls))
+	require.NoError(err)
+	assert.Equal(pageIds(items), pageIds(page.Items))
+	assert.Empty(page.NextPageToken)
+	assert.Equal(1, calls)
+
+	page, err = PaginateList(context.Background(), nil, &pbs.ListSessionsRequest{PageSize: 5}, resource.Session, "p_1234567890", nil, testListPageFunc(items, &calls))
+	require.NoError(err)
+	assert.Len(page.Items, 5)
+	assert.Empty(page.NextPageToken)
+
+	page, err = PaginateList(context.Background(), nil, &pbs.ListSessionsRequest{Filter: `"/item/status" == "active"`}, resource.Session, "p_1234567890", nil, testListPageFunc(items, &calls))
+	require.NoError(err)
+	assert.Equal([]string{"s_0000000000", "s_0000000002", "s_0000000004"}, pageIds(page.Items))
+}
+
+// testAuthorizer denies the items with the ids in denied, recording the
+// items it is asked about
+type testAuthorizer struct {
+	denied  map[string]bool
+	checked []string
+}
+
+func (a *testAuthorizer) ListItemAllowed(scopeId, pin, id string, typ resource.Type) bool {
+	a.checked = append(a.checked, fmt.Sprintf("%s/%s/%s/%s", scopeId, pin, id, typ))
+	return !a.denied[id]
+}
+
+func TestPaginateList_Authz(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	items := testListItems(3)
+	items[0].(*pb.Session).Scope = &scopes.ScopeInfo{Id: "p_1234567890"}
+	var calls int
+
+	authz := &testAuthorizer{denied: map[string]bool{"s_0000000001": true}}
+	page, err := PaginateList(context.Background(), nil, &pbs.ListSessionsRequest{}, resource.Session, "p_1234567890", authz, testListPageFunc(items, &calls))
+	require.NoError(err)
+	assert.Equal([]string{"s_0000000000", "s_0000000002"}, pageIds(page.Items))
+	assert.Equal([]string{
+		"p_1234567890/p_1234567890/s_0000000000/session",
+		"/p_1234567890/s_0000000001/session",
+		"/p_1234567890/s_0000000002/session",
+	}, authz.checked)
+
+	// Denied items fill no part of a page, but still count as read
+	authz.denied = map[string]bool{"s_0000000000": true, "s_0000000001": true}
+	page, err = PaginateList(context.Background(), nil, &pbs.ListSessionsRequest{PageSize: 1, Filter: `"/item/status" == "active"`}, resource.Session, "p_1234567890", authz, testListPageFunc(items, &calls))
+	require.NoError(err)
+	assert.Equal([]string{"s_0000000002"}, pageIds(page.Items))
+}
+
+func TestPaginateList_Errors(t *testing.T) {
+	items := testListItems(5)
+	var calls int
+	tests := []struct {
+		name string
+		req  *pbs.ListSessionsRequest
+	}{
+		{"bad filter", &pbs.ListSessionsRequest{Filter: `"//id/"=="bad"`}},
+		{"bad page token", &pbs.ListSessionsRequest{PageToken: "p1_notatoken"}},
+		{"unknown page token version", &pbs.ListSessionsRequest{PageToken: "p2_notatoken"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := PaginateList(context.Background(), nil, tt.req, resource.Session, "p_1234567890", nil, testListPageFunc(items, &calls))
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+		})
+	}
+
+	t.Run("no kms", func(t *testing.T) {
+		// A page token is needed, but there is no kms to encrypt it with
+		_, err := PaginateList(context.Background(), nil, &pbs.ListSessionsRequest{PageSize: 2}, resource.Session, "p_1234567890", nil, testListPageFunc(items, &calls))
+		require.Error(t, err)
+	})
+}
+
+func TestPaginateList(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, err := kms.CreateKeysTx(context.Background(), rw, rw, wrapper, rand.Reader, scope.Global.String())
+	require.NoError(t, err)
+
+	items := testListItems(10)
+	listAll := func(t *testing.T, req *pbs.ListSessionsRequest) ([]string, int) {
+		t.Helper()
+		var ids []string
+		var pages int
+		for {
+			var calls int
+			page, err := PaginateList(context.Background(), kmsCache, req, resource.Session, "p_1234567890", nil, testListPageFunc(items, &calls))
+			require.NoError(t, err)
+			assert.LessOrEqual(t, len(page.Items), int(req.GetPageSize()))
+			ids = append(ids, pageIds(page.Items)...)
+			pages++
+			if page.NextPageToken == "" {
+				return ids, pages
+			}
+			req.PageToken = page.NextPageToken
+		}
+	}
+
+	t.Run("all", func(t *testing.T) {
+		ids, pages := listAll(t, &pbs.ListSessionsRequest{PageSize: 3})
+		assert.Equal(t, pageIds(items), ids)
+		assert.Equal(t, 4, pages)
+	})
+	t.Run("exact pages", func(t *testing.T) {
+		ids, pages := listAll(t, &pbs.ListSessionsRequest{PageSize: 5})
+		assert.Equal(t, pageIds(items), ids)
+		assert.Equal(t, 2, pages)
+	})
+	t.Run("filtered", func(t *testing.T) {
+		ids, _ := listAll(t, &pbs.ListSessionsRequest{PageSize: 2, Filter: `"/item/status" == "terminated"`})
+		assert.Equal(t, []string{"s_0000000001", "s_0000000003", "s_0000000005", "s_0000000007", "s_0000000009"}, ids)
+	})
+
+	var calls int
+	page, err := PaginateList(context.Background(), kmsCache, &pbs.ListSessionsRequest{PageSize: 3}, resource.Session, "p_1234567890", nil, testListPageFunc(items, &calls))
+	require.NoError(t, err)
+	require.NotEmpty(t, page.NextPageToken)
+	tests := []struct {
+		name         string
+		req          *pbs.ListSessionsRequest
+		resourceType resource.Type
+		parentId     string
+	}{
+		{"other resource type", &pbs.ListSessionsRequest{PageToken: page.NextPageToken}, resource.Target, "p_1234567890"},
+		{"other parent", &pbs.ListSessionsRequest{PageToken: page.NextPageToken}, resource.Session, "p_0987654321"},
+		{"other filter", &pbs.ListSessionsRequest{PageToken: page.NextPageToken, Filter: `"/item/status" == "active"`}, resource.Session, "p_1234567890"},
+		{"other recursion", &pbs.ListSessionsRequest{PageToken: page.NextPageToken, Recursive: true}, resource.Session, "p_1234567890"},
+		{"tampered", &pbs.ListSessionsRequest{PageToken: page.NextPageToken[:len(page.NextPageToken)-2]}, resource.Session, "p_1234567890"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := PaginateList(context.Background(), kmsCache, tt.req, tt.resourceType, tt.parentId, nil, testListPageFunc(items, &calls))
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+		})
+	}
+}
+
+func TestMergeListPages(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	items := testListItems(9)
+	// The items are spread over three lists
+	lists := make(map[string][]PageItem)
+	for i, item := range items {
+		id := fmt.Sprintf("list-%d", i%3)
+		lists[id] = append(lists[id], item)
+	}
+	var calls int
+	listFn := MergeListPages([]string{"list-0", "list-1", "list-2"}, func(id string, after *db.PageAfter, limit int) ([]PageItem, error) {
+		return testListPageFunc(lists[id], &calls)(after, limit)
+	})
+
+	got, err := listFn(&db.PageAfter{}, 4)
+	require.NoError(err)
+	assert.Equal(pageIds(items[:4]), pageIds(got))
+	got, err = listFn(&db.PageAfter{CreateTime: items[3].GetCreatedTime().AsTime(), PublicId: items[3].GetId()}, 4)
+	require.NoError(err)
+	assert.Equal(pageIds(items[4:8]), pageIds(got))
+	assert.Equal(6, calls)
+}
+
+func TestSortPageItems(t *testing.T) {
+	now := time.Now()
+	items := []PageItem{
+		&pb.Session{Id: "s_3", CreatedTime: timestamppb.New(now.Add(time.Second))},
+		&pb.Session{Id: "s_2", CreatedTime: timestamppb.New(now)},
+		&pb.Session{Id: "s_1", CreatedTime: timestamppb.New(now)},
+		&pb.Session{Id: "s_0", CreatedTime: timestamppb.New(now.Add(-time.Second))},
+	}
+	SortPageItems(items)
+	assert.Equal(t, []string{"s_0", "s_1", "s_2", "s_3"}, pageIds(items))
+}
+
+func TestPageToken(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+	want := &tokens.PageToken{
+		ResourceType:       resource.Session.String(),
+		ParentId:           "p_1234567890",
+		Filter:             `"/item/status" == "active"`,
+		LastItemCreateTime: timestamppb.Now(),
+		LastItemId:         "s_1234567890",
+	}
+	encrypted, err := encryptPageTokenWithWrapper(ctx, wrapper, want)
+	require.NoError(err)
+	assert.True(strings.HasPrefix(encrypted, pageTokenV1))
+	assert.NotContains(encrypted, want.GetLastItemId())
+
+	got, err := decryptPageTokenWithWrapper(ctx, wrapper, resource.Session, encrypted)
+	require.NoError(err)
+	assert.True(proto.Equal(want, got))
+
+	// Tokens are bound to the resource type of their list
+	_, err = decryptPageTokenWithWrapper(ctx, wrapper, resource.Target, encrypted)
+	assert.Error(err)
+
+	_, err = decryptPageTokenWithWrapper(ctx, wrapper, resource.Session, encrypted[:len(encrypted)-2])
+	assert.Error(err)
+}
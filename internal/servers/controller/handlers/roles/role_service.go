@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
@@ -54,17 +55,30 @@ func (s Service) ListRoles(ctx context.Context, req *pbs.ListRolesRequest) (*pbs
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeInfos, err := scopeids.GetListingScopeIds(ctx, s.repoFn, &authResults, req.GetScopeId(), resource.Role, req.GetRecursive())
+	if err != nil {
+		return nil, err
 	}
-	gl, err := s.listFromRepo(ctx, req.GetScopeId())
+	page, err := handlers.PaginateList(ctx, auth.KmsFromContext(ctx), req, resource.Role, req.GetScopeId(), &authResults, handlers.MergeListPages(scopeIds, func(scopeId string, after *db.PageAfter, limit int) ([]handlers.PageItem, error) {
+		gl, err := s.listFromRepo(ctx, scopeId, after, limit)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]handlers.PageItem, 0, len(gl))
+		for _, item := range gl {
+			item.Scope = scopeInfos[scopeId]
+			items = append(items, item)
+		}
+		return items, nil
+	}))
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range gl {
-		item.Scope = authResults.Scope
+	var gl []*pb.Role
+	for _, item := range page.Items {
+		gl = append(gl, item.(*pb.Role))
 	}
-	return &pbs.ListRolesResponse{Items: gl}, nil
+	return &pbs.ListRolesResponse{Items: gl, NextPageToken: page.NextPageToken}, nil
 }
 
 // GetRoles implements the interface pbs.RoleServiceServer.
@@ -334,12 +348,12 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.Role, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeId string, after *db.PageAfter, limit int) ([]*pb.Role, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	rl, err := repo.ListRoles(ctx, scopeId)
+	rl, err := repo.ListRoles(ctx, scopeId, iam.WithPageAfter(after), iam.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
@@ -554,9 +568,13 @@ func toProto(in *iam.Role, principals []iam.PrincipalRole, grants []*iam.RoleGra
 				Raw:       g.GetRawGrant(),
 				Canonical: g.GetCanonicalGrant(),
 				Json: &pb.GrantJson{
-					Id:      parsed.Id(),
-					Type:    parsed.Type().String(),
-					Actions: actions,
+					Id:            parsed.Id(),
+					Type:          parsed.Type().String(),
+					Actions:       actions,
+					Deny:          parsed.Deny(),
+					Cidrs:         parsed.Cidrs(),
+					AuthMethodIds: parsed.AuthMethodIds(),
+					Time:          parsed.Time(),
 				},
 			})
 		}
@@ -637,6 +655,12 @@ func validateListRequest(req *pbs.ListRolesRequest) error {
 		req.GetScopeId() != scope.Global.String() {
 		badFields["scope_id"] = "Improperly formatted field."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("Must be at most %d.", handlers.MaxPageSize)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
@@ -503,9 +503,13 @@ func TestUpdate(t *testing.T) {
 		Raw:       grantString,
 		Canonical: g.CanonicalString(),
 		Json: &pb.GrantJson{
-			Id:      g.Id(),
-			Type:    g.Type().String(),
-			Actions: actions,
+			Id:            g.Id(),
+			Type:          g.Type().String(),
+			Actions:       actions,
+			Deny:          g.Deny(),
+			Cidrs:         g.Cidrs(),
+			AuthMethodIds: g.AuthMethodIds(),
+			Time:          g.Time(),
 		},
 	}
 	conn, _ := db.TestSetup(t, "postgres")
@@ -1467,6 +1471,12 @@ func TestAddGrants(t *testing.T) {
 			add:      []string{"id=*;type=*;actions=delete", "id=*;type=*;actions=delete"},
 			result:   []string{"id=1;actions=read", "id=*;type=*;actions=delete"},
 		},
+		{
+			name:     "Add deny grant alongside matching allow grant",
+			existing: []string{"id=*;type=*;actions=delete"},
+			add:      []string{"id=*;type=*;actions=delete;deny=true"},
+			result:   []string{"id=*;type=*;actions=delete", "id=*;type=*;actions=delete;deny=true"},
+		},
 		{
 			name:     "Add grant matching existing grant",
 			existing: []string{"id=1;actions=read", "id=*;type=*;actions=delete"},
@@ -7,12 +7,14 @@ import (
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
@@ -56,19 +58,31 @@ func (s Service) ListScopes(ctx context.Context, req *pbs.ListScopesRequest) (*p
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeInfos, err := scopeids.GetListingScopeIds(ctx, s.repoFn, &authResults, req.GetScopeId(), resource.Scope, req.GetRecursive())
+	if err != nil {
+		return nil, err
 	}
-	pl, err := s.listFromRepo(ctx, authResults.Scope.GetId())
+	page, err := handlers.PaginateList(ctx, auth.KmsFromContext(ctx), req, resource.Scope, authResults.Scope.GetId(), &authResults, handlers.MergeListPages(scopeIds, func(scopeId string, after *db.PageAfter, limit int) ([]handlers.PageItem, error) {
+		pl, err := s.listFromRepo(ctx, scopeId, after, limit)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]handlers.PageItem, 0, len(pl))
+		for _, item := range pl {
+			item.Scope = scopeInfos[scopeId]
+			items = append(items, item)
+		}
+		return items, nil
+	}))
 	if err != nil {
 		return nil, err
 	}
-
-	for _, item := range pl {
-		item.Scope = authResults.Scope
+	var pl []*pb.Scope
+	for _, item := range page.Items {
+		pl = append(pl, item.(*pb.Scope))
 	}
-
-	return &pbs.ListScopesResponse{Items: pl}, nil
+	SortScopes(pl)
+	return &pbs.ListScopesResponse{Items: pl, NextPageToken: page.NextPageToken}, nil
 }
 
 // GetScopes implements the interface pbs.ScopeServiceServer.
@@ -251,7 +265,7 @@ func SortScopes(scps []*pb.Scope) {
 	})
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.Scope, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeId string, after *db.PageAfter, limit int) ([]*pb.Scope, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
@@ -260,9 +274,9 @@ func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.Scope,
 	var scps []*iam.Scope
 	switch {
 	case scopeId == "global":
-		scps, err = repo.ListOrgs(ctx)
+		scps, err = repo.ListOrgs(ctx, iam.WithPageAfter(after), iam.WithLimit(limit))
 	case strings.HasPrefix(scopeId, scope.Org.Prefix()):
-		scps, err = repo.ListProjects(ctx, scopeId)
+		scps, err = repo.ListProjects(ctx, scopeId, iam.WithPageAfter(after), iam.WithLimit(limit))
 	default:
 		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
 			map[string]string{"scope_id": "This field must be 'global' or a valid org scope id."})
@@ -275,7 +289,6 @@ func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.Scope,
 	for _, scp := range scps {
 		outPl = append(outPl, ToProto(scp))
 	}
-	SortScopes(outPl)
 	return outPl, nil
 }
 
@@ -481,6 +494,12 @@ func validateListRequest(req *pbs.ListScopesRequest) error {
 	if req.GetScopeId() != scope.Global.String() && !handlers.ValidId(scope.Org.Prefix(), req.GetScopeId()) {
 		badFields["scope_id"] = "Must be 'global' or a valid org scope id when listing."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("Must be at most %d.", handlers.MaxPageSize)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
 	}
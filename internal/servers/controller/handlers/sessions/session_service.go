@@ -5,11 +5,15 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/boundary/internal/audit"
 	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/db"
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/sessions"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/recording"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
@@ -20,19 +24,23 @@ import (
 
 // Service handles request as described by the pbs.SessionServiceServer interface.
 type Service struct {
+	kms       *kms.Kms
 	repoFn    common.SessionRepoFactory
 	iamRepoFn common.IamRepoFactory
 }
 
 // NewService returns a session service which handles session related requests to boundary.
-func NewService(repoFn common.SessionRepoFactory, iamRepoFn common.IamRepoFactory) (Service, error) {
+func NewService(kmsCache *kms.Kms, repoFn common.SessionRepoFactory, iamRepoFn common.IamRepoFactory) (Service, error) {
+	if kmsCache == nil {
+		return Service{}, fmt.Errorf("nil kms provided")
+	}
 	if repoFn == nil {
 		return Service{}, fmt.Errorf("nil session repository provided")
 	}
 	if iamRepoFn == nil {
 		return Service{}, fmt.Errorf("nil iam repository provided")
 	}
-	return Service{repoFn: repoFn, iamRepoFn: iamRepoFn}, nil
+	return Service{kms: kmsCache, repoFn: repoFn, iamRepoFn: iamRepoFn}, nil
 }
 
 var _ pbs.SessionServiceServer = Service{}
@@ -60,17 +68,30 @@ func (s Service) ListSessions(ctx context.Context, req *pbs.ListSessionsRequest)
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeInfos, err := scopeids.GetListingScopeIds(ctx, s.iamRepoFn, &authResults, req.GetScopeId(), resource.Session, req.GetRecursive())
+	if err != nil {
+		return nil, err
 	}
-	seslist, err := s.listFromRepo(ctx, authResults.Scope.GetId())
+	page, err := handlers.PaginateList(ctx, auth.KmsFromContext(ctx), req, resource.Session, authResults.Scope.GetId(), &authResults, handlers.MergeListPages(scopeIds, func(scopeId string, after *db.PageAfter, limit int) ([]handlers.PageItem, error) {
+		seslist, err := s.listFromRepo(ctx, scopeId, after, limit)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]handlers.PageItem, 0, len(seslist))
+		for _, item := range seslist {
+			item.Scope = scopeInfos[scopeId]
+			items = append(items, item)
+		}
+		return items, nil
+	}))
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range seslist {
-		item.Scope = authResults.Scope
+	var seslist []*pb.Session
+	for _, item := range page.Items {
+		seslist = append(seslist, item.(*pb.Session))
 	}
-	return &pbs.ListSessionsResponse{Items: seslist}, nil
+	return &pbs.ListSessionsResponse{Items: seslist, NextPageToken: page.NextPageToken}, nil
 }
 
 // CancelSession implements the interface pbs.SessionServiceServer.
@@ -87,9 +108,48 @@ func (s Service) CancelSession(ctx context.Context, req *pbs.CancelSessionReques
 		return nil, err
 	}
 	ses.Scope = authResults.Scope
+	audit.Emit(ctx, &audit.Event{
+		Type:     audit.SessionEvent,
+		Resource: &audit.Resource{Id: ses.GetId(), Type: resource.Session.String(), ScopeId: ses.GetScopeId()},
+		Action:   action.Cancel.String(),
+		Result:   &audit.Result{Status: audit.StatusSuccess},
+		Attributes: map[string]string{
+			"target_id": ses.GetTargetId(),
+			"user_id":   ses.GetUserId(),
+		},
+	})
 	return &pbs.CancelSessionResponse{Item: ses}, nil
 }
 
+// ListSessionRecordings implements the interface pbs.SessionServiceServer.
+func (s Service) ListSessionRecordings(ctx context.Context, req *pbs.ListSessionRecordingsRequest) (*pbs.ListSessionRecordingsResponse, error) {
+	if err := validateListRecordingsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.DownloadRecording)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	recs, err := s.listRecordingsFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListSessionRecordingsResponse{Items: recs}, nil
+}
+
+// DownloadSessionRecording implements the interface pbs.SessionServiceServer.
+func (s Service) DownloadSessionRecording(req *pbs.DownloadSessionRecordingRequest, stream pbs.SessionService_DownloadSessionRecordingServer) error {
+	ctx := stream.Context()
+	if err := validateDownloadRecordingRequest(req); err != nil {
+		return err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.DownloadRecording)
+	if authResults.Error != nil {
+		return authResults.Error
+	}
+	return s.downloadRecordingFromRepo(ctx, req.GetId(), req.GetConnectionId(), stream)
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*pb.Session, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -108,12 +168,12 @@ func (s Service) getFromRepo(ctx context.Context, id string) (*pb.Session, error
 	return toProto(sess), nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.Session, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeId string, after *db.PageAfter, limit int) ([]*pb.Session, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	seslist, err := repo.ListSessions(ctx, session.WithScopeId(scopeId))
+	seslist, err := repo.ListSessions(ctx, session.WithScopeId(scopeId), session.WithPageAfter(after), session.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +196,102 @@ func (s Service) cancelInRepo(ctx context.Context, id string, version uint32) (*
 	return toProto(out), nil
 }
 
+// listRecordingsFromRepo returns the recordings of the session's
+// connections, without their data.
+func (s Service) listRecordingsFromRepo(ctx context.Context, id string) ([]*pb.SessionRecording, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	sess, _, err := repo.LookupSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if sess == nil {
+		return nil, handlers.NotFoundErrorf("Session %q doesn't exist.", id)
+	}
+	recs, err := repo.ListConnectionRecordings(ctx, id, session.WithLimit(-1))
+	if err != nil {
+		return nil, err
+	}
+	var out []*pb.SessionRecording
+	for _, rec := range recs {
+		out = append(out, toRecordingProto(rec))
+	}
+	return out, nil
+}
+
+// downloadRecordingFromRepo sends the decrypted recording of the connection
+// to stream as it is read from the repository, so that it never has to be
+// held in memory whole. A recording which can't be decrypted completely is
+// sent up to where it could be, followed by a response holding the reason.
+func (s Service) downloadRecordingFromRepo(ctx context.Context, id, connectionId string, stream pbs.SessionService_DownloadSessionRecordingServer) error {
+	repo, err := s.repoFn()
+	if err != nil {
+		return err
+	}
+	sess, _, err := repo.LookupSession(ctx, id)
+	if err != nil {
+		return err
+	}
+	if sess == nil {
+		return handlers.NotFoundErrorf("Session %q doesn't exist.", id)
+	}
+	rec, err := repo.LookupConnectionRecording(ctx, id, connectionId)
+	if err != nil {
+		return err
+	}
+	if rec == nil {
+		return handlers.NotFoundErrorf("Recording of connection %q of session %q doesn't exist.", connectionId, id)
+	}
+	wrapper, err := s.kms.GetWrapper(ctx, sess.ScopeId, kms.KeyPurposeSessions)
+	if err != nil {
+		return fmt.Errorf("unable to get sessions wrapper: %w", err)
+	}
+	key, err := session.DeriveRecordingKey(wrapper, sess.UserId, sess.PublicId)
+	if err != nil {
+		return fmt.Errorf("unable to derive recording key: %w", err)
+	}
+	w := &recordingStreamWriter{stream: stream}
+	if err := recording.Decrypt(w, repo.OpenConnectionRecording(ctx, rec), key); err != nil {
+		if w.err != nil {
+			return w.err
+		}
+		if err := stream.Send(&pbs.DownloadSessionRecordingResponse{Error: err.Error()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maxRecordingResponseSize bounds the data sent in a single response of a
+// recording download, well below the default maximum size of gRPC messages
+const maxRecordingResponseSize = 1 << 16
+
+// recordingStreamWriter sends the data written to it as responses of a
+// recording download, and keeps the first error sending them
+type recordingStreamWriter struct {
+	stream pbs.SessionService_DownloadSessionRecordingServer
+	err    error
+}
+
+func (w *recordingStreamWriter) Write(p []byte) (int, error) {
+	var n int
+	for len(p) > 0 {
+		size := len(p)
+		if size > maxRecordingResponseSize {
+			size = maxRecordingResponseSize
+		}
+		if err := w.stream.Send(&pbs.DownloadSessionRecordingResponse{Data: p[:size]}); err != nil {
+			w.err = err
+			return n, err
+		}
+		n += size
+		p = p[size:]
+	}
+	return n, nil
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 
@@ -158,7 +314,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			res.Error = handlers.NotFoundError()
 			return res
 		}
-	case action.Read, action.Cancel:
+	case action.Read, action.Cancel, action.DownloadRecording:
 		repo, err := s.repoFn()
 		if err != nil {
 			res.Error = err
@@ -221,6 +377,14 @@ func toProto(in *session.Session) *pb.Session {
 	return &out
 }
 
+func toRecordingProto(in *session.ConnectionRecording) *pb.SessionRecording {
+	return &pb.SessionRecording{
+		ConnectionId: in.ConnectionId,
+		CreatedTime:  in.CreateTime.GetTimestamp(),
+		Size:         uint32(in.Size),
+	}
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //  * The path passed in is correctly formatted
@@ -232,9 +396,19 @@ func validateGetRequest(req *pbs.GetSessionRequest) error {
 
 func validateListRequest(req *pbs.ListSessionsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(scope.Project.Prefix(), req.GetScopeId()) {
+	switch {
+	case handlers.ValidId(scope.Project.Prefix(), req.GetScopeId()):
+	case req.GetRecursive() && (req.GetScopeId() == scope.Global.String() || handlers.ValidId(scope.Org.Prefix(), req.GetScopeId())):
+		// Recursive lists can start above the projects
+	default:
 		badFields["scope_id"] = "This field is required to have a properly formatted project scope id."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("Must be at most %d.", handlers.MaxPageSize)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
@@ -254,3 +428,28 @@ func validateCancelRequest(req *pbs.CancelSessionRequest) error {
 	}
 	return nil
 }
+
+func validateListRecordingsRequest(req *pbs.ListSessionRecordingsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(session.SessionPrefix, req.GetId()) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
+func validateDownloadRecordingRequest(req *pbs.DownloadSessionRecordingRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(session.SessionPrefix, req.GetId()) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if !handlers.ValidId(session.ConnectionPrefix, req.GetConnectionId()) {
+		badFields["connection_id"] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
@@ -1,7 +1,10 @@
 package sessions_test
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -15,6 +18,7 @@ import (
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/recording"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/session"
@@ -22,6 +26,7 @@ import (
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/testing/protocmp"
 )
@@ -119,7 +124,7 @@ func TestGetSession(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := sessions.NewService(sessRepoFn, iamRepoFn)
+			s, err := sessions.NewService(kms, sessRepoFn, iamRepoFn)
 			require.NoError(err, "Couldn't create new session service.")
 
 			got, gErr := s.GetSession(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), tc.req)
@@ -216,10 +221,25 @@ func TestList(t *testing.T) {
 			req:  &pbs.ListSessionsRequest{ScopeId: pNoSessions.GetPublicId()},
 			res:  &pbs.ListSessionsResponse{},
 		},
+		{
+			name: "Filter To One Session",
+			req:  &pbs.ListSessionsRequest{ScopeId: pWithSessions.GetPublicId(), Filter: fmt.Sprintf(`"/item/id"==%q`, wantSession[1].GetId())},
+			res:  &pbs.ListSessionsResponse{Items: wantSession[1:2]},
+		},
+		{
+			name: "Filter To No Sessions",
+			req:  &pbs.ListSessionsRequest{ScopeId: pWithSessions.GetPublicId(), Filter: `"/item/status"=="terminated"`},
+			res:  &pbs.ListSessionsResponse{},
+		},
+		{
+			name: "Filter Bad Format",
+			req:  &pbs.ListSessionsRequest{ScopeId: pWithSessions.GetPublicId(), Filter: `"//id/"=="bad"`},
+			err:  handlers.InvalidArgumentErrorf("bad format", nil),
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := sessions.NewService(sessRepoFn, iamRepoFn)
+			s, err := sessions.NewService(kms, sessRepoFn, iamRepoFn)
 			require.NoError(t, err, "Couldn't create new session service.")
 
 			got, gErr := s.ListSessions(auth.DisabledAuthTestContext(auth.WithScopeId(tc.req.GetScopeId())), tc.req)
@@ -236,6 +256,37 @@ func TestList(t *testing.T) {
 			assert.Empty(t, cmp.Diff(got, tc.res, protocmp.Transform()), "ListSessions(%q) got response %q, wanted %q", tc.req, got, tc.res)
 		})
 	}
+
+	t.Run("List Sessions A Page At A Time", func(t *testing.T) {
+		s, err := sessions.NewService(kms, sessRepoFn, iamRepoFn)
+		require.NoError(t, err, "Couldn't create new session service.")
+		ctx := auth.DisabledAuthTestContext(auth.WithScopeId(pWithSessions.GetPublicId()), auth.WithKms(kms))
+
+		var wantIds, gotIds []string
+		for _, sess := range wantSession {
+			wantIds = append(wantIds, sess.GetId())
+		}
+		req := &pbs.ListSessionsRequest{ScopeId: pWithSessions.GetPublicId(), PageSize: 3}
+		for pages := 1; ; pages++ {
+			got, err := s.ListSessions(ctx, req)
+			require.NoError(t, err)
+			assert.LessOrEqual(t, len(got.GetItems()), 3)
+			for _, item := range got.GetItems() {
+				gotIds = append(gotIds, item.GetId())
+			}
+			if got.GetNextPageToken() == "" {
+				assert.Equal(t, 4, pages)
+				break
+			}
+			req.PageToken = got.GetNextPageToken()
+		}
+		assert.Equal(t, wantIds, gotIds)
+
+		_, err = s.ListSessions(ctx, &pbs.ListSessionsRequest{ScopeId: pNoSessions.GetPublicId(), PageToken: req.GetPageToken()})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v for a page token of another scope", err)
+		_, err = s.ListSessions(ctx, &pbs.ListSessionsRequest{ScopeId: pWithSessions.GetPublicId(), PageSize: handlers.MaxPageSize + 1})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v for a page size above the maximum", err)
+	})
 }
 
 func convertStates(in []*session.State) (string, []*pb.SessionState) {
@@ -348,7 +399,7 @@ func TestCancel(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := sessions.NewService(sessRepoFn, iamRepoFn)
+			s, err := sessions.NewService(kms, sessRepoFn, iamRepoFn)
 			require.NoError(err, "Couldn't create new session service.")
 
 			tc.req.Version = version
@@ -398,3 +449,209 @@ func TestCancel(t *testing.T) {
 		})
 	}
 }
+
+// recordingStream is the stream of a recording download, collecting the data
+// and error sent to it
+type recordingStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	data  bytes.Buffer
+	error string
+}
+
+func (s *recordingStream) Context() context.Context { return s.ctx }
+
+func (s *recordingStream) Send(resp *pbs.DownloadSessionRecordingResponse) error {
+	if s.error != "" {
+		return errors.New("response sent after the error")
+	}
+	s.data.Write(resp.GetData())
+	s.error = resp.GetError()
+	return nil
+}
+
+func TestDownloadRecording(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	rw := db.New(conn)
+	sessRepo, err := session.NewRepository(rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	sessRepoFn := func() (*session.Repository, error) {
+		return sessRepo, nil
+	}
+
+	o, p := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kmsCache, o.GetPublicId())
+	hc := static.TestCatalogs(t, conn, p.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := target.TestTcpTarget(t, conn, p.GetPublicId(), "test", target.WithHostSets([]string{hs.GetPublicId()}))
+
+	newSession := func() *session.Session {
+		return session.TestSession(t, conn, wrap, session.ComposedOf{
+			UserId:      at.GetIamUserId(),
+			HostId:      h.GetPublicId(),
+			TargetId:    tar.GetPublicId(),
+			HostSetId:   hs.GetPublicId(),
+			AuthTokenId: at.GetPublicId(),
+			ScopeId:     p.GetPublicId(),
+			Endpoint:    "tcp://127.0.0.1:22",
+		})
+	}
+	recorded := newSession()
+	unrecorded := newSession()
+
+	connection := session.TestConnection(t, conn, recorded.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222)
+	wrapper, err := kmsCache.GetWrapper(ctx, p.GetPublicId(), kms.KeyPurposeSessions)
+	require.NoError(t, err)
+	key, err := session.DeriveRecordingKey(wrapper, recorded.UserId, recorded.PublicId)
+	require.NoError(t, err)
+	var enc bytes.Buffer
+	w, err := recording.NewWriter(&enc, key, &recording.Header{SessionId: recorded.PublicId, ConnectionId: connection.PublicId})
+	require.NoError(t, err)
+	require.NoError(t, w.Record(recording.DirectionDown, []byte("login: ")))
+	unclosed := append([]byte(nil), enc.Bytes()...)
+	require.NoError(t, w.Close())
+	_, err = sessRepo.CreateConnectionRecording(ctx, recorded.PublicId, connection.PublicId, bytes.NewReader(enc.Bytes()))
+	require.NoError(t, err)
+	truncated := session.TestConnection(t, conn, recorded.PublicId, "127.0.0.1", 22, "127.0.0.1", 2223)
+	_, err = sessRepo.CreateConnectionRecording(ctx, recorded.PublicId, truncated.PublicId, bytes.NewReader(unclosed))
+	require.NoError(t, err)
+	unrecordedConn := session.TestConnection(t, conn, recorded.PublicId, "127.0.0.1", 22, "127.0.0.1", 2224)
+
+	t.Run("List", func(t *testing.T) {
+		cases := []struct {
+			name      string
+			scopeId   string
+			req       *pbs.ListSessionRecordingsRequest
+			wantConns []string
+			err       error
+		}{
+			{
+				name:      "List the recordings of a session",
+				scopeId:   p.GetPublicId(),
+				req:       &pbs.ListSessionRecordingsRequest{Id: recorded.GetPublicId()},
+				wantConns: []string{connection.PublicId, truncated.PublicId},
+			},
+			{
+				name:    "List a session without recordings",
+				scopeId: p.GetPublicId(),
+				req:     &pbs.ListSessionRecordingsRequest{Id: unrecorded.GetPublicId()},
+			},
+			{
+				name: "List a non existing Session",
+				req:  &pbs.ListSessionRecordingsRequest{Id: session.SessionPrefix + "_DoesntExis"},
+				err:  handlers.ApiErrorWithCode(codes.NotFound),
+			},
+			{
+				name: "Wrong id prefix",
+				req:  &pbs.ListSessionRecordingsRequest{Id: "j_1234567890"},
+				err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				assert, require := assert.New(t), require.New(t)
+
+				s, err := sessions.NewService(kmsCache, sessRepoFn, iamRepoFn)
+				require.NoError(err, "Couldn't create new session service.")
+
+				got, gErr := s.ListSessionRecordings(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), tc.req)
+				if tc.err != nil {
+					require.Error(gErr)
+					assert.True(errors.Is(gErr, tc.err), "ListSessionRecordings(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+					return
+				}
+				require.NoError(gErr)
+				var gotConns []string
+				for _, item := range got.GetItems() {
+					gotConns = append(gotConns, item.GetConnectionId())
+					assert.NotZero(item.GetSize())
+				}
+				assert.ElementsMatch(tc.wantConns, gotConns)
+			})
+		}
+	})
+
+	t.Run("Download", func(t *testing.T) {
+		cases := []struct {
+			name      string
+			scopeId   string
+			req       *pbs.DownloadSessionRecordingRequest
+			wantError bool
+			err       error
+		}{
+			{
+				name:    "Download the recording of a connection",
+				scopeId: p.GetPublicId(),
+				req:     &pbs.DownloadSessionRecordingRequest{Id: recorded.GetPublicId(), ConnectionId: connection.PublicId},
+			},
+			{
+				name:      "Download a truncated recording",
+				scopeId:   p.GetPublicId(),
+				req:       &pbs.DownloadSessionRecordingRequest{Id: recorded.GetPublicId(), ConnectionId: truncated.PublicId},
+				wantError: true,
+			},
+			{
+				name:    "Download a connection without recording",
+				scopeId: p.GetPublicId(),
+				req:     &pbs.DownloadSessionRecordingRequest{Id: recorded.GetPublicId(), ConnectionId: unrecordedConn.PublicId},
+				err:     handlers.ApiErrorWithCode(codes.NotFound),
+			},
+			{
+				name: "Download a non existing Session",
+				req:  &pbs.DownloadSessionRecordingRequest{Id: session.SessionPrefix + "_DoesntExis", ConnectionId: connection.PublicId},
+				err:  handlers.ApiErrorWithCode(codes.NotFound),
+			},
+			{
+				name: "Wrong id prefix",
+				req:  &pbs.DownloadSessionRecordingRequest{Id: "j_1234567890", ConnectionId: connection.PublicId},
+				err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+			{
+				name: "Wrong connection id prefix",
+				req:  &pbs.DownloadSessionRecordingRequest{Id: recorded.GetPublicId(), ConnectionId: "j_1234567890"},
+				err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+			{
+				name: "Missing connection id",
+				req:  &pbs.DownloadSessionRecordingRequest{Id: recorded.GetPublicId()},
+				err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				assert, require := assert.New(t), require.New(t)
+
+				s, err := sessions.NewService(kmsCache, sessRepoFn, iamRepoFn)
+				require.NoError(err, "Couldn't create new session service.")
+
+				stream := &recordingStream{ctx: auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId))}
+				gErr := s.DownloadSessionRecording(tc.req, stream)
+				if tc.err != nil {
+					require.Error(gErr)
+					assert.True(errors.Is(gErr, tc.err), "DownloadSessionRecording(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+					return
+				}
+				require.NoError(gErr)
+				assert.Equal(tc.wantError, stream.error != "")
+				r, err := recording.NewReader(bytes.NewReader(stream.data.Bytes()))
+				require.NoError(err)
+				assert.Equal(recorded.PublicId, r.Header().SessionId)
+				f, err := r.Next()
+				require.NoError(err)
+				assert.Equal("login: ", string(f.Data))
+			})
+		}
+	})
+}
@@ -6,18 +6,23 @@ import (
 	"fmt"
 	"math/rand"
 	"net/url"
+	"strings"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/boundary/internal/audit"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/targets"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dynamic"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
@@ -29,12 +34,15 @@ import (
 	"github.com/mr-tron/base58"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var (
-	maskManager handlers.MaskManager
+	maskManager     handlers.MaskManager
+	udpMaskManager  handlers.MaskManager
+	httpMaskManager handlers.MaskManager
 )
 
 func init() {
@@ -42,6 +50,12 @@ func init() {
 	if maskManager, err = handlers.NewMaskManager(&store.TcpTarget{}, &pb.Target{}, &pb.TcpTargetAttributes{}); err != nil {
 		panic(err)
 	}
+	if udpMaskManager, err = handlers.NewMaskManager(&store.UdpTarget{}, &pb.Target{}, &pb.UdpTargetAttributes{}); err != nil {
+		panic(err)
+	}
+	if httpMaskManager, err = handlers.NewMaskManager(&store.HttpTarget{}, &pb.Target{}, &pb.HttpTargetAttributes{}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.TargetServiceServer interface.
@@ -50,7 +64,8 @@ type Service struct {
 	iamRepoFn        common.IamRepoFactory
 	serversRepoFn    common.ServersRepoFactory
 	sessionRepoFn    common.SessionRepoFactory
-	staticHostRepoFn common.StaticRepoFactory
+	hostPluginFn     common.HostPluginFactory
+	credentialRepoFn common.CredentialRepoFactory
 	kmsCache         *kms.Kms
 }
 
@@ -61,7 +76,8 @@ func NewService(
 	iamRepoFn common.IamRepoFactory,
 	serversRepoFn common.ServersRepoFactory,
 	sessionRepoFn common.SessionRepoFactory,
-	staticHostRepoFn common.StaticRepoFactory) (Service, error) {
+	hostPluginFn common.HostPluginFactory,
+	credentialRepoFn common.CredentialRepoFactory) (Service, error) {
 	if repoFn == nil {
 		return Service{}, fmt.Errorf("nil target repository provided")
 	}
@@ -74,15 +90,19 @@ func NewService(
 	if sessionRepoFn == nil {
 		return Service{}, fmt.Errorf("nil session repository provided")
 	}
-	if staticHostRepoFn == nil {
-		return Service{}, fmt.Errorf("nil static host repository provided")
+	if hostPluginFn == nil {
+		return Service{}, fmt.Errorf("nil host plugin factory provided")
+	}
+	if credentialRepoFn == nil {
+		return Service{}, fmt.Errorf("nil credential repository provided")
 	}
 	return Service{
 		repoFn:           repoFn,
 		iamRepoFn:        iamRepoFn,
 		serversRepoFn:    serversRepoFn,
 		sessionRepoFn:    sessionRepoFn,
-		staticHostRepoFn: staticHostRepoFn,
+		hostPluginFn:     hostPluginFn,
+		credentialRepoFn: credentialRepoFn,
 		kmsCache:         kmsCache,
 	}, nil
 }
@@ -95,17 +115,30 @@ func (s Service) ListTargets(ctx context.Context, req *pbs.ListTargetsRequest) (
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeInfos, err := scopeids.GetListingScopeIds(ctx, s.iamRepoFn, &authResults, req.GetScopeId(), resource.Target, req.GetRecursive())
+	if err != nil {
+		return nil, err
 	}
-	ul, err := s.listFromRepo(ctx, authResults.Scope.GetId())
+	page, err := handlers.PaginateList(ctx, auth.KmsFromContext(ctx), req, resource.Target, authResults.Scope.GetId(), &authResults, handlers.MergeListPages(scopeIds, func(scopeId string, after *db.PageAfter, limit int) ([]handlers.PageItem, error) {
+		ul, err := s.listFromRepo(ctx, scopeId, after, limit)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]handlers.PageItem, 0, len(ul))
+		for _, item := range ul {
+			item.Scope = scopeInfos[scopeId]
+			items = append(items, item)
+		}
+		return items, nil
+	}))
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range ul {
-		item.Scope = authResults.Scope
+	var ul []*pb.Target
+	for _, item := range page.Items {
+		ul = append(ul, item.(*pb.Target))
 	}
-	return &pbs.ListTargetsResponse{Items: ul}, nil
+	return &pbs.ListTargetsResponse{Items: ul, NextPageToken: page.NextPageToken}, nil
 }
 
 // GetTargets implements the interface pbs.TargetServiceServer.
@@ -226,6 +259,57 @@ func (s Service) RemoveTargetHostSets(ctx context.Context, req *pbs.RemoveTarget
 	return &pbs.RemoveTargetHostSetsResponse{Item: u}, nil
 }
 
+// AddTargetCredentials implements the interface pbs.TargetServiceServer.
+func (s Service) AddTargetCredentials(ctx context.Context, req *pbs.AddTargetCredentialsRequest) (*pbs.AddTargetCredentialsResponse, error) {
+	if err := validateAddCredentialsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.AddCredentials)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	u, err := s.addCredentialsInRepo(ctx, req.GetId(), req.GetCredentialIds(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	u.Scope = authResults.Scope
+	return &pbs.AddTargetCredentialsResponse{Item: u}, nil
+}
+
+// SetTargetCredentials implements the interface pbs.TargetServiceServer.
+func (s Service) SetTargetCredentials(ctx context.Context, req *pbs.SetTargetCredentialsRequest) (*pbs.SetTargetCredentialsResponse, error) {
+	if err := validateSetCredentialsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.SetCredentials)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	u, err := s.setCredentialsInRepo(ctx, req.GetId(), req.GetCredentialIds(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	u.Scope = authResults.Scope
+	return &pbs.SetTargetCredentialsResponse{Item: u}, nil
+}
+
+// RemoveTargetCredentials implements the interface pbs.TargetServiceServer.
+func (s Service) RemoveTargetCredentials(ctx context.Context, req *pbs.RemoveTargetCredentialsRequest) (*pbs.RemoveTargetCredentialsResponse, error) {
+	if err := validateRemoveCredentialsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RemoveCredentials)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	u, err := s.removeCredentialsInRepo(ctx, req.GetId(), req.GetCredentialIds(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	u.Scope = authResults.Scope
+	return &pbs.RemoveTargetCredentialsResponse{Item: u}, nil
+}
+
 func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSessionRequest) (*pbs.AuthorizeSessionResponse, error) {
 	if err := validateAuthorizeSessionRequest(req); err != nil {
 		return nil, err
@@ -278,7 +362,33 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		return nil, err
 	}
 
-	// First, fetch all available hosts. Unless one was chosen in the request,
+	// Fetch the workers that may handle the session, narrowed down by the
+	// worker filter of the target if it has one. Draining workers don't take
+	// new sessions.
+	allWorkers, err := serversRepo.ListServers(ctx, servers.ServerTypeWorker)
+	if err != nil {
+		return nil, err
+	}
+	allWorkers = servers.ActiveWorkers(allWorkers)
+	workerServers := allWorkers
+	if t.GetWorkerFilter() != "" {
+		filter, err := servers.NewWorkerFilter(t.GetWorkerFilter())
+		if err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to parse the worker filter of the target: %v.", err)
+		}
+		workerServers = servers.FilterWorkers(workerServers, filter)
+	}
+	var workers []*pb.WorkerInfo
+	// Workers are listed in order of preference as clients use the first
+	// one they can connect to
+	for _, v := range servers.RankWorkers(servers.EntryWorkers(allWorkers, workerServers), req.GetWorkerRegion()) {
+		workers = append(workers, &pb.WorkerInfo{Address: v.Address})
+	}
+	if t.GetWorkerFilter() != "" && len(workers) == 0 {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "No workers are available that match the worker filter of the target.")
+	}
+
+	// Next, fetch all available hosts. Unless one was chosen in the request,
 	// we will pick one at random.
 	type compoundHost struct {
 		hostSetId string
@@ -287,29 +397,26 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 
 	var chosenId *compoundHost
 	requestedId := req.GetHostId()
-	staticHostRepo, err := s.staticHostRepoFn()
-	if err != nil {
-		return nil, err
-	}
 
 	hostIds := make([]compoundHost, 0, len(hostSets)*10)
 
 HostSetIterationLoop:
 	for _, tSet := range hostSets {
 		hsId := tSet.PublicId
-		switch host.SubtypeFromId(hsId) {
-		case host.StaticSubtype:
-			_, hosts, err := staticHostRepo.LookupSet(ctx, hsId)
-			if err != nil {
-				return nil, err
-			}
-			for _, host := range hosts {
-				compoundId := compoundHost{hostSetId: hsId, hostId: host.PublicId}
-				hostIds = append(hostIds, compoundId)
-				if host.PublicId == requestedId {
-					chosenId = &compoundId
-					break HostSetIterationLoop
-				}
+		hostPlugin, err := s.hostPluginFn(host.SubtypeFromId(hsId))
+		if err != nil {
+			return nil, err
+		}
+		hosts, err := hostPlugin.ResolveMembers(ctx, hsId)
+		if err != nil {
+			return nil, err
+		}
+		for _, host := range hosts {
+			compoundId := compoundHost{hostSetId: hsId, hostId: host.PublicId}
+			hostIds = append(hostIds, compoundId)
+			if host.PublicId == requestedId {
+				chosenId = &compoundId
+				break HostSetIterationLoop
 			}
 		}
 	}
@@ -333,18 +440,24 @@ HostSetIterationLoop:
 	endpointUrl := &url.URL{
 		Scheme: t.GetType(),
 	}
+	if ht, ok := t.(*target.HttpTarget); ok && ht.GetUseTls() {
+		endpointUrl.Scheme = "https"
+	}
 	defaultPort := t.GetDefaultPort()
-	var endpointHost string
-	switch host.SubtypeFromId(chosenId.hostId) {
-	case host.StaticSubtype:
-		h, err := staticHostRepo.LookupHost(ctx, chosenId.hostId)
-		if err != nil {
-			return nil, fmt.Errorf("error looking up host: %w", err)
-		}
-		endpointHost = h.Address
-		if endpointHost == "" {
-			return nil, errors.New("host had empty address")
-		}
+	hostPlugin, err := s.hostPluginFn(host.SubtypeFromId(chosenId.hostId))
+	if err != nil {
+		return nil, err
+	}
+	h, err := hostPlugin.LookupHost(ctx, chosenId.hostId)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up host: %w", err)
+	}
+	if h == nil {
+		return nil, handlers.NotFoundErrorf("Host %q not found.", chosenId.hostId)
+	}
+	endpointHost := h.Address
+	if endpointHost == "" {
+		return nil, errors.New("host had empty address")
 	}
 	if defaultPort != 0 {
 		endpointUrl.Host = fmt.Sprintf("%s:%d", endpointHost, defaultPort)
@@ -378,27 +491,37 @@ HostSetIterationLoop:
 	if err != nil {
 		return nil, err
 	}
+	audit.Emit(ctx, &audit.Event{
+		Type:     audit.SessionEvent,
+		Resource: &audit.Resource{Id: sess.PublicId, Type: resource.Session.String(), ScopeId: sess.ScopeId},
+		Action:   action.AuthorizeSession.String(),
+		Result:   &audit.Result{Status: audit.StatusSuccess},
+		Attributes: map[string]string{
+			"target_id":   t.GetPublicId(),
+			"host_id":     chosenId.hostId,
+			"host_set_id": chosenId.hostSetId,
+			"endpoint":    endpointUrl.String(),
+		},
+	})
 
-	var workers []*pb.WorkerInfo
-	servers, err := serversRepo.ListServers(ctx, servers.ServerTypeWorker)
+	creds, err := s.sessionCredentials(ctx, t)
 	if err != nil {
 		return nil, err
 	}
-	for _, v := range servers {
-		workers = append(workers, &pb.WorkerInfo{Address: v.Address})
-	}
 
 	sad := &pb.SessionAuthorizationData{
-		SessionId:       sess.PublicId,
-		TargetId:        t.GetPublicId(),
-		Scope:           authResults.Scope,
-		CreatedTime:     sess.CreateTime.GetTimestamp(),
-		Type:            t.GetType(),
-		Certificate:     sess.Certificate,
-		PrivateKey:      privKey,
-		HostId:          chosenId.hostId,
-		WorkerInfo:      workers,
-		ConnectionLimit: t.GetSessionConnectionLimit(),
+		SessionId:           sess.PublicId,
+		TargetId:            t.GetPublicId(),
+		Scope:               authResults.Scope,
+		CreatedTime:         sess.CreateTime.GetTimestamp(),
+		Type:                t.GetType(),
+		Certificate:         sess.Certificate,
+		PrivateKey:          privKey,
+		HostId:              chosenId.hostId,
+		WorkerInfo:          workers,
+		ConnectionLimit:     t.GetSessionConnectionLimit(),
+		Credentials:         creds,
+		CredentialInjection: t.GetCredentialInjection(),
 	}
 	marshaledSad, err := proto.Marshal(sad)
 	if err != nil {
@@ -407,19 +530,59 @@ HostSetIterationLoop:
 	encodedMarshaledSad := base58.FastBase58Encoding(marshaledSad)
 
 	ret := &pb.SessionAuthorization{
-		SessionId:          sess.PublicId,
-		TargetId:           t.GetPublicId(),
-		Scope:              authResults.Scope,
-		CreatedTime:        sess.CreateTime.GetTimestamp(),
-		Type:               t.GetType(),
-		AuthorizationToken: string(encodedMarshaledSad),
-		UserId:             authResults.UserId,
-		HostId:             chosenId.hostId,
-		HostSetId:          chosenId.hostSetId,
+		SessionId:           sess.PublicId,
+		TargetId:            t.GetPublicId(),
+		Scope:               authResults.Scope,
+		CreatedTime:         sess.CreateTime.GetTimestamp(),
+		Type:                t.GetType(),
+		AuthorizationToken:  string(encodedMarshaledSad),
+		UserId:              authResults.UserId,
+		HostId:              chosenId.hostId,
+		HostSetId:           chosenId.hostSetId,
+		Credentials:         creds,
+		CredentialInjection: t.GetCredentialInjection(),
 	}
 	return &pbs.AuthorizeSessionResponse{Item: ret}, nil
 }
 
+// sessionCredentials returns the credentials of the target t for a session.
+// The passwords are only included if the credentials are brokered to the
+// user, i.e. they are not injected by the worker.
+func (s Service) sessionCredentials(ctx context.Context, t target.Target) ([]*pb.SessionCredential, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	credIds, err := repo.ListTargetCredentialIds(ctx, t.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+	if len(credIds) == 0 {
+		return nil, nil
+	}
+	credRepo, err := s.credentialRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	found, err := credRepo.LookupCredentialsWithSecret(ctx, t.GetScopeId(), credIds)
+	if err != nil {
+		return nil, err
+	}
+	creds := make([]*pb.SessionCredential, 0, len(found))
+	for _, c := range found {
+		sc := &pb.SessionCredential{
+			CredentialId:      c.GetPublicId(),
+			CredentialStoreId: c.GetStoreId(),
+			Username:          c.GetUsername(),
+		}
+		if t.GetCredentialInjection() == "" {
+			sc.Password = string(c.GetPassword())
+		}
+		creds = append(creds, sc)
+	}
+	return creds, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*pb.Target, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -435,10 +598,20 @@ func (s Service) getFromRepo(ctx context.Context, id string) (*pb.Target, error)
 	if u == nil {
 		return nil, handlers.NotFoundErrorf("Target %q doesn't exist.", id)
 	}
-	return toProto(u, m)
+	credIds, err := repo.ListTargetCredentialIds(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toProto(u, m, credIds)
 }
 
 func (s Service) createInRepo(ctx context.Context, item *pb.Target) (*pb.Target, error) {
+	switch target.SubtypeFromType(item.GetType()) {
+	case target.UdpSubType:
+		return s.createUdpInRepo(ctx, item)
+	case target.HttpSubType:
+		return s.createHttpInRepo(ctx, item)
+	}
 	opts := []target.Option{target.WithName(item.GetName().GetValue())}
 	if item.GetDescription() != nil {
 		opts = append(opts, target.WithDescription(item.GetDescription().GetValue()))
@@ -449,6 +622,9 @@ func (s Service) createInRepo(ctx context.Context, item *pb.Target) (*pb.Target,
 	if item.GetSessionConnectionLimit() != nil {
 		opts = append(opts, target.WithSessionConnectionLimit(item.GetSessionConnectionLimit().GetValue()))
 	}
+	if item.GetWorkerFilter() != nil {
+		opts = append(opts, target.WithWorkerFilter(item.GetWorkerFilter().GetValue()))
+	}
 	tcpAttrs := &pb.TcpTargetAttributes{}
 	if err := handlers.StructToProto(item.GetAttributes(), tcpAttrs); err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "Provided attributes don't match expected format.")
@@ -456,6 +632,18 @@ func (s Service) createInRepo(ctx context.Context, item *pb.Target) (*pb.Target,
 	if tcpAttrs.GetDefaultPort().GetValue() != 0 {
 		opts = append(opts, target.WithDefaultPort(tcpAttrs.GetDefaultPort().GetValue()))
 	}
+	if tcpAttrs.GetRecordSessions() != nil {
+		opts = append(opts, target.WithRecordSessions(tcpAttrs.GetRecordSessions().GetValue()))
+	}
+	if tcpAttrs.GetCredentialInjection() != nil {
+		opts = append(opts, target.WithCredentialInjection(tcpAttrs.GetCredentialInjection().GetValue()))
+	}
+	if tcpAttrs.GetSessionMaxBytesPerSecond() != nil {
+		opts = append(opts, target.WithSessionMaxBytesPerSecond(tcpAttrs.GetSessionMaxBytesPerSecond().GetValue()))
+	}
+	if tcpAttrs.GetConnectionIdleTimeoutSeconds() != nil {
+		opts = append(opts, target.WithConnectionIdleTimeoutSeconds(tcpAttrs.GetConnectionIdleTimeoutSeconds().GetValue()))
+	}
 	u, err := target.NewTcpTarget(item.GetScopeId(), opts...)
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build target for creation: %v.", err)
@@ -471,10 +659,16 @@ func (s Service) createInRepo(ctx context.Context, item *pb.Target) (*pb.Target,
 	if out == nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create target but no error returned from repository.")
 	}
-	return toProto(out, m)
+	return toProto(out, m, nil)
 }
 
 func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.Target) (*pb.Target, error) {
+	switch target.SubtypeFromId(id) {
+	case target.UdpSubType:
+		return s.updateUdpInRepo(ctx, scopeId, id, mask, item)
+	case target.HttpSubType:
+		return s.updateHttpInRepo(ctx, scopeId, id, mask, item)
+	}
 	var opts []target.Option
 	if desc := item.GetDescription(); desc != nil {
 		opts = append(opts, target.WithDescription(desc.GetValue()))
@@ -488,6 +682,9 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	if item.GetSessionConnectionLimit() != nil {
 		opts = append(opts, target.WithSessionConnectionLimit(item.GetSessionConnectionLimit().GetValue()))
 	}
+	if item.GetWorkerFilter() != nil {
+		opts = append(opts, target.WithWorkerFilter(item.GetWorkerFilter().GetValue()))
+	}
 	tcpAttrs := &pb.TcpTargetAttributes{}
 	if err := handlers.StructToProto(item.GetAttributes(), tcpAttrs); err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "Provided attributes don't match expected format.")
@@ -495,6 +692,18 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	if tcpAttrs.GetDefaultPort().GetValue() != 0 {
 		opts = append(opts, target.WithDefaultPort(tcpAttrs.GetDefaultPort().GetValue()))
 	}
+	if tcpAttrs.GetRecordSessions() != nil {
+		opts = append(opts, target.WithRecordSessions(tcpAttrs.GetRecordSessions().GetValue()))
+	}
+	if tcpAttrs.GetCredentialInjection() != nil {
+		opts = append(opts, target.WithCredentialInjection(tcpAttrs.GetCredentialInjection().GetValue()))
+	}
+	if tcpAttrs.GetSessionMaxBytesPerSecond() != nil {
+		opts = append(opts, target.WithSessionMaxBytesPerSecond(tcpAttrs.GetSessionMaxBytesPerSecond().GetValue()))
+	}
+	if tcpAttrs.GetConnectionIdleTimeoutSeconds() != nil {
+		opts = append(opts, target.WithConnectionIdleTimeoutSeconds(tcpAttrs.GetConnectionIdleTimeoutSeconds().GetValue()))
+	}
 	version := item.GetVersion()
 	u, err := target.NewTcpTarget(scopeId, opts...)
 	if err != nil {
@@ -510,13 +719,250 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 		return nil, err
 	}
 	out, m, rowsUpdated, err := repo.UpdateTcpTarget(ctx, u, version, dbMask)
+	if errors.Is(err, target.ErrMultipleInjectedCredentials) {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{"attributes.credential_injection": "Credentials can only be injected by targets with a single credential."})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to update target: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Target %q not found or incorrect version provided.", id)
+	}
+	credIds, err := repo.ListTargetCredentialIds(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toProto(out, m, credIds)
+}
+
+func (s Service) createUdpInRepo(ctx context.Context, item *pb.Target) (*pb.Target, error) {
+	opts := []target.Option{target.WithName(item.GetName().GetValue())}
+	if item.GetDescription() != nil {
+		opts = append(opts, target.WithDescription(item.GetDescription().GetValue()))
+	}
+	if item.GetSessionMaxSeconds() != nil {
+		opts = append(opts, target.WithSessionMaxSeconds(item.GetSessionMaxSeconds().GetValue()))
+	}
+	if item.GetSessionConnectionLimit() != nil {
+		opts = append(opts, target.WithSessionConnectionLimit(item.GetSessionConnectionLimit().GetValue()))
+	}
+	if item.GetWorkerFilter() != nil {
+		opts = append(opts, target.WithWorkerFilter(item.GetWorkerFilter().GetValue()))
+	}
+	udpAttrs := &pb.UdpTargetAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), udpAttrs); err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "Provided attributes don't match expected format.")
+	}
+	if udpAttrs.GetDefaultPort().GetValue() != 0 {
+		opts = append(opts, target.WithDefaultPort(udpAttrs.GetDefaultPort().GetValue()))
+	}
+	if udpAttrs.GetSessionMaxBytesPerSecond() != nil {
+		opts = append(opts, target.WithSessionMaxBytesPerSecond(udpAttrs.GetSessionMaxBytesPerSecond().GetValue()))
+	}
+	if udpAttrs.GetConnectionIdleTimeoutSeconds() != nil {
+		opts = append(opts, target.WithConnectionIdleTimeoutSeconds(udpAttrs.GetConnectionIdleTimeoutSeconds().GetValue()))
+	}
+	u, err := target.NewUdpTarget(item.GetScopeId(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build target for creation: %v.", err)
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, m, err := repo.CreateUdpTarget(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create target: %w", err)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create target but no error returned from repository.")
+	}
+	return toProto(out, m, nil)
+}
+
+func (s Service) updateUdpInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.Target) (*pb.Target, error) {
+	var opts []target.Option
+	if desc := item.GetDescription(); desc != nil {
+		opts = append(opts, target.WithDescription(desc.GetValue()))
+	}
+	if name := item.GetName(); name != nil {
+		opts = append(opts, target.WithName(name.GetValue()))
+	}
+	if item.GetSessionMaxSeconds() != nil {
+		opts = append(opts, target.WithSessionMaxSeconds(item.GetSessionMaxSeconds().GetValue()))
+	}
+	if item.GetSessionConnectionLimit() != nil {
+		opts = append(opts, target.WithSessionConnectionLimit(item.GetSessionConnectionLimit().GetValue()))
+	}
+	if item.GetWorkerFilter() != nil {
+		opts = append(opts, target.WithWorkerFilter(item.GetWorkerFilter().GetValue()))
+	}
+	udpAttrs := &pb.UdpTargetAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), udpAttrs); err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "Provided attributes don't match expected format.")
+	}
+	if udpAttrs.GetDefaultPort().GetValue() != 0 {
+		opts = append(opts, target.WithDefaultPort(udpAttrs.GetDefaultPort().GetValue()))
+	}
+	if udpAttrs.GetSessionMaxBytesPerSecond() != nil {
+		opts = append(opts, target.WithSessionMaxBytesPerSecond(udpAttrs.GetSessionMaxBytesPerSecond().GetValue()))
+	}
+	if udpAttrs.GetConnectionIdleTimeoutSeconds() != nil {
+		opts = append(opts, target.WithConnectionIdleTimeoutSeconds(udpAttrs.GetConnectionIdleTimeoutSeconds().GetValue()))
+	}
+	version := item.GetVersion()
+	u, err := target.NewUdpTarget(scopeId, opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build target for update: %v.", err)
+	}
+	u.PublicId = id
+	dbMask := udpMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid paths provided in the update mask."})
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, m, rowsUpdated, err := repo.UpdateUdpTarget(ctx, u, version, dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update target: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Target %q not found or incorrect version provided.", id)
+	}
+	credIds, err := repo.ListTargetCredentialIds(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toProto(out, m, credIds)
+}
+
+func (s Service) createHttpInRepo(ctx context.Context, item *pb.Target) (*pb.Target, error) {
+	opts := []target.Option{target.WithName(item.GetName().GetValue())}
+	if item.GetDescription() != nil {
+		opts = append(opts, target.WithDescription(item.GetDescription().GetValue()))
+	}
+	if item.GetSessionMaxSeconds() != nil {
+		opts = append(opts, target.WithSessionMaxSeconds(item.GetSessionMaxSeconds().GetValue()))
+	}
+	if item.GetSessionConnectionLimit() != nil {
+		opts = append(opts, target.WithSessionConnectionLimit(item.GetSessionConnectionLimit().GetValue()))
+	}
+	if item.GetWorkerFilter() != nil {
+		opts = append(opts, target.WithWorkerFilter(item.GetWorkerFilter().GetValue()))
+	}
+	httpAttrs := &pb.HttpTargetAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), httpAttrs); err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "Provided attributes don't match expected format.")
+	}
+	if httpAttrs.GetDefaultPort().GetValue() != 0 {
+		opts = append(opts, target.WithDefaultPort(httpAttrs.GetDefaultPort().GetValue()))
+	}
+	if httpAttrs.GetSessionMaxBytesPerSecond() != nil {
+		opts = append(opts, target.WithSessionMaxBytesPerSecond(httpAttrs.GetSessionMaxBytesPerSecond().GetValue()))
+	}
+	if httpAttrs.GetConnectionIdleTimeoutSeconds() != nil {
+		opts = append(opts, target.WithConnectionIdleTimeoutSeconds(httpAttrs.GetConnectionIdleTimeoutSeconds().GetValue()))
+	}
+	if httpAttrs.GetUseTls() != nil {
+		opts = append(opts, target.WithUseTls(httpAttrs.GetUseTls().GetValue()))
+	}
+	if len(httpAttrs.GetAllowedMethods()) > 0 {
+		opts = append(opts, target.WithAllowedMethods(httpAttrs.GetAllowedMethods()))
+	}
+	if len(httpAttrs.GetAllowedPathPrefixes()) > 0 {
+		opts = append(opts, target.WithAllowedPathPrefixes(httpAttrs.GetAllowedPathPrefixes()))
+	}
+	if len(httpAttrs.GetInjectedHeaders()) > 0 {
+		opts = append(opts, target.WithInjectedHeaders(httpAttrs.GetInjectedHeaders()))
+	}
+	u, err := target.NewHttpTarget(item.GetScopeId(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build target for creation: %v.", err)
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, m, err := repo.CreateHttpTarget(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create target: %w", err)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create target but no error returned from repository.")
+	}
+	return toProto(out, m, nil)
+}
+
+func (s Service) updateHttpInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.Target) (*pb.Target, error) {
+	var opts []target.Option
+	if desc := item.GetDescription(); desc != nil {
+		opts = append(opts, target.WithDescription(desc.GetValue()))
+	}
+	if name := item.GetName(); name != nil {
+		opts = append(opts, target.WithName(name.GetValue()))
+	}
+	if item.GetSessionMaxSeconds() != nil {
+		opts = append(opts, target.WithSessionMaxSeconds(item.GetSessionMaxSeconds().GetValue()))
+	}
+	if item.GetSessionConnectionLimit() != nil {
+		opts = append(opts, target.WithSessionConnectionLimit(item.GetSessionConnectionLimit().GetValue()))
+	}
+	if item.GetWorkerFilter() != nil {
+		opts = append(opts, target.WithWorkerFilter(item.GetWorkerFilter().GetValue()))
+	}
+	httpAttrs := &pb.HttpTargetAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), httpAttrs); err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "Provided attributes don't match expected format.")
+	}
+	if httpAttrs.GetDefaultPort().GetValue() != 0 {
+		opts = append(opts, target.WithDefaultPort(httpAttrs.GetDefaultPort().GetValue()))
+	}
+	if httpAttrs.GetSessionMaxBytesPerSecond() != nil {
+		opts = append(opts, target.WithSessionMaxBytesPerSecond(httpAttrs.GetSessionMaxBytesPerSecond().GetValue()))
+	}
+	if httpAttrs.GetConnectionIdleTimeoutSeconds() != nil {
+		opts = append(opts, target.WithConnectionIdleTimeoutSeconds(httpAttrs.GetConnectionIdleTimeoutSeconds().GetValue()))
+	}
+	if httpAttrs.GetUseTls() != nil {
+		opts = append(opts, target.WithUseTls(httpAttrs.GetUseTls().GetValue()))
+	}
+	if len(httpAttrs.GetAllowedMethods()) > 0 {
+		opts = append(opts, target.WithAllowedMethods(httpAttrs.GetAllowedMethods()))
+	}
+	if len(httpAttrs.GetAllowedPathPrefixes()) > 0 {
+		opts = append(opts, target.WithAllowedPathPrefixes(httpAttrs.GetAllowedPathPrefixes()))
+	}
+	if len(httpAttrs.GetInjectedHeaders()) > 0 {
+		opts = append(opts, target.WithInjectedHeaders(httpAttrs.GetInjectedHeaders()))
+	}
+	version := item.GetVersion()
+	u, err := target.NewHttpTarget(scopeId, opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build target for update: %v.", err)
+	}
+	u.PublicId = id
+	dbMask := httpMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid paths provided in the update mask."})
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, m, rowsUpdated, err := repo.UpdateHttpTarget(ctx, u, version, dbMask)
 	if err != nil {
 		return nil, fmt.Errorf("unable to update target: %w", err)
 	}
 	if rowsUpdated == 0 {
 		return nil, handlers.NotFoundErrorf("Target %q not found or incorrect version provided.", id)
 	}
-	return toProto(out, m)
+	credIds, err := repo.ListTargetCredentialIds(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toProto(out, m, credIds)
 }
 
 func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
@@ -534,18 +980,18 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.Target, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeId string, after *db.PageAfter, limit int) ([]*pb.Target, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListTargets(ctx, target.WithScopeId(scopeId))
+	ul, err := repo.ListTargets(ctx, target.WithScopeId(scopeId), target.WithPageAfter(after), target.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
 	var outUl []*pb.Target
 	for _, u := range ul {
-		o, err := toProto(u, nil)
+		o, err := toProto(u, nil, nil)
 		if err != nil {
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to convert value to proto: %v.", err)
 		}
@@ -567,7 +1013,11 @@ func (s Service) addInRepo(ctx context.Context, targetId string, hostSetId []str
 	if out == nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to lookup target after adding host sets to it.")
 	}
-	return toProto(out, m)
+	credIds, err := repo.ListTargetCredentialIds(ctx, targetId)
+	if err != nil {
+		return nil, err
+	}
+	return toProto(out, m, credIds)
 }
 
 func (s Service) setInRepo(ctx context.Context, targetId string, hostSetIds []string, version uint32) (*pb.Target, error) {
@@ -588,7 +1038,11 @@ func (s Service) setInRepo(ctx context.Context, targetId string, hostSetIds []st
 	if out == nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to lookup target after setting host sets for it.")
 	}
-	return toProto(out, m)
+	credIds, err := repo.ListTargetCredentialIds(ctx, targetId)
+	if err != nil {
+		return nil, err
+	}
+	return toProto(out, m, credIds)
 }
 
 func (s Service) removeInRepo(ctx context.Context, targetId string, hostSetIds []string, version uint32) (*pb.Target, error) {
@@ -608,7 +1062,87 @@ func (s Service) removeInRepo(ctx context.Context, targetId string, hostSetIds [
 	if out == nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to lookup target after removing host sets from it.")
 	}
-	return toProto(out, m)
+	credIds, err := repo.ListTargetCredentialIds(ctx, targetId)
+	if err != nil {
+		return nil, err
+	}
+	return toProto(out, m, credIds)
+}
+
+func (s Service) addCredentialsInRepo(ctx context.Context, targetId string, credentialIds []string, version uint32) (*pb.Target, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	_, credIds, err := repo.AddTargetCredentials(ctx, targetId, version, strutil.RemoveDuplicates(credentialIds, false))
+	if errors.Is(err, target.ErrMultipleInjectedCredentials) {
+		return nil, multipleInjectedCredentialsError()
+	}
+	if err != nil {
+		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to add credentials to target: %v.", err)
+	}
+	out, m, err := repo.LookupTarget(ctx, targetId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to look up target after adding credentials: %w", err)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to lookup target after adding credentials to it.")
+	}
+	return toProto(out, m, credIds)
+}
+
+func (s Service) setCredentialsInRepo(ctx context.Context, targetId string, credentialIds []string, version uint32) (*pb.Target, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	credIds, _, err := repo.SetTargetCredentials(ctx, targetId, version, strutil.RemoveDuplicates(credentialIds, false))
+	if errors.Is(err, target.ErrMultipleInjectedCredentials) {
+		return nil, multipleInjectedCredentialsError()
+	}
+	if err != nil {
+		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to set credentials in target: %v.", err)
+	}
+	out, m, err := repo.LookupTarget(ctx, targetId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to look up target after setting credentials: %w", err)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to lookup target after setting credentials for it.")
+	}
+	return toProto(out, m, credIds)
+}
+
+// multipleInjectedCredentialsError is returned when a change would attach
+// more than one credential to a target injecting its credential
+func multipleInjectedCredentialsError() error {
+	return handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{"credential_ids": "Targets injecting their credential can only have a single credential."})
+}
+
+func (s Service) removeCredentialsInRepo(ctx context.Context, targetId string, credentialIds []string, version uint32) (*pb.Target, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	_, err = repo.DeleteTargetCredentials(ctx, targetId, version, strutil.RemoveDuplicates(credentialIds, false))
+	if err != nil {
+		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to remove credentials from target: %v.", err)
+	}
+	out, m, err := repo.LookupTarget(ctx, targetId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to look up target after removing credentials: %w", err)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to lookup target after removing credentials from it.")
+	}
+	credIds, err := repo.ListTargetCredentialIds(ctx, targetId)
+	if err != nil {
+		return nil, err
+	}
+	return toProto(out, m, credIds)
 }
 
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
@@ -655,14 +1189,14 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 	return auth.Verify(ctx, opts...)
 }
 
-func toProto(in target.Target, m []*target.TargetSet) (*pb.Target, error) {
+func toProto(in target.Target, m []*target.TargetSet, credIds []string) (*pb.Target, error) {
 	out := pb.Target{
 		Id:                     in.GetPublicId(),
 		ScopeId:                in.GetScopeId(),
 		CreatedTime:            in.GetCreateTime().GetTimestamp(),
 		UpdatedTime:            in.GetUpdateTime().GetTimestamp(),
 		Version:                in.GetVersion(),
-		Type:                   target.TcpTargetType.String(),
+		Type:                   in.GetType(),
 		SessionMaxSeconds:      wrapperspb.UInt32(in.GetSessionMaxSeconds()),
 		SessionConnectionLimit: wrapperspb.Int32(in.GetSessionConnectionLimit()),
 	}
@@ -672,9 +1206,61 @@ func toProto(in target.Target, m []*target.TargetSet) (*pb.Target, error) {
 	if in.GetName() != "" {
 		out.Name = wrapperspb.String(in.GetName())
 	}
-	attrs := &pb.TcpTargetAttributes{}
-	if in.GetDefaultPort() > 0 {
-		attrs.DefaultPort = &wrappers.UInt32Value{Value: in.GetDefaultPort()}
+	if in.GetWorkerFilter() != "" {
+		out.WorkerFilter = wrapperspb.String(in.GetWorkerFilter())
+	}
+	var attrs proto.Message
+	switch in.GetType() {
+	case target.UdpTargetType.String():
+		udpAttrs := &pb.UdpTargetAttributes{}
+		if in.GetDefaultPort() > 0 {
+			udpAttrs.DefaultPort = &wrappers.UInt32Value{Value: in.GetDefaultPort()}
+		}
+		if in.GetSessionMaxBytesPerSecond() > 0 {
+			udpAttrs.SessionMaxBytesPerSecond = &wrappers.UInt32Value{Value: in.GetSessionMaxBytesPerSecond()}
+		}
+		if in.GetConnectionIdleTimeoutSeconds() > 0 {
+			udpAttrs.ConnectionIdleTimeoutSeconds = &wrappers.UInt32Value{Value: in.GetConnectionIdleTimeoutSeconds()}
+		}
+		attrs = udpAttrs
+	case target.HttpTargetType.String():
+		httpAttrs := &pb.HttpTargetAttributes{}
+		if in.GetDefaultPort() > 0 {
+			httpAttrs.DefaultPort = &wrappers.UInt32Value{Value: in.GetDefaultPort()}
+		}
+		if in.GetSessionMaxBytesPerSecond() > 0 {
+			httpAttrs.SessionMaxBytesPerSecond = &wrappers.UInt32Value{Value: in.GetSessionMaxBytesPerSecond()}
+		}
+		if in.GetConnectionIdleTimeoutSeconds() > 0 {
+			httpAttrs.ConnectionIdleTimeoutSeconds = &wrappers.UInt32Value{Value: in.GetConnectionIdleTimeoutSeconds()}
+		}
+		if ht, ok := in.(*target.HttpTarget); ok {
+			if ht.GetUseTls() {
+				httpAttrs.UseTls = &wrappers.BoolValue{Value: true}
+			}
+			httpAttrs.AllowedMethods = ht.Methods()
+			httpAttrs.AllowedPathPrefixes = ht.PathPrefixes()
+			httpAttrs.InjectedHeaders = ht.Headers()
+		}
+		attrs = httpAttrs
+	default:
+		tcpAttrs := &pb.TcpTargetAttributes{}
+		if in.GetDefaultPort() > 0 {
+			tcpAttrs.DefaultPort = &wrappers.UInt32Value{Value: in.GetDefaultPort()}
+		}
+		if in.GetRecordSessions() {
+			tcpAttrs.RecordSessions = &wrappers.BoolValue{Value: true}
+		}
+		if in.GetCredentialInjection() != "" {
+			tcpAttrs.CredentialInjection = &wrappers.StringValue{Value: in.GetCredentialInjection()}
+		}
+		if in.GetSessionMaxBytesPerSecond() > 0 {
+			tcpAttrs.SessionMaxBytesPerSecond = &wrappers.UInt32Value{Value: in.GetSessionMaxBytesPerSecond()}
+		}
+		if in.GetConnectionIdleTimeoutSeconds() > 0 {
+			tcpAttrs.ConnectionIdleTimeoutSeconds = &wrappers.UInt32Value{Value: in.GetConnectionIdleTimeoutSeconds()}
+		}
+		attrs = tcpAttrs
 	}
 	st, err := handlers.ProtoToStruct(attrs)
 	if err != nil {
@@ -688,6 +1274,7 @@ func toProto(in target.Target, m []*target.TargetSet) (*pb.Target, error) {
 			HostCatalogId: hs.GetCatalogId(),
 		})
 	}
+	out.CredentialIds = credIds
 	return &out, nil
 }
 
@@ -697,7 +1284,7 @@ func toProto(in target.Target, m []*target.TargetSet) (*pb.Target, error) {
 //  * All required parameters are set
 //  * There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetTargetRequest) error {
-	return handlers.ValidateGetRequest(target.TcpTargetPrefix, req, handlers.NoopValidatorFn)
+	return handlers.ValidateGetRequest(idPrefix(req.GetId()), req, handlers.NoopValidatorFn)
 }
 
 func validateCreateRequest(req *pbs.CreateTargetRequest) error {
@@ -721,6 +1308,11 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 		if req.GetItem().GetSessionMaxSeconds() != nil && req.GetItem().GetSessionMaxSeconds().GetValue() == 0 {
 			badFields["session_max_seconds"] = "This must be greater than zero."
 		}
+		if wf := req.GetItem().GetWorkerFilter(); wf != nil {
+			if _, err := servers.NewWorkerFilter(wf.GetValue()); err != nil {
+				badFields["worker_filter"] = fmt.Sprintf("Unable to parse the worker filter: %v.", err)
+			}
+		}
 		switch target.SubtypeFromType(req.GetItem().GetType()) {
 		case target.TcpSubType:
 			tcpAttrs := &pb.TcpTargetAttributes{}
@@ -730,9 +1322,22 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 			if tcpAttrs.GetDefaultPort() != nil && tcpAttrs.GetDefaultPort().GetValue() == 0 {
 				badFields["attributes.default_port"] = "This optional field cannot be set to 0."
 			}
+			if !validCredentialInjection(tcpAttrs.GetCredentialInjection().GetValue()) {
+				badFields["attributes.credential_injection"] = fmt.Sprintf("This optional field must be unset or %q.", target.PostgresCredentialInjection)
+			}
+		case target.UdpSubType:
+			udpAttrs := &pb.UdpTargetAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), udpAttrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+			}
+			if udpAttrs.GetDefaultPort() != nil && udpAttrs.GetDefaultPort().GetValue() == 0 {
+				badFields["attributes.default_port"] = "This optional field cannot be set to 0."
+			}
+		case target.HttpSubType:
+			validateHttpAttributes(req.GetItem().GetAttributes(), badFields)
 		}
 		switch req.GetItem().GetType() {
-		case target.TcpTargetType.String():
+		case target.TcpTargetType.String(), target.UdpTargetType.String(), target.HttpTargetType.String():
 		case "":
 			badFields["type"] = "This is a required field."
 		default:
@@ -743,7 +1348,7 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 }
 
 func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
-	return handlers.ValidateUpdateRequest(target.TcpTargetPrefix, req, req.GetItem(), func() map[string]string {
+	return handlers.ValidateUpdateRequest(idPrefix(req.GetId()), req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
 		if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "name") && req.GetItem().GetName().GetValue() == "" {
 			badFields["name"] = "This field cannot be set to empty."
@@ -760,7 +1365,12 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 		if req.GetItem().GetSessionMaxSeconds() != nil && req.GetItem().GetSessionMaxSeconds().GetValue() == 0 {
 			badFields["session_max_seconds"] = "This must be greater than zero."
 		}
-		switch target.SubtypeFromId(req.GetItem().GetType()) {
+		if wf := req.GetItem().GetWorkerFilter(); wf != nil {
+			if _, err := servers.NewWorkerFilter(wf.GetValue()); err != nil {
+				badFields["worker_filter"] = fmt.Sprintf("Unable to parse the worker filter: %v.", err)
+			}
+		}
+		switch target.SubtypeFromId(req.GetId()) {
 		case target.TcpSubType:
 			if req.GetItem().GetType() != "" && target.SubtypeFromType(req.GetItem().GetType()) != target.TcpSubType {
 				badFields["type"] = "Cannot modify the resource type."
@@ -772,20 +1382,49 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 			if tcpAttrs.GetDefaultPort() != nil && tcpAttrs.GetDefaultPort().GetValue() == 0 {
 				badFields["attributes.default_port"] = "This optional field cannot be set to 0."
 			}
+			if !validCredentialInjection(tcpAttrs.GetCredentialInjection().GetValue()) {
+				badFields["attributes.credential_injection"] = fmt.Sprintf("This optional field must be unset or %q.", target.PostgresCredentialInjection)
+			}
+		case target.UdpSubType:
+			if req.GetItem().GetType() != "" && target.SubtypeFromType(req.GetItem().GetType()) != target.UdpSubType {
+				badFields["type"] = "Cannot modify the resource type."
+			}
+			udpAttrs := &pb.UdpTargetAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), udpAttrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+			}
+			if udpAttrs.GetDefaultPort() != nil && udpAttrs.GetDefaultPort().GetValue() == 0 {
+				badFields["attributes.default_port"] = "This optional field cannot be set to 0."
+			}
+		case target.HttpSubType:
+			if req.GetItem().GetType() != "" && target.SubtypeFromType(req.GetItem().GetType()) != target.HttpSubType {
+				badFields["type"] = "Cannot modify the resource type."
+			}
+			validateHttpAttributes(req.GetItem().GetAttributes(), badFields)
 		}
 		return badFields
 	})
 }
 
 func validateDeleteRequest(req *pbs.DeleteTargetRequest) error {
-	return handlers.ValidateDeleteRequest(target.TcpTargetPrefix, req, handlers.NoopValidatorFn)
+	return handlers.ValidateDeleteRequest(idPrefix(req.GetId()), req, handlers.NoopValidatorFn)
 }
 
 func validateListRequest(req *pbs.ListTargetsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(scope.Project.Prefix(), req.GetScopeId()) {
+	switch {
+	case handlers.ValidId(scope.Project.Prefix(), req.GetScopeId()):
+	case req.GetRecursive() && (req.GetScopeId() == scope.Global.String() || handlers.ValidId(scope.Org.Prefix(), req.GetScopeId())):
+		// Recursive lists can start above the projects
+	default:
 		badFields["scope_id"] = "This field is required to have a properly formatted project scope id."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("Must be at most %d.", handlers.MaxPageSize)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
@@ -794,7 +1433,7 @@ func validateListRequest(req *pbs.ListTargetsRequest) error {
 
 func validateAddRequest(req *pbs.AddTargetHostSetsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(target.TcpTargetPrefix, req.GetId()) {
+	if !handlers.ValidId(idPrefix(req.GetId()), req.GetId()) {
 		badFields["id"] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
@@ -804,7 +1443,7 @@ func validateAddRequest(req *pbs.AddTargetHostSetsRequest) error {
 		badFields["host_set_ids"] = "Must be non-empty."
 	}
 	for _, id := range req.GetHostSetIds() {
-		if !handlers.ValidId(static.HostSetPrefix, id) {
+		if !handlers.ValidId(static.HostSetPrefix, id) && !handlers.ValidId(dynamic.HostSetPrefix, id) {
 			badFields["host_set_ids"] = fmt.Sprintf("Incorrectly formatted host set identifier %q.", id)
 			break
 		}
@@ -817,14 +1456,14 @@ func validateAddRequest(req *pbs.AddTargetHostSetsRequest) error {
 
 func validateSetRequest(req *pbs.SetTargetHostSetsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(target.TcpTargetPrefix, req.GetId()) {
+	if !handlers.ValidId(idPrefix(req.GetId()), req.GetId()) {
 		badFields["id"] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
 		badFields["version"] = "Required field."
 	}
 	for _, id := range req.GetHostSetIds() {
-		if !handlers.ValidId(static.HostSetPrefix, id) {
+		if !handlers.ValidId(static.HostSetPrefix, id) && !handlers.ValidId(dynamic.HostSetPrefix, id) {
 			badFields["host_set_ids"] = fmt.Sprintf("Incorrectly formatted host set identifier %q.", id)
 			break
 		}
@@ -837,7 +1476,7 @@ func validateSetRequest(req *pbs.SetTargetHostSetsRequest) error {
 
 func validateRemoveRequest(req *pbs.RemoveTargetHostSetsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(target.TcpTargetPrefix, req.GetId()) {
+	if !handlers.ValidId(idPrefix(req.GetId()), req.GetId()) {
 		badFields["id"] = "Incorrectly formatted identifier."
 	}
 	if req.GetVersion() == 0 {
@@ -847,7 +1486,7 @@ func validateRemoveRequest(req *pbs.RemoveTargetHostSetsRequest) error {
 		badFields["host_set_ids"] = "Must be non-empty."
 	}
 	for _, id := range req.GetHostSetIds() {
-		if !handlers.ValidId(static.HostSetPrefix, id) {
+		if !handlers.ValidId(static.HostSetPrefix, id) && !handlers.ValidId(dynamic.HostSetPrefix, id) {
 			badFields["host_set_ids"] = fmt.Sprintf("Incorrectly formatted host set identifier %q.", id)
 			break
 		}
@@ -858,14 +1497,130 @@ func validateRemoveRequest(req *pbs.RemoveTargetHostSetsRequest) error {
 	return nil
 }
 
+func validateAddCredentialsRequest(req *pbs.AddTargetCredentialsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(idPrefix(req.GetId()), req.GetId()) {
+		badFields["id"] = "Incorrectly formatted identifier."
+	}
+	if req.GetVersion() == 0 {
+		badFields["version"] = "Required field."
+	}
+	if len(req.GetCredentialIds()) == 0 {
+		badFields["credential_ids"] = "Must be non-empty."
+	}
+	for _, id := range req.GetCredentialIds() {
+		if !handlers.ValidId(credential.CredentialPrefix, id) {
+			badFields["credential_ids"] = fmt.Sprintf("Incorrectly formatted credential identifier %q.", id)
+			break
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateSetCredentialsRequest(req *pbs.SetTargetCredentialsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(idPrefix(req.GetId()), req.GetId()) {
+		badFields["id"] = "Incorrectly formatted identifier."
+	}
+	if req.GetVersion() == 0 {
+		badFields["version"] = "Required field."
+	}
+	for _, id := range req.GetCredentialIds() {
+		if !handlers.ValidId(credential.CredentialPrefix, id) {
+			badFields["credential_ids"] = fmt.Sprintf("Incorrectly formatted credential identifier %q.", id)
+			break
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateRemoveCredentialsRequest(req *pbs.RemoveTargetCredentialsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(idPrefix(req.GetId()), req.GetId()) {
+		badFields["id"] = "Incorrectly formatted identifier."
+	}
+	if req.GetVersion() == 0 {
+		badFields["version"] = "Required field."
+	}
+	if len(req.GetCredentialIds()) == 0 {
+		badFields["credential_ids"] = "Must be non-empty."
+	}
+	for _, id := range req.GetCredentialIds() {
+		if !handlers.ValidId(credential.CredentialPrefix, id) {
+			badFields["credential_ids"] = fmt.Sprintf("Incorrectly formatted credential identifier %q.", id)
+			break
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+// idPrefix returns the prefix of the subtype of the target with the given id,
+// defaulting to the tcp prefix so malformed ids fail validation as before.
+func idPrefix(id string) string {
+	switch target.SubtypeFromId(id) {
+	case target.UdpSubType:
+		return target.UdpTargetPrefix
+	case target.HttpSubType:
+		return target.HttpTargetPrefix
+	}
+	return target.TcpTargetPrefix
+}
+
+// validateHttpAttributes adds the errors in the attributes of an http target
+// to badFields.
+func validateHttpAttributes(attributes *structpb.Struct, badFields map[string]string) {
+	httpAttrs := &pb.HttpTargetAttributes{}
+	if err := handlers.StructToProto(attributes, httpAttrs); err != nil {
+		badFields["attributes"] = "Attribute fields do not match the expected format."
+		return
+	}
+	if httpAttrs.GetDefaultPort() != nil && httpAttrs.GetDefaultPort().GetValue() == 0 {
+		badFields["attributes.default_port"] = "This optional field cannot be set to 0."
+	}
+	for _, m := range httpAttrs.GetAllowedMethods() {
+		if !target.ValidMethod(m) {
+			badFields["attributes.allowed_methods"] = fmt.Sprintf("%q is not a valid request method.", m)
+		}
+	}
+	for _, p := range httpAttrs.GetAllowedPathPrefixes() {
+		if !strings.HasPrefix(p, "/") || strings.ContainsAny(p, " \t\r\n") {
+			badFields["attributes.allowed_path_prefixes"] = fmt.Sprintf("%q must start with a slash and not contain whitespace.", p)
+		}
+	}
+	for _, h := range httpAttrs.GetInjectedHeaders() {
+		if _, _, err := target.ParseInjectedHeader(h); err != nil {
+			badFields["attributes.injected_headers"] = fmt.Sprintf("%q must be a single line header of the form \"Name: value\".", h)
+		}
+	}
+}
+
+// validCredentialInjection reports whether p is a supported credential
+// injection protocol. An empty p disables credential injection.
+func validCredentialInjection(p string) bool {
+	switch p {
+	case "", target.PostgresCredentialInjection:
+		return true
+	}
+	return false
+}
+
 func validateAuthorizeSessionRequest(req *pbs.AuthorizeSessionRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(target.TcpTargetPrefix, req.GetId()) {
+	if !handlers.ValidId(idPrefix(req.GetId()), req.GetId()) {
 		badFields["id"] = "Incorrectly formatted identifier."
 	}
 	if req.GetHostId() != "" {
 		switch host.SubtypeFromId(req.GetHostId()) {
-		case host.StaticSubtype:
+		case host.StaticSubtype, host.DynamicSubtype:
 		default:
 			badFields["host_id"] = "Incorrectly formatted identifier."
 		}
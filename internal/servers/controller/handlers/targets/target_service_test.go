@@ -10,10 +10,13 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/targets"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dynamic"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -53,7 +56,17 @@ func testService(t *testing.T, conn *gorm.DB, kms *kms.Kms, wrapper wrapping.Wra
 	staticHostRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
-	return targets.NewService(kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, staticHostRepoFn)
+	dynamicHostRepoFn := func() (*dynamic.Repository, error) {
+		return dynamic.NewRepository(rw, rw, kms)
+	}
+	credentialRepoFn := func() (*credential.Repository, error) {
+		return credential.NewRepository(rw, rw, kms)
+	}
+	hostPluginFn, err := host.NewPluginFactory(staticHostRepoFn, dynamicHostRepoFn)
+	if err != nil {
+		return targets.Service{}, err
+	}
+	return targets.NewService(kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, hostPluginFn, credentialRepoFn)
 }
 
 func TestGet(t *testing.T) {
@@ -143,7 +156,7 @@ func TestList(t *testing.T) {
 	kms := kms.TestKms(t, conn, wrapper)
 
 	_, projNoTar := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
-	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	org, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	hss := static.TestSets(t, conn, hc.GetPublicId(), 2)
 
@@ -169,6 +182,7 @@ func TestList(t *testing.T) {
 	cases := []struct {
 		name    string
 		scopeId string
+		filter  string
 		res     *pbs.ListTargetsResponse
 		err     error
 	}{
@@ -182,6 +196,24 @@ func TestList(t *testing.T) {
 			scopeId: projNoTar.GetPublicId(),
 			res:     &pbs.ListTargetsResponse{},
 		},
+		{
+			name:    "Filter To One Target",
+			scopeId: proj.GetPublicId(),
+			filter:  fmt.Sprintf(`"/item/id"==%q`, wantTars[1].GetId()),
+			res:     &pbs.ListTargetsResponse{Items: wantTars[1:2]},
+		},
+		{
+			name:    "Filter To No Targets",
+			scopeId: proj.GetPublicId(),
+			filter:  `"/item/type"=="udp"`,
+			res:     &pbs.ListTargetsResponse{},
+		},
+		{
+			name:    "Filter Bad Format",
+			scopeId: proj.GetPublicId(),
+			filter:  `"//id/"=="bad"`,
+			err:     handlers.InvalidArgumentErrorf("bad format", nil),
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -189,7 +221,7 @@ func TestList(t *testing.T) {
 			s, err := testService(t, conn, kms, wrapper)
 			require.NoError(err, "Couldn't create new host set service.")
 
-			got, gErr := s.ListTargets(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), &pbs.ListTargetsRequest{ScopeId: tc.scopeId})
+			got, gErr := s.ListTargets(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), &pbs.ListTargetsRequest{ScopeId: tc.scopeId, Filter: tc.filter})
 			if tc.err != nil {
 				require.Error(gErr)
 				assert.True(errors.Is(gErr, tc.err), "ListTargets(%q) got error %v, wanted %v", tc.scopeId, gErr, tc.err)
@@ -197,6 +229,37 @@ func TestList(t *testing.T) {
 			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "ListTargets(%q) got response %q, wanted %q", tc.scopeId, got, tc.res)
 		})
 	}
+
+	t.Run("List Recursively", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, err := testService(t, conn, kms, wrapper)
+		require.NoError(err, "Couldn't create new target service.")
+
+		otherProj := iam.TestProject(t, iam.TestRepo(t, conn, wrapper), org.GetPublicId())
+		otherTar := target.TestTcpTarget(t, conn, otherProj.GetPublicId(), "other")
+		var wantIds []string
+		for _, tar := range wantTars {
+			wantIds = append(wantIds, tar.GetId())
+		}
+		wantIds = append(wantIds, otherTar.GetPublicId())
+
+		ctx := auth.DisabledAuthTestContext(auth.WithScopeId(org.GetPublicId()))
+		got, err := s.ListTargets(ctx, &pbs.ListTargetsRequest{ScopeId: org.GetPublicId(), Recursive: true})
+		require.NoError(err)
+		var gotIds []string
+		for _, item := range got.GetItems() {
+			gotIds = append(gotIds, item.GetId())
+			assert.Equal(item.GetScopeId(), item.GetScope().GetId())
+			assert.Equal(scope.Project.String(), item.GetScope().GetType())
+			assert.Equal(org.GetPublicId(), item.GetScope().GetParentScopeId())
+		}
+		assert.Equal(wantIds, gotIds)
+
+		// Targets are only in projects, so only recursive lists can start
+		// from an org
+		_, err = s.ListTargets(ctx, &pbs.ListTargetsRequest{ScopeId: org.GetPublicId()})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+	})
 }
 
 func TestDelete(t *testing.T) {
@@ -321,6 +384,77 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Create a valid udp target",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("dns"),
+				Type:    target.UdpTargetType.String(),
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"default_port":                    structpb.NewNumberValue(53),
+					"connection_idle_timeout_seconds": structpb.NewNumberValue(30),
+				}},
+			}},
+			res: &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", target.UdpTargetPrefix),
+				Item: &pb.Target{
+					ScopeId: proj.GetPublicId(),
+					Scope:   &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
+					Name:    wrapperspb.String("dns"),
+					Type:    target.UdpTargetType.String(),
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"default_port":                    structpb.NewNumberValue(53),
+						"connection_idle_timeout_seconds": structpb.NewNumberValue(30),
+					}},
+					SessionMaxSeconds:      wrapperspb.UInt32(28800),
+					SessionConnectionLimit: wrapperspb.Int32(1),
+				},
+			},
+		},
+		{
+			name: "Create a valid http target",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("grafana"),
+				Type:    target.HttpTargetType.String(),
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"default_port":          structpb.NewNumberValue(3000),
+					"use_tls":               structpb.NewBoolValue(true),
+					"allowed_methods":       structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("GET")}}),
+					"allowed_path_prefixes": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("/d")}}),
+				}},
+			}},
+			res: &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", target.HttpTargetPrefix),
+				Item: &pb.Target{
+					ScopeId: proj.GetPublicId(),
+					Scope:   &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
+					Name:    wrapperspb.String("grafana"),
+					Type:    target.HttpTargetType.String(),
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"default_port":          structpb.NewNumberValue(3000),
+						"use_tls":               structpb.NewBoolValue(true),
+						"allowed_methods":       structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("GET")}}),
+						"allowed_path_prefixes": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("/d")}}),
+					}},
+					SessionMaxSeconds:      wrapperspb.UInt32(28800),
+					SessionConnectionLimit: wrapperspb.Int32(1),
+				},
+			},
+		},
+		{
+			name: "Create http target with invalid header",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("grafana"),
+				Type:    target.HttpTargetType.String(),
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"default_port":     structpb.NewNumberValue(3000),
+					"injected_headers": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("no colon")}}),
+				}},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Create with default port 0",
 			req: &pbs.CreateTargetRequest{Item: &pb.Target{
@@ -389,7 +523,7 @@ func TestCreate(t *testing.T) {
 			}
 			if got != nil {
 				assert.Contains(got.GetUri(), tc.res.GetUri())
-				assert.True(strings.HasPrefix(got.GetItem().GetId(), target.TcpTargetPrefix), got.GetItem().GetId())
+				assert.True(strings.HasPrefix(got.GetItem().GetId(), strings.TrimPrefix(tc.res.GetUri(), "targets/")), got.GetItem().GetId())
 
 				// Clear all values which are hard to compare against.
 				got.Uri, tc.res.Uri = "", ""
@@ -1136,3 +1270,173 @@ func TestRemoveTargetHostSets(t *testing.T) {
 		})
 	}
 }
+
+func TestTargetCredentials(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	s, err := testService(t, conn, kmsCache, wrapper)
+	require.NoError(t, err, "Error when getting new target service.")
+
+	credWrapper, err := kmsCache.GetWrapper(context.Background(), proj.GetPublicId(), kms.KeyPurposeCredentials)
+	require.NoError(t, err)
+	cs := credential.TestCredentialStores(t, conn, proj.GetPublicId(), 1)[0]
+	creds := credential.TestCredentials(t, conn, credWrapper, cs.GetPublicId(), 2)
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId()))
+
+	t.Run("lifecycle", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar := target.TestTcpTarget(t, conn, proj.GetPublicId(), "lifecycle")
+
+		added, err := s.AddTargetCredentials(ctx, &pbs.AddTargetCredentialsRequest{
+			Id:            tar.GetPublicId(),
+			Version:       tar.GetVersion(),
+			CredentialIds: []string{creds[0].GetPublicId(), creds[0].GetPublicId()},
+		})
+		require.NoError(err)
+		assert.Equal([]string{creds[0].GetPublicId()}, added.GetItem().GetCredentialIds())
+
+		set, err := s.SetTargetCredentials(ctx, &pbs.SetTargetCredentialsRequest{
+			Id:            tar.GetPublicId(),
+			Version:       added.GetItem().GetVersion(),
+			CredentialIds: []string{creds[1].GetPublicId()},
+		})
+		require.NoError(err)
+		assert.Equal([]string{creds[1].GetPublicId()}, set.GetItem().GetCredentialIds())
+
+		got, err := s.GetTarget(ctx, &pbs.GetTargetRequest{Id: tar.GetPublicId()})
+		require.NoError(err)
+		assert.Equal([]string{creds[1].GetPublicId()}, got.GetItem().GetCredentialIds())
+
+		removed, err := s.RemoveTargetCredentials(ctx, &pbs.RemoveTargetCredentialsRequest{
+			Id:            tar.GetPublicId(),
+			Version:       set.GetItem().GetVersion(),
+			CredentialIds: []string{creds[1].GetPublicId()},
+		})
+		require.NoError(err)
+		assert.Empty(removed.GetItem().GetCredentialIds())
+	})
+
+	t.Run("credential injection", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar := target.TestTcpTarget(t, conn, proj.GetPublicId(), "injection")
+		attrs, err := structpb.NewStruct(map[string]interface{}{"credential_injection": target.PostgresCredentialInjection})
+		require.NoError(err)
+		got, err := s.UpdateTarget(ctx, &pbs.UpdateTargetRequest{
+			Id:         tar.GetPublicId(),
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"attributes.credential_injection"}},
+			Item:       &pb.Target{Version: tar.GetVersion(), Attributes: attrs},
+		})
+		require.NoError(err)
+		assert.Equal(target.PostgresCredentialInjection, got.GetItem().GetAttributes().GetFields()["credential_injection"].GetStringValue())
+
+		attrs, err = structpb.NewStruct(map[string]interface{}{"credential_injection": "mysql"})
+		require.NoError(err)
+		_, err = s.UpdateTarget(ctx, &pbs.UpdateTargetRequest{
+			Id:         tar.GetPublicId(),
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"attributes.credential_injection"}},
+			Item:       &pb.Target{Version: got.GetItem().GetVersion(), Attributes: attrs},
+		})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+	})
+
+	tar := target.TestTcpTarget(t, conn, proj.GetPublicId(), "testing")
+	failCases := []struct {
+		name string
+		req  *pbs.AddTargetCredentialsRequest
+		err  error
+	}{
+		{
+			name: "Bad version",
+			req: &pbs.AddTargetCredentialsRequest{
+				Id:            tar.GetPublicId(),
+				Version:       tar.GetVersion() + 3,
+				CredentialIds: []string{creds[0].GetPublicId()},
+			},
+			err: handlers.ApiErrorWithCode(codes.Internal),
+		},
+		{
+			name: "empty credentials",
+			req: &pbs.AddTargetCredentialsRequest{
+				Id:      tar.GetPublicId(),
+				Version: tar.GetVersion(),
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Invalid credential ids",
+			req: &pbs.AddTargetCredentialsRequest{
+				Id:            tar.GetPublicId(),
+				Version:       tar.GetVersion(),
+				CredentialIds: []string{"invalid"},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range failCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			_, gErr := s.AddTargetCredentials(ctx, tc.req)
+			require.Error(gErr)
+			assert.True(errors.Is(gErr, tc.err), "AddTargetCredentials(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+		})
+	}
+}
+
+func TestWorkerFilter(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	s, err := testService(t, conn, kmsCache, wrapper)
+	require.NoError(t, err, "Error when getting new target service.")
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId()))
+
+	t.Run("update", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar := target.TestTcpTarget(t, conn, proj.GetPublicId(), "update")
+		got, err := s.UpdateTarget(ctx, &pbs.UpdateTargetRequest{
+			Id:         tar.GetPublicId(),
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"worker_filter"}},
+			Item:       &pb.Target{Version: tar.GetVersion(), WorkerFilter: wrapperspb.String(`"vpc-a" in tags.network`)},
+		})
+		require.NoError(err)
+		assert.Equal(`"vpc-a" in tags.network`, got.GetItem().GetWorkerFilter().GetValue())
+
+		got, err = s.UpdateTarget(ctx, &pbs.UpdateTargetRequest{
+			Id:         tar.GetPublicId(),
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"worker_filter"}},
+			Item:       &pb.Target{Version: got.GetItem().GetVersion()},
+		})
+		require.NoError(err)
+		assert.Nil(got.GetItem().GetWorkerFilter())
+	})
+
+	t.Run("create", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.CreateTarget(ctx, &pbs.CreateTargetRequest{Item: &pb.Target{
+			ScopeId:      proj.GetPublicId(),
+			Name:         wrapperspb.String("create"),
+			Type:         target.TcpTargetType.String(),
+			WorkerFilter: wrapperspb.String(`"us-east-1" in tags.region`),
+		}})
+		require.NoError(err)
+		assert.Equal(`"us-east-1" in tags.region`, got.GetItem().GetWorkerFilter().GetValue())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		assert := assert.New(t)
+		tar := target.TestTcpTarget(t, conn, proj.GetPublicId(), "invalid")
+		_, err := s.UpdateTarget(ctx, &pbs.UpdateTargetRequest{
+			Id:         tar.GetPublicId(),
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"worker_filter"}},
+			Item:       &pb.Target{Version: tar.GetVersion(), WorkerFilter: wrapperspb.String(`tags.network ==`)},
+		})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+	})
+}
@@ -4,15 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/db"
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/users"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/iam/store"
+	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
@@ -54,17 +59,30 @@ func (s Service) ListUsers(ctx context.Context, req *pbs.ListUsersRequest) (*pbs
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeInfos, err := scopeids.GetListingScopeIds(ctx, s.repoFn, &authResults, req.GetScopeId(), resource.User, req.GetRecursive())
+	if err != nil {
+		return nil, err
 	}
-	ul, err := s.listFromRepo(ctx, req.GetScopeId())
+	page, err := handlers.PaginateList(ctx, auth.KmsFromContext(ctx), req, resource.User, req.GetScopeId(), &authResults, handlers.MergeListPages(scopeIds, func(scopeId string, after *db.PageAfter, limit int) ([]handlers.PageItem, error) {
+		ul, err := s.listFromRepo(ctx, scopeId, after, limit)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]handlers.PageItem, 0, len(ul))
+		for _, item := range ul {
+			item.Scope = scopeInfos[scopeId]
+			items = append(items, item)
+		}
+		return items, nil
+	}))
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range ul {
-		item.Scope = authResults.Scope
+	var ul []*pb.User
+	for _, item := range page.Items {
+		ul = append(ul, item.(*pb.User))
 	}
-	return &pbs.ListUsersResponse{Items: ul}, nil
+	return &pbs.ListUsersResponse{Items: ul, NextPageToken: page.NextPageToken}, nil
 }
 
 // GetUsers implements the interface pbs.UserServiceServer.
@@ -185,6 +203,38 @@ func (s Service) RemoveUserAccounts(ctx context.Context, req *pbs.RemoveUserAcco
 	return &pbs.RemoveUserAccountsResponse{Item: u}, nil
 }
 
+// CheckUserPermission implements the interface pbs.UserServiceServer.
+func (s Service) CheckUserPermission(ctx context.Context, req *pbs.CheckUserPermissionRequest) (*pbs.CheckUserPermissionResponse, error) {
+	if err := validateCheckUserPermissionRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.CheckPermission)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	pc, err := s.checkPermissionInRepo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.CheckUserPermissionResponse{Item: pc}, nil
+}
+
+// GetUserEffectiveGrants implements the interface pbs.UserServiceServer.
+func (s Service) GetUserEffectiveGrants(ctx context.Context, req *pbs.GetUserEffectiveGrantsRequest) (*pbs.GetUserEffectiveGrantsResponse, error) {
+	if err := validateGetUserEffectiveGrantsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.EffectiveGrants)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	eg, err := s.effectiveGrantsFromRepo(ctx, req.GetId(), req.GetAuthMethodId())
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.GetUserEffectiveGrantsResponse{Item: eg}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*pb.User, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -276,12 +326,12 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, orgId string) ([]*pb.User, error) {
+func (s Service) listFromRepo(ctx context.Context, orgId string, after *db.PageAfter, limit int) ([]*pb.User, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListUsers(ctx, orgId)
+	ul, err := repo.ListUsers(ctx, orgId, iam.WithPageAfter(after), iam.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
@@ -349,6 +399,164 @@ func (s Service) removeInRepo(ctx context.Context, userId string, accountIds []s
 	return toProto(out, accts), nil
 }
 
+func (s Service) checkPermissionInRepo(ctx context.Context, req *pbs.CheckUserPermissionRequest) (*pb.PermissionCheck, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	var res perms.Resource
+	typ := resource.Map[req.GetType()]
+	switch req.GetResourceId() {
+	case "":
+		scp, err := repo.LookupScope(ctx, req.GetScopeId())
+		if err != nil {
+			return nil, err
+		}
+		if scp == nil {
+			return nil, handlers.NotFoundErrorf("Scope %q doesn't exist.", req.GetScopeId())
+		}
+		res = perms.Resource{ScopeId: scp.GetPublicId(), Type: typ}
+	default:
+		r, err := repo.LookupResource(ctx, req.GetResourceId())
+		if err != nil {
+			return nil, err
+		}
+		if r == nil {
+			return nil, handlers.NotFoundErrorf("Resource %q doesn't exist.", req.GetResourceId())
+		}
+		res = *r
+		if typ != resource.Unknown && typ != res.Type {
+			// The action is on the collection of the given type which is
+			// pinned to the resource, such as the hosts of a host catalog
+			res = perms.Resource{ScopeId: r.ScopeId, Pin: r.Id, Type: typ}
+		}
+	}
+
+	accountId, err := s.accountForAuthMethod(ctx, repo, req.GetId(), req.GetAuthMethodId())
+	if err != nil {
+		return nil, err
+	}
+	grantPairs, err := repo.GrantsForUser(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	grants := make([]perms.Grant, 0, len(grantPairs))
+	for _, pair := range grantPairs {
+		parsed, err := perms.Parse(
+			pair.ScopeId,
+			pair.Grant,
+			perms.WithUserId(req.GetId()),
+			perms.WithAccountId(accountId),
+			perms.WithRoleId(pair.RoleId),
+			perms.WithSkipFinalValidation(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse grant %q: %w", pair.Grant, err)
+		}
+		grants = append(grants, parsed)
+	}
+
+	act := action.Map[req.GetAction()]
+	aclOpts := []perms.Option{perms.WithAuthMethodId(req.GetAuthMethodId())}
+	if req.GetClientIp() != "" {
+		aclOpts = append(aclOpts, perms.WithClientIp(net.ParseIP(req.GetClientIp())))
+	}
+	acl := perms.NewACL(grants...)
+	results := acl.Allowed(res, act, aclOpts...)
+
+	out := &pb.PermissionCheck{
+		UserId:       req.GetId(),
+		ResourceId:   res.Id,
+		ResourceType: res.Type.String(),
+		ScopeId:      res.ScopeId,
+		Pin:          res.Pin,
+		Action:       act.String(),
+		Allowed:      results.Allowed,
+		Reason:       results.Reason.String(),
+	}
+	if results.Grant != nil {
+		out.Grant = toPermissionGrantProto(*results.Grant)
+	}
+	for _, g := range acl.ScopeGrants(res.ScopeId) {
+		out.ScopeGrants = append(out.ScopeGrants, toPermissionGrantProto(g))
+	}
+	return out, nil
+}
+
+// accountForAuthMethod returns the ID of the account of the user in the auth
+// method, which fills in the {{account.id}} templates of grants the way they
+// are when the user authenticates with it. It returns an empty ID if no auth
+// method is given.
+func (s Service) accountForAuthMethod(ctx context.Context, repo *iam.Repository, userId, authMethodId string) (string, error) {
+	if authMethodId == "" {
+		return "", nil
+	}
+	accountIds, err := repo.ListUserAccounts(ctx, userId, iam.WithAuthMethodId(authMethodId))
+	if err != nil {
+		return "", err
+	}
+	switch len(accountIds) {
+	case 0:
+		return "", nil
+	case 1:
+		return accountIds[0], nil
+	default:
+		return "", handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"auth_method_id": fmt.Sprintf("User %q has more than one account in auth method %q.", userId, authMethodId)})
+	}
+}
+
+func (s Service) effectiveGrantsFromRepo(ctx context.Context, userId, authMethodId string) (*pb.EffectiveGrants, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	accountId, err := s.accountForAuthMethod(ctx, repo, userId, authMethodId)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := repo.EffectiveGrantsForUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The grants are ordered by scope, role and grant, so the principals of a
+	// grant are adjacent and each scope's grants are contiguous
+	out := &pb.EffectiveGrants{UserId: userId}
+	var curScope *pb.EffectiveGrantScope
+	var curGrant *pb.EffectiveGrant
+	var lastRoleId, lastGrant string
+	for _, g := range grants {
+		if curScope == nil || curScope.GetScopeId() != g.ScopeId {
+			curScope = &pb.EffectiveGrantScope{ScopeId: g.ScopeId}
+			out.Scopes = append(out.Scopes, curScope)
+			curGrant = nil
+		}
+		if curGrant == nil || lastRoleId != g.RoleId || lastGrant != g.Grant {
+			// Parse the grant the same way it is when authorizing a request
+			// of the user so templates are filled in
+			parsed, err := perms.Parse(
+				g.ScopeId,
+				g.Grant,
+				perms.WithUserId(userId),
+				perms.WithAccountId(accountId),
+				perms.WithRoleId(g.RoleId),
+				perms.WithSkipFinalValidation(true))
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse grant %q: %w", g.Grant, err)
+			}
+			curGrant = &pb.EffectiveGrant{
+				RoleId:    g.RoleId,
+				Canonical: parsed.CanonicalString(),
+			}
+			curScope.Grants = append(curScope.Grants, curGrant)
+			lastRoleId, lastGrant = g.RoleId, g.Grant
+		}
+		curGrant.PrincipalIds = append(curGrant.PrincipalIds, g.PrincipalId)
+	}
+	return out, nil
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 	repo, err := s.repoFn()
@@ -413,6 +621,14 @@ func toProto(in *iam.User, accts []string) *pb.User {
 	return &out
 }
 
+func toPermissionGrantProto(g perms.Grant) *pb.PermissionGrant {
+	return &pb.PermissionGrant{
+		RoleId:       g.RoleId(),
+		GrantScopeId: g.ScopeId(),
+		Canonical:    g.CanonicalString(),
+	}
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //  * The path passed in is correctly formatted
@@ -447,6 +663,12 @@ func validateListRequest(req *pbs.ListUsersRequest) error {
 		req.GetScopeId() != scope.Global.String() {
 		badFields["scope_id"] = "Must be 'global' or a valid org scope id when listing."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("Must be at most %d.", handlers.MaxPageSize)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
@@ -465,8 +687,7 @@ func validateAddUserAccountsRequest(req *pbs.AddUserAccountsRequest) error {
 		badFields["account_ids"] = "Must be non-empty."
 	}
 	for _, a := range req.GetAccountIds() {
-		// TODO: Increase the type of auth accounts that can be added to a user.
-		if !handlers.ValidId(password.AccountPrefix, a) {
+		if !validAccountId(a) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
 		}
@@ -486,8 +707,7 @@ func validateSetUserAccountsRequest(req *pbs.SetUserAccountsRequest) error {
 		badFields["version"] = "Required field."
 	}
 	for _, a := range req.GetAccountIds() {
-		// TODO: Increase the type of auth accounts that can be added to a user.
-		if !handlers.ValidId(password.AccountPrefix, a) {
+		if !validAccountId(a) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
 		}
@@ -510,8 +730,7 @@ func validateRemoveUserAccountsRequest(req *pbs.RemoveUserAccountsRequest) error
 		badFields["account_ids"] = "Must be non-empty."
 	}
 	for _, a := range req.GetAccountIds() {
-		// TODO: Increase the type of auth accounts that can be added to a user.
-		if !handlers.ValidId(password.AccountPrefix, a) {
+		if !validAccountId(a) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
 		}
@@ -521,3 +740,47 @@ func validateRemoveUserAccountsRequest(req *pbs.RemoveUserAccountsRequest) error
 	}
 	return nil
 }
+
+// validAccountId reports whether id is a well formed id of an account of
+// any auth method subtype.
+func validAccountId(id string) bool {
+	return handlers.ValidId(password.AccountPrefix, id) ||
+		handlers.ValidId(oidc.AccountPrefix, id) ||
+		handlers.ValidId(ldap.AccountPrefix, id)
+}
+
+func validateGetUserEffectiveGrantsRequest(req *pbs.GetUserEffectiveGrantsRequest) error {
+	return handlers.ValidateGetRequest(iam.UserPrefix, req, handlers.NoopValidatorFn)
+}
+
+func validateCheckUserPermissionRequest(req *pbs.CheckUserPermissionRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(iam.UserPrefix, req.GetId()) {
+		badFields["id"] = "Incorrectly formatted identifier."
+	}
+	switch action.Map[req.GetAction()] {
+	case action.Unknown, action.All:
+		badFields["action"] = "Must be a single known action."
+	}
+	if req.GetType() != "" {
+		switch resource.Map[req.GetType()] {
+		case resource.Unknown, resource.All:
+			badFields["type"] = "Unknown resource type."
+		}
+	}
+	switch {
+	case req.GetResourceId() != "" && req.GetScopeId() != "":
+		badFields["scope_id"] = "Cannot be set with resource_id."
+	case req.GetResourceId() == "" && req.GetScopeId() == "":
+		badFields["resource_id"] = "Either resource_id, or scope_id and type, must be set."
+	case req.GetScopeId() != "" && req.GetType() == "":
+		badFields["type"] = "Required when scope_id is set."
+	}
+	if req.GetClientIp() != "" && net.ParseIP(req.GetClientIp()) == nil {
+		badFields["client_ip"] = "Must be a valid IP address."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/db"
@@ -16,6 +17,7 @@ import (
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/users"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/users"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -983,3 +985,265 @@ func TestRemoveAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckPermission(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	repoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	s, err := users.NewService(repoFn)
+	require.NoError(t, err, "Error when getting new user service.")
+
+	o, p := iam.TestScopes(t, iamRepo)
+	usr := iam.TestUser(t, iamRepo, o.GetPublicId())
+	grp := iam.TestGroup(t, conn, p.GetPublicId())
+
+	allowRole := iam.TestRole(t, conn, p.GetPublicId())
+	iam.TestRoleGrant(t, conn, allowRole.GetPublicId(), "id=*;type=group;actions=read,update")
+	iam.TestRoleGrant(t, conn, allowRole.GetPublicId(), "type=group;actions=list")
+	iam.TestUserRole(t, conn, allowRole.GetPublicId(), usr.GetPublicId())
+	denyRole := iam.TestRole(t, conn, p.GetPublicId())
+	iam.TestRoleGrant(t, conn, denyRole.GetPublicId(), fmt.Sprintf("id=%s;actions=update;deny=true", grp.GetPublicId()))
+	iam.TestUserRole(t, conn, denyRole.GetPublicId(), usr.GetPublicId())
+
+	amId := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0].GetPublicId()
+	acct := password.TestAccounts(t, conn, amId, 1)[0]
+	_, err = iamRepo.AddUserAccounts(context.Background(), usr.GetPublicId(), usr.GetVersion(), []string{acct.GetPublicId()})
+	require.NoError(t, err)
+	acctRole := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, acctRole.GetPublicId(), "id={{account.id}};actions=read")
+	iam.TestUserRole(t, conn, acctRole.GetPublicId(), usr.GetPublicId())
+	otherOrg, _ := iam.TestScopes(t, iamRepo)
+
+	cases := []struct {
+		name      string
+		req       *pbs.CheckUserPermissionRequest
+		allowed   bool
+		reason    string
+		wantGrant *pb.PermissionGrant
+		err       error
+	}{
+		{
+			name:    "Allowed",
+			req:     &pbs.CheckUserPermissionRequest{Id: usr.GetPublicId(), ResourceId: grp.GetPublicId(), Action: "read"},
+			allowed: true,
+			reason:  perms.ReasonAllowed.String(),
+			wantGrant: &pb.PermissionGrant{
+				RoleId:       allowRole.GetPublicId(),
+				GrantScopeId: p.GetPublicId(),
+				Canonical:    "id=*;type=group;actions=read,update",
+			},
+		},
+		{
+			name:   "Denied",
+			req:    &pbs.CheckUserPermissionRequest{Id: usr.GetPublicId(), ResourceId: grp.GetPublicId(), Action: "update"},
+			reason: perms.ReasonDenied.String(),
+			wantGrant: &pb.PermissionGrant{
+				RoleId:       denyRole.GetPublicId(),
+				GrantScopeId: p.GetPublicId(),
+				Canonical:    fmt.Sprintf("id=%s;actions=update;deny=true", grp.GetPublicId()),
+			},
+		},
+		{
+			name:   "No Grant For Action",
+			req:    &pbs.CheckUserPermissionRequest{Id: usr.GetPublicId(), ResourceId: grp.GetPublicId(), Action: "delete"},
+			reason: perms.ReasonNoGrantForAction.String(),
+		},
+		{
+			name:    "Collection",
+			req:     &pbs.CheckUserPermissionRequest{Id: usr.GetPublicId(), ScopeId: p.GetPublicId(), Type: "group", Action: "list"},
+			allowed: true,
+			reason:  perms.ReasonAllowed.String(),
+			wantGrant: &pb.PermissionGrant{
+				RoleId:       allowRole.GetPublicId(),
+				GrantScopeId: p.GetPublicId(),
+				Canonical:    "type=group;actions=list",
+			},
+		},
+		{
+			name:    "Account Template",
+			req:     &pbs.CheckUserPermissionRequest{Id: usr.GetPublicId(), ResourceId: acct.GetPublicId(), Action: "read", AuthMethodId: amId},
+			allowed: true,
+			reason:  perms.ReasonAllowed.String(),
+			wantGrant: &pb.PermissionGrant{
+				RoleId:       acctRole.GetPublicId(),
+				GrantScopeId: o.GetPublicId(),
+				Canonical:    fmt.Sprintf("id=%s;actions=read", acct.GetPublicId()),
+			},
+		},
+		{
+			name:   "Account Template Without Auth Method",
+			req:    &pbs.CheckUserPermissionRequest{Id: usr.GetPublicId(), ResourceId: acct.GetPublicId(), Action: "read"},
+			reason: perms.ReasonNoGrantForResource.String(),
+		},
+		{
+			name:   "No Grants In Scope",
+			req:    &pbs.CheckUserPermissionRequest{Id: usr.GetPublicId(), ResourceId: otherOrg.GetPublicId(), Action: "read"},
+			reason: perms.ReasonNoGrantsInScope.String(),
+		},
+		{
+			name: "Unknown Resource",
+			req:  &pbs.CheckUserPermissionRequest{Id: usr.GetPublicId(), ResourceId: "g_1234567890", Action: "read"},
+			err:  handlers.NotFoundError(),
+		},
+		{
+			name: "Unknown Action",
+			req:  &pbs.CheckUserPermissionRequest{Id: usr.GetPublicId(), ResourceId: grp.GetPublicId(), Action: "fly"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Scope Without Type",
+			req:  &pbs.CheckUserPermissionRequest{Id: usr.GetPublicId(), ScopeId: p.GetPublicId(), Action: "list"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Bad Client Ip",
+			req:  &pbs.CheckUserPermissionRequest{Id: usr.GetPublicId(), ResourceId: grp.GetPublicId(), Action: "read", ClientIp: "nope"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := s.CheckUserPermission(auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId())), tc.req)
+			if tc.err != nil {
+				require.Error(err)
+				assert.True(errors.Is(err, tc.err), "CheckUserPermission(%+v) got error %v, wanted %v", tc.req, err, tc.err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.allowed, got.GetItem().GetAllowed())
+			assert.Equal(tc.reason, got.GetItem().GetReason())
+			assert.Empty(cmp.Diff(tc.wantGrant, got.GetItem().GetGrant(), protocmp.Transform()))
+		})
+	}
+}
+
+func TestGetEffectiveGrants(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	repoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	s, err := users.NewService(repoFn)
+	require.NoError(t, err, "Error when getting new user service.")
+
+	o, p := iam.TestScopes(t, iamRepo)
+	usr := iam.TestUser(t, iamRepo, o.GetPublicId())
+	grp := iam.TestGroup(t, conn, o.GetPublicId())
+	iam.TestGroupMember(t, conn, grp.GetPublicId(), usr.GetPublicId())
+
+	prjRole := iam.TestRole(t, conn, p.GetPublicId())
+	iam.TestRoleGrant(t, conn, prjRole.GetPublicId(), "id=*;type=target;actions=authorize-session")
+	iam.TestUserRole(t, conn, prjRole.GetPublicId(), usr.GetPublicId())
+	iam.TestGroupRole(t, conn, prjRole.GetPublicId(), grp.GetPublicId())
+
+	orgRole := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, orgRole.GetPublicId(), "id={{user.id}};actions=read")
+	iam.TestUserRole(t, conn, orgRole.GetPublicId(), "u_auth")
+
+	amId := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0].GetPublicId()
+	acct := password.TestAccounts(t, conn, amId, 1)[0]
+	_, err = iamRepo.AddUserAccounts(context.Background(), usr.GetPublicId(), usr.GetVersion(), []string{acct.GetPublicId()})
+	require.NoError(t, err)
+	acctRole := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, acctRole.GetPublicId(), "id={{account.id}};actions=read")
+	iam.TestUserRole(t, conn, acctRole.GetPublicId(), usr.GetPublicId())
+
+	cases := []struct {
+		name string
+		req  *pbs.GetUserEffectiveGrantsRequest
+		want map[string][]*pb.EffectiveGrant
+		err  error
+	}{
+		{
+			name: "Grants By Scope",
+			req:  &pbs.GetUserEffectiveGrantsRequest{Id: usr.GetPublicId()},
+			want: map[string][]*pb.EffectiveGrant{
+				o.GetPublicId(): {
+					{
+						RoleId:       orgRole.GetPublicId(),
+						Canonical:    fmt.Sprintf("id=%s;actions=read", usr.GetPublicId()),
+						PrincipalIds: []string{"u_auth"},
+					},
+					{
+						RoleId:       acctRole.GetPublicId(),
+						Canonical:    "id={{account.id}};actions=read",
+						PrincipalIds: []string{usr.GetPublicId()},
+					},
+				},
+				p.GetPublicId(): {
+					{
+						RoleId:       prjRole.GetPublicId(),
+						Canonical:    "id=*;type=target;actions=authorize-session",
+						PrincipalIds: []string{grp.GetPublicId(), usr.GetPublicId()},
+					},
+				},
+			},
+		},
+		{
+			name: "Account Template",
+			req:  &pbs.GetUserEffectiveGrantsRequest{Id: usr.GetPublicId(), AuthMethodId: amId},
+			want: map[string][]*pb.EffectiveGrant{
+				o.GetPublicId(): {
+					{
+						RoleId:       orgRole.GetPublicId(),
+						Canonical:    fmt.Sprintf("id=%s;actions=read", usr.GetPublicId()),
+						PrincipalIds: []string{"u_auth"},
+					},
+					{
+						RoleId:       acctRole.GetPublicId(),
+						Canonical:    fmt.Sprintf("id=%s;actions=read", acct.GetPublicId()),
+						PrincipalIds: []string{usr.GetPublicId()},
+					},
+				},
+			},
+		},
+		{
+			name: "Unknown User",
+			req:  &pbs.GetUserEffectiveGrantsRequest{Id: iam.UserPrefix + "_1234567890"},
+			err:  handlers.NotFoundError(),
+		},
+		{
+			name: "Bad User Id",
+			req:  &pbs.GetUserEffectiveGrantsRequest{Id: "g_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := s.GetUserEffectiveGrants(auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId())), tc.req)
+			if tc.err != nil {
+				require.Error(err)
+				assert.True(errors.Is(err, tc.err), "GetUserEffectiveGrants(%+v) got error %v, wanted %v", tc.req, err, tc.err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.req.GetId(), got.GetItem().GetUserId())
+
+			// Other roles, such as those created with the scopes, may also
+			// grant to the user so only the roles created above are compared
+			for scopeId, want := range tc.want {
+				var found []*pb.EffectiveGrant
+				for _, gs := range got.GetItem().GetScopes() {
+					if gs.GetScopeId() != scopeId {
+						continue
+					}
+					for _, g := range gs.GetGrants() {
+						switch g.GetRoleId() {
+						case orgRole.GetPublicId(), prjRole.GetPublicId(), acctRole.GetPublicId():
+							found = append(found, g)
+						}
+					}
+				}
+				assert.Empty(cmp.Diff(want, found, protocmp.Transform(), cmpopts.SortSlices(func(a, b *pb.EffectiveGrant) bool {
+					return a.GetRoleId() < b.GetRoleId()
+				})), "grants for scope %s", scopeId)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/gen/controller/tokens"
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+)
+
+const (
+	// watchCursorV1 prefixes the watch cursors encrypted by
+	// EncryptWatchCursor.
+	watchCursorV1 = "w1_"
+
+	// watchCursorAad is the additional data watch cursors are bound to, so
+	// that they can not be used as page tokens or the other way round.
+	watchCursorAad = "watch"
+)
+
+// EncryptWatchCursor encrypts cur and encodes it for clients. Like page
+// tokens, watch cursors are encrypted with the tokens key of the global scope
+// in kmsCache, so that clients can neither read nor forge them.
+func EncryptWatchCursor(ctx context.Context, kmsCache *kms.Kms, cur *tokens.WatchCursor) (string, error) {
+	wrapper, err := tokensWrapper(ctx, kmsCache)
+	if err != nil {
+		return "", err
+	}
+	return encryptWatchCursorWithWrapper(ctx, wrapper, cur)
+}
+
+// DecryptWatchCursor decodes and decrypts a cursor encrypted by
+// EncryptWatchCursor.
+func DecryptWatchCursor(ctx context.Context, kmsCache *kms.Kms, cursor string) (*tokens.WatchCursor, error) {
+	wrapper, err := tokensWrapper(ctx, kmsCache)
+	if err != nil {
+		return nil, err
+	}
+	return decryptWatchCursorWithWrapper(ctx, wrapper, cursor)
+}
+
+func encryptWatchCursorWithWrapper(ctx context.Context, wrapper wrapping.Wrapper, cur *tokens.WatchCursor) (string, error) {
+	cur.Confounder = newConfounder()
+	return encryptToken(ctx, wrapper, "watch cursor", watchCursorV1, cur, watchCursorAad)
+}
+
+func decryptWatchCursorWithWrapper(ctx context.Context, wrapper wrapping.Wrapper, cursor string) (*tokens.WatchCursor, error) {
+	cur := new(tokens.WatchCursor)
+	if err := decryptToken(ctx, wrapper, "watch cursor", watchCursorV1, cursor, watchCursorAad, cur); err != nil {
+		return nil, err
+	}
+	return cur, nil
+}
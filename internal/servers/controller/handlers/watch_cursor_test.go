@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/gen/controller/tokens"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestWatchCursor(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+	want := &tokens.WatchCursor{
+		ScopeId:             "global",
+		Recursive:           true,
+		LastEventCreateTime: timestamppb.Now(),
+		LastEventId:         "s_1234567890:active",
+	}
+	encrypted, err := encryptWatchCursorWithWrapper(ctx, wrapper, want)
+	require.NoError(err)
+	assert.True(strings.HasPrefix(encrypted, watchCursorV1))
+	assert.NotContains(encrypted, want.GetLastEventId())
+
+	got, err := decryptWatchCursorWithWrapper(ctx, wrapper, encrypted)
+	require.NoError(err)
+	assert.True(proto.Equal(want, got))
+
+	_, err = decryptWatchCursorWithWrapper(ctx, wrapper, encrypted[:len(encrypted)-2])
+	assert.Error(err)
+
+	// Cursors and page tokens can not be used in place of each other
+	pageToken, err := encryptPageTokenWithWrapper(ctx, wrapper, &tokens.PageToken{ResourceType: resource.Session.String()})
+	require.NoError(err)
+	_, err = decryptWatchCursorWithWrapper(ctx, wrapper, pageToken)
+	assert.Error(err)
+	_, err = decryptWatchCursorWithWrapper(ctx, wrapper, watchCursorV1+strings.TrimPrefix(pageToken, pageTokenV1))
+	assert.Error(err)
+	_, err = decryptPageTokenWithWrapper(ctx, wrapper, resource.Session, pageTokenV1+strings.TrimPrefix(encrypted, watchCursorV1))
+	assert.Error(err)
+}
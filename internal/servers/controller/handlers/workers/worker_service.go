@@ -2,40 +2,54 @@ package workers
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/audit"
+	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/targets"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/go-hclog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// maxRecordingSize is the largest connection recording a worker may upload
+const maxRecordingSize = 512 * 1024 * 1024
+
 type workerServiceServer struct {
-	logger        hclog.Logger
-	serversRepoFn common.ServersRepoFactory
-	sessionRepoFn common.SessionRepoFactory
-	updateTimes   *sync.Map
-	kms           *kms.Kms
+	logger           hclog.Logger
+	serversRepoFn    common.ServersRepoFactory
+	sessionRepoFn    common.SessionRepoFactory
+	targetRepoFn     common.TargetRepoFactory
+	credentialRepoFn common.CredentialRepoFactory
+	updateTimes      *sync.Map
+	kms              *kms.Kms
 }
 
 func NewWorkerServiceServer(
 	logger hclog.Logger,
 	serversRepoFn common.ServersRepoFactory,
 	sessionRepoFn common.SessionRepoFactory,
+	targetRepoFn common.TargetRepoFactory,
+	credentialRepoFn common.CredentialRepoFactory,
 	updateTimes *sync.Map,
 	kms *kms.Kms) *workerServiceServer {
 	return &workerServiceServer{
-		logger:        logger,
-		serversRepoFn: serversRepoFn,
-		sessionRepoFn: sessionRepoFn,
-		updateTimes:   updateTimes,
-		kms:           kms,
+		logger:           logger,
+		serversRepoFn:    serversRepoFn,
+		sessionRepoFn:    sessionRepoFn,
+		targetRepoFn:     targetRepoFn,
+		credentialRepoFn: credentialRepoFn,
+		updateTimes:      updateTimes,
+		kms:              kms,
 	}
 }
 
@@ -51,6 +65,7 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 		return &pbs.StatusResponse{}, status.Errorf(codes.Internal, "Error aqcuiring repo to store worker status: %v", err)
 	}
 	req.Worker.Type = resource.Worker.String()
+	req.Worker.ActiveConnectionCount = activeConnectionCount(req.GetJobs())
 	controllers, _, err := repo.UpsertServer(ctx, req.Worker)
 	if err != nil {
 		ws.logger.Error("error storing worker status", "error", err)
@@ -70,6 +85,32 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 		return nil, status.Errorf(codes.Internal, "Error getting session repo: %v", err)
 	}
 
+	// Record the bytes transferred so far on connections that are still
+	// open; closed connections report final counts when they're closed. A
+	// failure is only logged, since the counts are reported again in the next
+	// status and the jobs still need to be checked for cancelation.
+	var connBytes []session.ConnectionBytes
+	for _, jobStatus := range req.GetJobs() {
+		for _, conn := range jobStatus.GetJob().GetSessionInfo().GetConnections() {
+			if conn.GetStatus() != pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED {
+				continue
+			}
+			if conn.GetBytesUp() == 0 && conn.GetBytesDown() == 0 {
+				continue
+			}
+			connBytes = append(connBytes, session.ConnectionBytes{
+				ConnectionId: conn.GetConnectionId(),
+				BytesUp:      conn.GetBytesUp(),
+				BytesDown:    conn.GetBytesDown(),
+			})
+		}
+	}
+	if len(connBytes) > 0 {
+		if _, err := sessRepo.UpdateConnectionBytes(ctx, req.Worker.PrivateId, connBytes); err != nil {
+			ws.logger.Error("error updating connection bytes at status time", "error", err)
+		}
+	}
+
 	for _, jobStatus := range req.GetJobs() {
 		switch jobStatus.Job.GetType() {
 		// Check for session cancelation
@@ -177,9 +218,128 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 		return nil, status.Errorf(codes.Internal, "Error deriving session key: %v", err)
 	}
 
+	targetRepo, err := ws.targetRepoFn()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error getting target repo: %v", err)
+	}
+	t, _, err := targetRepo.LookupTarget(ctx, sessionInfo.TargetId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error looking up target: %v", err)
+	}
+	if t != nil && t.GetRecordSessions() {
+		resp.RecordingKey, err = session.DeriveRecordingKey(wrapper, sessionInfo.UserId, sessionInfo.GetPublicId())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Error deriving recording key: %v", err)
+		}
+	}
+	if t != nil && t.GetCredentialInjection() != "" {
+		resp.CredentialInjection = t.GetCredentialInjection()
+		resp.InjectedCredential, err = ws.injectedCredential(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if t != nil && t.GetWorkerFilter() != "" {
+		resp.EligibleWorkers, err = ws.eligibleWorkers(ctx, t.GetWorkerFilter())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if t != nil {
+		resp.MaxBytesPerSecond = t.GetSessionMaxBytesPerSecond()
+		resp.IdleTimeoutSeconds = t.GetConnectionIdleTimeoutSeconds()
+	}
+	if ht, ok := t.(*target.HttpTarget); ok {
+		resp.HttpProxyPolicy = &pbs.HttpProxyPolicy{
+			AllowedMethods:      ht.Methods(),
+			AllowedPathPrefixes: ht.PathPrefixes(),
+			InjectedHeaders:     ht.Headers(),
+		}
+	}
+
 	return resp, nil
 }
 
+// eligibleWorkers returns the names of the workers matching the worker filter
+// of a target. The worker handling a session forwards its connections to one
+// of them if it isn't one itself.
+func (ws *workerServiceServer) eligibleWorkers(ctx context.Context, workerFilter string) ([]string, error) {
+	filter, err := servers.NewWorkerFilter(workerFilter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error parsing worker filter of target: %v", err)
+	}
+	serversRepo, err := ws.serversRepoFn()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error getting servers repo: %v", err)
+	}
+	workers, err := serversRepo.ListServers(ctx, servers.ServerTypeWorker)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error listing workers: %v", err)
+	}
+	var names []string
+	for _, w := range servers.FilterWorkers(servers.ActiveWorkers(workers), filter) {
+		names = append(names, w.GetName())
+	}
+	if len(names) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "No workers match the worker filter of the target.")
+	}
+	return names, nil
+}
+
+// activeConnectionCount returns the number of connections that aren't
+// closed in the session jobs a worker reported
+func activeConnectionCount(jobs []*pbs.JobStatus) uint32 {
+	var n uint32
+	for _, j := range jobs {
+		for _, c := range j.GetJob().GetSessionInfo().GetConnections() {
+			if c.GetStatus() != pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CLOSED {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// injectedCredential returns the credential the worker uses to authenticate
+// to the endpoint of a session for the target t, or nil if there is none.
+// Targets injecting their credential can only have a single credential, the
+// target repository refuses to attach more; should a target still have
+// several, the session is refused rather than one of them picked.
+func (ws *workerServiceServer) injectedCredential(ctx context.Context, t target.Target) (*targets.SessionCredential, error) {
+	targetRepo, err := ws.targetRepoFn()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error getting target repo: %v", err)
+	}
+	credIds, err := targetRepo.ListTargetCredentialIds(ctx, t.GetPublicId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error looking up target credentials: %v", err)
+	}
+	switch len(credIds) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "Target %s injects its credential but has %d credentials attached.", t.GetPublicId(), len(credIds))
+	}
+	credRepo, err := ws.credentialRepoFn()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error getting credential repo: %v", err)
+	}
+	creds, err := credRepo.LookupCredentialsWithSecret(ctx, t.GetScopeId(), credIds)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error looking up injected credential: %v", err)
+	}
+	if len(creds) == 0 {
+		return nil, nil
+	}
+	return &targets.SessionCredential{
+		CredentialId:      creds[0].GetPublicId(),
+		CredentialStoreId: creds[0].GetStoreId(),
+		Username:          creds[0].GetUsername(),
+		Password:          string(creds[0].GetPassword()),
+	}, nil
+}
+
 func (ws *workerServiceServer) ActivateSession(ctx context.Context, req *pbs.ActivateSessionRequest) (*pbs.ActivateSessionResponse, error) {
 	ws.logger.Trace("got activate session request from worker", "session_id", req.GetSessionId())
 
@@ -196,6 +356,13 @@ func (ws *workerServiceServer) ActivateSession(ctx context.Context, req *pbs.Act
 		resource.Worker.String(),
 		[]byte(req.GetTofuToken()))
 	if err != nil {
+		audit.Emit(ctx, &audit.Event{
+			Type:       audit.SessionEvent,
+			Resource:   &audit.Resource{Id: req.GetSessionId(), Type: resource.Session.String()},
+			Action:     "activate",
+			Result:     &audit.Result{Status: audit.StatusFailure, Error: err.Error()},
+			Attributes: map[string]string{"worker_id": req.GetWorkerId()},
+		})
 		return nil, status.Errorf(codes.Internal, "error looking up session: %v", err)
 	}
 	if sessionInfo == nil {
@@ -211,6 +378,19 @@ func (ws *workerServiceServer) ActivateSession(ctx context.Context, req *pbs.Act
 		"user_id", sessionInfo.UserId,
 		"host_set_id", sessionInfo.HostSetId,
 		"host_id", sessionInfo.HostId)
+	audit.Emit(ctx, &audit.Event{
+		Type:     audit.SessionEvent,
+		Auth:     &audit.Auth{UserId: sessionInfo.UserId, AuthTokenId: sessionInfo.AuthTokenId},
+		Resource: &audit.Resource{Id: sessionInfo.PublicId, Type: resource.Session.String(), ScopeId: sessionInfo.ScopeId},
+		Action:   "activate",
+		Result:   &audit.Result{Status: audit.StatusSuccess},
+		Attributes: map[string]string{
+			"worker_id":   req.GetWorkerId(),
+			"target_id":   sessionInfo.TargetId,
+			"host_id":     sessionInfo.HostId,
+			"host_set_id": sessionInfo.HostSetId,
+		},
+	})
 
 	return &pbs.ActivateSessionResponse{
 		Status: sessionStates[0].Status.ProtoVal(),
@@ -286,7 +466,7 @@ func (ws *workerServiceServer) ConnectConnection(ctx context.Context, req *pbs.C
 		"client_tcp_port", req.ClientTcpPort,
 	}
 	switch req.GetType() {
-	case "tcp":
+	case "tcp", "udp", "http":
 		loggerPairs = append(loggerPairs,
 			"endpoint_tcp_address", connectionInfo.EndpointTcpAddress,
 			"endpoint_tcp_port", connectionInfo.EndpointTcpPort,
@@ -346,7 +526,7 @@ func (ws *workerServiceServer) CloseConnection(ctx context.Context, req *pbs.Clo
 	}
 
 	for _, v := range req.GetCloseRequestData() {
-		ws.logger.Info("connection closed", "connection_id", v.ConnectionId)
+		ws.logger.Info("connection closed", "connection_id", v.ConnectionId, "bytes_up", v.BytesUp, "bytes_down", v.BytesDown)
 	}
 
 	ret := &pbs.CloseConnectionResponse{
@@ -355,3 +535,63 @@ func (ws *workerServiceServer) CloseConnection(ctx context.Context, req *pbs.Clo
 
 	return ret, nil
 }
+
+// UploadRecording stores the recording of a connection as it is received, a
+// chunk at a time. The first message of the stream identifies the session and
+// connection of the recording.
+func (ws *workerServiceServer) UploadRecording(stream pbs.SessionService_UploadRecordingServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Error receiving recording: %v", err)
+	}
+	sessionId, connectionId := req.GetSessionId(), req.GetConnectionId()
+	ws.logger.Trace("got upload recording request from worker", "session_id", sessionId, "connection_id", connectionId)
+
+	sessRepo, err := ws.sessionRepoFn()
+	if err != nil {
+		return status.Errorf(codes.Internal, "Error getting session repo: %v", err)
+	}
+	data := &uploadRecordingReader{stream: stream, buf: req.GetData()}
+	rec, err := sessRepo.CreateConnectionRecording(stream.Context(), sessionId, connectionId, data)
+	switch {
+	case err == nil:
+	case errors.Is(err, session.ErrRecordingExists):
+		return status.Error(codes.AlreadyExists, "Recording already stored.")
+	case errors.Is(err, errRecordingTooLarge):
+		return status.Error(codes.ResourceExhausted, "Recording exceeds the maximum allowed size.")
+	case errors.Is(err, db.ErrInvalidParameter), errors.Is(err, db.ErrRecordNotFound):
+		return status.Errorf(codes.InvalidArgument, "Invalid recording: %v", err)
+	default:
+		return status.Errorf(codes.Internal, "Error storing recording: %v", err)
+	}
+	ws.logger.Trace("stored recording", "session_id", sessionId, "connection_id", connectionId, "size", rec.Size)
+	return stream.SendAndClose(&pbs.UploadRecordingResponse{})
+}
+
+// errRecordingTooLarge is returned when reading an uploaded recording larger
+// than maxRecordingSize
+var errRecordingTooLarge = errors.New("recording too large")
+
+// uploadRecordingReader reads the data of the messages of a recording upload
+type uploadRecordingReader struct {
+	stream pbs.SessionService_UploadRecordingServer
+	buf    []byte
+	read   int64
+}
+
+func (r *uploadRecordingReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = req.GetData()
+	}
+	if r.read+int64(len(r.buf)) > maxRecordingSize {
+		return 0, errRecordingTooLarge
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	r.read += int64(n)
+	return n, nil
+}
@@ -119,8 +119,10 @@ func (c *Controller) startListeners() error {
 		workerServer := grpc.NewServer(
 			grpc.MaxRecvMsgSize(math.MaxInt32),
 			grpc.MaxSendMsgSize(math.MaxInt32),
+			grpc.ChainUnaryInterceptor(metricsInterceptor(clusterRequestKey), c.auditInterceptor),
+			grpc.ChainStreamInterceptor(streamMetricsInterceptor(clusterRequestKey), c.auditStreamInterceptor),
 		)
-		workerService := workers.NewWorkerServiceServer(c.logger.Named("worker-handler"), c.ServersRepoFn, c.SessionRepoFn, c.workerStatusUpdateTimes, c.kms)
+		workerService := workers.NewWorkerServiceServer(c.logger.Named("worker-handler"), c.ServersRepoFn, c.SessionRepoFn, c.TargetRepoFn, c.CredentialRepoFn, c.workerStatusUpdateTimes, c.kms)
 		pbs.RegisterServerCoordinationServiceServer(workerServer, workerService)
 		pbs.RegisterSessionServiceServer(workerServer, workerService)
 
@@ -134,6 +136,48 @@ func (c *Controller) startListeners() error {
 		return nil
 	}
 
+	configureForGrpc := func(ln *base.ServerListener) error {
+		server, err := c.grpcApiServer(HandlerProperties{
+			ListenerConfig: ln.Config,
+		})
+		if err != nil {
+			return err
+		}
+		ln.GrpcServer = server
+
+		var listeners []net.Listener
+		switch ln.Config.TLSDisable {
+		case true:
+			l, err := ln.Mux.RegisterProto(alpnmux.NoProto, nil)
+			if err != nil {
+				return fmt.Errorf("error getting non-tls listener: %w", err)
+			}
+			if l == nil {
+				return errors.New("could not get non-tls listener")
+			}
+			listeners = append(listeners, l)
+
+		default:
+			// gRPC clients negotiate h2, but serve those that don't use ALPN
+			// as well. HTTP/1.1 clients are turned away during the handshake.
+			ln.Mux.UnregisterProto("http/1.1")
+			for _, v := range []string{"", "h2"} {
+				l := ln.Mux.GetListener(v)
+				if l == nil {
+					return fmt.Errorf("could not get tls proto %q listener", v)
+				}
+				listeners = append(listeners, l)
+			}
+		}
+		for _, l := range listeners {
+			l := l
+			servers = append(servers, func() {
+				go server.Serve(l)
+			})
+		}
+		return nil
+	}
+
 	for _, ln := range c.conf.Listeners {
 		var err error
 		for _, purpose := range ln.Config.Purpose {
@@ -146,8 +190,11 @@ func (c *Controller) startListeners() error {
 				} else {
 					err = configureForCluster(ln)
 				}
-			case "proxy":
-				// Do nothing, in a dev mode we might see it here
+			case "grpc":
+				err = configureForGrpc(ln)
+			case "proxy", "ops":
+				// Do nothing, in a dev mode we might see proxy here and ops
+				// listeners are served by the base server
 			default:
 				err = fmt.Errorf("unknown listener purpose %q", purpose)
 			}
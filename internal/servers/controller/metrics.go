@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// apiRequestKey is the key of the latency of the API requests, labeled
+	// with the service and method they are routed to and their HTTP status
+	apiRequestKey = []string{"controller", "api", "request"}
+
+	// clusterRequestKey is the key of the latency of the gRPC requests of
+	// workers, such as status, labeled with the service and method and their
+	// gRPC code
+	clusterRequestKey = []string{"controller", "cluster", "request"}
+
+	// grpcApiRequestKey is the key of the latency of the API requests made
+	// over the gRPC listeners, labeled with the service and method and their
+	// gRPC code
+	grpcApiRequestKey = []string{"controller", "api", "grpc", "request"}
+)
+
+type rpcMethodKey struct{}
+
+// wrapHandlerWithMetrics measures the API requests served by h, which must be
+// the gateway mux for the method of a request to be known.
+func wrapHandlerWithMetrics(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rpcMethod := new(string)
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		h.ServeHTTP(rec, r.WithContext(context.WithValue(r.Context(), rpcMethodKey{}, rpcMethod)))
+
+		service, method := splitRpcMethod(*rpcMethod)
+		metrics.MeasureSinceWithLabels(apiRequestKey, start, []metrics.Label{
+			{Name: "service", Value: service},
+			{Name: "method", Value: method},
+			{Name: "code", Value: strconv.Itoa(rec.status)},
+		})
+	})
+}
+
+// recordRpcMethod is a gateway metadata annotator which records the method a
+// request is routed to for wrapHandlerWithMetrics. It adds no metadata.
+func recordRpcMethod(ctx context.Context, r *http.Request) metadata.MD {
+	if m, ok := runtime.RPCMethod(ctx); ok {
+		if rpcMethod, ok := r.Context().Value(rpcMethodKey{}).(*string); ok {
+			*rpcMethod = m
+		}
+	}
+	return nil
+}
+
+// metricsInterceptor returns an interceptor measuring gRPC requests under
+// key, such as those of workers on the cluster listener
+func metricsInterceptor(key []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		service, method := splitRpcMethod(info.FullMethod)
+		metrics.MeasureSinceWithLabels(key, start, []metrics.Label{
+			{Name: "service", Value: service},
+			{Name: "method", Value: method},
+			{Name: "code", Value: status.Code(err).String()},
+		})
+		return resp, err
+	}
+}
+
+// streamMetricsInterceptor returns an interceptor measuring streaming gRPC
+// requests under key, from their start until the handler returns
+func streamMetricsInterceptor(key []string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+
+		service, method := splitRpcMethod(info.FullMethod)
+		metrics.MeasureSinceWithLabels(key, start, []metrics.Label{
+			{Name: "service", Value: service},
+			{Name: "method", Value: method},
+			{Name: "code", Value: status.Code(err).String()},
+		})
+		return err
+	}
+}
+
+// splitRpcMethod splits a method of the form "/package.Service/Method" into
+// the name of the service and method. Both are "unknown" if the method is
+// empty, as it is for requests not routed to any.
+func splitRpcMethod(fullMethod string) (service, method string) {
+	i := strings.LastIndex(fullMethod, "/")
+	if i <= 0 {
+		return "unknown", "unknown"
+	}
+	service, method = fullMethod[1:i], fullMethod[i+1:]
+	if j := strings.LastIndex(service, "."); j >= 0 {
+		service = service[j+1:]
+	}
+	return service, method
+}
+
+// statusRecorder records the status of the response written through it
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Flush flushes the response written through the recorder, for the responses
+// which are streamed, such as those of watches of events
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
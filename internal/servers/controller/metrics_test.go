@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitRpcMethod(t *testing.T) {
+	tests := []struct {
+		fullMethod  string
+		wantService string
+		wantMethod  string
+	}{
+		{"/controller.api.services.v1.TargetService/AuthorizeSession", "TargetService", "AuthorizeSession"},
+		{"/controller.servers.services.v1.ServerCoordinationService/Status", "ServerCoordinationService", "Status"},
+		{"/Service/Method", "Service", "Method"},
+		{"/Method", "unknown", "unknown"},
+		{"", "unknown", "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fullMethod, func(t *testing.T) {
+			service, method := splitRpcMethod(tt.fullMethod)
+			assert.Equal(t, tt.wantService, service)
+			assert.Equal(t, tt.wantMethod, method)
+		})
+	}
+}
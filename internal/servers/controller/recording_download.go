@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// recordingDownloadPath is the path pattern of the endpoint serving
+	// downloads of session recordings over HTTP
+	recordingDownloadPath = "/v1/sessions/{id}:download-recording"
+
+	// recordingDownloadRpcMethod is the method of the session service
+	// downloads of session recordings served over HTTP are made with
+	recordingDownloadRpcMethod = "/controller.api.services.v1.SessionService/DownloadSessionRecording"
+
+	// recordingErrorTrailer is the trailer holding why a recording downloaded
+	// over HTTP could not be read completely
+	recordingErrorTrailer = "Boundary-Recording-Error"
+)
+
+// handleRecordingDownload serves the downloads of session recordings of svc
+// over HTTP, for the clients which can not use the gRPC API. It is routed by
+// the gateway mux, which passes the session ID of the path; the connection ID
+// is the connection_id query parameter. The decrypted recording is the body
+// of the response, written as it is read, and if it could not be read
+// completely the reason is sent in the recordingErrorTrailer trailer.
+func handleRecordingDownload(logger hclog.Logger, svc services.SessionServiceServer) runtime.HandlerFunc {
+	mar := &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{
+			// Ensures the json marshaler uses the snake casing as defined in the proto field names.
+			UseProtoNames: true,
+		},
+	}
+	errorHandler := handlers.ErrorHandler(logger)
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if rpcMethod, ok := r.Context().Value(rpcMethodKey{}).(*string); ok {
+			*rpcMethod = recordingDownloadRpcMethod
+		}
+		req := &services.DownloadSessionRecordingRequest{
+			Id:           pathParams["id"],
+			ConnectionId: r.URL.Query().Get("connection_id"),
+		}
+		stream := &httpRecordingStream{ctx: r.Context(), w: w}
+		err := svc.DownloadSessionRecording(req, stream)
+		switch {
+		case err == nil:
+			// Empty recordings are sent nothing, but still have a response
+			stream.start()
+		case !stream.started:
+			errorHandler(r.Context(), nil, mar, w, r, err)
+		default:
+			logger.Trace("error sending session recording", "error", err)
+			w.Header().Set(recordingErrorTrailer, err.Error())
+		}
+	}
+}
+
+// httpRecordingStream is the stream of a download of a session recording
+// served over HTTP. The response is started by the first header or data sent.
+type httpRecordingStream struct {
+	ctx     context.Context
+	w       http.ResponseWriter
+	started bool
+}
+
+var _ services.SessionService_DownloadSessionRecordingServer = (*httpRecordingStream)(nil)
+
+func (s *httpRecordingStream) Send(resp *services.DownloadSessionRecordingResponse) error {
+	s.start()
+	if resp.GetError() != "" {
+		s.w.Header().Set(recordingErrorTrailer, resp.GetError())
+	}
+	if len(resp.GetData()) == 0 {
+		return nil
+	}
+	_, err := s.w.Write(resp.GetData())
+	return err
+}
+
+func (s *httpRecordingStream) SendHeader(metadata.MD) error {
+	s.start()
+	return nil
+}
+
+func (s *httpRecordingStream) SetHeader(metadata.MD) error { return nil }
+
+func (s *httpRecordingStream) SetTrailer(metadata.MD) {}
+
+func (s *httpRecordingStream) Context() context.Context { return s.ctx }
+
+func (s *httpRecordingStream) SendMsg(m interface{}) error {
+	resp, ok := m.(*services.DownloadSessionRecordingResponse)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+	return s.Send(resp)
+}
+
+func (s *httpRecordingStream) RecvMsg(interface{}) error {
+	return errors.New("session recording downloads do not receive messages")
+}
+
+// start writes the headers of the response, once, declaring the trailer
+// which may follow the recording
+func (s *httpRecordingStream) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	s.w.Header().Set("Content-Type", "application/octet-stream")
+	s.w.Header().Set("Trailer", recordingErrorTrailer)
+	s.w.WriteHeader(http.StatusOK)
+}
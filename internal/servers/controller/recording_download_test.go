@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSessionService is a session service sending the responses of its
+// downloads and then returning err
+type testSessionService struct {
+	services.UnimplementedSessionServiceServer
+	gotReq    *services.DownloadSessionRecordingRequest
+	responses []*services.DownloadSessionRecordingResponse
+	err       error
+}
+
+func (s *testSessionService) DownloadSessionRecording(req *services.DownloadSessionRecordingRequest, stream services.SessionService_DownloadSessionRecordingServer) error {
+	s.gotReq = req
+	for _, resp := range s.responses {
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return s.err
+}
+
+// serveRecordingDownload serves a GET of path by the recording download
+// handler of svc, routed like the gateway mux routes it
+func serveRecordingDownload(t *testing.T, svc services.SessionServiceServer, path string) *http.Response {
+	t.Helper()
+	mux := runtime.NewServeMux(runtime.WithErrorHandler(handlers.ErrorHandler(hclog.L())))
+	require.NoError(t, mux.HandlePath(http.MethodGet, recordingDownloadPath, handleRecordingDownload(hclog.L(), svc)))
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec.Result()
+}
+
+func TestHandleRecordingDownload(t *testing.T) {
+	t.Run("recording", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		svc := &testSessionService{
+			responses: []*services.DownloadSessionRecordingResponse{
+				{Data: []byte("BNDRYREC")},
+				{Data: []byte("login: ")},
+			},
+		}
+		resp := serveRecordingDownload(t, svc, "/v1/sessions/s_1234567890:download-recording?connection_id=sc_1234567890")
+
+		require.NotNil(svc.gotReq)
+		assert.Equal("s_1234567890", svc.gotReq.GetId())
+		assert.Equal("sc_1234567890", svc.gotReq.GetConnectionId())
+
+		assert.Equal(http.StatusOK, resp.StatusCode)
+		assert.Equal("application/octet-stream", resp.Header.Get("Content-Type"))
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(err)
+		assert.Equal("BNDRYREClogin: ", string(body))
+		assert.Empty(resp.Trailer.Get(recordingErrorTrailer))
+	})
+	t.Run("incomplete recording", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		svc := &testSessionService{
+			responses: []*services.DownloadSessionRecordingResponse{
+				{Data: []byte("BNDRYREC")},
+				{Error: "recording is truncated"},
+			},
+		}
+		resp := serveRecordingDownload(t, svc, "/v1/sessions/s_1234567890:download-recording?connection_id=sc_1234567890")
+		assert.Equal(http.StatusOK, resp.StatusCode)
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(err)
+		assert.Equal("BNDRYREC", string(body))
+		assert.Equal("recording is truncated", resp.Trailer.Get(recordingErrorTrailer))
+	})
+	t.Run("error before the download starts", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		svc := &testSessionService{err: handlers.NotFoundErrorf("Recording of connection %q of session %q doesn't exist.", "sc_1234567890", "s_1234567890")}
+		resp := serveRecordingDownload(t, svc, "/v1/sessions/s_1234567890:download-recording?connection_id=sc_1234567890")
+		assert.Equal(http.StatusNotFound, resp.StatusCode)
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(err)
+		assert.Contains(string(body), "doesn't exist.")
+	})
+	t.Run("error once the download started", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		svc := &testSessionService{
+			responses: []*services.DownloadSessionRecordingResponse{{Data: []byte("BNDRYREC")}},
+			err:       handlers.ForbiddenError(),
+		}
+		resp := serveRecordingDownload(t, svc, "/v1/sessions/s_1234567890:download-recording?connection_id=sc_1234567890")
+		assert.Equal(http.StatusOK, resp.StatusCode)
+		_, err := ioutil.ReadAll(resp.Body)
+		require.NoError(err)
+		assert.NotEmpty(resp.Trailer.Get(recordingErrorTrailer))
+	})
+	t.Run("other methods", func(t *testing.T) {
+		assert := assert.New(t)
+		svc := &testSessionService{}
+		mux := runtime.NewServeMux()
+		assert.NoError(mux.HandlePath(http.MethodGet, recordingDownloadPath, handleRecordingDownload(hclog.L(), svc)))
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/sessions/s_1234567890:download-recording", nil))
+		assert.NotEqual(http.StatusOK, rec.Code)
+		assert.Nil(svc.gotReq)
+	})
+}
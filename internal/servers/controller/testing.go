@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/go-hclog"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/shared-secure-libs/configutil"
 	"github.com/hashicorp/vault/sdk/helper/base62"
 	"github.com/jinzhu/gorm"
 )
@@ -105,6 +106,12 @@ func (tc *TestController) ClusterAddrs() []string {
 	return tc.addrs("cluster")
 }
 
+// GrpcAddrs returns the addresses of the listeners serving the API over gRPC,
+// which are only started if EnableGrpcListener is set
+func (tc *TestController) GrpcAddrs() []string {
+	return tc.addrs("grpc")
+}
+
 func (tc *TestController) DbConn() *gorm.DB {
 	return tc.b.Database
 }
@@ -141,6 +148,8 @@ func (tc *TestController) addrs(purpose string) []string {
 			return tc.apiAddrs
 		}
 		prefix = "http://"
+	case "grpc":
+		prefix = "http://"
 	case "cluster":
 		if tc.clusterAddrs != nil {
 			return tc.clusterAddrs
@@ -181,6 +190,11 @@ func (tc *TestController) buildClient() {
 	if err := client.SetAddr(apiAddrs[0]); err != nil {
 		tc.t.Fatal(fmt.Errorf("error setting client address: %w", err))
 	}
+	if grpcAddrs := tc.GrpcAddrs(); len(grpcAddrs) > 0 {
+		if err := client.SetGrpcAddr(grpcAddrs[0]); err != nil {
+			tc.t.Fatal(fmt.Errorf("error setting client grpc address: %w", err))
+		}
+	}
 	// Because this is using the real lib it can pick up from stored locations
 	// like the system keychain. Explicitly clear the token to ensure we
 	// understand the client state at the start of each test.
@@ -289,6 +303,10 @@ type TestControllerOpts struct {
 
 	// The logger to use, or one will be created
 	Logger hclog.Logger
+
+	// EnableGrpcListener adds a listener with the "grpc" purpose, serving the
+	// API over gRPC, to the listeners of the config
+	EnableGrpcListener bool
 }
 
 func NewTestController(t *testing.T, opts *TestControllerOpts) *TestController {
@@ -373,11 +391,19 @@ func NewTestController(t *testing.T, opts *TestControllerOpts) *TestController {
 		tc.b.RecoveryKms = opts.RecoveryKms
 	}
 
+	if opts.EnableGrpcListener {
+		opts.Config.Listeners = append(opts.Config.Listeners, &configutil.Listener{
+			Type:       "tcp",
+			Purpose:    []string{"grpc"},
+			TLSDisable: true,
+		})
+	}
+
 	// Ensure the listeners use random port allocation
 	for _, listener := range opts.Config.Listeners {
 		listener.RandomPort = true
 	}
-	if err := tc.b.SetupListeners(nil, opts.Config.SharedConfig, []string{"api", "cluster"}); err != nil {
+	if err := tc.b.SetupListeners(nil, opts.Config.SharedConfig, []string{"api", "cluster", "grpc"}); err != nil {
 		t.Fatal(err)
 	}
 
@@ -3,20 +3,26 @@ package controller
 import (
 	"context"
 	"math/rand"
+	"os"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/host/dynamic"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/types/resource"
 )
 
 // In the future we could make this configurable
 const (
-	statusInterval      = 10 * time.Second
-	terminationInterval = 1 * time.Minute
+	statusInterval             = 10 * time.Second
+	terminationInterval        = 1 * time.Minute
+	dynamicHostRefreshInterval = 15 * time.Second
 )
 
-// This is exported so it can be tweaked in tests
-var RecoveryNonceCleanupInterval = 2 * time.Minute
+// These are exported so they can be tweaked in tests
+var (
+	RecoveryNonceCleanupInterval = 2 * time.Minute
+	DeadWorkerCleanupInterval    = 10 * time.Second
+)
 
 func (c *Controller) startStatusTicking(cancelCtx context.Context) {
 	go func() {
@@ -116,3 +122,141 @@ func (c *Controller) startTerminateCompletedSessionsTicking(cancelCtx context.Co
 		}
 	}()
 }
+
+// statusGracePeriod returns how long a worker may go without reporting its
+// status before it is considered dead
+func (c *Controller) statusGracePeriod() time.Duration {
+	if gp := c.conf.RawConfig.Controller.StatusGracePeriod; gp > 0 {
+		return gp
+	}
+	return servers.DefaultLiveness
+}
+
+// startCloseDeadWorkerConnectionsTicking periodically closes the connections
+// and terminates the sessions of workers which haven't reported their status
+// within the status grace period. Those workers are no longer listed by the
+// servers repository either, so they aren't offered to clients.
+func (c *Controller) startCloseDeadWorkerConnectionsTicking(cancelCtx context.Context) {
+	go func() {
+		timer := time.NewTimer(0)
+		for {
+			select {
+			case <-cancelCtx.Done():
+				c.logger.Info("closing dead worker connections ticking shutting down")
+				return
+
+			case <-timer.C:
+				repo, err := c.SessionRepoFn()
+				if err != nil {
+					c.logger.Error("error fetching repository for closing dead worker connections", "error", err)
+				} else {
+					closed, terminated, err := repo.CloseConnectionsForDeadWorkers(cancelCtx, c.statusGracePeriod())
+					if err != nil {
+						c.logger.Error("error closing dead worker connections", "error", err)
+					} else if closed+terminated > 0 {
+						c.logger.Info("closing dead worker connections successful", "connections_closed", closed, "sessions_terminated", terminated)
+					}
+				}
+				timer.Reset(DeadWorkerCleanupInterval)
+			}
+		}
+	}()
+}
+
+// dynamicCatalogState tracks when a dynamic host catalog was last refreshed
+// by this controller.
+type dynamicCatalogState struct {
+	source  string
+	next    time.Time
+	modTime time.Time
+}
+
+// startDynamicHostRefreshTicking periodically syncs the hosts of every
+// dynamic host catalog from its source. A catalog is refreshed when its
+// refresh interval elapses or, for file sources, as soon as the file's
+// modification time changes. Syncing is idempotent so it is safe for
+// several controllers to refresh the same catalog.
+func (c *Controller) startDynamicHostRefreshTicking(cancelCtx context.Context) {
+	go func() {
+		state := make(map[string]*dynamicCatalogState)
+		timer := time.NewTimer(0)
+		for {
+			select {
+			case <-cancelCtx.Done():
+				c.logger.Info("dynamic host refresh ticking shutting down")
+				return
+
+			case <-timer.C:
+				c.refreshDynamicHostCatalogs(cancelCtx, state)
+				timer.Reset(dynamicHostRefreshInterval)
+			}
+		}
+	}()
+}
+
+func (c *Controller) refreshDynamicHostCatalogs(ctx context.Context, state map[string]*dynamicCatalogState) {
+	repo, err := c.DynamicHostRepoFn()
+	if err != nil {
+		c.logger.Error("error fetching repository for dynamic host refresh", "error", err)
+		return
+	}
+	catalogs, err := repo.ListAllCatalogs(ctx)
+	if err != nil {
+		c.logger.Error("error listing dynamic host catalogs", "error", err)
+		return
+	}
+	p, err := dynamic.NewPlugin(c.DynamicHostRepoFn)
+	if err != nil {
+		c.logger.Error("error creating dynamic host plugin", "error", err)
+		return
+	}
+
+	now := time.Now()
+	seen := make(map[string]bool, len(catalogs))
+	for _, cat := range catalogs {
+		seen[cat.GetPublicId()] = true
+		st, ok := state[cat.GetPublicId()]
+		due := !ok || st.source != cat.GetSource() || !now.Before(st.next)
+
+		var modTime time.Time
+		if path, isFile := dynamic.SourceFile(cat.GetSource()); isFile {
+			if fi, err := os.Stat(path); err == nil {
+				modTime = fi.ModTime()
+				if ok && !modTime.Equal(st.modTime) {
+					due = true
+				}
+			}
+		}
+		if !due {
+			continue
+		}
+
+		interval := time.Duration(cat.GetRefreshIntervalSeconds()) * time.Second
+		if interval <= 0 {
+			interval = dynamic.DefaultRefreshIntervalSeconds * time.Second
+		}
+		state[cat.GetPublicId()] = &dynamicCatalogState{
+			source:  cat.GetSource(),
+			next:    now.Add(interval),
+			modTime: modTime,
+		}
+
+		res, err := p.RefreshCatalog(ctx, cat.GetPublicId())
+		if err != nil {
+			c.logger.Error("error refreshing dynamic host catalog", "host_catalog_id", cat.GetPublicId(), "error", err)
+			continue
+		}
+		if res.HostsCreated+res.HostsUpdated+res.HostsDeleted+res.MembersAdded+res.MembersRemoved > 0 {
+			c.logger.Info("dynamic host catalog refreshed", "host_catalog_id", cat.GetPublicId(),
+				"hosts_created", res.HostsCreated, "hosts_updated", res.HostsUpdated, "hosts_deleted", res.HostsDeleted,
+				"members_added", res.MembersAdded, "members_removed", res.MembersRemoved)
+		} else {
+			c.logger.Trace("dynamic host catalog refreshed with no changes", "host_catalog_id", cat.GetPublicId())
+		}
+	}
+	for id := range state {
+		if !seen[id] {
+			delete(state, id)
+		}
+	}
+}
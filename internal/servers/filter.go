@@ -0,0 +1,144 @@
+package servers
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-bexpr"
+)
+
+// ErrInvalidWorkerFilter is returned when a worker filter is empty or cannot
+// be parsed.
+var ErrInvalidWorkerFilter = errors.New("invalid worker filter")
+
+// WorkerFilter is a compiled worker filter.
+//
+// Worker filters use the go-bexpr syntax and are evaluated against a document
+// with the following fields for each worker:
+//
+//  name  the name of the worker
+//  tags  the tags of the worker, a map of keys to lists of values
+//
+// For example, `"vpc-a" in tags.network`. A filter that references a tag a
+// worker does not have does not match that worker.
+type WorkerFilter struct {
+	eval *bexpr.Evaluator
+}
+
+// NewWorkerFilter compiles filter. It returns an error wrapping
+// ErrInvalidWorkerFilter if filter is empty or cannot be parsed.
+func NewWorkerFilter(filter string) (*WorkerFilter, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, fmt.Errorf("filter is empty: %w", ErrInvalidWorkerFilter)
+	}
+	eval, err := bexpr.CreateEvaluator(filter)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrInvalidWorkerFilter)
+	}
+	return &WorkerFilter{eval: eval}, nil
+}
+
+// Match reports whether s matches the filter.
+func (f *WorkerFilter) Match(s *Server) bool {
+	tags := make(map[string][]string, len(s.GetTags()))
+	for k, v := range s.GetTags() {
+		tags[k] = v.GetValues()
+	}
+	ok, err := f.eval.Evaluate(map[string]interface{}{
+		"name": s.GetName(),
+		"tags": tags,
+	})
+	if err != nil {
+		// Evaluation errors mean a selector doesn't resolve for this worker,
+		// which is not a match.
+		return false
+	}
+	return ok
+}
+
+// FilterWorkers returns the workers matching filter, in their original
+// order.
+func FilterWorkers(workers []*Server, filter *WorkerFilter) []*Server {
+	var ret []*Server
+	for _, w := range workers {
+		if filter.Match(w) {
+			ret = append(ret, w)
+		}
+	}
+	return ret
+}
+
+// ActiveWorkers returns the workers that aren't draining, in their original
+// order. Draining workers are finishing their existing connections and must
+// not be given new ones.
+func ActiveWorkers(workers []*Server) []*Server {
+	var ret []*Server
+	for _, w := range workers {
+		if !w.GetDraining() {
+			ret = append(ret, w)
+		}
+	}
+	return ret
+}
+
+// EntryWorkers returns the workers clients connect to in order to reach the
+// selected workers, in their original order and without duplicates.
+// Downstream workers can't be reached directly, so the topmost worker of
+// their chain of upstream workers is returned in their place. Downstream
+// workers whose chain is not among all are skipped.
+func EntryWorkers(all, selected []*Server) []*Server {
+	byName := make(map[string]*Server, len(all))
+	for _, w := range all {
+		byName[w.GetName()] = w
+	}
+	var ret []*Server
+	seen := make(map[string]bool, len(selected))
+	for _, w := range selected {
+		// Bound the walk in case of a loop between workers
+		for i := 0; w != nil && w.GetUpstream() != "" && i < len(all); i++ {
+			w = byName[w.GetUpstream()]
+		}
+		if w == nil || w.GetUpstream() != "" || seen[w.GetName()] {
+			continue
+		}
+		seen[w.GetName()] = true
+		ret = append(ret, w)
+	}
+	return ret
+}
+
+// RankWorkers returns workers ordered by preference for new connections:
+// workers whose region tag contains region first, if one is given, then the
+// workers proxying the fewest connections. Workers ranked equally are shuffled
+// so that new sessions are spread across them. workers is not modified.
+func RankWorkers(workers []*Server, region string) []*Server {
+	if len(workers) == 0 {
+		return nil
+	}
+	inRegion := func(w *Server) bool {
+		if region == "" {
+			return false
+		}
+		for _, v := range w.GetTags()["region"].GetValues() {
+			if v == region {
+				return true
+			}
+		}
+		return false
+	}
+	ret := make([]*Server, len(workers))
+	copy(ret, workers)
+	rand.Shuffle(len(ret), func(i, j int) {
+		ret[i], ret[j] = ret[j], ret[i]
+	})
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ri, rj := inRegion(ret[i]), inRegion(ret[j]); ri != rj {
+			return ri
+		}
+		return ret[i].GetActiveConnectionCount() < ret[j].GetActiveConnectionCount()
+	})
+	return ret
+}
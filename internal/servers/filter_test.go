@@ -0,0 +1,163 @@
+package servers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorkerFilter(t *testing.T) {
+	t.Parallel()
+	var tests = []struct {
+		name    string
+		filter  string
+		wantErr bool
+	}{
+		{name: "valid", filter: `"vpc-a" in tags.network`},
+		{name: "empty", filter: "", wantErr: true},
+		{name: "blank", filter: "  ", wantErr: true},
+		{name: "unparsable", filter: `tags.network ==`, wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewWorkerFilter(tt.filter)
+			if tt.wantErr {
+				assert.Truef(t, errors.Is(err, ErrInvalidWorkerFilter), "want err: %q got: %q", ErrInvalidWorkerFilter, err)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, got)
+		})
+	}
+}
+
+func TestWorkerFilter_Match(t *testing.T) {
+	t.Parallel()
+	tagged := &Server{
+		Name: "w-1",
+		Tags: map[string]*TagValues{
+			"network": {Values: []string{"vpc-a", "vpc-b"}},
+			"region":  {Values: []string{"us-east-1"}},
+		},
+	}
+	bare := &Server{Name: "w-2"}
+
+	var tests = []struct {
+		name   string
+		filter string
+		worker *Server
+		want   bool
+	}{
+		{name: "tag-match", filter: `"vpc-a" in tags.network`, worker: tagged, want: true},
+		{name: "tag-mismatch", filter: `"vpc-c" in tags.network`, worker: tagged},
+		{name: "and", filter: `"vpc-b" in tags.network and "us-east-1" in tags.region`, worker: tagged, want: true},
+		{name: "name", filter: `name == "w-2"`, worker: bare, want: true},
+		{name: "missing-tag", filter: `"vpc-a" in tags.network`, worker: bare},
+		{name: "not-missing-tag", filter: `"vpc-a" not in tags.network`, worker: bare},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewWorkerFilter(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, f.Match(tt.worker))
+		})
+	}
+}
+
+func TestFilterWorkers(t *testing.T) {
+	t.Parallel()
+	a := &Server{Name: "a", Tags: map[string]*TagValues{"env": {Values: []string{"prod"}}}}
+	b := &Server{Name: "b", Tags: map[string]*TagValues{"env": {Values: []string{"dev"}}}}
+	c := &Server{Name: "c", Tags: map[string]*TagValues{"env": {Values: []string{"prod"}}}}
+
+	f, err := NewWorkerFilter(`"prod" in tags.env`)
+	require.NoError(t, err)
+	assert.Equal(t, []*Server{a, c}, FilterWorkers([]*Server{a, b, c}, f))
+
+	f, err = NewWorkerFilter(`"test" in tags.env`)
+	require.NoError(t, err)
+	assert.Empty(t, FilterWorkers([]*Server{a, b, c}, f))
+}
+
+func TestActiveWorkers(t *testing.T) {
+	t.Parallel()
+	a := &Server{Name: "a"}
+	b := &Server{Name: "b", Draining: true}
+	c := &Server{Name: "c"}
+
+	assert.Equal(t, []*Server{a, c}, ActiveWorkers([]*Server{a, b, c}))
+	assert.Empty(t, ActiveWorkers([]*Server{b}))
+}
+
+func TestEntryWorkers(t *testing.T) {
+	t.Parallel()
+	edge := &Server{Name: "edge", Address: "edge:9202"}
+	other := &Server{Name: "other", Address: "other:9202"}
+	down := &Server{Name: "down", Upstream: "edge"}
+	deep := &Server{Name: "deep", Upstream: "down"}
+	orphan := &Server{Name: "orphan", Upstream: "gone"}
+	loopA := &Server{Name: "loop-a", Upstream: "loop-b"}
+	loopB := &Server{Name: "loop-b", Upstream: "loop-a"}
+	all := []*Server{edge, other, down, deep, orphan, loopA, loopB}
+
+	var tests = []struct {
+		name     string
+		selected []*Server
+		want     []*Server
+	}{
+		{name: "direct", selected: []*Server{other, edge}, want: []*Server{other, edge}},
+		{name: "downstream", selected: []*Server{down}, want: []*Server{edge}},
+		{name: "chain", selected: []*Server{deep}, want: []*Server{edge}},
+		{name: "deduplicated", selected: []*Server{edge, down, deep}, want: []*Server{edge}},
+		{name: "orphan", selected: []*Server{orphan}},
+		{name: "loop", selected: []*Server{loopA}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, EntryWorkers(all, tt.selected))
+		})
+	}
+}
+
+func TestRankWorkers(t *testing.T) {
+	t.Parallel()
+	region := func(r string) map[string]*TagValues {
+		return map[string]*TagValues{"region": {Values: []string{r}}}
+	}
+	idle := &Server{Name: "idle", Tags: region("us-west-2")}
+	busy := &Server{Name: "busy", ActiveConnectionCount: 10, Tags: region("us-east-1")}
+	some := &Server{Name: "some", ActiveConnectionCount: 3}
+	workers := []*Server{busy, some, idle}
+
+	var tests = []struct {
+		name   string
+		region string
+		want   []*Server
+	}{
+		{name: "least-connections", want: []*Server{idle, some, busy}},
+		{name: "region-first", region: "us-east-1", want: []*Server{busy, idle, some}},
+		{name: "unknown-region", region: "eu-west-1", want: []*Server{idle, some, busy}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RankWorkers(workers, tt.region))
+		})
+	}
+	assert.Equal(t, []*Server{busy, some, idle}, workers, "input was modified")
+	assert.Nil(t, RankWorkers(nil, ""))
+
+	// Equally ranked workers are all given a turn at the top
+	a, b := &Server{Name: "a"}, &Server{Name: "b"}
+	first := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		first[RankWorkers([]*Server{a, b}, "")[0].GetName()] = true
+	}
+	assert.Len(t, first, 2)
+}
@@ -3,3 +3,15 @@ package servers
 func (s *Server) TableName() string {
 	return "server"
 }
+
+// ServerTag is a single key/value tag of a server
+type ServerTag struct {
+	ServerId   string
+	ServerType string
+	Key        string
+	Value      string
+}
+
+func (t *ServerTag) TableName() string {
+	return "server_tag"
+}
@@ -16,14 +16,16 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withLimit    int
-	withLiveness time.Duration
+	withLimit          int
+	withLiveness       time.Duration
+	withWorkerLiveness time.Duration
 }
 
 func getDefaultOptions() options {
 	return options{
-		withLimit:    0,
-		withLiveness: 0,
+		withLimit:          0,
+		withLiveness:       0,
+		withWorkerLiveness: 0,
 	}
 }
 
@@ -36,10 +38,19 @@ func WithLimit(limit int) Option {
 	}
 }
 
-// WithSkipVetForWrite provides an option to allow skipping vet checks to allow
-// testing lower-level SQL triggers and constraints
+// WithLiveness provides an option to set how long a server may go without a
+// status update and still be listed
 func WithLiveness(liveness time.Duration) Option {
 	return func(o *options) {
 		o.withLiveness = liveness
 	}
 }
+
+// WithWorkerLiveness provides an option to set how long a worker may go
+// without a status update and still be listed, by default, by a repository.
+// It does not apply to controllers, which are listed with DefaultLiveness.
+func WithWorkerLiveness(liveness time.Duration) Option {
+	return func(o *options) {
+		o.withWorkerLiveness = liveness
+	}
+}
@@ -12,9 +12,10 @@ import (
 	"github.com/hashicorp/boundary/internal/types/resource"
 )
 
-const (
-	defaultLiveness = 15 * time.Second
-)
+// DefaultLiveness is how long a server may go without a status update before
+// it is no longer listed, unless the repository is given another liveness for
+// workers
+const DefaultLiveness = 15 * time.Second
 
 type ServerType string
 
@@ -29,14 +30,16 @@ func (s ServerType) String() string {
 
 // Repository is the servers database repository
 type Repository struct {
-	reader db.Reader
-	writer db.Writer
-	kms    *kms.Kms
+	reader         db.Reader
+	writer         db.Writer
+	kms            *kms.Kms
+	workerLiveness time.Duration
 }
 
-// NewRepository creates a new servers Repository. Supports the options: WithLimit
-// which sets a default limit on results returned by repo operations.
-func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms) (*Repository, error) {
+// NewRepository creates a new servers Repository. Supports the options:
+// WithWorkerLiveness which sets the default liveness used when listing
+// workers.
+func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
 	if r == nil {
 		return nil, errors.New("error creating server repository with nil reader")
 	}
@@ -46,10 +49,16 @@ func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms) (*Repository, error)
 	if kms == nil {
 		return nil, errors.New("error creating server repository with nil kms")
 	}
+	opts := getOpts(opt...)
+	workerLiveness := opts.withWorkerLiveness
+	if workerLiveness <= 0 {
+		workerLiveness = DefaultLiveness
+	}
 	return &Repository{
-		reader: r,
-		writer: w,
-		kms:    kms,
+		reader:         r,
+		writer:         w,
+		kms:            kms,
+		workerLiveness: workerLiveness,
 	}, nil
 }
 
@@ -59,7 +68,10 @@ func (r *Repository) ListServers(ctx context.Context, serverType ServerType, opt
 	opts := getOpts(opt...)
 	liveness := opts.withLiveness
 	if liveness == 0 {
-		liveness = defaultLiveness
+		liveness = DefaultLiveness
+		if serverType == ServerTypeWorker {
+			liveness = r.workerLiveness
+		}
 	}
 	updateTime := time.Now().Add(-1 * liveness)
 	var servers []*Server
@@ -72,9 +84,49 @@ func (r *Repository) ListServers(ctx context.Context, serverType ServerType, opt
 	); err != nil {
 		return nil, fmt.Errorf("error listing servers: %w", err)
 	}
+	if err := r.loadTags(ctx, serverType, servers); err != nil {
+		return nil, err
+	}
 	return servers, nil
 }
 
+// loadTags sets the tags of servers, which all have type serverType
+func (r *Repository) loadTags(ctx context.Context, serverType ServerType, servers []*Server) error {
+	if len(servers) == 0 {
+		return nil
+	}
+	byId := make(map[string]*Server, len(servers))
+	ids := make([]interface{}, 0, len(servers))
+	for _, s := range servers {
+		byId[s.PrivateId] = s
+		ids = append(ids, s.PrivateId)
+	}
+	var tags []*ServerTag
+	if err := r.reader.SearchWhere(
+		ctx,
+		&tags,
+		"server_type = ? and server_id in (?)",
+		[]interface{}{serverType, ids},
+		db.WithLimit(-1),
+	); err != nil {
+		return fmt.Errorf("error listing server tags: %w", err)
+	}
+	for _, t := range tags {
+		s, ok := byId[t.ServerId]
+		if !ok {
+			continue
+		}
+		if s.Tags == nil {
+			s.Tags = make(map[string]*TagValues)
+		}
+		if s.Tags[t.Key] == nil {
+			s.Tags[t.Key] = &TagValues{}
+		}
+		s.Tags[t.Key].Values = append(s.Tags[t.Key].Values, t.Value)
+	}
+	return nil
+}
+
 // UpsertServer adds or updates a server in the DB
 func (r *Repository) UpsertServer(ctx context.Context, server *Server, opt ...Option) ([]*Server, int, error) {
 	if server == nil {
@@ -85,26 +137,56 @@ func (r *Repository) UpsertServer(ctx context.Context, server *Server, opt ...Op
 	// Build query
 	q := `
 	insert into server
-		(private_id, type, name, description, address, update_time)
+		(private_id, type, name, description, address, update_time, upstream, draining, active_connection_count)
 	values
-		($1, $2, $3, $4, $5, $6)
+		($1, $2, $3, $4, $5, $6, nullif($7, ''), $8, $9)
 	on conflict on constraint server_pkey
 	do update set
 		name = $3,
 		description = $4,
 		address = $5,
-		update_time = $6;
+		update_time = $6,
+		upstream = nullif($7, ''),
+		draining = $8,
+		active_connection_count = $9;
 	`
 
-	rowsAffected, err := r.writer.Exec(ctx, q,
-		[]interface{}{server.PrivateId,
-			server.Type,
-			server.Name,
-			server.Description,
-			server.Address,
-			time.Now().Format(time.RFC3339)})
+	var rowsAffected int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			rowsAffected, err = w.Exec(ctx, q,
+				[]interface{}{server.PrivateId,
+					server.Type,
+					server.Name,
+					server.Description,
+					server.Address,
+					time.Now().Format(time.RFC3339),
+					server.Upstream,
+					server.Draining,
+					server.ActiveConnectionCount})
+			if err != nil {
+				return fmt.Errorf("error performing status upsert: %w", err)
+			}
+			// The reported tags replace the stored ones
+			if _, err := w.Exec(ctx, deleteTagsSql, []interface{}{server.PrivateId, server.Type}); err != nil {
+				return fmt.Errorf("error deleting server tags: %w", err)
+			}
+			for key, values := range server.Tags {
+				for _, value := range values.GetValues() {
+					if _, err := w.Exec(ctx, insertTagSql, []interface{}{server.PrivateId, server.Type, key, value}); err != nil {
+						return fmt.Errorf("error inserting server tag %q: %w", key, err)
+					}
+				}
+			}
+			return nil
+		},
+	)
 	if err != nil {
-		return nil, db.NoRowsAffected, fmt.Errorf("error performing status upsert: %w", err)
+		return nil, db.NoRowsAffected, err
 	}
 	// If updating a controller, done
 	if server.Type == resource.Controller.String() {
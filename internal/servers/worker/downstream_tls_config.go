@@ -0,0 +1,173 @@
+package worker
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/proxy"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/yamux"
+	"google.golang.org/protobuf/proto"
+)
+
+// downstreamNonceTimeout is how long a downstream worker has to send its
+// connection nonce after the TLS handshake
+const downstreamNonceTimeout = 10 * time.Second
+
+// validateDownstreamTls returns the TLS configuration for a tunnel opened by
+// a downstream worker. Downstream workers authenticate exactly like workers
+// authenticating to a controller: the ALPN protos carry a certificate and
+// nonce encrypted with the worker-auth KMS, so only a holder of that key can
+// complete the handshake on either side.
+func (w *Worker) validateDownstreamTls(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+	var encString string
+	for _, p := range hello.SupportedProtos {
+		if strings.HasPrefix(p, "v1workerauth-") {
+			// Strip that and the number
+			encString += strings.TrimPrefix(p, "v1workerauth-")[3:]
+		}
+	}
+	if encString == "" {
+		return nil, errors.New("no worker auth information found")
+	}
+	marshaledEncInfo, err := base64.RawStdEncoding.DecodeString(encString)
+	if err != nil {
+		return nil, err
+	}
+	encInfo := new(wrapping.EncryptedBlobInfo)
+	if err := proto.Unmarshal(marshaledEncInfo, encInfo); err != nil {
+		return nil, err
+	}
+	marshaledInfo, err := w.conf.WorkerAuthKms.Decrypt(context.Background(), encInfo, nil)
+	if err != nil {
+		return nil, err
+	}
+	info := new(base.WorkerAuthInfo)
+	if err := json.Unmarshal(marshaledInfo, info); err != nil {
+		return nil, err
+	}
+
+	rootCAs := x509.NewCertPool()
+	if ok := rootCAs.AppendCertsFromPEM(info.CertPEM); !ok {
+		return nil, errors.New("unable to add ca cert to cert pool")
+	}
+	tlsCert, err := tls.X509KeyPair(info.CertPEM, info.KeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set the info we need to prevent replays
+	w.downstreamAuthCache.Set(info.ConnectionNonce, info, 0)
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{tlsCert},
+		ClientCAs:    rootCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		NextProtos:   []string{globals.WorkerTunnelV1},
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// acceptDownstreams accepts the tunnels of downstream workers on l until it
+// is closed
+func (w *Worker) acceptDownstreams(l net.Listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			w.logger.Debug("stopped accepting downstream workers", "error", err)
+			return
+		}
+		go w.handleDownstream(conn)
+	}
+}
+
+// handleDownstream completes the authentication of a downstream worker and
+// keeps its tunnel available for forwarding until it is closed. As with
+// controllers, the first thing sent on the connection must be the nonce from
+// the encrypted TLS information, which prevents replaying the handshake.
+func (w *Worker) handleDownstream(conn net.Conn) {
+	if err := conn.SetReadDeadline(time.Now().Add(downstreamNonceTimeout)); err != nil {
+		w.logger.Error("error setting downstream worker read deadline", "error", err)
+		conn.Close()
+		return
+	}
+	nonce := make([]byte, 20)
+	if _, err := io.ReadFull(conn, nonce); err != nil {
+		w.logger.Error("error reading nonce from downstream worker", "error", err)
+		conn.Close()
+		return
+	}
+	infoRaw, found := w.downstreamAuthCache.Get(string(nonce))
+	if !found {
+		w.logger.Error("did not find valid nonce for incoming downstream worker")
+		conn.Close()
+		return
+	}
+	w.downstreamAuthCache.Delete(string(nonce))
+	name := infoRaw.(*base.WorkerAuthInfo).Name
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		w.logger.Error("error clearing downstream worker read deadline", "error", err)
+		conn.Close()
+		return
+	}
+
+	if err := writeTunnelMessage(conn, &proxy.UpstreamHello{Name: w.conf.RawConfig.Worker.Name}); err != nil {
+		w.logger.Error("error sending hello to downstream worker", "error", err, "name", name)
+		conn.Close()
+		return
+	}
+
+	session, err := yamux.Client(conn, w.tunnelConfig())
+	if err != nil {
+		w.logger.Error("error starting tunnel to downstream worker", "error", err, "name", name)
+		conn.Close()
+		return
+	}
+	if old, loaded := w.downstreams.Load(name); loaded {
+		// The downstream worker reconnected, the old tunnel is stale
+		old.(*yamux.Session).Close()
+	}
+	w.downstreams.Store(name, session)
+	w.logger.Info("downstream worker connected", "name", name)
+
+	select {
+	case <-session.CloseChan():
+	case <-w.baseContext.Done():
+		session.Close()
+	}
+	// Only forget the tunnel if it hasn't been replaced in the meantime
+	if cur, ok := w.downstreams.Load(name); ok && cur.(*yamux.Session) == session {
+		w.downstreams.Delete(name)
+	}
+	w.logger.Info("downstream worker disconnected", "name", name)
+}
+
+// tunnelConfig returns the multiplexer configuration of tunnels between
+// workers
+func (w *Worker) tunnelConfig() *yamux.Config {
+	cfg := yamux.DefaultConfig()
+	cfg.LogOutput = nil
+	cfg.Logger = w.logger.Named("tunnel").StandardLogger(nil)
+	return cfg
+}
+
+// DownstreamWorkers returns the names of the downstream workers currently
+// connected to this worker
+func (w *Worker) DownstreamWorkers() []string {
+	var names []string
+	w.downstreams.Range(func(k, _ interface{}) bool {
+		names = append(names, k.(string))
+		return true
+	})
+	return names
+}
@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// drainPollInterval is how often a draining worker checks whether its
+// connections have finished
+const drainPollInterval = time.Second
+
+// StartDrain puts the worker into draining state. A draining worker reports
+// it to the controller so it is no longer offered to clients and rejects new
+// proxy connections, but keeps proxying the connections it already has. There
+// is no way back out of draining state short of restarting the worker.
+func (w *Worker) StartDrain() {
+	if w.draining.CAS(false, true) {
+		w.logger.Info("worker is draining, new connections will be rejected", "active_connections", w.activeConnections())
+	}
+}
+
+// Draining reports whether the worker is in draining state
+func (w *Worker) Draining() bool {
+	return w.draining.Load()
+}
+
+// Drain puts the worker into draining state and waits for its connections to
+// finish. An error is returned if ctx is done before they all have, in which
+// case the remaining connections are left running.
+func (w *Worker) Drain(ctx context.Context) error {
+	w.StartDrain()
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for {
+		n := w.activeConnections()
+		if n == 0 {
+			w.logger.Info("worker drained")
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%d connections still open: %w", n, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// activeConnections returns the number of connections the worker is
+// proxying, either for its own clients or for an upstream worker. Connections
+// are counted until the worker stops proxying them, not until the controller
+// acknowledges them as closed, so that an unreachable controller does not
+// hold up draining.
+func (w *Worker) activeConnections() int {
+	return int(w.proxiedConns.Load() + w.tunneledConns.Load())
+}
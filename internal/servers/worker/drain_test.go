@@ -0,0 +1,43 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrain(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	w := &Worker{
+		logger:         hclog.NewNullLogger(),
+		sessionInfoMap: new(sync.Map),
+	}
+	w.proxiedConns.Inc()
+	w.tunneledConns.Inc()
+	assert.Equal(2, w.activeConnections())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := w.Drain(ctx)
+	require.Error(err)
+	assert.True(errors.Is(err, context.DeadlineExceeded))
+	assert.True(w.Draining())
+
+	// Connections count as finished once they are no longer proxied, without
+	// waiting for the controller
+	w.tunneledConns.Dec()
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		w.proxiedConns.Dec()
+	}()
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	require.NoError(w.Drain(ctx))
+	assert.Equal(0, w.activeConnections())
+}
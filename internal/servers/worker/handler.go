@@ -71,6 +71,7 @@ func (w *Worker) handleProxy() http.HandlerFunc {
 		tofuToken := si.lookupSessionResponse.GetTofuToken()
 		version := si.lookupSessionResponse.GetVersion()
 		endpoint := si.lookupSessionResponse.GetEndpoint()
+		httpProxy := si.lookupSessionResponse.GetHttpProxyPolicy() != nil
 		//userId := si.lookupSessionResponse.GetAuthorization()
 		sessStatus := si.status
 		si.RUnlock()
@@ -78,7 +79,7 @@ func (w *Worker) handleProxy() http.HandlerFunc {
 		w.logger.Trace("found session in session info map")
 
 		opts := &websocket.AcceptOptions{
-			Subprotocols: []string{globals.TcpProxyV1},
+			Subprotocols: []string{globals.TcpProxyV1, globals.UdpProxyV1},
 		}
 		conn, err := websocket.Accept(wr, r, opts)
 		if err != nil {
@@ -91,6 +92,12 @@ func (w *Worker) handleProxy() http.HandlerFunc {
 
 		w.logger.Trace("websocket upgrade done")
 
+		if w.Draining() {
+			w.logger.Info("rejecting connection while draining", "session_id", sessionId)
+			conn.Close(websocket.StatusTryAgainLater, "worker is draining")
+			return
+		}
+
 		connCtx, connCancel := context.WithDeadline(r.Context(), expiration.AsTime())
 		defer connCancel()
 
@@ -154,6 +161,11 @@ func (w *Worker) handleProxy() http.HandlerFunc {
 		si.status = sessStatus
 		connectionLimit := si.lookupSessionResponse.GetConnectionLimit()
 		si.Unlock()
+		// The connection counts as active until the proxy handlers below
+		// return, which they do once they stopped copying its data, whether
+		// or not the controller has acknowledged it being closed
+		w.proxiedConns.Inc()
+		defer w.proxiedConns.Dec()
 
 		w.logger.Trace("authorized connection", "connection_id", ci.id)
 
@@ -170,7 +182,15 @@ func (w *Worker) handleProxy() http.HandlerFunc {
 
 		switch conn.Subprotocol() {
 		case globals.TcpProxyV1:
+			// The connections of http targets are tunneled in the same way
+			// as tcp ones but proxied request by request
+			if httpProxy {
+				w.handleHttpProxyV1(connCtx, clientAddr, conn, si, ci.id, endpoint)
+				return
+			}
 			w.handleTcpProxyV1(connCtx, clientAddr, conn, si, ci.id, endpoint)
+		case globals.UdpProxyV1:
+			w.handleUdpProxyV1(connCtx, clientAddr, conn, si, ci.id, endpoint)
 		default:
 			conn.Close(websocket.StatusProtocolError, "unsupported-protocol")
 			return
@@ -0,0 +1,384 @@
+package worker
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+	"nhooyr.io/websocket"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/proxy"
+	"github.com/hashicorp/boundary/internal/target"
+)
+
+// handleHttpProxyV1 serves the connection of a session of an http target as
+// an http reverse proxy to the endpoint. Requests not allowed by the policy of
+// the target are refused, the injected headers are set on the others, and
+// every request is logged against the session and connection.
+func (w *Worker) handleHttpProxyV1(connCtx context.Context, clientAddr *net.TCPAddr, conn *websocket.Conn, si *sessionInfo, connectionId, endpoint string) {
+	si.RLock()
+	sessionId := si.lookupSessionResponse.GetAuthorization().GetSessionId()
+	policy := si.lookupSessionResponse.GetHttpProxyPolicy()
+	eligibleWorkers := si.lookupSessionResponse.GetEligibleWorkers()
+	idleTimeout := time.Duration(si.lookupSessionResponse.GetIdleTimeoutSeconds()) * time.Second
+	si.RUnlock()
+
+	sessionUrl, err := url.Parse(endpoint)
+	if err != nil {
+		w.logger.Error("error parsing endpoint information", "error", err, "session_id", sessionId, "endpoint", endpoint)
+		conn.Close(websocket.StatusInternalError, "cannot parse endpoint url")
+		return
+	}
+	if sessionUrl.Scheme != "http" && sessionUrl.Scheme != "https" {
+		w.logger.Error("invalid scheme for http proxy", "session_id", sessionId, "endpoint", endpoint)
+		conn.Close(websocket.StatusInternalError, "invalid scheme for type")
+		return
+	}
+	injectedHeaders := make(http.Header, len(policy.GetInjectedHeaders()))
+	for _, h := range policy.GetInjectedHeaders() {
+		name, value, err := target.ParseInjectedHeader(h)
+		if err != nil {
+			w.logger.Error("error parsing injected header", "error", err, "session_id", sessionId)
+			conn.Close(websocket.StatusInternalError, "invalid injected header")
+			return
+		}
+		injectedHeaders.Add(name, value)
+	}
+
+	// The endpoint is dialed up front so that the connection can be marked
+	// as connected to it. Later requests dial it again if the connection has
+	// been closed.
+	dialRequest := &proxy.DownstreamDialRequest{
+		SessionId:       sessionId,
+		ConnectionId:    connectionId,
+		Endpoint:        sessionUrl.Host,
+		EligibleWorkers: eligibleWorkers,
+	}
+	remoteConn, dialed, err := w.dialEndpoint(connCtx, dialRequest)
+	if err != nil {
+		w.logger.Error("error dialing endpoint", "error", err, "endpoint", endpoint)
+		conn.Close(websocket.StatusInternalError, "endpoint dialing failed")
+		return
+	}
+
+	connectionInfo := &pbs.ConnectConnectionRequest{
+		ConnectionId:       connectionId,
+		ClientTcpAddress:   clientAddr.IP.String(),
+		ClientTcpPort:      uint32(clientAddr.Port),
+		EndpointTcpAddress: dialed.GetEndpointTcpAddress(),
+		EndpointTcpPort:    dialed.GetEndpointTcpPort(),
+		Type:               "http",
+	}
+
+	connStatus, err := w.connectConnection(connCtx, connectionInfo)
+	if err != nil {
+		w.logger.Error("error marking connection as connected", "error", err)
+		conn.Close(websocket.StatusInternalError, "failed to mark connection as connected")
+		remoteConn.Close()
+		return
+	}
+	si.Lock()
+	ci := si.connInfoMap[connectionId]
+	ci.status = connStatus
+	si.Unlock()
+
+	var firstConnLock sync.Mutex
+	firstConn := remoteConn
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			firstConnLock.Lock()
+			c := firstConn
+			firstConn = nil
+			firstConnLock.Unlock()
+			if c != nil {
+				return c, nil
+			}
+			c, _, err := w.dialEndpoint(ctx, dialRequest)
+			return c, err
+		},
+		DisableCompression:    true,
+		MaxIdleConnsPerHost:   1,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+	defer func() {
+		transport.CloseIdleConnections()
+		firstConnLock.Lock()
+		if firstConn != nil {
+			firstConn.Close()
+		}
+		firstConnLock.Unlock()
+	}()
+	reverseProxy := &httputil.ReverseProxy{
+		Director: func(r *http.Request) {
+			r.URL.Scheme = sessionUrl.Scheme
+			r.URL.Host = sessionUrl.Host
+			r.Host = sessionUrl.Host
+			for name, values := range injectedHeaders {
+				r.Header[name] = values
+			}
+		},
+		Transport:     transport,
+		FlushInterval: -1,
+		ErrorLog:      w.logger.StandardLogger(nil),
+		ErrorHandler: func(rw http.ResponseWriter, r *http.Request, err error) {
+			w.logger.Debug("error proxying http request", "error", err, "session_id", sessionId, "connection_id", connectionId)
+			rw.WriteHeader(http.StatusBadGateway)
+		},
+	}
+
+	limitUp, limitDown := si.limiters()
+	clientConn := &proxiedConn{
+		Conn:      websocket.NetConn(connCtx, conn, websocket.MessageBinary),
+		ctx:       connCtx,
+		ci:        ci,
+		limitUp:   limitUp,
+		limitDown: limitDown,
+	}
+
+	atomic.StoreInt64(&ci.lastActivity, time.Now().UnixNano())
+	proxyDone := make(chan struct{})
+	if idleTimeout > 0 {
+		go w.closeIdleConnection(si, ci, conn, clientConn, idleTimeout, proxyDone)
+	}
+
+	// Upgraded requests keep being proxied by their handler once the server
+	// is done with the connection
+	handlersWg := new(sync.WaitGroup)
+	listener := newConnListener(clientConn)
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			handlersWg.Add(1)
+			defer handlersWg.Done()
+			start := time.Now()
+			rec := &requestRecorder{ResponseWriter: rw}
+			body := &countingBody{ReadCloser: r.Body}
+			r.Body = body
+			switch {
+			case !methodAllowed(policy.GetAllowedMethods(), r.Method):
+				rec.Header().Set("Allow", strings.Join(policy.GetAllowedMethods(), ", "))
+				http.Error(rec, "Method not allowed by the target.", http.StatusMethodNotAllowed)
+			case !canonicalPath(r.URL.Path):
+				http.Error(rec, "Path must not contain empty or dot segments.", http.StatusBadRequest)
+			case !pathAllowed(policy.GetAllowedPathPrefixes(), r.URL.Path):
+				http.Error(rec, "Path not allowed by the target.", http.StatusForbidden)
+			default:
+				reverseProxy.ServeHTTP(rec, r)
+			}
+			w.logger.Info("http request",
+				"session_id", sessionId,
+				"connection_id", connectionId,
+				"method", r.Method,
+				"path", r.URL.Path,
+				"status", rec.statusCode(),
+				"request_bytes", atomic.LoadInt64(&body.count),
+				"response_bytes", rec.count,
+				"duration", time.Since(start))
+		}),
+		ErrorLog: w.logger.StandardLogger(nil),
+		ConnState: func(_ net.Conn, state http.ConnState) {
+			if state == http.StateClosed || state == http.StateHijacked {
+				listener.Close()
+			}
+		},
+	}
+	err = srv.Serve(listener)
+	handlersWg.Wait()
+	close(proxyDone)
+	w.logger.Debug("http proxying done", "error", err, "session_id", sessionId, "connection_id", connectionId)
+
+	bytesUp, bytesDown := ci.bytes()
+	w.logger.Debug("connection proxying done", "session_id", sessionId, "connection_id", connectionId, "bytes_up", bytesUp, "bytes_down", bytesDown)
+}
+
+// methodAllowed reports whether method is one of allowed, all methods being
+// allowed if allowed is empty.
+func methodAllowed(allowed []string, method string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, m := range allowed {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// canonicalPath reports whether p has no empty or dot segments, which could
+// otherwise be used to reach paths outside of an allowed prefix.
+func canonicalPath(p string) bool {
+	clean := path.Clean(p)
+	if strings.HasSuffix(p, "/") && clean != "/" {
+		clean += "/"
+	}
+	return clean == p
+}
+
+// pathAllowed reports whether p starts with one of the allowed prefixes, all
+// paths being allowed if prefixes is empty. A prefix not ending with a slash
+// only matches whole path segments, so that "/admin" matches "/admin/users"
+// but not "/administrator".
+func pathAllowed(prefixes []string, p string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+	for _, prefix := range prefixes {
+		switch {
+		case strings.HasSuffix(prefix, "/") && strings.HasPrefix(p, prefix):
+			return true
+		case p == prefix || strings.HasPrefix(p, prefix+"/"):
+			return true
+		}
+	}
+	return false
+}
+
+// proxiedConn is the client side of a connection proxied as http requests.
+// The bytes read and written are counted as the bytes proxied up and down
+// the connection and limited by the limiters of the session if it has any.
+type proxiedConn struct {
+	net.Conn
+	ctx       context.Context
+	ci        *connInfo
+	limitUp   *rate.Limiter
+	limitDown *rate.Limiter
+}
+
+func (c *proxiedConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	if n > 0 {
+		atomic.AddUint64(&c.ci.bytesUp, uint64(n))
+		atomic.StoreInt64(&c.ci.lastActivity, time.Now().UnixNano())
+		if c.limitUp != nil {
+			if err := waitLimiter(c.ctx, c.limitUp, n); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, err
+}
+
+func (c *proxiedConn) Write(p []byte) (int, error) {
+	if c.limitDown != nil {
+		if err := waitLimiter(c.ctx, c.limitDown, len(p)); err != nil {
+			return 0, err
+		}
+	}
+	n, err := c.Conn.Write(p)
+	atomic.AddUint64(&c.ci.bytesDown, uint64(n))
+	if n > 0 {
+		atomic.StoreInt64(&c.ci.lastActivity, time.Now().UnixNano())
+	}
+	return n, err
+}
+
+// connListener is a net.Listener accepting a single connection, so that it
+// can be served by an http.Server. Accept blocks once the connection has been
+// accepted until the listener is closed.
+type connListener struct {
+	conn      net.Conn
+	accepted  bool
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newConnListener(conn net.Conn) *connListener {
+	return &connListener{
+		conn:   conn,
+		closed: make(chan struct{}),
+	}
+}
+
+func (l *connListener) Accept() (net.Conn, error) {
+	if !l.accepted {
+		l.accepted = true
+		return l.conn, nil
+	}
+	<-l.closed
+	return nil, errors.New("listener closed")
+}
+
+func (l *connListener) Close() error {
+	l.closeOnce.Do(func() { close(l.closed) })
+	return nil
+}
+
+func (l *connListener) Addr() net.Addr {
+	return l.conn.LocalAddr()
+}
+
+// requestRecorder wraps an http.ResponseWriter to record the status and the
+// number of bytes of the response.
+type requestRecorder struct {
+	http.ResponseWriter
+	status int
+	count  int64
+}
+
+func (r *requestRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *requestRecorder) Write(p []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	n, err := r.ResponseWriter.Write(p)
+	r.count += int64(n)
+	return n, err
+}
+
+func (r *requestRecorder) statusCode() int {
+	if r.status == 0 {
+		return http.StatusOK
+	}
+	return r.status
+}
+
+// Flush lets streamed responses through as they are received.
+func (r *requestRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack lets upgraded requests, such as websockets, be proxied.
+func (r *requestRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer cannot be hijacked")
+	}
+	if r.status == 0 {
+		r.status = http.StatusSwitchingProtocols
+	}
+	return h.Hijack()
+}
+
+// countingBody wraps a request body to count the bytes read from it.
+type countingBody struct {
+	io.ReadCloser
+	count int64
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	atomic.AddInt64(&b.count, int64(n))
+	return n, err
+}
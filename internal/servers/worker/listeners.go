@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/libs/alpnmux"
 	"github.com/hashicorp/go-multierror"
 )
@@ -20,8 +21,9 @@ func (w *Worker) startListeners() error {
 	for _, ln := range w.conf.Listeners {
 		for _, purpose := range ln.Config.Purpose {
 			switch purpose {
-			case "api", "cluster":
-				// We may have this in dev mode; ignore
+			case "api", "cluster", "ops", "grpc":
+				// We may have api, cluster and grpc in dev mode and ops
+				// listeners are served by the base server; ignore
 				continue
 
 			case "proxy":
@@ -77,6 +79,19 @@ func (w *Worker) startListeners() error {
 			servers = append(servers, func() {
 				go server.Serve(l)
 			})
+
+			// Downstream workers open their tunnels to the proxy listener as
+			// well, with their own ALPN proto
+			ln.Mux.UnregisterProto(globals.WorkerTunnelV1)
+			tl, err := ln.Mux.RegisterProto(globals.WorkerTunnelV1, &tls.Config{
+				GetConfigForClient: w.validateDownstreamTls,
+			})
+			if err != nil {
+				return fmt.Errorf("error getting tunnel listener: %w", err)
+			}
+			servers = append(servers, func() {
+				go w.acceptDownstreams(tl)
+			})
 		}
 	}
 
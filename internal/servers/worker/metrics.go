@@ -0,0 +1,68 @@
+package worker
+
+import (
+	"time"
+
+	"github.com/armon/go-metrics"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// sessionsActiveKey and connectionsActiveKey are the keys of the number
+	// of active sessions and connected connections of the worker
+	sessionsActiveKey    = []string{"worker", "sessions", "active"}
+	connectionsActiveKey = []string{"worker", "connections", "active"}
+
+	// proxyBytesKey is the key of the number of bytes proxied by the worker,
+	// labeled with the direction: up from clients to endpoints, down from
+	// endpoints to clients
+	proxyBytesKey = []string{"worker", "proxy", "bytes"}
+
+	// statusRequestKey is the key of the latency of the status requests of
+	// the worker to controllers, labeled with their gRPC code
+	statusRequestKey = []string{"worker", "status", "request"}
+)
+
+// emitSessionMetrics sets the gauges of the sessions and connections of the
+// worker from sessionInfoMap and adds the bytes proxied since the last call to
+// the proxy bytes counter. It is only called from the status loop, which owns
+// the reported byte counts of the connections.
+func (w *Worker) emitSessionMetrics() {
+	labels := []metrics.Label{{Name: "worker", Value: w.conf.RawConfig.Worker.Name}}
+	var sessions, connections int
+	var bytesUp, bytesDown uint64
+	w.sessionInfoMap.Range(func(_, value interface{}) bool {
+		si := value.(*sessionInfo)
+		si.RLock()
+		defer si.RUnlock()
+		if si.status == pbs.SESSIONSTATUS_SESSIONSTATUS_ACTIVE {
+			sessions++
+		}
+		for _, ci := range si.connInfoMap {
+			if ci.status == pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED {
+				connections++
+			}
+			up, down := ci.bytes()
+			bytesUp += up - ci.reportedBytesUp
+			bytesDown += down - ci.reportedBytesDown
+			ci.reportedBytesUp, ci.reportedBytesDown = up, down
+		}
+		return true
+	})
+
+	metrics.SetGaugeWithLabels(sessionsActiveKey, float32(sessions), labels)
+	metrics.SetGaugeWithLabels(connectionsActiveKey, float32(connections), labels)
+	if bytesUp > 0 {
+		metrics.IncrCounterWithLabels(proxyBytesKey, float32(bytesUp), append(labels, metrics.Label{Name: "direction", Value: "up"}))
+	}
+	if bytesDown > 0 {
+		metrics.IncrCounterWithLabels(proxyBytesKey, float32(bytesDown), append(labels, metrics.Label{Name: "direction", Value: "down"}))
+	}
+}
+
+// measureStatusRequest records the latency of a status request started at
+// start which returned err
+func measureStatusRequest(start time.Time, err error) {
+	metrics.MeasureSinceWithLabels(statusRequestKey, start, []metrics.Label{{Name: "code", Value: status.Code(err).String()}})
+}
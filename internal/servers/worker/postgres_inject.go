@@ -0,0 +1,435 @@
+package worker
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/targets"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// The codes of the postgres startup packets that can be sent by a client
+// before the startup message
+const (
+	pgProtocolVersion3 = 196608
+	pgSSLRequest       = 80877103
+	pgGSSENCRequest    = 80877104
+	pgCancelRequest    = 80877102
+)
+
+// The authentication request codes sent by a postgres server
+const (
+	pgAuthOk                = 0
+	pgAuthCleartextPassword = 3
+	pgAuthMD5Password       = 5
+	pgAuthSASL              = 10
+	pgAuthSASLContinue      = 11
+	pgAuthSASLFinal         = 12
+)
+
+// maxPgMessageSize bounds the messages read during the injection handshake,
+// which are all small
+const maxPgMessageSize = 64 * 1024
+
+const pgScramSHA256 = "SCRAM-SHA-256"
+
+// maxPgScramIterations bounds the SCRAM iteration count accepted from an
+// endpoint, which would otherwise choose how long the worker spends deriving
+// the salted password. Postgres uses 4096 by default.
+const maxPgScramIterations = 1 << 20
+
+// injectPostgresCredential authenticates the client to the postgres endpoint
+// with cred, so the client never sees the password. It reads the startup
+// message of the client, replaces its user with the username of cred and
+// answers the authentication requests of the endpoint. SSL and GSS encryption
+// requests of the client are declined, the connection to the worker is
+// already encrypted. Once the endpoint reports AuthenticationOk it is
+// forwarded to the client and the connection can be proxied as usual, over
+// the returned connection to the endpoint.
+//
+// The connection to the endpoint is encrypted with TLS if the endpoint
+// supports it. As the password itself is sent in response to a cleartext
+// password request, those are refused unless the certificate of the endpoint
+// was verified against the system roots for endpointHost; md5 and SCRAM
+// authentication don't reveal the password and are allowed either way.
+//
+// If authentication fails the error of the endpoint is forwarded to the
+// client and an error is returned.
+func injectPostgresCredential(client io.ReadWriter, endpoint net.Conn, endpointHost string, cred *targets.SessionCredential) (net.Conn, error) {
+	if cred == nil {
+		return nil, errors.New("no credential to inject")
+	}
+
+	var startup []byte
+	for startup == nil {
+		msg, err := readPgStartup(client)
+		if err != nil {
+			return nil, fmt.Errorf("error reading client startup message: %w", err)
+		}
+		switch binary.BigEndian.Uint32(msg[4:8]) {
+		case pgSSLRequest, pgGSSENCRequest:
+			if _, err := client.Write([]byte{'N'}); err != nil {
+				return nil, fmt.Errorf("error declining client encryption request: %w", err)
+			}
+		case pgCancelRequest:
+			// Cancel requests carry no credentials, the endpoint closes the
+			// connection after handling it
+			if _, err := endpoint.Write(msg); err != nil {
+				return nil, fmt.Errorf("error forwarding cancel request: %w", err)
+			}
+			return endpoint, nil
+		case pgProtocolVersion3:
+			startup, err = rewritePgStartupUser(msg, cred.GetUsername())
+			if err != nil {
+				return nil, err
+			}
+		default:
+			writePgError(client, "unsupported frontend protocol")
+			return nil, errors.New("unsupported postgres frontend protocol")
+		}
+	}
+
+	endpoint, verified, err := startPgTLS(endpoint, endpointHost)
+	if err != nil {
+		writePgError(client, "unable to negotiate encryption with the server")
+		return nil, err
+	}
+	if _, err := endpoint.Write(startup); err != nil {
+		return nil, fmt.Errorf("error writing startup message to endpoint: %w", err)
+	}
+
+	var scram *pgScramClient
+	for {
+		typ, body, err := readPgMessage(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("error reading endpoint authentication message: %w", err)
+		}
+		switch typ {
+		case 'E':
+			_ = writePgMessage(client, typ, body)
+			return nil, errors.New("endpoint rejected the injected credential")
+		case 'R':
+		default:
+			writePgError(client, "unexpected message during authentication")
+			return nil, fmt.Errorf("unexpected postgres message type %q during authentication", typ)
+		}
+		if len(body) < 4 {
+			return nil, errors.New("invalid postgres authentication message")
+		}
+
+		var resp []byte
+		switch code := binary.BigEndian.Uint32(body[:4]); code {
+		case pgAuthOk:
+			if err := writePgMessage(client, typ, body); err != nil {
+				return nil, fmt.Errorf("error forwarding authentication result to client: %w", err)
+			}
+			return endpoint, nil
+		case pgAuthCleartextPassword:
+			if !verified {
+				writePgError(client, "the server requested a cleartext password over a connection that isn't verified TLS")
+				return nil, errors.New("endpoint requested a cleartext password without a verified TLS connection")
+			}
+			resp = append([]byte(cred.GetPassword()), 0)
+		case pgAuthMD5Password:
+			if len(body) != 8 {
+				return nil, errors.New("invalid postgres md5 authentication request")
+			}
+			resp = append([]byte(pgMD5Password(cred.GetUsername(), cred.GetPassword(), body[4:8])), 0)
+		case pgAuthSASL:
+			if !containsPgString(body[4:], pgScramSHA256) {
+				writePgError(client, "no supported SASL mechanism offered by the server")
+				return nil, errors.New("endpoint does not offer SCRAM-SHA-256")
+			}
+			scram, err = newPgScramClient(cred.GetPassword())
+			if err != nil {
+				return nil, err
+			}
+			first := scram.clientFirst()
+			resp = append([]byte(pgScramSHA256), 0)
+			resp = append(resp, make([]byte, 4)...)
+			binary.BigEndian.PutUint32(resp[len(resp)-4:], uint32(len(first)))
+			resp = append(resp, first...)
+		case pgAuthSASLContinue:
+			if scram == nil {
+				return nil, errors.New("unexpected SASL continue message")
+			}
+			resp, err = scram.clientFinal(body[4:])
+			if err != nil {
+				return nil, err
+			}
+		case pgAuthSASLFinal:
+			if scram == nil {
+				return nil, errors.New("unexpected SASL final message")
+			}
+			if err := scram.verifyServerFinal(body[4:]); err != nil {
+				return nil, err
+			}
+			continue
+		default:
+			writePgError(client, "unsupported authentication method")
+			return nil, fmt.Errorf("unsupported postgres authentication request %d", code)
+		}
+		if err := writePgMessage(endpoint, 'p', resp); err != nil {
+			return nil, fmt.Errorf("error writing authentication response to endpoint: %w", err)
+		}
+	}
+}
+
+// startPgTLS requests TLS from the postgres endpoint conn and, if the endpoint
+// supports it, returns the TLS connection to it along with whether its
+// certificate could be verified against the system roots for host. Endpoints
+// that don't support TLS are returned as they are. The certificate isn't
+// required to verify since many endpoints use self-signed certificates, the
+// connection is encrypted either way.
+func startPgTLS(conn net.Conn, host string) (net.Conn, bool, error) {
+	req := make([]byte, 8)
+	binary.BigEndian.PutUint32(req[:4], 8)
+	binary.BigEndian.PutUint32(req[4:], pgSSLRequest)
+	if _, err := conn.Write(req); err != nil {
+		return nil, false, fmt.Errorf("error requesting TLS from endpoint: %w", err)
+	}
+	var resp [1]byte
+	if _, err := io.ReadFull(conn, resp[:]); err != nil {
+		return nil, false, fmt.Errorf("error reading endpoint TLS response: %w", err)
+	}
+	switch resp[0] {
+	case 'N':
+		return conn, false, nil
+	case 'S':
+	default:
+		return nil, false, fmt.Errorf("unexpected endpoint TLS response %q", resp[0])
+	}
+
+	tlsConn := tls.Client(conn, &tls.Config{
+		ServerName: host,
+		MinVersion: tls.VersionTLS12,
+		// Verified below, so that endpoints with certificates that can't be
+		// verified are still encrypted
+		InsecureSkipVerify: true,
+	})
+	if err := tlsConn.Handshake(); err != nil {
+		return nil, false, fmt.Errorf("error during endpoint TLS handshake: %w", err)
+	}
+	state := tlsConn.ConnectionState()
+	if len(state.PeerCertificates) == 0 {
+		return tlsConn, false, nil
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       host,
+		Intermediates: intermediates,
+	})
+	return tlsConn, err == nil, nil
+}
+
+// readPgStartup reads an untyped startup packet, including its length
+func readPgStartup(r io.Reader) ([]byte, error) {
+	var l [4]byte
+	if _, err := io.ReadFull(r, l[:]); err != nil {
+		return nil, err
+	}
+	n := binary.BigEndian.Uint32(l[:])
+	if n < 8 || n > maxPgMessageSize {
+		return nil, fmt.Errorf("invalid startup message length %d", n)
+	}
+	msg := make([]byte, n)
+	copy(msg, l[:])
+	if _, err := io.ReadFull(r, msg[4:]); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// rewritePgStartupUser returns the startup message msg with the user
+// parameter set to user
+func rewritePgStartupUser(msg []byte, user string) ([]byte, error) {
+	params := strings.Split(string(msg[8:]), "\x00")
+	// The parameters are terminated by an empty name
+	if len(params) < 2 || params[len(params)-1] != "" || params[len(params)-2] != "" {
+		return nil, errors.New("invalid postgres startup message")
+	}
+	params = params[:len(params)-2]
+	if len(params)%2 != 0 {
+		return nil, errors.New("invalid postgres startup message parameters")
+	}
+
+	var buf bytes.Buffer
+	buf.Write(make([]byte, 4))
+	buf.Write(msg[4:8])
+	buf.WriteString("user\x00" + user + "\x00")
+	for i := 0; i < len(params); i += 2 {
+		if params[i] == "user" {
+			continue
+		}
+		buf.WriteString(params[i] + "\x00" + params[i+1] + "\x00")
+	}
+	buf.WriteByte(0)
+	out := buf.Bytes()
+	binary.BigEndian.PutUint32(out, uint32(len(out)))
+	return out, nil
+}
+
+// readPgMessage reads a typed message, returning its type and body
+func readPgMessage(r io.Reader) (byte, []byte, error) {
+	var hdr [5]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return 0, nil, err
+	}
+	n := binary.BigEndian.Uint32(hdr[1:])
+	if n < 4 || n > maxPgMessageSize {
+		return 0, nil, fmt.Errorf("invalid message length %d", n)
+	}
+	body := make([]byte, n-4)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, nil, err
+	}
+	return hdr[0], body, nil
+}
+
+func writePgMessage(w io.Writer, typ byte, body []byte) error {
+	msg := make([]byte, 5, 5+len(body))
+	msg[0] = typ
+	binary.BigEndian.PutUint32(msg[1:], uint32(len(body)+4))
+	_, err := w.Write(append(msg, body...))
+	return err
+}
+
+// writePgError sends a fatal ErrorResponse to the client. Errors writing it
+// are ignored as the connection is closed afterwards anyway.
+func writePgError(w io.Writer, message string) {
+	body := "SFATAL\x00C28000\x00Mboundary: " + message + "\x00\x00"
+	_ = writePgMessage(w, 'E', []byte(body))
+}
+
+// containsPgString reports whether the list of null terminated strings b
+// contains s
+func containsPgString(b []byte, s string) bool {
+	for _, v := range strings.Split(string(b), "\x00") {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// pgMD5Password computes the response to an md5 authentication request
+func pgMD5Password(user, password string, salt []byte) string {
+	inner := md5.Sum([]byte(password + user))
+	outer := md5.Sum(append([]byte(hex.EncodeToString(inner[:])), salt...))
+	return "md5" + hex.EncodeToString(outer[:])
+}
+
+// pgScramClient holds the state of a SCRAM-SHA-256 exchange (RFC 5802 and
+// RFC 7677). Channel binding is not used.
+type pgScramClient struct {
+	password    string
+	nonce       string
+	clientBare  string
+	authMessage string
+	salted      []byte
+}
+
+func newPgScramClient(password string) (*pgScramClient, error) {
+	nonce := make([]byte, 18)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("error generating SCRAM nonce: %w", err)
+	}
+	c := &pgScramClient{
+		password: password,
+		nonce:    base64.StdEncoding.EncodeToString(nonce),
+	}
+	// The server uses the user of the startup message, so the SCRAM user is
+	// left empty
+	c.clientBare = "n=,r=" + c.nonce
+	return c, nil
+}
+
+func (c *pgScramClient) clientFirst() string {
+	return "n,," + c.clientBare
+}
+
+// clientFinal returns the client-final-message for the server-first-message
+// serverFirst
+func (c *pgScramClient) clientFinal(serverFirst []byte) ([]byte, error) {
+	var nonce, salt string
+	var iterations int
+	for _, attr := range strings.Split(string(serverFirst), ",") {
+		if len(attr) < 2 || attr[1] != '=' {
+			continue
+		}
+		switch attr[0] {
+		case 'r':
+			nonce = attr[2:]
+		case 's':
+			salt = attr[2:]
+		case 'i':
+			iterations, _ = strconv.Atoi(attr[2:])
+		}
+	}
+	if !strings.HasPrefix(nonce, c.nonce) || len(nonce) == len(c.nonce) {
+		return nil, errors.New("invalid SCRAM server nonce")
+	}
+	saltBytes, err := base64.StdEncoding.DecodeString(salt)
+	if err != nil || len(saltBytes) == 0 {
+		return nil, errors.New("invalid SCRAM salt")
+	}
+	if iterations <= 0 {
+		return nil, errors.New("invalid SCRAM iteration count")
+	}
+	if iterations > maxPgScramIterations {
+		return nil, fmt.Errorf("SCRAM iteration count %d exceeds the maximum of %d", iterations, maxPgScramIterations)
+	}
+
+	c.salted = pbkdf2.Key([]byte(c.password), saltBytes, iterations, sha256.Size, sha256.New)
+	clientKey := scramHMAC(c.salted, "Client Key")
+	storedKey := sha256.Sum256(clientKey)
+	withoutProof := "c=biws,r=" + nonce
+	c.authMessage = c.clientBare + "," + string(serverFirst) + "," + withoutProof
+	signature := scramHMAC(storedKey[:], c.authMessage)
+	proof := make([]byte, len(clientKey))
+	for i := range clientKey {
+		proof[i] = clientKey[i] ^ signature[i]
+	}
+	return []byte(withoutProof + ",p=" + base64.StdEncoding.EncodeToString(proof)), nil
+}
+
+// verifyServerFinal checks the server signature of the server-final-message
+// serverFinal, which proves the endpoint knows the password as well
+func (c *pgScramClient) verifyServerFinal(serverFinal []byte) error {
+	msg := string(serverFinal)
+	if !strings.HasPrefix(msg, "v=") {
+		return errors.New("invalid SCRAM server final message")
+	}
+	got, err := base64.StdEncoding.DecodeString(msg[2:])
+	if err != nil {
+		return errors.New("invalid SCRAM server signature")
+	}
+	want := scramHMAC(scramHMAC(c.salted, "Server Key"), c.authMessage)
+	if !hmac.Equal(got, want) {
+		return errors.New("SCRAM server signature mismatch")
+	}
+	return nil
+}
+
+func scramHMAC(key []byte, msg string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(msg))
+	return h.Sum(nil)
+}
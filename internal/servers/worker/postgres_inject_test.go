@@ -0,0 +1,226 @@
+package worker
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPgStartup returns the startup message of a client connecting as user
+func testPgStartup(user string) []byte {
+	params := "user\x00" + user + "\x00database\x00db\x00\x00"
+	msg := make([]byte, 8, 8+len(params))
+	binary.BigEndian.PutUint32(msg, uint32(8+len(params)))
+	binary.BigEndian.PutUint32(msg[4:], pgProtocolVersion3)
+	return append(msg, params...)
+}
+
+// testPgAuthRequest returns the body of an authentication request
+func testPgAuthRequest(code uint32, data ...byte) []byte {
+	body := make([]byte, 4)
+	binary.BigEndian.PutUint32(body, code)
+	return append(body, data...)
+}
+
+// testSelfSignedCert returns a certificate for localhost which can't be
+// verified against the system roots
+func testSelfSignedCert(t *testing.T) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// testPgEndpoint answers the TLS request and startup message of a worker
+// injecting a credential, encrypting the connection with cert if set, and
+// then requests authentication with authRequest. It returns the message
+// sent in response, or an error if there is none.
+func testPgEndpoint(conn net.Conn, cert *tls.Certificate, authRequest []byte) ([]byte, error) {
+	defer conn.Close()
+	req, err := readPgStartup(conn)
+	if err != nil {
+		return nil, err
+	}
+	if binary.BigEndian.Uint32(req[4:8]) != pgSSLRequest {
+		return nil, errors.New("expected a TLS request")
+	}
+	if cert == nil {
+		if _, err := conn.Write([]byte{'N'}); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err := conn.Write([]byte{'S'}); err != nil {
+			return nil, err
+		}
+		tlsConn := tls.Server(conn, &tls.Config{Certificates: []tls.Certificate{*cert}})
+		if err := tlsConn.Handshake(); err != nil {
+			return nil, err
+		}
+		conn = tlsConn
+	}
+	if _, err := readPgStartup(conn); err != nil {
+		return nil, err
+	}
+	if err := writePgMessage(conn, 'R', authRequest); err != nil {
+		return nil, err
+	}
+	typ, resp, err := readPgMessage(conn)
+	if err != nil {
+		return nil, err
+	}
+	if typ != 'p' {
+		return nil, errors.New("expected a password message")
+	}
+	return resp, writePgMessage(conn, 'R', testPgAuthRequest(pgAuthOk))
+}
+
+func TestInjectPostgresCredential(t *testing.T) {
+	cred := &targets.SessionCredential{Username: "injected", Password: "secret"}
+	cert := testSelfSignedCert(t)
+	salt := []byte{1, 2, 3, 4}
+	md5Resp := append([]byte(pgMD5Password("injected", "secret", salt)), 0)
+
+	cases := []struct {
+		name        string
+		cert        *tls.Certificate
+		authRequest []byte
+		wantResp    []byte
+		wantErr     bool
+	}{
+		{
+			name:        "md5 without tls",
+			authRequest: testPgAuthRequest(pgAuthMD5Password, salt...),
+			wantResp:    md5Resp,
+		},
+		{
+			name:        "md5 with tls",
+			cert:        &cert,
+			authRequest: testPgAuthRequest(pgAuthMD5Password, salt...),
+			wantResp:    md5Resp,
+		},
+		{
+			name:        "cleartext without tls",
+			authRequest: testPgAuthRequest(pgAuthCleartextPassword),
+			wantErr:     true,
+		},
+		{
+			name:        "cleartext with unverified tls",
+			cert:        &cert,
+			authRequest: testPgAuthRequest(pgAuthCleartextPassword),
+			wantErr:     true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			client, clientWorker := net.Pipe()
+			endpointWorker, endpoint := net.Pipe()
+			defer client.Close()
+
+			type result struct {
+				resp []byte
+				err  error
+			}
+			endpointDone := make(chan result, 1)
+			go func() {
+				resp, err := testPgEndpoint(endpoint, tc.cert, tc.authRequest)
+				endpointDone <- result{resp, err}
+			}()
+			clientMsgs := make(chan byte, 10)
+			go func() {
+				defer close(clientMsgs)
+				if _, err := client.Write(testPgStartup("client")); err != nil {
+					return
+				}
+				for {
+					typ, _, err := readPgMessage(client)
+					if err != nil {
+						return
+					}
+					clientMsgs <- typ
+				}
+			}()
+
+			conn, err := injectPostgresCredential(clientWorker, endpointWorker, "localhost", cred)
+			endpointWorker.Close()
+			if conn != nil {
+				conn.Close()
+			}
+			clientWorker.Close()
+			got := <-endpointDone
+			if tc.wantErr {
+				require.Error(err)
+				// The password was never sent to the endpoint
+				assert.Error(got.err)
+				assert.Nil(got.resp)
+				assert.Equal(byte('E'), <-clientMsgs)
+				return
+			}
+			require.NoError(err)
+			require.NoError(got.err)
+			assert.Equal(tc.wantResp, got.resp)
+			assert.Equal(byte('R'), <-clientMsgs)
+			if tc.cert != nil {
+				_, ok := conn.(*tls.Conn)
+				assert.True(ok, "the endpoint connection should use TLS")
+			}
+		})
+	}
+}
+
+func TestPgScramClientFinal(t *testing.T) {
+	salt := base64.StdEncoding.EncodeToString([]byte("salt"))
+	cases := []struct {
+		name       string
+		iterations string
+		wantErr    bool
+	}{
+		{name: "default iterations", iterations: "4096"},
+		{name: "maximum iterations", iterations: strconv.Itoa(maxPgScramIterations)},
+		{name: "too many iterations", iterations: strconv.Itoa(maxPgScramIterations + 1), wantErr: true},
+		{name: "overflowing iterations", iterations: "99999999999999999999", wantErr: true},
+		{name: "no iterations", iterations: "0", wantErr: true},
+		{name: "invalid iterations", iterations: "many", wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			c, err := newPgScramClient("secret")
+			require.NoError(err)
+			serverFirst := fmt.Sprintf("r=%sserver,s=%s,i=%s", c.nonce, salt, tc.iterations)
+			final, err := c.clientFinal([]byte(serverFirst))
+			if tc.wantErr {
+				assert.Error(err)
+				assert.Nil(final)
+				return
+			}
+			require.NoError(err)
+			assert.Contains(string(final), ",p=")
+		})
+	}
+}
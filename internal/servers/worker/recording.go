@@ -0,0 +1,263 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/recording"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// recordingUploadChunkSize is the amount of recording data sent in each
+	// message when uploading a recording to the controller
+	recordingUploadChunkSize = 64 * 1024
+
+	uploadRecordingTimeout = 5 * time.Minute
+
+	// recordingUploadInterval is how often the recording path is scanned for
+	// recordings which haven't been uploaded yet, such as ones whose upload
+	// failed or which were left behind by a restart
+	recordingUploadInterval = time.Minute
+
+	recordingExt = ".rec"
+
+	// rejectedRecordingExt is appended to the files of recordings the
+	// controller refused, so they are kept but no longer retried
+	rejectedRecordingExt = ".rejected"
+)
+
+// connRecording is the recording of a single connection, written to a file in
+// the worker's recording path
+type connRecording struct {
+	*recording.Writer
+	file *os.File
+}
+
+// startRecording creates the recording of a connection if the session's
+// target requires it. It returns nil if the connection should not be recorded.
+func (w *Worker) startRecording(si *sessionInfo, connectionId string) (*connRecording, error) {
+	si.RLock()
+	resp := si.lookupSessionResponse
+	key := resp.GetRecordingKey()
+	header := &recording.Header{
+		SessionId:    resp.GetAuthorization().GetSessionId(),
+		ConnectionId: connectionId,
+		TargetId:     resp.GetTargetId(),
+		UserId:       resp.GetUserId(),
+		Endpoint:     resp.GetEndpoint(),
+	}
+	si.RUnlock()
+
+	if len(key) == 0 {
+		return nil, nil
+	}
+
+	if w.conf.RawConfig.Worker.RecordingPath == "" {
+		return nil, errors.New("no recording path is configured")
+	}
+	// Recordings are kept in a directory per session so that the upload loop
+	// can tell which session a leftover recording belongs to
+	dir := filepath.Join(w.conf.RawConfig.Worker.RecordingPath, header.SessionId)
+	w.recordingDirLock.Lock()
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		w.recordingDirLock.Unlock()
+		return nil, fmt.Errorf("error creating recording directory: %w", err)
+	}
+	f, err := os.OpenFile(filepath.Join(dir, connectionId+recordingExt), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	w.recordingDirLock.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("error creating recording file: %w", err)
+	}
+	w.activeRecordings.Store(f.Name(), struct{}{})
+	rw, err := recording.NewWriter(f, key, header)
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		w.activeRecordings.Delete(f.Name())
+		return nil, err
+	}
+	return &connRecording{
+		Writer: rw,
+		file:   f,
+	}, nil
+}
+
+// finish completes the recording and closes its file
+func (r *connRecording) finish() error {
+	err := r.Writer.Close()
+	if closeErr := r.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// finishRecording completes the recording of a connection and hands it to the
+// upload loop. The recording is uploaded even if it could not be completed,
+// as what was recorded is still readable.
+func (w *Worker) finishRecording(r *connRecording) error {
+	err := r.finish()
+	w.activeRecordings.Delete(r.file.Name())
+	select {
+	case w.recordingUploadTrigger <- struct{}{}:
+	default:
+	}
+	return err
+}
+
+// startRecordingUploads uploads the finished recordings in the recording path
+// whenever a recording finishes and every recordingUploadInterval, so that
+// recordings whose upload failed or which were left behind by a restart are
+// retried. Uploads happen one at a time.
+func (w *Worker) startRecordingUploads(cancelCtx context.Context) {
+	go func() {
+		timer := time.NewTimer(0)
+		for {
+			select {
+			case <-cancelCtx.Done():
+				w.logger.Info("recording uploads shutting down")
+				return
+
+			case <-w.recordingUploadTrigger:
+
+			case <-timer.C:
+				timer.Reset(recordingUploadInterval)
+			}
+			w.uploadRecordings(cancelCtx)
+		}
+	}()
+}
+
+// uploadRecordings uploads the recordings in the recording path which are not
+// being written, removing each one the controller has stored. Recordings the
+// controller refuses are renamed so they are no longer retried.
+func (w *Worker) uploadRecordings(cancelCtx context.Context) {
+	root := w.conf.RawConfig.Worker.RecordingPath
+	if root == "" {
+		return
+	}
+	sessionDirs, err := ioutil.ReadDir(root)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			w.logger.Error("error reading recording path", "error", err, "path", root)
+		}
+		return
+	}
+	for _, sessionDir := range sessionDirs {
+		if !sessionDir.IsDir() {
+			continue
+		}
+		sessionId := sessionDir.Name()
+		dir := filepath.Join(root, sessionId)
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			w.logger.Error("error reading session recording directory", "error", err, "path", dir)
+			continue
+		}
+		remaining := len(files)
+		for _, f := range files {
+			if cancelCtx.Err() != nil {
+				return
+			}
+			path := filepath.Join(dir, f.Name())
+			if f.IsDir() || !strings.HasSuffix(f.Name(), recordingExt) {
+				continue
+			}
+			if _, ok := w.activeRecordings.Load(path); ok {
+				continue
+			}
+			connectionId := strings.TrimSuffix(f.Name(), recordingExt)
+			err := w.uploadRecording(cancelCtx, sessionId, connectionId, path)
+			switch status.Code(err) {
+			case codes.OK, codes.AlreadyExists:
+				if err := os.Remove(path); err != nil {
+					w.logger.Error("error removing uploaded recording file", "error", err, "path", path)
+					continue
+				}
+				remaining--
+			case codes.InvalidArgument, codes.ResourceExhausted:
+				w.logger.Error("controller refused connection recording, it will not be retried", "error", err, "session_id", sessionId, "connection_id", connectionId, "path", path+rejectedRecordingExt)
+				if err := os.Rename(path, path+rejectedRecordingExt); err != nil {
+					w.logger.Error("error renaming refused recording file", "error", err, "path", path)
+				}
+			default:
+				w.logger.Error("error uploading connection recording, it will be retried", "error", err, "session_id", sessionId, "connection_id", connectionId, "path", path)
+			}
+		}
+		if remaining == 0 {
+			// Removing fails if a recording was started in the directory
+			// since it was read, which is fine
+			w.recordingDirLock.Lock()
+			os.Remove(dir)
+			w.recordingDirLock.Unlock()
+		}
+	}
+}
+
+// uploadRecording sends a finished recording to the controller. Errors
+// returned by the controller are returned as is so that the caller can tell
+// whether the upload should be retried.
+func (w *Worker) uploadRecording(cancelCtx context.Context, sessionId, connectionId, path string) error {
+	rawConn := w.controllerSessionConn.Load()
+	if rawConn == nil {
+		return errors.New("could not get a controller client")
+	}
+	conn, ok := rawConn.(pbs.SessionServiceClient)
+	if !ok {
+		return errors.New("could not cast atomic controller client to the real thing")
+	}
+	if conn == nil {
+		return errors.New("controller client is nil")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("error opening recording file: %w", err)
+	}
+	defer f.Close()
+
+	ctx, cancel := context.WithTimeout(cancelCtx, uploadRecordingTimeout)
+	defer cancel()
+	stream, err := conn.UploadRecording(ctx)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, recordingUploadChunkSize)
+	first := true
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			req := &pbs.UploadRecordingRequest{Data: buf[:n]}
+			if first {
+				req.SessionId = sessionId
+				req.ConnectionId = connectionId
+				first = false
+			}
+			if err := stream.Send(req); err != nil {
+				// The controller's error is only available from the
+				// stream's status, which CloseAndRecv returns
+				if err == io.EOF {
+					_, err = stream.CloseAndRecv()
+				}
+				return err
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error reading recording file: %w", err)
+		}
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
@@ -0,0 +1,122 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testSessionClient is a controller session service which stores uploaded
+// recordings, or fails the uploads of connections in errs
+type testSessionClient struct {
+	pbs.SessionServiceClient
+	errs map[string]error
+
+	mu       sync.Mutex
+	uploaded map[string][]byte
+}
+
+func (c *testSessionClient) UploadRecording(context.Context, ...grpc.CallOption) (pbs.SessionService_UploadRecordingClient, error) {
+	return &testUploadRecordingClient{client: c}, nil
+}
+
+type testUploadRecordingClient struct {
+	grpc.ClientStream
+	client       *testSessionClient
+	connectionId string
+	data         bytes.Buffer
+}
+
+func (u *testUploadRecordingClient) Send(req *pbs.UploadRecordingRequest) error {
+	if req.GetConnectionId() != "" {
+		u.connectionId = req.GetConnectionId()
+	}
+	u.data.Write(req.GetData())
+	return nil
+}
+
+func (u *testUploadRecordingClient) CloseAndRecv() (*pbs.UploadRecordingResponse, error) {
+	if err := u.client.errs[u.connectionId]; err != nil {
+		return nil, err
+	}
+	u.client.mu.Lock()
+	defer u.client.mu.Unlock()
+	u.client.uploaded[u.connectionId] = u.data.Bytes()
+	return &pbs.UploadRecordingResponse{}, nil
+}
+
+func TestUploadRecordings(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	dir, err := ioutil.TempDir("", "boundary-recordings-test")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	client := &testSessionClient{
+		errs: map[string]error{
+			"sc_exists":  status.Error(codes.AlreadyExists, "exists"),
+			"sc_refused": status.Error(codes.InvalidArgument, "refused"),
+			"sc_unavail": status.Error(codes.Unavailable, "unavailable"),
+		},
+		uploaded: map[string][]byte{},
+	}
+	sessionConn := new(atomic.Value)
+	sessionConn.Store(pbs.SessionServiceClient(client))
+	w := &Worker{
+		conf:                  &Config{RawConfig: &config.Config{Worker: &config.Worker{RecordingPath: dir}}},
+		logger:                hclog.NewNullLogger(),
+		controllerSessionConn: sessionConn,
+		activeRecordings:      new(sync.Map),
+		recordingDirLock:      new(sync.Mutex),
+	}
+
+	writeRecording := func(sessionId, connectionId string) string {
+		require.NoError(os.MkdirAll(filepath.Join(dir, sessionId), 0o700))
+		path := filepath.Join(dir, sessionId, connectionId+recordingExt)
+		require.NoError(ioutil.WriteFile(path, []byte(connectionId), 0o600))
+		return path
+	}
+	finished := writeRecording("s_1", "sc_finished")
+	exists := writeRecording("s_1", "sc_exists")
+	refused := writeRecording("s_2", "sc_refused")
+	unavail := writeRecording("s_2", "sc_unavail")
+	active := writeRecording("s_3", "sc_active")
+	w.activeRecordings.Store(active, struct{}{})
+
+	w.uploadRecordings(context.Background())
+
+	assert.Equal(map[string][]byte{"sc_finished": []byte("sc_finished")}, client.uploaded)
+	assert.NoFileExists(finished)
+	assert.NoFileExists(exists)
+	assert.NoDirExists(filepath.Join(dir, "s_1"))
+	assert.NoFileExists(refused)
+	assert.FileExists(refused + rejectedRecordingExt)
+	assert.FileExists(unavail)
+	assert.FileExists(active)
+
+	// Once the controller is available again the recordings left behind are
+	// uploaded, but the refused one is not retried
+	delete(client.errs, "sc_unavail")
+	w.activeRecordings.Delete(active)
+	w.uploadRecordings(context.Background())
+
+	assert.Len(client.uploaded, 3)
+	assert.Equal([]byte("sc_active"), client.uploaded["sc_active"])
+	assert.NoFileExists(unavail)
+	assert.NoFileExists(active)
+	assert.NoDirExists(filepath.Join(dir, "s_3"))
+	assert.FileExists(refused + rejectedRecordingExt)
+}
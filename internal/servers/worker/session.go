@@ -9,10 +9,12 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/session"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -20,11 +22,28 @@ const (
 )
 
 type connInfo struct {
-	id         string
-	connCtx    context.Context
-	connCancel context.CancelFunc
-	status     pbs.CONNECTIONSTATUS
-	closeTime  time.Time
+	// bytesUp, bytesDown and lastActivity are accessed atomically and are kept
+	// first in the struct to guarantee 64-bit alignment
+	bytesUp      uint64
+	bytesDown    uint64
+	lastActivity int64
+	id           string
+	connCtx      context.Context
+	connCancel   context.CancelFunc
+	status       pbs.CONNECTIONSTATUS
+	closeTime    time.Time
+	closeReason  session.ClosedReason
+
+	// reportedBytesUp and reportedBytesDown are the byte counts last added to
+	// the proxy bytes metric, only accessed by the status loop
+	reportedBytesUp   uint64
+	reportedBytesDown uint64
+}
+
+// bytes returns the number of bytes proxied so far from the client to the
+// endpoint (up) and from the endpoint to the client (down)
+func (ci *connInfo) bytes() (up, down uint64) {
+	return atomic.LoadUint64(&ci.bytesUp), atomic.LoadUint64(&ci.bytesDown)
 }
 
 type sessionInfo struct {
@@ -34,6 +53,28 @@ type sessionInfo struct {
 	status                pbs.SESSIONSTATUS
 	lookupSessionResponse *pbs.LookupSessionResponse
 	connInfoMap           map[string]*connInfo
+
+	// limitUp and limitDown are shared by all the connections of the session
+	// so the bandwidth limit of the target applies to the session as a whole
+	limitUp   *rate.Limiter
+	limitDown *rate.Limiter
+}
+
+// limiters returns the rate limiters for the data proxied from the client to
+// the endpoint (up) and from the endpoint to the client (down), creating them
+// on first use. Both are nil if the bandwidth of the session is not limited.
+func (si *sessionInfo) limiters() (up, down *rate.Limiter) {
+	si.Lock()
+	defer si.Unlock()
+	bytesPerSecond := si.lookupSessionResponse.GetMaxBytesPerSecond()
+	if bytesPerSecond == 0 {
+		return nil, nil
+	}
+	if si.limitUp == nil {
+		si.limitUp = rate.NewLimiter(rate.Limit(bytesPerSecond), int(bytesPerSecond))
+		si.limitDown = rate.NewLimiter(rate.Limit(bytesPerSecond), int(bytesPerSecond))
+	}
+	return si.limitUp, si.limitDown
 }
 
 func (w *Worker) getSessionTls(hello *tls.ClientHelloInfo) (*tls.Config, error) {
@@ -231,10 +272,25 @@ func (w *Worker) closeConnections(ctx context.Context, closeMap map[string]strin
 	w.logger.Trace("marking connections as closed", "session_and_connection_ids", fmt.Sprintf("%#v", closeMap))
 
 	closeData := make([]*pbs.CloseConnectionRequestData, 0, len(closeMap))
-	for connId := range closeMap {
+	for connId, sessionId := range closeMap {
+		var bytesUp, bytesDown uint64
+		reason := session.UnknownReason
+		if siRaw, ok := w.sessionInfoMap.Load(sessionId); ok {
+			si := siRaw.(*sessionInfo)
+			si.RLock()
+			if ci, ok := si.connInfoMap[connId]; ok {
+				bytesUp, bytesDown = ci.bytes()
+				if ci.closeReason != "" {
+					reason = ci.closeReason
+				}
+			}
+			si.RUnlock()
+		}
 		closeData = append(closeData, &pbs.CloseConnectionRequestData{
 			ConnectionId: connId,
-			Reason:       session.UnknownReason.String(),
+			BytesUp:      bytesUp,
+			BytesDown:    bytesDown,
+			Reason:       reason.String(),
 		})
 	}
 	closeInfo := &pbs.CloseConnectionRequest{
@@ -45,6 +45,8 @@ func (w *Worker) startStatusTicking(cancelCtx context.Context) {
 				return
 
 			case <-timer.C:
+				w.emitSessionMetrics()
+
 				// First send info as-is. We'll perform cleanup duties after we
 				// get cancel/job change info back.
 				var activeJobs []*pbs.JobStatus
@@ -56,9 +58,12 @@ func (w *Worker) startStatusTicking(cancelCtx context.Context) {
 					status := si.status
 					connections := make([]*pbs.Connection, 0, len(si.connInfoMap))
 					for k, v := range si.connInfoMap {
+						bytesUp, bytesDown := v.bytes()
 						connections = append(connections, &pbs.Connection{
 							ConnectionId: k,
 							Status:       v.status,
+							BytesUp:      bytesUp,
+							BytesDown:    bytesDown,
 						})
 					}
 					si.RUnlock()
@@ -78,6 +83,7 @@ func (w *Worker) startStatusTicking(cancelCtx context.Context) {
 					return true
 				})
 				client := w.controllerStatusConn.Load().(pbs.ServerCoordinationServiceClient)
+				statusStart := time.Now()
 				result, err := client.Status(cancelCtx, &pbs.StatusRequest{
 					Jobs: activeJobs,
 					Worker: &servers.Server{
@@ -86,8 +92,12 @@ func (w *Worker) startStatusTicking(cancelCtx context.Context) {
 						Type:        resource.Worker.String(),
 						Description: w.conf.RawConfig.Worker.Description,
 						Address:     w.conf.RawConfig.Worker.PublicAddr,
+						Tags:        w.tags(),
+						Upstream:    w.upstream(),
+						Draining:    w.Draining(),
 					},
 				})
+				measureStatusRequest(statusStart, err)
 				if err != nil {
 					w.logger.Error("error making status request to controller", "error", err)
 				} else {
@@ -185,3 +195,16 @@ func (w *Worker) startStatusTicking(cancelCtx context.Context) {
 func (w *Worker) LastStatusSuccess() *LastStatusInformation {
 	return w.lastStatusSuccess.Load().(*LastStatusInformation)
 }
+
+// tags converts the configured tags of the worker into the form sent to the
+// controller
+func (w *Worker) tags() map[string]*servers.TagValues {
+	if len(w.conf.RawConfig.Worker.Tags) == 0 {
+		return nil
+	}
+	tags := make(map[string]*servers.TagValues, len(w.conf.RawConfig.Worker.Tags))
+	for k, v := range w.conf.RawConfig.Worker.Tags {
+		tags[k] = &servers.TagValues{Values: v}
+	}
+	return tags
+}
@@ -6,15 +6,25 @@ import (
 	"net"
 	"net/url"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"golang.org/x/time/rate"
 	"nhooyr.io/websocket"
 
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/proxy"
+	"github.com/hashicorp/boundary/internal/recording"
+	"github.com/hashicorp/boundary/internal/session"
 )
 
 func (w *Worker) handleTcpProxyV1(connCtx context.Context, clientAddr *net.TCPAddr, conn *websocket.Conn, si *sessionInfo, connectionId, endpoint string) {
 	si.RLock()
 	sessionId := si.lookupSessionResponse.GetAuthorization().GetSessionId()
+	credentialInjection := si.lookupSessionResponse.GetCredentialInjection()
+	injectedCredential := si.lookupSessionResponse.GetInjectedCredential()
+	eligibleWorkers := si.lookupSessionResponse.GetEligibleWorkers()
+	idleTimeout := time.Duration(si.lookupSessionResponse.GetIdleTimeoutSeconds()) * time.Second
 	si.RUnlock()
 
 	sessionUrl, err := url.Parse(endpoint)
@@ -28,22 +38,29 @@ func (w *Worker) handleTcpProxyV1(connCtx context.Context, clientAddr *net.TCPAd
 		conn.Close(websocket.StatusInternalError, "invalid scheme for type")
 		return
 	}
-	remoteConn, err := net.Dial("tcp", sessionUrl.Host)
+	// The endpoint is dialed by this worker or, if the target only allows
+	// workers in its network, forwarded to a downstream worker there
+	remoteConn, dialed, err := w.dialEndpoint(connCtx, &proxy.DownstreamDialRequest{
+		SessionId:       sessionId,
+		ConnectionId:    connectionId,
+		Endpoint:        sessionUrl.Host,
+		EligibleWorkers: eligibleWorkers,
+	})
 	if err != nil {
 		w.logger.Error("error dialing endpoint", "error", err, "endpoint", endpoint)
 		conn.Close(websocket.StatusInternalError, "endpoint dialing failed")
 		return
 	}
-	// Assert this for better Go 1.11 splice support
-	tcpRemoteConn := remoteConn.(*net.TCPConn)
+	if dialed.GetWorkerName() != w.conf.RawConfig.Worker.Name {
+		w.logger.Debug("endpoint dialed by downstream worker", "session_id", sessionId, "connection_id", connectionId, "worker", dialed.GetWorkerName())
+	}
 
-	endpointAddr := tcpRemoteConn.RemoteAddr().(*net.TCPAddr)
 	connectionInfo := &pbs.ConnectConnectionRequest{
 		ConnectionId:       connectionId,
 		ClientTcpAddress:   clientAddr.IP.String(),
 		ClientTcpPort:      uint32(clientAddr.Port),
-		EndpointTcpAddress: endpointAddr.IP.String(),
-		EndpointTcpPort:    uint32(endpointAddr.Port),
+		EndpointTcpAddress: dialed.GetEndpointTcpAddress(),
+		EndpointTcpPort:    dialed.GetEndpointTcpPort(),
 		Type:               "tcp",
 	}
 
@@ -54,24 +71,166 @@ func (w *Worker) handleTcpProxyV1(connCtx context.Context, clientAddr *net.TCPAd
 		return
 	}
 	si.Lock()
-	si.connInfoMap[connectionId].status = connStatus
+	ci := si.connInfoMap[connectionId]
+	ci.status = connStatus
 	si.Unlock()
 
 	// Get a wrapped net.Conn so we can use io.Copy
 	netConn := websocket.NetConn(connCtx, conn, websocket.MessageBinary)
 
+	// If the target injects its credential, authenticate to the endpoint
+	// before proxying. The handshake is not recorded as it is derived from
+	// the credential.
+	switch credentialInjection {
+	case "":
+	case "postgres":
+		// The endpoint connection may be replaced by a TLS connection
+		injectedConn, err := injectPostgresCredential(netConn, remoteConn, sessionUrl.Hostname(), injectedCredential)
+		if err != nil {
+			w.logger.Error("error injecting credential", "error", err, "session_id", sessionId, "connection_id", connectionId)
+			conn.Close(websocket.StatusInternalError, "credential injection failed")
+			remoteConn.Close()
+			return
+		}
+		remoteConn = injectedConn
+	default:
+		w.logger.Error("unknown credential injection protocol", "protocol", credentialInjection, "session_id", sessionId)
+		conn.Close(websocket.StatusInternalError, "unknown credential injection protocol")
+		remoteConn.Close()
+		return
+	}
+
+	// If the target requires it, start recording before any data flows. If
+	// the recording can't be created we refuse to proxy rather than let an
+	// unrecorded connection through.
+	rec, err := w.startRecording(si, connectionId)
+	if err != nil {
+		w.logger.Error("error starting connection recording", "error", err, "session_id", sessionId, "connection_id", connectionId)
+		conn.Close(websocket.StatusInternalError, "unable to record connection")
+		return
+	}
+
+	var toClient, toEndpoint io.Writer = netConn, remoteConn
+	if rec != nil {
+		// Recording first ensures nothing is proxied without being recorded
+		toClient = io.MultiWriter(rec.Tee(recording.DirectionDown), netConn)
+		toEndpoint = io.MultiWriter(rec.Tee(recording.DirectionUp), remoteConn)
+	}
+
+	// The bandwidth limit of the target is shared by all the connections of
+	// the session, each direction being limited separately
+	if limitUp, limitDown := si.limiters(); limitUp != nil {
+		toClient = &rateLimitedWriter{Writer: toClient, ctx: connCtx, limiter: limitDown}
+		toEndpoint = &rateLimitedWriter{Writer: toEndpoint, ctx: connCtx, limiter: limitUp}
+	}
+
+	atomic.StoreInt64(&ci.lastActivity, time.Now().UnixNano())
+	proxyDone := make(chan struct{})
+	if idleTimeout > 0 {
+		go w.closeIdleConnection(si, ci, conn, remoteConn, idleTimeout, proxyDone)
+	}
+
 	connWg := new(sync.WaitGroup)
 	connWg.Add(2)
 	go func() {
 		defer connWg.Done()
-		_, err := io.Copy(netConn, tcpRemoteConn)
-		w.logger.Debug("copy from client to endpoint done", "error", err)
+		_, err := io.Copy(&countingWriter{Writer: toClient, count: &ci.bytesDown, lastActivity: &ci.lastActivity}, remoteConn)
+		w.logger.Debug("copy from endpoint to client done", "error", err)
 	}()
 	go func() {
 		defer connWg.Done()
-		_, err := io.Copy(tcpRemoteConn, netConn)
-		w.logger.Debug("copy from endpoint to client done", "error", err)
+		_, err := io.Copy(&countingWriter{Writer: toEndpoint, count: &ci.bytesUp, lastActivity: &ci.lastActivity}, netConn)
+		w.logger.Debug("copy from client to endpoint done", "error", err)
 	}()
 	connWg.Wait()
+	close(proxyDone)
 
+	if rec != nil {
+		if err := w.finishRecording(rec); err != nil {
+			w.logger.Error("error finishing connection recording", "error", err, "session_id", sessionId, "connection_id", connectionId)
+		}
+	}
+
+	bytesUp, bytesDown := ci.bytes()
+	w.logger.Debug("connection proxying done", "session_id", sessionId, "connection_id", connectionId, "bytes_up", bytesUp, "bytes_down", bytesDown)
+}
+
+// closeIdleConnection closes both sides of a proxied connection once no data
+// has been proxied in either direction for idleTimeout, recording the idle
+// timeout as the reason the connection was closed. It returns when done is
+// closed.
+func (w *Worker) closeIdleConnection(si *sessionInfo, ci *connInfo, conn *websocket.Conn, remoteConn net.Conn, idleTimeout time.Duration, done <-chan struct{}) {
+	timer := time.NewTimer(idleTimeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-timer.C:
+		}
+		idle := time.Since(time.Unix(0, atomic.LoadInt64(&ci.lastActivity)))
+		if idle < idleTimeout {
+			timer.Reset(idleTimeout - idle)
+			continue
+		}
+		w.logger.Debug("closing idle connection", "session_id", si.id, "connection_id", ci.id, "idle_timeout", idleTimeout)
+		si.Lock()
+		ci.closeReason = session.ConnectionIdleTimeout
+		si.Unlock()
+		conn.Close(websocket.StatusGoingAway, "connection idle timeout")
+		remoteConn.Close()
+		return
+	}
+}
+
+// countingWriter wraps an io.Writer and atomically adds the number of bytes
+// written to count, so that the total can be read while a copy is still in
+// progress. If lastActivity is set it is updated with the time of each write
+// that proxied data.
+type countingWriter struct {
+	io.Writer
+	count        *uint64
+	lastActivity *int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.Writer.Write(p)
+	atomic.AddUint64(c.count, uint64(n))
+	if n > 0 && c.lastActivity != nil {
+		atomic.StoreInt64(c.lastActivity, time.Now().UnixNano())
+	}
+	return n, err
+}
+
+// rateLimitedWriter wraps an io.Writer and waits for the limiter before each
+// write. Writes larger than the burst of the limiter wait for it in
+// burst-sized chunks but are passed on whole, so that a datagram is never
+// split.
+type rateLimitedWriter struct {
+	io.Writer
+	ctx     context.Context
+	limiter *rate.Limiter
+}
+
+func (r *rateLimitedWriter) Write(p []byte) (int, error) {
+	if err := waitLimiter(r.ctx, r.limiter, len(p)); err != nil {
+		return 0, err
+	}
+	return r.Writer.Write(p)
+}
+
+// waitLimiter waits until the limiter allows n bytes, waiting for it in
+// burst-sized chunks when n is larger than its burst.
+func waitLimiter(ctx context.Context, limiter *rate.Limiter, n int) error {
+	for n > 0 {
+		chunk := n
+		if burst := limiter.Burst(); chunk > burst {
+			chunk = burst
+		}
+		if err := limiter.WaitN(ctx, chunk); err != nil {
+			return err
+		}
+		n -= chunk
+	}
+	return nil
 }
@@ -0,0 +1,152 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+	"nhooyr.io/websocket"
+)
+
+// writeRecorder records the size of the writes made to it
+type writeRecorder struct {
+	writes []int
+}
+
+func (r *writeRecorder) Write(p []byte) (int, error) {
+	r.writes = append(r.writes, len(p))
+	return len(p), nil
+}
+
+func TestWaitLimiter(t *testing.T) {
+	t.Run("larger-than-burst", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		// 1000 bytes a second with a burst of 100, so that writing 350 bytes
+		// takes the burst and 250ms
+		limiter := rate.NewLimiter(1000, 100)
+		require.Error(limiter.WaitN(context.Background(), 350), "a single wait can't exceed the burst")
+
+		rec := new(writeRecorder)
+		w := &rateLimitedWriter{Writer: rec, ctx: context.Background(), limiter: limiter}
+		start := time.Now()
+		n, err := w.Write(make([]byte, 350))
+		require.NoError(err)
+		assert.Equal(350, n)
+		assert.True(time.Since(start) >= 200*time.Millisecond, "the write did not wait for the limiter")
+		// The write is passed on whole
+		assert.Equal([]int{350}, rec.writes)
+
+		assert.NoError(waitLimiter(context.Background(), limiter, 0))
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		assert := assert.New(t)
+		// A byte a second, so that the wait for the second chunk outlasts
+		// the test
+		limiter := rate.NewLimiter(1, 10)
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(50*time.Millisecond, cancel)
+
+		rec := new(writeRecorder)
+		w := &rateLimitedWriter{Writer: rec, ctx: ctx, limiter: limiter}
+		start := time.Now()
+		n, err := w.Write(make([]byte, 20))
+		assert.True(errors.Is(err, context.Canceled))
+		assert.Zero(n)
+		assert.Empty(rec.writes)
+		assert.True(time.Since(start) < 5*time.Second, "the wait was not canceled")
+
+		// A context already done is not waited on
+		assert.Error(waitLimiter(ctx, rate.NewLimiter(1, 10), 20))
+	})
+}
+
+func TestCloseIdleConnection(t *testing.T) {
+	// newConns returns a websocket connection to a server which sends the
+	// status it is closed with to closed, and the end of a pipe standing for
+	// the connection to the endpoint with its other end
+	newConns := func(t *testing.T) (*websocket.Conn, <-chan websocket.StatusCode, net.Conn, net.Conn) {
+		closed := make(chan websocket.StatusCode, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			c, err := websocket.Accept(w, r, nil)
+			if err != nil {
+				return
+			}
+			_, _, err = c.Read(r.Context())
+			closed <- websocket.CloseStatus(err)
+		}))
+		t.Cleanup(srv.Close)
+		conn, _, err := websocket.Dial(context.Background(), "ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close(websocket.StatusNormalClosure, "") })
+		remoteConn, endpoint := net.Pipe()
+		t.Cleanup(func() { endpoint.Close() })
+		return conn, closed, remoteConn, endpoint
+	}
+	w := &Worker{logger: hclog.NewNullLogger()}
+
+	t.Run("idle", func(t *testing.T) {
+		assert := assert.New(t)
+		conn, closed, remoteConn, endpoint := newConns(t)
+		si := &sessionInfo{id: "s_1234567890"}
+		ci := &connInfo{id: "sc_1234567890", lastActivity: time.Now().UnixNano()}
+
+		const idleTimeout = 400 * time.Millisecond
+		returned := make(chan struct{})
+		go func() {
+			w.closeIdleConnection(si, ci, conn, remoteConn, idleTimeout, make(chan struct{}))
+			close(returned)
+		}()
+
+		// Activity halfway through postpones the close
+		time.Sleep(idleTimeout / 2)
+		atomic.StoreInt64(&ci.lastActivity, time.Now().UnixNano())
+		time.Sleep(idleTimeout/2 + idleTimeout/4)
+		select {
+		case <-returned:
+			assert.Fail("the connection was closed while it was not idle")
+		default:
+		}
+
+		select {
+		case <-returned:
+		case <-time.After(5 * time.Second):
+			assert.Fail("the idle connection was not closed")
+		}
+		si.RLock()
+		assert.Equal(session.ConnectionIdleTimeout, ci.closeReason)
+		si.RUnlock()
+		assert.Equal(websocket.StatusGoingAway, <-closed)
+		_, err := ioutil.ReadAll(endpoint)
+		assert.NoError(err, "the connection to the endpoint was not closed")
+	})
+
+	t.Run("done", func(t *testing.T) {
+		assert := assert.New(t)
+		conn, closed, remoteConn, _ := newConns(t)
+		si := &sessionInfo{id: "s_1234567890"}
+		ci := &connInfo{id: "sc_1234567890", lastActivity: time.Now().UnixNano()}
+
+		done := make(chan struct{})
+		close(done)
+		w.closeIdleConnection(si, ci, conn, remoteConn, time.Millisecond, done)
+		assert.Empty(ci.closeReason)
+		select {
+		case <-closed:
+			assert.Fail("the connection was closed once the proxy was done")
+		default:
+		}
+	})
+}
@@ -104,6 +104,10 @@ type TestWorkerOpts struct {
 	// Sets initial controller addresses
 	InitialControllers []string
 
+	// Sets the proxy addresses of upstream workers, making this a downstream
+	// worker
+	Upstreams []string
+
 	// If true, the worker will not be started
 	DisableAutoStart bool
 
@@ -150,6 +154,9 @@ func NewTestWorker(t *testing.T, opts *TestWorkerOpts) *TestWorker {
 	if len(opts.InitialControllers) > 0 {
 		opts.Config.Worker.Controllers = opts.InitialControllers
 	}
+	if len(opts.Upstreams) > 0 {
+		opts.Config.Worker.Upstreams = opts.Upstreams
+	}
 
 	// Start a logger
 	tw.b.Logger = opts.Logger
@@ -0,0 +1,200 @@
+package worker
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/proxy"
+	"github.com/hashicorp/yamux"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxTunnelHops bounds the number of tunnels a dial request may cross, which
+// protects against loops between misconfigured workers
+const maxTunnelHops = 8
+
+// maxTunnelMessageSize bounds the messages exchanged on a tunnel before a
+// stream starts carrying a proxied connection
+const maxTunnelMessageSize = 64 * 1024
+
+// writeTunnelMessage writes m to w prefixed with its length
+func writeTunnelMessage(w io.Writer, m proto.Message) error {
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+	msg := make([]byte, 4, 4+len(b))
+	binary.BigEndian.PutUint32(msg, uint32(len(b)))
+	_, err = w.Write(append(msg, b...))
+	return err
+}
+
+// readTunnelMessage reads a message written by writeTunnelMessage into m
+func readTunnelMessage(r io.Reader, m proto.Message) error {
+	var l [4]byte
+	if _, err := io.ReadFull(r, l[:]); err != nil {
+		return err
+	}
+	n := binary.BigEndian.Uint32(l[:])
+	if n > maxTunnelMessageSize {
+		return fmt.Errorf("tunnel message of %d bytes is too large", n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return err
+	}
+	return proto.Unmarshal(b, m)
+}
+
+// eligible reports whether this worker may dial the endpoint of req
+func (w *Worker) eligible(req *proxy.DownstreamDialRequest) bool {
+	if len(req.GetEligibleWorkers()) == 0 {
+		return true
+	}
+	for _, n := range req.GetEligibleWorkers() {
+		if n == w.conf.RawConfig.Worker.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// dialEndpoint returns a connection to the endpoint of req. If this worker is
+// eligible it dials the endpoint itself. Otherwise the request is forwarded
+// over the tunnels of the downstream workers, trying the eligible ones first
+// as the others can only reach an eligible worker through their own
+// downstream workers.
+func (w *Worker) dialEndpoint(ctx context.Context, req *proxy.DownstreamDialRequest) (net.Conn, *proxy.DownstreamDialResponse, error) {
+	if w.eligible(req) {
+		conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", req.GetEndpoint())
+		if err != nil {
+			return nil, nil, err
+		}
+		addr := conn.RemoteAddr().(*net.TCPAddr)
+		return conn, &proxy.DownstreamDialResponse{
+			EndpointTcpAddress: addr.IP.String(),
+			EndpointTcpPort:    uint32(addr.Port),
+			WorkerName:         w.conf.RawConfig.Worker.Name,
+		}, nil
+	}
+	if req.GetHops() >= maxTunnelHops {
+		return nil, nil, fmt.Errorf("dial request crossed more than %d tunnels", maxTunnelHops)
+	}
+
+	eligible := make(map[string]bool, len(req.GetEligibleWorkers()))
+	for _, n := range req.GetEligibleWorkers() {
+		eligible[n] = true
+	}
+	var first, rest []*yamux.Session
+	w.downstreams.Range(func(k, v interface{}) bool {
+		if eligible[k.(string)] {
+			first = append(first, v.(*yamux.Session))
+		} else {
+			rest = append(rest, v.(*yamux.Session))
+		}
+		return true
+	})
+	if len(first)+len(rest) == 0 {
+		return nil, nil, errors.New("not an eligible worker and no downstream workers are connected")
+	}
+
+	fwd := proto.Clone(req).(*proxy.DownstreamDialRequest)
+	fwd.Hops++
+	var lastErr error
+	for _, s := range append(first, rest...) {
+		stream, resp, err := forwardDial(s, fwd)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		return stream, resp, nil
+	}
+	return nil, nil, fmt.Errorf("no downstream worker could dial the endpoint: %w", lastErr)
+}
+
+// forwardDial sends req on a new stream of the tunnel s. The stream is
+// returned once the worker on the other end reports it dialed the endpoint.
+func forwardDial(s *yamux.Session, req *proxy.DownstreamDialRequest) (net.Conn, *proxy.DownstreamDialResponse, error) {
+	stream, err := s.Open()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error opening tunnel stream: %w", err)
+	}
+	if err := writeTunnelMessage(stream, req); err != nil {
+		stream.Close()
+		return nil, nil, fmt.Errorf("error sending dial request: %w", err)
+	}
+	resp := new(proxy.DownstreamDialResponse)
+	if err := readTunnelMessage(stream, resp); err != nil {
+		stream.Close()
+		return nil, nil, fmt.Errorf("error reading dial response: %w", err)
+	}
+	if resp.GetError() != "" {
+		stream.Close()
+		return nil, nil, errors.New(resp.GetError())
+	}
+	return stream, resp, nil
+}
+
+// handleDialRequest serves a dial request an upstream worker sent on stream,
+// then proxies between the stream and the endpoint
+func (w *Worker) handleDialRequest(ctx context.Context, stream net.Conn) {
+	defer stream.Close()
+
+	req := new(proxy.DownstreamDialRequest)
+	if err := readTunnelMessage(stream, req); err != nil {
+		w.logger.Error("error reading dial request from upstream worker", "error", err)
+		return
+	}
+	if w.Draining() {
+		if err := writeTunnelMessage(stream, &proxy.DownstreamDialResponse{Error: "worker is draining"}); err != nil {
+			w.logger.Error("error sending dial response to upstream worker", "error", err)
+		}
+		return
+	}
+	conn, resp, err := w.dialEndpoint(ctx, req)
+	if err != nil {
+		w.logger.Error("error dialing endpoint for upstream worker", "error", err, "session_id", req.GetSessionId(), "connection_id", req.GetConnectionId())
+		if err := writeTunnelMessage(stream, &proxy.DownstreamDialResponse{Error: err.Error()}); err != nil {
+			w.logger.Error("error sending dial response to upstream worker", "error", err)
+		}
+		return
+	}
+	defer conn.Close()
+	if err := writeTunnelMessage(stream, resp); err != nil {
+		w.logger.Error("error sending dial response to upstream worker", "error", err)
+		return
+	}
+
+	w.tunneledConns.Inc()
+	defer w.tunneledConns.Dec()
+	w.logger.Debug("proxying connection for upstream worker", "session_id", req.GetSessionId(), "connection_id", req.GetConnectionId(), "worker", resp.GetWorkerName())
+	pipeConns(stream, conn)
+}
+
+// pipeConns copies between a and b until either side is done, then closes
+// both so the other copy ends as well
+func pipeConns(a, b net.Conn) {
+	var once sync.Once
+	closeBoth := func() {
+		a.Close()
+		b.Close()
+	}
+	wg := new(sync.WaitGroup)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		io.Copy(a, b)
+		once.Do(closeBoth)
+	}()
+	go func() {
+		defer wg.Done()
+		io.Copy(b, a)
+		once.Do(closeBoth)
+	}()
+	wg.Wait()
+}
@@ -0,0 +1,156 @@
+package worker
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"nhooyr.io/websocket"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/proxy"
+)
+
+// defaultUdpFlowIdleTimeout is how long a flow of datagrams is proxied
+// without traffic when the target doesn't set an idle timeout. Unlike TCP
+// there is no end to a flow other than the client going away, so flows are
+// never left open indefinitely.
+const defaultUdpFlowIdleTimeout = 2 * time.Minute
+
+// maxDatagramSize is the size of the largest UDP payload
+const maxDatagramSize = 64 * 1024
+
+func (w *Worker) handleUdpProxyV1(connCtx context.Context, clientAddr *net.TCPAddr, conn *websocket.Conn, si *sessionInfo, connectionId, endpoint string) {
+	si.RLock()
+	sessionId := si.lookupSessionResponse.GetAuthorization().GetSessionId()
+	eligibleWorkers := si.lookupSessionResponse.GetEligibleWorkers()
+	idleTimeout := time.Duration(si.lookupSessionResponse.GetIdleTimeoutSeconds()) * time.Second
+	si.RUnlock()
+	if idleTimeout == 0 {
+		idleTimeout = defaultUdpFlowIdleTimeout
+	}
+
+	sessionUrl, err := url.Parse(endpoint)
+	if err != nil {
+		w.logger.Error("error parsing endpoint information", "error", err, "session_id", sessionId, "endpoint", endpoint)
+		conn.Close(websocket.StatusInternalError, "cannot parse endpoint url")
+		return
+	}
+	if sessionUrl.Scheme != "udp" {
+		w.logger.Error("invalid scheme for udp proxy", "session_id", sessionId, "endpoint", endpoint)
+		conn.Close(websocket.StatusInternalError, "invalid scheme for type")
+		return
+	}
+	// Datagrams are not forwarded over worker tunnels, so only a worker
+	// allowed to reach the endpoint itself can proxy the flow
+	if !w.eligible(&proxy.DownstreamDialRequest{EligibleWorkers: eligibleWorkers}) {
+		w.logger.Error("worker not eligible to proxy udp flow", "session_id", sessionId, "connection_id", connectionId)
+		conn.Close(websocket.StatusInternalError, "udp flows cannot be proxied through downstream workers")
+		return
+	}
+	remoteConn, err := (&net.Dialer{}).DialContext(connCtx, "udp", sessionUrl.Host)
+	if err != nil {
+		w.logger.Error("error dialing endpoint", "error", err, "endpoint", endpoint)
+		conn.Close(websocket.StatusInternalError, "endpoint dialing failed")
+		return
+	}
+	defer remoteConn.Close()
+	endpointAddr := remoteConn.RemoteAddr().(*net.UDPAddr)
+
+	connectionInfo := &pbs.ConnectConnectionRequest{
+		ConnectionId:       connectionId,
+		ClientTcpAddress:   clientAddr.IP.String(),
+		ClientTcpPort:      uint32(clientAddr.Port),
+		EndpointTcpAddress: endpointAddr.IP.String(),
+		EndpointTcpPort:    uint32(endpointAddr.Port),
+		Type:               "udp",
+	}
+
+	connStatus, err := w.connectConnection(connCtx, connectionInfo)
+	if err != nil {
+		w.logger.Error("error marking connection as connected", "error", err)
+		conn.Close(websocket.StatusInternalError, "failed to mark connection as connected")
+		return
+	}
+	si.Lock()
+	ci := si.connInfoMap[connectionId]
+	ci.status = connStatus
+	si.Unlock()
+
+	// A message carries a whole datagram, which may exceed the default limit
+	conn.SetReadLimit(maxDatagramSize)
+
+	var toClient, toEndpoint io.Writer = &datagramWriter{ctx: connCtx, conn: conn}, remoteConn
+	if limitUp, limitDown := si.limiters(); limitUp != nil {
+		toClient = &rateLimitedWriter{Writer: toClient, ctx: connCtx, limiter: limitDown}
+		toEndpoint = &rateLimitedWriter{Writer: toEndpoint, ctx: connCtx, limiter: limitUp}
+	}
+	toClient = &countingWriter{Writer: toClient, count: &ci.bytesDown, lastActivity: &ci.lastActivity}
+	toEndpoint = &countingWriter{Writer: toEndpoint, count: &ci.bytesUp, lastActivity: &ci.lastActivity}
+
+	atomic.StoreInt64(&ci.lastActivity, time.Now().UnixNano())
+	proxyDone := make(chan struct{})
+	go w.closeIdleConnection(si, ci, conn, remoteConn, idleTimeout, proxyDone)
+
+	connWg := new(sync.WaitGroup)
+	connWg.Add(2)
+	go func() {
+		defer connWg.Done()
+		buf := make([]byte, maxDatagramSize)
+		for {
+			n, err := remoteConn.Read(buf)
+			if err != nil {
+				w.logger.Debug("read from endpoint done", "error", err)
+				conn.Close(websocket.StatusNormalClosure, "endpoint closed")
+				return
+			}
+			if _, err := toClient.Write(buf[:n]); err != nil {
+				w.logger.Debug("write to client done", "error", err)
+				remoteConn.Close()
+				return
+			}
+		}
+	}()
+	go func() {
+		defer connWg.Done()
+		for {
+			typ, msg, err := conn.Read(connCtx)
+			if err != nil {
+				w.logger.Debug("read from client done", "error", err)
+				remoteConn.Close()
+				return
+			}
+			if typ != websocket.MessageBinary {
+				continue
+			}
+			if _, err := toEndpoint.Write(msg); err != nil {
+				w.logger.Debug("write to endpoint done", "error", err)
+				conn.Close(websocket.StatusInternalError, "endpoint write failed")
+				return
+			}
+		}
+	}()
+	connWg.Wait()
+	close(proxyDone)
+
+	bytesUp, bytesDown := ci.bytes()
+	w.logger.Debug("udp flow proxying done", "session_id", sessionId, "connection_id", connectionId, "bytes_up", bytesUp, "bytes_down", bytesDown)
+}
+
+// datagramWriter writes each buffer as a single binary websocket message,
+// preserving the boundaries of the datagrams it carries.
+type datagramWriter struct {
+	ctx  context.Context
+	conn *websocket.Conn
+}
+
+func (d *datagramWriter) Write(p []byte) (int, error) {
+	if err := d.conn.Write(d.ctx, websocket.MessageBinary, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,121 @@
+package worker
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/proxy"
+	"github.com/hashicorp/yamux"
+)
+
+// upstreamRetryInterval is how long a downstream worker waits before trying
+// its upstream workers again after none could be reached
+const upstreamRetryInterval = 5 * time.Second
+
+// startUpstreamConnections connects this worker to the first reachable of
+// its configured upstream workers, reconnecting whenever the tunnel is lost
+func (w *Worker) startUpstreamConnections() error {
+	addrs := make([]string, 0, len(w.conf.RawConfig.Worker.Upstreams))
+	for _, addr := range w.conf.RawConfig.Worker.Upstreams {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil && strings.Contains(err.Error(), "missing port in address") {
+			w.logger.Trace("missing port in upstream address, using port 9202", "address", addr)
+			host, port, err = net.SplitHostPort(fmt.Sprintf("%s:%s", addr, "9202"))
+		}
+		if err != nil {
+			return fmt.Errorf("error parsing upstream address: %w", err)
+		}
+		addrs = append(addrs, net.JoinHostPort(host, port))
+	}
+	if len(addrs) == 0 {
+		return nil
+	}
+
+	ctx := w.baseContext
+	go func() {
+		for {
+			for _, addr := range addrs {
+				if err := w.runUpstreamTunnel(ctx, addr); err != nil {
+					w.logger.Error("error connecting to upstream worker", "error", err, "address", addr)
+				}
+				if ctx.Err() != nil {
+					return
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(upstreamRetryInterval):
+			}
+		}
+	}()
+	return nil
+}
+
+// runUpstreamTunnel opens a tunnel to the upstream worker at addr and serves
+// the dial requests it sends until the tunnel is closed
+func (w *Worker) runUpstreamTunnel(ctx context.Context, addr string) error {
+	tlsConf, authInfo, err := w.workerAuthTLSConfig()
+	if err != nil {
+		return fmt.Errorf("error creating tls config for worker auth: %w", err)
+	}
+	// Ask for the tunnel proto so the upstream worker routes the connection
+	// to its tunnel listener rather than its session proxy
+	tlsConf.NextProtos = append([]string{globals.WorkerTunnelV1}, tlsConf.NextProtos...)
+
+	dialer := &net.Dialer{}
+	nonTlsConn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("unable to dial to upstream worker: %w", err)
+	}
+	tlsConn := tls.Client(nonTlsConn, tlsConf)
+	if _, err := tlsConn.Write([]byte(authInfo.ConnectionNonce)); err != nil {
+		tlsConn.Close()
+		return fmt.Errorf("unable to write connection nonce: %w", err)
+	}
+	hello := new(proxy.UpstreamHello)
+	if err := readTunnelMessage(tlsConn, hello); err != nil {
+		tlsConn.Close()
+		return fmt.Errorf("error reading upstream worker hello: %w", err)
+	}
+
+	session, err := yamux.Server(tlsConn, w.tunnelConfig())
+	if err != nil {
+		tlsConn.Close()
+		return fmt.Errorf("error starting tunnel: %w", err)
+	}
+	defer session.Close()
+	go func() {
+		select {
+		case <-ctx.Done():
+			session.Close()
+		case <-session.CloseChan():
+		}
+	}()
+
+	w.upstreamName.Store(hello.GetName())
+	defer w.upstreamName.Store("")
+	w.logger.Info("connected to upstream worker", "name", hello.GetName(), "address", addr)
+
+	for {
+		stream, err := session.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("tunnel to upstream worker %s closed: %w", hello.GetName(), err)
+		}
+		go w.handleDialRequest(ctx, stream)
+	}
+}
+
+// upstream returns the name of the upstream worker this worker is currently
+// connected through, if any
+func (w *Worker) upstream() string {
+	return w.upstreamName.Load().(string)
+}
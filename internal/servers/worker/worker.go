@@ -4,6 +4,8 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sync"
 	"sync/atomic"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/vault/sdk/helper/base62"
 	"github.com/hashicorp/vault/sdk/helper/mlock"
+	"github.com/patrickmn/go-cache"
 	ua "go.uber.org/atomic"
 	"google.golang.org/grpc/resolver"
 	"google.golang.org/grpc/resolver/manual"
@@ -32,6 +35,31 @@ type Worker struct {
 
 	controllerSessionConn *atomic.Value
 	sessionInfoMap        *sync.Map
+
+	// downstreams holds the tunnels of the downstream workers connected to
+	// this worker, keyed by worker name
+	downstreams         *sync.Map
+	downstreamAuthCache *cache.Cache
+
+	// upstreamName is the name of the upstream worker this worker is
+	// connected through, if it is a downstream worker
+	upstreamName *atomic.Value
+
+	// draining is set once the worker stops accepting new connections,
+	// proxiedConns counts the connections of its own clients being proxied
+	// and tunneledConns those proxied for upstream workers
+	draining      ua.Bool
+	proxiedConns  ua.Int64
+	tunneledConns ua.Int64
+
+	// activeRecordings holds the paths of the recordings being written, which
+	// the upload loop skips, and recordingUploadTrigger wakes the upload loop
+	// once a recording finishes. recordingDirLock keeps the upload loop from
+	// removing a session's recording directory while a recording is created
+	// in it.
+	activeRecordings       *sync.Map
+	recordingUploadTrigger chan struct{}
+	recordingDirLock       *sync.Mutex
 }
 
 func New(conf *Config) (*Worker, error) {
@@ -44,12 +72,19 @@ func New(conf *Config) (*Worker, error) {
 		controllerResolverCleanup: new(atomic.Value),
 		controllerSessionConn:     new(atomic.Value),
 		sessionInfoMap:            new(sync.Map),
+		downstreams:               new(sync.Map),
+		downstreamAuthCache:       cache.New(0, 0),
+		upstreamName:              new(atomic.Value),
+		activeRecordings:          new(sync.Map),
+		recordingUploadTrigger:    make(chan struct{}, 1),
+		recordingDirLock:          new(sync.Mutex),
 	}
 
 	w.lastStatusSuccess.Store((*LastStatusInformation)(nil))
 	w.started.Store(false)
 	w.controllerResolver.Store((*manual.Resolver)(nil))
 	w.controllerResolverCleanup.Store(func() {})
+	w.upstreamName.Store("")
 
 	if conf.SecureRandomReader == nil {
 		conf.SecureRandomReader = rand.Reader
@@ -64,6 +99,15 @@ func New(conf *Config) (*Worker, error) {
 			return nil, fmt.Errorf("error auto-generating worker name: %w", err)
 		}
 	}
+	if conf.RawConfig.Worker.RecordingPath == "" {
+		// Recordings must survive a restart so that they can still be
+		// uploaded, so they aren't kept in the temporary directory. If there
+		// is no home directory recording is unavailable until a path is
+		// configured.
+		if home, err := os.UserHomeDir(); err == nil {
+			conf.RawConfig.Worker.RecordingPath = filepath.Join(home, ".boundary", "recordings", conf.RawConfig.Worker.Name)
+		}
+	}
 
 	if !conf.RawConfig.DisableMlock {
 		// Ensure our memory usage is locked into physical RAM
@@ -102,8 +146,12 @@ func (w *Worker) Start() error {
 	if err := w.startControllerConnections(); err != nil {
 		return fmt.Errorf("error making controller connections: %w", err)
 	}
+	if err := w.startUpstreamConnections(); err != nil {
+		return fmt.Errorf("error making upstream worker connections: %w", err)
+	}
 
 	w.startStatusTicking(w.baseContext)
+	w.startRecordingUploads(w.baseContext)
 	w.started.Store(true)
 
 	return nil
@@ -0,0 +1,23 @@
+package session
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// ConnectionBytes defines the number of bytes a worker has proxied in each
+// direction so far over a connection which is still open.
+type ConnectionBytes struct {
+	ConnectionId string
+	BytesUp      uint64
+	BytesDown    uint64
+}
+
+func (c ConnectionBytes) validate() error {
+	if c.ConnectionId == "" {
+		return fmt.Errorf("missing connection id: %w", db.ErrInvalidParameter)
+	}
+	// 0 is valid for BytesUp and BytesDown
+	return nil
+}
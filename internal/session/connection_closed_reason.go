@@ -16,6 +16,8 @@ const (
 	ConnectionCanceled     ClosedReason = "canceled"
 	ConnectionNetworkError ClosedReason = "network error"
 	ConnectionSystemError  ClosedReason = "system error"
+	ConnectionWorkerDead   ClosedReason = "worker dead"
+	ConnectionIdleTimeout  ClosedReason = "idle timeout"
 )
 
 // String representation of the termination reason
@@ -36,6 +38,10 @@ func convertToClosedReason(s string) (ClosedReason, error) {
 		return ConnectionNetworkError, nil
 	case ConnectionSystemError.String():
 		return ConnectionSystemError, nil
+	case ConnectionWorkerDead.String():
+		return ConnectionWorkerDead, nil
+	case ConnectionIdleTimeout.String():
+		return ConnectionIdleTimeout, nil
 	default:
 		return "", fmt.Errorf("closed reason: %s is not a valid reason: %w", s, db.ErrInvalidParameter)
 	}
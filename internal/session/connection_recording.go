@@ -0,0 +1,145 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultConnectionRecordingTableName      = "session_connection_recording"
+	defaultConnectionRecordingChunkTableName = "session_connection_recording_chunk"
+)
+
+// ConnectionRecording records that the encrypted recording of a session's
+// connection has been stored, and its size. The recording is encrypted by the
+// worker with a key derived via DeriveRecordingKey, and its data is stored in
+// chunks read with Repository.OpenConnectionRecording.
+type ConnectionRecording struct {
+	// ConnectionId of the recorded connection
+	ConnectionId string `json:"connection_id,omitempty" gorm:"primary_key"`
+	// SessionId of the recorded connection
+	SessionId string `json:"session_id,omitempty" gorm:"default:null"`
+	// Size of the encrypted recording, in bytes
+	Size int64 `json:"size,omitempty" gorm:"default:null"`
+	// CreateTime from the RDBMS
+	CreateTime *timestamp.Timestamp `json:"create_time,omitempty" gorm:"default:current_timestamp"`
+
+	tableName string `gorm:"-"`
+}
+
+var _ Cloneable = (*ConnectionRecording)(nil)
+var _ db.VetForWriter = (*ConnectionRecording)(nil)
+
+// NewConnectionRecording creates a new in memory connection recording. No
+// options are currently supported.
+func NewConnectionRecording(sessionId, connectionId string, size int64, opt ...Option) (*ConnectionRecording, error) {
+	r := ConnectionRecording{
+		ConnectionId: connectionId,
+		SessionId:    sessionId,
+		Size:         size,
+	}
+	if err := r.validate("new connection recording:"); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// allocConnectionRecording will allocate a ConnectionRecording
+func allocConnectionRecording() ConnectionRecording {
+	return ConnectionRecording{}
+}
+
+// Clone creates a clone of the ConnectionRecording
+func (r *ConnectionRecording) Clone() interface{} {
+	clone := &ConnectionRecording{
+		ConnectionId: r.ConnectionId,
+		SessionId:    r.SessionId,
+		Size:         r.Size,
+	}
+	if r.CreateTime != nil {
+		clone.CreateTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: r.CreateTime.Timestamp.Seconds,
+				Nanos:   r.CreateTime.Timestamp.Nanos,
+			},
+		}
+	}
+	return clone
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the
+// connection recording before it's written. Recordings are immutable, so only
+// creation is allowed.
+func (r *ConnectionRecording) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, opt ...db.Option) error {
+	switch opType {
+	case db.CreateOp:
+		return r.validate("connection recording vet for write:")
+	default:
+		return fmt.Errorf("connection recording vet for write: recordings are immutable: %w", db.ErrInvalidParameter)
+	}
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (r *ConnectionRecording) TableName() string {
+	if r.tableName != "" {
+		return r.tableName
+	}
+	return defaultConnectionRecordingTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (r *ConnectionRecording) SetTableName(n string) {
+	r.tableName = n
+}
+
+func (r *ConnectionRecording) validate(errorPrefix string) error {
+	if r.ConnectionId == "" {
+		return fmt.Errorf("%s missing connection id: %w", errorPrefix, db.ErrInvalidParameter)
+	}
+	if r.SessionId == "" {
+		return fmt.Errorf("%s missing session id: %w", errorPrefix, db.ErrInvalidParameter)
+	}
+	if r.Size <= 0 {
+		return fmt.Errorf("%s missing recording: %w", errorPrefix, db.ErrInvalidParameter)
+	}
+	return nil
+}
+
+// connectionRecordingChunk is a chunk of the data of a connection recording.
+// The chunks of a recording are numbered from 0 in the order of their data.
+// Sequence isn't tagged as part of the primary key as gorm would leave the
+// zero sequence of the first chunk out of its insert.
+type connectionRecordingChunk struct {
+	ConnectionId string               `gorm:"primary_key"`
+	Sequence     uint32               `gorm:"not null"`
+	Data         []byte               `gorm:"default:null"`
+	CreateTime   *timestamp.Timestamp `gorm:"default:current_timestamp"`
+}
+
+var _ db.VetForWriter = (*connectionRecordingChunk)(nil)
+
+// VetForWrite implements db.VetForWrite() interface. Chunks are immutable, so
+// only creation is allowed.
+func (c *connectionRecordingChunk) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, opt ...db.Option) error {
+	if opType != db.CreateOp {
+		return fmt.Errorf("connection recording chunk vet for write: chunks are immutable: %w", db.ErrInvalidParameter)
+	}
+	if c.ConnectionId == "" {
+		return fmt.Errorf("connection recording chunk vet for write: missing connection id: %w", db.ErrInvalidParameter)
+	}
+	if len(c.Data) == 0 {
+		return fmt.Errorf("connection recording chunk vet for write: missing data: %w", db.ErrInvalidParameter)
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (c *connectionRecordingChunk) TableName() string {
+	return defaultConnectionRecordingChunkTableName
+}
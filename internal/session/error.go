@@ -16,4 +16,8 @@ var (
 	// ErrOpenConnection indicates that a session can not be terminated because
 	// it has open connections.
 	ErrOpenConnection = errors.New("session has open connections")
+
+	// ErrRecordingExists indicates that the recording of a connection can not
+	// be stored because it already has been.
+	ErrRecordingExists = errors.New("connection recording already exists")
 )
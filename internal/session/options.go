@@ -1,6 +1,7 @@
 package session
 
 import (
+	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 )
 
@@ -19,6 +20,7 @@ type Option func(*options)
 // options = how options are represented
 type options struct {
 	withLimit          int
+	withPageAfter      *db.PageAfter
 	withOrder          string
 	withScopeId        string
 	withUserId         string
@@ -41,6 +43,15 @@ func WithLimit(limit int) Option {
 	}
 }
 
+// WithPageAfter provides an option to list only the items after the given
+// position, ordered by create time and public id, so that a list can be paged
+// through with WithLimit.
+func WithPageAfter(after *db.PageAfter) Option {
+	return func(o *options) {
+		o.withPageAfter = after
+	}
+}
+
 // WithOrder allows specifying an order for returned values
 func WithOrder(order string) Option {
 	return func(o *options) {
@@ -144,14 +144,15 @@ select expiration_time, connection_limit, current_connection_count
 from  
 	session_connection_limit, session_connection_count;	
 `
+	// sessionList selects the sessions to list in a subquery so that the
+	// limit applies to sessions rather than to their states
 	sessionList = `
 select * 
 from
-	(select public_id from session %s) s,
+	(select public_id from session %s %s %s) s,
 	session_with_state ss
 where 
 	s.public_id = ss.public_id 
-	%s
 %s
 `
 
@@ -231,5 +232,84 @@ where
                	end_time is null
     )
 )
+`
+
+	// closeDeadWorkerConnections closes the open connections of sessions
+	// activated on a worker which hasn't reported its status since $1.
+	closeDeadWorkerConnections = `
+update session_connection
+	set closed_reason = 'worker dead'
+where
+	closed_reason is null and
+	public_id in (
+		select 
+			connection_id
+		from 
+			session_connection_state
+		where 
+			state != 'closed' and
+			end_time is null
+	) and
+	session_id in (
+		select 
+			s.public_id
+		from 
+			session s,
+			server w
+		where 
+			s.server_id = w.private_id and
+			s.server_type = w.type and
+			w.type = 'worker' and
+			w.update_time < $1
+	)
+`
+
+	// termDeadWorkerSessions terminates the sessions activated on a worker
+	// which hasn't reported its status since $1. It must run after
+	// closeDeadWorkerConnections since sessions with open connections can't
+	// be terminated.
+	termDeadWorkerSessions = `
+update session us
+	set termination_reason = 'worker dead'
+where
+	termination_reason is null and
+	us.public_id in (
+		select 
+			s.public_id
+		from 
+			session s,
+			server w
+		where 
+			s.server_id = w.private_id and
+			s.server_type = w.type and
+			w.type = 'worker' and
+			w.update_time < $1
+	)
+`
+
+	// deleteConnectionRecordingChunks deletes the chunks of the recording of
+	// a connection.
+	deleteConnectionRecordingChunks = `
+delete from session_connection_recording_chunk
+where
+	connection_id = $1;
+`
+
+	// updateConnectionsBytes sets the bytes transferred over the open
+	// connections listed in its values, which are formatted into it as
+	// (connection id, bytes up, bytes down) tuples, of the sessions of the
+	// worker given by $1.
+	updateConnectionsBytes = `
+update session_connection c
+	set bytes_up = v.bytes_up,
+		bytes_down = v.bytes_down
+from (
+	values %s
+) as v(public_id, bytes_up, bytes_down), session s
+where
+	c.public_id = v.public_id and
+	c.session_id = s.public_id and
+	s.server_id = $1 and
+	c.closed_reason is null;
 `
 )
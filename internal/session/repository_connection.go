@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/hashicorp/boundary/internal/db"
 )
@@ -99,3 +100,184 @@ func fetchConnectionStates(ctx context.Context, r db.Reader, connectionId string
 	}
 	return states, nil
 }
+
+// connectionRecordingChunkSize is the size of the chunks recordings are
+// stored in, which bounds the memory used to store or read a recording
+const connectionRecordingChunkSize = 1024 * 1024
+
+// CreateConnectionRecording stores the recording of a connection read from
+// data. The connection must belong to the session and not have a recording
+// already, or ErrRecordingExists is returned. The recording is stored in
+// chunks as it is read, and is only listed once all of it has been stored;
+// the chunks of a recording that failed to be stored are replaced when it is
+// stored again. No options are currently supported.
+func (r *Repository) CreateConnectionRecording(ctx context.Context, sessionId, connectionId string, data io.Reader, opt ...Option) (*ConnectionRecording, error) {
+	if sessionId == "" {
+		return nil, fmt.Errorf("create connection recording: missing session id: %w", db.ErrInvalidParameter)
+	}
+	if connectionId == "" {
+		return nil, fmt.Errorf("create connection recording: missing connection id: %w", db.ErrInvalidParameter)
+	}
+	if data == nil {
+		return nil, fmt.Errorf("create connection recording: missing recording: %w", db.ErrInvalidParameter)
+	}
+	connection := AllocConnection()
+	connection.PublicId = connectionId
+	if err := r.reader.LookupById(ctx, &connection); err != nil {
+		return nil, fmt.Errorf("create connection recording: unable to look up connection %s: %w", connectionId, err)
+	}
+	if connection.SessionId != sessionId {
+		return nil, fmt.Errorf("create connection recording: connection %s does not belong to session %s: %w", connectionId, sessionId, db.ErrInvalidParameter)
+	}
+	existing, err := r.LookupConnectionRecording(ctx, sessionId, connectionId)
+	if err != nil {
+		return nil, fmt.Errorf("create connection recording: %w", err)
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("create connection recording: %s: %w", connectionId, ErrRecordingExists)
+	}
+	if _, err := r.writer.Exec(ctx, deleteConnectionRecordingChunks, []interface{}{connectionId}); err != nil {
+		return nil, fmt.Errorf("create connection recording: unable to delete previous chunks: %w", err)
+	}
+
+	size, err := r.createRecordingChunks(ctx, connectionId, data)
+	if err == nil && size == 0 {
+		err = fmt.Errorf("missing recording: %w", db.ErrInvalidParameter)
+	}
+	var returnedRecording *ConnectionRecording
+	if err == nil {
+		returnedRecording = &ConnectionRecording{
+			ConnectionId: connectionId,
+			SessionId:    sessionId,
+			Size:         size,
+		}
+		err = r.writer.Create(ctx, returnedRecording)
+		if db.IsUniqueError(err) {
+			err = fmt.Errorf("%s: %w", connectionId, ErrRecordingExists)
+		}
+	}
+	if err != nil {
+		// The chunks would be replaced when the recording is stored again,
+		// but there is no need to keep them until then. Those of a recording
+		// stored concurrently are kept.
+		if !errors.Is(err, ErrRecordingExists) {
+			if _, delErr := r.writer.Exec(ctx, deleteConnectionRecordingChunks, []interface{}{connectionId}); delErr != nil {
+				return nil, fmt.Errorf("create connection recording: %w (unable to delete chunks: %v)", err, delErr)
+			}
+		}
+		return nil, fmt.Errorf("create connection recording: %w", err)
+	}
+	return returnedRecording, nil
+}
+
+// createRecordingChunks stores data in chunks for the recording of the
+// connection, and returns its size
+func (r *Repository) createRecordingChunks(ctx context.Context, connectionId string, data io.Reader) (int64, error) {
+	var size int64
+	buf := make([]byte, connectionRecordingChunkSize)
+	for seq := uint32(0); ; seq++ {
+		n, err := io.ReadFull(data, buf)
+		if n > 0 {
+			chunk := &connectionRecordingChunk{
+				ConnectionId: connectionId,
+				Sequence:     seq,
+				Data:         buf[:n],
+			}
+			if err := r.writer.Create(ctx, chunk); err != nil {
+				return size, fmt.Errorf("unable to store chunk %d: %w", seq, err)
+			}
+			size += int64(n)
+		}
+		switch {
+		case err == io.EOF || err == io.ErrUnexpectedEOF:
+			return size, nil
+		case err != nil:
+			return size, fmt.Errorf("unable to read recording: %w", err)
+		}
+	}
+}
+
+// LookupConnectionRecording returns the recording of a connection of the
+// session, or nil if it has not been stored. No options are currently
+// supported.
+func (r *Repository) LookupConnectionRecording(ctx context.Context, sessionId, connectionId string, opt ...Option) (*ConnectionRecording, error) {
+	if sessionId == "" {
+		return nil, fmt.Errorf("lookup connection recording: missing session id: %w", db.ErrInvalidParameter)
+	}
+	if connectionId == "" {
+		return nil, fmt.Errorf("lookup connection recording: missing connection id: %w", db.ErrInvalidParameter)
+	}
+	var recordings []*ConnectionRecording
+	if err := r.reader.SearchWhere(ctx, &recordings, "session_id = ? and connection_id = ?", []interface{}{sessionId, connectionId}, db.WithLimit(1)); err != nil {
+		return nil, fmt.Errorf("lookup connection recording: %w", err)
+	}
+	if len(recordings) == 0 {
+		return nil, nil
+	}
+	return recordings[0], nil
+}
+
+// OpenConnectionRecording returns a reader of the encrypted data of the
+// recording rec. The data is read from the repository a chunk at a time, and
+// reading it fails if it doesn't add up to the size of the recording.
+func (r *Repository) OpenConnectionRecording(ctx context.Context, rec *ConnectionRecording) io.Reader {
+	return &connectionRecordingReader{
+		ctx:          ctx,
+		reader:       r.reader,
+		connectionId: rec.ConnectionId,
+		size:         rec.Size,
+	}
+}
+
+// connectionRecordingReader reads the chunks of a connection recording in
+// order
+type connectionRecordingReader struct {
+	ctx          context.Context
+	reader       db.Reader
+	connectionId string
+	size         int64
+
+	next uint32
+	read int64
+	buf  []byte
+}
+
+func (c *connectionRecordingReader) Read(p []byte) (int, error) {
+	if len(c.buf) == 0 {
+		if c.read >= c.size {
+			return 0, io.EOF
+		}
+		var chunks []*connectionRecordingChunk
+		if err := c.reader.SearchWhere(c.ctx, &chunks, "connection_id = ? and sequence = ?", []interface{}{c.connectionId, c.next}, db.WithLimit(1)); err != nil {
+			return 0, fmt.Errorf("unable to read chunk %d of the recording of connection %s: %w", c.next, c.connectionId, err)
+		}
+		if len(chunks) == 0 {
+			return 0, fmt.Errorf("recording of connection %s is missing chunk %d", c.connectionId, c.next)
+		}
+		c.buf = chunks[0].Data
+		c.read += int64(len(c.buf))
+		c.next++
+		if c.read > c.size {
+			return 0, fmt.Errorf("recording of connection %s is larger than its size", c.connectionId)
+		}
+	}
+	n := copy(p, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+// ListConnectionRecordings returns the recordings of a session's connections,
+// without their data, ordered by the time they were stored. Supports the
+// WithLimit option.
+func (r *Repository) ListConnectionRecordings(ctx context.Context, sessionId string, opt ...Option) ([]*ConnectionRecording, error) {
+	if sessionId == "" {
+		return nil, fmt.Errorf("list connection recordings: missing session id: %w", db.ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+	opts.withOrder = "create_time asc"
+	var recordings []*ConnectionRecording
+	if err := r.list(ctx, &recordings, "session_id = ?", []interface{}{sessionId}, opts); err != nil {
+		return nil, fmt.Errorf("list connection recordings: %w", err)
+	}
+	return recordings, nil
+}
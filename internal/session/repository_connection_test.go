@@ -1,8 +1,11 @@
 package session
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
 	"errors"
+	"io/ioutil"
 	"testing"
 	"time"
 
@@ -211,3 +214,93 @@ func TestRepository_DeleteConnection(t *testing.T) {
 		})
 	}
 }
+
+// failingReader returns data and then err
+type failingReader struct {
+	data []byte
+	err  error
+}
+
+func (f *failingReader) Read(p []byte) (int, error) {
+	if len(f.data) == 0 {
+		return 0, f.err
+	}
+	n := copy(p, f.data)
+	f.data = f.data[n:]
+	return n, nil
+}
+
+func TestRepository_ConnectionRecording(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	session := TestDefaultSession(t, conn, wrapper, iamRepo)
+	otherSession := TestDefaultSession(t, conn, wrapper, iamRepo)
+	connection := TestConnection(t, conn, session.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222)
+
+	// Spans several chunks, the last one partial
+	recording := make([]byte, 2*connectionRecordingChunkSize+100)
+	_, err = rand.Read(recording)
+	require.NoError(t, err)
+
+	t.Run("missing-recording", func(t *testing.T) {
+		_, err := repo.CreateConnectionRecording(ctx, session.PublicId, connection.PublicId, bytes.NewReader(nil))
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, db.ErrInvalidParameter))
+	})
+	t.Run("wrong-session", func(t *testing.T) {
+		_, err := repo.CreateConnectionRecording(ctx, otherSession.PublicId, connection.PublicId, bytes.NewReader(recording))
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, db.ErrInvalidParameter))
+	})
+	t.Run("failed-upload", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		readErr := errors.New("upload failed")
+		_, err := repo.CreateConnectionRecording(ctx, session.PublicId, connection.PublicId, &failingReader{data: recording[:connectionRecordingChunkSize+10], err: readErr})
+		require.Error(err)
+		assert.True(errors.Is(err, readErr))
+
+		found, err := repo.ListConnectionRecordings(ctx, session.PublicId)
+		require.NoError(err)
+		assert.Empty(found)
+		var chunks []*connectionRecordingChunk
+		require.NoError(rw.SearchWhere(ctx, &chunks, "connection_id = ?", []interface{}{connection.PublicId}))
+		assert.Empty(chunks)
+	})
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.CreateConnectionRecording(ctx, session.PublicId, connection.PublicId, bytes.NewReader(recording))
+		require.NoError(err)
+		assert.Equal(int64(len(recording)), got.Size)
+		assert.NotNil(got.CreateTime)
+
+		found, err := repo.ListConnectionRecordings(ctx, session.PublicId)
+		require.NoError(err)
+		require.Len(found, 1)
+		assert.Equal(connection.PublicId, found[0].ConnectionId)
+		assert.Equal(int64(len(recording)), found[0].Size)
+
+		rec, err := repo.LookupConnectionRecording(ctx, session.PublicId, connection.PublicId)
+		require.NoError(err)
+		require.NotNil(rec)
+		data, err := ioutil.ReadAll(repo.OpenConnectionRecording(ctx, rec))
+		require.NoError(err)
+		assert.Equal(recording, data)
+
+		rec, err = repo.LookupConnectionRecording(ctx, otherSession.PublicId, connection.PublicId)
+		require.NoError(err)
+		assert.Nil(rec)
+	})
+	t.Run("exists", func(t *testing.T) {
+		_, err := repo.CreateConnectionRecording(ctx, session.PublicId, connection.PublicId, bytes.NewReader(recording))
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrRecordingExists))
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
@@ -162,7 +163,8 @@ func (r *Repository) LookupSession(ctx context.Context, sessionId string, opt ..
 	return &session, authzSummary, nil
 }
 
-// ListSessions will sessions.  Supports the WithLimit, WithScopeId and WithSessionIds options.
+// ListSessions will sessions.  Supports the WithLimit, WithPageAfter, WithOrder,
+// WithScopeId and WithSessionIds options.
 func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Session, error) {
 	opts := getOpts(opt...)
 	var where []string
@@ -183,7 +185,12 @@ func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Sessio
 			inClauseCnt += 1
 			idsInClause, args = append(idsInClause, fmt.Sprintf("$%d", inClauseCnt)), append(args, id)
 		}
-		where = append(where, fmt.Sprintf("s.public_id in(%s)", strings.Join(idsInClause, ",")))
+		where = append(where, fmt.Sprintf("public_id in(%s)", strings.Join(idsInClause, ",")))
+	}
+	if opts.withPageAfter != nil && opts.withPageAfter.PublicId != "" {
+		inClauseCnt += 2
+		where = append(where, fmt.Sprintf("(create_time, public_id) > ($%d, $%d)", inClauseCnt-1, inClauseCnt))
+		args = append(args, opts.withPageAfter.CreateTime, opts.withPageAfter.PublicId)
 	}
 
 	var limit string
@@ -196,16 +203,24 @@ func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Sessio
 		limit = fmt.Sprintf("limit %d", opts.withLimit)
 	}
 
-	if opts.withOrder != "" {
-		opts.withOrder = fmt.Sprintf("order by %s", opts.withOrder)
+	// The states of a session must be consecutive for convertToSessions, so
+	// the sessions are always ordered
+	var sessionOrder, order string
+	switch {
+	case opts.withPageAfter != nil:
+		sessionOrder, order = "order by create_time, public_id", "order by ss.create_time, ss.public_id"
+	case opts.withOrder != "":
+		sessionOrder, order = fmt.Sprintf("order by %s", opts.withOrder), fmt.Sprintf("order by %s, ss.public_id", opts.withOrder)
+	default:
+		order = "order by ss.public_id"
 	}
 
 	var whereClause string
 	if len(where) > 0 {
-		whereClause = " and " + strings.Join(where, " and")
+		whereClause = "where " + strings.Join(where, " and ")
 	}
 	q := sessionList
-	query := fmt.Sprintf(q, limit, whereClause, opts.withOrder)
+	query := fmt.Sprintf(q, whereClause, sessionOrder, limit, order)
 
 	rows, err := r.reader.Query(ctx, query, args)
 	if err != nil {
@@ -358,6 +373,41 @@ func (r *Repository) TerminateCompletedSessions(ctx context.Context) (int, error
 	return rowsAffected, nil
 }
 
+// CloseConnectionsForDeadWorkers closes the connections and terminates the
+// sessions of workers which haven't reported their status within gracePeriod.
+// Connections are closed with ConnectionWorkerDead and sessions are
+// terminated with WorkerDead. It returns the number of connections closed
+// and sessions terminated. This function should be called on a periodic basis
+// by Controllers via their "ticker" pattern.
+func (r *Repository) CloseConnectionsForDeadWorkers(ctx context.Context, gracePeriod time.Duration) (int, int, error) {
+	if gracePeriod <= 0 {
+		return db.NoRowsAffected, db.NoRowsAffected, fmt.Errorf("close connections for dead workers: grace period must be positive: %w", db.ErrInvalidParameter)
+	}
+	deadSince := time.Now().Add(-1 * gracePeriod).Format(time.RFC3339)
+	var closed, terminated int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			var err error
+			closed, err = w.Exec(ctx, closeDeadWorkerConnections, []interface{}{deadSince})
+			if err != nil {
+				return fmt.Errorf("closing connections: %w", err)
+			}
+			terminated, err = w.Exec(ctx, termDeadWorkerSessions, []interface{}{deadSince})
+			if err != nil {
+				return fmt.Errorf("terminating sessions: %w", err)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, db.NoRowsAffected, fmt.Errorf("close connections for dead workers: %w", err)
+	}
+	return closed, terminated, nil
+}
+
 // AuthorizeConnection will check to see if a connection is allowed.  Currently,
 // that authorization checks:
 // * the hasn't expired based on the session.Expiration
@@ -552,6 +602,67 @@ func (r *Repository) CloseConnections(ctx context.Context, closeWith []CloseWith
 	return resp, nil
 }
 
+// maxConnectionBytesPerStatement is the number of connections updated by each
+// statement of UpdateConnectionBytes, which keeps its parameters well under the
+// limit of 65535 parameters of a Postgres statement.
+const maxConnectionBytesPerStatement = 1000
+
+// UpdateConnectionBytes updates the number of bytes transferred in each
+// direction for the given connections that have not yet been closed, in
+// statements of up to 1000 connections each. It's called with the counts a
+// worker reports in its status while the connections are being proxied, so
+// only connections of sessions on the worker with the given server ID are
+// updated. Updates for connections that are already closed are ignored,
+// since the counts given when closing the connection are final. It returns
+// the number of connections updated.
+func (r *Repository) UpdateConnectionBytes(ctx context.Context, serverId string, connBytes []ConnectionBytes, _ ...Option) (int, error) {
+	if serverId == "" {
+		return db.NoRowsAffected, fmt.Errorf("update connection bytes: missing server id: %w", db.ErrInvalidParameter)
+	}
+	if len(connBytes) == 0 {
+		return db.NoRowsAffected, fmt.Errorf("update connection bytes: missing connections: %w", db.ErrInvalidParameter)
+	}
+	for _, c := range connBytes {
+		if err := c.validate(); err != nil {
+			return db.NoRowsAffected, fmt.Errorf("update connection bytes: %w", err)
+		}
+	}
+	var rowsUpdated int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			rowsUpdated = 0
+			for start := 0; start < len(connBytes); start += maxConnectionBytesPerStatement {
+				end := start + maxConnectionBytesPerStatement
+				if end > len(connBytes) {
+					end = len(connBytes)
+				}
+				chunk := connBytes[start:end]
+				values := make([]string, 0, len(chunk))
+				args := make([]interface{}, 0, 1+3*len(chunk))
+				args = append(args, serverId)
+				for _, c := range chunk {
+					n := len(args)
+					values = append(values, fmt.Sprintf("($%d, $%d::bigint, $%d::bigint)", n+1, n+2, n+3))
+					args = append(args, c.ConnectionId, int64(c.BytesUp), int64(c.BytesDown))
+				}
+				updated, err := w.Exec(ctx, fmt.Sprintf(updateConnectionsBytes, strings.Join(values, ", ")), args)
+				if err != nil {
+					return err
+				}
+				rowsUpdated += updated
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("update connection bytes: %w", err)
+	}
+	return rowsUpdated, nil
+}
+
 // ActivateSession will activate the session and is called by a worker after
 // authenticating the session. The session must be in a "pending" state to be
 // activated. States are ordered by start time descending. Returns an
@@ -3,6 +3,7 @@ package session
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/host/static"
 	staticStore "github.com/hashicorp/boundary/internal/host/static/store"
+	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/target"
 	targetStore "github.com/hashicorp/boundary/internal/target/store"
 	"github.com/lib/pq"
@@ -135,6 +137,32 @@ func TestRepository_ListSession(t *testing.T) {
 			assert.True(first.Before(second))
 		}
 	})
+	t.Run("withPageAfter", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		require.NoError(conn.Where("1=1").Delete(AllocSession()).Error)
+		var want []string
+		for i := 0; i < 5; i++ {
+			s := TestSession(t, conn, wrapper, composedOf)
+			_ = TestState(t, conn, s.PublicId, StatusActive)
+			want = append(want, s.PublicId)
+		}
+		var got []string
+		after := &db.PageAfter{}
+		for {
+			page, err := repo.ListSessions(context.Background(), WithScopeId(composedOf.ScopeId), WithLimit(2), WithPageAfter(after))
+			require.NoError(err)
+			for _, s := range page {
+				assert.Len(s.States, 2)
+				got = append(got, s.PublicId)
+			}
+			if len(page) < 2 {
+				break
+			}
+			last := page[len(page)-1]
+			after = &db.PageAfter{CreateTime: last.CreateTime.GetTimestamp().AsTime(), PublicId: last.PublicId}
+		}
+		assert.ElementsMatch(want, got)
+	})
 	t.Run("withUserId", func(t *testing.T) {
 		assert, require := assert.New(t), require.New(t)
 		require.NoError(conn.Where("1=1").Delete(AllocSession()).Error)
@@ -1036,6 +1064,213 @@ func TestRepository_CloseConnections(t *testing.T) {
 	}
 }
 
+func TestRepository_CloseConnectionsForDeadWorkers(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+	ctx := context.Background()
+
+	setupFn := func() (*Session, *Connection, string) {
+		s := TestDefaultSession(t, conn, wrapper, iamRepo)
+		srv := TestWorker(t, conn, wrapper)
+		s, _, err := repo.ActivateSession(ctx, s.PublicId, s.Version, srv.PrivateId, srv.Type, TestTofu(t))
+		require.NoError(err)
+		c := TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222)
+		return s, c, srv.PrivateId
+	}
+	deadSession, deadConn, deadWorker := setupFn()
+	liveSession, liveConn, _ := setupFn()
+
+	_, _, err = repo.CloseConnectionsForDeadWorkers(ctx, 0)
+	require.Error(err)
+	assert.True(errors.Is(err, db.ErrInvalidParameter))
+
+	// Nothing is closed while every worker is within the grace period
+	closed, terminated, err := repo.CloseConnectionsForDeadWorkers(ctx, time.Minute)
+	require.NoError(err)
+	assert.Equal(0, closed)
+	assert.Equal(0, terminated)
+
+	_, err = rw.Exec(ctx, "update server set update_time = $1 where private_id = $2",
+		[]interface{}{time.Now().Add(-2 * time.Minute).Format(time.RFC3339), deadWorker})
+	require.NoError(err)
+
+	closed, terminated, err = repo.CloseConnectionsForDeadWorkers(ctx, time.Minute)
+	require.NoError(err)
+	assert.Equal(1, closed)
+	assert.Equal(1, terminated)
+
+	c, cs, err := repo.LookupConnection(ctx, deadConn.PublicId)
+	require.NoError(err)
+	assert.Equal(ConnectionWorkerDead.String(), c.ClosedReason)
+	assert.Equal(StatusClosed, cs[0].Status)
+	s, _, err := repo.LookupSession(ctx, deadSession.PublicId)
+	require.NoError(err)
+	assert.Equal(WorkerDead.String(), s.TerminationReason)
+	assert.Equal(StatusTerminated, s.States[0].Status)
+
+	c, cs, err = repo.LookupConnection(ctx, liveConn.PublicId)
+	require.NoError(err)
+	assert.Empty(c.ClosedReason)
+	assert.NotEqual(StatusClosed, cs[0].Status)
+	s, _, err = repo.LookupSession(ctx, liveSession.PublicId)
+	require.NoError(err)
+	assert.Empty(s.TerminationReason)
+	assert.Equal(StatusActive, s.States[0].Status)
+
+	// Running again is a no-op
+	closed, terminated, err = repo.CloseConnectionsForDeadWorkers(ctx, time.Minute)
+	require.NoError(err)
+	assert.Equal(0, closed)
+	assert.Equal(0, terminated)
+}
+
+func TestRepository_UpdateConnectionBytes(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	setupFn := func(srv *servers.Server, closed bool) *Connection {
+		s := TestDefaultSession(t, conn, wrapper, iamRepo)
+		tofu := TestTofu(t)
+		s, _, err := repo.ActivateSession(context.Background(), s.PublicId, s.Version, srv.PrivateId, srv.Type, tofu)
+		require.NoError(t, err)
+		c := TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222)
+		if closed {
+			_, err := repo.CloseConnections(context.Background(), []CloseWith{
+				{
+					ConnectionId: c.PublicId,
+					BytesUp:      10,
+					BytesDown:    20,
+					ClosedReason: ConnectionClosedByUser,
+				},
+			})
+			require.NoError(t, err)
+		}
+		return c
+	}
+	srv, otherSrv := TestWorker(t, conn, wrapper), TestWorker(t, conn, wrapper)
+	open, closed := setupFn(srv, false), setupFn(srv, true)
+	other := setupFn(srv, false)
+	otherWorker := setupFn(otherSrv, false)
+
+	// Enough connections for more than one statement, with only the last
+	// one existing
+	chunked := make([]ConnectionBytes, 0, maxConnectionBytesPerStatement+1)
+	for i := 0; i < maxConnectionBytesPerStatement; i++ {
+		chunked = append(chunked, ConnectionBytes{ConnectionId: fmt.Sprintf("sc_%010d", i), BytesUp: 1, BytesDown: 1})
+	}
+	chunked = append(chunked, ConnectionBytes{ConnectionId: other.PublicId, BytesUp: 700, BytesDown: 800})
+
+	tests := []struct {
+		name        string
+		serverId    string
+		connBytes   []ConnectionBytes
+		wantUpdated int
+		wantBytes   map[string][2]uint64
+		wantErr     bool
+		wantIsError error
+	}{
+		{
+			name:     "valid",
+			serverId: srv.PrivateId,
+			connBytes: []ConnectionBytes{
+				{ConnectionId: open.PublicId, BytesUp: 100, BytesDown: 200},
+				{ConnectionId: other.PublicId, BytesUp: 300, BytesDown: 400},
+			},
+			wantUpdated: 2,
+			wantBytes: map[string][2]uint64{
+				open.PublicId:  {100, 200},
+				other.PublicId: {300, 400},
+			},
+		},
+		{
+			name:     "closed",
+			serverId: srv.PrivateId,
+			connBytes: []ConnectionBytes{
+				{ConnectionId: closed.PublicId, BytesUp: 100, BytesDown: 200},
+				{ConnectionId: open.PublicId, BytesUp: 500, BytesDown: 600},
+			},
+			wantUpdated: 1,
+			wantBytes: map[string][2]uint64{
+				closed.PublicId: {10, 20},
+				open.PublicId:   {500, 600},
+			},
+		},
+		{
+			name:     "other-worker",
+			serverId: srv.PrivateId,
+			connBytes: []ConnectionBytes{
+				{ConnectionId: otherWorker.PublicId, BytesUp: 100, BytesDown: 200},
+			},
+			wantUpdated: 0,
+			wantBytes: map[string][2]uint64{
+				otherWorker.PublicId: {0, 0},
+			},
+		},
+		{
+			name:        "chunked",
+			serverId:    srv.PrivateId,
+			connBytes:   chunked,
+			wantUpdated: 1,
+			wantBytes: map[string][2]uint64{
+				other.PublicId: {700, 800},
+			},
+		},
+		{
+			name:        "missing-server-id",
+			connBytes:   []ConnectionBytes{{ConnectionId: open.PublicId, BytesUp: 100, BytesDown: 200}},
+			wantErr:     true,
+			wantIsError: db.ErrInvalidParameter,
+		},
+		{
+			name:        "missing-connections",
+			serverId:    srv.PrivateId,
+			wantErr:     true,
+			wantIsError: db.ErrInvalidParameter,
+		},
+		{
+			name:        "missing-connection-id",
+			serverId:    srv.PrivateId,
+			connBytes:   []ConnectionBytes{{BytesUp: 100, BytesDown: 200}},
+			wantErr:     true,
+			wantIsError: db.ErrInvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			updated, err := repo.UpdateConnectionBytes(context.Background(), tt.serverId, tt.connBytes)
+			if tt.wantErr {
+				require.Error(err)
+				if tt.wantIsError != nil {
+					assert.Truef(errors.Is(err, tt.wantIsError), "unexpected error %s", err.Error())
+				}
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantUpdated, updated)
+			for id, want := range tt.wantBytes {
+				found, _, err := repo.LookupConnection(context.Background(), id)
+				require.NoError(err)
+				assert.Equal(want[0], found.BytesUp)
+				assert.Equal(want[1], found.BytesDown)
+			}
+		})
+	}
+}
+
 func TestRepository_CancelSession(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
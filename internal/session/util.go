@@ -3,35 +3,45 @@ package session
 import (
 	"crypto/ed25519"
 	"crypto/sha256"
-	"errors"
 	"io"
 
+	"github.com/hashicorp/boundary/internal/kms"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
-	"github.com/hashicorp/go-kms-wrapping/wrappers/aead"
-	"github.com/hashicorp/go-kms-wrapping/wrappers/multiwrapper"
 	"golang.org/x/crypto/hkdf"
 )
 
+// recordingKeyInfo is mixed into the derivation of recording keys so that
+// they can never collide with session private keys
+const recordingKeyInfo = "boundary-session-recording"
+
 // DeriveED25519Key generates a key based on the scope's session DEK, the
 // requesting user, and the generated job ID.
 func DeriveED25519Key(wrapper wrapping.Wrapper, userId, jobId string) (ed25519.PublicKey, ed25519.PrivateKey, error) {
-	var aeadWrapper *aead.Wrapper
-	switch w := wrapper.(type) {
-	case *multiwrapper.MultiWrapper:
-		raw := w.WrapperForKeyID("__base__")
-		var ok bool
-		if aeadWrapper, ok = raw.(*aead.Wrapper); !ok {
-			return nil, nil, errors.New("unexpected wrapper type from multiwrapper base")
-		}
-	case *aead.Wrapper:
-		aeadWrapper = w
-	default:
-		return nil, nil, errors.New("unknown wrapper type")
+	keyBytes, err := kms.BaseKeyBytes(wrapper)
+	if err != nil {
+		return nil, nil, err
 	}
-	reader := hkdf.New(sha256.New, aeadWrapper.GetKeyBytes(), []byte(jobId), []byte(userId))
+	reader := hkdf.New(sha256.New, keyBytes, []byte(jobId), []byte(userId))
 	limitedReader := &io.LimitedReader{
 		R: reader,
 		N: 32,
 	}
 	return ed25519.GenerateKey(limitedReader)
 }
+
+// DeriveRecordingKey generates a 32 byte key used to encrypt the recordings
+// of a session's connections, based on the scope's session DEK, the user
+// and the session ID. Deriving it on demand allows the controller to decrypt
+// recordings without the key having to be stored.
+func DeriveRecordingKey(wrapper wrapping.Wrapper, userId, sessionId string) ([]byte, error) {
+	keyBytes, err := kms.BaseKeyBytes(wrapper)
+	if err != nil {
+		return nil, err
+	}
+	reader := hkdf.New(sha256.New, keyBytes, []byte(sessionId), []byte(recordingKeyInfo+userId))
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(reader, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
@@ -0,0 +1,195 @@
+package target
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target/store"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DefaultHttpTableName = "target_http"
+)
+
+type HttpTarget struct {
+	*store.HttpTarget
+	tableName string `gorm:"-"`
+}
+
+var _ Target = (*HttpTarget)(nil)
+var _ db.VetForWriter = (*HttpTarget)(nil)
+var _ oplog.ReplayableMessage = (*HttpTarget)(nil)
+
+// NewHttpTarget creates a new in memory http target.  WithName, WithDescription,
+// WithDefaultPort, WithSessionMaxSeconds, WithSessionConnectionLimit,
+// WithWorkerFilter, WithSessionMaxBytesPerSecond,
+// WithConnectionIdleTimeoutSeconds, WithUseTls, WithAllowedMethods,
+// WithAllowedPathPrefixes and WithInjectedHeaders options are supported
+func NewHttpTarget(scopeId string, opt ...Option) (*HttpTarget, error) {
+	opts := getOpts(opt...)
+	if scopeId == "" {
+		return nil, fmt.Errorf("new http target: missing scope id: %w", db.ErrInvalidParameter)
+	}
+	t := &HttpTarget{
+		HttpTarget: &store.HttpTarget{
+			ScopeId:                      scopeId,
+			Name:                         opts.withName,
+			Description:                  opts.withDescription,
+			DefaultPort:                  opts.withDefaultPort,
+			SessionConnectionLimit:       opts.withSessionConnectionLimit,
+			SessionMaxSeconds:            opts.withSessionMaxSeconds,
+			WorkerFilter:                 opts.withWorkerFilter,
+			SessionMaxBytesPerSecond:     opts.withMaxBytesPerSecond,
+			ConnectionIdleTimeoutSeconds: opts.withIdleTimeoutSeconds,
+			UseTls:                       opts.withUseTls,
+			AllowedMethods:               strings.Join(opts.withAllowedMethods, " "),
+			AllowedPathPrefixes:          strings.Join(opts.withAllowedPathPrefixes, " "),
+			InjectedHeaders:              strings.Join(opts.withInjectedHeaders, "\n"),
+		},
+	}
+	return t, nil
+}
+
+// allocHttpTarget will allocate a http target
+func allocHttpTarget() HttpTarget {
+	return HttpTarget{
+		HttpTarget: &store.HttpTarget{},
+	}
+}
+
+// Clone creates a clone of the HttpTarget
+func (t *HttpTarget) Clone() interface{} {
+	cp := proto.Clone(t.HttpTarget)
+	return &HttpTarget{
+		HttpTarget: cp.(*store.HttpTarget),
+	}
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the http target
+// before it's written.
+func (t *HttpTarget) VetForWrite(ctx context.Context, r db.Reader, opType db.OpType, opt ...db.Option) error {
+	if t.PublicId == "" {
+		return fmt.Errorf("http target vet for write: missing public id: %w", db.ErrInvalidParameter)
+	}
+	if opType == db.CreateOp {
+		if t.ScopeId == "" {
+			return fmt.Errorf("http target vet for write: missing scope id: %w", db.ErrInvalidParameter)
+		}
+		if t.Name == "" {
+			return fmt.Errorf("http target vet for write: missing name id: %w", db.ErrInvalidParameter)
+		}
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (t *HttpTarget) TableName() string {
+	if t.tableName != "" {
+		return t.tableName
+	}
+	return DefaultHttpTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (t *HttpTarget) SetTableName(n string) {
+	t.tableName = n
+}
+
+func (t *HttpTarget) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{t.PublicId},
+		"resource-type":      []string{"http target"},
+		"op-type":            []string{op.String()},
+		"scope-id":           []string{t.ScopeId},
+	}
+	return metadata
+}
+
+func (t HttpTarget) GetType() string {
+	return "http"
+}
+
+// GetRecordSessions returns false as the requests to http targets are not
+// recorded.
+func (t *HttpTarget) GetRecordSessions() bool {
+	return false
+}
+
+// GetCredentialInjection returns "" as workers inject headers rather than
+// credentials into the requests to http targets.
+func (t *HttpTarget) GetCredentialInjection() string {
+	return ""
+}
+
+// Methods returns the request methods let through to the target.
+func (t *HttpTarget) Methods() []string {
+	return strings.Fields(t.GetAllowedMethods())
+}
+
+// PathPrefixes returns the path prefixes of the requests let through to the
+// target.
+func (t *HttpTarget) PathPrefixes() []string {
+	return strings.Fields(t.GetAllowedPathPrefixes())
+}
+
+// Headers returns the "Name: value" headers set on every request to the
+// target.
+func (t *HttpTarget) Headers() []string {
+	if t.GetInjectedHeaders() == "" {
+		return nil
+	}
+	return strings.Split(t.GetInjectedHeaders(), "\n")
+}
+
+// ParseInjectedHeader splits an injected header of the "Name: value" form
+// into its name and value. An error is returned if the name is not a valid
+// header name or the value spans several lines.
+func ParseInjectedHeader(h string) (string, string, error) {
+	i := strings.Index(h, ":")
+	if i < 0 {
+		return "", "", fmt.Errorf("parse injected header: missing colon in %q: %w", h, db.ErrInvalidParameter)
+	}
+	name, value := strings.TrimSpace(h[:i]), strings.TrimSpace(h[i+1:])
+	if name == "" {
+		return "", "", fmt.Errorf("parse injected header: missing name in %q: %w", h, db.ErrInvalidParameter)
+	}
+	for _, r := range name {
+		if !isTokenRune(r) {
+			return "", "", fmt.Errorf("parse injected header: invalid name %q: %w", name, db.ErrInvalidParameter)
+		}
+	}
+	if strings.ContainsAny(value, "\r\n") {
+		return "", "", fmt.Errorf("parse injected header: value of %q spans several lines: %w", name, db.ErrInvalidParameter)
+	}
+	return name, value, nil
+}
+
+// isTokenRune reports whether r may be used in an http token, such as a
+// method or a header name.
+func isTokenRune(r rune) bool {
+	switch {
+	case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+		return true
+	}
+	return strings.ContainsRune("!#$%&'*+-.^_`|~", r)
+}
+
+// ValidMethod reports whether m is a request method which can be allowed on
+// an http target.
+func ValidMethod(m string) bool {
+	if m == "" {
+		return false
+	}
+	for _, r := range m {
+		if !isTokenRune(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+package target
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHttpTarget(t *testing.T) {
+	t.Parallel()
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := NewHttpTarget("p_1234567890",
+			WithName("grafana"),
+			WithDefaultPort(3000),
+			WithUseTls(true),
+			WithAllowedMethods([]string{"GET", "HEAD"}),
+			WithAllowedPathPrefixes([]string{"/d/", "/api/dashboards"}),
+			WithInjectedHeaders([]string{"X-Webauth-User: admin", "X-Team: ops"}))
+		require.NoError(err)
+		assert.Equal("grafana", got.GetName())
+		assert.Equal("http", got.GetType())
+		assert.True(got.GetUseTls())
+		assert.Equal("GET HEAD", got.GetAllowedMethods())
+		assert.Equal([]string{"GET", "HEAD"}, got.Methods())
+		assert.Equal([]string{"/d/", "/api/dashboards"}, got.PathPrefixes())
+		assert.Equal([]string{"X-Webauth-User: admin", "X-Team: ops"}, got.Headers())
+		assert.False(got.GetRecordSessions())
+	})
+	t.Run("no-policy", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := NewHttpTarget("p_1234567890", WithName("grafana"))
+		require.NoError(err)
+		assert.Empty(got.Methods())
+		assert.Empty(got.PathPrefixes())
+		assert.Empty(got.Headers())
+	})
+	t.Run("missing-scope-id", func(t *testing.T) {
+		_, err := NewHttpTarget("")
+		assert.True(t, errors.Is(err, db.ErrInvalidParameter))
+	})
+}
+
+func TestSubtype_Http(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	assert.Equal(HttpSubType, SubtypeFromType("http"))
+	assert.Equal(HttpSubType, SubtypeFromId(HttpTargetPrefix+"_1234567890"))
+	assert.Equal("http", HttpTargetType.String())
+}
+
+func TestParseInjectedHeader(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in        string
+		wantName  string
+		wantValue string
+		wantErr   bool
+	}{
+		{in: "X-Webauth-User: admin", wantName: "X-Webauth-User", wantValue: "admin"},
+		{in: "Authorization:Bearer abc:def", wantName: "Authorization", wantValue: "Bearer abc:def"},
+		{in: "X-Empty:", wantName: "X-Empty"},
+		{in: "no colon", wantErr: true},
+		{in: ": value", wantErr: true},
+		{in: "Bad Name: value", wantErr: true},
+		{in: "X-Split: a\r\nX-Other: b", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert := assert.New(t)
+			name, value, err := ParseInjectedHeader(tt.in)
+			if tt.wantErr {
+				assert.True(errors.Is(err, db.ErrInvalidParameter))
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(tt.wantName, name)
+			assert.Equal(tt.wantValue, value)
+		})
+	}
+}
+
+func TestValidMethod(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	assert.True(ValidMethod("GET"))
+	assert.True(ValidMethod("PROPFIND"))
+	assert.False(ValidMethod(""))
+	assert.False(ValidMethod("GET POST"))
+}
@@ -7,7 +7,9 @@ import (
 )
 
 const (
-	TcpTargetPrefix = "ttcp"
+	TcpTargetPrefix  = "ttcp"
+	UdpTargetPrefix  = "tudp"
+	HttpTargetPrefix = "thttp"
 )
 
 func newTcpTargetId() (string, error) {
@@ -17,3 +19,19 @@ func newTcpTargetId() (string, error) {
 	}
 	return id, nil
 }
+
+func newUdpTargetId() (string, error) {
+	id, err := db.NewPublicId(UdpTargetPrefix)
+	if err != nil {
+		return "", fmt.Errorf("new udp target id: %w", err)
+	}
+	return id, nil
+}
+
+func newHttpTargetId() (string, error) {
+	id, err := db.NewPublicId(HttpTargetPrefix)
+	if err != nil {
+		return "", fmt.Errorf("new http target id: %w", err)
+	}
+	return id, nil
+}
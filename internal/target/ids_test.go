@@ -15,4 +15,14 @@ func Test_Ids(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(t, strings.HasPrefix(id, TcpTargetPrefix+"_"))
 	})
+	t.Run("http", func(t *testing.T) {
+		id, err := newHttpTargetId()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(id, HttpTargetPrefix+"_"))
+	})
+	t.Run("udp", func(t *testing.T) {
+		id, err := newUdpTargetId()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(id, UdpTargetPrefix+"_"))
+	})
 }
@@ -1,6 +1,10 @@
 package target
 
-import "time"
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
 
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
@@ -20,6 +24,7 @@ type options struct {
 	withDescription            string
 	withDefaultPort            uint32
 	withLimit                  int
+	withPageAfter              *db.PageAfter
 	withScopeId                string
 	withUserId                 string
 	withTargetType             *TargetType
@@ -27,6 +32,15 @@ type options struct {
 	withSessionMaxSeconds      uint32
 	withSessionConnectionLimit int32
 	withPublicId               string
+	withRecordSessions         bool
+	withCredentialInjection    string
+	withWorkerFilter           string
+	withMaxBytesPerSecond      uint32
+	withIdleTimeoutSeconds     uint32
+	withUseTls                 bool
+	withAllowedMethods         []string
+	withAllowedPathPrefixes    []string
+	withInjectedHeaders        []string
 }
 
 func getDefaultOptions() options {
@@ -42,6 +56,15 @@ func getDefaultOptions() options {
 		withSessionMaxSeconds:      uint32((8 * time.Hour).Seconds()),
 		withSessionConnectionLimit: 1,
 		withPublicId:               "",
+		withRecordSessions:         false,
+		withCredentialInjection:    "",
+		withWorkerFilter:           "",
+		withMaxBytesPerSecond:      0,
+		withIdleTimeoutSeconds:     0,
+		withUseTls:                 false,
+		withAllowedMethods:         nil,
+		withAllowedPathPrefixes:    nil,
+		withInjectedHeaders:        nil,
 	}
 }
 
@@ -68,6 +91,15 @@ func WithLimit(limit int) Option {
 	}
 }
 
+// WithPageAfter provides an option to list only the items after the given
+// position, ordered by create time and public id, so that a list can be paged
+// through with WithLimit.
+func WithPageAfter(after *db.PageAfter) Option {
+	return func(o *options) {
+		o.withPageAfter = after
+	}
+}
+
 // WithDefaultPort provides an option to specify the default target port.
 func WithDefaultPort(p uint32) Option {
 	return func(o *options) {
@@ -121,3 +153,79 @@ func WithPublicId(id string) Option {
 		o.withPublicId = id
 	}
 }
+
+// WithRecordSessions provides an option to specify whether the connections of
+// sessions for the target are recorded.
+func WithRecordSessions(record bool) Option {
+	return func(o *options) {
+		o.withRecordSessions = record
+	}
+}
+
+// WithCredentialInjection provides an option to specify the protocol with
+// which the worker authenticates to the endpoint with the credentials of the
+// target.
+func WithCredentialInjection(protocol string) Option {
+	return func(o *options) {
+		o.withCredentialInjection = protocol
+	}
+}
+
+// WithWorkerFilter provides an option to specify the filter expression that
+// selects the workers which may handle sessions for the target.
+func WithWorkerFilter(filter string) Option {
+	return func(o *options) {
+		o.withWorkerFilter = filter
+	}
+}
+
+// WithSessionMaxBytesPerSecond provides an option to specify the maximum
+// number of bytes per second the worker proxies in each direction for a
+// session of the target. 0 means unlimited.
+func WithSessionMaxBytesPerSecond(bytes uint32) Option {
+	return func(o *options) {
+		o.withMaxBytesPerSecond = bytes
+	}
+}
+
+// WithConnectionIdleTimeoutSeconds provides an option to specify the number
+// of seconds without data after which the worker closes a connection to the
+// target. 0 means idle connections are never closed.
+func WithConnectionIdleTimeoutSeconds(seconds uint32) Option {
+	return func(o *options) {
+		o.withIdleTimeoutSeconds = seconds
+	}
+}
+
+// WithUseTls provides an option to specify whether the worker reaches the
+// endpoint of an http target over https.
+func WithUseTls(useTls bool) Option {
+	return func(o *options) {
+		o.withUseTls = useTls
+	}
+}
+
+// WithAllowedMethods provides an option to specify the request methods the
+// worker lets through to an http target. If empty, all methods are.
+func WithAllowedMethods(methods []string) Option {
+	return func(o *options) {
+		o.withAllowedMethods = methods
+	}
+}
+
+// WithAllowedPathPrefixes provides an option to specify the path prefixes of
+// the requests the worker lets through to an http target. If empty, all paths
+// are.
+func WithAllowedPathPrefixes(prefixes []string) Option {
+	return func(o *options) {
+		o.withAllowedPathPrefixes = prefixes
+	}
+}
+
+// WithInjectedHeaders provides an option to specify the "Name: value" headers
+// the worker sets on every request to an http target.
+func WithInjectedHeaders(headers []string) Option {
+	return func(o *options) {
+		o.withInjectedHeaders = headers
+	}
+}
@@ -3,6 +3,7 @@ package target
 import (
 	"testing"
 
+	"github.com/hashicorp/boundary/internal/db"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,6 +42,14 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withLimit = 1
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithPageAfter", func(t *testing.T) {
+		assert := assert.New(t)
+		after := &db.PageAfter{PublicId: "test"}
+		opts := getOpts(WithPageAfter(after))
+		testOpts := getDefaultOptions()
+		testOpts.withPageAfter = after
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithDefaultPort", func(t *testing.T) {
 		assert := assert.New(t)
 		// test default of 0
@@ -90,4 +99,39 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withHostSets = []string{"alice", "bob"}
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithRecordSessions", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithRecordSessions(true))
+		testOpts := getDefaultOptions()
+		testOpts.withRecordSessions = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithCredentialInjection", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithCredentialInjection(PostgresCredentialInjection))
+		testOpts := getDefaultOptions()
+		testOpts.withCredentialInjection = PostgresCredentialInjection
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithWorkerFilter", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithWorkerFilter(`"vpc-a" in tags.network`))
+		testOpts := getDefaultOptions()
+		testOpts.withWorkerFilter = `"vpc-a" in tags.network`
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSessionMaxBytesPerSecond", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithSessionMaxBytesPerSecond(1024))
+		testOpts := getDefaultOptions()
+		testOpts.withMaxBytesPerSecond = 1024
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithConnectionIdleTimeoutSeconds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithConnectionIdleTimeoutSeconds(300))
+		testOpts := getDefaultOptions()
+		testOpts.withIdleTimeoutSeconds = 300
+		assert.Equal(opts, testOpts)
+	})
 }
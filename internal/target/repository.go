@@ -13,6 +13,11 @@ import (
 
 var (
 	ErrMetadataScopeNotFound = errors.New("scope not found for metadata")
+
+	// ErrMultipleInjectedCredentials is returned when a target would inject
+	// its credential into sessions while having more than one credential
+	// attached, as the worker would have no way to choose which one to inject
+	ErrMultipleInjectedCredentials = errors.New("credential injection requires a single credential")
 )
 
 // Clonable provides a cloning interface
@@ -104,7 +109,7 @@ func fetchSets(ctx context.Context, r db.Reader, targetId string) ([]*TargetSet,
 	return hostSets, nil
 }
 
-// ListTargets in targets in a scope.  Supports the WithScopeId, WithLimit, WithTargetType options.
+// ListTargets in targets in a scope.  Supports the WithScopeId, WithLimit, WithPageAfter, WithTargetType options.
 func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target, error) {
 	opts := getOpts(opt...)
 	if opts.withScopeId == "" && opts.withUserId == "" {
@@ -139,7 +144,7 @@ func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target,
 }
 
 // list will return a listing of resources and honor the WithLimit option or the
-// repo defaultLimit
+// repo defaultLimit, and the WithPageAfter option
 func (r *Repository) list(ctx context.Context, resources interface{}, where string, args []interface{}, opt ...Option) error {
 	opts := getOpts(opt...)
 	limit := r.defaultLimit
@@ -148,7 +153,7 @@ func (r *Repository) list(ctx context.Context, resources interface{}, where stri
 		// non-zero signals an override of the default limit for the repo.
 		limit = opts.withLimit
 	}
-	dbOpts = append(dbOpts, db.WithLimit(limit))
+	dbOpts = append(dbOpts, db.WithLimit(limit), db.WithPageAfter(opts.withPageAfter))
 	return r.reader.SearchWhere(ctx, resources, where, args, dbOpts...)
 }
 
@@ -170,6 +175,16 @@ func (r *Repository) DeleteTarget(ctx context.Context, publicId string, opt ...O
 		tcpT.PublicId = publicId
 		deleteTarget = &tcpT
 		metadata = tcpT.oplog(oplog.OpType_OP_TYPE_DELETE)
+	case UdpTargetType.String():
+		udpT := allocUdpTarget()
+		udpT.PublicId = publicId
+		deleteTarget = &udpT
+		metadata = udpT.oplog(oplog.OpType_OP_TYPE_DELETE)
+	case HttpTargetType.String():
+		httpT := allocHttpTarget()
+		httpT.PublicId = publicId
+		deleteTarget = &httpT
+		metadata = httpT.oplog(oplog.OpType_OP_TYPE_DELETE)
 	default:
 		return db.NoRowsAffected, fmt.Errorf("delete target: %s is an unsupported target type %s", publicId, t.Type)
 	}
@@ -305,6 +320,19 @@ func (r *Repository) AddTargetHostSets(ctx context.Context, targetId string, tar
 		target = &tcpT
 		metadata = tcpT.oplog(oplog.OpType_OP_TYPE_UPDATE)
 		metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_CREATE.String())
+	case UdpTargetType.String():
+		udpT := allocUdpTarget()
+		udpT.PublicId = t.PublicId
+		udpT.Version = targetVersion + 1
+		target = &udpT
+		metadata = udpT.oplog(oplog.OpType_OP_TYPE_UPDATE)
+	case HttpTargetType.String():
+		httpT := allocHttpTarget()
+		httpT.PublicId = t.PublicId
+		httpT.Version = targetVersion + 1
+		target = &httpT
+		metadata = httpT.oplog(oplog.OpType_OP_TYPE_UPDATE)
+		metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_CREATE.String())
 	default:
 		return nil, nil, fmt.Errorf("delete target host sets: %s is an unsupported target type %s", t.PublicId, t.Type)
 	}
@@ -396,6 +424,19 @@ func (r *Repository) DeleteTargeHostSets(ctx context.Context, targetId string, t
 		target = &tcpT
 		metadata = tcpT.oplog(oplog.OpType_OP_TYPE_UPDATE)
 		metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_DELETE.String())
+	case UdpTargetType.String():
+		udpT := allocUdpTarget()
+		udpT.PublicId = t.PublicId
+		udpT.Version = targetVersion + 1
+		target = &udpT
+		metadata = udpT.oplog(oplog.OpType_OP_TYPE_UPDATE)
+	case HttpTargetType.String():
+		httpT := allocHttpTarget()
+		httpT.PublicId = t.PublicId
+		httpT.Version = targetVersion + 1
+		target = &httpT
+		metadata = httpT.oplog(oplog.OpType_OP_TYPE_UPDATE)
+		metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_DELETE.String())
 	default:
 		return db.NoRowsAffected, fmt.Errorf("delete target host sets: %s is an unsupported target type %s", t.PublicId, t.Type)
 	}
@@ -517,6 +558,18 @@ func (r *Repository) SetTargetHostSets(ctx context.Context, targetId string, tar
 		tcpT.Version = targetVersion + 1
 		target = &tcpT
 		metadata = tcpT.oplog(oplog.OpType_OP_TYPE_UPDATE)
+	case UdpTargetType.String():
+		udpT := allocUdpTarget()
+		udpT.PublicId = t.PublicId
+		udpT.Version = targetVersion + 1
+		target = &udpT
+		metadata = udpT.oplog(oplog.OpType_OP_TYPE_UPDATE)
+	case HttpTargetType.String():
+		httpT := allocHttpTarget()
+		httpT.PublicId = t.PublicId
+		httpT.Version = targetVersion + 1
+		target = &httpT
+		metadata = httpT.oplog(oplog.OpType_OP_TYPE_UPDATE)
 	default:
 		return nil, db.NoRowsAffected, fmt.Errorf("set target host sets: %s is an unsupported target type %s", t.PublicId, t.Type)
 	}
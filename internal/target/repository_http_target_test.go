@@ -0,0 +1,109 @@
+package target
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateHttpTarget(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	cats := static.TestCatalogs(t, conn, proj.PublicId, 1)
+	hsets := static.TestSets(t, conn, cats[0].GetPublicId(), 1)
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		target, err := NewHttpTarget(proj.PublicId,
+			WithName("admin-ui"),
+			WithDefaultPort(8443),
+			WithUseTls(true),
+			WithAllowedMethods([]string{"GET"}),
+			WithInjectedHeaders([]string{"X-Forwarded-User: boundary"}))
+		require.NoError(err)
+		got, gotSets, err := repo.CreateHttpTarget(context.Background(), target, WithHostSets([]string{hsets[0].PublicId}))
+		require.NoError(err)
+		assert.True(strings.HasPrefix(got.GetPublicId(), HttpTargetPrefix+"_"))
+		assert.Equal("http", got.GetType())
+		assert.Equal(uint32(8443), got.GetDefaultPort())
+		require.Len(gotSets, 1)
+		assert.Equal(hsets[0].PublicId, gotSets[0].PublicId)
+
+		found, _, err := repo.LookupTarget(context.Background(), got.GetPublicId())
+		require.NoError(err)
+		require.IsType(&HttpTarget{}, found)
+		httpTarget := found.(*HttpTarget)
+		assert.True(httpTarget.GetUseTls())
+		assert.Equal([]string{"GET"}, httpTarget.Methods())
+		assert.Equal([]string{"X-Forwarded-User: boundary"}, httpTarget.Headers())
+		assert.False(found.GetRecordSessions())
+	})
+	t.Run("nil-target", func(t *testing.T) {
+		_, _, err := repo.CreateHttpTarget(context.Background(), nil)
+		assert.True(t, errors.Is(err, db.ErrInvalidParameter))
+	})
+	t.Run("tcp-public-id", func(t *testing.T) {
+		target, err := NewHttpTarget(proj.PublicId, WithName("wrong-prefix"))
+		require.NoError(t, err)
+		id, err := newTcpTargetId()
+		require.NoError(t, err)
+		_, _, err = repo.CreateHttpTarget(context.Background(), target, WithPublicId(id))
+		assert.True(t, errors.Is(err, db.ErrInvalidPublicId))
+	})
+}
+
+func TestRepository_UpdateHttpTarget(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+
+	repo, err := NewRepository(rw, rw, testKms)
+	require.NoError(err)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	tar := TestHttpTarget(t, conn, proj.PublicId, "admin-ui", WithAllowedMethods([]string{"GET"}))
+	tar.Description = "admin web ui"
+	tar.AllowedMethods = ""
+	tar.AllowedPathPrefixes = "/admin/"
+	got, _, updated, err := repo.UpdateHttpTarget(ctx, tar, tar.Version, []string{"Description", "AllowedMethods", "AllowedPathPrefixes"})
+	require.NoError(err)
+	assert.Equal(1, updated)
+	assert.Equal("admin web ui", got.GetDescription())
+	assert.Empty(got.(*HttpTarget).Methods())
+	assert.Equal([]string{"/admin/"}, got.(*HttpTarget).PathPrefixes())
+
+	_, _, _, err = repo.UpdateHttpTarget(ctx, tar, got.GetVersion(), []string{"RecordSessions"})
+	assert.True(errors.Is(err, db.ErrInvalidFieldMask))
+
+	TestTcpTarget(t, conn, proj.PublicId, "ssh")
+	targets, err := repo.ListTargets(ctx, WithScopeId(proj.PublicId))
+	require.NoError(err)
+	types := make([]string, 0, len(targets))
+	for _, t := range targets {
+		types = append(types, t.GetType())
+	}
+	assert.ElementsMatch([]string{"tcp", "http"}, types)
+
+	deleted, err := repo.DeleteTarget(ctx, tar.PublicId)
+	require.NoError(err)
+	assert.Equal(1, deleted)
+}
@@ -0,0 +1,257 @@
+package target
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// ListTargetCredentialIds returns the ids of the credentials attached to the
+// target (targetId), sorted.
+func (r *Repository) ListTargetCredentialIds(ctx context.Context, targetId string) ([]string, error) {
+	if targetId == "" {
+		return nil, fmt.Errorf("list target credentials: missing target id: %w", db.ErrInvalidParameter)
+	}
+	return fetchCredentialIds(ctx, r.reader, targetId)
+}
+
+func fetchCredentialIds(ctx context.Context, r db.Reader, targetId string) ([]string, error) {
+	var tcs []*TargetCredential
+	if err := r.SearchWhere(ctx, &tcs, "target_id = ?", []interface{}{targetId}); err != nil {
+		return nil, fmt.Errorf("fetch credentials: %w", err)
+	}
+	ids := make([]string, 0, len(tcs))
+	for _, tc := range tcs {
+		ids = append(ids, tc.CredentialId)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// AddTargetCredentials attaches credentials (credentialIds) to a target
+// (targetId). The credentials must be in a credential store in the scope of
+// the target. The target's current db version must match the targetVersion
+// or an error will be returned. The target and the ids of its current
+// credentials will be returned on success.
+func (r *Repository) AddTargetCredentials(ctx context.Context, targetId string, targetVersion uint32, credentialIds []string, opt ...Option) (Target, []string, error) {
+	if targetId == "" {
+		return nil, nil, fmt.Errorf("add target credentials: missing target id: %w", db.ErrInvalidParameter)
+	}
+	if targetVersion == 0 {
+		return nil, nil, fmt.Errorf("add target credentials: version cannot be zero: %w", db.ErrInvalidParameter)
+	}
+	if len(credentialIds) == 0 {
+		return nil, nil, fmt.Errorf("add target credentials: missing credential ids: %w", db.ErrInvalidParameter)
+	}
+	adds, err := targetCredentialItems(targetId, credentialIds)
+	if err != nil {
+		return nil, nil, fmt.Errorf("add target credentials: %w", err)
+	}
+	target, current, _, err := r.changeTargetCredentials(ctx, targetId, targetVersion, adds, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("add target credentials: %w", err)
+	}
+	return target, current, nil
+}
+
+// DeleteTargetCredentials detaches credentials (credentialIds) from a target
+// (targetId). The target's current db version must match the targetVersion
+// or an error will be returned. The number of detached credentials is
+// returned on success.
+func (r *Repository) DeleteTargetCredentials(ctx context.Context, targetId string, targetVersion uint32, credentialIds []string, opt ...Option) (int, error) {
+	if targetId == "" {
+		return db.NoRowsAffected, fmt.Errorf("delete target credentials: missing target id: %w", db.ErrInvalidParameter)
+	}
+	if targetVersion == 0 {
+		return db.NoRowsAffected, fmt.Errorf("delete target credentials: version cannot be zero: %w", db.ErrInvalidParameter)
+	}
+	if len(credentialIds) == 0 {
+		return db.NoRowsAffected, fmt.Errorf("delete target credentials: missing credential ids: %w", db.ErrInvalidParameter)
+	}
+	deletes, err := targetCredentialItems(targetId, credentialIds)
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("delete target credentials: %w", err)
+	}
+	_, _, rowsAffected, err := r.changeTargetCredentials(ctx, targetId, targetVersion, nil, deletes)
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("delete target credentials: %w", err)
+	}
+	return rowsAffected, nil
+}
+
+// SetTargetCredentials will set the target's credentials. Set adds and/or
+// deletes target credentials as needed to reconcile the existing
+// credentials with the credentials requested. If credentialIds is empty,
+// the credentials of the target will be cleared. The ids of the current
+// credentials of the target and the number of attached and detached
+// credentials are returned on success.
+func (r *Repository) SetTargetCredentials(ctx context.Context, targetId string, targetVersion uint32, credentialIds []string, opt ...Option) ([]string, int, error) {
+	if targetId == "" {
+		return nil, db.NoRowsAffected, fmt.Errorf("set target credentials: missing target id: %w", db.ErrInvalidParameter)
+	}
+	if targetVersion == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("set target credentials: version cannot be zero: %w", db.ErrInvalidParameter)
+	}
+
+	// NOTE: like SetTargetHostSets, calculating the changes can safely happen
+	// outside of the write transaction since targetVersion ensures the target
+	// has not changed in the meantime.
+	currentIds, err := fetchCredentialIds(ctx, r.reader, targetId)
+	if err != nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("set target credentials: unable to search for existing target credentials: %w", err)
+	}
+	found := make(map[string]bool, len(currentIds))
+	for _, id := range currentIds {
+		found[id] = true
+	}
+	var addIds []string
+	for _, id := range credentialIds {
+		if found[id] {
+			delete(found, id)
+			continue
+		}
+		addIds = append(addIds, id)
+	}
+	var deleteIds []string
+	for id := range found {
+		deleteIds = append(deleteIds, id)
+	}
+	if len(addIds) == 0 && len(deleteIds) == 0 {
+		return currentIds, db.NoRowsAffected, nil
+	}
+	adds, err := targetCredentialItems(targetId, addIds)
+	if err != nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("set target credentials: %w", err)
+	}
+	deletes, err := targetCredentialItems(targetId, deleteIds)
+	if err != nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("set target credentials: %w", err)
+	}
+	_, current, rowsAffected, err := r.changeTargetCredentials(ctx, targetId, targetVersion, adds, deletes)
+	if err != nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("set target credentials: %w", err)
+	}
+	return current, rowsAffected, nil
+}
+
+func targetCredentialItems(targetId string, credentialIds []string) ([]interface{}, error) {
+	items := make([]interface{}, 0, len(credentialIds))
+	for _, id := range credentialIds {
+		tc, err := NewTargetCredential(targetId, id)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create in memory target credential: %w", err)
+		}
+		items = append(items, tc)
+	}
+	return items, nil
+}
+
+// changeTargetCredentials creates adds and deletes deletes in a single
+// transaction which also updates the version of the target. It returns the
+// updated target, the ids of its current credentials and the number of
+// created and deleted target credentials.
+func (r *Repository) changeTargetCredentials(ctx context.Context, targetId string, targetVersion uint32, adds, deletes []interface{}) (Target, []string, int, error) {
+	t := allocTargetView()
+	t.PublicId = targetId
+	if err := r.reader.LookupByPublicId(ctx, &t); err != nil {
+		return nil, nil, db.NoRowsAffected, fmt.Errorf("failed %w for %s", err, targetId)
+	}
+	var metadata oplog.Metadata
+	var target interface{}
+	switch t.Type {
+	case TcpTargetType.String():
+		tcpT := allocTcpTarget()
+		tcpT.PublicId = t.PublicId
+		tcpT.Version = targetVersion + 1
+		target = &tcpT
+		metadata = tcpT.oplog(oplog.OpType_OP_TYPE_UPDATE)
+	case UdpTargetType.String():
+		udpT := allocUdpTarget()
+		udpT.PublicId = t.PublicId
+		udpT.Version = targetVersion + 1
+		target = &udpT
+		metadata = udpT.oplog(oplog.OpType_OP_TYPE_UPDATE)
+	case HttpTargetType.String():
+		httpT := allocHttpTarget()
+		httpT.PublicId = t.PublicId
+		httpT.Version = targetVersion + 1
+		target = &httpT
+		metadata = httpT.oplog(oplog.OpType_OP_TYPE_UPDATE)
+	default:
+		return nil, nil, db.NoRowsAffected, fmt.Errorf("%s is an unsupported target type %s", t.PublicId, t.Type)
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, t.GetScopeId(), kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, fmt.Errorf("unable to get oplog wrapper: %w", err)
+	}
+
+	var totalRowsAffected int
+	var currentIds []string
+	var updatedTarget interface{}
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 2)
+			targetTicket, err := w.GetTicket(target)
+			if err != nil {
+				return fmt.Errorf("unable to get ticket: %w", err)
+			}
+			updatedTarget = target.(Cloneable).Clone()
+			var targetOplogMsg oplog.Message
+			rowsUpdated, err := w.Update(ctx, updatedTarget, []string{"Version"}, nil, db.NewOplogMsg(&targetOplogMsg), db.WithVersion(&targetVersion))
+			if err != nil {
+				return fmt.Errorf("unable to update target version: %w", err)
+			}
+			if rowsUpdated != 1 {
+				return fmt.Errorf("updated target and %d rows updated", rowsUpdated)
+			}
+			msgs = append(msgs, &targetOplogMsg)
+
+			if len(adds) > 0 {
+				addMsgs := make([]*oplog.Message, 0, len(adds))
+				if err := w.CreateItems(ctx, adds, db.NewOplogMsgs(&addMsgs)); err != nil {
+					return fmt.Errorf("unable to add target credentials: %w", err)
+				}
+				totalRowsAffected += len(adds)
+				msgs = append(msgs, addMsgs...)
+				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_CREATE.String())
+			}
+			if len(deletes) > 0 {
+				deleteMsgs := make([]*oplog.Message, 0, len(deletes))
+				rowsDeleted, err := w.DeleteItems(ctx, deletes, db.NewOplogMsgs(&deleteMsgs))
+				if err != nil {
+					return fmt.Errorf("unable to delete target credentials: %w", err)
+				}
+				if rowsDeleted != len(deletes) {
+					return fmt.Errorf("target credentials deleted %d did not match request for %d", rowsDeleted, len(deletes))
+				}
+				totalRowsAffected += rowsDeleted
+				msgs = append(msgs, deleteMsgs...)
+				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_DELETE.String())
+			}
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, targetTicket, metadata, msgs); err != nil {
+				return fmt.Errorf("unable to write oplog: %w", err)
+			}
+			currentIds, err = fetchCredentialIds(ctx, reader, targetId)
+			if err != nil {
+				return fmt.Errorf("unable to retrieve current target credentials: %w", err)
+			}
+			// The version update above ensures the credential injection of
+			// the target hasn't changed since it was looked up
+			if t.CredentialInjection != "" && len(currentIds) > 1 {
+				return fmt.Errorf("target %s has %d credentials: %w", targetId, len(currentIds), ErrMultipleInjectedCredentials)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, err
+	}
+	return updatedTarget.(Target), currentIds, totalRowsAffected, nil
+}
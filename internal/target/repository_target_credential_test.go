@@ -0,0 +1,109 @@
+package target
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_TargetCredentials(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+	_, otherProj := iam.TestScopes(t, iamRepo)
+	ctx := context.Background()
+
+	credWrapper, err := testKms.GetWrapper(ctx, proj.PublicId, kms.KeyPurposeCredentials)
+	require.NoError(t, err)
+	cs := credential.TestCredentialStores(t, conn, proj.PublicId, 1)[0]
+	creds := credential.TestCredentials(t, conn, credWrapper, cs.PublicId, 3)
+
+	otherWrapper, err := testKms.GetWrapper(ctx, otherProj.PublicId, kms.KeyPurposeCredentials)
+	require.NoError(t, err)
+	otherCs := credential.TestCredentialStores(t, conn, otherProj.PublicId, 1)[0]
+	otherCred := credential.TestCredentials(t, conn, otherWrapper, otherCs.PublicId, 1)[0]
+
+	repo, err := NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+
+	t.Run("lifecycle", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar := TestTcpTarget(t, conn, proj.PublicId, "lifecycle")
+
+		got, ids, err := repo.AddTargetCredentials(ctx, tar.PublicId, tar.Version, []string{creds[0].PublicId, creds[1].PublicId})
+		require.NoError(err)
+		assert.Equal(tar.Version+1, got.GetVersion())
+		assert.ElementsMatch([]string{creds[0].PublicId, creds[1].PublicId}, ids)
+
+		ids, n, err := repo.SetTargetCredentials(ctx, tar.PublicId, got.GetVersion(), []string{creds[1].PublicId, creds[2].PublicId})
+		require.NoError(err)
+		assert.Equal(2, n)
+		assert.ElementsMatch([]string{creds[1].PublicId, creds[2].PublicId}, ids)
+
+		n, err = repo.DeleteTargetCredentials(ctx, tar.PublicId, got.GetVersion()+1, []string{creds[1].PublicId})
+		require.NoError(err)
+		assert.Equal(1, n)
+
+		ids, err = repo.ListTargetCredentialIds(ctx, tar.PublicId)
+		require.NoError(err)
+		assert.Equal([]string{creds[2].PublicId}, ids)
+	})
+
+	t.Run("bad-version", func(t *testing.T) {
+		tar := TestTcpTarget(t, conn, proj.PublicId, "bad-version")
+		_, _, err := repo.AddTargetCredentials(ctx, tar.PublicId, tar.Version+10, []string{creds[0].PublicId})
+		assert.Error(t, err)
+	})
+
+	t.Run("other-scope", func(t *testing.T) {
+		tar := TestTcpTarget(t, conn, proj.PublicId, "other-scope")
+		_, _, err := repo.AddTargetCredentials(ctx, tar.PublicId, tar.Version, []string{otherCred.PublicId})
+		assert.Error(t, err)
+	})
+
+	t.Run("credential-injection", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar := TestTcpTarget(t, conn, proj.PublicId, "injection")
+		tar.CredentialInjection = PostgresCredentialInjection
+		got, _, _, err := repo.UpdateTcpTarget(ctx, tar, tar.Version, []string{"CredentialInjection"})
+		require.NoError(err)
+		assert.Equal(PostgresCredentialInjection, got.GetCredentialInjection())
+
+		tar.CredentialInjection = "mysql"
+		_, _, _, err = repo.UpdateTcpTarget(ctx, tar, got.GetVersion(), []string{"CredentialInjection"})
+		assert.Error(err)
+
+		// Targets injecting their credential can only have one
+		got, _, err = repo.AddTargetCredentials(ctx, tar.PublicId, got.GetVersion(), []string{creds[0].PublicId})
+		require.NoError(err)
+		_, _, err = repo.AddTargetCredentials(ctx, tar.PublicId, got.GetVersion(), []string{creds[1].PublicId})
+		assert.True(errors.Is(err, ErrMultipleInjectedCredentials), "got error %v", err)
+		_, _, err = repo.SetTargetCredentials(ctx, tar.PublicId, got.GetVersion(), []string{creds[1].PublicId, creds[2].PublicId})
+		assert.True(errors.Is(err, ErrMultipleInjectedCredentials), "got error %v", err)
+		ids, err := repo.ListTargetCredentialIds(ctx, tar.PublicId)
+		require.NoError(err)
+		assert.Equal([]string{creds[0].PublicId}, ids)
+	})
+
+	t.Run("credential-injection-with-credentials", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar := TestTcpTarget(t, conn, proj.PublicId, "injection-credentials")
+		got, _, err := repo.AddTargetCredentials(ctx, tar.PublicId, tar.Version, []string{creds[0].PublicId, creds[1].PublicId})
+		require.NoError(err)
+
+		tar.CredentialInjection = PostgresCredentialInjection
+		_, _, _, err = repo.UpdateTcpTarget(ctx, tar, got.GetVersion(), []string{"CredentialInjection"})
+		assert.True(errors.Is(err, ErrMultipleInjectedCredentials), "got error %v", err)
+	})
+}
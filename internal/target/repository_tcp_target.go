@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/sdk/strutil"
 )
 
 // CreateTcpTarget inserts into the repository and returns the new Target with
@@ -127,6 +128,11 @@ func (r *Repository) UpdateTcpTarget(ctx context.Context, target *TcpTarget, ver
 		case strings.EqualFold("defaultport", f):
 		case strings.EqualFold("sessionmaxseconds", f):
 		case strings.EqualFold("sessionconnectionlimit", f):
+		case strings.EqualFold("recordsessions", f):
+		case strings.EqualFold("credentialinjection", f):
+		case strings.EqualFold("workerfilter", f):
+		case strings.EqualFold("sessionmaxbytespersecond", f):
+		case strings.EqualFold("connectionidletimeoutseconds", f):
 		default:
 			return nil, nil, db.NoRowsAffected, fmt.Errorf("update tcp target: field: %s: %w", f, db.ErrInvalidFieldMask)
 		}
@@ -134,18 +140,34 @@ func (r *Repository) UpdateTcpTarget(ctx context.Context, target *TcpTarget, ver
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbcommon.BuildUpdatePaths(
 		map[string]interface{}{
-			"Name":                   target.Name,
-			"Description":            target.Description,
-			"DefaultPort":            target.DefaultPort,
-			"SessionMaxSeconds":      target.SessionMaxSeconds,
-			"SessionConnectionLimit": target.SessionConnectionLimit,
+			"Name":                         target.Name,
+			"Description":                  target.Description,
+			"DefaultPort":                  target.DefaultPort,
+			"SessionMaxSeconds":            target.SessionMaxSeconds,
+			"SessionConnectionLimit":       target.SessionConnectionLimit,
+			"RecordSessions":               target.RecordSessions,
+			"CredentialInjection":          target.CredentialInjection,
+			"WorkerFilter":                 target.WorkerFilter,
+			"SessionMaxBytesPerSecond":     target.SessionMaxBytesPerSecond,
+			"ConnectionIdleTimeoutSeconds": target.ConnectionIdleTimeoutSeconds,
 		},
 		fieldMaskPaths,
-		[]string{"SessionMaxSeconds", "SessionConnectionLimit"},
+		[]string{"SessionMaxSeconds", "SessionConnectionLimit", "RecordSessions", "SessionMaxBytesPerSecond", "ConnectionIdleTimeoutSeconds"},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, nil, db.NoRowsAffected, fmt.Errorf("update tcp target: %w", db.ErrEmptyFieldMask)
 	}
+	if target.CredentialInjection != "" && strutil.StrListContains(dbMask, "CredentialInjection") {
+		// A concurrent change to the credentials of the target updates its
+		// version as well, so the update fails if they changed since
+		credIds, err := fetchCredentialIds(ctx, r.reader, target.PublicId)
+		if err != nil {
+			return nil, nil, db.NoRowsAffected, fmt.Errorf("update tcp target: %w", err)
+		}
+		if len(credIds) > 1 {
+			return nil, nil, db.NoRowsAffected, fmt.Errorf("update tcp target: target %s has %d credentials: %w", target.PublicId, len(credIds), ErrMultipleInjectedCredentials)
+		}
+	}
 	var returnedTarget Target
 	var rowsUpdated int
 	var targetSets []*TargetSet
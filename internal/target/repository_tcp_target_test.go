@@ -420,3 +420,68 @@ func TestRepository_UpdateTcpTarget(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_UpdateTcpTarget_WorkerFilter(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+
+	repo, err := NewRepository(rw, rw, testKms)
+	require.NoError(err)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	tar := TestTcpTarget(t, conn, proj.PublicId, "worker-filter")
+	tar.WorkerFilter = `"vpc-a" in tags.network`
+	got, _, _, err := repo.UpdateTcpTarget(ctx, tar, tar.Version, []string{"WorkerFilter"})
+	require.NoError(err)
+	assert.Equal(`"vpc-a" in tags.network`, got.GetWorkerFilter())
+
+	found, _, err := repo.LookupTarget(ctx, tar.PublicId)
+	require.NoError(err)
+	assert.Equal(`"vpc-a" in tags.network`, found.GetWorkerFilter())
+
+	tar.WorkerFilter = ""
+	got, _, _, err = repo.UpdateTcpTarget(ctx, tar, got.GetVersion(), []string{"WorkerFilter"})
+	require.NoError(err)
+	assert.Empty(got.GetWorkerFilter())
+}
+
+func TestRepository_UpdateTcpTarget_BandwidthAndIdleTimeout(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+
+	repo, err := NewRepository(rw, rw, testKms)
+	require.NoError(err)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	tar := TestTcpTarget(t, conn, proj.PublicId, "bandwidth-idle-timeout")
+	tar.SessionMaxBytesPerSecond = 1024
+	tar.ConnectionIdleTimeoutSeconds = 300
+	got, _, _, err := repo.UpdateTcpTarget(ctx, tar, tar.Version, []string{"SessionMaxBytesPerSecond", "ConnectionIdleTimeoutSeconds"})
+	require.NoError(err)
+	assert.Equal(uint32(1024), got.GetSessionMaxBytesPerSecond())
+	assert.Equal(uint32(300), got.GetConnectionIdleTimeoutSeconds())
+
+	found, _, err := repo.LookupTarget(ctx, tar.PublicId)
+	require.NoError(err)
+	assert.Equal(uint32(1024), found.GetSessionMaxBytesPerSecond())
+	assert.Equal(uint32(300), found.GetConnectionIdleTimeoutSeconds())
+
+	tar.SessionMaxBytesPerSecond = 0
+	tar.ConnectionIdleTimeoutSeconds = 0
+	got, _, _, err = repo.UpdateTcpTarget(ctx, tar, got.GetVersion(), []string{"SessionMaxBytesPerSecond", "ConnectionIdleTimeoutSeconds"})
+	require.NoError(err)
+	assert.Zero(got.GetSessionMaxBytesPerSecond())
+	assert.Zero(got.GetConnectionIdleTimeoutSeconds())
+}
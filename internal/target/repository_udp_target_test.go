@@ -0,0 +1,102 @@
+package target
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateUdpTarget(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	cats := static.TestCatalogs(t, conn, proj.PublicId, 1)
+	hsets := static.TestSets(t, conn, cats[0].GetPublicId(), 1)
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		target, err := NewUdpTarget(proj.PublicId,
+			WithName("dns"),
+			WithDefaultPort(53),
+			WithConnectionIdleTimeoutSeconds(30))
+		require.NoError(err)
+		got, gotSets, err := repo.CreateUdpTarget(context.Background(), target, WithHostSets([]string{hsets[0].PublicId}))
+		require.NoError(err)
+		assert.True(strings.HasPrefix(got.GetPublicId(), UdpTargetPrefix+"_"))
+		assert.Equal("udp", got.GetType())
+		assert.Equal(uint32(53), got.GetDefaultPort())
+		require.Len(gotSets, 1)
+		assert.Equal(hsets[0].PublicId, gotSets[0].PublicId)
+
+		found, _, err := repo.LookupTarget(context.Background(), got.GetPublicId())
+		require.NoError(err)
+		require.IsType(&UdpTarget{}, found)
+		assert.Equal(uint32(30), found.GetConnectionIdleTimeoutSeconds())
+		assert.False(found.GetRecordSessions())
+	})
+	t.Run("nil-target", func(t *testing.T) {
+		_, _, err := repo.CreateUdpTarget(context.Background(), nil)
+		assert.True(t, errors.Is(err, db.ErrInvalidParameter))
+	})
+	t.Run("tcp-public-id", func(t *testing.T) {
+		target, err := NewUdpTarget(proj.PublicId, WithName("wrong-prefix"))
+		require.NoError(t, err)
+		id, err := newTcpTargetId()
+		require.NoError(t, err)
+		_, _, err = repo.CreateUdpTarget(context.Background(), target, WithPublicId(id))
+		assert.True(t, errors.Is(err, db.ErrInvalidPublicId))
+	})
+}
+
+func TestRepository_UpdateUdpTarget(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+
+	repo, err := NewRepository(rw, rw, testKms)
+	require.NoError(err)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	tar := TestUdpTarget(t, conn, proj.PublicId, "syslog")
+	tar.Description = "syslog receivers"
+	tar.SessionMaxBytesPerSecond = 4096
+	got, _, updated, err := repo.UpdateUdpTarget(ctx, tar, tar.Version, []string{"Description", "SessionMaxBytesPerSecond"})
+	require.NoError(err)
+	assert.Equal(1, updated)
+	assert.Equal("syslog receivers", got.GetDescription())
+	assert.Equal(uint32(4096), got.GetSessionMaxBytesPerSecond())
+
+	_, _, _, err = repo.UpdateUdpTarget(ctx, tar, got.GetVersion(), []string{"RecordSessions"})
+	assert.True(errors.Is(err, db.ErrInvalidFieldMask))
+
+	TestTcpTarget(t, conn, proj.PublicId, "ssh")
+	targets, err := repo.ListTargets(ctx, WithScopeId(proj.PublicId))
+	require.NoError(err)
+	types := make([]string, 0, len(targets))
+	for _, t := range targets {
+		types = append(types, t.GetType())
+	}
+	assert.ElementsMatch([]string{"tcp", "udp"}, types)
+
+	deleted, err := repo.DeleteTarget(ctx, tar.PublicId)
+	require.NoError(err)
+	assert.Equal(1, deleted)
+}
@@ -9,12 +9,18 @@ type SubType int
 const (
 	UnknownSubtype SubType = iota
 	TcpSubType
+	UdpSubType
+	HttpSubType
 )
 
 func (t SubType) String() string {
 	switch t {
 	case TcpSubType:
 		return "tcp"
+	case UdpSubType:
+		return "udp"
+	case HttpSubType:
+		return "http"
 	}
 	return "unknown"
 }
@@ -25,6 +31,10 @@ func SubtypeFromType(t string) SubType {
 	switch {
 	case strings.EqualFold(strings.TrimSpace(t), TcpSubType.String()):
 		return TcpSubType
+	case strings.EqualFold(strings.TrimSpace(t), UdpSubType.String()):
+		return UdpSubType
+	case strings.EqualFold(strings.TrimSpace(t), HttpSubType.String()):
+		return HttpSubType
 	}
 	return UnknownSubtype
 }
@@ -36,6 +46,10 @@ func SubtypeFromId(id string) SubType {
 	switch {
 	case strings.HasPrefix(strings.TrimSpace(id), TcpTargetPrefix):
 		return TcpSubType
+	case strings.HasPrefix(strings.TrimSpace(id), UdpTargetPrefix):
+		return UdpSubType
+	case strings.HasPrefix(strings.TrimSpace(id), HttpTargetPrefix):
+		return HttpSubType
 	}
 	return UnknownSubtype
 }
@@ -21,6 +21,11 @@ type Target interface {
 	GetUpdateTime() *timestamp.Timestamp
 	GetSessionMaxSeconds() uint32
 	GetSessionConnectionLimit() int32
+	GetRecordSessions() bool
+	GetCredentialInjection() string
+	GetWorkerFilter() string
+	GetSessionMaxBytesPerSecond() uint32
+	GetConnectionIdleTimeoutSeconds() uint32
 	oplog(op oplog.OpType) oplog.Metadata
 }
 
@@ -30,6 +35,8 @@ type TargetType uint32
 const (
 	UnknownTargetType TargetType = 0
 	TcpTargetType     TargetType = 1
+	UdpTargetType     TargetType = 2
+	HttpTargetType    TargetType = 3
 )
 
 // String returns a string representation of the target type.
@@ -37,6 +44,8 @@ func (t TargetType) String() string {
 	return [...]string{
 		"unknown",
 		"tcp",
+		"udp",
+		"http",
 	}[t]
 }
 
@@ -92,7 +101,48 @@ func (t *targetView) targetSubType() (Target, error) {
 		tcpTarget.Version = t.Version
 		tcpTarget.SessionMaxSeconds = t.SessionMaxSeconds
 		tcpTarget.SessionConnectionLimit = t.SessionConnectionLimit
+		tcpTarget.RecordSessions = t.RecordSessions
+		tcpTarget.CredentialInjection = t.CredentialInjection
+		tcpTarget.WorkerFilter = t.WorkerFilter
+		tcpTarget.SessionMaxBytesPerSecond = t.SessionMaxBytesPerSecond
+		tcpTarget.ConnectionIdleTimeoutSeconds = t.ConnectionIdleTimeoutSeconds
 		return &tcpTarget, nil
+	case UdpTargetType.String():
+		udpTarget := allocUdpTarget()
+		udpTarget.PublicId = t.PublicId
+		udpTarget.ScopeId = t.ScopeId
+		udpTarget.Name = t.Name
+		udpTarget.Description = t.Description
+		udpTarget.DefaultPort = t.DefaultPort
+		udpTarget.CreateTime = t.CreateTime
+		udpTarget.UpdateTime = t.UpdateTime
+		udpTarget.Version = t.Version
+		udpTarget.SessionMaxSeconds = t.SessionMaxSeconds
+		udpTarget.SessionConnectionLimit = t.SessionConnectionLimit
+		udpTarget.WorkerFilter = t.WorkerFilter
+		udpTarget.SessionMaxBytesPerSecond = t.SessionMaxBytesPerSecond
+		udpTarget.ConnectionIdleTimeoutSeconds = t.ConnectionIdleTimeoutSeconds
+		return &udpTarget, nil
+	case HttpTargetType.String():
+		httpTarget := allocHttpTarget()
+		httpTarget.PublicId = t.PublicId
+		httpTarget.ScopeId = t.ScopeId
+		httpTarget.Name = t.Name
+		httpTarget.Description = t.Description
+		httpTarget.DefaultPort = t.DefaultPort
+		httpTarget.CreateTime = t.CreateTime
+		httpTarget.UpdateTime = t.UpdateTime
+		httpTarget.Version = t.Version
+		httpTarget.SessionMaxSeconds = t.SessionMaxSeconds
+		httpTarget.SessionConnectionLimit = t.SessionConnectionLimit
+		httpTarget.WorkerFilter = t.WorkerFilter
+		httpTarget.SessionMaxBytesPerSecond = t.SessionMaxBytesPerSecond
+		httpTarget.ConnectionIdleTimeoutSeconds = t.ConnectionIdleTimeoutSeconds
+		httpTarget.UseTls = t.UseTls
+		httpTarget.AllowedMethods = t.AllowedMethods
+		httpTarget.AllowedPathPrefixes = t.AllowedPathPrefixes
+		httpTarget.InjectedHeaders = t.InjectedHeaders
+		return &httpTarget, nil
 	}
 	return nil, fmt.Errorf("%s is an unknown target subtype of %s", t.PublicId, t.Type)
 }
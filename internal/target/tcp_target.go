@@ -32,12 +32,17 @@ func NewTcpTarget(scopeId string, opt ...Option) (*TcpTarget, error) {
 	}
 	t := &TcpTarget{
 		TcpTarget: &store.TcpTarget{
-			ScopeId:                scopeId,
-			Name:                   opts.withName,
-			Description:            opts.withDescription,
-			DefaultPort:            opts.withDefaultPort,
-			SessionConnectionLimit: opts.withSessionConnectionLimit,
-			SessionMaxSeconds:      opts.withSessionMaxSeconds,
+			ScopeId:                      scopeId,
+			Name:                         opts.withName,
+			Description:                  opts.withDescription,
+			DefaultPort:                  opts.withDefaultPort,
+			SessionConnectionLimit:       opts.withSessionConnectionLimit,
+			SessionMaxSeconds:            opts.withSessionMaxSeconds,
+			RecordSessions:               opts.withRecordSessions,
+			CredentialInjection:          opts.withCredentialInjection,
+			WorkerFilter:                 opts.withWorkerFilter,
+			SessionMaxBytesPerSecond:     opts.withMaxBytesPerSecond,
+			ConnectionIdleTimeoutSeconds: opts.withIdleTimeoutSeconds,
 		},
 	}
 	return t, nil
@@ -38,6 +38,60 @@ func TestTcpTarget(t *testing.T, conn *gorm.DB, scopeId, name string, opt ...Opt
 	return target
 }
 
+func TestUdpTarget(t *testing.T, conn *gorm.DB, scopeId, name string, opt ...Option) *UdpTarget {
+	t.Helper()
+	opt = append(opt, WithName(name))
+	opts := getOpts(opt...)
+	require := require.New(t)
+	rw := db.New(conn)
+	target, err := NewUdpTarget(scopeId, opt...)
+	require.NoError(err)
+	id, err := newUdpTargetId()
+	require.NoError(err)
+	target.PublicId = id
+	err = rw.Create(context.Background(), target)
+	require.NoError(err)
+
+	if len(opts.withHostSets) > 0 {
+		newHostSets := make([]interface{}, 0, len(opts.withHostSets))
+		for _, s := range opts.withHostSets {
+			hostSet, err := NewTargetHostSet(target.PublicId, s)
+			require.NoError(err)
+			newHostSets = append(newHostSets, hostSet)
+		}
+		err := rw.CreateItems(context.Background(), newHostSets)
+		require.NoError(err)
+	}
+	return target
+}
+
+func TestHttpTarget(t *testing.T, conn *gorm.DB, scopeId, name string, opt ...Option) *HttpTarget {
+	t.Helper()
+	opt = append(opt, WithName(name))
+	opts := getOpts(opt...)
+	require := require.New(t)
+	rw := db.New(conn)
+	target, err := NewHttpTarget(scopeId, opt...)
+	require.NoError(err)
+	id, err := newHttpTargetId()
+	require.NoError(err)
+	target.PublicId = id
+	err = rw.Create(context.Background(), target)
+	require.NoError(err)
+
+	if len(opts.withHostSets) > 0 {
+		newHostSets := make([]interface{}, 0, len(opts.withHostSets))
+		for _, s := range opts.withHostSets {
+			hostSet, err := NewTargetHostSet(target.PublicId, s)
+			require.NoError(err)
+			newHostSets = append(newHostSets, hostSet)
+		}
+		err := rw.CreateItems(context.Background(), newHostSets)
+		require.NoError(err)
+	}
+	return target
+}
+
 func testTargetName(t *testing.T, scopeId string) string {
 	t.Helper()
 	return fmt.Sprintf("%s-%s", scopeId, testId(t))
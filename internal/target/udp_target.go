@@ -0,0 +1,122 @@
+package target
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target/store"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DefaultUdpTableName = "target_udp"
+)
+
+type UdpTarget struct {
+	*store.UdpTarget
+	tableName string `gorm:"-"`
+}
+
+var _ Target = (*UdpTarget)(nil)
+var _ db.VetForWriter = (*UdpTarget)(nil)
+var _ oplog.ReplayableMessage = (*UdpTarget)(nil)
+
+// NewUdpTarget creates a new in memory udp target.  WithName, WithDescription,
+// WithDefaultPort, WithSessionMaxSeconds, WithSessionConnectionLimit,
+// WithWorkerFilter, WithSessionMaxBytesPerSecond and
+// WithConnectionIdleTimeoutSeconds options are supported
+func NewUdpTarget(scopeId string, opt ...Option) (*UdpTarget, error) {
+	opts := getOpts(opt...)
+	if scopeId == "" {
+		return nil, fmt.Errorf("new udp target: missing scope id: %w", db.ErrInvalidParameter)
+	}
+	t := &UdpTarget{
+		UdpTarget: &store.UdpTarget{
+			ScopeId:                      scopeId,
+			Name:                         opts.withName,
+			Description:                  opts.withDescription,
+			DefaultPort:                  opts.withDefaultPort,
+			SessionConnectionLimit:       opts.withSessionConnectionLimit,
+			SessionMaxSeconds:            opts.withSessionMaxSeconds,
+			WorkerFilter:                 opts.withWorkerFilter,
+			SessionMaxBytesPerSecond:     opts.withMaxBytesPerSecond,
+			ConnectionIdleTimeoutSeconds: opts.withIdleTimeoutSeconds,
+		},
+	}
+	return t, nil
+}
+
+// allocUdpTarget will allocate a udp target
+func allocUdpTarget() UdpTarget {
+	return UdpTarget{
+		UdpTarget: &store.UdpTarget{},
+	}
+}
+
+// Clone creates a clone of the UdpTarget
+func (t *UdpTarget) Clone() interface{} {
+	cp := proto.Clone(t.UdpTarget)
+	return &UdpTarget{
+		UdpTarget: cp.(*store.UdpTarget),
+	}
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the udp target
+// before it's written.
+func (t *UdpTarget) VetForWrite(ctx context.Context, r db.Reader, opType db.OpType, opt ...db.Option) error {
+	if t.PublicId == "" {
+		return fmt.Errorf("udp target vet for write: missing public id: %w", db.ErrInvalidParameter)
+	}
+	if opType == db.CreateOp {
+		if t.ScopeId == "" {
+			return fmt.Errorf("udp target vet for write: missing scope id: %w", db.ErrInvalidParameter)
+		}
+		if t.Name == "" {
+			return fmt.Errorf("udp target vet for write: missing name id: %w", db.ErrInvalidParameter)
+		}
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (t *UdpTarget) TableName() string {
+	if t.tableName != "" {
+		return t.tableName
+	}
+	return DefaultUdpTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (t *UdpTarget) SetTableName(n string) {
+	t.tableName = n
+}
+
+func (t *UdpTarget) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{t.PublicId},
+		"resource-type":      []string{"udp target"},
+		"op-type":            []string{op.String()},
+		"scope-id":           []string{t.ScopeId},
+	}
+	return metadata
+}
+
+func (t UdpTarget) GetType() string {
+	return "udp"
+}
+
+// GetRecordSessions returns false as the datagrams of udp targets are not
+// recorded.
+func (t *UdpTarget) GetRecordSessions() bool {
+	return false
+}
+
+// GetCredentialInjection returns "" as workers don't inject credentials into
+// udp flows.
+func (t *UdpTarget) GetCredentialInjection() string {
+	return ""
+}
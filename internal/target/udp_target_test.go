@@ -0,0 +1,42 @@
+package target
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUdpTarget(t *testing.T) {
+	t.Parallel()
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := NewUdpTarget("p_1234567890",
+			WithName("dns"),
+			WithDefaultPort(53),
+			WithRecordSessions(true),
+			WithCredentialInjection(PostgresCredentialInjection))
+		require.NoError(err)
+		assert.Equal("dns", got.GetName())
+		assert.Equal(uint32(53), got.GetDefaultPort())
+		assert.Equal("udp", got.GetType())
+		// Neither recording nor credential injection apply to datagrams
+		assert.False(got.GetRecordSessions())
+		assert.Empty(got.GetCredentialInjection())
+	})
+	t.Run("missing-scope-id", func(t *testing.T) {
+		_, err := NewUdpTarget("")
+		assert.True(t, errors.Is(err, db.ErrInvalidParameter))
+	})
+}
+
+func TestSubtype_Udp(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	assert.Equal(UdpSubType, SubtypeFromType("udp"))
+	assert.Equal(UdpSubType, SubtypeFromId(UdpTargetPrefix+"_1234567890"))
+	assert.Equal(TcpSubType, SubtypeFromId(TcpTargetPrefix+"_1234567890"))
+	assert.Equal("udp", UdpTargetType.String())
+}
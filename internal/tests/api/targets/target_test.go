@@ -97,6 +97,44 @@ func TestList(t *testing.T) {
 	assert.ElementsMatch(comparableSlice(expected), comparableSlice(ul.Items))
 }
 
+func TestList_DenyGrant(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	tc := controller.NewTestController(t, nil)
+	defer tc.Shutdown()
+
+	client := tc.Client()
+	token := tc.Token()
+	client.SetToken(token.Token)
+	_, proj := iam.TestScopes(t, tc.IamRepo(), iam.WithUserId(token.UserId))
+
+	tarClient := targets.NewClient(client)
+	var expected []*targets.Target
+	for i := 0; i < 3; i++ {
+		tcr, err := tarClient.Create(tc.Context(), "tcp", proj.GetPublicId(), targets.WithName(fmt.Sprint(i)))
+		require.NoError(err)
+		expected = append(expected, tcr.Item)
+	}
+	// The admin role of the project allows everything on every target
+	ul, err := tarClient.List(tc.Context(), proj.GetPublicId())
+	require.NoError(err)
+	assert.ElementsMatch(comparableSlice(expected), comparableSlice(ul.Items))
+
+	secret := expected[1]
+	role := iam.TestRole(t, tc.DbConn(), proj.GetPublicId())
+	iam.TestUserRole(t, tc.DbConn(), role.GetPublicId(), token.UserId)
+	iam.TestRoleGrant(t, tc.DbConn(), role.GetPublicId(), fmt.Sprintf("id=%s;actions=*;deny=true", secret.Id))
+
+	ul, err = tarClient.List(tc.Context(), proj.GetPublicId())
+	require.NoError(err)
+	assert.ElementsMatch(comparableSlice([]*targets.Target{expected[0], expected[2]}), comparableSlice(ul.Items))
+
+	_, err = tarClient.Read(tc.Context(), secret.Id)
+	require.Error(err)
+	apiErr := api.AsServerError(err)
+	require.NotNil(apiErr)
+	assert.EqualValues(http.StatusForbidden, apiErr.Status)
+}
+
 func comparableSlice(in []*targets.Target) []targets.Target {
 	var filtered []targets.Target
 	for _, i := range in {
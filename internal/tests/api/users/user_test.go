@@ -90,6 +90,25 @@ func TestList(t *testing.T) {
 	ul, err = userClient.List(tc.Context(), org.GetPublicId())
 	require.NoError(err)
 	assert.ElementsMatch(comparableSlice(expected), comparableSlice(ul.Items))
+
+	// Paging through the list yields the same users, in creation order
+	ul, err = userClient.List(tc.Context(), org.GetPublicId(), users.WithPageSize(3))
+	require.NoError(err)
+	assert.Equal(comparableSlice(expected), comparableSlice(ul.Items))
+	assert.Empty(ul.NextPageToken)
+
+	it, err := userClient.ListIterator(tc.Context(), org.GetPublicId(), users.WithPageSize(3))
+	require.NoError(err)
+	var got []*users.User
+	pageTokens := make(map[string]bool)
+	for it.Next() {
+		got = append(got, it.Item())
+		assert.LessOrEqual(len(it.Page().Items), 3)
+		pageTokens[it.Page().NextPageToken] = true
+	}
+	require.NoError(it.Err())
+	assert.Equal(comparableSlice(expected), comparableSlice(got))
+	assert.Len(pageTokens, 4)
 }
 
 func comparableSlice(in []*users.User) []users.User {
@@ -0,0 +1,66 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/servers"
+	"github.com/hashicorp/boundary/internal/servers/controller"
+	"github.com/hashicorp/boundary/internal/servers/worker"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiHopWorkers(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	logger := hclog.New(&hclog.LoggerOptions{
+		Level: hclog.Trace,
+	})
+
+	c1 := controller.NewTestController(t, &controller.TestControllerOpts{
+		Logger: logger.Named("c1"),
+	})
+	defer c1.Shutdown()
+
+	upstream := worker.NewTestWorker(t, &worker.TestWorkerOpts{
+		WorkerAuthKms:      c1.Config().WorkerAuthKms,
+		InitialControllers: c1.ClusterAddrs(),
+		Logger:             logger.Named("upstream"),
+	})
+	defer upstream.Shutdown()
+
+	downstream := worker.NewTestWorker(t, &worker.TestWorkerOpts{
+		WorkerAuthKms:      c1.Config().WorkerAuthKms,
+		InitialControllers: c1.ClusterAddrs(),
+		Upstreams:          upstream.ProxyAddrs(),
+		Logger:             logger.Named("downstream"),
+	})
+	defer downstream.Shutdown()
+
+	time.Sleep(10 * time.Second)
+	assert.Equal([]string{downstream.Name()}, upstream.Worker().DownstreamWorkers())
+
+	// The downstream worker reports its upstream, so clients are sent to the
+	// upstream worker to reach it
+	workers, err := c1.ServersRepo().ListServers(context.Background(), servers.ServerTypeWorker)
+	require.NoError(err)
+	byName := map[string]*servers.Server{}
+	for _, w := range workers {
+		byName[w.GetName()] = w
+	}
+	require.Contains(byName, downstream.Name())
+	require.Contains(byName, upstream.Name())
+	assert.Equal(upstream.Name(), byName[downstream.Name()].GetUpstream())
+	assert.Empty(byName[upstream.Name()].GetUpstream())
+	assert.Equal(
+		[]*servers.Server{byName[upstream.Name()]},
+		servers.EntryWorkers(workers, []*servers.Server{byName[downstream.Name()]}),
+	)
+
+	// Once the downstream worker is gone the tunnel is forgotten
+	require.NoError(downstream.Worker().Shutdown(true))
+	time.Sleep(2 * time.Second)
+	assert.Empty(upstream.Worker().DownstreamWorkers())
+}
@@ -0,0 +1,54 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/servers"
+	"github.com/hashicorp/boundary/internal/servers/controller"
+	"github.com/hashicorp/boundary/internal/servers/worker"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkerDrain(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	logger := hclog.New(&hclog.LoggerOptions{
+		Level: hclog.Trace,
+	})
+
+	c1 := controller.NewTestController(t, &controller.TestControllerOpts{
+		Logger: logger.Named("c1"),
+	})
+	defer c1.Shutdown()
+
+	w1 := worker.NewTestWorker(t, &worker.TestWorkerOpts{
+		WorkerAuthKms:      c1.Config().WorkerAuthKms,
+		InitialControllers: c1.ClusterAddrs(),
+		Logger:             logger.Named("w1"),
+	})
+	defer w1.Shutdown()
+
+	listWorker := func() *servers.Server {
+		workers, err := c1.ServersRepo().ListServers(context.Background(), servers.ServerTypeWorker)
+		require.NoError(err)
+		require.Len(workers, 1)
+		return workers[0]
+	}
+
+	time.Sleep(5 * time.Second)
+	assert.False(listWorker().GetDraining())
+
+	// Without connections draining finishes right away, and the worker is
+	// reported as draining but still alive
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	require.NoError(w1.Worker().Drain(ctx))
+	assert.True(w1.Worker().Draining())
+
+	time.Sleep(5 * time.Second)
+	assert.True(listWorker().GetDraining())
+	assert.Empty(servers.ActiveWorkers([]*servers.Server{listWorker()}))
+}
@@ -75,6 +75,30 @@ func TestAction(t *testing.T) {
 			action: Deauthenticate,
 			want:   "deauthenticate",
 		},
+		{
+			action: DownloadRecording,
+			want:   "download-recording",
+		},
+		{
+			action: CheckPermission,
+			want:   "check-permission",
+		},
+		{
+			action: EffectiveGrants,
+			want:   "effective-grants",
+		},
+		{
+			action: AddCredentials,
+			want:   "add-credentials",
+		},
+		{
+			action: SetCredentials,
+			want:   "set-credentials",
+		},
+		{
+			action: RemoveCredentials,
+			want:   "remove-credentials",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
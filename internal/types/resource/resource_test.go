@@ -76,6 +76,14 @@ func Test_Resource(t *testing.T) {
 			typeString: "session",
 			want:       Session,
 		},
+		{
+			typeString: "credential-store",
+			want:       CredentialStore,
+		},
+		{
+			typeString: "credential",
+			want:       Credential,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.typeString, func(t *testing.T) {
@@ -0,0 +1,53 @@
+// Package watch reads the changes made to sessions, their connections and IAM
+// resources, for clients watching them. The changes of sessions and
+// connections are read from their state tables, and those of scopes, users,
+// groups and roles from the oplog.
+package watch
+
+import "time"
+
+// ChangeType is the type of a change made to a resource
+type ChangeType string
+
+const (
+	Created ChangeType = "created"
+	Updated ChangeType = "updated"
+	Deleted ChangeType = "deleted"
+)
+
+// ConnectionResourceType is the resource type of the changes of the
+// connections of sessions, which are not a resource type of their own
+const ConnectionResourceType = "connection"
+
+// Change is a change made to a resource. Changes are ordered by their create
+// time and id.
+//
+// The change of a session or connection is its move into State: sessions are
+// created pending and connections authorized, and updated by every later
+// state. The changes of IAM resources are those written to the oplog. Changes
+// to the grants and principals of roles and the accounts of users are updates
+// of the role or user.
+type Change struct {
+	// Id is unique to the change
+	Id         string
+	CreateTime time.Time
+	Type       ChangeType
+
+	// ResourceType is the type of the resource changed, as in grants, or
+	// ConnectionResourceType
+	ResourceType string
+	ResourceId   string
+
+	// ScopeId is the scope the resource is in. The scope of scopes is their
+	// parent scope.
+	ScopeId string
+
+	// State is the state of a session or connection after the change
+	State string
+
+	// SessionId, UserId and TargetId are those of the session of a session or
+	// connection
+	SessionId string
+	UserId    string
+	TargetId  string
+}
@@ -0,0 +1,39 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_GetOpts provides unit tests for GetOpts and all the options
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithLimit", func(t *testing.T) {
+		assert := assert.New(t)
+		// test default of 0
+		opts := getOpts()
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 0
+		assert.Equal(opts, testOpts)
+
+		opts = getOpts(WithLimit(-1))
+		testOpts = getDefaultOptions()
+		testOpts.withLimit = -1
+		assert.Equal(opts, testOpts)
+
+		opts = getOpts(WithLimit(1))
+		testOpts = getDefaultOptions()
+		testOpts.withLimit = 1
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithPageAfter", func(t *testing.T) {
+		assert := assert.New(t)
+		after := &db.PageAfter{PublicId: "test"}
+		opts := getOpts(WithPageAfter(after))
+		testOpts := getDefaultOptions()
+		testOpts.withPageAfter = after
+		assert.Equal(opts, testOpts)
+	})
+}
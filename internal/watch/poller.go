@@ -0,0 +1,328 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+const (
+	// pollInterval is how often the poller reads the changes made since it
+	// last did
+	pollInterval = time.Second
+
+	// lookback is how long before the last change they read the poller and
+	// watchers read the changes again, to find those committed after it that
+	// were created before it
+	lookback = 5 * time.Second
+
+	// batchSize is the number of changes read from the database at a time
+	batchSize = 1000
+
+	// watcherBuffer is the number of changes the poller buffers for each
+	// watcher. A watcher further behind reads the changes it missed from the
+	// database.
+	watcherBuffer = 1000
+)
+
+var (
+	// ErrLagged ends the subscription of a watcher which fell behind the
+	// changes read by the poller
+	ErrLagged = errors.New("watch: watcher fell behind the changes")
+
+	// ErrPollerStopped ends the subscriptions of the watchers of a poller
+	// which stopped
+	ErrPollerStopped = errors.New("watch: poller stopped")
+)
+
+// Poller reads the changes made to resources for all the watchers of a
+// controller, so that the database is read once per poll interval however
+// many clients watch the changes. The changes are only read while there are
+// watchers.
+type Poller struct {
+	repoFn func() (*Repository, error)
+
+	l        sync.Mutex
+	watchers map[*Watcher]struct{}
+}
+
+// NewPoller creates a poller reading the changes from the repository returned
+// by repoFn. The poller reads nothing until it is started.
+func NewPoller(repoFn func() (*Repository, error)) (*Poller, error) {
+	if repoFn == nil {
+		return nil, errors.New("error creating poller with nil repository factory")
+	}
+	return &Poller{
+		repoFn:   repoFn,
+		watchers: make(map[*Watcher]struct{}),
+	}, nil
+}
+
+// pollState is the position of a poller in the changes
+type pollState struct {
+	// last is the last change read, or nil if the poller has not read the
+	// changes since it last had watchers
+	last *db.PageAfter
+	// seen holds the IDs of the changes read since lookback before last,
+	// which are read again
+	seen map[string]time.Time
+}
+
+// Start reads the changes every poll interval and sends them to the watchers
+// until ctx is done. If the changes can't be read the subscriptions of the
+// watchers are ended with the error.
+func (p *Poller) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		st := &pollState{seen: make(map[string]time.Time)}
+		for {
+			select {
+			case <-ctx.Done():
+				p.endAll(ErrPollerStopped)
+				return
+			case <-ticker.C:
+			}
+			p.l.Lock()
+			idle := len(p.watchers) == 0
+			p.l.Unlock()
+			if idle {
+				st = &pollState{seen: make(map[string]time.Time)}
+				continue
+			}
+			if err := p.poll(ctx, st); err != nil {
+				if ctx.Err() != nil {
+					continue
+				}
+				p.endAll(err)
+				st = &pollState{seen: make(map[string]time.Time)}
+			}
+		}
+	}()
+}
+
+// poll reads the changes made since the last poll and sends those not read
+// before to the watchers. The first poll since the poller had no watchers
+// starts from the current time of the database, as watchers read the changes
+// made before they subscribed themselves.
+func (p *Poller) poll(ctx context.Context, st *pollState) error {
+	repo, err := p.repoFn()
+	if err != nil {
+		return err
+	}
+	if st.last == nil {
+		now, err := repo.Now(ctx)
+		if err != nil {
+			return err
+		}
+		st.last = &db.PageAfter{CreateTime: now}
+	}
+	after := &db.PageAfter{CreateTime: st.last.CreateTime.Add(-lookback)}
+	for {
+		changes, err := repo.ListChanges(ctx, WithPageAfter(after), WithLimit(batchSize))
+		if err != nil {
+			return err
+		}
+		for _, c := range changes {
+			if _, ok := st.seen[c.Id]; ok {
+				continue
+			}
+			st.seen[c.Id] = c.CreateTime
+			if isAfter(c, st.last) {
+				st.last = &db.PageAfter{CreateTime: c.CreateTime, PublicId: c.Id}
+			}
+			p.publish(c)
+		}
+		if len(changes) < batchSize {
+			break
+		}
+		lastRead := changes[len(changes)-1]
+		after = &db.PageAfter{CreateTime: lastRead.CreateTime, PublicId: lastRead.Id}
+	}
+	for id, t := range st.seen {
+		if t.Before(st.last.CreateTime.Add(-lookback)) {
+			delete(st.seen, id)
+		}
+	}
+	return nil
+}
+
+// publish sends c to every watcher, ending the subscription of those whose
+// buffer is full with ErrLagged
+func (p *Poller) publish(c *Change) {
+	p.l.Lock()
+	defer p.l.Unlock()
+	for w := range p.watchers {
+		select {
+		case w.changes <- c:
+		default:
+			p.endLocked(w, ErrLagged)
+		}
+	}
+}
+
+// endAll ends the subscriptions of all the watchers with err
+func (p *Poller) endAll(err error) {
+	p.l.Lock()
+	defer p.l.Unlock()
+	for w := range p.watchers {
+		p.endLocked(w, err)
+	}
+}
+
+func (p *Poller) endLocked(w *Watcher, err error) {
+	delete(p.watchers, w)
+	w.err = err
+	close(w.done)
+}
+
+// subscribe adds w to the watchers the changes are sent to
+func (p *Poller) subscribe(w *Watcher) {
+	p.l.Lock()
+	defer p.l.Unlock()
+	w.changes = make(chan *Change, watcherBuffer)
+	w.done = make(chan struct{})
+	w.err = nil
+	p.watchers[w] = struct{}{}
+}
+
+// Watch returns a watcher of the changes made after start, in the order of
+// their create time and id. The watcher first reads the changes already made
+// from the database, and then those read by the poller. It must be closed once
+// it is no longer used.
+func (p *Poller) Watch(start *db.PageAfter) *Watcher {
+	w := &Watcher{
+		p:       p,
+		start:   start,
+		last:    start,
+		seen:    make(map[string]time.Time),
+		catchUp: &db.PageAfter{CreateTime: start.CreateTime.Add(-lookback)},
+	}
+	// Subscribing before reading the changes already made ensures none made
+	// in between are missed
+	p.subscribe(w)
+	return w
+}
+
+// Watcher follows the changes made after a position, for a single client. It
+// is not safe for concurrent use.
+type Watcher struct {
+	p *Poller
+
+	// changes receives the changes read by the poller, until done is closed
+	// with the reason in err
+	changes chan *Change
+	done    chan struct{}
+	err     error
+
+	// start is the position the watcher started after and last the last
+	// change it returned
+	start *db.PageAfter
+	last  *db.PageAfter
+	// seen holds the IDs of the changes returned recently, which may be read
+	// again by the watcher or the poller
+	seen map[string]time.Time
+	// catchUp is the position after which the watcher reads the changes from
+	// the database, or nil once it has read those made before it subscribed
+	catchUp *db.PageAfter
+}
+
+// Next returns the next changes, waiting until there are some or ctx is done.
+// A watcher which falls behind the poller reads the changes it missed from
+// the database, so changes are not skipped.
+func (w *Watcher) Next(ctx context.Context) ([]*Change, error) {
+	for {
+		if w.catchUp != nil {
+			repo, err := w.p.repoFn()
+			if err != nil {
+				return nil, err
+			}
+			changes, err := repo.ListChanges(ctx, WithPageAfter(w.catchUp), WithLimit(batchSize))
+			if err != nil {
+				return nil, err
+			}
+			if len(changes) < batchSize {
+				w.catchUp = nil
+			} else {
+				lastRead := changes[len(changes)-1]
+				w.catchUp = &db.PageAfter{CreateTime: lastRead.CreateTime, PublicId: lastRead.Id}
+			}
+			if out := w.filter(changes); len(out) > 0 {
+				return out, nil
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+
+		case <-w.done:
+			if w.err != ErrLagged {
+				return nil, w.err
+			}
+			w.p.subscribe(w)
+			w.catchUp = &db.PageAfter{CreateTime: w.last.CreateTime.Add(-lookback)}
+
+		case c := <-w.changes:
+			changes := []*Change{c}
+		buffered:
+			for len(changes) < batchSize {
+				select {
+				case c := <-w.changes:
+					changes = append(changes, c)
+				default:
+					break buffered
+				}
+			}
+			if out := w.filter(changes); len(out) > 0 {
+				return out, nil
+			}
+		}
+	}
+}
+
+// filter returns the changes after the start of the watcher which it hasn't
+// returned yet
+func (w *Watcher) filter(changes []*Change) []*Change {
+	var out []*Change
+	for _, c := range changes {
+		if _, ok := w.seen[c.Id]; ok || !isAfter(c, w.start) {
+			continue
+		}
+		w.seen[c.Id] = c.CreateTime
+		if isAfter(c, w.last) {
+			w.last = &db.PageAfter{CreateTime: c.CreateTime, PublicId: c.Id}
+		}
+		out = append(out, c)
+	}
+	// The poller sends changes up to lookback before its last change, which
+	// may be behind that of the watcher when it caught up from the database
+	for id, t := range w.seen {
+		if t.Before(w.last.CreateTime.Add(-2 * lookback)) {
+			delete(w.seen, id)
+		}
+	}
+	return out
+}
+
+// Close stops sending the changes read by the poller to the watcher
+func (w *Watcher) Close() {
+	w.p.l.Lock()
+	defer w.p.l.Unlock()
+	if _, ok := w.p.watchers[w]; ok {
+		delete(w.p.watchers, w)
+	}
+}
+
+// isAfter reports whether c is after the position after in the order of
+// changes
+func isAfter(c *Change, after *db.PageAfter) bool {
+	if !c.CreateTime.Equal(after.CreateTime) {
+		return c.CreateTime.After(after.CreateTime)
+	}
+	return c.Id > after.PublicId
+}
@@ -0,0 +1,105 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPoller(t *testing.T) {
+	_, err := NewPoller(nil)
+	require.Error(t, err)
+	assert.Equal(t, "error creating poller with nil repository factory", err.Error())
+}
+
+func TestPoller_Publish(t *testing.T) {
+	assert := assert.New(t)
+	p, err := NewPoller(func() (*Repository, error) { return nil, errors.New("unused") })
+	require.NoError(t, err)
+	fast := p.Watch(&db.PageAfter{CreateTime: time.Now()})
+	slow := p.Watch(&db.PageAfter{CreateTime: time.Now()})
+	closed := p.Watch(&db.PageAfter{CreateTime: time.Now()})
+	closed.Close()
+
+	for i := 0; i < watcherBuffer; i++ {
+		p.publish(&Change{Id: "c"})
+		<-fast.changes
+	}
+	assert.Len(slow.changes, watcherBuffer)
+	assert.Len(closed.changes, 0)
+
+	// A watcher whose buffer is full is no longer sent the changes, and
+	// catches up from the database instead
+	p.publish(&Change{Id: "c"})
+	assert.Len(fast.changes, 1)
+	select {
+	case <-slow.done:
+		assert.Equal(ErrLagged, slow.err)
+	default:
+		assert.Fail("the subscription of the watcher behind was not ended")
+	}
+	assert.NotContains(p.watchers, slow)
+
+	p.endAll(ErrPollerStopped)
+	<-fast.done
+	assert.Equal(ErrPollerStopped, fast.err)
+	// As if the watcher had read the changes made before it started
+	fast.catchUp = nil
+	_, err = fast.Next(context.Background())
+	assert.Equal(ErrPollerStopped, err)
+	assert.Empty(p.watchers)
+}
+
+func TestPoller_Watch(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	repo, err := NewRepository(rw)
+	require.NoError(err)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	p, err := NewPoller(func() (*Repository, error) { return repo, nil })
+	require.NoError(err)
+	p.Start(ctx)
+
+	// nextChange returns the next change of the resource with the given id
+	nextChange := func(w *Watcher, resourceId string) *Change {
+		for {
+			changes, err := w.Next(ctx)
+			require.NoError(err)
+			for _, c := range changes {
+				if c.ResourceId == resourceId {
+					return c
+				}
+			}
+		}
+	}
+
+	now, err := repo.Now(ctx)
+	require.NoError(err)
+	w := p.Watch(&db.PageAfter{CreateTime: now})
+	defer w.Close()
+
+	// Changes made after the start are read by the poller
+	s := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	session.TestState(t, conn, s.PublicId, session.StatusActive)
+	pending := nextChange(w, s.PublicId)
+	assert.Equal("pending", pending.State)
+	assert.Equal("active", nextChange(w, s.PublicId).State)
+
+	// A watcher started before changes which were already made reads them
+	// from the database, after its start
+	resumed := p.Watch(&db.PageAfter{CreateTime: pending.CreateTime, PublicId: pending.Id})
+	defer resumed.Close()
+	assert.Equal("active", nextChange(resumed, s.PublicId).State)
+}
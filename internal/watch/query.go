@@ -0,0 +1,110 @@
+package watch
+
+const (
+	// now reads the current time of the database, which the create times of
+	// changes are set from
+	now = `select current_timestamp;`
+
+	// listChanges lists the changes after the position ($1, $2) in order of
+	// create time and id. Each of the unioned queries only reads the rows
+	// created at or after $1, using the indexes of their create times.
+	listChanges = `
+select *
+from (
+	select
+		ss.session_id || ':' || ss.state as id,
+		ss.start_time as create_time,
+		case ss.state when 'pending' then 'created' else 'updated' end as type,
+		'session' as resource_type,
+		ss.session_id as resource_id,
+		s.scope_id as scope_id,
+		ss.state as state,
+		ss.session_id as session_id,
+		coalesce(s.user_id, '') as user_id,
+		coalesce(s.target_id, '') as target_id
+	from
+		session_state ss
+		join session s on s.public_id = ss.session_id
+	where
+		ss.start_time >= $1
+	union all
+	select
+		cs.connection_id || ':' || cs.state as id,
+		cs.start_time as create_time,
+		case cs.state when 'authorized' then 'created' else 'updated' end as type,
+		'connection' as resource_type,
+		cs.connection_id as resource_id,
+		s.scope_id as scope_id,
+		cs.state as state,
+		c.session_id as session_id,
+		coalesce(s.user_id, '') as user_id,
+		coalesce(s.target_id, '') as target_id
+	from
+		session_connection_state cs
+		join session_connection c on c.public_id = cs.connection_id
+		join session s on s.public_id = c.session_id
+	where
+		cs.start_time >= $1
+	union all
+	select
+		o.id,
+		o.create_time,
+		-- entries writing more than one operation, or without a resource
+		-- type, change the resource of the entry through its associations
+		case
+			when o.op_type_count = 1 and o.has_resource_type then
+				case o.op_type
+					when 'OP_TYPE_CREATE' then 'created'
+					when 'OP_TYPE_DELETE' then 'deleted'
+					else 'updated'
+				end
+			else 'updated'
+		end as type,
+		case split_part(o.resource_id, '_', 1)
+			when 'o' then 'scope'
+			when 'p' then 'scope'
+			when 'u' then 'user'
+			when 'g' then 'group'
+			when 'r' then 'role'
+		end as resource_type,
+		o.resource_id,
+		-- the scope of orgs is global and that of projects their parent org,
+		-- which is missing from the entries of some of their updates
+		case split_part(o.resource_id, '_', 1)
+			when 'o' then 'global'
+			when 'p' then coalesce(
+				nullif(o.scope_id, o.resource_id),
+				(select parent_id from iam_scope where public_id = o.resource_id),
+				''
+			)
+			else coalesce(o.scope_id, '')
+		end as scope_id,
+		'' as state,
+		'' as session_id,
+		'' as user_id,
+		'' as target_id
+	from (
+		select
+			'oplog:' || e.id::text as id,
+			e.create_time,
+			max(m.value) filter (where m.key = 'resource-public-id') as resource_id,
+			nullif(max(m.value) filter (where m.key = 'scope-id'), '') as scope_id,
+			max(m.value) filter (where m.key = 'op-type') as op_type,
+			count(*) filter (where m.key = 'op-type') as op_type_count,
+			bool_or(m.key = 'resource-type') as has_resource_type
+		from
+			oplog_entry e
+			join oplog_metadata m on m.entry_id = e.id
+		where
+			e.create_time >= $1
+		group by e.id, e.create_time
+	) o
+	where
+		split_part(o.resource_id, '_', 1) in ('o', 'p', 'u', 'g', 'r')
+) c
+where
+	(c.create_time, c.id) > ($1, $2)
+order by c.create_time, c.id
+%s;
+`
+)
@@ -0,0 +1,104 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// Repository is the repository of the changes made to the resources clients
+// can watch
+type Repository struct {
+	reader db.Reader
+
+	// defaultLimit provides a default for limiting the number of results returned from the repo
+	defaultLimit int
+}
+
+// NewRepository creates a new watch Repository. Supports the options: WithLimit
+// which sets a default limit on results returned by repo operations.
+func NewRepository(r db.Reader, opt ...Option) (*Repository, error) {
+	if r == nil {
+		return nil, errors.New("error creating db repository with nil reader")
+	}
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	return &Repository{
+		reader:       r,
+		defaultLimit: opts.withLimit,
+	}, nil
+}
+
+// ListChanges lists the changes made to sessions, connections and IAM
+// resources in order of create time and id. Supports the WithLimit and
+// WithPageAfter options. A position without an id lists the changes created
+// at or after its create time.
+//
+// Changes are read as they are committed, so a change created before the last
+// one listed may still be listed by a later call, if its transaction
+// committed in between. Callers following the changes should list them again
+// from a little before the last one they listed.
+func (r *Repository) ListChanges(ctx context.Context, opt ...Option) ([]*Change, error) {
+	opts := getOpts(opt...)
+	after := &db.PageAfter{CreateTime: time.Unix(0, 0)}
+	if opts.withPageAfter != nil {
+		after = opts.withPageAfter
+	}
+
+	var limit string
+	switch {
+	case opts.withLimit < 0: // any negative number signals unlimited results
+	case opts.withLimit == 0: // zero signals the default value and default limits
+		limit = fmt.Sprintf("limit %d", r.defaultLimit)
+	default:
+		// non-zero signals an override of the default limit for the repo.
+		limit = fmt.Sprintf("limit %d", opts.withLimit)
+	}
+
+	rows, err := r.reader.Query(ctx, fmt.Sprintf(listChanges, limit), []interface{}{after.CreateTime, after.PublicId})
+	if err != nil {
+		return nil, fmt.Errorf("list changes: query failed: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []*Change
+	for rows.Next() {
+		var c Change
+		if err := r.reader.ScanRows(rows, &c); err != nil {
+			return nil, fmt.Errorf("list changes: scan row failed: %w", err)
+		}
+		changes = append(changes, &c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list changes: %w", err)
+	}
+	return changes, nil
+}
+
+// Now returns the current time of the database. Watches start from it rather
+// than the time of the controller, as the create times of changes are set by
+// the database and the clocks of the two may differ.
+func (r *Repository) Now(ctx context.Context) (time.Time, error) {
+	rows, err := r.reader.Query(ctx, now, nil)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("now: query failed: %w", err)
+	}
+	defer rows.Close()
+	var t time.Time
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return time.Time{}, fmt.Errorf("now: %w", err)
+		}
+		return time.Time{}, errors.New("now: no rows returned")
+	}
+	if err := rows.Scan(&t); err != nil {
+		return time.Time{}, fmt.Errorf("now: scan row failed: %w", err)
+	}
+	return t, nil
+}
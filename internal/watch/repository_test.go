@@ -0,0 +1,124 @@
+package watch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRepository(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+
+	repo, err := NewRepository(rw)
+	require.NoError(t, err)
+	assert.Equal(t, &Repository{reader: rw, defaultLimit: db.DefaultLimit}, repo)
+
+	repo, err = NewRepository(rw, WithLimit(5))
+	require.NoError(t, err)
+	assert.Equal(t, 5, repo.defaultLimit)
+
+	_, err = NewRepository(nil)
+	require.Error(t, err)
+	assert.Equal(t, "error creating db repository with nil reader", err.Error())
+}
+
+func TestRepository_ListChanges(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	ctx := context.Background()
+
+	s := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	session.TestState(t, conn, s.PublicId, session.StatusActive)
+	c := session.TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222)
+	org, _ := iam.TestScopes(t, iamRepo)
+	u := iam.TestUser(t, iamRepo, org.GetPublicId())
+
+	repo, err := NewRepository(rw)
+	require.NoError(err)
+	changes, err := repo.ListChanges(ctx, WithLimit(-1))
+	require.NoError(err)
+
+	byId := make(map[string]*Change)
+	for i, change := range changes {
+		if i > 0 {
+			prev := changes[i-1]
+			assert.False(change.CreateTime.Before(prev.CreateTime), "changes are not ordered")
+		}
+		byId[change.Id] = change
+	}
+
+	pending := byId[s.PublicId+":pending"]
+	require.NotNil(pending)
+	assert.Equal(Created, pending.Type)
+	assert.Equal("session", pending.ResourceType)
+	assert.Equal(s.PublicId, pending.ResourceId)
+	assert.Equal(s.ScopeId, pending.ScopeId)
+	assert.Equal(s.UserId, pending.UserId)
+	assert.Equal(s.TargetId, pending.TargetId)
+
+	active := byId[s.PublicId+":active"]
+	require.NotNil(active)
+	assert.Equal(Updated, active.Type)
+	assert.Equal("active", active.State)
+
+	authorized := byId[c.PublicId+":authorized"]
+	require.NotNil(authorized)
+	assert.Equal(Created, authorized.Type)
+	assert.Equal(ConnectionResourceType, authorized.ResourceType)
+	assert.Equal(s.PublicId, authorized.SessionId)
+	assert.Equal(s.ScopeId, authorized.ScopeId)
+	connected := byId[c.PublicId+":connected"]
+	require.NotNil(connected)
+	assert.Equal(Updated, connected.Type)
+
+	var orgChange, userChange *Change
+	for _, change := range changes {
+		switch change.ResourceId {
+		case org.GetPublicId():
+			orgChange = change
+		case u.GetPublicId():
+			userChange = change
+		}
+	}
+	require.NotNil(orgChange)
+	assert.Equal(Created, orgChange.Type)
+	assert.Equal("scope", orgChange.ResourceType)
+	assert.Equal(scope.Global.String(), orgChange.ScopeId)
+	require.NotNil(userChange)
+	assert.Equal(Created, userChange.Type)
+	assert.Equal("user", userChange.ResourceType)
+	assert.Equal(org.GetPublicId(), userChange.ScopeId)
+
+	// The changes can be read a batch at a time
+	var paged []*Change
+	var after *db.PageAfter
+	for {
+		batch, err := repo.ListChanges(ctx, WithLimit(2), WithPageAfter(after))
+		require.NoError(err)
+		paged = append(paged, batch...)
+		if len(batch) < 2 {
+			break
+		}
+		last := batch[len(batch)-1]
+		after = &db.PageAfter{CreateTime: last.CreateTime, PublicId: last.Id}
+	}
+	assert.Equal(changes, paged)
+
+	// A position without an id lists the changes at or after its time
+	from, err := repo.ListChanges(ctx, WithLimit(-1), WithPageAfter(&db.PageAfter{CreateTime: userChange.CreateTime}))
+	require.NoError(err)
+	require.NotEmpty(from)
+	assert.Equal(userChange.CreateTime, from[0].CreateTime)
+}
@@ -35,6 +35,8 @@ export default [
         content: [
           'accounts',
           'auth-methods',
+          'credentials',
+          'credential-stores',
           'groups',
           'hosts',
           'host-catalogs',